@@ -120,6 +120,9 @@ type UpgradeEntry struct {
 	UpgType UpgradeType
 	// UpgSql is used to perform upgrade operations
 	UpgSql string
+	// UpgSqlFunc builds the sql of the upgrade operations when it is not a
+	// constant, it is used instead of UpgSql if it is set
+	UpgSqlFunc func() (string, error)
 	// CheckFunc was used to check whether an upgrade is required
 	// return true if the system is already in the final state and does not need to be upgraded,
 	// otherwise return false
@@ -150,7 +153,14 @@ func (u *UpgradeEntry) Upgrade(txn executor.TxnExecutor, accountId uint32) error
 		}
 
 		// 2. Second, Execute upgrade sql
-		res, err := txn.Exec(u.UpgSql, executor.StatementOption{}.WithAccountID(accountId))
+		upgSql := u.UpgSql
+		if u.UpgSqlFunc != nil {
+			if upgSql, err = u.UpgSqlFunc(); err != nil {
+				getLogger().Error("generate upgrade entry sql error", zap.Error(err), zap.String("upgrade entry", u.String()))
+				return err
+			}
+		}
+		res, err := txn.Exec(upgSql, executor.StatementOption{}.WithAccountID(accountId))
 		if err != nil {
 			getLogger().Error("execute upgrade entry sql error", zap.Error(err), zap.String("upgrade entry", u.String()))
 			return err
@@ -264,7 +264,7 @@ var upg_information_schema_events = versions.UpgradeEntry{
 	Schema:    sysview.InformationDBConst,
 	TableName: "events",
 	UpgType:   versions.CREATE_NEW_TABLE,
-	UpgSql:    sysview.InformationSchemaEventsDDL,
+	UpgSql:    sysview.InformationSchemaEventsTableDDL,
 	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
 		return versions.CheckTableDefinition(txn, accountId, sysview.InformationDBConst, "events")
 	},
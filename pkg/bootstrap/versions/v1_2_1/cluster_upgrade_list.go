@@ -19,6 +19,9 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/predefine"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 )
 
@@ -27,6 +30,9 @@ var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_system_rawlog_comment,
 	upg_system_statementInto_comment,
 	upg_systemMetric_metric_comment,
+	upg_mo_task_auto_analyze,
+	upg_mo_resource_groups,
+	upg_mo_resource_group_bindings,
 }
 
 // viewSystemLogInfoDDL113 = "CREATE VIEW IF NOT EXISTS `system`.`log_info` as select `trace_id`, `span_id`, `span_kind`, `node_uuid`, `node_type`, `timestamp`, `logger_name`, `level`, `caller`, `message`, `extra`, `stack` from `system`.`rawlog` where `raw_item` = \"log_info\""
@@ -111,3 +117,46 @@ var upg_systemMetric_metric_comment = versions.UpgradeEntry{
 		return false, nil
 	},
 }
+
+var upg_mo_task_auto_analyze = versions.UpgradeEntry{
+	Schema:     catalog.MOTaskDB,
+	TableName:  "sys_cron_task",
+	UpgType:    versions.MODIFY_METADATA,
+	UpgSqlFunc: predefine.GenAutoAnalyzeCronTaskSQL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		sql := fmt.Sprintf("select task_metadata_id from %s.sys_cron_task where task_metadata_id = '%s'",
+			catalog.MOTaskDB, catalog.AutoAnalyzeCronTask)
+		res, err := txn.Exec(sql, executor.StatementOption{}.WithAccountID(accountId))
+		if err != nil {
+			return false, err
+		}
+		defer res.Close()
+
+		exists := false
+		res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+			exists = rows > 0
+			return !exists
+		})
+		return exists, nil
+	},
+}
+
+var upg_mo_resource_groups = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_RESOURCE_GROUPS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoResourceGroupsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUPS)
+	},
+}
+
+var upg_mo_resource_group_bindings = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_RESOURCE_GROUP_BINDINGS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoResourceGroupBindingsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUP_BINDINGS)
+	},
+}
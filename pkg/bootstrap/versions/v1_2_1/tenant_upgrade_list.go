@@ -15,8 +15,11 @@
 package v1_2_1
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/sysview"
 )
@@ -24,6 +27,20 @@ import (
 var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_mo_mysql_compatibility_mode1,
 	upg_information_schema_files,
+	upg_mo_mviews,
+	upg_mo_triggers,
+	upg_information_schema_triggers,
+	upg_mo_events,
+	upg_mo_event_history,
+	upg_information_schema_events,
+	upg_mo_online_ddl,
+	upg_information_schema_online_ddl_progress,
+	upg_mo_column_histograms,
+	upg_mo_table_analyze,
+	upg_information_schema_analyze_status,
+	upg_mo_plan_baselines,
+	upg_mo_catalog_mo_sessions,
+	upg_information_schema_processlist,
 }
 
 var upg_mo_mysql_compatibility_mode1 = versions.UpgradeEntry{
@@ -46,3 +63,197 @@ var upg_information_schema_files = versions.UpgradeEntry{
 		return versions.CheckTableDefinition(txn, accountId, sysview.InformationDBConst, "files")
 	},
 }
+
+var upg_mo_mviews = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_MVIEWS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoMviewsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_MVIEWS)
+	},
+}
+
+var upg_mo_triggers = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_TRIGGERS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoTriggersDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_TRIGGERS)
+	},
+}
+
+var upg_information_schema_triggers = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "TRIGGERS",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    sysview.InformationSchemaTriggersDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "TRIGGERS")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaTriggersDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	// TRIGGERS used to be an empty table
+	PreSql: fmt.Sprintf("DROP TABLE IF EXISTS %s.%s;", sysview.InformationDBConst, "TRIGGERS"),
+}
+
+var upg_mo_events = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENTS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENTS)
+	},
+}
+
+var upg_mo_event_history = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_EVENT_HISTORY,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoEventHistoryDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_EVENT_HISTORY)
+	},
+}
+
+var upg_information_schema_events = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "EVENTS",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    sysview.InformationSchemaEventsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "EVENTS")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaEventsDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	// EVENTS used to be an empty table
+	PreSql: fmt.Sprintf("DROP TABLE IF EXISTS %s.%s;", sysview.InformationDBConst, "EVENTS"),
+}
+
+var upg_mo_online_ddl = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_ONLINE_DDL,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoOnlineDDLDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_ONLINE_DDL)
+	},
+}
+
+var upg_information_schema_online_ddl_progress = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "ONLINE_DDL_PROGRESS",
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    sysview.InformationSchemaOnlineDDLProgressDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "ONLINE_DDL_PROGRESS")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaOnlineDDLProgressDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_column_histograms = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_COLUMN_HISTOGRAMS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoColumnHistogramsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_COLUMN_HISTOGRAMS)
+	},
+}
+
+var upg_mo_table_analyze = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_TABLE_ANALYZE,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoTableAnalyzeDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_TABLE_ANALYZE)
+	},
+}
+
+var upg_information_schema_analyze_status = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "ANALYZE_STATUS",
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    sysview.InformationSchemaAnalyzeStatusDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "ANALYZE_STATUS")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaAnalyzeStatusDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_plan_baselines = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_PLAN_BASELINES,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoPlanBaselinesDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES)
+	},
+}
+
+var upg_mo_catalog_mo_sessions = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_sessions",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    frontend.MoCatalogMoSessionsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, "mo_sessions")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == frontend.MoCatalogMoSessionsDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	PreSql: fmt.Sprintf("DROP VIEW IF EXISTS %s.%s;", catalog.MO_CATALOG, "mo_sessions"),
+}
+
+var upg_information_schema_processlist = versions.UpgradeEntry{
+	Schema:    sysview.InformationDBConst,
+	TableName: "processlist",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    sysview.InformationSchemaProcesslistDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, sysview.InformationDBConst, "processlist")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == sysview.InformationSchemaProcesslistDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	PreSql: fmt.Sprintf("DROP VIEW IF EXISTS %s.%s;", sysview.InformationDBConst, "processlist"),
+}
@@ -69,6 +69,7 @@ const (
 	IndexAlgoParamOpType_l2 = "vector_l2_ops"
 	//IndexAlgoParamOpType_ip  = "vector_ip_ops"
 	//IndexAlgoParamOpType_cos = "vector_cosine_ops"
+	IndexAlgoParamOpType_hamming = "vector_hamming_ops"
 )
 
 const (
@@ -114,11 +115,13 @@ func IndexParamsToStringList(indexParams string) (string, error) {
 
 	if opType, ok := result[IndexAlgoParamOpType]; ok {
 		opType = ToLower(opType)
-		if opType != IndexAlgoParamOpType_l2 {
+		if opType != IndexAlgoParamOpType_l2 &&
 			//	opType != IndexAlgoParamOpType_ip &&
 			//	opType != IndexAlgoParamOpType_cos
-			return "", moerr.NewInternalErrorNoCtx("invalid op_type. not of type '%s'", IndexAlgoParamOpType_l2)
-			//IndexAlgoParamOpType_ip, , IndexAlgoParamOpType_cos)
+			opType != IndexAlgoParamOpType_hamming {
+			return "", moerr.NewInternalErrorNoCtx("invalid op_type. not of type '%s', '%s'", IndexAlgoParamOpType_l2,
+				//IndexAlgoParamOpType_ip, , IndexAlgoParamOpType_cos)
+				IndexAlgoParamOpType_hamming)
 
 		}
 
@@ -189,13 +192,15 @@ func indexParamsToMap(def *tree.Index) (map[string]string, error) {
 
 		if len(def.IndexOption.AlgoParamVectorOpType) > 0 {
 			opType := ToLower(def.IndexOption.AlgoParamVectorOpType)
-			if opType != IndexAlgoParamOpType_l2 {
+			if opType != IndexAlgoParamOpType_l2 &&
 				//opType != IndexAlgoParamOpType_ip &&
 				//opType != IndexAlgoParamOpType_cos &&
+				opType != IndexAlgoParamOpType_hamming {
 
-				return nil, moerr.NewInternalErrorNoCtx("invalid op_type. not of type '%s'",
+				return nil, moerr.NewInternalErrorNoCtx("invalid op_type. not of type '%s', '%s'",
 					IndexAlgoParamOpType_l2,
 					//IndexAlgoParamOpType_ip, IndexAlgoParamOpType_cos,
+					IndexAlgoParamOpType_hamming,
 				)
 			}
 			res[IndexAlgoParamOpType] = def.IndexOption.AlgoParamVectorOpType
@@ -140,6 +140,41 @@ const (
 
 	// MO_SNAPSHOTS
 	MO_SNAPSHOTS = "mo_snapshots"
+
+	// MO_MVIEWS materialized view meta table
+	MO_MVIEWS = "mo_mviews"
+
+	// MO_TRIGGERS trigger meta table
+	MO_TRIGGERS = "mo_triggers"
+
+	// MO_EVENTS event meta table
+	MO_EVENTS = "mo_events"
+
+	// MO_EVENT_HISTORY execution history of the events
+	MO_EVENT_HISTORY = "mo_event_history"
+
+	// MO_ONLINE_DDL progress of the online DDL jobs
+	MO_ONLINE_DDL = "mo_online_ddl"
+
+	// MO_COLUMN_HISTOGRAMS histograms of the columns collected by ANALYZE TABLE
+	MO_COLUMN_HISTOGRAMS = "mo_column_histograms"
+
+	// MO_TABLE_ANALYZE the last analyze and the auto analyze option of the tables
+	MO_TABLE_ANALYZE = "mo_table_analyze"
+
+	// AutoAnalyzeCronTask is the id of the cron task of the auto analyze
+	AutoAnalyzeCronTask = "StatsAutoAnalyze"
+	// AutoAnalyzeCronExpr runs the auto analyze every ten minutes
+	AutoAnalyzeCronExpr = "0 */10 * * * *"
+
+	// MO_PLAN_BASELINES the plans captured and pinned of the statement digests
+	MO_PLAN_BASELINES = "mo_plan_baselines"
+
+	// MO_RESOURCE_GROUPS the resource groups of the cluster
+	MO_RESOURCE_GROUPS = "mo_resource_groups"
+
+	// MO_RESOURCE_GROUP_BINDINGS the accounts and users bound to the resource groups
+	MO_RESOURCE_GROUP_BINDINGS = "mo_resource_group_bindings"
 )
 
 const (
@@ -249,6 +249,7 @@ func NewService(
 
 func (s *service) Start() error {
 	s.initSqlWriterFactory()
+	compile.SetAdmissionLimits(s.cfg.AdmissionControl.MaxConcurrency, int64(s.cfg.AdmissionControl.MaxMemory))
 
 	if err := s.queryService.Start(); err != nil {
 		return err
@@ -16,6 +16,7 @@ package cnservice
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -282,4 +283,45 @@ func (s *service) registerExecutorsLocked() {
 			return err
 		},
 	)
+	// refresh of materialized views created with refresh every
+	s.task.runner.RegisterExecutor(task.TaskCode_MaterializedViewRefresh,
+		func(ctx context.Context, t task.Task) error {
+			metadata := t.GetMetadata()
+			var refresh frontend.MaterializedViewRefreshTask
+			if err := json.Unmarshal(metadata.Context, &refresh); err != nil {
+				return err
+			}
+
+			sql := fmt.Sprintf("refresh materialized view `%s`.`%s`", refresh.Database, refresh.View)
+			ctx, cancel := context.WithTimeout(ctx, s.cfg.Frontend.SessionTimeout.Duration)
+			defer cancel()
+			opts := executor.Options{}.WithAccountID(refresh.AccountID).WithWaitCommittedLogApplied()
+			res, err := s.sqlExecutor.Exec(ctx, sql, opts)
+			if err != nil {
+				// the view is dropped without its task being canceled, e.g. by drop database
+				if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) || moerr.IsMoErrCode(err, moerr.ErrBadDB) {
+					_, err = ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, metadata.ID))
+				}
+				return err
+			}
+			res.Close()
+			return nil
+		},
+	)
+	// execution of events created by create event
+	s.task.runner.RegisterExecutor(task.TaskCode_EventSchedule,
+		func(ctx context.Context, t task.Task) error {
+			return frontend.ExecuteEvent(ctx, s.sqlExecutor, ts, t.GetMetadata())
+		},
+	)
+	// background refresh of the table statistics
+	s.task.runner.RegisterExecutor(task.TaskCode_StatsAutoAnalyze,
+		func(ctx context.Context, t task.Task) error {
+			// the internal sql executor requires a deadline, the next run
+			// goes on with the tables left.
+			ctx, cancel := context.WithTimeout(ctx, s.cfg.Frontend.SessionTimeout.Duration)
+			defer cancel()
+			return frontend.ExecuteAutoAnalyze(ctx, s.sqlExecutor, s.storeEngine)
+		},
+	)
 }
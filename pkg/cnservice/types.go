@@ -264,6 +264,17 @@ type Config struct {
 	// MaxPreparedStmtCount
 	MaxPreparedStmtCount int `toml:"max_prepared_stmt_count"`
 
+	// AdmissionControl queues the statements of the CN exceeding the limits
+	// until the running ones finish. The TP queries are never queued.
+	AdmissionControl struct {
+		// MaxConcurrency is the max number of the running statements, 0 means
+		// no limit.
+		MaxConcurrency int `toml:"max-concurrency"`
+		// MaxMemory is the max memory of the running statements estimated by
+		// their plans, 0 means no limit.
+		MaxMemory toml.ByteSize `toml:"max-memory"`
+	} `toml:"admission-control"`
+
 	// InitWorkState is the initial work state for CN. Valid values are:
 	// "working", "draining" and "drained".
 	InitWorkState string `toml:"init-work-state"`
@@ -64,6 +64,8 @@ const (
 	ErrOOM              uint16 = 20103
 	ErrQueryInterrupted uint16 = 20104
 	ErrNotSupported     uint16 = 20105
+	ErrQueryTimeout     uint16 = 20106
+	ErrMemoryQuota      uint16 = 20107
 
 	// Group 2: numeric and functions
 	ErrDivByZero                   uint16 = 20200
@@ -86,6 +88,11 @@ const (
 	ErrWrongDatetimeSpec    uint16 = 20310
 	ErrUpgrateError         uint16 = 20311
 	ErrInvalidTz            uint16 = 20312
+	ErrSpFetchNoData        uint16 = 20313
+	ErrSpCursorNotOpen      uint16 = 20314
+	ErrSpCursorAlreadyOpen  uint16 = 20315
+	ErrSpWrongNoOfFetchArgs uint16 = 20316
+	ErrSignalException      uint16 = 20317
 
 	// Group 4: unexpected state and io errors
 	ErrInvalidState                             uint16 = 20400
@@ -161,6 +168,20 @@ const (
 	ErrDuplicateKeyName                         uint16 = 20470
 	ErrFKNoReferencedRow2                       uint16 = 20471
 	ErrBlobCantHaveDefault                      uint16 = 20472
+	ErrDropPartitionNonExistent                 uint16 = 20473
+	ErrDropLastPartition                        uint16 = 20474
+	ErrOnlyOnRangeListPartition                 uint16 = 20475
+	ErrPartitionExchangePartTable               uint16 = 20476
+	ErrTablesDifferentMetadata                  uint16 = 20477
+	ErrRowDoesNotMatchPartition                 uint16 = 20478
+	ErrUnknownPartition                         uint16 = 20479
+	ErrCoalesceOnlyOnHashPartition              uint16 = 20480
+	ErrReorgHashOnlyOnSameNumber                uint16 = 20481
+	ErrReorgNoParam                             uint16 = 20482
+	ErrAddPartitionNoNewPartition               uint16 = 20483
+	ErrCoalescePartitionNoPartition             uint16 = 20484
+	ErrSubpartition                             uint16 = 20485
+	ErrPartitionWrongNoSubpart                  uint16 = 20486
 
 	// Group 5: rpc timeout
 	// ErrRPCTimeout rpc timeout
@@ -307,6 +328,8 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrOOM:              {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "error: out of memory"},
 	ErrQueryInterrupted: {ER_QUERY_INTERRUPTED, []string{MySQLDefaultSqlState}, "query interrupted"},
 	ErrNotSupported:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "not supported: %s"},
+	ErrQueryTimeout:     {ER_QUERY_TIMEOUT, []string{MySQLDefaultSqlState}, "Query execution was interrupted, maximum statement execution time exceeded"},
+	ErrMemoryQuota:      {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "query exceeded memory quota, quota %d bytes"},
 
 	// Group 2: numeric
 	ErrDivByZero:                   {ER_DIVISION_BY_ZERO, []string{MySQLDefaultSqlState}, "division by zero"},
@@ -328,6 +351,11 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrBadFieldError:        {ER_BAD_FIELD_ERROR, []string{MySQLDefaultSqlState}, "Unknown column '%s' in '%s'"},
 	ErrWrongDatetimeSpec:    {ER_WRONG_DATETIME_SPEC, []string{MySQLDefaultSqlState}, "wrong date/time format specifier: %s"},
 	ErrUpgrateError:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "CN upgrade table or view '%s.%s' under tenant '%s:%d' reports error: %s"},
+	ErrSpFetchNoData:        {ER_SP_FETCH_NO_DATA, []string{"02000"}, "No data - zero rows fetched, selected, or processed"},
+	ErrSpCursorNotOpen:      {ER_SP_CURSOR_NOT_OPEN, []string{"24000"}, "Cursor is not open"},
+	ErrSpCursorAlreadyOpen:  {ER_SP_CURSOR_ALREADY_OPEN, []string{"24000"}, "Cursor is already open"},
+	ErrSpWrongNoOfFetchArgs: {ER_SP_WRONG_NO_OF_FETCH_ARGS, []string{MySQLDefaultSqlState}, "Incorrect number of FETCH variables"},
+	ErrSignalException:      {ER_SIGNAL_EXCEPTION, []string{"45000"}, "Unhandled user-defined exception condition"},
 
 	// Group 4: unexpected state or file io error
 	ErrInvalidState:                             {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "invalid state %s"},
@@ -403,6 +431,20 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrDuplicateKeyName:                         {ER_DUP_KEYNAME, []string{MySQLDefaultSqlState}, "Duplicate foreign key constraint name '%-.192s'"},
 	ErrFKNoReferencedRow2:                       {ER_NO_REFERENCED_ROW_2, []string{"23000"}, "Cannot add or update a child row: a foreign key constraint fails"},
 	ErrBlobCantHaveDefault:                      {ER_BLOB_CANT_HAVE_DEFAULT, []string{MySQLDefaultSqlState}, "BLOB, TEXT, GEOMETRY or JSON column '%-.192s' can't have a default value"},
+	ErrDropPartitionNonExistent:                 {ER_DROP_PARTITION_NON_EXISTENT, []string{MySQLDefaultSqlState}, "Error in list of partitions to %-.64s"},
+	ErrDropLastPartition:                        {ER_DROP_LAST_PARTITION, []string{MySQLDefaultSqlState}, "Cannot remove all partitions, use DROP TABLE instead"},
+	ErrOnlyOnRangeListPartition:                 {ER_ONLY_ON_RANGE_LIST_PARTITION, []string{MySQLDefaultSqlState}, "%-.64s PARTITION can only be used on RANGE/LIST partitions"},
+	ErrPartitionExchangePartTable:               {ER_PARTITION_EXCHANGE_PART_TABLE, []string{MySQLDefaultSqlState}, "Table to exchange with partition is partitioned: '%-.64s'"},
+	ErrTablesDifferentMetadata:                  {ER_TABLES_DIFFERENT_METADATA, []string{MySQLDefaultSqlState}, "Tables have different definitions"},
+	ErrRowDoesNotMatchPartition:                 {ER_ROW_DOES_NOT_MATCH_PARTITION, []string{MySQLDefaultSqlState}, "Found a row that does not match the partition"},
+	ErrUnknownPartition:                         {ER_UNKNOWN_PARTITION, []string{MySQLDefaultSqlState}, "Unknown partition '%-.64s' in table '%-.64s'"},
+	ErrCoalesceOnlyOnHashPartition:              {ER_COALESCE_ONLY_ON_HASH_PARTITION, []string{MySQLDefaultSqlState}, "COALESCE PARTITION can only be used on HASH/KEY partitions"},
+	ErrReorgHashOnlyOnSameNumber:                {ER_REORG_HASH_ONLY_ON_SAME_NO, []string{MySQLDefaultSqlState}, "REORGANIZE PARTITION can only be used to reorganize partitions not to change their numbers"},
+	ErrReorgNoParam:                             {ER_REORG_NO_PARAM_ERROR, []string{MySQLDefaultSqlState}, "REORGANIZE PARTITION without parameters can only be used on auto-partitioned tables using HASH PARTITIONs"},
+	ErrAddPartitionNoNewPartition:               {ER_ADD_PARTITION_NO_NEW_PARTITION, []string{MySQLDefaultSqlState}, "At least one partition must be added"},
+	ErrCoalescePartitionNoPartition:             {ER_COALESCE_PARTITION_NO_PARTITION, []string{MySQLDefaultSqlState}, "At least one partition must be coalesced"},
+	ErrSubpartition:                             {ER_SUBPARTITION_ERROR, []string{MySQLDefaultSqlState}, "It is only possible to mix RANGE/LIST partitioning with HASH/KEY partitioning for subpartitioning"},
+	ErrPartitionWrongNoSubpart:                  {ER_PARTITION_WRONG_NO_SUBPART_ERROR, []string{MySQLDefaultSqlState}, "Wrong number of subpartitions defined, mismatch with previous setting"},
 
 	// Group 5: rpc timeout
 	ErrRPCTimeout:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "rpc timeout"},
@@ -755,6 +797,14 @@ func NewQueryInterrupted(ctx context.Context) *Error {
 	return newError(ctx, ErrQueryInterrupted)
 }
 
+func NewQueryTimeout(ctx context.Context) *Error {
+	return newError(ctx, ErrQueryTimeout)
+}
+
+func NewMemoryQuota(ctx context.Context, quota int64) *Error {
+	return newError(ctx, ErrMemoryQuota, quota)
+}
+
 func NewDivByZero(ctx context.Context) *Error {
 	return newError(ctx, ErrDivByZero)
 }
@@ -1112,6 +1162,36 @@ func NewWrongDatetimeSpec(ctx context.Context, val string) *Error {
 	return newError(ctx, ErrWrongDatetimeSpec, val)
 }
 
+func NewSpFetchNoData(ctx context.Context) *Error {
+	return newError(ctx, ErrSpFetchNoData)
+}
+
+func NewSpCursorNotOpen(ctx context.Context) *Error {
+	return newError(ctx, ErrSpCursorNotOpen)
+}
+
+func NewSpCursorAlreadyOpen(ctx context.Context) *Error {
+	return newError(ctx, ErrSpCursorAlreadyOpen)
+}
+
+func NewSpWrongNoOfFetchArgs(ctx context.Context) *Error {
+	return newError(ctx, ErrSpWrongNoOfFetchArgs)
+}
+
+// NewSignalException returns the condition raised by SIGNAL or RESIGNAL,
+// the message, mysql error code and sqlstate are given by the statement.
+func NewSignalException(ctx context.Context, sqlState string, mysqlCode uint16, msg string) *Error {
+	err := newError(ctx, ErrSignalException)
+	err.sqlState = sqlState
+	if mysqlCode != 0 {
+		err.mysqlCode = mysqlCode
+	}
+	if msg != "" {
+		err.message = msg
+	}
+	return err
+}
+
 func NewRoleGrantedToSelf(ctx context.Context, from, to string) *Error {
 	return newError(ctx, ErrRoleGrantedToSelf, from, to)
 }
@@ -1403,6 +1483,62 @@ func NewErrBlobCantHaveDefault(ctx context.Context, arg any) *Error {
 	return newError(ctx, ErrBlobCantHaveDefault, arg)
 }
 
+func NewErrDropPartitionNonExistent(ctx context.Context, op string) *Error {
+	return newError(ctx, ErrDropPartitionNonExistent, op)
+}
+
+func NewErrDropLastPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrDropLastPartition)
+}
+
+func NewErrOnlyOnRangeListPartition(ctx context.Context, op string) *Error {
+	return newError(ctx, ErrOnlyOnRangeListPartition, op)
+}
+
+func NewErrPartitionExchangePartTable(ctx context.Context, tableName string) *Error {
+	return newError(ctx, ErrPartitionExchangePartTable, tableName)
+}
+
+func NewErrTablesDifferentMetadata(ctx context.Context) *Error {
+	return newError(ctx, ErrTablesDifferentMetadata)
+}
+
+func NewErrRowDoesNotMatchPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrRowDoesNotMatchPartition)
+}
+
+func NewErrUnknownPartition(ctx context.Context, partitionName, tableName string) *Error {
+	return newError(ctx, ErrUnknownPartition, partitionName, tableName)
+}
+
+func NewErrCoalesceOnlyOnHashPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrCoalesceOnlyOnHashPartition)
+}
+
+func NewErrReorgHashOnlyOnSameNumber(ctx context.Context) *Error {
+	return newError(ctx, ErrReorgHashOnlyOnSameNumber)
+}
+
+func NewErrReorgNoParam(ctx context.Context) *Error {
+	return newError(ctx, ErrReorgNoParam)
+}
+
+func NewErrAddPartitionNoNewPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrAddPartitionNoNewPartition)
+}
+
+func NewErrCoalescePartitionNoPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrCoalescePartitionNoPartition)
+}
+
+func NewErrSubpartition(ctx context.Context) *Error {
+	return newError(ctx, ErrSubpartition)
+}
+
+func NewErrPartitionWrongNoSubpart(ctx context.Context) *Error {
+	return newError(ctx, ErrPartitionWrongNoSubpart)
+}
+
 var contextFunc atomic.Value
 
 func SetContextFunc(f func() context.Context) {
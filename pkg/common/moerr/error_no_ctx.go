@@ -46,6 +46,10 @@ func NewOOMNoCtx() *Error {
 	return newError(Context(), ErrOOM)
 }
 
+func NewMemoryQuotaNoCtx(quota int64) *Error {
+	return newError(Context(), ErrMemoryQuota, quota)
+}
+
 func NewDivByZeroNoCtx() *Error {
 	return newError(Context(), ErrDivByZero)
 }
@@ -21,11 +21,12 @@ import (
 	"unsafe"
 )
 
-func alloc(sz, requiredSpaceWithoutHeader int, mp *MPool) []byte {
+func alloc(sz, requiredSpaceWithoutHeader int, quotaId int64, mp *MPool) []byte {
 	bs := make([]byte, requiredSpaceWithoutHeader+kMemHdrSz)
 	hdr := unsafe.Pointer(&bs[0])
 	pHdr := (*memHdr)(hdr)
 	pHdr.poolId = mp.id
+	pHdr.quotaId = quotaId
 	pHdr.fixedPoolIdx = NumFixedPool
 	pHdr.allocSz = int32(sz)
 	pHdr.SetGuard()
@@ -120,7 +120,7 @@ func (s *MPoolStats) RecordManyFrees(tag string, nfree, sz int64) int64 {
 
 const (
 	NumFixedPool = 5
-	kMemHdrSz    = 16
+	kMemHdrSz    = 24
 	kStripeSize  = 128
 	B            = 1
 	KB           = 1024
@@ -136,6 +136,7 @@ var PoolElemSize = [NumFixedPool]int32{64, 128, 256, 512, 1024}
 // Memory header, kMemHdrSz bytes.
 type memHdr struct {
 	poolId       int64
+	quotaId      int64 // the quota charged by the allocation, 0 if none
 	allocSz      int32
 	fixedPoolIdx int8
 	guard        [3]uint8
@@ -330,6 +331,7 @@ type MPool struct {
 	inUseCount int32 // number of in use call
 	pools      [NumFixedPool]fixedPool
 	details    *mpoolDetails
+	quota      atomic.Pointer[Quota] // memory quota of the statement, see SetQuota
 
 	// To remove: this thing is highly unlikely to be of any good use.
 	sels *sync.Pool
@@ -583,17 +585,27 @@ func (mp *MPool) Alloc(sz int) ([]byte, error) {
 		globalStats.RecordFree("global", tempSize)
 		return nil, moerr.NewInternalErrorNoCtx("mpool out of space, alloc %d bytes, cap %d", sz, mp.cap)
 	}
+	var quotaId int64
+	if q := mp.quota.Load(); q != nil {
+		if exceeded := q.alloc(tempSize); exceeded != nil {
+			mp.stats.RecordFree(mp.tag, tempSize)
+			globalStats.RecordFree("global", tempSize)
+			return nil, moerr.NewMemoryQuotaNoCtx(exceeded.Limit())
+		}
+		quotaId = q.id
+	}
 
 	// from fixed pool
 	if idx < NumFixedPool {
 		bs := mp.pools[idx].alloc(int32(requiredSpaceWithoutHeader))
+		bs.quotaId = quotaId
 		if mp.details != nil {
 			mp.details.recordAlloc(int64(bs.allocSz))
 		}
 		return bs.ToSlice(sz, int(mp.pools[idx].eleSz)), nil
 	}
 
-	return alloc(sz, requiredSpaceWithoutHeader, mp), nil
+	return alloc(sz, requiredSpaceWithoutHeader, quotaId, mp), nil
 }
 
 func (mp *MPool) Free(bs []byte) {
@@ -632,6 +644,11 @@ func (mp *MPool) Free(bs []byte) {
 	recordSize := int64(pHdr.allocSz) + kMemHdrSz
 	mp.stats.RecordFree(mp.tag, recordSize)
 	globalStats.RecordFree("global", recordSize)
+	// the memory is returned to the quota charged by its allocation, which
+	// may be the quota of a statement before
+	if pHdr.quotaId != 0 {
+		freeQuota(pHdr.quotaId, recordSize)
+	}
 	if mp.details != nil {
 		mp.details.recordFree(int64(pHdr.allocSz))
 	}
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 func TestMPool(t *testing.T) {
@@ -150,3 +152,140 @@ func TestMpoolReAllocate(t *testing.T) {
 	m.Free(d4)
 	require.Equal(t, int64(0), m.CurrNB())
 }
+
+func TestMPoolQuota(t *testing.T) {
+	m1, err := NewMPool("test-quota-1", 0, NoFixed)
+	require.NoError(t, err)
+	m2, err := NewMPool("test-quota-2", 0, NoFixed)
+	require.NoError(t, err)
+
+	// the memory allocated before the quota is set is not charged
+	before, err := m1.Alloc(1000)
+	require.NoError(t, err)
+
+	q := NewQuota(10 * KB)
+	require.Nil(t, m1.SetQuota(q))
+	require.Nil(t, m2.SetQuota(q))
+
+	a, err := m1.Alloc(4 * KB)
+	require.NoError(t, err)
+	b, err := m2.Alloc(4 * KB)
+	require.NoError(t, err)
+	require.Equal(t, int64(8*KB+2*kMemHdrSz), q.Curr())
+
+	// the quota is shared by the pools
+	nb := m2.CurrNB()
+	_, err = m2.Alloc(4 * KB)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrMemoryQuota))
+	require.Equal(t, nb, m2.CurrNB())
+
+	m1.Free(a)
+	c, err := m2.Alloc(4 * KB)
+	require.NoError(t, err)
+	require.Equal(t, int64(8*KB+2*kMemHdrSz), q.Peak())
+
+	m1.Free(before)
+	m2.Free(b)
+	m2.Free(c)
+	require.Equal(t, int64(0), q.Curr())
+	require.Equal(t, q, m1.SetQuota(nil))
+	require.Equal(t, q, m2.SetQuota(nil))
+}
+
+func TestMPoolChildQuota(t *testing.T) {
+	m1, err := NewMPool("test-child-quota-1", 0, NoFixed)
+	require.NoError(t, err)
+	m2, err := NewMPool("test-child-quota-2", 0, NoFixed)
+	require.NoError(t, err)
+
+	// the pools of two statements share the budget of their group
+	group := NewQuota(10 * KB)
+	q1 := NewChildQuota(0, group)
+	q2 := NewChildQuota(0, group)
+	m1.SetQuota(q1)
+	m2.SetQuota(q2)
+
+	a, err := m1.Alloc(6 * KB)
+	require.NoError(t, err)
+	_, err = m2.Alloc(6 * KB)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrMemoryQuota))
+	require.Equal(t, int64(0), q2.Curr())
+
+	m1.Free(a)
+	b, err := m2.Alloc(6 * KB)
+	require.NoError(t, err)
+	require.Equal(t, q2.Curr(), group.Curr())
+	m2.Free(b)
+	require.Equal(t, int64(0), group.Curr())
+	require.Equal(t, int64(6*KB+kMemHdrSz), group.Peak())
+}
+
+func TestQuotaOfTxnStatements(t *testing.T) {
+	// the statements of an explicit transaction share the pool of the session
+	m, err := NewMPool("test-txn-quota", 0, NoFixed)
+	require.NoError(t, err)
+	group := NewQuota(10 * KB)
+
+	// the first statement allocates the workspace of the transaction
+	q1 := NewChildQuota(0, group)
+	m.SetQuota(q1)
+	workspace, err := m.Alloc(4 * KB)
+	require.NoError(t, err)
+	a, err := m.Alloc(2 * KB)
+	require.NoError(t, err)
+	m.Free(a)
+	require.Equal(t, int64(4*KB+kMemHdrSz), q1.Curr())
+	require.Equal(t, q1.Curr(), group.Curr())
+	m.SetQuota(nil)
+	q1.Release()
+	require.Zero(t, q1.Curr())
+	require.Zero(t, group.Curr())
+
+	// the second statement frees the workspace, which is not deducted from
+	// its quota or the group
+	q2 := NewChildQuota(0, group)
+	m.SetQuota(q2)
+	b, err := m.Alloc(8 * KB)
+	require.NoError(t, err)
+	m.Free(workspace)
+	require.Equal(t, int64(8*KB+kMemHdrSz), q2.Curr())
+	require.Equal(t, q2.Curr(), group.Curr())
+	_, err = m.Alloc(4 * KB)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrMemoryQuota))
+	m.SetQuota(nil)
+
+	// the memory freed after the statement is not charged
+	q2.Release()
+	m.Free(b)
+	require.Zero(t, q2.Curr())
+	require.Zero(t, group.Curr())
+	require.Zero(t, m.CurrNB())
+}
+
+func TestQuotaFreeToParent(t *testing.T) {
+	group := NewQuota(0)
+	require.Nil(t, group.alloc(100))
+	q := NewChildQuota(0, group)
+	require.Nil(t, q.alloc(10))
+	require.Equal(t, int64(110), group.Curr())
+
+	// only the bytes deducted from the child are returned to the parent
+	q.free(30)
+	require.Zero(t, q.Curr())
+	require.Equal(t, int64(100), group.Curr())
+}
+
+func TestGroupQuota(t *testing.T) {
+	require.Nil(t, GroupQuota("g1", 0))
+	require.Nil(t, GroupQuota("", 10*KB))
+
+	q := GroupQuota("g1", 10*KB)
+	require.Equal(t, int64(10*KB), q.Limit())
+	require.Same(t, q, GroupQuota("g1", 10*KB))
+	require.NotSame(t, q, GroupQuota("g2", 10*KB))
+
+	// the budget is replaced if the limit is changed
+	q2 := GroupQuota("g1", 20*KB)
+	require.NotSame(t, q, q2)
+	require.Equal(t, int64(20*KB), q2.Limit())
+}
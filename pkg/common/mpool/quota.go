@@ -0,0 +1,169 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpool
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Quota limits the memory allocated by the mpools sharing it, such as the
+// pools used by the pipelines of a statement. An allocation exceeding the
+// limit fails with ErrMemoryQuota, so that the statement is cancelled instead
+// of the CN running out of memory.
+//
+// The quota is charged by the allocations made while it is set to the pool,
+// and the memory is returned to it when freed, even if another quota is set to
+// the pool by then. The memory still held when the quota is released, e.g.
+// the workspace of a transaction running on, is no longer charged.
+//
+// A quota may have a parent, e.g. the memory budget of the resource group the
+// statement runs in, which is charged together with it.
+type Quota struct {
+	id     int64 // recorded in the header of the memory charged
+	limit  int64 // 0 means no limit, the quota only records the peak
+	parent *Quota
+	curr   atomic.Int64
+	peak   atomic.Int64
+}
+
+var nextQuota atomic.Int64
+
+// quotas are the quotas set to the pools and not released yet, by id.
+var quotas sync.Map
+
+func NewQuota(limit int64) *Quota {
+	return NewChildQuota(limit, nil)
+}
+
+// NewChildQuota returns a quota charging the parent too, the parent may be nil.
+func NewChildQuota(limit int64, parent *Quota) *Quota {
+	if limit < 0 {
+		limit = 0
+	}
+	return &Quota{id: nextQuota.Add(1), limit: limit, parent: parent}
+}
+
+// Limit returns the limit in bytes, 0 means no limit.
+func (q *Quota) Limit() int64 {
+	return q.limit
+}
+
+// Curr returns the bytes in use.
+func (q *Quota) Curr() int64 {
+	return q.curr.Load()
+}
+
+// Peak returns the max bytes in use so far.
+func (q *Quota) Peak() int64 {
+	return q.peak.Load()
+}
+
+// alloc charges sz bytes to the quota and its parents. It returns the quota
+// whose limit is exceeded, or nil if the allocation is allowed.
+func (q *Quota) alloc(sz int64) *Quota {
+	curr := q.curr.Add(sz)
+	if q.limit > 0 && curr > q.limit {
+		q.curr.Add(-sz)
+		return q
+	}
+	if q.parent != nil {
+		if exceeded := q.parent.alloc(sz); exceeded != nil {
+			q.curr.Add(-sz)
+			return exceeded
+		}
+	}
+	for {
+		peak := q.peak.Load()
+		if curr <= peak || q.peak.CompareAndSwap(peak, curr) {
+			return nil
+		}
+	}
+}
+
+// free returns sz bytes to the quota and its parents. The quota never goes
+// below zero, only the bytes deducted from it are returned to the parent.
+func (q *Quota) free(sz int64) {
+	for {
+		curr := q.curr.Load()
+		next := curr - sz
+		if next < 0 {
+			next = 0
+		}
+		if q.curr.CompareAndSwap(curr, next) {
+			sz = curr - next
+			break
+		}
+	}
+	if q.parent != nil && sz > 0 {
+		q.parent.free(sz)
+	}
+}
+
+// Release returns the bytes still charged to the quota to its parents, e.g.
+// when the statement ends. The memory freed after is not charged to the quota.
+func (q *Quota) Release() {
+	quotas.Delete(q.id)
+	if sz := q.curr.Swap(0); sz > 0 && q.parent != nil {
+		q.parent.free(sz)
+	}
+}
+
+// freeQuota returns the memory freed to the quota charged by its allocation,
+// if the quota is not released.
+func freeQuota(id int64, sz int64) {
+	if q, ok := quotas.Load(id); ok {
+		q.(*Quota).free(sz)
+	}
+}
+
+// SetQuota sets the quota charged by the allocations of the pool and returns
+// the previous one. A nil quota removes the limit.
+func (mp *MPool) SetQuota(q *Quota) *Quota {
+	if q != nil {
+		quotas.Store(q.id, q)
+	}
+	return mp.quota.Swap(q)
+}
+
+// Quota returns the quota charged by the allocations of the pool, or nil.
+func (mp *MPool) Quota() *Quota {
+	return mp.quota.Load()
+}
+
+// groupQuotas are the memory budgets of the resource groups on this CN. A
+// budget is shared by the statements of the group started on this CN and the
+// pipelines of its statements sent from the other CNs.
+var groupQuotas = struct {
+	sync.Mutex
+	m map[string]*Quota
+}{m: make(map[string]*Quota)}
+
+// GroupQuota returns the memory budget of the resource group on this CN, or
+// nil if the memory of the group is not limited. The budget is replaced if its
+// limit is changed, the statements running keep charging the old one.
+func GroupQuota(name string, limit int64) *Quota {
+	if name == "" || limit <= 0 {
+		return nil
+	}
+	groupQuotas.Lock()
+	defer groupQuotas.Unlock()
+	if q, ok := groupQuotas.m[name]; ok && q.limit == limit {
+		return q
+	}
+	q := NewQuota(limit)
+	groupQuotas.m[name] = q
+	return q
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// NOTE: veci8 and vecbit in SQL is internally represented using T_array_int8 and T_array_bit.
+// - veci8 stores one int8 per dimension.
+// - vecbit stores 8 dimensions per byte, the most significant bit first. So its dimension
+//   must be a multiple of 8.
+
+const BitArrayDimensionUnit = 8
+
+// BytesToInt8Array bytes should be of little-endian format
+func BytesToInt8Array(input []byte) []int8 {
+	return DecodeSlice[int8](input)
+}
+
+func Int8ArrayToBytes(input []int8) []byte {
+	return EncodeSlice[int8](input)
+}
+
+func Int8ArrayToString(input []int8) string {
+	var buffer bytes.Buffer
+	_, _ = io.WriteString(&buffer, "[")
+	for i, value := range input {
+		if i > 0 {
+			_, _ = io.WriteString(&buffer, ", ")
+		}
+		_, _ = io.WriteString(&buffer, strconv.FormatInt(int64(value), 10))
+	}
+	_, _ = io.WriteString(&buffer, "]")
+	return buffer.String()
+}
+
+func StringToInt8Array(str string) ([]int8, error) {
+	numStrs, err := splitArrayString(str)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]int8, len(numStrs))
+	for i, numStr := range numStrs {
+		v, err := strconv.ParseInt(numStr, 10, 8)
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtx("error while casting %s to int8", numStr)
+		}
+		result[i] = int8(v)
+	}
+	return result, nil
+}
+
+// BitArrayToString converts a packed bit vector to "[1, 0, 1, ...]".
+func BitArrayToString(input []byte) string {
+	var buffer bytes.Buffer
+	_, _ = io.WriteString(&buffer, "[")
+	for i := 0; i < len(input)*BitArrayDimensionUnit; i++ {
+		if i > 0 {
+			_, _ = io.WriteString(&buffer, ", ")
+		}
+		if BitArrayGet(input, i) {
+			_ = buffer.WriteByte('1')
+		} else {
+			_ = buffer.WriteByte('0')
+		}
+	}
+	_, _ = io.WriteString(&buffer, "]")
+	return buffer.String()
+}
+
+// StringToBitArray converts "[1, 0, 1, ...]" to a packed bit vector.
+func StringToBitArray(str string) ([]byte, error) {
+	numStrs, err := splitArrayString(str)
+	if err != nil {
+		return nil, err
+	}
+	if len(numStrs)%BitArrayDimensionUnit != 0 {
+		return nil, moerr.NewInternalErrorNoCtx("vecbit dimension %d is not a multiple of %d", len(numStrs), BitArrayDimensionUnit)
+	}
+
+	result := make([]byte, len(numStrs)/BitArrayDimensionUnit)
+	for i, numStr := range numStrs {
+		switch numStr {
+		case "0":
+		case "1":
+			BitArraySet(result, i)
+		default:
+			return nil, moerr.NewInternalErrorNoCtx("error while casting %s to bit", numStr)
+		}
+	}
+	return result, nil
+}
+
+func BitArrayGet(input []byte, i int) bool {
+	return input[i/BitArrayDimensionUnit]&(0x80>>(i%BitArrayDimensionUnit)) != 0
+}
+
+func BitArraySet(input []byte, i int) {
+	input[i/BitArrayDimensionUnit] |= 0x80 >> (i % BitArrayDimensionUnit)
+}
+
+// QuantizeToInt8 maps each element to the nearest int8, saturating at the int8 bounds.
+func QuantizeToInt8[T RealNumbers](input []T) []int8 {
+	result := make([]int8, len(input))
+	for i, v := range input {
+		f := math.Round(float64(v))
+		if f > math.MaxInt8 {
+			f = math.MaxInt8
+		} else if f < math.MinInt8 {
+			f = math.MinInt8
+		}
+		result[i] = int8(f)
+	}
+	return result
+}
+
+// QuantizeToBit sets one bit for every positive element. The dimension must be a multiple of 8.
+func QuantizeToBit[T RealNumbers](input []T) ([]byte, error) {
+	if len(input)%BitArrayDimensionUnit != 0 {
+		return nil, moerr.NewInternalErrorNoCtx("vecbit dimension %d is not a multiple of %d", len(input), BitArrayDimensionUnit)
+	}
+	result := make([]byte, len(input)/BitArrayDimensionUnit)
+	for i, v := range input {
+		if v > 0 {
+			BitArraySet(result, i)
+		}
+	}
+	return result, nil
+}
+
+func Int8ArrayToFloatArray[T RealNumbers](input []int8) []T {
+	result := make([]T, len(input))
+	for i, v := range input {
+		result[i] = T(v)
+	}
+	return result
+}
+
+func BitArrayToFloatArray[T RealNumbers](input []byte) []T {
+	result := make([]T, len(input)*BitArrayDimensionUnit)
+	for i := range result {
+		if BitArrayGet(input, i) {
+			result[i] = 1
+		}
+	}
+	return result
+}
+
+// QuantizedArrayDimension returns the number of dimensions stored in input.
+func QuantizedArrayDimension(oid T, input []byte) int {
+	if oid == T_array_bit {
+		return len(input) * BitArrayDimensionUnit
+	}
+	return len(input)
+}
+
+// StringToQuantizedArrayToBytes parses "[1,2,3]" into the storage format of oid.
+func StringToQuantizedArrayToBytes(oid T, str string) ([]byte, error) {
+	switch oid {
+	case T_array_int8:
+		a, err := StringToInt8Array(str)
+		if err != nil {
+			return nil, err
+		}
+		return Int8ArrayToBytes(a), nil
+	case T_array_bit:
+		return StringToBitArray(str)
+	default:
+		return nil, moerr.NewInternalErrorNoCtx("%s is not a quantized array type", oid.String())
+	}
+}
+
+// QuantizedArrayBytesToString formats the storage format of oid as "[1, 2, 3]".
+func QuantizedArrayBytesToString(oid T, input []byte) string {
+	if oid == T_array_bit {
+		return BitArrayToString(input)
+	}
+	return Int8ArrayToString(BytesToInt8Array(input))
+}
+
+// splitArrayString removes the brackets and spaces of "[1, 2, 3]" and returns its elements.
+func splitArrayString(str string) ([]string, error) {
+	input := strings.ReplaceAll(str, " ", "")
+
+	if !(strings.HasPrefix(input, "[") && strings.HasSuffix(input, "]")) || len(input) == 2 {
+		return nil, moerr.NewInternalErrorNoCtx("malformed vector input: %s", str)
+	}
+
+	numStrs := strings.Split(input[1:len(input)-1], ",")
+	if len(numStrs) > MaxArrayDimension {
+		return nil, moerr.NewInternalErrorNoCtx("typeLen is over the MaxVectorLen: %v", MaxArrayDimension)
+	}
+	return numStrs, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt8ArrayString(t *testing.T) {
+	a, err := StringToInt8Array("[1, -2, 127, -128]")
+	require.NoError(t, err)
+	require.Equal(t, []int8{1, -2, 127, -128}, a)
+	require.Equal(t, "[1, -2, 127, -128]", Int8ArrayToString(BytesToInt8Array(Int8ArrayToBytes(a))))
+
+	_, err = StringToInt8Array("[1, 128]")
+	require.Error(t, err)
+	_, err = StringToInt8Array("[]")
+	require.Error(t, err)
+}
+
+func TestBitArrayString(t *testing.T) {
+	b, err := StringToBitArray("[1,0,1,1,0,0,0,1]")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0b10110001}, b)
+	require.Equal(t, "[1, 0, 1, 1, 0, 0, 0, 1]", BitArrayToString(b))
+	require.Equal(t, 8, QuantizedArrayDimension(T_array_bit, b))
+
+	_, err = StringToBitArray("[1,0,1]")
+	require.Error(t, err)
+	_, err = StringToBitArray("[1,0,1,1,0,0,0,2]")
+	require.Error(t, err)
+}
+
+func TestQuantize(t *testing.T) {
+	require.Equal(t, []int8{1, -3, 127, -128}, QuantizeToInt8([]float32{0.6, -2.5, 300, -1000}))
+
+	b, err := QuantizeToBit([]float64{0.1, -1, 0, 2, 3, -4, 5, 0})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0b10011010}, b)
+
+	_, err = QuantizeToBit([]float64{1, 2})
+	require.Error(t, err)
+
+	require.Equal(t, []float32{1, 0, 0, 1, 1, 0, 1, 0}, BitArrayToFloatArray[float32](b))
+	require.Equal(t, []float64{1, -3}, Int8ArrayToFloatArray[float64]([]int8{1, -3}))
+}
@@ -89,6 +89,8 @@ const (
 	// Array/Vector family
 	T_array_float32 T = 224 // In SQL , it is vecf32
 	T_array_float64 T = 225 // In SQL , it is vecf64
+	T_array_int8    T = 226 // In SQL , it is veci8
+	T_array_bit     T = 227 // In SQL , it is vecbit
 
 	//note: max value of uint8 is 255
 )
@@ -394,6 +396,8 @@ var Types = map[string]T{
 
 	"array float32": T_array_float32,
 	"array float64": T_array_float64,
+	"array int8":    T_array_int8,
+	"array bit":     T_array_bit,
 }
 
 func New(oid T, width, scale int32) Type {
@@ -602,7 +606,7 @@ func (t T) ToType() Type {
 	case T_varchar:
 		typ.Size = VarlenaSize
 		typ.Width = MaxVarcharLen
-	case T_array_float32, T_array_float64:
+	case T_array_float32, T_array_float64, T_array_int8, T_array_bit:
 		typ.Size = VarlenaSize
 		typ.Width = MaxArrayDimension
 	case T_binary:
@@ -700,6 +704,10 @@ func (t T) String() string {
 		return "VECF32"
 	case T_array_float64:
 		return "VECF64"
+	case T_array_int8:
+		return "VECI8"
+	case T_array_bit:
+		return "VECBIT"
 	case T_enum:
 		return "ENUM"
 	}
@@ -777,6 +785,10 @@ func (t T) OidString() string {
 		return "T_array_float32"
 	case T_array_float64:
 		return "T_array_float64"
+	case T_array_int8:
+		return "T_array_int8"
+	case T_array_bit:
+		return "T_array_bit"
 	}
 	return "unknown_type"
 }
@@ -808,7 +820,7 @@ func (t T) TypeLen() int {
 		return 4
 	case T_float64:
 		return 8
-	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64:
+	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_array_int8, T_array_bit:
 		return VarlenaSize
 	case T_decimal64:
 		return 8
@@ -861,7 +873,7 @@ func (t T) FixedLength() int {
 		return RowidSize
 	case T_Blockid:
 		return BlockidSize
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_array_int8, T_array_bit:
 		return -24
 	case T_enum:
 		return 2
@@ -948,6 +960,12 @@ func (t T) IsArrayRelate() bool {
 	return false
 }
 
+// IsQuantizedArray returns true for the int8-quantized and bit vector types.
+// Unlike IsArrayRelate, these do not support element-wise arithmetic.
+func (t T) IsQuantizedArray() bool {
+	return t == T_array_int8 || t == T_array_bit
+}
+
 // IsDecimal return true if the types.T is decimal64 or decimal128
 func (t T) IsDecimal() bool {
 	if t == T_decimal64 || t == T_decimal128 || t == T_decimal256 {
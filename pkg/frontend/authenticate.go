@@ -904,6 +904,16 @@ var (
 		"mo_transactions":             0,
 		"mo_cache":                    0,
 		"mo_snapshots":                0,
+		"mo_mviews":                   0,
+		"mo_triggers":                 0,
+		"mo_events":                   0,
+		"mo_event_history":            0,
+		"mo_online_ddl":               0,
+		"mo_column_histograms":        0,
+		"mo_table_analyze":            0,
+		"mo_plan_baselines":           0,
+		"mo_resource_groups":          0,
+		"mo_resource_group_bindings":  0,
 	}
 	sysAccountTables = map[string]struct{}{
 		catalog.MOVersionTable:       {},
@@ -937,6 +947,16 @@ var (
 		"mo_cache":                    0,
 		"mo_foreign_keys":             0,
 		"mo_snapshots":                0,
+		"mo_mviews":                   0,
+		"mo_triggers":                 0,
+		"mo_events":                   0,
+		"mo_event_history":            0,
+		"mo_online_ddl":               0,
+		"mo_column_histograms":        0,
+		"mo_table_analyze":            0,
+		"mo_plan_baselines":           0,
+		"mo_resource_groups":          0,
+		"mo_resource_group_bindings":  0,
 	}
 	createDbInformationSchemaSql = "create database information_schema;"
 	createAutoTableSql           = MoCatalogMoAutoIncrTableDDL
@@ -960,6 +980,16 @@ var (
 		MoCatalogMoUserDefinedFunctionDDL,
 		MoCatalogMoMysqlCompatibilityModeDDL,
 		MoCatalogMoSnapshotsDDL,
+		MoCatalogMoMviewsDDL,
+		MoCatalogMoTriggersDDL,
+		MoCatalogMoEventsDDL,
+		MoCatalogMoEventHistoryDDL,
+		MoCatalogMoOnlineDDLDDL,
+		MoCatalogMoColumnHistogramsDDL,
+		MoCatalogMoTableAnalyzeDDL,
+		MoCatalogMoPlanBaselinesDDL,
+		MoCatalogMoResourceGroupsDDL,
+		MoCatalogMoResourceGroupBindingsDDL,
 		MoCatalogMoPubsDDL,
 		MoCatalogMoStoredProcedureDDL,
 		MoCatalogMoStagesDDL,
@@ -988,6 +1018,14 @@ var (
 		`drop view if exists mo_catalog.mo_transactions;`,
 		`drop view if exists mo_catalog.mo_cache;`,
 		`drop table if exists mo_catalog.mo_snapshots;`,
+		`drop table if exists mo_catalog.mo_mviews;`,
+		`drop table if exists mo_catalog.mo_triggers;`,
+		`drop table if exists mo_catalog.mo_events;`,
+		`drop table if exists mo_catalog.mo_event_history;`,
+		`drop table if exists mo_catalog.mo_online_ddl;`,
+		`drop table if exists mo_catalog.mo_column_histograms;`,
+		`drop table if exists mo_catalog.mo_table_analyze;`,
+		`drop table if exists mo_catalog.mo_plan_baselines;`,
 	}
 	dropMoMysqlCompatibilityModeSql = `drop table if exists mo_catalog.mo_mysql_compatibility_mode;`
 	dropMoPubsSql                   = `drop table if exists mo_catalog.mo_pubs;`
@@ -5569,6 +5607,20 @@ func doGrantRole(ctx context.Context, ses *Session, gr *tree.GrantRole) (err err
 	return err
 }
 
+// mergeHasAction returns whether a WHEN clause of the MERGE statement takes
+// the action of the privilege.
+func mergeHasAction(stmt *tree.Merge, typ PrivilegeType) bool {
+	for _, when := range stmt.Whens {
+		switch {
+		case typ == PrivilegeTypeInsert && when.Action == tree.MergeInsert,
+			typ == PrivilegeTypeUpdate && when.Action == tree.MergeUpdate,
+			typ == PrivilegeTypeDelete && when.Action == tree.MergeDelete:
+			return true
+		}
+	}
+	return false
+}
+
 // determinePrivilegeSetOfStatement decides the privileges that the statement needs before running it.
 // That is the Set P for the privilege Set .
 func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
@@ -5741,12 +5793,76 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		writeDatabaseAndTableDirectly = true
 
 	case *tree.AlterTable:
+		objType = objectTypeDatabase
+		writeDatabaseAndTableDirectly = true
+		if st.Table != nil {
+			dbName = string(st.Table.SchemaName)
+		}
+		if exchange, ok := st.PartitionOption.(*tree.AlterPartitionExchangePartitionClause); ok {
+			// the rows of both tables are replaced, and the table exchanged is
+			// in the same database as the partitioned table
+			typs = append(typs, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+			entry1 := privilegeEntry{
+				privilegeEntryTyp: privilegeEntryTypeCompound,
+				compound: &compoundEntry{
+					items: []privilegeItem{
+						{privilegeTyp: PrivilegeTypeAlterTable, dbName: dbName},
+						{privilegeTyp: PrivilegeTypeDropTable, dbName: dbName},
+						{privilegeTyp: PrivilegeTypeInsert, dbName: dbName, tableName: string(st.Table.ObjectName)},
+						{privilegeTyp: PrivilegeTypeInsert, dbName: dbName, tableName: string(exchange.Table.ObjectName)},
+					},
+				},
+			}
+			extraEntries = append(extraEntries, entry1)
+		} else {
+			typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		}
+	case *tree.RefreshMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
+	case *tree.CreateTrigger:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
 		writeDatabaseAndTableDirectly = true
 		if st.Table != nil {
 			dbName = string(st.Table.SchemaName)
 		}
+	case *tree.DropTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
+	case *tree.CreateEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
+	case *tree.AlterEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
+	case *tree.DropEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
+	case *tree.CreatePlanBaseline, *tree.AlterPlanBaseline, *tree.DropPlanBaseline:
+		typs = append(typs, PrivilegeTypeAccountAll)
+	case *tree.CreateResourceGroup, *tree.AlterResourceGroup, *tree.DropResourceGroup:
+		typs = append(typs, PrivilegeTypeAlterAccount)
 	case *tree.CreateProcedure:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -5808,6 +5924,25 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 			},
 		}
 
+		extraEntries = append(extraEntries, entry1)
+		writeDatabaseAndTableDirectly = true
+	case *tree.Merge:
+		objType = objectTypeTable
+		typs = append(typs, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
+		// only the privileges of the actions in the WHEN clauses are needed
+		var items []privilegeItem
+		for _, typ := range []PrivilegeType{PrivilegeTypeInsert, PrivilegeTypeUpdate, PrivilegeTypeDelete} {
+			if mergeHasAction(st, typ) {
+				items = append(items, privilegeItem{privilegeTyp: typ})
+			}
+		}
+		entry1 := privilegeEntry{
+			privilegeEntryTyp: privilegeEntryTypeCompound,
+			compound: &compoundEntry{
+				items: items,
+			},
+		}
+
 		extraEntries = append(extraEntries, entry1)
 		writeDatabaseAndTableDirectly = true
 	case *tree.Load:
@@ -5880,7 +6015,7 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.Execute:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.Declare:
+	case *tree.Declare, *tree.DeclareCursor, *tree.DeclareCondition, *tree.DeclareHandler:
 		objType = objectTypeNone
 		kind = privilegeKindNone
 	case *InternalCmdFieldList:
@@ -7829,8 +7964,9 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *createAccoun
 	defer span.End()
 	//create tables for the tenant
 	for _, sql := range createSqls {
-		//only the SYS tenant has the table mo_account
-		if strings.HasPrefix(sql, "create table mo_catalog.mo_account") {
+		//only the SYS tenant has the table mo_account and the resource groups
+		if strings.HasPrefix(sql, "create table mo_catalog.mo_account") ||
+			strings.HasPrefix(sql, "create table mo_catalog.mo_resource_group") {
 			continue
 		}
 		err = bh.Exec(newTenantCtx, sql)
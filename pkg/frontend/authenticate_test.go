@@ -44,6 +44,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/queryservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
@@ -549,6 +551,58 @@ func Test_determinePrivilege(t *testing.T) {
 	})
 }
 
+func Test_determinePrivilegeOfExchangePartition(t *testing.T) {
+	stmt := &tree.AlterTable{
+		Table: tree.NewTableName("t1", tree.ObjectNamePrefix{SchemaName: "db1", ExplicitSchema: true}, nil),
+		PartitionOption: tree.NewAlterPartitionExchangePartitionClause(tree.AlterPartitionExchangePartition,
+			"p0", tree.NewTableName("t2", tree.ObjectNamePrefix{}, nil), false),
+	}
+	priv := determinePrivilegeSetOfStatement(stmt)
+	require.Equal(t, objectTypeDatabase, priv.objectType())
+	// ALTER TABLE alone is not enough to exchange the partition
+	require.NotContains(t, priv.entries, privilegeEntriesMap[PrivilegeTypeAlterTable])
+
+	var compound *compoundEntry
+	for _, entry := range priv.entries {
+		if entry.privilegeEntryTyp == privilegeEntryTypeCompound {
+			compound = entry.compound
+		}
+	}
+	require.NotNil(t, compound)
+	require.Equal(t, []privilegeItem{
+		{privilegeTyp: PrivilegeTypeAlterTable, dbName: "db1"},
+		{privilegeTyp: PrivilegeTypeDropTable, dbName: "db1"},
+		{privilegeTyp: PrivilegeTypeInsert, dbName: "db1", tableName: "t1"},
+		{privilegeTyp: PrivilegeTypeInsert, dbName: "db1", tableName: "t2"},
+	}, compound.items)
+}
+
+func Test_determinePrivilegeOfMerge(t *testing.T) {
+	compoundOf := func(sql string) []privilegeItem {
+		stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, sql, 1)
+		require.NoError(t, err)
+		priv := determinePrivilegeSetOfStatement(stmt)
+		require.Equal(t, objectTypeTable, priv.objectType())
+		for _, entry := range priv.entries {
+			if entry.privilegeEntryTyp == privilegeEntryTypeCompound {
+				return entry.compound.items
+			}
+		}
+		return nil
+	}
+
+	// only the privileges of the actions in the WHEN clauses are needed
+	require.Equal(t, []privilegeItem{{privilegeTyp: PrivilegeTypeUpdate}},
+		compoundOf("merge into t using s on t.a = s.a when matched then update set b = s.b"))
+	require.Equal(t, []privilegeItem{{privilegeTyp: PrivilegeTypeInsert}},
+		compoundOf("merge into t using s on t.a = s.a when not matched then insert values (s.a, s.b)"))
+	require.Equal(t, []privilegeItem{{privilegeTyp: PrivilegeTypeUpdate}, {privilegeTyp: PrivilegeTypeDelete}},
+		compoundOf("merge into t using s on t.a = s.a when matched and s.b is null then delete when matched then update set b = s.b"))
+	require.Equal(t, []privilegeItem{{privilegeTyp: PrivilegeTypeInsert}, {privilegeTyp: PrivilegeTypeUpdate}, {privilegeTyp: PrivilegeTypeDelete}},
+		compoundOf("merge into t using s on t.a = s.a when matched and s.b is null then delete "+
+			"when matched then update set b = s.b when not matched then insert values (s.a, s.b)"))
+}
+
 func Test_determineCreateAccount(t *testing.T) {
 	convey.Convey("create/drop/alter account succ", t, func() {
 		ctrl := gomock.NewController(t)
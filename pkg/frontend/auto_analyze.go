@@ -0,0 +1,274 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	// autoAnalyzeRatio is the ratio of the rows or the size of a table changed
+	// since its last analyze, above which it is analyzed again.
+	autoAnalyzeRatio = 0.1
+	// autoAnalyzeMinRows is the min number of the rows of a table analyzed
+	// automatically, the zonemaps of the smaller tables are good enough.
+	autoAnalyzeMinRows = 1000
+
+	getAnalyzeAccountsSql = `select account_id from mo_catalog.mo_account where status != 'suspend';`
+
+	// the rows left by the dropped tables
+	deleteStaleTableAnalyzeFormat = `delete from %s.%s where table_id not in (select rel_id from mo_catalog.mo_tables);`
+
+	getAutoAnalyzeTablesFormat = `select tbl.rel_id, tbl.reldatabase, tbl.relname, ` +
+		`mo_table_rows(tbl.reldatabase, tbl.relname), mo_table_size(tbl.reldatabase, tbl.relname), ` +
+		`ana.analyzed_rows, ana.analyzed_size ` +
+		`from mo_catalog.mo_tables tbl left join %s.%s ana on tbl.rel_id = ana.table_id ` +
+		`where tbl.account_id = current_account_id() and tbl.relkind = 'r' and tbl.relname not like '%s' ` +
+		`and (ana.auto_analyze is null or ana.auto_analyze);`
+
+	getAnalyzeColumnsFormat = `select attname, atttyp from mo_catalog.mo_columns ` +
+		`where att_relname_id = %d and att_is_hidden = 0 and attisdropped = 0 order by attnum;`
+)
+
+// autoAnalyzeTable is a table checked by the auto analyze.
+type autoAnalyzeTable struct {
+	id           uint64
+	database     string
+	name         string
+	rows         int64
+	size         int64
+	analyzed     bool
+	analyzedRows int64
+	analyzedSize int64
+}
+
+// needAnalyze returns whether the rows or the size of the table have changed
+// enough since its last analyze. The updates and the deletes are seen by the
+// size, as the new objects are written before the old ones are merged.
+func (t autoAnalyzeTable) needAnalyze() bool {
+	if t.rows < autoAnalyzeMinRows {
+		return false
+	}
+	if !t.analyzed {
+		return true
+	}
+	changed := func(cur, last int64) float64 {
+		return math.Abs(float64(cur-last)) / math.Max(float64(last), 1)
+	}
+	return changed(t.rows, t.analyzedRows) >= autoAnalyzeRatio ||
+		changed(t.size, t.analyzedSize) >= autoAnalyzeRatio
+}
+
+// ExecuteAutoAnalyze is the executor of the cron task of the auto analyze. In
+// every account, it analyzes the tables changed enough since their last
+// analyze, except the ones created or altered with STATS_AUTO_RECALC = 0.
+func ExecuteAutoAnalyze(ctx context.Context, exec executor.SQLExecutor, eng engine.Engine) error {
+	// mo_table_rows and mo_table_size get the tables from the engine
+	ctx = context.WithValue(ctx, defines.EngineKey{}, eng)
+	ctx = defines.AttachAccount(ctx, catalog.System_Account, catalog.System_User, catalog.System_Role)
+
+	res, err := exec.Exec(ctx, getAnalyzeAccountsSql, executor.Options{}.WithWaitCommittedLogApplied())
+	if err != nil {
+		return err
+	}
+	var accounts []uint32
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			accounts = append(accounts, uint32(vector.GetFixedAt[int32](cols[0], i)))
+		}
+		return true
+	})
+	res.Close()
+
+	for _, accountID := range accounts {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		// one account fails to be analyzed does not stop the others
+		if err := autoAnalyzeAccount(ctx, exec, accountID); err != nil {
+			getLogger().Error("failed to auto analyze the account",
+				zap.Uint32("accountID", accountID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func autoAnalyzeAccount(ctx context.Context, exec executor.SQLExecutor, accountID uint32) error {
+	opts := executor.Options{}.WithAccountID(accountID).WithWaitCommittedLogApplied()
+
+	for _, tbl := range []string{catalog.MO_TABLE_ANALYZE, catalog.MO_COLUMN_HISTOGRAMS} {
+		res, err := exec.Exec(ctx, fmt.Sprintf(deleteStaleTableAnalyzeFormat, catalog.MO_CATALOG, tbl), opts)
+		if err != nil {
+			return err
+		}
+		res.Close()
+	}
+
+	sql := fmt.Sprintf(getAutoAnalyzeTablesFormat, catalog.MO_CATALOG, catalog.MO_TABLE_ANALYZE,
+		catalog.IndexTableNamePrefix+"%")
+	res, err := exec.Exec(ctx, sql, opts)
+	if err != nil {
+		return err
+	}
+	var tables []autoAnalyzeTable
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			t := autoAnalyzeTable{
+				id:       vector.GetFixedAt[uint64](cols[0], i),
+				database: cols[1].GetStringAt(i),
+				name:     cols[2].GetStringAt(i),
+				rows:     vector.GetFixedAt[int64](cols[3], i),
+				size:     vector.GetFixedAt[int64](cols[4], i),
+				analyzed: !cols[5].IsNull(uint64(i)),
+			}
+			if t.analyzed {
+				t.analyzedRows = vector.GetFixedAt[int64](cols[5], i)
+				t.analyzedSize = vector.GetFixedAt[int64](cols[6], i)
+			}
+			if !isBannedDatabase(t.database) && t.needAnalyze() {
+				tables = append(tables, t)
+			}
+		}
+		return true
+	})
+	res.Close()
+
+	for _, t := range tables {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		// the table may be dropped after it is checked
+		if err := autoAnalyzeTableColumns(ctx, exec, opts, t); err != nil {
+			getLogger().Error("failed to auto analyze the table",
+				zap.Uint32("accountID", accountID),
+				zap.String("table", t.database+"."+t.name),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// autoAnalyzeTableColumns does what ANALYZE TABLE does on all the columns of
+// the table.
+func autoAnalyzeTableColumns(ctx context.Context, exec executor.SQLExecutor, opts executor.Options, t autoAnalyzeTable) error {
+	res, err := exec.Exec(ctx, fmt.Sprintf(getAnalyzeColumnsFormat, t.id), opts)
+	if err != nil {
+		return err
+	}
+	var colNames []string
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			var typ types.Type
+			if err = types.Decode(cols[1].GetBytesAt(i), &typ); err != nil {
+				return false
+			}
+			if plan2.IsHistogramSupported(typ.Oid) {
+				colNames = append(colNames, cols[0].GetStringAt(i))
+			}
+		}
+		return true
+	})
+	res.Close()
+	if err != nil {
+		return err
+	}
+
+	samples := make([][]float64, len(colNames))
+	if len(colNames) > 0 {
+		res, err = exec.Exec(ctx, genSampleColumnsSql(t.database, t.name, colNames), opts)
+		if err != nil {
+			return err
+		}
+		res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+			for c, vec := range cols {
+				for i := 0; i < rows; i++ {
+					if v, ok := histogramVectorValue(vec, i); ok {
+						samples[c] = append(samples[c], v)
+					}
+				}
+			}
+			return true
+		})
+		res.Close()
+	}
+
+	sqls, err := genSaveAnalyzeSqls(t.id, t.database, t.name, colNames, samples,
+		t.rows, t.size, autoAnalyze, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	err = exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		for _, sql := range sqls {
+			res, err := txn.Exec(sql, executor.StatementOption{})
+			if err != nil {
+				return err
+			}
+			res.Close()
+		}
+		return nil
+	}, opts)
+	if err != nil {
+		return err
+	}
+	invalidateCachedHistograms(t.id)
+	return nil
+}
+
+// histogramVectorValue encodes the value sampled like histogramValue.
+func histogramVectorValue(vec *vector.Vector, row int) (float64, bool) {
+	if vec.IsNull(uint64(row)) {
+		return 0, false
+	}
+	switch vec.GetType().Oid {
+	case types.T_int8:
+		return float64(vector.GetFixedAt[int8](vec, row)), true
+	case types.T_int16:
+		return float64(vector.GetFixedAt[int16](vec, row)), true
+	case types.T_int32:
+		return float64(vector.GetFixedAt[int32](vec, row)), true
+	case types.T_int64:
+		return float64(vector.GetFixedAt[int64](vec, row)), true
+	case types.T_uint8:
+		return float64(vector.GetFixedAt[uint8](vec, row)), true
+	case types.T_uint16:
+		return float64(vector.GetFixedAt[uint16](vec, row)), true
+	case types.T_uint32:
+		return float64(vector.GetFixedAt[uint32](vec, row)), true
+	case types.T_uint64:
+		return float64(vector.GetFixedAt[uint64](vec, row)), true
+	case types.T_float32:
+		return float64(vector.GetFixedAt[float32](vec, row)), true
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, row), true
+	case types.T_date:
+		return float64(vector.GetFixedAt[types.Date](vec, row)), true
+	case types.T_datetime:
+		return float64(vector.GetFixedAt[types.Datetime](vec, row)), true
+	}
+	return 0, false
+}
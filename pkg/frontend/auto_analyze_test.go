@@ -0,0 +1,59 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_autoAnalyzeTableNeedAnalyze(t *testing.T) {
+	kases := []struct {
+		table autoAnalyzeTable
+		need  bool
+	}{
+		{autoAnalyzeTable{rows: 10}, false},
+		{autoAnalyzeTable{rows: 10000}, true},
+		{autoAnalyzeTable{rows: 10000, size: 100, analyzed: true, analyzedRows: 9500, analyzedSize: 100}, false},
+		{autoAnalyzeTable{rows: 10000, size: 100, analyzed: true, analyzedRows: 8000, analyzedSize: 100}, true},
+		// updated in place, the rows are not changed
+		{autoAnalyzeTable{rows: 10000, size: 150, analyzed: true, analyzedRows: 10000, analyzedSize: 100}, true},
+		{autoAnalyzeTable{rows: 500, size: 150, analyzed: true, analyzedRows: 100, analyzedSize: 100}, false},
+	}
+	for i, kase := range kases {
+		require.Equal(t, kase.need, kase.table.needAnalyze(), i)
+	}
+}
+
+func Test_genSaveAnalyzeSqls(t *testing.T) {
+	now := time.Date(2024, 5, 20, 10, 30, 15, 0, time.UTC)
+	sqls, err := genSaveAnalyzeSqls(1, "db", "t", []string{"a", "b"}, [][]float64{{1, 2, 2}, nil},
+		100, 2000, autoAnalyze, now)
+	require.NoError(t, err)
+	require.Len(t, sqls, 3)
+	require.Contains(t, sqls[0], "column_name in ('a', 'b')")
+	require.True(t, strings.HasPrefix(sqls[1], "insert into mo_catalog.mo_column_histograms"))
+	require.NotContains(t, sqls[1], "'b'")
+	require.Contains(t, sqls[2], "values (1, 100, 2000, 'auto', '2024-05-20 10:30:15')")
+
+	// the analyze of the table without any column supported is recorded too
+	sqls, err = genSaveAnalyzeSqls(1, "db", "t", nil, nil, 100, 2000, manualAnalyze, now)
+	require.NoError(t, err)
+	require.Len(t, sqls, 1)
+	require.Contains(t, sqls[0], "on duplicate key update analyzed_rows = 100")
+}
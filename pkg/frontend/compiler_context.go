@@ -53,6 +53,8 @@ type TxnCompilerContext struct {
 	sub                  *plan.SubscriptionMeta
 	snapshot             *plan2.Snapshot
 	views                []string
+	// planShape is the shape of the last select plan built
+	planShape *tree.OptimizerHints
 	//for support explain analyze
 	tcw     ComputationWrapper
 	execCtx *ExecCtx
@@ -60,6 +62,7 @@ type TxnCompilerContext struct {
 }
 
 var _ plan2.CompilerContext = &TxnCompilerContext{}
+var _ plan2.PlanShapeRecorder = &TxnCompilerContext{}
 
 func (tcc *TxnCompilerContext) SetExecCtx(execCtx *ExecCtx) {
 	tcc.mu.Lock()
@@ -79,6 +82,18 @@ func (tcc *TxnCompilerContext) SetViews(views []string) {
 	tcc.views = views
 }
 
+func (tcc *TxnCompilerContext) SetPlanShape(shape *tree.OptimizerHints) {
+	tcc.mu.Lock()
+	defer tcc.mu.Unlock()
+	tcc.planShape = shape
+}
+
+func (tcc *TxnCompilerContext) GetPlanShape() *tree.OptimizerHints {
+	tcc.mu.Lock()
+	defer tcc.mu.Unlock()
+	return tcc.planShape
+}
+
 func (tcc *TxnCompilerContext) GetSnapshot() *plan2.Snapshot {
 	tcc.mu.Lock()
 	defer tcc.mu.Unlock()
@@ -766,9 +781,9 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef, snapshot plan2.Snapsh
 		if err != nil {
 			return s, err
 		}
-		if s != nil {
-			tcc.UpdateStatsInCache(table.GetTableID(ctx), s)
-		}
+	}
+	if s = tcc.attachHistograms(ctx, dbName, table.GetTableID(ctx), s); s != nil && needUpdate {
+		tcc.UpdateStatsInCache(table.GetTableID(ctx), s)
 	}
 	return s, nil
 }
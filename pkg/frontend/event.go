@@ -0,0 +1,1004 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/util"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	getEventFormat = `select event_name from mo_catalog.mo_events where database_name = '%s' and event_name = '%s';`
+
+	insertMoEventFormat = `insert into mo_catalog.mo_events(
+		database_name,
+		event_name,
+		definer,
+		definer_id,
+		definer_role_id,
+		event_body,
+		event_type,
+		execute_at,
+		interval_value,
+		interval_field,
+		starts,
+		ends,
+		status,
+		on_completion,
+		event_comment,
+		time_zone,
+		created,
+		last_altered,
+		last_executed) values ('%s', '%s', '%s', %d, %d, '%s', '%s', %s, %s, %s, %s, %s, '%s', '%s', '%s', '%s', '%s', '%s', null);`
+
+	updateMoEventScheduleFormat = `update mo_catalog.mo_events set event_type = '%s', execute_at = %s, interval_value = %s, interval_field = %s, starts = %s, ends = %s, time_zone = '%s' where database_name = '%s' and event_name = '%s';`
+
+	updateMoEventBodyFormat = `update mo_catalog.mo_events set event_body = '%s', definer = '%s', definer_id = %d, definer_role_id = %d where database_name = '%s' and event_name = '%s';`
+
+	updateMoEventFormat = `update mo_catalog.mo_events set %s = '%s' where database_name = '%s' and event_name = '%s';`
+
+	renameMoEventFormat = `update mo_catalog.mo_events set database_name = '%s', event_name = '%s' where database_name = '%s' and event_name = '%s';`
+
+	renameMoEventHistoryFormat = `update mo_catalog.mo_event_history set database_name = '%s', event_name = '%s' where database_name = '%s' and event_name = '%s';`
+
+	deleteMoEventFormat = `delete from mo_catalog.mo_events where database_name = '%s' and event_name = '%s';`
+
+	deleteMoEventHistoryFormat = `delete from mo_catalog.mo_event_history where database_name = '%s' and event_name = '%s';`
+
+	getEventToRunFormat = `select event_body, execute_at, starts, ends, status, on_completion, definer_id, definer_role_id, last_executed, interval_value, interval_field from mo_catalog.mo_events where database_name = '%s' and event_name = '%s';`
+
+	getEventDefinerFormat = `select user_name from mo_catalog.mo_user where user_id = %d;`
+
+	getEventDefinerRoleFormat = `select r.role_name from mo_catalog.mo_role r join mo_catalog.mo_user_grant g on r.role_id = g.role_id where g.user_id = %d and g.role_id = %d;`
+
+	insertMoEventHistoryFormat = `insert into mo_catalog.mo_event_history(
+		database_name,
+		event_name,
+		start_time,
+		end_time,
+		status,
+		error_message) values ('%s', '%s', '%s', '%s', '%s', '%s');`
+
+	updateMoEventLastExecutedFormat = `update mo_catalog.mo_events set last_executed = '%s' where database_name = '%s' and event_name = '%s';`
+)
+
+const (
+	eventTypeOneTime   = "ONE TIME"
+	eventTypeRecurring = "RECURRING"
+
+	eventRunSuccess = "SUCCESS"
+	eventRunFailed  = "FAILED"
+
+	eventTimeLayout = "2006-01-02 15:04:05"
+)
+
+// EventTask is the context of the cron task executing an event.
+type EventTask struct {
+	AccountID uint32 `json:"account_id"`
+	Database  string `json:"database"`
+	Event     string `json:"event"`
+}
+
+// EventTaskID returns the id of the cron task executing the event.
+func EventTaskID(accountID uint32, dbName, eventName string) string {
+	return fmt.Sprintf("event:%d:%s.%s", accountID, dbName, eventName)
+}
+
+// eventSchedule is the evaluated ON SCHEDULE clause of the event.
+type eventSchedule struct {
+	// executeAt is the execution time of the one time event
+	executeAt time.Time
+	// value and field are the interval of the recurring event
+	value  int64
+	field  string
+	starts time.Time
+	// ends is zero if the recurring event never ends
+	ends time.Time
+}
+
+func (sch *eventSchedule) eventType() string {
+	if sch.executeAt.IsZero() {
+		return eventTypeRecurring
+	}
+	return eventTypeOneTime
+}
+
+// cronExpr returns the cron expression triggering the event. The cron tasks
+// run in the local time zone of the CN.
+//
+// The recurring events are fired at the times aligned with STARTS. The fields of
+// the cron expression finer than the unit of the interval are the ones of STARTS,
+// and the field of the unit lists the slots if the interval divides its range.
+// Otherwise the cron task fires on every unit, and the runs between the
+// intervals are skipped by onSlot.
+func (sch *eventSchedule) cronExpr(ctx context.Context) (string, error) {
+	if !sch.executeAt.IsZero() {
+		t := sch.executeAt.In(time.Local)
+		return fmt.Sprintf("%d %d %d %d %d *", t.Second(), t.Minute(), t.Hour(), t.Day(), int(t.Month())), nil
+	}
+
+	t := sch.starts.In(time.Local)
+	months := int64(0)
+	switch sch.field {
+	case "SECOND":
+		return fmt.Sprintf("%s * * * * *", cronSlots(t.Second(), sch.value, 60)), nil
+	case "MINUTE":
+		return fmt.Sprintf("%d %s * * * *", t.Second(), cronSlots(t.Minute(), sch.value, 60)), nil
+	case "HOUR":
+		return fmt.Sprintf("%d %d %s * * *", t.Second(), t.Minute(), cronSlots(t.Hour(), sch.value, 24)), nil
+	case "DAY":
+		return fmt.Sprintf("%d %d %d * * *", t.Second(), t.Minute(), t.Hour()), nil
+	case "WEEK":
+		return fmt.Sprintf("%d %d %d * * %d", t.Second(), t.Minute(), t.Hour(), int(t.Weekday())), nil
+	case "MONTH":
+		months = sch.value
+	case "QUARTER":
+		months = 3 * sch.value
+	case "YEAR":
+		months = 12 * sch.value
+	default:
+		return "", moerr.NewNotSupported(ctx, "event interval unit %s", sch.field)
+	}
+
+	// the months in the year are fired from the month of the start
+	if months > 12 || 12%months != 0 {
+		return "", moerr.NewNotSupported(ctx, "event interval of %d %s", sch.value, sch.field)
+	}
+	var list []int
+	for m := int64(0); m < 12; m += months {
+		list = append(list, (int(t.Month())-1+int(m))%12+1)
+	}
+	sort.Ints(list)
+	strs := make([]string, len(list))
+	for i, m := range list {
+		strs[i] = strconv.Itoa(m)
+	}
+	return fmt.Sprintf("%d %d %d %d %s *", t.Second(), t.Minute(), t.Hour(), t.Day(), strings.Join(strs, ",")), nil
+}
+
+// cronSlots returns the cron field listing the slots of the interval in the
+// range of the field from the start, or "*" if the interval does not divide it.
+func cronSlots(start int, interval int64, size int) string {
+	if interval == 1 || int64(size)%interval != 0 {
+		return "*"
+	}
+	var slots []string
+	for i := int64(start) % interval; i < int64(size); i += interval {
+		slots = append(slots, strconv.FormatInt(i, 10))
+	}
+	return strings.Join(slots, ",")
+}
+
+// onSlot reports whether the run of the cron task of the recurring event at
+// the time is on an interval from STARTS. The intervals in months are always
+// on the slots of their cron expressions.
+func (sch *eventSchedule) onSlot(now time.Time) bool {
+	var elapsed int64
+	interval := sch.value
+	switch sch.field {
+	case "SECOND":
+		elapsed = int64(now.Sub(sch.starts) / time.Second)
+	case "MINUTE":
+		elapsed = int64(now.Sub(sch.starts) / time.Minute)
+	case "HOUR":
+		elapsed = int64(now.Sub(sch.starts) / time.Hour)
+	case "DAY", "WEEK":
+		// the days are counted by the dates, which is not changed by the daylight saving time
+		date := func(t time.Time) time.Time {
+			t = t.In(time.Local)
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		}
+		elapsed = int64(date(now).Sub(date(sch.starts)) / (24 * time.Hour))
+		if sch.field == "WEEK" {
+			interval *= 7
+		}
+	default:
+		return true
+	}
+	return elapsed >= 0 && interval > 0 && elapsed%interval == 0
+}
+
+// sqlValues returns the values of the schedule columns of mo_events.
+func (sch *eventSchedule) sqlValues() (executeAt, value, field, starts, ends string) {
+	timeValue := func(t time.Time) string {
+		if t.IsZero() {
+			return "null"
+		}
+		return "'" + t.In(time.Local).Format(eventTimeLayout) + "'"
+	}
+	if !sch.executeAt.IsZero() {
+		return timeValue(sch.executeAt), "null", "null", "null", "null"
+	}
+	return "null", fmt.Sprintf("'%d'", sch.value), "'" + sch.field + "'", timeValue(sch.starts), timeValue(sch.ends)
+}
+
+func handleCreateEvent(ses FeSession, execCtx *ExecCtx, ce *tree.CreateEvent) error {
+	return doCreateEvent(execCtx.reqCtx, ses.(*Session), ce)
+}
+
+func handleAlterEvent(ses FeSession, execCtx *ExecCtx, ae *tree.AlterEvent) error {
+	return doAlterEvent(execCtx.reqCtx, ses.(*Session), ae)
+}
+
+func handleDropEvent(ses FeSession, execCtx *ExecCtx, de *tree.DropEvent) error {
+	return doDropEvent(execCtx.reqCtx, ses.(*Session), de)
+}
+
+// eventDatabaseName returns the database of the event.
+func eventDatabaseName(ctx context.Context, ses *Session, name *tree.TableName) (string, error) {
+	dbName := string(name.SchemaName)
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	if _, ok := sysDatabases[dbName]; ok {
+		return "", moerr.NewNotSupported(ctx, "event in database %s", dbName)
+	}
+	if !ses.GetTxnCompileCtx().DatabaseExists(dbName, plan2.Snapshot{TS: &timestamp.Timestamp{}}) {
+		return "", moerr.NewBadDB(ctx, dbName)
+	}
+	return dbName, nil
+}
+
+func doCreateEvent(ctx context.Context, ses *Session, ce *tree.CreateEvent) (err error) {
+	var erArray []ExecResult
+
+	dbName, err := eventDatabaseName(ctx, ses, ce.Name)
+	if err != nil {
+		return err
+	}
+	eventName := string(ce.Name.ObjectName)
+
+	body, err := checkEventBody(ctx, ses, ce.Body)
+	if err != nil {
+		return err
+	}
+	sch, err := evalEventSchedule(ctx, ses, ce.Schedule)
+	if err != nil {
+		return err
+	}
+	cronExpr, err := sch.cronExpr(ctx)
+	if err != nil {
+		return err
+	}
+	if getGlobalPu() == nil || getGlobalPu().TaskService == nil {
+		return moerr.NewInternalError(ctx, "task service not ready yet")
+	}
+	ts := getGlobalPu().TaskService
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	// the task is not in the transaction of mo_events, it is created after the
+	// row of the event is committed, or it could run before the row is visible
+	// and drop itself. The event without its task is dropped again.
+	inserted := false
+	defer func() {
+		if err != nil || !inserted {
+			return
+		}
+		if err = replaceEventTask(ctx, ts, ses.GetAccountId(), dbName, eventName, cronExpr); err != nil {
+			if dropErr := deleteEventRows(ctx, bh, dbName, eventName); dropErr != nil {
+				err = errors.Join(err, dropErr)
+			}
+		}
+	}()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getEventFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+		return err
+	}
+	if erArray, err = getResultSet(ctx, bh); err != nil {
+		return err
+	}
+	if execResultArrayHasData(erArray) {
+		if ce.IfNotExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "event %s already exists", eventName)
+	}
+
+	completion := ce.Options.Completion
+	if completion == tree.EventCompletionDefault {
+		completion = tree.EventNotPreserve
+	}
+	tenant := ses.GetTenantInfo()
+	now := time.Now().In(time.Local).Format(eventTimeLayout)
+	executeAt, value, field, starts, ends := sch.sqlValues()
+	sql := fmt.Sprintf(insertMoEventFormat,
+		util.EscapeSQLString(dbName), util.EscapeSQLString(eventName),
+		util.EscapeSQLString(tenant.GetUser()), tenant.GetUserID(), tenant.GetDefaultRoleID(),
+		util.EscapeSQLString(body), sch.eventType(), executeAt, value, field, starts, ends,
+		ce.Options.Status.String(), completion.String(), util.EscapeSQLString(ce.Options.Comment),
+		util.EscapeSQLString(eventTimeZone(ses)), now, now)
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	inserted = true
+	return nil
+}
+
+// replaceEventTask creates the cron task of the event, the task left by the
+// event dropped without its task being canceled is replaced.
+func replaceEventTask(ctx context.Context, ts taskservice.TaskService, accountID uint32, dbName, eventName, cronExpr string) error {
+	id := EventTaskID(accountID, dbName, eventName)
+	if _, err := ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, id)); err != nil {
+		return err
+	}
+	return createEventTask(ctx, ts, accountID, dbName, eventName, cronExpr)
+}
+
+// deleteEventRows deletes the event and its history in a new transaction.
+func deleteEventRows(ctx context.Context, bh BackgroundExec, dbName, eventName string) (err error) {
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+	for _, format := range []string{deleteMoEventFormat, deleteMoEventHistoryFormat} {
+		if err = bh.Exec(ctx, fmt.Sprintf(format, util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func doAlterEvent(ctx context.Context, ses *Session, ae *tree.AlterEvent) (err error) {
+	var erArray []ExecResult
+
+	if ae.Schedule == nil && ae.Rename == nil && ae.Body == nil && ae.Options == (tree.EventOptions{}) {
+		return moerr.NewInvalidInput(ctx, "alter event %s without any change", ae.Name.ObjectName)
+	}
+	dbName, err := eventDatabaseName(ctx, ses, ae.Name)
+	if err != nil {
+		return err
+	}
+	eventName := string(ae.Name.ObjectName)
+
+	newDbName, newEventName := dbName, eventName
+	if ae.Rename != nil {
+		if ae.Rename.SchemaName != "" {
+			if newDbName, err = eventDatabaseName(ctx, ses, ae.Rename); err != nil {
+				return err
+			}
+		}
+		newEventName = string(ae.Rename.ObjectName)
+	}
+
+	var body string
+	if ae.Body != nil {
+		if body, err = checkEventBody(ctx, ses, ae.Body); err != nil {
+			return err
+		}
+	}
+	var sch *eventSchedule
+	var cronExpr string
+	if ae.Schedule != nil {
+		if sch, err = evalEventSchedule(ctx, ses, ae.Schedule); err != nil {
+			return err
+		}
+		if cronExpr, err = sch.cronExpr(ctx); err != nil {
+			return err
+		}
+	}
+	if getGlobalPu() == nil || getGlobalPu().TaskService == nil {
+		return moerr.NewInternalError(ctx, "task service not ready yet")
+	}
+	ts := getGlobalPu().TaskService
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	// the task is not in the transaction of mo_events, the old one is restored
+	// if the changes of the event are not committed
+	var restoreTask func() error
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+		if err != nil && restoreTask != nil {
+			if restoreErr := restoreTask(); restoreErr != nil {
+				err = errors.Join(err, restoreErr)
+			}
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getEventFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+		return err
+	}
+	if erArray, err = getResultSet(ctx, bh); err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInvalidInput(ctx, "event %s does not exist", eventName)
+	}
+
+	if sch != nil {
+		executeAt, value, field, starts, ends := sch.sqlValues()
+		sql := fmt.Sprintf(updateMoEventScheduleFormat, sch.eventType(), executeAt, value, field, starts, ends,
+			util.EscapeSQLString(eventTimeZone(ses)), util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	if ae.Body != nil {
+		// the event is executed with the privileges of the user altering its body
+		tenant := ses.GetTenantInfo()
+		sql := fmt.Sprintf(updateMoEventBodyFormat, util.EscapeSQLString(body), util.EscapeSQLString(tenant.GetUser()),
+			tenant.GetUserID(), tenant.GetDefaultRoleID(), util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	updates := [][2]string{{"last_altered", time.Now().In(time.Local).Format(eventTimeLayout)}}
+	if ae.Options.Completion != tree.EventCompletionDefault {
+		updates = append(updates, [2]string{"on_completion", ae.Options.Completion.String()})
+	}
+	if ae.Options.Status != tree.EventStatusDefault {
+		updates = append(updates, [2]string{"status", ae.Options.Status.String()})
+	}
+	if ae.Options.HasComment {
+		updates = append(updates, [2]string{"event_comment", util.EscapeSQLString(ae.Options.Comment)})
+	}
+	for _, update := range updates {
+		sql := fmt.Sprintf(updateMoEventFormat, update[0], update[1],
+			util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+
+	renamed := newDbName != dbName || newEventName != eventName
+	if renamed {
+		bh.ClearExecResultSet()
+		if err = bh.Exec(ctx, fmt.Sprintf(getEventFormat, util.EscapeSQLString(newDbName), util.EscapeSQLString(newEventName))); err != nil {
+			return err
+		}
+		if erArray, err = getResultSet(ctx, bh); err != nil {
+			return err
+		}
+		if execResultArrayHasData(erArray) {
+			return moerr.NewInvalidInput(ctx, "event %s already exists", newEventName)
+		}
+		if err = bh.Exec(ctx, fmt.Sprintf(renameMoEventFormat,
+			util.EscapeSQLString(newDbName), util.EscapeSQLString(newEventName), util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+			return err
+		}
+		if err = bh.Exec(ctx, fmt.Sprintf(renameMoEventHistoryFormat,
+			util.EscapeSQLString(newDbName), util.EscapeSQLString(newEventName), util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+			return err
+		}
+	}
+
+	if sch == nil && !renamed {
+		return nil
+	}
+	// the cron task is replaced by the one with the new schedule or name
+	id := EventTaskID(ses.GetAccountId(), dbName, eventName)
+	newID := EventTaskID(ses.GetAccountId(), newDbName, newEventName)
+	tasks, err := ts.QueryCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, id))
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		return moerr.NewInternalError(ctx, "the cron task of event %s does not exist", eventName)
+	}
+	oldCronExpr := tasks[0].CronExpr
+	if cronExpr == "" {
+		cronExpr = oldCronExpr
+	}
+	if _, err = ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, id)); err != nil {
+		return err
+	}
+	restoreTask = func() error {
+		return createEventTask(ctx, ts, ses.GetAccountId(), dbName, eventName, oldCronExpr)
+	}
+	if err = createEventTask(ctx, ts, ses.GetAccountId(), newDbName, newEventName, cronExpr); err != nil {
+		return err
+	}
+	restoreTask = func() error {
+		if _, err := ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, newID)); err != nil {
+			return err
+		}
+		return createEventTask(ctx, ts, ses.GetAccountId(), dbName, eventName, oldCronExpr)
+	}
+	return nil
+}
+
+func doDropEvent(ctx context.Context, ses *Session, de *tree.DropEvent) (err error) {
+	var erArray []ExecResult
+
+	dbName := string(de.Name.SchemaName)
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return moerr.NewNoDBNoCtx()
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	eventName := string(de.Name.ObjectName)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getEventFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+		return err
+	}
+	if erArray, err = getResultSet(ctx, bh); err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		if de.IfExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "event %s does not exist", eventName)
+	}
+
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteMoEventFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+		return err
+	}
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteMoEventHistoryFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(eventName))); err != nil {
+		return err
+	}
+	if getGlobalPu() == nil || getGlobalPu().TaskService == nil {
+		return moerr.NewInternalError(ctx, "task service not ready yet")
+	}
+	id := EventTaskID(ses.GetAccountId(), dbName, eventName)
+	_, err = getGlobalPu().TaskService.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, id))
+	return err
+}
+
+func createEventTask(ctx context.Context, ts taskservice.TaskService, accountID uint32, dbName, eventName, cronExpr string) error {
+	et := EventTask{
+		AccountID: accountID,
+		Database:  dbName,
+		Event:     eventName,
+	}
+	data, err := json.Marshal(et)
+	if err != nil {
+		return err
+	}
+	return ts.CreateCronTask(ctx, pb.TaskMetadata{
+		ID:       EventTaskID(accountID, dbName, eventName),
+		Executor: pb.TaskCode_EventSchedule,
+		Context:  data,
+		Options:  pb.TaskOptions{Concurrency: 1},
+	}, cronExpr)
+}
+
+// eventTimeZone returns the time zone of the session as the offset from UTC.
+func eventTimeZone(ses *Session) string {
+	loc := ses.GetTimeZone()
+	if loc == nil || loc == time.Local {
+		return "SYSTEM"
+	}
+	_, offset := time.Now().In(loc).Zone()
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+// checkEventBody rejects the statements which can not be executed by the
+// internal executor and the statements the user has no privilege to execute.
+// It returns the text of the body stored in mo_events.
+func checkEventBody(ctx context.Context, ses *Session, body tree.Statement) (string, error) {
+	stmts := []tree.Statement{body}
+	if cs, ok := body.(*tree.CompoundStmt); ok {
+		stmts = cs.Stmts
+	}
+	for _, stmt := range stmts {
+		if stmt == nil {
+			continue
+		}
+		if stmt.GetQueryType() == tree.QueryTypeTCL || stmt.StmtKind().ExecLocation() == tree.EXEC_IN_FRONTEND {
+			return "", moerr.NewNotSupported(ctx, "statement '%s' in an event", stmt.GetStatementType())
+		}
+		if err := authenticateUserCanExecuteStatement(ctx, ses, stmt); err != nil {
+			return "", err
+		}
+	}
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	body.Format(fmtctx)
+	return fmtctx.String(), nil
+}
+
+// evalEventSchedule evaluates the times and the interval of the schedule in the session.
+func evalEventSchedule(ctx context.Context, ses *Session, schedule *tree.EventSchedule) (*eventSchedule, error) {
+	evalTime := func(e tree.Expr) (time.Time, error) {
+		v, err := GetSimpleExprValue(ctx, e, ses)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if v == nil {
+			return time.Time{}, moerr.NewInvalidInput(ctx, "incorrect event time '%s'", tree.String(e, dialect.MYSQL))
+		}
+		dt, err := types.ParseDatetime(fmt.Sprint(v), 0)
+		if err != nil {
+			return time.Time{}, moerr.NewInvalidInput(ctx, "incorrect event time '%v'", v)
+		}
+		return dt.ConvertToGoTime(ses.GetTimeZone()), nil
+	}
+
+	now := time.Now()
+	sch := &eventSchedule{}
+	if schedule.At != nil {
+		t, err := evalTime(schedule.At)
+		if err != nil {
+			return nil, err
+		}
+		if t.Before(now) {
+			return nil, moerr.NewInvalidInput(ctx, "event execution time is in the past")
+		}
+		sch.executeAt = t
+		return sch, nil
+	}
+
+	v, err := GetSimpleExprValue(ctx, schedule.Every, ses)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+	if err != nil || value <= 0 || value > 1000000 {
+		return nil, moerr.NewInvalidInput(ctx, "INTERVAL is either not positive or too big")
+	}
+	sch.value = value
+	sch.field = strings.TrimPrefix(strings.ToUpper(schedule.Unit), "SQL_TSI_")
+
+	sch.starts = now.Truncate(time.Second)
+	if schedule.Starts != nil {
+		if sch.starts, err = evalTime(schedule.Starts); err != nil {
+			return nil, err
+		}
+	}
+	if schedule.Ends != nil {
+		if sch.ends, err = evalTime(schedule.Ends); err != nil {
+			return nil, err
+		}
+		if !sch.ends.After(sch.starts) {
+			return nil, moerr.NewInvalidInput(ctx, "ENDS is either invalid or before STARTS")
+		}
+		if sch.ends.Before(now) {
+			return nil, moerr.NewInvalidInput(ctx, "event ends in the past")
+		}
+	}
+	return sch, nil
+}
+
+// eventToRun is the event read by its cron task.
+type eventToRun struct {
+	body         string
+	executeAt    time.Time
+	starts       time.Time
+	ends         time.Time
+	enabled      bool
+	preserve     bool
+	definerID    uint32
+	roleID       uint32
+	lastExecuted time.Time
+	// schedule is the interval of the recurring event
+	schedule eventSchedule
+}
+
+// ExecuteEvent is the executor of the cron task of the event. It executes the
+// body of the event in a session of the definer of the event, so the privileges
+// of the definer are checked on every execution as they are in the session of
+// the user, and records the execution in mo_event_history. The event is disabled
+// if its definer is dropped or the role of the definer is revoked.
+func ExecuteEvent(ctx context.Context, exec executor.SQLExecutor, ts taskservice.TaskService, metadata pb.TaskMetadata) error {
+	var et EventTask
+	if err := json.Unmarshal(metadata.Context, &et); err != nil {
+		return err
+	}
+	opts := executor.Options{}.
+		WithAccountID(et.AccountID).
+		WithTimeZone(time.Local).
+		WithWaitCommittedLogApplied()
+
+	ev, err := getEventToRun(ctx, exec, opts, et)
+	if err != nil {
+		return err
+	}
+	// the event is dropped without its task being canceled, e.g. by drop database
+	if ev == nil {
+		_, err = ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, metadata.ID))
+		return err
+	}
+	if !ev.enabled {
+		return nil
+	}
+
+	now := time.Now()
+	if !ev.executeAt.IsZero() {
+		// the cron task of the one time event is fired once a year
+		if !ev.lastExecuted.IsZero() || now.Add(time.Second).Before(ev.executeAt) {
+			return nil
+		}
+	} else {
+		if now.Before(ev.starts) {
+			return nil
+		}
+		if !ev.ends.IsZero() && now.After(ev.ends) {
+			return completeEvent(ctx, exec, ts, opts, et, ev, metadata.ID)
+		}
+		if !ev.schedule.onSlot(now) {
+			return nil
+		}
+	}
+
+	definer, err := getEventDefiner(ctx, exec, opts, et, ev)
+	if err != nil {
+		return err
+	}
+	var runErr error
+	if definer == nil {
+		sql := fmt.Sprintf(updateMoEventFormat, "status", tree.EventDisable.String(),
+			util.EscapeSQLString(et.Database), util.EscapeSQLString(et.Event))
+		res, err := exec.Exec(ctx, sql, opts)
+		if err != nil {
+			return err
+		}
+		res.Close()
+		runErr = moerr.NewInternalError(ctx, "the definer of event %s does not exist, the event is disabled", et.Event)
+	} else {
+		runErr = executeEventBody(ctx, et, ev, definer)
+	}
+	status, message := eventRunSuccess, ""
+	if runErr != nil {
+		status, message = eventRunFailed, runErr.Error()
+	}
+	end := time.Now()
+	err = exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		sql := fmt.Sprintf(insertMoEventHistoryFormat, util.EscapeSQLString(et.Database), util.EscapeSQLString(et.Event),
+			now.In(time.Local).Format(eventTimeLayout), end.In(time.Local).Format(eventTimeLayout),
+			status, util.EscapeSQLString(message))
+		res, err := txn.Exec(sql, executor.StatementOption{})
+		if err != nil {
+			return err
+		}
+		res.Close()
+		sql = fmt.Sprintf(updateMoEventLastExecutedFormat, now.In(time.Local).Format(eventTimeLayout),
+			util.EscapeSQLString(et.Database), util.EscapeSQLString(et.Event))
+		res, err = txn.Exec(sql, executor.StatementOption{})
+		if err != nil {
+			return err
+		}
+		res.Close()
+		return nil
+	}, opts)
+	if err != nil {
+		return err
+	}
+
+	if !ev.executeAt.IsZero() {
+		if err = completeEvent(ctx, exec, ts, opts, et, ev, metadata.ID); err != nil {
+			return err
+		}
+	}
+	return runErr
+}
+
+// getEventToRun returns nil if the event does not exist.
+func getEventToRun(ctx context.Context, exec executor.SQLExecutor, opts executor.Options, et EventTask) (*eventToRun, error) {
+	res, err := exec.Exec(ctx, fmt.Sprintf(getEventToRunFormat, util.EscapeSQLString(et.Database), util.EscapeSQLString(et.Event)), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var ev *eventToRun
+	getTime := func(vec *vector.Vector, row int) time.Time {
+		if vec.IsNull(uint64(row)) {
+			return time.Time{}
+		}
+		return time.Unix(vector.GetFixedAt[types.Timestamp](vec, row).Unix(), 0)
+	}
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		if rows == 0 {
+			return true
+		}
+		ev = &eventToRun{
+			body:         cols[0].GetStringAt(0),
+			executeAt:    getTime(cols[1], 0),
+			starts:       getTime(cols[2], 0),
+			ends:         getTime(cols[3], 0),
+			enabled:      cols[4].GetStringAt(0) == tree.EventEnable.String(),
+			preserve:     cols[5].GetStringAt(0) == tree.EventPreserve.String(),
+			definerID:    vector.GetFixedAt[uint32](cols[6], 0),
+			roleID:       vector.GetFixedAt[uint32](cols[7], 0),
+			lastExecuted: getTime(cols[8], 0),
+		}
+		if !cols[9].IsNull(0) {
+			ev.schedule.value, _ = strconv.ParseInt(cols[9].GetStringAt(0), 10, 64)
+			ev.schedule.field = cols[10].GetStringAt(0)
+			ev.schedule.starts = ev.starts
+		}
+		return false
+	})
+	return ev, nil
+}
+
+// getEventDefiner returns the definer of the event with the role it created the
+// event with, or nil if the user is dropped or the role is revoked from it.
+func getEventDefiner(ctx context.Context, exec executor.SQLExecutor, opts executor.Options, et EventTask, ev *eventToRun) (*TenantInfo, error) {
+	getName := func(sql string, opts executor.Options) (string, error) {
+		res, err := exec.Exec(ctx, sql, opts)
+		if err != nil {
+			return "", err
+		}
+		defer res.Close()
+		name := ""
+		res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+			if rows == 0 {
+				return true
+			}
+			name = cols[0].GetStringAt(0)
+			return false
+		})
+		return name, nil
+	}
+
+	user, err := getName(fmt.Sprintf(getEventDefinerFormat, ev.definerID), opts)
+	if err != nil || user == "" {
+		return nil, err
+	}
+	role, err := getName(fmt.Sprintf(getEventDefinerRoleFormat, ev.definerID, ev.roleID), opts)
+	if err != nil || role == "" {
+		return nil, err
+	}
+	tenant := sysAccountName
+	if et.AccountID != sysAccountID {
+		sql := fmt.Sprintf(getTenantNameForMat, et.AccountID)
+		if tenant, err = getName(sql, opts.WithAccountID(sysAccountID)); err != nil {
+			return nil, err
+		}
+	}
+	return &TenantInfo{
+		Tenant:        tenant,
+		User:          user,
+		DefaultRole:   role,
+		TenantID:      et.AccountID,
+		UserID:        ev.definerID,
+		DefaultRoleID: ev.roleID,
+		delimiter:     ':',
+	}, nil
+}
+
+// executeEventBody executes the statements of the body in one transaction of a
+// session of the definer.
+func executeEventBody(ctx context.Context, et EventTask, ev *eventToRun, definer *TenantInfo) (err error) {
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, ev.body, 1)
+	if err != nil {
+		return err
+	}
+	defer stmt.Free()
+	stmts := []tree.Statement{stmt}
+	if cs, ok := stmt.(*tree.CompoundStmt); ok {
+		stmts = cs.Stmts
+	}
+
+	ctx = defines.AttachAccount(ctx, definer.TenantID, definer.UserID, definer.DefaultRoleID)
+	ctx, cancel := context.WithTimeout(ctx, getGlobalPu().SV.SessionTimeout.Duration)
+	defer cancel()
+	sess := newIe().newCmdSession(ctx, ie.NewOptsBuilder().Database(et.Database).Internal(false).Finish())
+	defer sess.Close()
+	sess.SetTenantInfo(definer)
+
+	run := func(sql string) error {
+		execCtx := ExecCtx{
+			reqCtx: ctx,
+			ses:    sess,
+		}
+		return doComQuery(sess, &execCtx, &UserInput{sql: sql})
+	}
+	if err = run("begin;"); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = run("commit;")
+		} else if rbErr := run("rollback;"); rbErr != nil {
+			err = errors.Join(err, rbErr)
+		}
+	}()
+	for _, st := range stmts {
+		if st == nil {
+			continue
+		}
+		fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+		st.Format(fmtctx)
+		if err = run(fmtctx.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// completeEvent drops the event which will not be executed anymore, or disables
+// it if it is created with ON COMPLETION PRESERVE.
+func completeEvent(ctx context.Context, exec executor.SQLExecutor, ts taskservice.TaskService,
+	opts executor.Options, et EventTask, ev *eventToRun, taskID string) error {
+	if ev.preserve {
+		sql := fmt.Sprintf(updateMoEventFormat, "status", tree.EventDisable.String(),
+			util.EscapeSQLString(et.Database), util.EscapeSQLString(et.Event))
+		res, err := exec.Exec(ctx, sql, opts)
+		if err != nil {
+			return err
+		}
+		res.Close()
+		return nil
+	}
+
+	err := exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		for _, format := range []string{deleteMoEventFormat, deleteMoEventHistoryFormat} {
+			res, err := txn.Exec(fmt.Sprintf(format, util.EscapeSQLString(et.Database), util.EscapeSQLString(et.Event)), executor.StatementOption{})
+			if err != nil {
+				return err
+			}
+			res.Close()
+		}
+		return nil
+	}, opts)
+	if err != nil {
+		return err
+	}
+	_, err = ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, taskID))
+	return err
+}
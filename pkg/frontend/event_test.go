@@ -0,0 +1,160 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_eventCronExpr(t *testing.T) {
+	ctx := context.TODO()
+	at := time.Date(2024, 5, 20, 10, 30, 15, 0, time.Local)
+	kases := []struct {
+		sch   eventSchedule
+		expr  string
+		valid bool
+	}{
+		{eventSchedule{executeAt: at}, "15 30 10 20 5 *", true},
+		{eventSchedule{value: 1, field: "HOUR", starts: at}, "15 30 * * * *", true},
+		{eventSchedule{value: 6, field: "HOUR", starts: at}, "15 30 4,10,16,22 * * *", true},
+		{eventSchedule{value: 30, field: "SECOND", starts: at}, "15,45 * * * * *", true},
+		{eventSchedule{value: 7, field: "MINUTE", starts: at}, "15 * * * * *", true},
+		{eventSchedule{value: 3, field: "DAY", starts: at}, "15 30 10 * * *", true},
+		{eventSchedule{value: 2, field: "WEEK", starts: at}, "15 30 10 * * 1", true},
+		{eventSchedule{value: 1, field: "QUARTER", starts: at}, "15 30 10 20 2,5,8,11 *", true},
+		{eventSchedule{value: 6, field: "MONTH", starts: at}, "15 30 10 20 5,11 *", true},
+		{eventSchedule{value: 1, field: "YEAR", starts: at}, "15 30 10 20 5 *", true},
+		{eventSchedule{value: 5, field: "MONTH", starts: at}, "", false},
+		{eventSchedule{value: 2, field: "YEAR", starts: at}, "", false},
+		{eventSchedule{value: 1, field: "MINUTE_SECOND", starts: at}, "", false},
+	}
+	for _, kase := range kases {
+		expr, err := kase.sch.cronExpr(ctx)
+		if kase.valid {
+			require.NoError(t, err, kase.expr)
+			require.Equal(t, kase.expr, expr)
+		} else {
+			require.Error(t, err, kase.sch.field)
+		}
+	}
+}
+
+func Test_eventOnSlot(t *testing.T) {
+	// the runs are aligned with STARTS which is not on the boundary of the unit
+	starts := time.Date(2024, 5, 20, 10, 30, 0, 0, time.Local)
+	sch := eventSchedule{value: 1, field: "HOUR", starts: starts}
+	expr, err := sch.cronExpr(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, "0 30 * * * *", expr)
+	require.True(t, sch.onSlot(starts.Add(2*time.Hour)))
+
+	kases := []struct {
+		sch eventSchedule
+		now time.Time
+		on  bool
+	}{
+		{eventSchedule{value: 7, field: "MINUTE", starts: starts}, starts.Add(7 * time.Minute), true},
+		{eventSchedule{value: 7, field: "MINUTE", starts: starts}, starts.Add(8 * time.Minute), false},
+		{eventSchedule{value: 7, field: "MINUTE", starts: starts}, starts.Add(14*time.Minute + 300*time.Millisecond), true},
+		{eventSchedule{value: 5, field: "HOUR", starts: starts}, starts.Add(25 * time.Hour), true},
+		{eventSchedule{value: 5, field: "HOUR", starts: starts}, starts.Add(24 * time.Hour), false},
+		{eventSchedule{value: 3, field: "DAY", starts: starts}, starts.AddDate(0, 0, 6), true},
+		{eventSchedule{value: 3, field: "DAY", starts: starts}, starts.AddDate(0, 0, 4), false},
+		{eventSchedule{value: 2, field: "WEEK", starts: starts}, starts.AddDate(0, 0, 14), true},
+		{eventSchedule{value: 2, field: "WEEK", starts: starts}, starts.AddDate(0, 0, 7), false},
+		{eventSchedule{value: 1, field: "MONTH", starts: starts}, starts.AddDate(0, 1, 0), true},
+	}
+	for _, kase := range kases {
+		require.Equal(t, kase.on, kase.sch.onSlot(kase.now), "%d %s at %s", kase.sch.value, kase.sch.field, kase.now)
+	}
+}
+
+func Test_eventScheduleSqlValues(t *testing.T) {
+	at := time.Date(2024, 5, 20, 10, 30, 15, 0, time.Local)
+	sch := eventSchedule{executeAt: at}
+	require.Equal(t, eventTypeOneTime, sch.eventType())
+	executeAt, value, field, starts, ends := sch.sqlValues()
+	require.Equal(t, "'2024-05-20 10:30:15'", executeAt)
+	require.Equal(t, []string{"null", "null", "null", "null"}, []string{value, field, starts, ends})
+
+	sch = eventSchedule{value: 3, field: "DAY", starts: at}
+	require.Equal(t, eventTypeRecurring, sch.eventType())
+	executeAt, value, field, starts, ends = sch.sqlValues()
+	require.Equal(t, []string{"null", "'3'", "'DAY'", "'2024-05-20 10:30:15'", "null"},
+		[]string{executeAt, value, field, starts, ends})
+}
+
+func Test_getEventDefiner(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	names := map[string]string{
+		fmt.Sprintf(getEventDefinerFormat, 5):        "u1",
+		fmt.Sprintf(getEventDefinerRoleFormat, 5, 7): "r1",
+		fmt.Sprintf(getTenantNameForMat, 3):          "acc1",
+	}
+	exec := executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		mr := executor.NewMemResult([]types.Type{types.T_varchar.ToType()}, mp)
+		if name, ok := names[sql]; ok {
+			mr.NewBatch()
+			if err := executor.AppendStringRows(mr, 0, []string{name}); err != nil {
+				return executor.Result{}, err
+			}
+		}
+		return mr.GetResult(), nil
+	})
+	et := EventTask{AccountID: 3, Database: "db1", Event: "e1"}
+
+	definer, err := getEventDefiner(ctx, exec, executor.Options{}, et, &eventToRun{definerID: 5, roleID: 7})
+	require.NoError(t, err)
+	require.NotNil(t, definer)
+	require.Equal(t, "acc1", definer.GetTenant())
+	require.Equal(t, "u1", definer.GetUser())
+	require.Equal(t, "r1", definer.GetDefaultRole())
+	require.Equal(t, uint32(7), definer.GetDefaultRoleID())
+
+	// the role is revoked from the definer
+	definer, err = getEventDefiner(ctx, exec, executor.Options{}, et, &eventToRun{definerID: 5, roleID: 8})
+	require.NoError(t, err)
+	require.Nil(t, definer)
+
+	// the definer is dropped
+	definer, err = getEventDefiner(ctx, exec, executor.Options{}, et, &eventToRun{definerID: 6, roleID: 7})
+	require.NoError(t, err)
+	require.Nil(t, definer)
+}
+
+func Test_replaceEventTask(t *testing.T) {
+	ctx := context.TODO()
+	ts := taskservice.NewTaskService(runtime.DefaultRuntime(), taskservice.NewMemTaskStorage())
+	id := EventTaskID(1, "db", "e1")
+
+	// the task left by a dropped event is replaced
+	require.NoError(t, createEventTask(ctx, ts, 1, "db", "e1", "0 30 * * * *"))
+	require.NoError(t, replaceEventTask(ctx, ts, 1, "db", "e1", "15,45 * * * * *"))
+	tasks, err := ts.QueryCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, id))
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "15,45 * * * * *", tasks[0].CronExpr)
+}
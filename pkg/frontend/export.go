@@ -478,6 +478,10 @@ func constructByte(ctx context.Context, obj FeSession, bat *batch.Batch, index i
 				arrStr := types.BytesToArrayToString[float64](vec.GetBytesAt(i))
 				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
 				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
+			case types.T_array_int8, types.T_array_bit:
+				arrStr := types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt(i))
+				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
+				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
 			case types.T_date:
 				val := vector.GetFixedAt[types.Date](vec, i)
 				writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
@@ -0,0 +1,377 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util"
+)
+
+const (
+	// histogramCacheTTL is how long the histograms loaded are used before
+	// loading them again, so ANALYZE TABLE on other CNs is seen after it.
+	histogramCacheTTL = time.Minute
+	// histogramCacheMaxSize is the max number of the tables in the cache.
+	histogramCacheMaxSize = 8192
+
+	getHistogramsFormat    = `select column_name, histogram from %s.%s where table_id = %d;`
+	deleteHistogramsFormat = `delete from %s.%s where table_id = %d and column_name in (%s);`
+	insertHistogramsFormat = `insert into %s.%s (table_id, column_name, database_name, table_name, histogram, analyze_time) values %s;`
+
+	getTableRowsAndSizeFormat = `select mo_table_rows('%s', '%s'), mo_table_size('%s', '%s');`
+	sampleColumnsFormat       = "select sample(%s, %d rows) from %s.%s;"
+	upsertTableAnalyzeFormat  = `insert into %s.%s (table_id, analyzed_rows, analyzed_size, analyze_type, analyze_time) values (%d, %d, %d, '%s', '%s') ` +
+		`on duplicate key update analyzed_rows = %d, analyzed_size = %d, analyze_type = '%s', analyze_time = '%s';`
+
+	// manualAnalyze and autoAnalyze are the types of the analyze in mo_table_analyze
+	manualAnalyze = "manual"
+	autoAnalyze   = "auto"
+)
+
+type histogramCacheEntry struct {
+	histograms map[string]*pb.Histogram
+	loadTime   time.Time
+}
+
+// histogramCache caches the histograms in mo_column_histograms of the tables
+// planned in this CN.
+var histogramCache = struct {
+	sync.Mutex
+	entries map[uint64]*histogramCacheEntry
+}{entries: make(map[uint64]*histogramCacheEntry)}
+
+func getCachedHistograms(tableID uint64) (map[string]*pb.Histogram, bool) {
+	histogramCache.Lock()
+	defer histogramCache.Unlock()
+	entry, ok := histogramCache.entries[tableID]
+	if !ok || time.Since(entry.loadTime) > histogramCacheTTL {
+		return nil, false
+	}
+	return entry.histograms, true
+}
+
+func setCachedHistograms(tableID uint64, histograms map[string]*pb.Histogram) {
+	histogramCache.Lock()
+	defer histogramCache.Unlock()
+	if len(histogramCache.entries) >= histogramCacheMaxSize {
+		histogramCache.entries = make(map[uint64]*histogramCacheEntry)
+	}
+	histogramCache.entries[tableID] = &histogramCacheEntry{
+		histograms: histograms,
+		loadTime:   time.Now(),
+	}
+}
+
+func invalidateCachedHistograms(tableID uint64) {
+	histogramCache.Lock()
+	defer histogramCache.Unlock()
+	delete(histogramCache.entries, tableID)
+}
+
+// attachHistograms returns the stats info with the histograms of the table.
+// The stats info may be shared by the sessions, so it is copied but never
+// changed.
+func (tcc *TxnCompilerContext) attachHistograms(ctx context.Context, dbName string, tableID uint64, s *pb.StatsInfo) *pb.StatsInfo {
+	if s == nil || isBannedDatabase(dbName) {
+		return s
+	}
+	// the background sessions never load the histograms
+	ses, ok := tcc.GetSession().(*Session)
+	if !ok {
+		return s
+	}
+	histograms, ok := getCachedHistograms(tableID)
+	if !ok {
+		var err error
+		if histograms, err = loadHistograms(ctx, ses, tableID); err != nil {
+			ses.Error(ctx, "Failed to load the histograms", zap.Uint64("tableID", tableID), zap.Error(err))
+		}
+		setCachedHistograms(tableID, histograms)
+	}
+	if len(histograms) == 0 && len(s.HistogramMap) == 0 {
+		return s
+	}
+	c := *s
+	c.HistogramMap = histograms
+	return &c
+}
+
+func loadHistograms(ctx context.Context, ses *Session, tableID uint64) (histograms map[string]*pb.Histogram, err error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(getHistogramsFormat, catalog.MO_CATALOG, catalog.MO_COLUMN_HISTOGRAMS, tableID)
+	if err = bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return nil, err
+	}
+	histograms = make(map[string]*pb.Histogram)
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		colName, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		data, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		h := &pb.Histogram{}
+		if err = json.Unmarshal([]byte(data), h); err != nil {
+			return nil, err
+		}
+		histograms[colName] = h
+	}
+	return histograms, nil
+}
+
+// doAnalyzeTable samples the columns by the sample function, saves the
+// histograms built from the sample into mo_column_histograms, and records the
+// analyze of the table in mo_table_analyze.
+func doAnalyzeTable(ctx context.Context, ses *Session, stmt *tree.AnalyzeStmt) (err error) {
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		dbName = ses.GetDatabaseName()
+	}
+	if dbName == "" {
+		return moerr.NewNoDB(ctx)
+	}
+	tblName := string(stmt.Table.ObjectName)
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tblName, plan2.Snapshot{TS: &timestamp.Timestamp{}})
+	if tableDef == nil {
+		return moerr.NewNoSuchTable(ctx, dbName, tblName)
+	}
+
+	var cols []*plan.ColDef
+	if len(stmt.Cols) == 0 {
+		for _, col := range tableDef.Cols {
+			if !col.Hidden && plan2.IsHistogramSupported(types.T(col.Typ.Id)) {
+				cols = append(cols, col)
+			}
+		}
+	} else {
+		for _, ident := range stmt.Cols {
+			name := strings.ToLower(string(ident))
+			idx, ok := tableDef.Name2ColIndex[name]
+			if !ok {
+				return moerr.NewBadFieldError(ctx, string(ident), tblName)
+			}
+			// the columns of the other types only have the zonemap stats
+			if col := tableDef.Cols[idx]; plan2.IsHistogramSupported(types.T(col.Typ.Id)) {
+				cols = append(cols, col)
+			}
+		}
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return err
+	}
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, genTableRowsAndSizeSql(dbName, tblName)); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	var rows, size int64
+	if execResultArrayHasData(erArray) {
+		if rows, err = erArray[0].GetInt64(ctx, 0, 0); err != nil {
+			return err
+		}
+		if size, err = erArray[0].GetInt64(ctx, 0, 1); err != nil {
+			return err
+		}
+	}
+
+	colNames := make([]string, len(cols))
+	for i, col := range cols {
+		colNames[i] = col.Name
+	}
+	samples := make([][]float64, len(cols))
+	if len(cols) > 0 {
+		bh.ClearExecResultSet()
+		if err = bh.Exec(ctx, genSampleColumnsSql(dbName, tblName, colNames)); err != nil {
+			return err
+		}
+		if erArray, err = getResultSet(ctx, bh); err != nil {
+			return err
+		}
+		if execResultArrayHasData(erArray) {
+			mrs, ok := erArray[0].(*MysqlResultSet)
+			if !ok {
+				return moerr.NewInternalError(ctx, "it is not the type of mysql result set")
+			}
+			for r := uint64(0); r < mrs.GetRowCount(); r++ {
+				for c, col := range cols {
+					value, err := mrs.GetValue(ctx, r, uint64(c))
+					if err != nil {
+						return err
+					}
+					if value == nil {
+						continue
+					}
+					if v, ok := histogramValue(types.T(col.Typ.Id), col.Typ.Scale, value); ok {
+						samples[c] = append(samples[c], v)
+					}
+				}
+			}
+		}
+	}
+
+	sqls, err := genSaveAnalyzeSqls(tableDef.TblId, dbName, tblName, colNames, samples,
+		rows, size, manualAnalyze, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	for _, sql := range sqls {
+		bh.ClearExecResultSet()
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	invalidateCachedHistograms(tableDef.TblId)
+	return nil
+}
+
+// genTableRowsAndSizeSql returns the sql getting the rows and the size of the
+// table recorded in mo_table_analyze, they are compared with the current ones
+// by the auto analyze.
+func genTableRowsAndSizeSql(dbName, tblName string) string {
+	return fmt.Sprintf(getTableRowsAndSizeFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(tblName),
+		util.EscapeSQLString(dbName), util.EscapeSQLString(tblName))
+}
+
+// genSampleColumnsSql returns the sql sampling the columns by the sample function.
+func genSampleColumnsSql(dbName, tblName string, colNames []string) string {
+	quoted := make([]string, len(colNames))
+	for i, name := range colNames {
+		quoted[i] = quoteIdent(name)
+	}
+	return fmt.Sprintf(sampleColumnsFormat, strings.Join(quoted, ", "), plan2.HistogramSampleRows,
+		quoteIdent(dbName), quoteIdent(tblName))
+}
+
+// genSaveAnalyzeSqls returns the sqls replacing the histograms of the columns
+// by the ones built from the samples, and recording the analyze of the table.
+func genSaveAnalyzeSqls(tableID uint64, dbName, tblName string, colNames []string, samples [][]float64,
+	rows, size int64, analyzeType string, now time.Time) ([]string, error) {
+	nowStr := now.Format("2006-01-02 15:04:05")
+	var sqls []string
+	if len(colNames) > 0 {
+		quotedNames := make([]string, len(colNames))
+		values := make([]string, 0, len(colNames))
+		for i, name := range colNames {
+			quotedNames[i] = "'" + util.EscapeSQLString(name) + "'"
+			h := plan2.BuildHistogram(samples[i], plan2.HistogramBuckets, plan2.HistogramMCVs)
+			if h == nil {
+				continue
+			}
+			data, err := json.Marshal(h)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, fmt.Sprintf("(%d, %s, '%s', '%s', '%s', '%s')",
+				tableID, quotedNames[i], util.EscapeSQLString(dbName), util.EscapeSQLString(tblName),
+				util.EscapeSQLString(string(data)), nowStr))
+		}
+		sqls = append(sqls, fmt.Sprintf(deleteHistogramsFormat, catalog.MO_CATALOG, catalog.MO_COLUMN_HISTOGRAMS,
+			tableID, strings.Join(quotedNames, ", ")))
+		if len(values) > 0 {
+			sqls = append(sqls, fmt.Sprintf(insertHistogramsFormat, catalog.MO_CATALOG, catalog.MO_COLUMN_HISTOGRAMS,
+				strings.Join(values, ", ")))
+		}
+	}
+	sqls = append(sqls, fmt.Sprintf(upsertTableAnalyzeFormat, catalog.MO_CATALOG, catalog.MO_TABLE_ANALYZE,
+		tableID, rows, size, analyzeType, nowStr, rows, size, analyzeType, nowStr))
+	return sqls, nil
+}
+
+func quoteIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// histogramValue encodes the value sampled like the min and max values in
+// the stats info.
+func histogramValue(typ types.T, scale int32, value any) (float64, bool) {
+	switch v := value.(type) {
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case types.Date:
+		return float64(v), true
+	case types.Datetime:
+		return float64(v), true
+	case string:
+		// the datetime values are sent as strings
+		if typ == types.T_datetime {
+			dt, err := types.ParseDatetime(v, scale)
+			if err != nil {
+				return 0, false
+			}
+			return float64(dt), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+)
+
+// MaterializedViewRefreshTask is the context of the cron task refreshing a
+// materialized view created with REFRESH EVERY.
+type MaterializedViewRefreshTask struct {
+	AccountID uint32 `json:"account_id"`
+	Database  string `json:"database"`
+	View      string `json:"view"`
+}
+
+// MaterializedViewRefreshTaskID returns the id of the cron task refreshing the materialized view.
+func MaterializedViewRefreshTaskID(accountID uint32, dbName, viewName string) string {
+	return fmt.Sprintf("mv_refresh:%d:%s.%s", accountID, dbName, viewName)
+}
+
+// handleCreateMaterializedView starts the cron task refreshing the
+// materialized view if it is created with REFRESH EVERY.
+//
+// The task is not in the transaction creating the view, so it is created after
+// the transaction is committed. A task created before would be left refreshing
+// a view which does not exist if the transaction is rolled back, and the task
+// running before the view is visible drops itself.
+func handleCreateMaterializedView(ctx context.Context, ses *Session, ct *plan.CreateTable) error {
+	mv := ct.GetMaterializedView()
+	if mv == nil || mv.RefreshCron == "" {
+		return nil
+	}
+	if getGlobalPu() == nil || getGlobalPu().TaskService == nil {
+		return moerr.NewInternalError(ctx, "task service not ready yet")
+	}
+	ts := getGlobalPu().TaskService
+
+	refresh := MaterializedViewRefreshTask{
+		AccountID: ses.GetAccountId(),
+		Database:  ct.Database,
+		View:      ct.TableDef.Name,
+	}
+	data, err := json.Marshal(refresh)
+	if err != nil {
+		return err
+	}
+	txnOp := ses.GetTxnHandler().GetTxn()
+	if txnOp == nil {
+		return moerr.NewInternalError(ctx, "create materialized view without transaction")
+	}
+	txnOp.AppendEventCallback(client.ClosedEvent, func(e client.TxnEvent) {
+		if !e.Committed() {
+			return
+		}
+		// the callback is called with the transaction locked
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			if err := createMaterializedViewTask(ctx, ts, refresh, data, mv.RefreshCron); err != nil {
+				logutil.Error("failed to create the refresh task of materialized view",
+					zap.String("view", refresh.Database+"."+refresh.View),
+					zap.Error(err))
+			}
+		}()
+	})
+	return nil
+}
+
+func createMaterializedViewTask(ctx context.Context, ts taskservice.TaskService,
+	refresh MaterializedViewRefreshTask, data []byte, cronExpr string) error {
+	id := MaterializedViewRefreshTaskID(refresh.AccountID, refresh.Database, refresh.View)
+	// the view may be created again with if not exists, replace the old task
+	if _, err := ts.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, id)); err != nil {
+		return err
+	}
+	return ts.CreateCronTask(ctx, pb.TaskMetadata{
+		ID:       id,
+		Executor: pb.TaskCode_MaterializedViewRefresh,
+		Context:  data,
+		Options:  pb.TaskOptions{Concurrency: 1},
+	}, cronExpr)
+}
+
+// handleDropMaterializedView stops the cron task refreshing the dropped materialized view.
+func handleDropMaterializedView(ctx context.Context, ses *Session, dt *plan.DropTable) error {
+	if dt == nil || !dt.IsMaterializedView {
+		return nil
+	}
+	if getGlobalPu() == nil || getGlobalPu().TaskService == nil {
+		return moerr.NewInternalError(ctx, "task service not ready yet")
+	}
+	id := MaterializedViewRefreshTaskID(ses.GetAccountId(), dt.Database, dt.Table)
+	_, err := getGlobalPu().TaskService.DeleteCronTask(ctx, taskservice.WithTaskMetadataId(taskservice.EQ, id))
+	return err
+}
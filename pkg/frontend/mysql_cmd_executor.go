@@ -39,6 +39,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/common/util"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -734,7 +735,7 @@ func handleSetVar(ses FeSession, execCtx *ExecCtx, sv *tree.SetVar, sql string)
 	return nil
 }
 
-func doShowErrors(ses *Session, execCtx *ExecCtx) error {
+func doShowErrors(ses *Session, execCtx *ExecCtx, onlyErrors bool) error {
 
 	levelCol := new(MysqlColumn)
 	levelCol.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
@@ -757,8 +758,14 @@ func doShowErrors(ses *Session, execCtx *ExecCtx) error {
 	info := ses.GetErrInfo()
 
 	for i := info.length() - 1; i >= 0; i-- {
+		if info.warnings[i] && onlyErrors {
+			continue
+		}
 		row := make([]interface{}, 3)
 		row[0] = "Error"
+		if info.warnings[i] {
+			row[0] = "Warning"
+		}
 		row[1] = int16(info.codes[i])
 		row[2] = info.msgs[i]
 		mrs.AddRow(row)
@@ -766,8 +773,8 @@ func doShowErrors(ses *Session, execCtx *ExecCtx) error {
 	return trySaveQueryResult(execCtx.reqCtx, ses, mrs)
 }
 
-func handleShowErrors(ses FeSession, execCtx *ExecCtx) error {
-	err := doShowErrors(ses.(*Session), execCtx)
+func handleShowErrors(ses FeSession, execCtx *ExecCtx, onlyErrors bool) error {
+	err := doShowErrors(ses.(*Session), execCtx, onlyErrors)
 	if err != nil {
 		return err
 	}
@@ -913,34 +920,7 @@ func handleShowVariables(ses FeSession, execCtx *ExecCtx, sv *tree.ShowVariables
 func handleAnalyzeStmt(ses *Session, execCtx *ExecCtx, stmt *tree.AnalyzeStmt) error {
 	ses.EnterFPrint(115)
 	defer ses.ExitFPrint(115)
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
-	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	//backup the inside statement
-	prevInsideStmt := ses.ReplaceDerivedStmt(true)
-	defer func() {
-		//restore the inside statement
-		ses.ReplaceDerivedStmt(prevInsideStmt)
-	}()
-	tempExecCtx := ExecCtx{
-		ses:    ses,
-		reqCtx: execCtx.reqCtx,
-	}
-	return doComQuery(ses, &tempExecCtx, &UserInput{sql: sql})
+	return doAnalyzeTable(execCtx.reqCtx, ses, stmt)
 }
 
 func doExplainStmt(reqCtx context.Context, ses *Session, stmt *tree.ExplainStmt) error {
@@ -1011,8 +991,103 @@ func handleExplainStmt(ses FeSession, execCtx *ExecCtx, stmt *tree.ExplainStmt)
 	return doExplainStmt(execCtx.reqCtx, ses.(*Session), stmt)
 }
 
+// buildPreparePlan builds the plan of the PREPARE statement, the plan of the
+// statement prepared is shared by the sessions of the CN.
+func buildPreparePlan(ctx context.Context, ses *Session, st tree.Statement, name string, stmt tree.Statement) (*plan.Plan, error) {
+	// the pinned plan is checked before the cached plan is used, the plans
+	// built by different pinned plans have different keys
+	pinnedHints, pinned := pinnedPlanHints(ctx, ses, stmt)
+	var key string
+	if v, err := ses.GetSessionSysVar("enable_shared_plan_cache"); err == nil && !ses.GetIsInternal() {
+		if on, err := valueIsBoolTrue(v); err == nil && on {
+			key, err = sharedPlanCacheKey(ctx, ses, stmt, pinnedHints)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if key != "" {
+		if preparePlan := globalPlanCache.get(key, name); preparePlan != nil {
+			if !checkModify(preparePlan.GetDcl().GetPrepare().GetPlan(), ses) {
+				if ses.GetTenantInfo() != nil {
+					if err := authenticateCanExecuteStatementAndPlan(ctx, ses, st, preparePlan); err != nil {
+						return nil, err
+					}
+				}
+				return preparePlan, nil
+			}
+			// the tables are changed by the DDL in the other CNs
+			globalPlanCache.remove(key)
+		}
+	}
+
+	var preparePlan *plan.Plan
+	if pinned {
+		preparePlan = buildWithPinnedPlan(ses, stmt, pinnedHints, func() (*plan.Plan, error) {
+			return buildPlan(ctx, ses, ses.GetTxnCompileCtx(), st)
+		})
+	}
+	if preparePlan == nil {
+		var err error
+		preparePlan, err = buildPlan(ctx, ses, ses.GetTxnCompileCtx(), st)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if key != "" && canSharePlan(preparePlan.GetDcl().GetPrepare()) {
+		globalPlanCache.cache(key, ses.GetAccountId(), preparePlan.GetDcl().GetPrepare())
+	}
+	return preparePlan, nil
+}
+
+// sharedPlanCacheVariables are the session variables read when the plan is
+// built.
+var sharedPlanCacheVariables = []string{
+	"sql_mode",
+	"lower_case_table_names",
+	"foreign_key_checks",
+	"ivf_filter_mode",
+}
+
+// catalogVersioner is implemented by the engine keeping the catalog versions
+// of the accounts.
+type catalogVersioner interface {
+	CatalogVersion(accountID uint32) uint64
+}
+
+func sharedPlanCacheKey(ctx context.Context, ses *Session, stmt tree.Statement, pinnedHints string) (string, error) {
+	accountID, err := defines.GetAccountId(ctx)
+	if err != nil {
+		return "", err
+	}
+	var vars strings.Builder
+	for _, name := range sharedPlanCacheVariables {
+		value, err := ses.GetSessionSysVar(name)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&vars, "%v;", value)
+	}
+	vars.WriteString(pinnedHints)
+
+	return globalPlanCache.key(accountID, getCatalogVersion(ses, accountID), ses.GetDatabaseName(), vars.String(), stmt), nil
+}
+
+// getCatalogVersion returns the catalog version of the account applied from
+// the logtail, it is changed by the DDL of the account on any CN.
+func getCatalogVersion(ses *Session, accountID uint32) uint64 {
+	eng := ses.GetTxnHandler().GetStorage()
+	if entire, ok := eng.(*engine.EntireEngine); ok {
+		eng = entire.Engine
+	}
+	if versioner, ok := eng.(catalogVersioner); ok {
+		return versioner.CatalogVersion(accountID)
+	}
+	return 0
+}
+
 func doPrepareStmt(ctx context.Context, ses *Session, st *tree.PrepareStmt, sql string, paramTypes []byte) (*PrepareStmt, error) {
-	preparePlan, err := buildPlan(ctx, ses, ses.GetTxnCompileCtx(), st)
+	preparePlan, err := buildPreparePlan(ctx, ses, st, string(st.Name), st.Stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -1049,7 +1124,11 @@ func doPrepareString(ses *Session, execCtx *ExecCtx, st *tree.PrepareString) (*P
 		return nil, err
 	}
 
-	preparePlan, err := buildPlan(execCtx.reqCtx, ses, ses.GetTxnCompileCtx(), st)
+	if len(stmts) > 1 {
+		return nil, moerr.NewInvalidInput(execCtx.reqCtx, "cannot prepare multi statements")
+	}
+
+	preparePlan, err := buildPreparePlan(execCtx.reqCtx, ses, st, string(st.Name), stmts[0])
 	if err != nil {
 		return nil, err
 	}
@@ -1698,9 +1777,9 @@ func getExplainOption(reqCtx context.Context, options []tree.OptionElem) (*expla
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupported(reqCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
-					return nil, moerr.NewNotSupported(reqCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_DOT
 				} else {
 					return nil, moerr.NewInvalidInput(reqCtx, "invalid explain option '%s', valud '%s'", v.Name, v.Value)
 				}
@@ -1808,12 +1887,11 @@ func buildPlan(reqCtx context.Context, ses FeSession, ctx plan2.CompilerContext,
 	}
 	switch stmt := stmt.(type) {
 	case *tree.Select, *tree.ParenSelect, *tree.ValuesStatement,
-		*tree.Update, *tree.Delete, *tree.Insert,
+		*tree.Update, *tree.Delete, *tree.Insert, *tree.Merge,
 		*tree.ShowDatabases, *tree.ShowTables, *tree.ShowSequences, *tree.ShowColumns, *tree.ShowColumnNumber, *tree.ShowTableNumber,
 		*tree.ShowCreateDatabase, *tree.ShowCreateTable, *tree.ShowIndex,
 		*tree.ExplainStmt, *tree.ExplainAnalyze:
-		opt := plan2.NewBaseOptimizer(ctx)
-		optimized, err := opt.Optimize(stmt, isPrepareStmt)
+		optimized, err := optimizeWithPlanBaseline(reqCtx, ses, ctx, stmt, isPrepareStmt)
 		if err != nil {
 			return nil, err
 		}
@@ -2512,6 +2590,70 @@ func executeStmt(ses *Session,
 		}
 	}
 
+	// the statement deadline is propagated to the pipelines on all CNs by the
+	// context of the compile
+	if timeout := getStatementTimeout(ses, execCtx); timeout > 0 {
+		reqCtx := execCtx.reqCtx
+		var cancel context.CancelFunc
+		execCtx.reqCtx, cancel = context.WithTimeout(reqCtx, timeout)
+		defer func() {
+			if err != nil && execCtx.reqCtx.Err() == context.DeadlineExceeded && reqCtx.Err() == nil {
+				err = moerr.NewQueryTimeout(reqCtx)
+			}
+			cancel()
+			execCtx.reqCtx = reqCtx
+		}()
+	}
+
+	// the statement runs with the limits of the resource group of its user,
+	// it waits for a slot of the group until the statement times out. The
+	// statement of a transaction holding locks does not wait, the statements
+	// holding the slots may be waiting for its locks.
+	var budget *mpool.Quota
+	if group := getResourceGroup(execCtx.reqCtx, ses); group != nil && !resourceGroupExempt(execCtx.stmt) {
+		acquired := !txnHoldsLocks(ses)
+		if acquired {
+			if err = group.acquire(execCtx.reqCtx, resourceGroupWaitTimeout); err != nil {
+				return
+			}
+		}
+		budget = group.budget
+		execCtx.proc.Lim.CPUShare = group.cpuShare
+		execCtx.proc.Lim.ResourceGroup = group.name
+		execCtx.proc.Lim.MemoryBudget = group.memoryBudget
+		defer func() {
+			execCtx.proc.Lim.CPUShare = 0
+			execCtx.proc.Lim.ResourceGroup = ""
+			execCtx.proc.Lim.MemoryBudget = 0
+			if acquired {
+				group.release()
+			}
+		}()
+	}
+
+	// the memory of the statement is limited by the quota shared by the pools
+	// of its pipelines, the pipelines on the other CNs have the same quota
+	quota := mpool.NewChildQuota(getStatementMemoryQuota(ses), budget)
+	prevQuota := execCtx.proc.Mp().SetQuota(quota)
+	execCtx.proc.Lim.MemoryQuota = quota.Limit()
+	defer func() {
+		// the memory the transaction still holds is not charged to the
+		// budget of the group after the statement
+		execCtx.proc.Mp().SetQuota(prevQuota)
+		quota.Release()
+		execCtx.proc.Lim.MemoryQuota = 0
+		if stmtInfo := ses.GetStmtInfo(); stmtInfo != nil {
+			stmtInfo.SetPeakMemory(quota.Peak())
+		}
+	}()
+
+	// the limits above apply to the statements fired by the triggers too, so
+	// the statement is executed row by row after them
+	var executed bool
+	if executed, err = executeStmtWithTriggers(ses, execCtx); err != nil || executed {
+		return
+	}
+
 	defer func() {
 		// Serialize the execution plan as json
 		_ = execCtx.cw.RecordExecPlan(execCtx.reqCtx)
@@ -2570,6 +2712,60 @@ func executeStmt(ses *Session,
 	return
 }
 
+// getStatementTimeout returns the max execution time of the statement. Like
+// MySQL, it only limits the SELECT statements, and the MAX_EXECUTION_TIME
+// hint of the statement overrides the max_execution_time of the session.
+func getStatementTimeout(ses FeSession, execCtx *ExecCtx) time.Duration {
+	stmt := execCtx.stmt
+	if st, ok := stmt.(*tree.Execute); ok {
+		if prepareStmt, err := ses.GetPrepareStmt(execCtx.reqCtx, string(st.Name)); err == nil {
+			stmt = prepareStmt.PrepareStmt
+		}
+	}
+	switch st := stmt.(type) {
+	case *tree.Select:
+		if st.Ep != nil {
+			return 0
+		}
+	case *tree.ParenSelect:
+	default:
+		return 0
+	}
+
+	if hints := tree.GetOptimizerHints(stmt); hints != nil {
+		for i := len(hints.Hints) - 1; i >= 0; i-- {
+			if hints.Hints[i].Name == tree.HintMaxExecutionTime {
+				ms, _ := strconv.ParseUint(hints.Hints[i].Args[0], 10, 32)
+				return time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	v, err := ses.GetSessionSysVar("max_execution_time")
+	if err != nil {
+		return 0
+	}
+	ms, ok := v.(int64)
+	if !ok || ms <= 0 {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// getStatementMemoryQuota returns the max memory in bytes of the statement on
+// a CN, 0 means no limit. The global query_memory_quota is the default of the
+// sessions of the account.
+func getStatementMemoryQuota(ses FeSession) int64 {
+	v, err := ses.GetSessionSysVar("query_memory_quota")
+	if err != nil {
+		return 0
+	}
+	quota, ok := v.(int64)
+	if !ok || quota < 0 {
+		return 0
+	}
+	return quota
+}
+
 // execute query
 func doComQuery(ses *Session, execCtx *ExecCtx, input *UserInput) (retErr error) {
 	ses.EnterFPrint(2)
@@ -2737,6 +2933,13 @@ func doComQuery(ses *Session, execCtx *ExecCtx, input *UserInput) (retErr error)
 		//average parse duration
 		statsInfo.ParseDuration = time.Duration(ParseDuration.Nanoseconds() / int64(len(cws)))
 
+		// the optimizer hints not recognized never fail the statement
+		if hints := tree.GetOptimizerHints(stmt); hints != nil {
+			for _, warning := range hints.Warnings {
+				ses.GetErrInfo().pushWarning(moerr.ER_PARSE_ERROR, warning)
+			}
+		}
+
 		tenant := ses.GetTenantNameWithStmt(stmt)
 		//skip PREPARE statement here
 		if ses.GetTenantInfo() != nil && !IsPrepareStatement(stmt) {
@@ -2786,6 +2989,10 @@ func doComQuery(ses *Session, execCtx *ExecCtx, input *UserInput) (retErr error)
 		execCtx.input = input
 
 		err = executeStmtWithResponse(ses, execCtx)
+		if stmt.GetQueryType() == tree.QueryTypeDDL {
+			// the prepared plans shared may use the tables changed
+			globalPlanCache.invalidate(ses.GetAccountId())
+		}
 		if err != nil {
 			return err
 		}
@@ -3063,7 +3270,7 @@ func convertEngineTypeToMysqlType(ctx context.Context, engineType types.T, col *
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
-	case types.T_array_float32, types.T_array_float64:
+	case types.T_array_float32, types.T_array_float64, types.T_array_int8, types.T_array_bit:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 	case types.T_binary:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
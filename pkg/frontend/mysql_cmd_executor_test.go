@@ -71,6 +71,7 @@ func mockRecordStatement(ctx context.Context) (context.Context, *gostub.Stubs) {
 
 func Test_mce(t *testing.T) {
 	ctx := defines.AttachAccountId(context.TODO(), sysAccountID)
+	defer mockLoadResourceGroups().Reset()
 	convey.Convey("boot mce succ", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -818,6 +819,8 @@ func runTestHandle(funName string, t *testing.T, handleFun func(ses *Session) er
 
 func Test_HandlePrepareStmt(t *testing.T) {
 	ctx := defines.AttachAccountId(context.TODO(), catalog.System_Account)
+	defer mockLoadResourceGroups().Reset()
+	defer mockLoadPinnedPlans().Reset()
 	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "Prepare stmt1 from select 1, 2", 1)
 	if err != nil {
 		t.Errorf("parser sql error %v", err)
@@ -1328,11 +1331,13 @@ func Test_getExplainOption(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_TEXT)
 
-	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "json"}})
-	require.NotNil(t, err)
+	option, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "json"}})
+	require.Nil(t, err)
+	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_JSON)
 
-	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "dot"}})
-	require.NotNil(t, err)
+	option, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "dot"}})
+	require.Nil(t, err)
+	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_DOT)
 
 	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "???"}})
 	require.NotNil(t, err)
@@ -1423,3 +1428,36 @@ func Test_ExecRequest(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func Test_getStatementTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	timeout := func(sql string) time.Duration {
+		stmt, err := mysql.ParseOne(context.TODO(), sql, 1)
+		require.NoError(t, err)
+		return getStatementTimeout(ses, &ExecCtx{reqCtx: context.TODO(), stmt: stmt})
+	}
+	require.Equal(t, time.Duration(0), timeout("select * from t"))
+	require.Equal(t, 100*time.Millisecond, timeout("select /*+ MAX_EXECUTION_TIME(100) */ * from t"))
+
+	require.NoError(t, ses.SetSessionSysVar(context.TODO(), "max_execution_time", int64(2000)))
+	require.Equal(t, 2*time.Second, timeout("select * from t"))
+	require.Equal(t, time.Duration(0), timeout("select /*+ MAX_EXECUTION_TIME(0) */ * from t"))
+	// only the SELECT statements are limited
+	require.Equal(t, time.Duration(0), timeout("insert into t select * from s"))
+	require.Equal(t, time.Duration(0), timeout("update t set a = 1"))
+}
+
+func Test_getStatementMemoryQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	require.Equal(t, int64(0), getStatementMemoryQuota(ses))
+	require.NoError(t, ses.SetSessionSysVar(context.TODO(), "query_memory_quota", int64(64<<20)))
+	require.Equal(t, int64(64<<20), getStatementMemoryQuota(ses))
+}
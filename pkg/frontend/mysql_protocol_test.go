@@ -166,6 +166,7 @@ func TestKill(t *testing.T) {
 	//ion method: mysql -h 127.0.0.1 -P 6001 -udump -p
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
+	defer mockLoadResourceGroups().Reset()
 	var dbConnPool *sql.DB
 	var conn1, conn2 *sql.Conn
 	var err error
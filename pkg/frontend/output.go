@@ -93,6 +93,8 @@ func extractRowFromVector(ctx context.Context, ses FeSession, vec *vector.Vector
 		row[i] = vector.GetArrayAt[float32](vec, rowIndex)
 	case types.T_array_float64:
 		row[i] = vector.GetArrayAt[float64](vec, rowIndex)
+	case types.T_array_int8, types.T_array_bit:
+		row[i] = types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt(rowIndex))
 	case types.T_date:
 		row[i] = vector.GetFixedAt[types.Date](vec, rowIndex)
 	case types.T_datetime:
@@ -0,0 +1,566 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util"
+)
+
+// A plan baseline is the plans of the select statements with the same digest,
+// which is the hash of the statement text with the values normalized and the
+// database it runs in. The shape of a plan is the optimizer hints building it,
+// see plan.QueryBuilder.planShape. The plans are captured into
+// mo_plan_baselines if optimizer_capture_plan_baselines is on, and the pinned
+// one is used to build the plan of the statements with the digest if
+// optimizer_use_plan_baselines is on, as long as it is still valid.
+
+const (
+	// planBaselineCaptured and planBaselinePinned are the status of the plans
+	// in mo_plan_baselines
+	planBaselineCaptured = "captured"
+	planBaselinePinned   = "pinned"
+
+	// planBaselineCacheTTL is how long the pinned plans loaded are used before
+	// loading them again, so the plans pinned on other CNs are seen after it.
+	planBaselineCacheTTL = time.Minute
+	// planBaselineCapturedMaxSize is the max number of the plans remembered as
+	// captured by this CN.
+	planBaselineCapturedMaxSize = 65536
+
+	getPinnedPlanBaselinesFormat = `select digest, plan_hints from %s.%s where status = '%s';`
+	getPlanBaselinesFormat       = `select plan_digest, database_name, sql_text, plan_hints, status from %s.%s where digest = '%s' order by update_time desc;`
+	upsertPlanBaselineFormat     = `insert into %s.%s (digest, plan_digest, database_name, sql_text, plan_hints, status, cost, create_time, update_time) ` +
+		`values ('%s', '%s', '%s', '%s', '%s', '%s', %v, '%s', '%s') on duplicate key update cost = %v, update_time = '%s';`
+	pinPlanBaselineFormat     = `update %s.%s set status = case when plan_digest = '%s' then '%s' else '%s' end where digest = '%s';`
+	unpinPlanBaselineFormat   = `update %s.%s set status = '%s' where digest = '%s';`
+	deletePlanBaselinesFormat = `delete from %s.%s where digest = '%s';`
+)
+
+type planBaselineCacheEntry struct {
+	// pinned is the hints of the pinned plans by the digests
+	pinned   map[string]string
+	loadTime time.Time
+}
+
+// planBaselineCache caches the pinned plans of the accounts planned in this
+// CN, and remembers the plans captured by it, which are only inserted once.
+var planBaselineCache = struct {
+	sync.Mutex
+	accounts map[uint32]*planBaselineCacheEntry
+	captured map[string]struct{}
+}{
+	accounts: make(map[uint32]*planBaselineCacheEntry),
+	captured: make(map[string]struct{}),
+}
+
+func getCachedPinnedPlans(accountID uint32) (map[string]string, bool) {
+	planBaselineCache.Lock()
+	defer planBaselineCache.Unlock()
+	entry, ok := planBaselineCache.accounts[accountID]
+	if !ok || time.Since(entry.loadTime) > planBaselineCacheTTL {
+		return nil, false
+	}
+	return entry.pinned, true
+}
+
+func setCachedPinnedPlans(accountID uint32, pinned map[string]string) {
+	planBaselineCache.Lock()
+	defer planBaselineCache.Unlock()
+	planBaselineCache.accounts[accountID] = &planBaselineCacheEntry{
+		pinned:   pinned,
+		loadTime: time.Now(),
+	}
+}
+
+func invalidateCachedPlanBaselines(accountID uint32) {
+	planBaselineCache.Lock()
+	defer planBaselineCache.Unlock()
+	delete(planBaselineCache.accounts, accountID)
+	planBaselineCache.captured = make(map[string]struct{})
+}
+
+// markPlanCaptured returns false if the plan has been captured by this CN.
+func markPlanCaptured(accountID uint32, digest, planDigest string) bool {
+	planBaselineCache.Lock()
+	defer planBaselineCache.Unlock()
+	key := fmt.Sprintf("%d/%s/%s", accountID, digest, planDigest)
+	if _, ok := planBaselineCache.captured[key]; ok {
+		return false
+	}
+	if len(planBaselineCache.captured) >= planBaselineCapturedMaxSize {
+		planBaselineCache.captured = make(map[string]struct{})
+	}
+	planBaselineCache.captured[key] = struct{}{}
+	return true
+}
+
+// planBaselineDigest returns the digest of the statement run in the database.
+// The statements only different in the values or the hints have the same
+// digest.
+func planBaselineDigest(dbName string, stmt tree.Statement) string {
+	text := tree.StringWithOpts(stmt, dialect.MYSQL, tree.WithNormalizeValues())
+	return sha256Hex(dbName + "\n" + text)
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// planShapeString returns the text of the plan shape saved as plan_hints.
+func planShapeString(shape *tree.OptimizerHints) string {
+	if shape == nil || len(shape.Hints) == 0 {
+		return ""
+	}
+	return tree.String(shape, dialect.MYSQL)
+}
+
+// isPlanBaselineStatement returns whether the statement may have a plan
+// baseline, the statements choosing the join order or the indexes by their own
+// hints never use one.
+func isPlanBaselineStatement(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.Select, *tree.ParenSelect:
+		if hints := tree.GetOptimizerHints(stmt); hints != nil {
+			for _, hint := range hints.Hints {
+				switch hint.Name {
+				case tree.HintJoinOrder, tree.HintUseIndex:
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// setPinnedHints attaches the hints of the pinned plan to the statement with
+// its own hints, e.g. MAX_EXECUTION_TIME, and returns the function restoring
+// the hints of the statement.
+func setPinnedHints(stmt tree.Statement, hints string) func() {
+	orig := tree.GetOptimizerHints(stmt)
+	merged := &tree.OptimizerHints{}
+	merged.Append(mysql.ParseOptimizerHints(hints))
+	if orig != nil {
+		merged.Append(orig)
+	}
+	tree.SetOptimizerHints(stmt, merged)
+	return func() {
+		tree.SetOptimizerHints(stmt, orig)
+	}
+}
+
+func planBaselineVarIsOn(ses *Session, name string) bool {
+	value, err := ses.GetSessionSysVar(name)
+	if err != nil {
+		return false
+	}
+	on, err := valueIsBoolTrue(value)
+	return err == nil && on
+}
+
+// optimizeWithPlanBaseline optimizes the query by its pinned plan if it is
+// still valid, that is, the plan built by it has the same shape, and captures
+// the plan if optimizer_capture_plan_baselines is on.
+func optimizeWithPlanBaseline(reqCtx context.Context, ses FeSession, ctx plan2.CompilerContext, stmt tree.Statement, isPrepareStmt bool) (*plan2.Query, error) {
+	s, ok := ses.(*Session)
+	tcc, ok2 := ctx.(*TxnCompilerContext)
+	if !ok || !ok2 || s.IsBackgroundSession() || s.GetIsInternal() || !isPlanBaselineStatement(stmt) {
+		return plan2.NewBaseOptimizer(ctx).Optimize(stmt, isPrepareStmt)
+	}
+
+	use := planBaselineVarIsOn(s, "optimizer_use_plan_baselines")
+	capture := planBaselineVarIsOn(s, "optimizer_capture_plan_baselines")
+	var pinned map[string]string
+	if use {
+		pinned = getPinnedPlans(reqCtx, s)
+	}
+	if len(pinned) == 0 && !capture {
+		return plan2.NewBaseOptimizer(ctx).Optimize(stmt, isPrepareStmt)
+	}
+
+	dbName := s.GetDatabaseName()
+	digest := planBaselineDigest(dbName, stmt)
+	if hints, ok := pinned[digest]; ok {
+		restore := setPinnedHints(stmt, hints)
+		tcc.SetPlanShape(nil)
+		qry, err := plan2.NewBaseOptimizer(ctx).Optimize(stmt, isPrepareStmt)
+		restore()
+		if err == nil && planShapeString(tcc.GetPlanShape()) == hints {
+			return qry, nil
+		}
+		// the pinned plan is no longer valid, e.g. its index is dropped, the
+		// optimizer chooses the plan by itself
+	}
+
+	tcc.SetPlanShape(nil)
+	qry, err := plan2.NewBaseOptimizer(ctx).Optimize(stmt, isPrepareStmt)
+	if err != nil || !capture {
+		return qry, err
+	}
+	capturePlanBaseline(reqCtx, s, digest, dbName, stmt, tcc.GetPlanShape(), plan2.PlanCost(qry))
+	return qry, nil
+}
+
+// pinnedPlanHints returns the hints of the plan pinned for the statement if
+// the session uses the plan baselines.
+func pinnedPlanHints(ctx context.Context, ses *Session, stmt tree.Statement) (string, bool) {
+	if ses.IsBackgroundSession() || ses.GetIsInternal() || !isPlanBaselineStatement(stmt) ||
+		!planBaselineVarIsOn(ses, "optimizer_use_plan_baselines") {
+		return "", false
+	}
+	pinned := getPinnedPlans(ctx, ses)
+	if len(pinned) == 0 {
+		return "", false
+	}
+	hints, ok := pinned[planBaselineDigest(ses.GetDatabaseName(), stmt)]
+	return hints, ok
+}
+
+// buildWithPinnedPlan builds the plan of the statement by the hints of its
+// pinned plan, it returns nil if the plan built is not in the shape pinned.
+func buildWithPinnedPlan(ses *Session, stmt tree.Statement, hints string, build func() (*plan2.Plan, error)) *plan2.Plan {
+	tcc := ses.GetTxnCompileCtx()
+	restore := setPinnedHints(stmt, hints)
+	tcc.SetPlanShape(nil)
+	p, err := build()
+	restore()
+	if err != nil || planShapeString(tcc.GetPlanShape()) != hints {
+		return nil
+	}
+	return p
+}
+
+// getPinnedPlans returns the pinned plans of the account of the session.
+func getPinnedPlans(ctx context.Context, ses *Session) map[string]string {
+	accountID := ses.GetAccountId()
+	pinned, ok := getCachedPinnedPlans(accountID)
+	if ok {
+		return pinned
+	}
+	pinned, err := loadPinnedPlans(ctx, ses)
+	if err != nil {
+		ses.Error(ctx, "Failed to load the pinned plans", zap.Error(err))
+	}
+	setCachedPinnedPlans(accountID, pinned)
+	return pinned
+}
+
+// loadPinnedPlans loads the pinned plans of the account of the session.
+var loadPinnedPlans = func(ctx context.Context, ses *Session) (pinned map[string]string, err error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(getPinnedPlanBaselinesFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES, planBaselinePinned)
+	if err = bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return nil, err
+	}
+	pinned = make(map[string]string)
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		digest, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		hints, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		pinned[digest] = hints
+	}
+	return pinned, nil
+}
+
+// capturePlanBaseline saves the plan into mo_plan_baselines, the failure of
+// it never fails the query.
+func capturePlanBaseline(ctx context.Context, ses *Session, digest, dbName string, stmt tree.Statement, shape *tree.OptimizerHints, cost float64) {
+	hints := planShapeString(shape)
+	planDigest := sha256Hex(hints)
+	if !markPlanCaptured(ses.GetAccountId(), digest, planDigest) {
+		return
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	sql := genUpsertPlanBaselineSql(digest, planDigest, dbName, tree.String(stmt, dialect.MYSQL),
+		hints, planBaselineCaptured, cost, time.Now().UTC())
+	if err := bh.Exec(ctx, sql); err != nil {
+		ses.Error(ctx, "Failed to capture the plan baseline", zap.String("digest", digest), zap.Error(err))
+	}
+}
+
+func genUpsertPlanBaselineSql(digest, planDigest, dbName, sqlText, hints, status string, cost float64, now time.Time) string {
+	nowStr := now.Format("2006-01-02 15:04:05")
+	return fmt.Sprintf(upsertPlanBaselineFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES,
+		digest, planDigest, util.EscapeSQLString(dbName), util.EscapeSQLString(sqlText), util.EscapeSQLString(hints),
+		status, cost, nowStr, nowStr, cost, nowStr)
+}
+
+// buildPlanShape builds the plan of the select statement without its plan
+// baseline, and returns the shape and the cost of the plan.
+func buildPlanShape(ses *Session, stmt tree.Statement) (*tree.OptimizerHints, float64, error) {
+	tcc := ses.GetTxnCompileCtx()
+	tcc.SetPlanShape(nil)
+	// the statements captured by PREPARE have the parameters
+	isPrepareStmt := strings.Contains(tree.String(stmt, dialect.MYSQL), "?")
+	p, err := plan2.BuildPlan(tcc, stmt, isPrepareStmt)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tcc.GetPlanShape(), plan2.PlanCost(p.GetQuery()), nil
+}
+
+type planBaselineRow struct {
+	planDigest string
+	dbName     string
+	sqlText    string
+	hints      string
+	status     string
+}
+
+func loadPlanBaselines(ctx context.Context, bh BackgroundExec, digest string) ([]planBaselineRow, error) {
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(getPlanBaselinesFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES, util.EscapeSQLString(digest))
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return nil, err
+	}
+	rows := make([]planBaselineRow, erArray[0].GetRowCount())
+	for i := range rows {
+		cols := []*string{&rows[i].planDigest, &rows[i].dbName, &rows[i].sqlText, &rows[i].hints, &rows[i].status}
+		for j, col := range cols {
+			if *col, err = erArray[0].GetString(ctx, uint64(i), uint64(j)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+func handleCreatePlanBaseline(ses FeSession, execCtx *ExecCtx, cpb *tree.CreatePlanBaseline) error {
+	return doCreatePlanBaseline(execCtx.reqCtx, ses.(*Session), cpb)
+}
+
+func handleAlterPlanBaseline(ses FeSession, execCtx *ExecCtx, apb *tree.AlterPlanBaseline) error {
+	return doAlterPlanBaseline(execCtx.reqCtx, ses.(*Session), apb)
+}
+
+func handleDropPlanBaseline(ses FeSession, execCtx *ExecCtx, dpb *tree.DropPlanBaseline) error {
+	return doDropPlanBaseline(execCtx.reqCtx, ses.(*Session), dpb)
+}
+
+// doCreatePlanBaseline pins the plan built for the statement, with the hints
+// in it, as the plan of the statements with its digest.
+func doCreatePlanBaseline(ctx context.Context, ses *Session, cpb *tree.CreatePlanBaseline) (err error) {
+	switch cpb.Stmt.(type) {
+	case *tree.Select, *tree.ParenSelect:
+	default:
+		return moerr.NewNotSupported(ctx, "plan baseline for %s", cpb.Stmt.GetStatementType())
+	}
+	dbName := ses.GetDatabaseName()
+	digest := planBaselineDigest(dbName, cpb.Stmt)
+	shape, cost, err := buildPlanShape(ses, cpb.Stmt)
+	if err != nil {
+		return err
+	}
+	hints := planShapeString(shape)
+	planDigest := sha256Hex(hints)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	sql := genUpsertPlanBaselineSql(digest, planDigest, dbName, tree.String(cpb.Stmt, dialect.MYSQL),
+		hints, planBaselineCaptured, cost, time.Now().UTC())
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	if err = bh.Exec(ctx, fmt.Sprintf(pinPlanBaselineFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES,
+		planDigest, planBaselinePinned, planBaselineCaptured, digest)); err != nil {
+		return err
+	}
+	invalidateCachedPlanBaselines(ses.GetAccountId())
+	return nil
+}
+
+func doAlterPlanBaseline(ctx context.Context, ses *Session, apb *tree.AlterPlanBaseline) (err error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	rows, err := loadPlanBaselines(ctx, bh, apb.Digest)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return moerr.NewInvalidInput(ctx, "plan baseline %s does not exist", apb.Digest)
+	}
+
+	var sql string
+	switch apb.Action {
+	case tree.PlanBaselinePin:
+		// the latest captured plan is pinned by default
+		planDigest := rows[0].planDigest
+		if apb.PlanDigest != "" {
+			planDigest = ""
+			for _, row := range rows {
+				if row.planDigest == apb.PlanDigest {
+					planDigest = row.planDigest
+				}
+			}
+			if planDigest == "" {
+				return moerr.NewInvalidInput(ctx, "plan %s of plan baseline %s does not exist", apb.PlanDigest, apb.Digest)
+			}
+		}
+		sql = fmt.Sprintf(pinPlanBaselineFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES,
+			planDigest, planBaselinePinned, planBaselineCaptured, util.EscapeSQLString(apb.Digest))
+	case tree.PlanBaselineUnpin:
+		sql = fmt.Sprintf(unpinPlanBaselineFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES,
+			planBaselineCaptured, util.EscapeSQLString(apb.Digest))
+	case tree.PlanBaselineEvolve:
+		if sql, err = evolvePlanBaseline(ctx, ses, bh, apb.Digest, rows); err != nil || sql == "" {
+			return err
+		}
+	}
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	invalidateCachedPlanBaselines(ses.GetAccountId())
+	return nil
+}
+
+// evolvePlanBaseline builds the plan of the statement again both by the
+// optimizer itself and by the pinned plan. The plan chosen by the optimizer is
+// captured, and it is pinned instead if the pinned one is no longer valid or
+// the estimated cost of it is lower. It returns the sql pinning the plan, or
+// "" if the pinned one is kept.
+func evolvePlanBaseline(ctx context.Context, ses *Session, bh BackgroundExec, digest string, rows []planBaselineRow) (string, error) {
+	var pinned *planBaselineRow
+	for i := range rows {
+		if rows[i].status == planBaselinePinned {
+			pinned = &rows[i]
+		}
+	}
+	if pinned == nil {
+		return "", moerr.NewInvalidInput(ctx, "plan baseline %s has no pinned plan", digest)
+	}
+
+	v, err := ses.GetSessionSysVar("lower_case_table_names")
+	if err != nil {
+		return "", err
+	}
+	stmt, err := mysql.ParseOne(ctx, pinned.sqlText, v.(int64))
+	if err != nil {
+		return "", err
+	}
+	defer stmt.Free()
+
+	// the statement runs in the database it is captured in
+	tcc := ses.GetTxnCompileCtx()
+	oldDB := tcc.DefaultDatabase()
+	tcc.SetDatabase(pinned.dbName)
+	defer tcc.SetDatabase(oldDB)
+
+	shape, cost, err := buildPlanShape(ses, stmt)
+	if err != nil {
+		return "", err
+	}
+	tree.SetOptimizerHints(stmt, mysql.ParseOptimizerHints(pinned.hints))
+	pinnedShape, pinnedCost, err := buildPlanShape(ses, stmt)
+	valid := err == nil && planShapeString(pinnedShape) == pinned.hints
+
+	hints := planShapeString(shape)
+	if hints == pinned.hints {
+		return "", nil
+	}
+	planDigest := sha256Hex(hints)
+	sql := genUpsertPlanBaselineSql(digest, planDigest, pinned.dbName, pinned.sqlText,
+		hints, planBaselineCaptured, cost, time.Now().UTC())
+	if err = bh.Exec(ctx, sql); err != nil {
+		return "", err
+	}
+	if valid && pinnedCost <= cost {
+		return "", nil
+	}
+	return fmt.Sprintf(pinPlanBaselineFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES,
+		planDigest, planBaselinePinned, planBaselineCaptured, util.EscapeSQLString(digest)), nil
+}
+
+func doDropPlanBaseline(ctx context.Context, ses *Session, dpb *tree.DropPlanBaseline) (err error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	rows, err := loadPlanBaselines(ctx, bh, dpb.Digest)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		if dpb.IfExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "plan baseline %s does not exist", dpb.Digest)
+	}
+	if err = bh.Exec(ctx, fmt.Sprintf(deletePlanBaselinesFormat, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES,
+		util.EscapeSQLString(dpb.Digest))); err != nil {
+		return err
+	}
+	invalidateCachedPlanBaselines(ses.GetAccountId())
+	return nil
+}
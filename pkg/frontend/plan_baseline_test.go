@@ -0,0 +1,90 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// mockLoadPinnedPlans stubs loading the pinned plans for the tests building
+// the plans with mocked transactions.
+func mockLoadPinnedPlans() *gostub.Stubs {
+	return gostub.Stub(&loadPinnedPlans, func(context.Context, *Session) (map[string]string, error) {
+		return nil, nil
+	})
+}
+
+func Test_planBaselineDigest(t *testing.T) {
+	digest := func(db, sql string) string {
+		stmt, err := mysql.ParseOne(context.TODO(), sql, 1)
+		require.NoError(t, err)
+		return planBaselineDigest(db, stmt)
+	}
+	d := digest("db", "select * from t where a = 1 and b = 'x'")
+	require.Equal(t, d, digest("db", "select * from t where a = 2 and b = 'y'"))
+	require.Equal(t, d, digest("db", "select /*+ JOIN_ORDER(t, s) */ * from t where a = 2 and b = 'y'"))
+	require.NotEqual(t, d, digest("db1", "select * from t where a = 1 and b = 'x'"))
+	require.NotEqual(t, d, digest("db", "select * from t where a = 1 or b = 'x'"))
+}
+
+func Test_planBaselineShape(t *testing.T) {
+	require.Equal(t, "", planShapeString(nil))
+	require.Equal(t, "", planShapeString(&tree.OptimizerHints{}))
+
+	shape := "/*+ JOIN_ORDER(a, b) USE_INDEX(b, idx) */"
+	hints := mysql.ParseOptimizerHints(shape)
+	require.Empty(t, hints.Warnings)
+	require.Equal(t, shape, planShapeString(hints))
+
+	stmt, err := mysql.ParseOne(context.TODO(), "select "+shape+" * from a, b", 1)
+	require.NoError(t, err)
+	require.False(t, isPlanBaselineStatement(stmt))
+	stmt, err = mysql.ParseOne(context.TODO(), "select * from a, b", 1)
+	require.NoError(t, err)
+	require.True(t, isPlanBaselineStatement(stmt))
+
+	// the hints of the statement are kept with the hints of the pinned plan
+	stmt, err = mysql.ParseOne(context.TODO(), "select /*+ MAX_EXECUTION_TIME(1000) */ * from a, b", 1)
+	require.NoError(t, err)
+	require.True(t, isPlanBaselineStatement(stmt))
+	orig := tree.GetOptimizerHints(stmt)
+	restore := setPinnedHints(stmt, shape)
+	require.Equal(t, "/*+ JOIN_ORDER(a, b) USE_INDEX(b, idx) MAX_EXECUTION_TIME(1000) */",
+		tree.String(tree.GetOptimizerHints(stmt), dialect.MYSQL))
+	restore()
+	require.Same(t, orig, tree.GetOptimizerHints(stmt))
+}
+
+func Test_genUpsertPlanBaselineSql(t *testing.T) {
+	now := time.Date(2024, 5, 20, 10, 30, 15, 0, time.UTC)
+	sql := genUpsertPlanBaselineSql("d", "p", "db", "select * from t where a = 'x'", "", planBaselineCaptured, 1.5, now)
+	require.Contains(t, sql, "values ('d', 'p', 'db', 'select * from t where a = ''x''', '', 'captured', 1.5, '2024-05-20 10:30:15', '2024-05-20 10:30:15')")
+	require.Contains(t, sql, "on duplicate key update cost = 1.5")
+
+	require.True(t, markPlanCaptured(1, "d", "p"))
+	require.False(t, markPlanCaptured(1, "d", "p"))
+	require.True(t, markPlanCaptured(2, "d", "p"))
+	invalidateCachedPlanBaselines(1)
+	require.True(t, markPlanCaptured(1, "d", "p"))
+}
@@ -16,7 +16,12 @@ package frontend
 
 import (
 	"container/list"
+	"fmt"
+	"slices"
+	"sync"
 
+	pbplan "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
@@ -104,3 +109,172 @@ func (pc *planCache) clean() {
 	pc.lruList = nil
 	pc.cachePool = nil
 }
+
+// sharedPlanCacheCapacity is the max number of the prepared plans cached by
+// a CN for all the sessions.
+const sharedPlanCacheCapacity = 4096
+
+// globalPlanCache is the prepared plans shared by the sessions of this CN.
+var globalPlanCache = newSharedPlanCache(sharedPlanCacheCapacity)
+
+type sharedPlan struct {
+	key       string
+	accountID uint32
+	// prepare is the plan of the PREPARE statement, its name is not used
+	prepare *pbplan.Prepare
+}
+
+// sharedPlanCache uses LRU to cache the prepared plans for the same sql of
+// the same account and database, so that the sessions preparing the same
+// statement do not plan it again.
+//
+// The key of a plan has two catalog versions of the account: the one of this
+// cache is changed after the DDL in the account is executed in this CN, and
+// the plans of the account cached before are dropped; the one of the engine is
+// changed after the DDL executed in any CN is applied from the logtail. The
+// key also has the hints and the session variables changing the plan.
+type sharedPlanCache struct {
+	sync.Mutex
+	capacity        int
+	lruList         *list.List
+	cachePool       map[string]*list.Element
+	catalogVersions map[uint32]uint64
+}
+
+func newSharedPlanCache(capacity int) *sharedPlanCache {
+	return &sharedPlanCache{
+		capacity:        capacity,
+		lruList:         list.New(),
+		cachePool:       make(map[string]*list.Element),
+		catalogVersions: make(map[uint32]uint64),
+	}
+}
+
+// key returns the key of the statement prepared in the database, catalogVersion
+// is the catalog version of the account in the engine and vars is the values of
+// the session variables changing the plan.
+func (spc *sharedPlanCache) key(accountID uint32, catalogVersion uint64, dbName, vars string, stmt tree.Statement) string {
+	spc.Lock()
+	version := spc.catalogVersions[accountID]
+	spc.Unlock()
+	var hints string
+	if h := tree.GetOptimizerHints(stmt); h != nil && len(h.Hints) > 0 {
+		hints = tree.String(h, dialect.MYSQL)
+	}
+	return fmt.Sprintf("%d/%d/%d/%s/%s/%s/%s", accountID, version, catalogVersion, dbName, vars, hints, tree.String(stmt, dialect.MYSQL))
+}
+
+// get returns a copy of the prepared plan cached as the plan of the PREPARE
+// statement with the name.
+func (spc *sharedPlanCache) get(key string, name string) *plan.Plan {
+	spc.Lock()
+	defer spc.Unlock()
+	element, ok := spc.cachePool[key]
+	if !ok {
+		return nil
+	}
+	spc.lruList.MoveToFront(element)
+	prepare := copyPrepare(element.Value.(*sharedPlan).prepare)
+	prepare.Name = name
+	return &plan.Plan{
+		Plan: &pbplan.Plan_Dcl{
+			Dcl: &pbplan.DataControl{
+				DclType: pbplan.DataControl_PREPARE,
+				Control: &pbplan.DataControl_Prepare{
+					Prepare: prepare,
+				},
+			},
+		},
+	}
+}
+
+// cache saves a copy of the prepared plan.
+func (spc *sharedPlanCache) cache(key string, accountID uint32, prepare *pbplan.Prepare) {
+	prepare = copyPrepare(prepare)
+	spc.Lock()
+	defer spc.Unlock()
+	if element, ok := spc.cachePool[key]; ok {
+		element.Value.(*sharedPlan).prepare = prepare
+		spc.lruList.MoveToFront(element)
+		return
+	}
+	spc.cachePool[key] = spc.lruList.PushFront(&sharedPlan{
+		key:       key,
+		accountID: accountID,
+		prepare:   prepare,
+	})
+	if spc.lruList.Len() > spc.capacity {
+		spc.removeLocked(spc.lruList.Back())
+	}
+}
+
+// copyPrepare copies the prepared plan, the sessions never share the query
+// plan modified when it runs.
+func copyPrepare(prepare *pbplan.Prepare) *pbplan.Prepare {
+	return &pbplan.Prepare{
+		Name:       prepare.Name,
+		Schemas:    slices.Clone(prepare.Schemas),
+		Plan:       plan.DeepCopyPlan(prepare.Plan),
+		ParamTypes: slices.Clone(prepare.ParamTypes),
+	}
+}
+
+// remove drops the plan, which is out of date.
+func (spc *sharedPlanCache) remove(key string) {
+	spc.Lock()
+	defer spc.Unlock()
+	if element, ok := spc.cachePool[key]; ok {
+		spc.removeLocked(element)
+	}
+}
+
+func (spc *sharedPlanCache) removeLocked(element *list.Element) {
+	spc.lruList.Remove(element)
+	delete(spc.cachePool, element.Value.(*sharedPlan).key)
+}
+
+// invalidate changes the catalog version of the account and drops its plans.
+func (spc *sharedPlanCache) invalidate(accountID uint32) {
+	spc.Lock()
+	defer spc.Unlock()
+	spc.catalogVersions[accountID]++
+	for element := spc.lruList.Front(); element != nil; {
+		next := element.Next()
+		if element.Value.(*sharedPlan).accountID == accountID {
+			spc.removeLocked(element)
+		}
+		element = next
+	}
+}
+
+func (spc *sharedPlanCache) len() int {
+	spc.Lock()
+	defer spc.Unlock()
+	return spc.lruList.Len()
+}
+
+// canSharePlan returns whether the prepared plan can be used by the other
+// sessions. The plans with the values prepared, the temporary tables, the
+// snapshots or the subscriptions depend on the session preparing them.
+func canSharePlan(prepare *pbplan.Prepare) bool {
+	p := prepare.GetPlan()
+	if p == nil {
+		return false
+	}
+	q, ok := p.Plan.(*pbplan.Plan_Query)
+	if !ok || !checkNodeCanCache(p) {
+		return false
+	}
+	for _, node := range q.Query.Nodes {
+		if node.NodeType == pbplan.Node_VALUE_SCAN && node.RowsetData != nil {
+			return false
+		}
+		if node.TableDef != nil && node.TableDef.IsTemporary {
+			return false
+		}
+		if node.ScanSnapshot != nil && node.ScanSnapshot.TS != nil && !node.ScanSnapshot.TS.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
@@ -15,9 +15,14 @@
 package frontend
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pbplan "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 )
 
 func Test_BasicGet(t *testing.T) {
@@ -92,3 +97,57 @@ func Test_CleanCache(t *testing.T) {
 	require.NotNil(t, pc.get("3"))
 	require.NotNil(t, pc.get("4"))
 }
+
+func Test_sharedPlanCache(t *testing.T) {
+	stmt, err := mysql.ParseOne(context.TODO(), "select * from t where a = ?", 1)
+	require.NoError(t, err)
+	prepare := func(name string) *pbplan.Prepare {
+		return &pbplan.Prepare{
+			Name: name,
+			Plan: &pbplan.Plan{Plan: &pbplan.Plan_Query{Query: &pbplan.Query{
+				Nodes: []*pbplan.Node{{NodeType: pbplan.Node_TABLE_SCAN, TableDef: &pbplan.TableDef{Name: "t"}}},
+			}}},
+			ParamTypes: []int32{int32(types.T_int64)},
+		}
+	}
+
+	spc := newSharedPlanCache(2)
+	key1 := spc.key(1, 0, "db", "", stmt)
+	require.NotEqual(t, key1, spc.key(1, 0, "db1", "", stmt))
+	require.NotEqual(t, key1, spc.key(2, 0, "db", "", stmt))
+	// the DDL applied from the logtail, the session variables and the hints
+	// change the key
+	require.NotEqual(t, key1, spc.key(1, 1, "db", "", stmt))
+	require.NotEqual(t, key1, spc.key(1, 0, "db", "ONLY_FULL_GROUP_BY;", stmt))
+	hinted, err := mysql.ParseOne(context.TODO(), "select /*+ JOIN_ORDER(t) */ * from t where a = ?", 1)
+	require.NoError(t, err)
+	require.NotEqual(t, key1, spc.key(1, 0, "db", "", hinted))
+	require.Nil(t, spc.get(key1, "s1"))
+
+	p := prepare("s0")
+	require.True(t, canSharePlan(p))
+	spc.cache(key1, 1, p)
+	got := spc.get(key1, "s1")
+	require.NotNil(t, got)
+	require.Equal(t, "s1", got.GetDcl().GetPrepare().GetName())
+	require.Equal(t, p.ParamTypes, got.GetDcl().GetPrepare().GetParamTypes())
+	// the plan got is a copy
+	require.NotSame(t, p.Plan.GetQuery().Nodes[0], got.GetDcl().GetPrepare().GetPlan().GetQuery().Nodes[0])
+
+	key2 := spc.key(2, 0, "db", "", stmt)
+	key3 := spc.key(2, 0, "db1", "", stmt)
+	spc.cache(key2, 2, p)
+	spc.cache(key3, 2, p)
+	require.Equal(t, 2, spc.len())
+	require.Nil(t, spc.get(key1, "s1"))
+
+	// the DDL in the account drops its plans and changes its keys
+	spc.invalidate(2)
+	require.Equal(t, 0, spc.len())
+	require.NotEqual(t, key2, spc.key(2, 0, "db", "", stmt))
+	require.Equal(t, key1, spc.key(1, 0, "db", "", stmt))
+
+	p.Plan.GetQuery().Nodes[0].TableDef.IsTemporary = true
+	require.False(t, canSharePlan(p))
+	require.False(t, canSharePlan(&pbplan.Prepare{}))
+}
@@ -16,11 +16,14 @@ package frontend
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -47,6 +50,58 @@ type Interpreter struct {
 	argsAttr    map[string]tree.InOutArgType // used for IN, OUT, IN/OUT check
 	argsMap     map[string]tree.Expr         // used for argument to parameter mapping
 	outParamMap map[string]interface{}       // used for storing and updating OUT type arg
+	trigger     *triggerContext              // the row trigger being executed, nil for stored procedure
+	dbName      string                       // the database of the stored procedure
+	blocks      []*spBlock                   // the cursors, conditions and handlers of each variable scope
+	handling    []error                      // the conditions being handled, the last one is resignaled by RESIGNAL
+}
+
+// spBlock holds the cursors, conditions and handlers declared in a block. It is
+// pushed and popped along with the variable scope of the block.
+type spBlock struct {
+	cursors    map[string]*spCursor
+	conditions map[string]*tree.ConditionValue
+	handlers   []*tree.DeclareHandler
+}
+
+// spCursor is a cursor of the stored procedure. The rows of the query are
+// fetched when the cursor is opened.
+type spCursor struct {
+	stmt    *tree.Select
+	open    bool
+	columns uint64
+	rows    [][]interface{}
+	pos     int
+}
+
+// spRaisedError is the condition which no handler catches. It is passed through
+// the enclosing statements without looking up the handlers again.
+type spRaisedError struct {
+	err error
+}
+
+func (e *spRaisedError) Error() string {
+	return e.err.Error()
+}
+
+// spExitError leaves the block of the EXIT handler which has handled a condition.
+type spExitError struct {
+	depth int
+}
+
+func (e *spExitError) Error() string {
+	return "exit handler"
+}
+
+// spError returns the error raised out of the stored procedure or trigger.
+func spError(err error) error {
+	switch e := err.(type) {
+	case *spRaisedError:
+		return e.err
+	case *spExitError:
+		return nil
+	}
+	return err
 }
 
 func (interpreter *Interpreter) GetResult() []ExecResult {
@@ -226,8 +281,382 @@ func (interpreter *Interpreter) EvalCond(cond string) (int, error) {
 	return 0, nil
 }
 
+// EvalExpr evaluates the expression by sending it to bh with a select.
+// The value is returned as a string, or nil for NULL.
+func (interpreter *Interpreter) EvalExpr(expr string) (interface{}, error) {
+	interpreter.bh.ClearExecResultSet()
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+	err := interpreter.bh.Exec(interpreter.ctx, "select "+expr)
+	if err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(interpreter.ctx, interpreter.bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	return getResultValue(interpreter.ctx, erArray[0].(*MysqlResultSet), 0, 0)
+}
+
+// getResultValue returns the value in the result set as a string, nil for NULL.
+func getResultValue(ctx context.Context, mrs *MysqlResultSet, rindex, cindex uint64) (interface{}, error) {
+	value, err := mrs.GetValue(ctx, rindex, cindex)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	if date, ok := value.(types.Date); ok {
+		return date.String(), nil
+	}
+	return mrs.GetString(ctx, rindex, cindex)
+}
+
+func (interpreter *Interpreter) pushScope(scope map[string]interface{}) {
+	*interpreter.varScope = append(*interpreter.varScope, scope)
+	interpreter.blocks = append(interpreter.blocks, &spBlock{})
+}
+
+func (interpreter *Interpreter) popScope() {
+	*interpreter.varScope = (*interpreter.varScope)[:len(*interpreter.varScope)-1]
+	interpreter.blocks = interpreter.blocks[:len(interpreter.blocks)-1]
+}
+
+func (interpreter *Interpreter) findCursor(name string) (*spCursor, error) {
+	for i := len(interpreter.blocks) - 1; i >= 0; i-- {
+		if cur, ok := interpreter.blocks[i].cursors[name]; ok {
+			return cur, nil
+		}
+	}
+	return nil, moerr.NewInvalidInput(interpreter.ctx, "undefined CURSOR: %s", name)
+}
+
+// findCondition returns the named condition visible in the block at depth, nil if it is not declared.
+func (interpreter *Interpreter) findCondition(depth int, name string) *tree.ConditionValue {
+	for i := depth; i >= 0; i-- {
+		if cond, ok := interpreter.blocks[i].conditions[name]; ok {
+			return cond
+		}
+	}
+	return nil
+}
+
+func (interpreter *Interpreter) declareCursor(st *tree.DeclareCursor) error {
+	block := interpreter.blocks[len(interpreter.blocks)-1]
+	if _, ok := block.cursors[string(st.Name)]; ok {
+		return moerr.NewInvalidInput(interpreter.ctx, "duplicate cursor: %s", st.Name)
+	}
+	if block.cursors == nil {
+		block.cursors = make(map[string]*spCursor)
+	}
+	block.cursors[string(st.Name)] = &spCursor{stmt: st.Select}
+	return nil
+}
+
+func (interpreter *Interpreter) declareCondition(st *tree.DeclareCondition) error {
+	block := interpreter.blocks[len(interpreter.blocks)-1]
+	if _, ok := block.conditions[string(st.Name)]; ok {
+		return moerr.NewInvalidInput(interpreter.ctx, "duplicate condition: %s", st.Name)
+	}
+	if st.Value.Type == tree.ConditionSqlState && !isValidSqlState(st.Value.SqlState) {
+		return moerr.NewInvalidInput(interpreter.ctx, "bad SQLSTATE: '%s'", st.Value.SqlState)
+	}
+	if block.conditions == nil {
+		block.conditions = make(map[string]*tree.ConditionValue)
+	}
+	block.conditions[string(st.Name)] = st.Value
+	return nil
+}
+
+func (interpreter *Interpreter) declareHandler(st *tree.DeclareHandler) error {
+	depth := len(interpreter.blocks) - 1
+	for _, cond := range st.Conditions {
+		switch cond.Type {
+		case tree.ConditionName:
+			if interpreter.findCondition(depth, string(cond.Name)) == nil {
+				return moerr.NewInvalidInput(interpreter.ctx, "undefined CONDITION: %s", cond.Name)
+			}
+		case tree.ConditionSqlState:
+			if !isValidSqlState(cond.SqlState) {
+				return moerr.NewInvalidInput(interpreter.ctx, "bad SQLSTATE: '%s'", cond.SqlState)
+			}
+		}
+	}
+	interpreter.blocks[depth].handlers = append(interpreter.blocks[depth].handlers, st)
+	return nil
+}
+
+// openCursor runs the query of the cursor and keeps the rows for FETCH.
+func (interpreter *Interpreter) openCursor(cur *spCursor) error {
+	if cur.open {
+		return moerr.NewSpCursorAlreadyOpen(interpreter.ctx)
+	}
+	interpreter.bh.ClearExecResultSet()
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+	err := interpreter.bh.Exec(interpreter.ctx, interpreter.GetStatementString(cur.stmt))
+	if err != nil {
+		return err
+	}
+	erArray, err := getResultSet(interpreter.ctx, interpreter.bh)
+	if err != nil {
+		return err
+	}
+
+	cur.columns, cur.rows, cur.pos = 0, nil, 0
+	if len(erArray) > 0 {
+		mrs := erArray[0].(*MysqlResultSet)
+		cur.columns = mrs.GetColumnCount()
+		cur.rows = make([][]interface{}, mrs.GetRowCount())
+		for i := range cur.rows {
+			cur.rows[i] = make([]interface{}, cur.columns)
+			for j := range cur.rows[i] {
+				if cur.rows[i][j], err = getResultValue(interpreter.ctx, mrs, uint64(i), uint64(j)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	cur.open = true
+	return nil
+}
+
+// fetchCursor assigns the next row of the cursor to the variables, it raises
+// the NOT FOUND condition if there is no more row.
+func (interpreter *Interpreter) fetchCursor(cur *spCursor, variables []string) error {
+	if !cur.open {
+		return moerr.NewSpCursorNotOpen(interpreter.ctx)
+	}
+	if cur.pos >= len(cur.rows) {
+		return moerr.NewSpFetchNoData(interpreter.ctx)
+	}
+	if uint64(len(variables)) != cur.columns {
+		return moerr.NewSpWrongNoOfFetchArgs(interpreter.ctx)
+	}
+	row := cur.rows[cur.pos]
+	cur.pos++
+	for i, name := range variables {
+		if err := interpreter.SetSpVar(name, row[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (interpreter *Interpreter) closeCursor(cur *spCursor) error {
+	if !cur.open {
+		return moerr.NewSpCursorNotOpen(interpreter.ctx)
+	}
+	cur.open, cur.rows = false, nil
+	return nil
+}
+
+// signal returns the condition raised by SIGNAL or RESIGNAL.
+func (interpreter *Interpreter) signal(st *tree.Signal) error {
+	var sqlState, msg string
+	var code uint16
+	if st.Resignal {
+		if len(interpreter.handling) == 0 {
+			return moerr.NewInvalidInput(interpreter.ctx, "RESIGNAL when handler not active")
+		}
+		handled := interpreter.handling[len(interpreter.handling)-1]
+		sqlState, code = getConditionInfo(handled)
+		msg = handled.Error()
+	}
+	if cond := st.Condition; cond != nil {
+		if cond.Type == tree.ConditionName {
+			if cond = interpreter.findCondition(len(interpreter.blocks)-1, string(cond.Name)); cond == nil {
+				return moerr.NewInvalidInput(interpreter.ctx, "undefined CONDITION: %s", st.Condition.Name)
+			}
+			if cond.Type != tree.ConditionSqlState {
+				return moerr.NewInvalidInput(interpreter.ctx, "SIGNAL/RESIGNAL can only use a CONDITION defined with SQLSTATE")
+			}
+		}
+		if !isValidSqlState(cond.SqlState) {
+			return moerr.NewInvalidInput(interpreter.ctx, "bad SQLSTATE: '%s'", cond.SqlState)
+		}
+		sqlState, code, msg = cond.SqlState, 0, ""
+	}
+
+	for _, info := range st.Info {
+		val, err := interpreter.EvalExpr(interpreter.GetExprString(info.Value))
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			return moerr.NewInvalidInput(interpreter.ctx, "variable '%s' can't be set to the value of 'NULL'", strings.ToUpper(info.Item.String()))
+		}
+		switch info.Item {
+		case tree.SignalMessageText:
+			msg = fmt.Sprintf("%v", val)
+		case tree.SignalMysqlErrno:
+			errno, err := strconv.ParseUint(fmt.Sprintf("%v", val), 10, 16)
+			if err != nil || errno == 0 {
+				return moerr.NewInvalidInput(interpreter.ctx, "variable 'MYSQL_ERRNO' can't be set to the value of '%v'", val)
+			}
+			code = uint16(errno)
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(sqlState, "01"):
+		if code == 0 {
+			code = moerr.ER_SIGNAL_WARN
+		}
+		if msg == "" {
+			msg = "Unhandled user-defined warning condition"
+		}
+	case strings.HasPrefix(sqlState, "02"):
+		if code == 0 {
+			code = moerr.ER_SIGNAL_NOT_FOUND
+		}
+		if msg == "" {
+			msg = "Unhandled user-defined not found condition"
+		}
+	}
+	return moerr.NewSignalException(interpreter.ctx, sqlState, code, msg)
+}
+
+// handleCondition runs the handler of the condition raised by a statement. After a
+// CONTINUE handler the execution resumes with the next statement, and an EXIT handler
+// leaves the block it is declared in.
+func (interpreter *Interpreter) handleCondition(err error) (SpStatus, error) {
+	sqlState, code := getConditionInfo(err)
+	depth, handler := interpreter.findHandler(sqlState, code)
+	if handler == nil {
+		// the warning no handler catches does not stop the execution
+		if strings.HasPrefix(sqlState, "01") {
+			return SpOk, nil
+		}
+		return SpNotOk, &spRaisedError{err: err}
+	}
+
+	// the handler runs in the scope it is declared in, and the conditions it raises
+	// are handled by the handlers of the enclosing blocks.
+	varScope, blocks := *interpreter.varScope, interpreter.blocks
+	*interpreter.varScope = slices.Clone(varScope[:depth+1])
+	interpreter.blocks = append(slices.Clone(blocks[:depth]), &spBlock{
+		cursors:    blocks[depth].cursors,
+		conditions: blocks[depth].conditions,
+	})
+	interpreter.handling = append(interpreter.handling, err)
+	_, herr := interpreter.interpret(handler.Body)
+	interpreter.handling = interpreter.handling[:len(interpreter.handling)-1]
+	*interpreter.varScope, interpreter.blocks = varScope, blocks
+
+	if herr != nil {
+		return SpNotOk, herr
+	}
+	if handler.Action == tree.HandlerExit {
+		return SpNotOk, &spExitError{depth: depth}
+	}
+	return SpOk, nil
+}
+
+// findHandler returns the handler of the condition in the innermost block and the depth
+// of the block. In a block, the handler of the error code is chosen first, then the one
+// of the sqlstate and at last the one of the class.
+func (interpreter *Interpreter) findHandler(sqlState string, code uint16) (int, *tree.DeclareHandler) {
+	for depth := len(interpreter.blocks) - 1; depth >= 0; depth-- {
+		var found *tree.DeclareHandler
+		priority := 0
+		for _, handler := range interpreter.blocks[depth].handlers {
+			for _, cond := range handler.Conditions {
+				if cond.Type == tree.ConditionName {
+					cond = interpreter.findCondition(depth, string(cond.Name))
+				}
+				if p := matchCondition(cond, sqlState, code); p > priority {
+					found, priority = handler, p
+				}
+			}
+		}
+		if found != nil {
+			return depth, found
+		}
+	}
+	return -1, nil
+}
+
+// matchCondition returns how specific the condition matches, 0 if it does not match.
+func matchCondition(cond *tree.ConditionValue, sqlState string, code uint16) int {
+	if cond == nil {
+		return 0
+	}
+	switch cond.Type {
+	case tree.ConditionErrorCode:
+		if cond.ErrorCode == code {
+			return 3
+		}
+	case tree.ConditionSqlState:
+		if cond.SqlState == sqlState {
+			return 2
+		}
+	case tree.ConditionSqlWarning:
+		if strings.HasPrefix(sqlState, "01") {
+			return 1
+		}
+	case tree.ConditionNotFound:
+		if strings.HasPrefix(sqlState, "02") {
+			return 1
+		}
+	case tree.ConditionSqlException:
+		if !strings.HasPrefix(sqlState, "00") && !strings.HasPrefix(sqlState, "01") && !strings.HasPrefix(sqlState, "02") {
+			return 1
+		}
+	}
+	return 0
+}
+
+// getConditionInfo returns the sqlstate and the mysql error code of the error.
+func getConditionInfo(err error) (string, uint16) {
+	var moErr *moerr.Error
+	if errors.As(err, &moErr) {
+		return moErr.SqlState(), moErr.MySQLCode()
+	}
+	return moerr.MySQLDefaultSqlState, moerr.ER_UNKNOWN_ERROR
+}
+
+func isValidSqlState(sqlState string) bool {
+	if len(sqlState) != 5 || strings.HasPrefix(sqlState, "00") {
+		return false
+	}
+	for _, c := range sqlState {
+		if (c < '0' || c > '9') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// SetTriggerColumn assigns the column of the NEW pseudo-row of the row trigger.
+func (interpreter *Interpreter) SetTriggerColumn(name string, value tree.Expr) error {
+	name = strings.ToLower(name)
+	if _, ok := interpreter.trigger.row[name]; !ok {
+		return moerr.NewBadFieldError(interpreter.ctx, name[len(triggerNewPrefix):], "NEW")
+	}
+	val, err := interpreter.EvalExpr(interpreter.GetExprString(value))
+	if err != nil {
+		return err
+	}
+	interpreter.trigger.row[name] = val
+	interpreter.trigger.assigned[name[len(triggerNewPrefix):]] = true
+	return nil
+}
+
+// ExecuteTrigger runs the body of the row trigger in the transaction of the
+// triggering statement. The NEW and OLD pseudo-rows are the outermost variable scope.
+func (interpreter *Interpreter) ExecuteTrigger(stmt tree.Statement) (err error) {
+	interpreter.pushScope(interpreter.trigger.row)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+	_, err = interpreter.interpret(stmt)
+	return spError(err)
+}
+
 func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (err error) {
 	curScope := make(map[string]interface{})
+	interpreter.dbName = dbName
 	interpreter.bh.ClearExecResultSet()
 
 	// use current database as default
@@ -246,7 +675,7 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 	}
 
 	// save parameters as local variables
-	*interpreter.varScope = append(*interpreter.varScope, curScope)
+	interpreter.pushScope(curScope)
 	for k, v := range interpreter.argsMap {
 		var value interface{}
 		if varParam, ok := v.(*tree.VarExpr); ok {
@@ -280,7 +709,7 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 
 	_, err = interpreter.interpret(stmt)
 
-	if err != nil {
+	if err = spError(err); err != nil {
 		return err
 	}
 
@@ -303,25 +732,38 @@ func (interpreter *Interpreter) ExecuteSp(stmt tree.Statement, dbName string) (e
 	return nil
 }
 
+// interpret executes the statement, and runs the handler of the condition raised by it.
 func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error) {
+	status, err := interpreter.interpretStmt(stmt)
+	switch err.(type) {
+	case nil, *spRaisedError, *spExitError:
+		return status, err
+	}
+	return interpreter.handleCondition(err)
+}
+
+func (interpreter *Interpreter) interpretStmt(stmt tree.Statement) (SpStatus, error) {
 	if stmt == nil {
 		return SpOk, nil
 	}
 	switch st := stmt.(type) {
 	case *tree.CompoundStmt:
 		// create new variable scope and push it
-		curScope := make(map[string]interface{})
-		*interpreter.varScope = append(*interpreter.varScope, curScope)
+		interpreter.pushScope(make(map[string]interface{}))
+		defer interpreter.popScope()
+		depth := len(interpreter.blocks) - 1
 		interpreter.ses.Infof(interpreter.ctx, "current scope level: "+strconv.Itoa(len(*interpreter.varScope)))
 		// recursively execute
 		for _, innerSt := range st.Stmts {
 			_, err := interpreter.interpret(innerSt)
+			if exit, ok := err.(*spExitError); ok && exit.depth == depth {
+				// an EXIT handler of this block has handled the condition
+				return SpOk, nil
+			}
 			if err != nil {
 				return SpNotOk, err
 			}
 		}
-		// pop current scope
-		*interpreter.varScope = (*interpreter.varScope)[:len(*interpreter.varScope)-1]
 		return SpOk, nil
 	case *tree.RepeatStmt:
 		for {
@@ -509,10 +951,62 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 			(*interpreter.varScope)[len(*interpreter.varScope)-1][v] = value
 		}
 		return SpOk, nil
+	case *tree.DeclareCursor:
+		if err := interpreter.declareCursor(st); err != nil {
+			return SpNotOk, err
+		}
+		return SpOk, nil
+	case *tree.DeclareCondition:
+		if err := interpreter.declareCondition(st); err != nil {
+			return SpNotOk, err
+		}
+		return SpOk, nil
+	case *tree.DeclareHandler:
+		if err := interpreter.declareHandler(st); err != nil {
+			return SpNotOk, err
+		}
+		return SpOk, nil
+	case *tree.OpenCursor:
+		cur, err := interpreter.findCursor(string(st.Name))
+		if err == nil {
+			err = interpreter.openCursor(cur)
+		}
+		if err != nil {
+			return SpNotOk, err
+		}
+		return SpOk, nil
+	case *tree.FetchCursor:
+		cur, err := interpreter.findCursor(string(st.Name))
+		if err == nil {
+			err = interpreter.fetchCursor(cur, st.Variables)
+		}
+		if err != nil {
+			return SpNotOk, err
+		}
+		return SpOk, nil
+	case *tree.CloseCursor:
+		cur, err := interpreter.findCursor(string(st.Name))
+		if err == nil {
+			err = interpreter.closeCursor(cur)
+		}
+		if err != nil {
+			return SpNotOk, err
+		}
+		return SpOk, nil
+	case *tree.Signal:
+		return SpNotOk, interpreter.signal(st)
 	case *tree.SetVar:
 		for _, assign := range st.Assignments {
 			name := assign.Name
 
+			// NEW.col of the row trigger
+			if interpreter.trigger != nil && strings.HasPrefix(strings.ToLower(name), triggerNewPrefix) {
+				if err := interpreter.SetTriggerColumn(name, assign.Value); err != nil {
+					return SpNotOk, err
+				}
+				continue
+			}
+
 			// if this is a system set, ignore if it's not a INOUT/OUT arg
 			if strings.Contains(interpreter.GetExprString(st), "@") {
 				str := interpreter.GetExprString(st)
@@ -544,6 +1038,22 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 		// For sp variable replacement
 		interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
 		interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+		// the statements of the trigger run with the privileges of the invoker
+		if interpreter.trigger != nil {
+			if err := interpreter.trigger.triggers.checkPrivilege(interpreter.ctx, interpreter.ses, st); err != nil {
+				return SpNotOk, err
+			}
+		}
+		// the DML of the stored procedure fires the triggers of its table
+		if interpreter.trigger == nil {
+			executed, err := executeSpStmtWithTriggers(interpreter.ctx, interpreter.ses, interpreter.bh, interpreter.dbName, st)
+			if err != nil {
+				return SpNotOk, err
+			}
+			if executed {
+				return SpOk, nil
+			}
+		}
 		err := interpreter.bh.Exec(interpreter.ctx, str)
 		if err != nil {
 			return SpNotOk, err
@@ -0,0 +1,110 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+// newCursorInterpreter returns an interpreter with the variable v and the open cursor c.
+func newCursorInterpreter(rows ...string) (*Interpreter, *spCursor) {
+	var varScope []map[string]interface{}
+	interpreter := &Interpreter{
+		ctx:         context.TODO(),
+		varScope:    &varScope,
+		fmtctx:      tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true)),
+		outParamMap: make(map[string]interface{}),
+	}
+	interpreter.pushScope(map[string]interface{}{"v": nil})
+	cur := &spCursor{open: true, columns: 1}
+	for _, row := range rows {
+		cur.rows = append(cur.rows, []interface{}{row})
+	}
+	interpreter.blocks[0].cursors = map[string]*spCursor{"c": cur}
+	return interpreter, cur
+}
+
+func runInterpreter(t *testing.T, interpreter *Interpreter, sql string) error {
+	stmts, err := parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+	require.NoError(t, err)
+	_, err = interpreter.interpret(stmts[0])
+	return spError(err)
+}
+
+func Test_InterpreterHandler(t *testing.T) {
+	// the EXIT handler leaves the loop when the cursor has no more row
+	interpreter, cur := newCursorInterpreter("1", "2")
+	err := runInterpreter(t, interpreter, "begin declare exit handler for not found close c; loop fetch c into v; end loop; end")
+	require.NoError(t, err)
+	require.Equal(t, "2", (*interpreter.varScope)[0]["v"])
+	require.False(t, cur.open)
+	require.Len(t, *interpreter.varScope, 1)
+
+	// the handler of the error code is chosen before the one of the class
+	interpreter, cur = newCursorInterpreter()
+	err = runInterpreter(t, interpreter, "begin declare continue handler for not found close c; declare exit handler for 1329 resignal; fetch c into v; end")
+	require.Error(t, err)
+	require.Equal(t, moerr.ER_SP_FETCH_NO_DATA, err.(*moerr.Error).MySQLCode())
+	require.Equal(t, "02000", err.(*moerr.Error).SqlState())
+	require.True(t, cur.open)
+
+	// the named condition
+	interpreter, cur = newCursorInterpreter()
+	err = runInterpreter(t, interpreter, "begin declare no_data condition for sqlstate '02000'; declare continue handler for no_data close c; fetch c into v; end")
+	require.NoError(t, err)
+	require.False(t, cur.open)
+
+	// SIGNAL without handler
+	interpreter, _ = newCursorInterpreter()
+	err = runInterpreter(t, interpreter, "begin declare continue handler for sqlstate '23000' close c; signal sqlstate '45000'; end")
+	require.Error(t, err)
+	require.Equal(t, moerr.ER_SIGNAL_EXCEPTION, err.(*moerr.Error).MySQLCode())
+	require.Equal(t, "45000", err.(*moerr.Error).SqlState())
+
+	// the conditions raised in the handler are not handled by itself
+	interpreter, _ = newCursorInterpreter()
+	err = runInterpreter(t, interpreter, "begin declare continue handler for sqlexception signal sqlstate '45001'; signal sqlstate '45000'; end")
+	require.Error(t, err)
+	require.Equal(t, "45001", err.(*moerr.Error).SqlState())
+
+	// the unhandled warning does not stop the execution
+	interpreter, cur = newCursorInterpreter()
+	err = runInterpreter(t, interpreter, "begin signal sqlstate '01000'; close c; end")
+	require.NoError(t, err)
+	require.False(t, cur.open)
+
+	interpreter, _ = newCursorInterpreter()
+	err = runInterpreter(t, interpreter, "begin resignal; end")
+	require.Error(t, err)
+	err = runInterpreter(t, interpreter, "begin declare continue handler for undefined_cond close c; end")
+	require.Error(t, err)
+	err = runInterpreter(t, interpreter, "begin close c; close c; end")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSpCursorNotOpen))
+}
+
+func Test_isValidSqlState(t *testing.T) {
+	require.True(t, isValidSqlState("45000"))
+	require.True(t, isValidSqlState("HY000"))
+	require.False(t, isValidSqlState("00000"))
+	require.False(t, isValidSqlState("4500"))
+	require.False(t, isValidSqlState("45a00"))
+}
@@ -133,6 +133,128 @@ var (
 			obj_id bigint unsigned
 			)`, catalog.MO_CATALOG, catalog.MO_SNAPSHOTS)
 
+	MoCatalogMoMviewsDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			database_name varchar(5000),
+			mv_name varchar(5000),
+			mv_query text,
+			query_database varchar(5000),
+			refresh_mode varchar(32),
+			refresh_cron varchar(100),
+			last_refresh_ts varchar(64),
+			created_time timestamp,
+			primary key(database_name, mv_name)
+			)`, catalog.MO_CATALOG, catalog.MO_MVIEWS)
+
+	MoCatalogMoTriggersDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			database_name varchar(5000),
+			table_name varchar(5000),
+			trigger_name varchar(64),
+			action_timing varchar(10),
+			event_manipulation varchar(10),
+			action_order int unsigned,
+			action_statement text,
+			created_time timestamp,
+			primary key(database_name, table_name, trigger_name)
+			)`, catalog.MO_CATALOG, catalog.MO_TRIGGERS)
+
+	MoCatalogMoEventsDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			database_name varchar(5000),
+			event_name varchar(64),
+			definer varchar(288),
+			definer_id int unsigned,
+			definer_role_id int unsigned,
+			event_body text,
+			event_type varchar(9),
+			execute_at timestamp,
+			interval_value varchar(256),
+			interval_field varchar(18),
+			starts timestamp,
+			ends timestamp,
+			status varchar(18),
+			on_completion varchar(12),
+			event_comment varchar(2048),
+			time_zone varchar(64),
+			created timestamp,
+			last_altered timestamp,
+			last_executed timestamp,
+			primary key(database_name, event_name)
+			)`, catalog.MO_CATALOG, catalog.MO_EVENTS)
+
+	MoCatalogMoEventHistoryDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			database_name varchar(5000),
+			event_name varchar(64),
+			start_time timestamp,
+			end_time timestamp,
+			status varchar(16),
+			error_message text
+			)`, catalog.MO_CATALOG, catalog.MO_EVENT_HISTORY)
+
+	MoCatalogMoOnlineDDLDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			job_id varchar(64) primary key,
+			database_name varchar(5000),
+			table_name varchar(5000),
+			target_name varchar(5000),
+			cn_addr varchar(128),
+			statement text,
+			phase varchar(32),
+			rows_total bigint unsigned,
+			rows_copied bigint unsigned,
+			changes_replayed bigint unsigned,
+			rounds int,
+			error_message text,
+			start_time timestamp,
+			update_time timestamp
+			)`, catalog.MO_CATALOG, catalog.MO_ONLINE_DDL)
+
+	MoCatalogMoColumnHistogramsDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			table_id bigint unsigned,
+			column_name varchar(256),
+			database_name varchar(5000),
+			table_name varchar(5000),
+			histogram text,
+			analyze_time timestamp,
+			primary key(table_id, column_name)
+			)`, catalog.MO_CATALOG, catalog.MO_COLUMN_HISTOGRAMS)
+
+	MoCatalogMoTableAnalyzeDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			table_id bigint unsigned primary key,
+			auto_analyze bool default true,
+			analyzed_rows bigint,
+			analyzed_size bigint,
+			analyze_type varchar(16),
+			analyze_time timestamp
+			)`, catalog.MO_CATALOG, catalog.MO_TABLE_ANALYZE)
+
+	MoCatalogMoPlanBaselinesDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			digest varchar(64),
+			plan_digest varchar(64),
+			database_name varchar(5000),
+			sql_text text,
+			plan_hints text,
+			status varchar(16),
+			cost double,
+			create_time timestamp,
+			update_time timestamp,
+			primary key(digest, plan_digest)
+			)`, catalog.MO_CATALOG, catalog.MO_PLAN_BASELINES)
+
+	MoCatalogMoResourceGroupsDDL = fmt.Sprintf(`create table %s.%s (
+			group_name varchar(64) primary key,
+			cpu_share int unsigned,
+			max_concurrency int unsigned,
+			memory_budget bigint unsigned,
+			created_time timestamp,
+			update_time timestamp
+			)`, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUPS)
+
+	MoCatalogMoResourceGroupBindingsDDL = fmt.Sprintf(`create table %s.%s (
+			account_name varchar(300),
+			user_name varchar(300),
+			group_name varchar(64),
+			created_time timestamp,
+			primary key(account_name, user_name)
+			)`, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUP_BINDINGS)
+
 	MoCatalogMoPubsDDL = `create table mo_catalog.mo_pubs (
     		pub_name varchar(64) primary key,
     		database_name varchar(5000),
@@ -177,7 +299,7 @@ var (
 				primary key(stage_id)
 			)`
 
-	MoCatalogMoSessionsDDL       = `CREATE VIEW mo_catalog.mo_sessions AS SELECT node_id, conn_id, session_id, account, user, host, db, session_start, command, info, txn_id, statement_id, statement_type, query_type, sql_source_type, query_start, client_host, role, proxy_host FROM mo_sessions() AS mo_sessions_tmp`
+	MoCatalogMoSessionsDDL       = `CREATE VIEW mo_catalog.mo_sessions AS SELECT node_id, conn_id, session_id, account, user, host, db, session_start, command, info, txn_id, statement_id, statement_type, query_type, sql_source_type, query_start, client_host, role, proxy_host, queue_wait_time FROM mo_sessions() AS mo_sessions_tmp`
 	MoCatalogMoConfigurationsDDL = `CREATE VIEW mo_catalog.mo_configurations AS SELECT node_type, node_id, name, current_value, default_value, internal FROM mo_configurations() AS mo_configurations_tmp`
 	MoCatalogMoLocksDDL          = `CREATE VIEW mo_catalog.mo_locks AS SELECT cn_id, txn_id, table_id, lock_key, lock_content, lock_mode, lock_status, lock_wait FROM mo_locks() AS mo_locks_tmp`
 	MoCatalogMoVariablesDDL      = `CREATE VIEW mo_catalog.mo_variables AS SELECT configuration_id, account_id, account_name, dat_name, variable_name, variable_value, system_variables FROM mo_catalog.mo_mysql_compatibility_mode`
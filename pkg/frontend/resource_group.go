@@ -0,0 +1,501 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util"
+)
+
+// A resource group limits the statements of the accounts and the users bound
+// to it on each CN: the share of the CPUs their pipelines may use, the number
+// of them running at the same time, and the memory shared by them. The groups
+// and the bindings are saved in the tables of the sys account. A binding of a
+// user takes precedence over the binding of its account, and the statements
+// of the accounts not bound run without limits.
+//
+// The CPU share scales the degree of parallelism of the pipelines of the
+// statements, and the pipelines on each CN sleep between the batches while the
+// group uses more than its share of the CPUs there. The memory budget is
+// charged by the pipelines on each CN, including the ones sent from the other
+// CNs.
+
+const (
+	// resourceGroupCacheTTL is how long the groups loaded are used before
+	// loading them again, so the groups changed on other CNs are seen after it.
+	resourceGroupCacheTTL = time.Minute
+
+	defaultResourceGroupCPUShare = 100
+
+	// resourceGroupWaitTimeout is the max time a statement waits for a slot
+	// of its resource group.
+	resourceGroupWaitTimeout = time.Minute
+
+	getResourceGroupsFormat          = `select group_name, cpu_share, max_concurrency, memory_budget from %s.%s;`
+	getResourceGroupBindingsFormat   = `select account_name, user_name, group_name from %s.%s;`
+	checkResourceGroupFormat         = `select group_name from %s.%s where group_name = '%s';`
+	insertResourceGroupFormat        = `insert into %s.%s (group_name, cpu_share, max_concurrency, memory_budget, created_time, update_time) values ('%s', %d, %d, %d, '%s', '%s');`
+	updateResourceGroupFormat        = `update %s.%s set %s, update_time = '%s' where group_name = '%s';`
+	deleteResourceGroupFormat        = `delete from %s.%s where group_name = '%s';`
+	upsertResourceGroupBindingFormat = `insert into %s.%s (account_name, user_name, group_name, created_time) values ('%s', '%s', '%s', '%s') ` +
+		`on duplicate key update group_name = '%s', created_time = '%s';`
+	deleteResourceGroupBindingFormat = `delete from %s.%s where account_name = '%s' and user_name = '%s' and group_name = '%s';`
+)
+
+// resourceGroup is the runtime of a resource group in this CN.
+type resourceGroup struct {
+	name           string
+	cpuShare       int64
+	maxConcurrency int64
+	memoryBudget   int64
+	// slots are held by the running statements of the group, nil if the
+	// concurrency is not limited
+	slots chan struct{}
+	// budget is the parent of the memory quotas of the running statements of
+	// the group, nil if the memory is not limited
+	budget *mpool.Quota
+}
+
+func newResourceGroup(name string, cpuShare, maxConcurrency, memoryBudget int64) *resourceGroup {
+	g := &resourceGroup{
+		name:           name,
+		cpuShare:       cpuShare,
+		maxConcurrency: maxConcurrency,
+		memoryBudget:   memoryBudget,
+	}
+	if maxConcurrency > 0 {
+		g.slots = make(chan struct{}, maxConcurrency)
+	}
+	// the budget is shared with the pipelines sent from the other CNs
+	g.budget = mpool.GroupQuota(name, memoryBudget)
+	return g
+}
+
+func (g *resourceGroup) sameOptions(o *resourceGroup) bool {
+	return g.cpuShare == o.cpuShare &&
+		g.maxConcurrency == o.maxConcurrency &&
+		g.memoryBudget == o.memoryBudget
+}
+
+// acquire waits for a slot to run a statement of the group until the ctx is
+// done or the timeout.
+func (g *resourceGroup) acquire(ctx context.Context, timeout time.Duration) error {
+	if g.slots == nil {
+		return nil
+	}
+	select {
+	case g.slots <- struct{}{}:
+		return nil
+	default:
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case g.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return moerr.NewInternalError(ctx, "wait for resource group %s timeout after %v, max_concurrency %d", g.name, timeout, g.maxConcurrency)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (g *resourceGroup) release() {
+	if g.slots != nil {
+		<-g.slots
+	}
+}
+
+// resourceGroupCache caches the resource groups and the bindings. A group
+// loaded again with the same options is kept, so are the statements holding
+// its slots and its memory budget.
+var resourceGroupCache = struct {
+	sync.Mutex
+	groups map[string]*resourceGroup
+	// bindings are the names of the groups by resourceGroupBindingKey
+	bindings map[string]string
+	loadTime time.Time
+}{
+	groups:   make(map[string]*resourceGroup),
+	bindings: make(map[string]string),
+}
+
+func resourceGroupBindingKey(account, user string) string {
+	return account + "/" + user
+}
+
+// getCachedResourceGroup returns the group of the user of the account, it
+// returns false if the groups need to be loaded.
+func getCachedResourceGroup(account, user string) (*resourceGroup, bool) {
+	resourceGroupCache.Lock()
+	defer resourceGroupCache.Unlock()
+	if time.Since(resourceGroupCache.loadTime) > resourceGroupCacheTTL {
+		return nil, false
+	}
+	return getCachedResourceGroupLocked(account, user), true
+}
+
+// getLastResourceGroup returns the group of the user of the account in the
+// groups loaded last time, even if they are expired.
+func getLastResourceGroup(account, user string) *resourceGroup {
+	resourceGroupCache.Lock()
+	defer resourceGroupCache.Unlock()
+	return getCachedResourceGroupLocked(account, user)
+}
+
+func getCachedResourceGroupLocked(account, user string) *resourceGroup {
+	name, ok := resourceGroupCache.bindings[resourceGroupBindingKey(account, user)]
+	if !ok {
+		name, ok = resourceGroupCache.bindings[resourceGroupBindingKey(account, "")]
+	}
+	if !ok {
+		return nil
+	}
+	return resourceGroupCache.groups[name]
+}
+
+func setCachedResourceGroups(groups []*resourceGroup, bindings map[string]string) {
+	resourceGroupCache.Lock()
+	defer resourceGroupCache.Unlock()
+	m := make(map[string]*resourceGroup, len(groups))
+	for _, g := range groups {
+		if old, ok := resourceGroupCache.groups[g.name]; ok && old.sameOptions(g) {
+			g = old
+		}
+		m[g.name] = g
+	}
+	resourceGroupCache.groups = m
+	resourceGroupCache.bindings = bindings
+	resourceGroupCache.loadTime = time.Now()
+}
+
+func invalidateCachedResourceGroups() {
+	resourceGroupCache.Lock()
+	defer resourceGroupCache.Unlock()
+	resourceGroupCache.loadTime = time.Time{}
+}
+
+// getResourceGroup returns the resource group of the statements of the
+// session, or nil if it is not bound to any.
+func getResourceGroup(ctx context.Context, ses *Session) *resourceGroup {
+	tenant := ses.GetTenantInfo()
+	if tenant == nil || ses.IsBackgroundSession() || ses.GetIsInternal() {
+		return nil
+	}
+	account, user := tenant.GetTenant(), tenant.GetUser()
+	if g, ok := getCachedResourceGroup(account, user); ok {
+		return g
+	}
+	groups, bindings, err := loadResourceGroups(ctx, ses)
+	if err != nil {
+		// the groups loaded last time are used until the groups are loaded
+		// again, the failure is not cached so the next statement retries
+		ses.Error(ctx, "Failed to load the resource groups", zap.Error(err))
+		return getLastResourceGroup(account, user)
+	}
+	setCachedResourceGroups(groups, bindings)
+	g, _ := getCachedResourceGroup(account, user)
+	return g
+}
+
+// txnHoldsLocks returns whether the transaction of the session holds locks.
+func txnHoldsLocks(ses *Session) bool {
+	txnOp := ses.GetTxnHandler().GetTxn()
+	return txnOp != nil && txnOp.LockTableCount() > 0
+}
+
+// resourceGroupExempt returns whether the statement runs without the limits of
+// its resource group. Ending a transaction, killing a query, setting variables
+// and showing the status must not wait for the statements of the group.
+func resourceGroupExempt(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.Kill, *tree.SetVar, *tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword,
+		*tree.SetTransaction, *tree.Use:
+		return true
+	}
+	return strings.HasPrefix(strings.ToLower(stmt.GetStatementType()), "show")
+}
+
+// loadResourceGroups loads the groups and the bindings from the tables of the
+// sys account.
+var loadResourceGroups = func(ctx context.Context, ses *Session) (groups []*resourceGroup, bindings map[string]string, err error) {
+	bindings = make(map[string]string)
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	// the groups are in the tables of the sys account
+	sysCtx := defines.AttachAccountId(ctx, catalog.System_Account)
+	bh.ClearExecResultSet()
+	if err = bh.Exec(sysCtx, fmt.Sprintf(getResourceGroupsFormat, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUPS)); err != nil {
+		return nil, bindings, err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return nil, bindings, err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			name, err := erArray[0].GetString(sysCtx, i, 0)
+			if err != nil {
+				return nil, bindings, err
+			}
+			var values [3]int64
+			for j := range values {
+				if values[j], err = erArray[0].GetInt64(sysCtx, i, uint64(j+1)); err != nil {
+					return nil, bindings, err
+				}
+			}
+			groups = append(groups, newResourceGroup(name, values[0], values[1], values[2]))
+		}
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(sysCtx, fmt.Sprintf(getResourceGroupBindingsFormat, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUP_BINDINGS)); err != nil {
+		return nil, bindings, err
+	}
+	erArray, err = getResultSet(sysCtx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return groups, bindings, err
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		var cols [3]string
+		for j := range cols {
+			if cols[j], err = erArray[0].GetString(sysCtx, i, uint64(j)); err != nil {
+				return nil, make(map[string]string), err
+			}
+		}
+		bindings[resourceGroupBindingKey(cols[0], cols[1])] = cols[2]
+	}
+	return groups, bindings, nil
+}
+
+func validateResourceGroupOptions(ctx context.Context, options *tree.ResourceGroupOptions) error {
+	if options.HasCPUShare && (options.CPUShare < 1 || options.CPUShare > 100) {
+		return moerr.NewInvalidInput(ctx, "cpu_share of resource group must be between 1 and 100, got %d", options.CPUShare)
+	}
+	if options.HasMaxConcurrency && options.MaxConcurrency < 0 {
+		return moerr.NewInvalidInput(ctx, "max_concurrency of resource group can not be negative")
+	}
+	if options.HasMemoryBudget && options.MemoryBudget < 0 {
+		return moerr.NewInvalidInput(ctx, "memory_budget of resource group can not be negative")
+	}
+	return nil
+}
+
+func checkResourceGroupTenant(ctx context.Context, ses *Session) error {
+	if tenant := ses.GetTenantInfo(); tenant == nil || !tenant.IsSysTenant() {
+		return moerr.NewInternalError(ctx, "only sys tenant can manage resource groups")
+	}
+	return nil
+}
+
+func resourceGroupExists(ctx context.Context, bh BackgroundExec, name string) (bool, error) {
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(checkResourceGroupFormat, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUPS, util.EscapeSQLString(name))
+	if err := bh.Exec(ctx, sql); err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+func handleCreateResourceGroup(ses FeSession, execCtx *ExecCtx, crg *tree.CreateResourceGroup) error {
+	return doCreateResourceGroup(execCtx.reqCtx, ses.(*Session), crg)
+}
+
+func handleAlterResourceGroup(ses FeSession, execCtx *ExecCtx, arg *tree.AlterResourceGroup) error {
+	return doAlterResourceGroup(execCtx.reqCtx, ses.(*Session), arg)
+}
+
+func handleDropResourceGroup(ses FeSession, execCtx *ExecCtx, drg *tree.DropResourceGroup) error {
+	return doDropResourceGroup(execCtx.reqCtx, ses.(*Session), drg)
+}
+
+func doCreateResourceGroup(ctx context.Context, ses *Session, crg *tree.CreateResourceGroup) (err error) {
+	if err = checkResourceGroupTenant(ctx, ses); err != nil {
+		return err
+	}
+	if err = validateResourceGroupOptions(ctx, &crg.Options); err != nil {
+		return err
+	}
+	cpuShare := int64(defaultResourceGroupCPUShare)
+	if crg.Options.HasCPUShare {
+		cpuShare = crg.Options.CPUShare
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	exists, err := resourceGroupExists(ctx, bh, crg.Name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if crg.IfNotExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "resource group %s already exists", crg.Name)
+	}
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+	sql := fmt.Sprintf(insertResourceGroupFormat, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUPS,
+		util.EscapeSQLString(crg.Name), cpuShare, crg.Options.MaxConcurrency, crg.Options.MemoryBudget, now, now)
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	invalidateCachedResourceGroups()
+	return nil
+}
+
+func doAlterResourceGroup(ctx context.Context, ses *Session, arg *tree.AlterResourceGroup) (err error) {
+	if err = checkResourceGroupTenant(ctx, ses); err != nil {
+		return err
+	}
+	if err = validateResourceGroupOptions(ctx, &arg.Options); err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	exists, err := resourceGroupExists(ctx, bh, arg.Name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return moerr.NewInvalidInput(ctx, "resource group %s does not exist", arg.Name)
+	}
+
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+	name := util.EscapeSQLString(arg.Name)
+	var sql string
+	switch arg.Action {
+	case tree.ResourceGroupSetOptions:
+		var sets []string
+		if arg.Options.HasCPUShare {
+			sets = append(sets, fmt.Sprintf("cpu_share = %d", arg.Options.CPUShare))
+		}
+		if arg.Options.HasMaxConcurrency {
+			sets = append(sets, fmt.Sprintf("max_concurrency = %d", arg.Options.MaxConcurrency))
+		}
+		if arg.Options.HasMemoryBudget {
+			sets = append(sets, fmt.Sprintf("memory_budget = %d", arg.Options.MemoryBudget))
+		}
+		sql = fmt.Sprintf(updateResourceGroupFormat, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUPS,
+			strings.Join(sets, ", "), now, name)
+	case tree.ResourceGroupAddBinding:
+		if err = checkResourceGroupAccount(ctx, bh, arg.Account); err != nil {
+			return err
+		}
+		account, user := util.EscapeSQLString(arg.Account), util.EscapeSQLString(arg.User)
+		sql = fmt.Sprintf(upsertResourceGroupBindingFormat, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUP_BINDINGS,
+			account, user, name, now, name, now)
+	case tree.ResourceGroupDropBinding:
+		sql = fmt.Sprintf(deleteResourceGroupBindingFormat, catalog.MO_CATALOG, catalog.MO_RESOURCE_GROUP_BINDINGS,
+			util.EscapeSQLString(arg.Account), util.EscapeSQLString(arg.User), name)
+	}
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	invalidateCachedResourceGroups()
+	return nil
+}
+
+// checkResourceGroupAccount checks the account bound to a group exists.
+func checkResourceGroupAccount(ctx context.Context, bh BackgroundExec, account string) error {
+	sql, err := getSqlForCheckTenant(ctx, account)
+	if err != nil {
+		return err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInvalidInput(ctx, "account %s does not exist", account)
+	}
+	return nil
+}
+
+func doDropResourceGroup(ctx context.Context, ses *Session, drg *tree.DropResourceGroup) (err error) {
+	if err = checkResourceGroupTenant(ctx, ses); err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	exists, err := resourceGroupExists(ctx, bh, drg.Name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if drg.IfExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "resource group %s does not exist", drg.Name)
+	}
+	// the accounts and the users bound to the group run without limits
+	for _, table := range []string{catalog.MO_RESOURCE_GROUP_BINDINGS, catalog.MO_RESOURCE_GROUPS} {
+		if err = bh.Exec(ctx, fmt.Sprintf(deleteResourceGroupFormat, catalog.MO_CATALOG, table, util.EscapeSQLString(drg.Name))); err != nil {
+			return err
+		}
+	}
+	invalidateCachedResourceGroups()
+	return nil
+}
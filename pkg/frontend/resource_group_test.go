@@ -0,0 +1,150 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// mockLoadResourceGroups stubs loading the resource groups for the tests
+// running statements with mocked transactions.
+func mockLoadResourceGroups() *gostub.Stubs {
+	return gostub.Stub(&loadResourceGroups, func(context.Context, *Session) ([]*resourceGroup, map[string]string, error) {
+		return nil, make(map[string]string), nil
+	})
+}
+
+func Test_resourceGroupCache(t *testing.T) {
+	defer setCachedResourceGroups(nil, make(map[string]string))
+
+	g1 := newResourceGroup("g1", 50, 2, 0)
+	g2 := newResourceGroup("g2", 100, 0, 1024)
+	setCachedResourceGroups([]*resourceGroup{g1, g2}, map[string]string{
+		resourceGroupBindingKey("acc1", ""):   "g1",
+		resourceGroupBindingKey("acc1", "u2"): "g2",
+	})
+
+	// the binding of the user takes precedence over the one of its account
+	g, ok := getCachedResourceGroup("acc1", "u1")
+	require.True(t, ok)
+	require.Same(t, g1, g)
+	g, _ = getCachedResourceGroup("acc1", "u2")
+	require.Same(t, g2, g)
+	g, ok = getCachedResourceGroup("acc2", "u1")
+	require.True(t, ok)
+	require.Nil(t, g)
+	require.Nil(t, g1.budget)
+	require.Nil(t, g2.slots)
+
+	// the group loaded again with the same options is kept
+	setCachedResourceGroups([]*resourceGroup{newResourceGroup("g1", 50, 2, 0), newResourceGroup("g2", 100, 0, 2048)},
+		map[string]string{resourceGroupBindingKey("acc1", ""): "g2"})
+	g, _ = getCachedResourceGroup("acc1", "u1")
+	require.NotSame(t, g2, g)
+	require.Equal(t, int64(2048), g.memoryBudget)
+	require.Same(t, g1, resourceGroupCache.groups["g1"])
+
+	invalidateCachedResourceGroups()
+	_, ok = getCachedResourceGroup("acc1", "u1")
+	require.False(t, ok)
+}
+
+func Test_resourceGroupAcquire(t *testing.T) {
+	g := newResourceGroup("g", 100, 1, 0)
+	require.NoError(t, g.acquire(context.TODO(), time.Minute))
+
+	// the second statement waits until the first one releases its slot
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, g.acquire(ctx, time.Minute), context.DeadlineExceeded)
+	// or until the timeout
+	err := g.acquire(context.TODO(), 10*time.Millisecond)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInternal), err)
+
+	g.release()
+	require.NoError(t, g.acquire(context.TODO(), time.Minute))
+	g.release()
+
+	// the concurrency of the group without max_concurrency is not limited
+	g = newResourceGroup("g", 100, 0, 0)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, g.acquire(context.TODO(), time.Minute))
+	}
+}
+
+func Test_txnHoldsLocks(t *testing.T) {
+	ses := &Session{feSessionImpl: feSessionImpl{txnHandler: &TxnHandler{}}}
+	require.False(t, txnHoldsLocks(ses))
+
+	// the statements of the transaction holding locks do not wait for slots
+	ctrl := gomock.NewController(t)
+	txnOp := mock_frontend.NewMockTxnOperator(ctrl)
+	ses.txnHandler.txnOp = txnOp
+	txnOp.EXPECT().LockTableCount().Return(int32(0))
+	require.False(t, txnHoldsLocks(ses))
+	txnOp.EXPECT().LockTableCount().Return(int32(1))
+	require.True(t, txnHoldsLocks(ses))
+}
+
+func Test_resourceGroupExempt(t *testing.T) {
+	for _, stmt := range []tree.Statement{
+		&tree.CommitTransaction{}, &tree.RollbackTransaction{}, &tree.BeginTransaction{},
+		&tree.Kill{}, &tree.SetVar{}, &tree.ShowVariables{}, &tree.ShowProcessList{}, &tree.ShowTables{},
+	} {
+		require.True(t, resourceGroupExempt(stmt), stmt.GetStatementType())
+	}
+	for _, stmt := range []tree.Statement{&tree.Select{}, &tree.Insert{}, &tree.Update{}, &tree.Delete{}} {
+		require.False(t, resourceGroupExempt(stmt), stmt.GetStatementType())
+	}
+}
+
+func Test_resourceGroupLastLoaded(t *testing.T) {
+	defer setCachedResourceGroups(nil, make(map[string]string))
+
+	g1 := newResourceGroup("g1", 50, 2, 0)
+	setCachedResourceGroups([]*resourceGroup{g1}, map[string]string{
+		resourceGroupBindingKey("acc1", ""): "g1",
+	})
+	// the groups expired are still used if they can not be loaded again
+	invalidateCachedResourceGroups()
+	_, ok := getCachedResourceGroup("acc1", "u1")
+	require.False(t, ok)
+	require.Same(t, g1, getLastResourceGroup("acc1", "u1"))
+	require.Nil(t, getLastResourceGroup("acc2", "u1"))
+
+	// the budget is shared with the pipelines sent from the other CNs
+	g2 := newResourceGroup("g2", 100, 0, 1024)
+	require.Same(t, mpool.GroupQuota("g2", 1024), g2.budget)
+}
+
+func Test_validateResourceGroupOptions(t *testing.T) {
+	ctx := context.TODO()
+	require.NoError(t, validateResourceGroupOptions(ctx, &tree.ResourceGroupOptions{}))
+	require.NoError(t, validateResourceGroupOptions(ctx, &tree.ResourceGroupOptions{HasCPUShare: true, CPUShare: 100}))
+	require.Error(t, validateResourceGroupOptions(ctx, &tree.ResourceGroupOptions{HasCPUShare: true, CPUShare: 0}))
+	require.Error(t, validateResourceGroupOptions(ctx, &tree.ResourceGroupOptions{HasCPUShare: true, CPUShare: 101}))
+	require.Error(t, validateResourceGroupOptions(ctx, &tree.ResourceGroupOptions{HasMaxConcurrency: true, MaxConcurrency: -1}))
+}
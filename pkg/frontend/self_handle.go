@@ -160,7 +160,8 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 	case *tree.ShowErrors, *tree.ShowWarnings:
 		ses.EnterFPrint(25)
 		defer ses.ExitFPrint(25)
-		err = handleShowErrors(ses, execCtx)
+		_, onlyErrors := st.(*tree.ShowErrors)
+		err = handleShowErrors(ses, execCtx, onlyErrors)
 		if err != nil {
 			return
 		}
@@ -326,6 +327,72 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		if err = handleCallProcedure(ses, execCtx, st); err != nil {
 			return
 		}
+	case *tree.CreateTrigger:
+		ses.EnterFPrint(120)
+		defer ses.ExitFPrint(120)
+		if err = handleCreateTrigger(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropTrigger:
+		ses.EnterFPrint(121)
+		defer ses.ExitFPrint(121)
+		if err = handleDropTrigger(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.CreateEvent:
+		ses.EnterFPrint(122)
+		defer ses.ExitFPrint(122)
+		if err = handleCreateEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.AlterEvent:
+		ses.EnterFPrint(123)
+		defer ses.ExitFPrint(123)
+		if err = handleAlterEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropEvent:
+		ses.EnterFPrint(124)
+		defer ses.ExitFPrint(124)
+		if err = handleDropEvent(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.CreatePlanBaseline:
+		ses.EnterFPrint(125)
+		defer ses.ExitFPrint(125)
+		if err = handleCreatePlanBaseline(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.AlterPlanBaseline:
+		ses.EnterFPrint(126)
+		defer ses.ExitFPrint(126)
+		if err = handleAlterPlanBaseline(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropPlanBaseline:
+		ses.EnterFPrint(127)
+		defer ses.ExitFPrint(127)
+		if err = handleDropPlanBaseline(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.CreateResourceGroup:
+		ses.EnterFPrint(128)
+		defer ses.ExitFPrint(128)
+		if err = handleCreateResourceGroup(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.AlterResourceGroup:
+		ses.EnterFPrint(129)
+		defer ses.ExitFPrint(129)
+		if err = handleAlterResourceGroup(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropResourceGroup:
+		ses.EnterFPrint(130)
+		defer ses.ExitFPrint(130)
+		if err = handleDropResourceGroup(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.Grant:
 		ses.EnterFPrint(50)
 		defer ses.ExitFPrint(50)
@@ -456,18 +456,30 @@ func (ses *Session) getQueryId(internalSql bool) []string {
 }
 
 type errInfo struct {
-	codes  []uint16
-	msgs   []string
-	maxCnt int
+	codes    []uint16
+	msgs     []string
+	warnings []bool
+	maxCnt   int
 }
 
 func (e *errInfo) push(code uint16, msg string) {
+	e.pushWithLevel(code, msg, false)
+}
+
+// pushWarning records a warning shown by SHOW WARNINGS, but not SHOW ERRORS.
+func (e *errInfo) pushWarning(code uint16, msg string) {
+	e.pushWithLevel(code, msg, true)
+}
+
+func (e *errInfo) pushWithLevel(code uint16, msg string, warning bool) {
 	if e.maxCnt > 0 && len(e.codes) > e.maxCnt {
 		e.codes = e.codes[1:]
 		e.msgs = e.msgs[1:]
+		e.warnings = e.warnings[1:]
 	}
 	e.codes = append(e.codes, code)
 	e.msgs = append(e.msgs, msg)
+	e.warnings = append(e.warnings, warning)
 }
 
 func (e *errInfo) length() int {
@@ -492,9 +504,10 @@ func NewSession(connCtx context.Context, proto MysqlRrWr, mp *mpool.MPool) *Sess
 			respr:          NewMysqlResp(proto),
 		},
 		errInfo: &errInfo{
-			codes:  make([]uint16, 0, MoDefaultErrorCount),
-			msgs:   make([]string, 0, MoDefaultErrorCount),
-			maxCnt: MoDefaultErrorCount,
+			codes:    make([]uint16, 0, MoDefaultErrorCount),
+			msgs:     make([]string, 0, MoDefaultErrorCount),
+			warnings: make([]bool, 0, MoDefaultErrorCount),
+			maxCnt:   MoDefaultErrorCount,
 		},
 		cache:     &privilegeCache{},
 		blockIdx:  0,
@@ -1454,6 +1467,7 @@ func (ses *Session) StatusSession() *status.Session {
 		Role:          roleName,
 		FromProxy:     ses.fromProxy,
 		ProxyHost:     ses.proxyAddr,
+		QueueWaitTime: ses.GetQueueWait().Milliseconds(),
 	}
 }
 
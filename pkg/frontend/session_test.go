@@ -578,3 +578,14 @@ func TestSession_Migrate(t *testing.T) {
 	assert.Equal(t, "d1", s.GetDatabaseName())
 	assert.Equal(t, 2, len(s.prepareStmts))
 }
+
+func TestErrInfoWarnings(t *testing.T) {
+	info := &errInfo{maxCnt: 2}
+	info.push(moerr.ER_PARSE_ERROR, "e1")
+	info.pushWarning(moerr.ER_PARSE_ERROR, "w1")
+	info.push(moerr.ER_PARSE_ERROR, "e2")
+	info.pushWarning(moerr.ER_PARSE_ERROR, "w2")
+	assert.Equal(t, 3, info.length())
+	assert.Equal(t, []string{"w1", "e2", "w2"}, info.msgs)
+	assert.Equal(t, []bool{true, false, true}, info.warnings)
+}
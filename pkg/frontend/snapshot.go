@@ -104,7 +104,18 @@ var (
 		"mo_transactions":   1,
 		"mo_cache":          1,
 
-		"mo_snapshots": 1,
+		"mo_snapshots":         1,
+		"mo_mviews":            0,
+		"mo_triggers":          0,
+		"mo_events":            0,
+		"mo_event_history":     1,
+		"mo_online_ddl":        1,
+		"mo_column_histograms": 1,
+		"mo_table_analyze":     1,
+		"mo_plan_baselines":    1,
+
+		"mo_resource_groups":         1,
+		"mo_resource_group_bindings": 1,
 	}
 )
 
@@ -98,6 +98,13 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 			ses.Infof(execCtx.reqCtx, "time of Exec.Run : %s", time.Since(runBegin).String())
 		}
 
+		// Start the refresh task of the materialized view
+		if st.MaterializedView != nil {
+			if err = handleCreateMaterializedView(execCtx.reqCtx, ses, execCtx.cw.Plan().GetDdl().GetCreateTable()); err != nil {
+				return
+			}
+		}
+
 		// execute insert sql if this is a `create table as select` stmt
 		if st.IsAsSelect {
 			insertSql := execCtx.cw.Plan().GetDdl().GetDefinition().(*plan.DataDefinition_CreateTable).CreateTable.CreateAsSelectSql
@@ -257,6 +264,9 @@ func (resper *MysqlResp) respStatus(ses *Session,
 		case *tree.DropTable:
 			// handle dynamic table drop, cancel all the running daemon task
 			_ = handleDropDynamicTable(execCtx.reqCtx, ses, st)
+			if execCtx.cw != nil {
+				_ = handleDropMaterializedView(execCtx.reqCtx, ses, execCtx.cw.Plan().GetDdl().GetDropTable())
+			}
 			_ = doRevokePrivilegeImplicitly(execCtx.reqCtx, ses, st)
 		case *tree.CreateDatabase:
 			_ = insertRecordToMoMysqlCompatibilityMode(execCtx.reqCtx, ses, execCtx.stmt)
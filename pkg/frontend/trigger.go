@@ -0,0 +1,1226 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	sqlutil "github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	getTriggerFormat = `select table_name, action_timing, event_manipulation from mo_catalog.mo_triggers where database_name = '%s' and trigger_name = '%s';`
+
+	getTriggerNamesFormat = `select trigger_name from mo_catalog.mo_triggers where database_name = '%s' and table_name = '%s' and action_timing = '%s' and event_manipulation = '%s' order by action_order;`
+
+	getTableTriggersFormat = `select event_manipulation, action_timing, action_statement from mo_catalog.mo_triggers where database_name = '%s' and table_name = '%s' order by action_order;`
+
+	insertMoTriggerFormat = `insert into mo_catalog.mo_triggers(
+		database_name,
+		table_name,
+		trigger_name,
+		action_timing,
+		event_manipulation,
+		action_order,
+		action_statement,
+		created_time) values ('%s', '%s', '%s', '%s', '%s', %d, '%s', '%s');`
+
+	updateMoTriggerOrderFormat = `update mo_catalog.mo_triggers set action_order = %d where database_name = '%s' and table_name = '%s' and trigger_name = '%s';`
+
+	deleteMoTriggerFormat = `delete from mo_catalog.mo_triggers where database_name = '%s' and trigger_name = '%s';`
+)
+
+const (
+	triggerNewPrefix = "new."
+	triggerOldPrefix = "old."
+
+	// triggerCacheTTL is how long the triggers loaded are used before loading
+	// them again, so the triggers created or dropped on other CNs are seen after it.
+	triggerCacheTTL = time.Minute
+	// triggerCacheMaxSize is the max number of the tables in the cache.
+	triggerCacheMaxSize = 8192
+)
+
+// cachedTrigger is a trigger of the table in mo_triggers.
+type cachedTrigger struct {
+	event  string
+	timing string
+	body   string
+}
+
+type triggerCacheEntry struct {
+	triggers       []cachedTrigger
+	catalogVersion uint64
+	loadTime       time.Time
+}
+
+// triggerCache caches the triggers of the tables written in this CN, including
+// the tables without triggers, so the DML does not query mo_triggers. The
+// entries are dropped by the DDL of the account applied from the logtail, and
+// by CREATE TRIGGER and DROP TRIGGER in this CN.
+var triggerCache = struct {
+	sync.Mutex
+	entries map[string]*triggerCacheEntry
+}{entries: make(map[string]*triggerCacheEntry)}
+
+func triggerCacheKey(accountID uint32, dbName, tblName string) string {
+	return fmt.Sprintf("%d/%s/%s", accountID, dbName, tblName)
+}
+
+func getCachedTriggers(key string, catalogVersion uint64) ([]cachedTrigger, bool) {
+	triggerCache.Lock()
+	defer triggerCache.Unlock()
+	entry, ok := triggerCache.entries[key]
+	if !ok || entry.catalogVersion != catalogVersion || time.Since(entry.loadTime) > triggerCacheTTL {
+		return nil, false
+	}
+	return entry.triggers, true
+}
+
+func setCachedTriggers(key string, catalogVersion uint64, triggers []cachedTrigger) {
+	triggerCache.Lock()
+	defer triggerCache.Unlock()
+	if len(triggerCache.entries) >= triggerCacheMaxSize {
+		triggerCache.entries = make(map[string]*triggerCacheEntry)
+	}
+	triggerCache.entries[key] = &triggerCacheEntry{
+		triggers:       triggers,
+		catalogVersion: catalogVersion,
+		loadTime:       time.Now(),
+	}
+}
+
+func invalidateCachedTriggers(key string) {
+	triggerCache.Lock()
+	defer triggerCache.Unlock()
+	delete(triggerCache.entries, key)
+}
+
+// triggerContext is the row trigger executed by the Interpreter.
+type triggerContext struct {
+	triggers *tableTriggers
+	// row holds the NEW and OLD pseudo-rows as "new.col" and "old.col"
+	row map[string]interface{}
+	// assigned records the columns of NEW set by the statement and the BEFORE triggers
+	assigned map[string]bool
+}
+
+func handleCreateTrigger(ses FeSession, execCtx *ExecCtx, ct *tree.CreateTrigger) error {
+	return doCreateTrigger(execCtx.reqCtx, ses.(*Session), ct)
+}
+
+func handleDropTrigger(ses FeSession, execCtx *ExecCtx, dt *tree.DropTrigger) error {
+	return doDropTrigger(execCtx.reqCtx, ses.(*Session), dt)
+}
+
+func doCreateTrigger(ctx context.Context, ses *Session, ct *tree.CreateTrigger) (err error) {
+	var erArray []ExecResult
+
+	// the trigger lives in the database of its table
+	dbName := string(ct.Name.SchemaName)
+	if dbName == "" {
+		dbName = string(ct.Table.SchemaName)
+	}
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return moerr.NewNoDBNoCtx()
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	if ct.Table.SchemaName != "" && string(ct.Table.SchemaName) != dbName {
+		return moerr.NewInvalidInput(ctx, "trigger in wrong schema")
+	}
+	triggerName := string(ct.Name.ObjectName)
+	tblName := string(ct.Table.ObjectName)
+
+	if _, ok := sysDatabases[dbName]; ok {
+		return moerr.NewNotSupported(ctx, "create trigger on the table in database %s", dbName)
+	}
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tblName, plan.Snapshot{TS: &timestamp.Timestamp{}})
+	if tableDef == nil {
+		return moerr.NewNoSuchTable(ctx, dbName, tblName)
+	}
+	if err = checkTriggerTable(ctx, dbName, tableDef); err != nil {
+		return err
+	}
+	if err = checkTriggerBody(ctx, ct.Body, ct.Timing, ct.Event); err != nil {
+		return err
+	}
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	ct.Body.Format(fmtctx)
+	body := fmtctx.String()
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	defer func() {
+		if err == nil {
+			invalidateCachedTriggers(triggerCacheKey(ses.GetAccountId(), dbName, tblName))
+		}
+	}()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	// the trigger name is unique in the database
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getTriggerFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(triggerName))); err != nil {
+		return err
+	}
+	if erArray, err = getResultSet(ctx, bh); err != nil {
+		return err
+	}
+	if execResultArrayHasData(erArray) {
+		if ct.IfNotExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "trigger %s already exists", triggerName)
+	}
+
+	timing, event := ct.Timing.String(), ct.Event.String()
+	names, err := getTriggerNames(ctx, bh, dbName, tblName, timing, event)
+	if err != nil {
+		return err
+	}
+	pos := len(names)
+	if ct.Order != nil {
+		if pos = slices.Index(names, string(ct.Order.Name)); pos < 0 {
+			return moerr.NewInvalidInput(ctx, "referenced trigger '%s' for the given action time and event type does not exist", ct.Order.Name)
+		}
+		if ct.Order.Follows {
+			pos++
+		}
+	}
+	names = slices.Insert(names, pos, triggerName)
+
+	sql := fmt.Sprintf(insertMoTriggerFormat,
+		util.EscapeSQLString(dbName), util.EscapeSQLString(tblName), util.EscapeSQLString(triggerName),
+		timing, event, pos+1,
+		util.EscapeSQLString(body),
+		types.CurrentTimestamp().String2(time.UTC, 0))
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	// the triggers after the new one move backward
+	return reorderTriggers(ctx, bh, dbName, tblName, names[pos+1:], pos+2)
+}
+
+func doDropTrigger(ctx context.Context, ses *Session, dt *tree.DropTrigger) (err error) {
+	var erArray []ExecResult
+
+	dbName := string(dt.Name.SchemaName)
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return moerr.NewNoDBNoCtx()
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	triggerName := string(dt.Name.ObjectName)
+	var tblName string
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	defer func() {
+		if err == nil && tblName != "" {
+			invalidateCachedTriggers(triggerCacheKey(ses.GetAccountId(), dbName, tblName))
+		}
+	}()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getTriggerFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(triggerName))); err != nil {
+		return err
+	}
+	if erArray, err = getResultSet(ctx, bh); err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		if dt.IfExists {
+			return nil
+		}
+		return moerr.NewInvalidInput(ctx, "trigger %s does not exist", triggerName)
+	}
+	if tblName, err = erArray[0].GetString(ctx, 0, 0); err != nil {
+		return err
+	}
+	timing, err := erArray[0].GetString(ctx, 0, 1)
+	if err != nil {
+		return err
+	}
+	event, err := erArray[0].GetString(ctx, 0, 2)
+	if err != nil {
+		return err
+	}
+
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteMoTriggerFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(triggerName))); err != nil {
+		return err
+	}
+	names, err := getTriggerNames(ctx, bh, dbName, tblName, timing, event)
+	if err != nil {
+		return err
+	}
+	return reorderTriggers(ctx, bh, dbName, tblName, names, 1)
+}
+
+// checkTriggerTable rejects the tables which can not have triggers. The rows
+// of the statements firing the triggers are changed one by one by the primary
+// key, so the table must have one.
+func checkTriggerTable(ctx context.Context, dbName string, tableDef *plan.TableDef) error {
+	if tableDef.TableType != catalog.SystemOrdinaryRel || tableDef.IsTemporary {
+		return moerr.NewNotSupported(ctx, "create trigger on %s.%s which is not a base table", dbName, tableDef.Name)
+	}
+	if tableDef.Pkey == nil || len(tableDef.Pkey.Names) == 0 || tableDef.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+		return moerr.NewNotSupported(ctx, "create trigger on %s.%s without primary key", dbName, tableDef.Name)
+	}
+	return nil
+}
+
+// checkTriggerBody rejects the statements which can not run in the trigger,
+// and the assignments of the pseudo-rows which are read only.
+func checkTriggerBody(ctx context.Context, stmt tree.Statement, timing tree.TriggerTiming, event tree.TriggerEvent) error {
+	checkList := func(stmts []tree.Statement) error {
+		for _, st := range stmts {
+			if st == nil {
+				continue
+			}
+			if err := checkTriggerBody(ctx, st, timing, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch st := stmt.(type) {
+	case *tree.CompoundStmt:
+		return checkList(st.Stmts)
+	case *tree.IfStmt:
+		if err := checkList(st.Body); err != nil {
+			return err
+		}
+		for _, elif := range st.Elifs {
+			if err := checkList(elif.Body); err != nil {
+				return err
+			}
+		}
+		return checkList(st.Else)
+	case *tree.ElseIfStmt:
+		return checkList(st.Body)
+	case *tree.CaseStmt:
+		for _, when := range st.Whens {
+			if err := checkList(when.Body); err != nil {
+				return err
+			}
+		}
+		return checkList(st.Else)
+	case *tree.WhenStmt:
+		return checkList(st.Body)
+	case *tree.WhileStmt:
+		return checkList(st.Body)
+	case *tree.RepeatStmt:
+		return checkList(st.Body)
+	case *tree.LoopStmt:
+		return checkList(st.Body)
+	case *tree.DeclareHandler:
+		return checkTriggerBody(ctx, st.Body, timing, event)
+	case *tree.SetVar:
+		for _, assign := range st.Assignments {
+			name := strings.ToLower(assign.Name)
+			switch {
+			case strings.HasPrefix(name, triggerOldPrefix):
+				return moerr.NewInvalidInput(ctx, "updating of OLD row is not allowed in trigger")
+			case strings.HasPrefix(name, triggerNewPrefix) && event == tree.TriggerDelete:
+				return moerr.NewInvalidInput(ctx, "there is no NEW row in on DELETE trigger")
+			case strings.HasPrefix(name, triggerNewPrefix) && timing == tree.TriggerAfter:
+				return moerr.NewInvalidInput(ctx, "updating of NEW row is not allowed in after trigger")
+			}
+		}
+		return nil
+	}
+
+	switch stmt.GetQueryType() {
+	case tree.QueryTypeDQL:
+		return moerr.NewNotSupported(ctx, "return a result set from a trigger")
+	case tree.QueryTypeDDL, tree.QueryTypeDCL, tree.QueryTypeTCL:
+		return moerr.NewNotSupported(ctx, "statement '%s' in a trigger", stmt.GetStatementType())
+	}
+	return nil
+}
+
+// getTriggerNames returns the names of the triggers of the table for the timing
+// and event in the action order.
+func getTriggerNames(ctx context.Context, bh BackgroundExec, dbName, tblName, timing, event string) ([]string, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getTriggerNamesFormat,
+		util.EscapeSQLString(dbName), util.EscapeSQLString(tblName), timing, event)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			name, err := erArray[0].GetString(ctx, i, 0)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// reorderTriggers sets the action order of the triggers starting from the order.
+func reorderTriggers(ctx context.Context, bh BackgroundExec, dbName, tblName string, names []string, order int) error {
+	for i, name := range names {
+		sql := fmt.Sprintf(updateMoTriggerOrderFormat, order+i,
+			util.EscapeSQLString(dbName), util.EscapeSQLString(tblName), util.EscapeSQLString(name))
+		if err := bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tableTriggers are the row triggers of the table fired by a statement.
+type tableTriggers struct {
+	dbName   string
+	tableDef *plan.TableDef
+	event    tree.TriggerEvent
+	before   []tree.Statement
+	after    []tree.Statement
+	// checked are the statements of the bodies the invoker is allowed to execute
+	checked map[tree.Statement]bool
+}
+
+// loadTableTriggers returns the triggers of the table in the action order,
+// they are loaded from mo_triggers if they are not cached.
+func loadTableTriggers(ctx context.Context, ses *Session, dbName, tblName string) ([]cachedTrigger, error) {
+	key := triggerCacheKey(ses.GetAccountId(), dbName, tblName)
+	catalogVersion := getCatalogVersion(ses, ses.GetAccountId())
+	if triggers, ok := getCachedTriggers(key, catalogVersion); ok {
+		return triggers, nil
+	}
+
+	bh := ses.GetShareTxnBackgroundExec(ctx, false)
+	defer bh.Close()
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getTableTriggersFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(tblName))); err != nil {
+		// mo_triggers does not exist before the tenant is upgraded
+		if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	var triggers []cachedTrigger
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			var trigger cachedTrigger
+			if trigger.event, err = erArray[0].GetString(ctx, i, 0); err != nil {
+				return nil, err
+			}
+			if trigger.timing, err = erArray[0].GetString(ctx, i, 1); err != nil {
+				return nil, err
+			}
+			if trigger.body, err = erArray[0].GetString(ctx, i, 2); err != nil {
+				return nil, err
+			}
+			triggers = append(triggers, trigger)
+		}
+	}
+	setCachedTriggers(key, catalogVersion, triggers)
+	return triggers, nil
+}
+
+// hasTriggers reports whether the triggers have some for the event.
+func hasTriggers(triggers []cachedTrigger, event tree.TriggerEvent) bool {
+	return slices.ContainsFunc(triggers, func(trigger cachedTrigger) bool {
+		return trigger.event == event.String()
+	})
+}
+
+// getTableTriggers returns the triggers of the table for the event, nil if there is none.
+func getTableTriggers(ctx context.Context, dbName string, triggers []cachedTrigger, event tree.TriggerEvent) (*tableTriggers, error) {
+	if !hasTriggers(triggers, event) {
+		return nil, nil
+	}
+	tt := &tableTriggers{
+		dbName: dbName,
+		event:  event,
+	}
+	for _, trigger := range triggers {
+		if trigger.event != event.String() {
+			continue
+		}
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, trigger.body, 1)
+		if err != nil {
+			tt.Free()
+			return nil, err
+		}
+		if trigger.timing == tree.TriggerBefore.String() {
+			tt.before = append(tt.before, stmt)
+		} else {
+			tt.after = append(tt.after, stmt)
+		}
+	}
+	return tt, nil
+}
+
+func (tt *tableTriggers) Free() {
+	for _, stmt := range tt.before {
+		stmt.Free()
+	}
+	for _, stmt := range tt.after {
+		stmt.Free()
+	}
+}
+
+// useDatabase makes the database of the table the current one of the background
+// executor, the unqualified names in the trigger bodies are in it.
+func (tt *tableTriggers) useDatabase(ctx context.Context, bh BackgroundExec) error {
+	return bh.Exec(ctx, fmt.Sprintf("use `%s`", util.EscapeSQLIdent(tt.dbName)))
+}
+
+// checkPrivilege checks the invoker of the triggering statement can execute the
+// statement of the trigger body, which is planned in the database of the table with
+// the pseudo-rows in the variables of ctx. Each statement is checked once.
+func (tt *tableTriggers) checkPrivilege(ctx context.Context, ses *Session, stmt tree.Statement) error {
+	if ses.GetTenantInfo() == nil || tt.checked[stmt] {
+		return nil
+	}
+	if err := authenticateUserCanExecuteStatement(ctx, ses, stmt); err != nil {
+		return err
+	}
+	if stmt.GetQueryType() == tree.QueryTypeDML {
+		tcc := ses.GetTxnCompileCtx()
+		oldDB, oldExecCtx := tcc.DefaultDatabase(), tcc.execCtx
+		execCtx := *oldExecCtx
+		execCtx.reqCtx = ctx
+		tcc.SetDatabase(tt.dbName)
+		tcc.SetExecCtx(&execCtx)
+		p, err := plan2.BuildPlan(tcc, stmt, false)
+		tcc.SetDatabase(oldDB)
+		tcc.SetExecCtx(oldExecCtx)
+		if err != nil {
+			return err
+		}
+		if err = authenticateCanExecuteStatementAndPlan(ctx, ses, stmt, p); err != nil {
+			return err
+		}
+	}
+	if tt.checked == nil {
+		tt.checked = make(map[tree.Statement]bool)
+	}
+	tt.checked[stmt] = true
+	return nil
+}
+
+// fire runs the triggers of the timing for one row.
+func (tt *tableTriggers) fire(ctx context.Context, ses *Session, bh BackgroundExec,
+	timing tree.TriggerTiming, row map[string]interface{}, assigned map[string]bool) error {
+	stmts := tt.before
+	if timing == tree.TriggerAfter {
+		stmts = tt.after
+	}
+	for _, stmt := range stmts {
+		var varScope []map[string]interface{}
+		interpreter := Interpreter{
+			ctx:         ctx,
+			ses:         ses,
+			bh:          bh,
+			varScope:    &varScope,
+			fmtctx:      tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true)),
+			outParamMap: make(map[string]interface{}),
+			trigger: &triggerContext{
+				triggers: tt,
+				row:      row,
+				assigned: assigned,
+			},
+		}
+		if err := interpreter.ExecuteTrigger(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeStmtWithTriggers executes the INSERT, UPDATE or DELETE statement row by row
+// in the shared transaction if the table has row triggers for the event, and reports
+// whether the statement is executed. The prepared statements are executed with their
+// parameters as the values.
+//
+// Only the single table statements fire the triggers, and NEW does not hold the auto
+// increment values. LOAD, REPLACE and the statements with RETURNING clause on the tables
+// with triggers fail, and the statements of triggers do not fire triggers. The statements
+// of triggers run with the privileges of the invoker of the statement, no definer is kept.
+func executeStmtWithTriggers(ses *Session, execCtx *ExecCtx) (executed bool, err error) {
+	if ses.GetIsInternal() || execCtx.inMigration {
+		return false, nil
+	}
+	ctx := execCtx.reqCtx
+
+	stmt := execCtx.stmt
+	var prepareStmt *PrepareStmt
+	if st, ok := stmt.(*tree.Execute); ok {
+		if prepareStmt, err = ses.GetPrepareStmt(ctx, string(st.Name)); err != nil {
+			// let the normal execution report the missing statement
+			return false, nil
+		}
+		stmt = prepareStmt.PrepareStmt
+	}
+
+	tt, table, err := getStmtTriggers(ctx, ses, ses.GetDatabaseName(), stmt)
+	if err != nil || tt == nil {
+		return false, err
+	}
+	defer tt.Free()
+
+	// the statement is not compiled, so the privileges on its tables are checked
+	// by its plan here
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	if prepareStmt != nil {
+		if ses.GetTenantInfo() != nil {
+			if err = authenticateCanExecuteStatementAndPlan(ctx, ses, stmt, prepareStmt.PreparePlan.GetDcl().GetPrepare().GetPlan()); err != nil {
+				return false, err
+			}
+		}
+		values, err := getTriggerParamValues(ctx, execCtx.proc, execCtx.stmt.(*tree.Execute), prepareStmt)
+		if err != nil {
+			return false, err
+		}
+		fmtctx = tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true), tree.WithParamValues(values))
+	} else if _, err = buildPlan(ctx, ses, ses.GetTxnCompileCtx(), stmt); err != nil {
+		return false, err
+	}
+
+	bh := ses.GetShareTxnBackgroundExec(ctx, false)
+	defer bh.Close()
+	affected, err := runStmtWithTriggers(ctx, ses, bh, fmtctx, tt, table, stmt)
+	if err != nil {
+		return false, err
+	}
+	execCtx.runResult = &util.RunResult{AffectRows: affected}
+	return true, nil
+}
+
+// executeSpStmtWithTriggers executes the statement of the stored procedure row by row
+// in the transaction of the procedure if the table has row triggers for the event, and
+// reports whether the statement is executed. The unqualified names of the statement are
+// in the database of the procedure.
+func executeSpStmtWithTriggers(ctx context.Context, ses *Session, bh BackgroundExec, dbName string, stmt tree.Statement) (bool, error) {
+	tt, table, err := getStmtTriggers(ctx, ses, dbName, stmt)
+	if err != nil || tt == nil {
+		return false, err
+	}
+	defer tt.Free()
+
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	if _, err = runStmtWithTriggers(ctx, ses, bh, fmtctx, tt, table, stmt); err != nil {
+		return false, err
+	}
+	// the triggers run in the database of the table
+	if dbName != tt.dbName {
+		if err = bh.Exec(ctx, fmt.Sprintf("use `%s`", util.EscapeSQLIdent(dbName))); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// getStmtTriggers returns the triggers fired by the INSERT, UPDATE or DELETE statement
+// and its table, nil if it fires none. The statements which can not be executed row by
+// row on the tables with triggers fail.
+func getStmtTriggers(ctx context.Context, ses *Session, defaultDbName string, stmt tree.Statement) (*tableTriggers, *tree.AliasedTableExpr, error) {
+	var table *tree.AliasedTableExpr
+	var others []*tree.TableName
+	var event tree.TriggerEvent
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		if st.IsRestore || len(st.Accounts) > 0 {
+			return nil, nil, nil
+		}
+		if tbl, ok := st.Table.(*tree.TableName); ok {
+			table = &tree.AliasedTableExpr{Expr: tbl}
+		}
+		event = tree.TriggerInsert
+	case *tree.Load:
+		if len(st.Accounts) > 0 {
+			return nil, nil, nil
+		}
+		others = []*tree.TableName{st.Table}
+		event = tree.TriggerInsert
+	case *tree.Replace:
+		others = collectTableNames(tree.TableExprs{st.Table}, nil)
+	case *tree.Merge:
+		others = collectTableNames(tree.TableExprs{st.Table}, nil)
+	case *tree.Update:
+		if table = singleTargetTable(st.Tables); table == nil {
+			others = collectTableNames(st.Tables, nil)
+		}
+		event = tree.TriggerUpdate
+	case *tree.Delete:
+		if st.TableRefs == nil {
+			table = singleTargetTable(st.Tables)
+		} else {
+			others = collectTableNames(st.TableRefs, nil)
+		}
+		event = tree.TriggerDelete
+	default:
+		return nil, nil, nil
+	}
+	// the statements with RETURNING clause return their rows as one result set
+	if table != nil && stmt.StmtKind().OutputType() == tree.OUTPUT_RESULT_ROW {
+		others = collectTableNames(tree.TableExprs{table}, nil)
+		table = nil
+	}
+	if table == nil && len(others) == 0 {
+		return nil, nil, nil
+	}
+
+	// the statements which can not be executed row by row
+	for _, tbl := range others {
+		dbName := triggerDatabaseName(defaultDbName, tbl)
+		if dbName == "" {
+			continue
+		}
+		triggers, err := loadTableTriggers(ctx, ses, dbName, string(tbl.ObjectName))
+		if err != nil {
+			return nil, nil, err
+		}
+		events := []tree.TriggerEvent{event}
+		switch stmt.(type) {
+		case *tree.Replace:
+			events = []tree.TriggerEvent{tree.TriggerInsert, tree.TriggerDelete}
+		case *tree.Merge:
+			events = []tree.TriggerEvent{tree.TriggerInsert, tree.TriggerUpdate, tree.TriggerDelete}
+		}
+		for _, e := range events {
+			if hasTriggers(triggers, e) {
+				return nil, nil, moerr.NewNotSupported(ctx, "%s on table %s with triggers", stmt.GetStatementType(), tbl.ObjectName)
+			}
+		}
+	}
+	if table == nil {
+		return nil, nil, nil
+	}
+
+	tbl := table.Expr.(*tree.TableName)
+	dbName := triggerDatabaseName(defaultDbName, tbl)
+	if dbName == "" {
+		return nil, nil, nil
+	}
+	tblName := string(tbl.ObjectName)
+	triggers, err := loadTableTriggers(ctx, ses, dbName, tblName)
+	if err != nil {
+		return nil, nil, err
+	}
+	tt, err := getTableTriggers(ctx, dbName, triggers, event)
+	if err != nil || tt == nil {
+		return nil, nil, err
+	}
+
+	// let the normal execution report the missing table
+	if _, tt.tableDef = ses.GetTxnCompileCtx().Resolve(dbName, tblName, plan.Snapshot{TS: &timestamp.Timestamp{}}); tt.tableDef == nil {
+		tt.Free()
+		return nil, nil, nil
+	}
+	return tt, table, nil
+}
+
+// runStmtWithTriggers executes the statement row by row and fires the triggers of each
+// row, it returns the rows changed. The rows are fetched in the current database of the
+// background executor, and the triggers run in the database of the table.
+func runStmtWithTriggers(ctx context.Context, ses *Session, bh BackgroundExec, fmtctx *tree.FmtCtx,
+	tt *tableTriggers, table *tree.AliasedTableExpr, stmt tree.Statement) (uint64, error) {
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		return executeInsertWithTriggers(ctx, ses, bh, fmtctx, tt, st)
+	case *tree.Update:
+		return executeUpdateWithTriggers(ctx, ses, bh, fmtctx, tt, table, st)
+	case *tree.Delete:
+		return executeDeleteWithTriggers(ctx, ses, bh, fmtctx, tt, table, st)
+	}
+	return 0, nil
+}
+
+// getTriggerParamValues returns the parameters of the EXECUTE statement as the SQL literals,
+// they are read from the COM_STMT_EXECUTE packet or the variables in USING.
+func getTriggerParamValues(ctx context.Context, proc *process.Process, st *tree.Execute, prepareStmt *PrepareStmt) ([]string, error) {
+	numParams := len(prepareStmt.PreparePlan.GetDcl().GetPrepare().ParamTypes)
+	params := prepareStmt.params
+	if params == nil || params.Length() == 0 {
+		if len(st.Variables) != numParams {
+			return nil, moerr.NewInvalidInput(ctx, "Incorrect arguments to EXECUTE")
+		}
+		params = proc.GetVector(types.T_text.ToType())
+		defer params.Free(proc.Mp())
+		for _, v := range st.Variables {
+			val, err := proc.GetResolveVariableFunc()(v.Name, v.System, v.Global)
+			if err != nil {
+				return nil, err
+			}
+			if val == nil {
+				return nil, moerr.NewInvalidInput(ctx, "Incorrect arguments to EXECUTE")
+			}
+			if err = sqlutil.AppendAnyToStringVector(proc, val, params); err != nil {
+				return nil, err
+			}
+		}
+	} else if params.Length() != numParams {
+		return nil, moerr.NewInvalidInput(ctx, "Incorrect arguments to EXECUTE")
+	}
+
+	values := make([]string, params.Length())
+	for i := range values {
+		if params.IsNull(uint64(i)) {
+			values[i] = "null"
+		} else {
+			values[i] = fmt.Sprintf("'%s'", util.EscapeSQLString(params.GetStringAt(i)))
+		}
+	}
+	return values, nil
+}
+
+func executeInsertWithTriggers(ctx context.Context, ses *Session, bh BackgroundExec, fmtctx *tree.FmtCtx, tt *tableTriggers, st *tree.Insert) (uint64, error) {
+	if len(st.OnDuplicateUpdate) > 0 || len(st.PartitionNames) > 0 || st.Rows == nil {
+		return 0, moerr.NewNotSupported(ctx, "INSERT with ON DUPLICATE KEY UPDATE or PARTITION on table %s with triggers", tt.tableDef.Name)
+	}
+
+	cols := visibleColumns(tt.tableDef)
+	insertCols := cols
+	if len(st.Columns) > 0 {
+		insertCols = make([]*plan.ColDef, 0, len(st.Columns))
+		for _, name := range st.Columns {
+			col := findColumn(cols, string(name))
+			if col == nil {
+				return 0, moerr.NewBadFieldError(ctx, string(name), tt.tableDef.Name)
+			}
+			insertCols = append(insertCols, col)
+		}
+	}
+
+	// NEW holds the default values of the columns not inserted
+	defaults := make([]interface{}, len(cols))
+	var defaultExprs []string
+	var defaultPos []int
+	for i, col := range cols {
+		if col.Default != nil && col.Default.OriginString != "" && !col.Typ.AutoIncr {
+			defaultExprs = append(defaultExprs, col.Default.OriginString)
+			defaultPos = append(defaultPos, i)
+		}
+	}
+	if len(defaultExprs) > 0 {
+		rows, err := fetchTriggerRows(ctx, bh, "select "+strings.Join(defaultExprs, ", "))
+		if err != nil {
+			return 0, err
+		}
+		for i, pos := range defaultPos {
+			defaults[pos] = rows[0][i]
+		}
+	}
+
+	// the inserted values, nil in listed means the default value
+	var values [][]interface{}
+	var listed [][]bool
+	if clause, ok := st.Rows.Select.(*tree.ValuesClause); ok && st.Rows.With == nil {
+		for i, exprs := range clause.Rows {
+			if len(exprs) != len(insertCols) {
+				return 0, moerr.NewWrongValueCountOnRow(ctx, i+1)
+			}
+			row := make([]interface{}, len(insertCols))
+			set := make([]bool, len(insertCols))
+			var selected []string
+			var pos []int
+			for j, expr := range exprs {
+				if _, ok := expr.(*tree.DefaultVal); ok {
+					continue
+				}
+				set[j] = true
+				selected = append(selected, formatTriggerNode(fmtctx, expr))
+				pos = append(pos, j)
+			}
+			if len(selected) > 0 {
+				rows, err := fetchTriggerRows(ctx, bh, "select "+strings.Join(selected, ", "))
+				if err != nil {
+					return 0, err
+				}
+				for k, j := range pos {
+					row[j] = rows[0][k]
+				}
+			}
+			values = append(values, row)
+			listed = append(listed, set)
+		}
+	} else {
+		rows, err := fetchTriggerRows(ctx, bh, formatTriggerNode(fmtctx, st.Rows))
+		if err != nil {
+			return 0, err
+		}
+		for i, row := range rows {
+			if len(row) != len(insertCols) {
+				return 0, moerr.NewWrongValueCountOnRow(ctx, i+1)
+			}
+			set := make([]bool, len(insertCols))
+			for j := range set {
+				set[j] = true
+			}
+			values = append(values, row)
+			listed = append(listed, set)
+		}
+	}
+
+	if err := tt.useDatabase(ctx, bh); err != nil {
+		return 0, err
+	}
+	tableRef := fmt.Sprintf("`%s`.`%s`", util.EscapeSQLIdent(tt.dbName), util.EscapeSQLIdent(tt.tableDef.Name))
+	for i, row := range values {
+		newRow := make(map[string]interface{}, len(cols))
+		assigned := make(map[string]bool)
+		for j, col := range cols {
+			newRow[triggerNewPrefix+strings.ToLower(col.Name)] = defaults[j]
+		}
+		for j, col := range insertCols {
+			if listed[i][j] {
+				name := strings.ToLower(col.Name)
+				newRow[triggerNewPrefix+name] = row[j]
+				assigned[name] = true
+			}
+		}
+
+		if err := tt.fire(ctx, ses, bh, tree.TriggerBefore, newRow, assigned); err != nil {
+			return 0, err
+		}
+
+		// the columns neither inserted nor set by the triggers get the default values
+		var names, exprs []string
+		for _, col := range cols {
+			if assigned[strings.ToLower(col.Name)] {
+				names = append(names, fmt.Sprintf("`%s`", util.EscapeSQLIdent(col.Name)))
+				exprs = append(exprs, fmt.Sprintf("new.`%s`", util.EscapeSQLIdent(strings.ToLower(col.Name))))
+			}
+		}
+		sql := fmt.Sprintf("insert into %s (%s) values (%s)", tableRef, strings.Join(names, ", "), strings.Join(exprs, ", "))
+		bh.ClearExecResultSet()
+		if err := bh.Exec(triggerRowContext(ctx, newRow), sql); err != nil {
+			return 0, err
+		}
+
+		if err := tt.fire(ctx, ses, bh, tree.TriggerAfter, newRow, assigned); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(values)), nil
+}
+
+func executeUpdateWithTriggers(ctx context.Context, ses *Session, bh BackgroundExec, fmtctx *tree.FmtCtx, tt *tableTriggers, table *tree.AliasedTableExpr, st *tree.Update) (uint64, error) {
+	if st.With != nil {
+		return 0, moerr.NewNotSupported(ctx, "UPDATE with CTE on table %s with triggers", tt.tableDef.Name)
+	}
+
+	cols := visibleColumns(tt.tableDef)
+	setCols := make([]string, 0, len(st.Exprs))
+	setExprs := make([]string, 0, len(st.Exprs))
+	for _, ue := range st.Exprs {
+		if ue.Tuple || len(ue.Names) != 1 {
+			return 0, moerr.NewNotSupported(ctx, "UPDATE with tuple assignment on table %s with triggers", tt.tableDef.Name)
+		}
+		if _, ok := ue.Expr.(*tree.DefaultVal); ok {
+			return 0, moerr.NewNotSupported(ctx, "UPDATE with DEFAULT on table %s with triggers", tt.tableDef.Name)
+		}
+		col := findColumn(cols, ue.Names[0].Parts[0])
+		if col == nil {
+			return 0, moerr.NewBadFieldError(ctx, ue.Names[0].Parts[0], tt.tableDef.Name)
+		}
+		setCols = append(setCols, strings.ToLower(col.Name))
+		setExprs = append(setExprs, formatTriggerNode(fmtctx, ue.Expr))
+	}
+
+	oldCols, pkWhere, err := triggerRowColumns(ctx, tt.tableDef, cols)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := fetchTriggerRows(ctx, bh, selectTriggerRowsSql(fmtctx, tt, table, oldCols, setExprs, st.Where, st.OrderBy, st.Limit))
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tt.useDatabase(ctx, bh); err != nil {
+		return 0, err
+	}
+	tableRef := fmt.Sprintf("`%s`.`%s`", util.EscapeSQLIdent(tt.dbName), util.EscapeSQLIdent(tt.tableDef.Name))
+	for _, row := range rows {
+		triggerRow := make(map[string]interface{}, len(oldCols)+len(cols))
+		assigned := make(map[string]bool)
+		for i, col := range oldCols {
+			triggerRow[triggerOldPrefix+strings.ToLower(col.Name)] = row[i]
+		}
+		for i, col := range cols {
+			triggerRow[triggerNewPrefix+strings.ToLower(col.Name)] = row[i]
+		}
+		for i, name := range setCols {
+			triggerRow[triggerNewPrefix+name] = row[len(oldCols)+i]
+			assigned[name] = true
+		}
+
+		if err = tt.fire(ctx, ses, bh, tree.TriggerBefore, triggerRow, assigned); err != nil {
+			return 0, err
+		}
+
+		var sets []string
+		for _, col := range cols {
+			if assigned[strings.ToLower(col.Name)] {
+				sets = append(sets, fmt.Sprintf("`%s` = new.`%s`", util.EscapeSQLIdent(col.Name), util.EscapeSQLIdent(strings.ToLower(col.Name))))
+			}
+		}
+		sql := fmt.Sprintf("update %s set %s where %s", tableRef, strings.Join(sets, ", "), pkWhere)
+		bh.ClearExecResultSet()
+		if err = bh.Exec(triggerRowContext(ctx, triggerRow), sql); err != nil {
+			return 0, err
+		}
+
+		if err = tt.fire(ctx, ses, bh, tree.TriggerAfter, triggerRow, assigned); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(rows)), nil
+}
+
+func executeDeleteWithTriggers(ctx context.Context, ses *Session, bh BackgroundExec, fmtctx *tree.FmtCtx, tt *tableTriggers, table *tree.AliasedTableExpr, st *tree.Delete) (uint64, error) {
+	if st.With != nil || len(st.PartitionNames) > 0 {
+		return 0, moerr.NewNotSupported(ctx, "DELETE with CTE or PARTITION on table %s with triggers", tt.tableDef.Name)
+	}
+
+	oldCols, pkWhere, err := triggerRowColumns(ctx, tt.tableDef, visibleColumns(tt.tableDef))
+	if err != nil {
+		return 0, err
+	}
+	rows, err := fetchTriggerRows(ctx, bh, selectTriggerRowsSql(fmtctx, tt, table, oldCols, nil, st.Where, st.OrderBy, st.Limit))
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tt.useDatabase(ctx, bh); err != nil {
+		return 0, err
+	}
+	sql := fmt.Sprintf("delete from `%s`.`%s` where %s", util.EscapeSQLIdent(tt.dbName), util.EscapeSQLIdent(tt.tableDef.Name), pkWhere)
+	for _, row := range rows {
+		triggerRow := make(map[string]interface{}, len(oldCols))
+		for i, col := range oldCols {
+			triggerRow[triggerOldPrefix+strings.ToLower(col.Name)] = row[i]
+		}
+
+		if err = tt.fire(ctx, ses, bh, tree.TriggerBefore, triggerRow, nil); err != nil {
+			return 0, err
+		}
+		bh.ClearExecResultSet()
+		if err = bh.Exec(triggerRowContext(ctx, triggerRow), sql); err != nil {
+			return 0, err
+		}
+		if err = tt.fire(ctx, ses, bh, tree.TriggerAfter, triggerRow, nil); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(rows)), nil
+}
+
+// triggerRowColumns returns the columns of OLD, which are the visible columns and the
+// hidden primary key columns, and the filter of the row by the primary key in OLD.
+func triggerRowColumns(ctx context.Context, tableDef *plan.TableDef, cols []*plan.ColDef) ([]*plan.ColDef, string, error) {
+	if tableDef.Pkey == nil || len(tableDef.Pkey.Names) == 0 {
+		return nil, "", moerr.NewNotSupported(ctx, "triggers on table %s without primary key", tableDef.Name)
+	}
+	oldCols := slices.Clone(cols)
+	filters := make([]string, 0, len(tableDef.Pkey.Names))
+	for _, name := range tableDef.Pkey.Names {
+		col := findColumn(tableDef.Cols, name)
+		if col == nil {
+			return nil, "", moerr.NewInternalError(ctx, "primary key %s not found in table %s", name, tableDef.Name)
+		}
+		if col.Hidden {
+			oldCols = append(oldCols, col)
+		}
+		filters = append(filters, fmt.Sprintf("`%s` = cast(old.`%s` as %s)", util.EscapeSQLIdent(col.Name), util.EscapeSQLIdent(strings.ToLower(col.Name)), plan2.FormatColType(col.Typ)))
+	}
+	return oldCols, strings.Join(filters, " and "), nil
+}
+
+// selectTriggerRowsSql returns the sql fetching the OLD rows and the assigned values.
+func selectTriggerRowsSql(fmtctx *tree.FmtCtx, tt *tableTriggers, table *tree.AliasedTableExpr,
+	oldCols []*plan.ColDef, exprs []string, where *tree.Where, orderBy tree.OrderBy, limit *tree.Limit) string {
+	projections := make([]string, 0, len(oldCols)+len(exprs))
+	for _, col := range oldCols {
+		projections = append(projections, fmt.Sprintf("`%s`", util.EscapeSQLIdent(col.Name)))
+	}
+	projections = append(projections, exprs...)
+
+	var sb strings.Builder
+	sb.WriteString("select ")
+	sb.WriteString(strings.Join(projections, ", "))
+	sb.WriteString(fmt.Sprintf(" from `%s`.`%s`", util.EscapeSQLIdent(tt.dbName), util.EscapeSQLIdent(tt.tableDef.Name)))
+	if table.As.Alias != "" {
+		sb.WriteString(fmt.Sprintf(" as `%s`", util.EscapeSQLIdent(string(table.As.Alias))))
+	}
+	if where != nil {
+		sb.WriteString(" " + formatTriggerNode(fmtctx, where))
+	}
+	if len(orderBy) > 0 {
+		sb.WriteString(" " + formatTriggerNode(fmtctx, &orderBy))
+	}
+	if limit != nil {
+		sb.WriteString(" " + formatTriggerNode(fmtctx, limit))
+	}
+	return sb.String()
+}
+
+// fetchTriggerRows runs the query in the shared transaction and returns the rows,
+// the values are strings or nil for NULL.
+func fetchTriggerRows(ctx context.Context, bh BackgroundExec, sql string) ([][]interface{}, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	mrs := erArray[0].(*MysqlResultSet)
+	rows := make([][]interface{}, mrs.GetRowCount())
+	for i := range rows {
+		rows[i] = make([]interface{}, mrs.GetColumnCount())
+		for j := range rows[i] {
+			if rows[i][j], err = getResultValue(ctx, mrs, uint64(i), uint64(j)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+// triggerRowContext attaches the NEW and OLD pseudo-rows to the context as variables.
+func triggerRowContext(ctx context.Context, row map[string]interface{}) context.Context {
+	varScope := []map[string]interface{}{row}
+	ctx = context.WithValue(ctx, defines.VarScopeKey{}, &varScope)
+	return context.WithValue(ctx, defines.InSp{}, true)
+}
+
+// triggerDatabaseName returns the database of the table, empty if the table can not have triggers.
+func triggerDatabaseName(defaultDbName string, tbl *tree.TableName) string {
+	dbName := string(tbl.SchemaName)
+	if dbName == "" {
+		dbName = defaultDbName
+	}
+	if _, ok := sysDatabases[dbName]; ok {
+		return ""
+	}
+	return dbName
+}
+
+// singleTargetTable returns the table of the single table UPDATE or DELETE.
+func singleTargetTable(exprs tree.TableExprs) *tree.AliasedTableExpr {
+	if len(exprs) != 1 {
+		return nil
+	}
+	table, ok := exprs[0].(*tree.AliasedTableExpr)
+	if !ok {
+		return nil
+	}
+	if _, ok = table.Expr.(*tree.TableName); !ok {
+		return nil
+	}
+	return table
+}
+
+// collectTableNames returns the tables referenced by the table expressions.
+func collectTableNames(exprs tree.TableExprs, names []*tree.TableName) []*tree.TableName {
+	for _, expr := range exprs {
+		switch e := expr.(type) {
+		case *tree.TableName:
+			names = append(names, e)
+		case *tree.AliasedTableExpr:
+			names = collectTableNames(tree.TableExprs{e.Expr}, names)
+		case *tree.ParenTableExpr:
+			names = collectTableNames(tree.TableExprs{e.Expr}, names)
+		case *tree.JoinTableExpr:
+			names = collectTableNames(tree.TableExprs{e.Left}, names)
+			if e.Right != nil {
+				names = collectTableNames(tree.TableExprs{e.Right}, names)
+			}
+		}
+	}
+	return names
+}
+
+func visibleColumns(tableDef *plan.TableDef) []*plan.ColDef {
+	cols := make([]*plan.ColDef, 0, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if !col.Hidden {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+func findColumn(cols []*plan.ColDef, name string) *plan.ColDef {
+	for _, col := range cols {
+		if strings.EqualFold(col.Name, name) {
+			return col
+		}
+	}
+	return nil
+}
+
+func formatTriggerNode(fmtctx *tree.FmtCtx, node tree.NodeFormatter) string {
+	fmtctx.Reset()
+	node.Format(fmtctx)
+	return fmtctx.String()
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	sqlutil "github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkTriggerBody(t *testing.T) {
+	ctx := context.TODO()
+	kases := []struct {
+		sql   string
+		valid bool
+	}{
+		{"create trigger t1 before insert on t for each row set new.a = 1", true},
+		{"create trigger t1 before update on t for each row begin if old.a > 1 then set new.b = old.a; end if; end", true},
+		{"create trigger t1 after insert on t for each row insert into log values (new.a)", true},
+		{"create trigger t1 after insert on t for each row set new.a = 1", false},
+		{"create trigger t1 before update on t for each row set old.a = 1", false},
+		{"create trigger t1 before delete on t for each row set new.a = 1", false},
+		{"create trigger t1 before insert on t for each row select 1", false},
+		{"create trigger t1 before insert on t for each row begin drop table t2; end", false},
+	}
+	for _, kase := range kases {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, kase.sql, 1)
+		require.NoError(t, err, kase.sql)
+		ct := stmt.(*tree.CreateTrigger)
+		err = checkTriggerBody(ctx, ct.Body, ct.Timing, ct.Event)
+		if kase.valid {
+			require.NoError(t, err, kase.sql)
+		} else {
+			require.Error(t, err, kase.sql)
+		}
+	}
+}
+
+func Test_collectTableNames(t *testing.T) {
+	ctx := context.TODO()
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "update t1 join (t2, db.t3) on t1.a = t2.a set t1.b = 1", 1)
+	require.NoError(t, err)
+	upd := stmt.(*tree.Update)
+	require.Nil(t, singleTargetTable(upd.Tables))
+
+	names := collectTableNames(upd.Tables, nil)
+	require.Len(t, names, 3)
+	require.Equal(t, "t1", string(names[0].ObjectName))
+	require.Equal(t, "db", string(names[2].SchemaName))
+}
+
+func Test_checkTriggerTable(t *testing.T) {
+	ctx := context.TODO()
+	tableDef := &plan.TableDef{
+		Name:      "t",
+		TableType: catalog.SystemOrdinaryRel,
+		Pkey:      &plan.PrimaryKeyDef{Names: []string{"a"}, PkeyColName: "a"},
+	}
+	require.NoError(t, checkTriggerTable(ctx, "db", tableDef))
+
+	// the rows are changed by the primary key
+	tableDef.Pkey = &plan.PrimaryKeyDef{Names: []string{catalog.FakePrimaryKeyColName}, PkeyColName: catalog.FakePrimaryKeyColName}
+	require.Error(t, checkTriggerTable(ctx, "db", tableDef))
+	tableDef.Pkey = nil
+	require.Error(t, checkTriggerTable(ctx, "db", tableDef))
+
+	view := &plan.TableDef{Name: "v", TableType: catalog.SystemViewRel}
+	require.Error(t, checkTriggerTable(ctx, "db", view))
+}
+
+func Test_triggerCache(t *testing.T) {
+	key := triggerCacheKey(1, "db", "t")
+	triggers := []cachedTrigger{{event: tree.TriggerInsert.String(), timing: tree.TriggerBefore.String(), body: "set new.a = 1"}}
+	setCachedTriggers(key, 1, triggers)
+	defer invalidateCachedTriggers(key)
+
+	got, ok := getCachedTriggers(key, 1)
+	require.True(t, ok)
+	require.Equal(t, triggers, got)
+	require.True(t, hasTriggers(got, tree.TriggerInsert))
+	require.False(t, hasTriggers(got, tree.TriggerDelete))
+
+	// the DDL of the account changes the catalog version
+	_, ok = getCachedTriggers(key, 2)
+	require.False(t, ok)
+
+	// the tables without triggers are cached too
+	setCachedTriggers(key, 2, nil)
+	got, ok = getCachedTriggers(key, 2)
+	require.True(t, ok)
+	require.Empty(t, got)
+
+	invalidateCachedTriggers(key)
+	_, ok = getCachedTriggers(key, 2)
+	require.False(t, ok)
+}
+
+func Test_triggerRowSqlQuoting(t *testing.T) {
+	ctx := context.TODO()
+	col := &plan.ColDef{Name: "a`b", Typ: plan.Type{Id: int32(types.T_int64)}}
+	tableDef := &plan.TableDef{Name: "t`1", Cols: []*plan.ColDef{col}, Pkey: &plan.PrimaryKeyDef{Names: []string{"a`b"}}}
+	tt := &tableTriggers{dbName: "d`b", tableDef: tableDef}
+
+	// the backticks in the names are doubled, so they can not end the quoted identifiers
+	oldCols, pkWhere, err := triggerRowColumns(ctx, tableDef, visibleColumns(tableDef))
+	require.NoError(t, err)
+	require.Equal(t, "`a``b` = cast(old.`a``b` as BIGINT)", pkWhere)
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	sql := selectTriggerRowsSql(fmtctx, tt, &tree.AliasedTableExpr{As: tree.AliasClause{Alias: "x`y"}}, oldCols, nil, nil, nil, nil)
+	require.Equal(t, "select `a``b` from `d``b`.`t``1` as `x``y`", sql)
+	_, err = parsers.ParseOne(ctx, dialect.MYSQL, sql, 1)
+	require.NoError(t, err)
+}
+
+func Test_triggerCheckPrivilege(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newSes(nil, ctrl)
+
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "insert into audit values (new.a)", 1)
+	require.NoError(t, err)
+	// the statements checked for the invoker are not checked again for the next rows
+	tt := &tableTriggers{dbName: "db", checked: map[tree.Statement]bool{stmt: true}}
+	require.NoError(t, tt.checkPrivilege(ctx, ses, stmt))
+}
+
+func Test_executeStmtWithTriggers(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newSes(nil, ctrl)
+	ses.SetDatabaseName("db")
+
+	key := triggerCacheKey(ses.GetAccountId(), "db", "t")
+	setCachedTriggers(key, 0, []cachedTrigger{{event: tree.TriggerInsert.String(), timing: tree.TriggerBefore.String(), body: "set new.a = 1"}})
+	defer invalidateCachedTriggers(key)
+	key2 := triggerCacheKey(ses.GetAccountId(), "db", "t2")
+	setCachedTriggers(key2, 0, nil)
+	defer invalidateCachedTriggers(key2)
+
+	parse := func(sql string) tree.Statement {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, sql, 1)
+		require.NoError(t, err, sql)
+		return stmt
+	}
+
+	// LOAD can not fire the triggers
+	_, err := executeStmtWithTriggers(ses, &ExecCtx{reqCtx: ctx, stmt: parse("load data infile 'a.csv' into table t")})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+
+	// the statements returning the rows can not fire the triggers
+	_, err = executeStmtWithTriggers(ses, &ExecCtx{reqCtx: ctx, stmt: parse("insert into t values (1) returning a")})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+	require.NoError(t, ses.SetPrepareStmt(ctx, "s1", &PrepareStmt{PrepareStmt: parse("insert into t values (?) returning a")}))
+	_, err = executeStmtWithTriggers(ses, &ExecCtx{reqCtx: ctx, stmt: parse("execute s1")})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+
+	// the statements on the tables without triggers are executed normally
+	require.NoError(t, ses.SetPrepareStmt(ctx, "s2", &PrepareStmt{PrepareStmt: parse("insert into t2 values (1)")}))
+	executed, err := executeStmtWithTriggers(ses, &ExecCtx{reqCtx: ctx, stmt: parse("execute s2")})
+	require.NoError(t, err)
+	require.False(t, executed)
+	executed, err = executeStmtWithTriggers(ses, &ExecCtx{reqCtx: ctx, stmt: parse("load data infile 'a.csv' into table t2")})
+	require.NoError(t, err)
+	require.False(t, executed)
+}
+
+func Test_getTriggerParamValues(t *testing.T) {
+	ctx := context.TODO()
+	proc := testutil.NewProcess()
+	proc.SetResolveVariableFunc(func(name string, system, global bool) (interface{}, error) {
+		switch name {
+		case "a":
+			return int64(1), nil
+		case "b":
+			return "it's", nil
+		}
+		return nil, nil
+	})
+	prepareStmt := &PrepareStmt{PreparePlan: &plan.Plan{Plan: &plan.Plan_Dcl{Dcl: &plan.DataControl{
+		Control: &plan.DataControl_Prepare{Prepare: &plan.Prepare{ParamTypes: []int32{0, 0}}},
+	}}}}
+	execute := func(sql string) *tree.Execute {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, sql, 1)
+		require.NoError(t, err, sql)
+		return stmt.(*tree.Execute)
+	}
+
+	// the variables in USING
+	values, err := getTriggerParamValues(ctx, proc, execute("execute s using @a, @b"), prepareStmt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"'1'", "'it''s'"}, values)
+	_, err = getTriggerParamValues(ctx, proc, execute("execute s using @a"), prepareStmt)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+	_, err = getTriggerParamValues(ctx, proc, execute("execute s using @a, @c"), prepareStmt)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+
+	// the parameters of COM_STMT_EXECUTE
+	prepareStmt.params = proc.GetVector(types.T_text.ToType())
+	defer prepareStmt.params.Free(proc.Mp())
+	require.NoError(t, sqlutil.AppendAnyToStringVector(proc, "x", prepareStmt.params))
+	require.NoError(t, sqlutil.AppendAnyToStringVector(proc, nil, prepareStmt.params))
+	values, err = getTriggerParamValues(ctx, proc, execute("execute s"), prepareStmt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"'x'", "null"}, values)
+
+	// the statement is formatted with the values
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "insert into t values (?, ?)", 1)
+	require.NoError(t, err)
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true), tree.WithParamValues(values))
+	stmt.(*tree.Insert).Rows.Format(fmtctx)
+	require.Equal(t, "values ('x', null)", fmtctx.String())
+}
@@ -601,6 +601,10 @@ func (ses *feSessionImpl) GetQueryStart() time.Time {
 	return ses.stmtProfile.GetQueryStart()
 }
 
+func (ses *feSessionImpl) GetQueueWait() time.Duration {
+	return ses.stmtProfile.GetQueueWait()
+}
+
 func (ses *feSessionImpl) SetSqlOfStmt(sot string) {
 	ses.stmtProfile.SetSqlOfStmt(sot)
 }
@@ -424,6 +424,8 @@ func getValueFromVector(ctx context.Context, vec *vector.Vector, ses *Session, e
 		return vector.GetArrayAt[float32](vec, 0), nil
 	case types.T_array_float64:
 		return vector.GetArrayAt[float64](vec, 0), nil
+	case types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt(0)), nil
 	case types.T_decimal64:
 		val := vector.GetFixedAt[types.Decimal64](vec, 0)
 		return val.Format(expr.Typ.Scale), nil
@@ -1232,6 +1232,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("interactive_timeout", 1, 31536000, false),
 		Default:           int64(28800),
 	},
+	"ivf_filter_mode": {
+		Name:              "ivf_filter_mode",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("ivf_filter_mode", "post", "pre", "auto"),
+		Default:           "post",
+	},
 	"lower_case_table_names": {
 		Name:              "lower_case_table_names",
 		Scope:             ScopeGlobal,
@@ -1377,6 +1385,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("clear_privilege_cache"),
 		Default:           int64(0),
 	},
+	"enable_shared_plan_cache": {
+		Name:              "enable_shared_plan_cache",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("enable_shared_plan_cache"),
+		Default:           int64(1),
+	},
 	"foreign_key_checks": {
 		Name:              "foreign_key_checks",
 		Scope:             ScopeBoth,
@@ -2289,6 +2305,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("max_execution_time", 0, 4294967295, false),
 		Default:           int64(0),
 	},
+	"query_memory_quota": {
+		Name:              "query_memory_quota",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("query_memory_quota", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
 	"max_heap_table_size": {
 		Name:              "max_heap_table_size",
 		Scope:             ScopeBoth,
@@ -2561,6 +2585,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("open_files_limit", 0, 1048576, false),
 		Default:           int64(5000),
 	},
+	"optimizer_capture_plan_baselines": {
+		Name:              "optimizer_capture_plan_baselines",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("optimizer_capture_plan_baselines"),
+		Default:           int64(0),
+	},
+	"optimizer_use_plan_baselines": {
+		Name:              "optimizer_use_plan_baselines",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("optimizer_use_plan_baselines"),
+		Default:           int64(1),
+	},
 	"optimizer_hints": {
 		Name:              "optimizer_hints",
 		Scope:             ScopeBoth,
@@ -3505,6 +3545,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("experimental_ivf_index"),
 		Default:           int64(0),
 	},
+	"online_ddl": {
+		Name:              "online_ddl",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("online_ddl"),
+		Default:           int64(1),
+	},
 	"refresh_global_sys_vars_mgr": {
 		Name:              "refresh_global_sys_vars_mgr",
 		Scope:             ScopeBoth,
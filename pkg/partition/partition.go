@@ -174,7 +174,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 	case types.T_decimal128:
 		return genericPartition[types.Decimal128](sels, diffs, partitions, vec)
 	case types.T_char, types.T_varchar, types.T_json, types.T_text,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_int8, types.T_array_bit:
 		return bytesPartition(sels, diffs, partitions, vec)
 		//Used by ORDER_BY SQL clause.
 		//Byte partition logic doesn't use byte.Compare or Str.
@@ -65,6 +65,14 @@ func NewRenameTableReq(did, tid uint64, old, new string) *AlterTableReq {
 	}
 }
 
+// NewRenameTableWithKindReq renames the table and replaces its kind and create sql.
+func NewRenameTableWithKindReq(did, tid uint64, old, new, relKind, createSql string) *AlterTableReq {
+	req := NewRenameTableReq(did, tid, old, new)
+	req.GetRenameTable().RelKind = relKind
+	req.GetRenameTable().CreateSql = createSql
+	return req
+}
+
 func NewAddColumnReq(did, tid uint64, name string, typ *plan.Type, insertAt int32) *AlterTableReq {
 	return &AlterTableReq{
 		DbId:    did,
@@ -128,6 +136,21 @@ func NewRenameColumnReq(did, tid uint64, oldname, newname string, seqnum uint32)
 		},
 	}
 }
+
+func NewModifyColumnReq(did, tid uint64, name string, typ *plan.Type, seqnum uint32) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_ModifyColumn,
+		Operation: &AlterTableReq_ModifyCol{
+			&AlterTableModifyCol{
+				Name:        name,
+				Type:        typ,
+				SequenceNum: seqnum,
+			},
+		},
+	}
+}
 func (m *SyncLogTailReq) MarshalBinary() ([]byte, error) {
 	return m.Marshal()
 }
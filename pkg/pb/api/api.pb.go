@@ -99,6 +99,7 @@ const (
 	AlterKind_UpdatePolicy     AlterKind = 6
 	AlterKind_AddPartition     AlterKind = 7
 	AlterKind_RenameColumn     AlterKind = 8
+	AlterKind_ModifyColumn     AlterKind = 9
 )
 
 var AlterKind_name = map[int32]string{
@@ -111,6 +112,7 @@ var AlterKind_name = map[int32]string{
 	6: "UpdatePolicy",
 	7: "AddPartition",
 	8: "RenameColumn",
+	9: "ModifyColumn",
 }
 
 var AlterKind_value = map[string]int32{
@@ -123,6 +125,7 @@ var AlterKind_value = map[string]int32{
 	"UpdatePolicy":     6,
 	"AddPartition":     7,
 	"RenameColumn":     8,
+	"ModifyColumn":     9,
 }
 
 func (x AlterKind) String() string {
@@ -1244,8 +1247,11 @@ func (m *AlterTableComment) GetComment() string {
 }
 
 type AlterTableRenameTable struct {
-	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
-	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	OldName string `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// the kind and the create sql of the table are replaced if rel_kind is not empty
+	RelKind              string   `protobuf:"bytes,3,opt,name=rel_kind,json=relKind,proto3" json:"rel_kind,omitempty"`
+	CreateSql            string   `protobuf:"bytes,4,opt,name=create_sql,json=createSql,proto3" json:"create_sql,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1298,6 +1304,20 @@ func (m *AlterTableRenameTable) GetNewName() string {
 	return ""
 }
 
+func (m *AlterTableRenameTable) GetRelKind() string {
+	if m != nil {
+		return m.RelKind
+	}
+	return ""
+}
+
+func (m *AlterTableRenameTable) GetCreateSql() string {
+	if m != nil {
+		return m.CreateSql
+	}
+	return ""
+}
+
 type AlterTableRenameCol struct {
 	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
 	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
@@ -1361,6 +1381,71 @@ func (m *AlterTableRenameCol) GetSequenceNum() uint32 {
 	return 0
 }
 
+// AlterTableModifyCol only widens the type of a column, the data of the
+// column is untouched.
+type AlterTableModifyCol struct {
+	Name                 string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 *plan.Type `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SequenceNum          uint32     `protobuf:"varint,3,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *AlterTableModifyCol) Reset()         { *m = AlterTableModifyCol{} }
+func (m *AlterTableModifyCol) String() string { return proto.CompactTextString(m) }
+func (*AlterTableModifyCol) ProtoMessage()    {}
+func (*AlterTableModifyCol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+}
+func (m *AlterTableModifyCol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableModifyCol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableModifyCol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableModifyCol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableModifyCol.Merge(m, src)
+}
+func (m *AlterTableModifyCol) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableModifyCol) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableModifyCol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableModifyCol proto.InternalMessageInfo
+
+func (m *AlterTableModifyCol) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlterTableModifyCol) GetType() *plan.Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *AlterTableModifyCol) GetSequenceNum() uint32 {
+	if m != nil {
+		return m.SequenceNum
+	}
+	return 0
+}
+
 type AlterTableAddColumn struct {
 	Column               *plan.ColDef `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
 	InsertPosition       int32        `protobuf:"varint,2,opt,name=insert_position,json=insertPosition,proto3" json:"insert_position,omitempty"`
@@ -1373,7 +1458,7 @@ func (m *AlterTableAddColumn) Reset()         { *m = AlterTableAddColumn{} }
 func (m *AlterTableAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddColumn) ProtoMessage()    {}
 func (*AlterTableAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 func (m *AlterTableAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1427,7 +1512,7 @@ func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{}
 func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddPartition) ProtoMessage()    {}
 func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,7 +1560,7 @@ func (m *AlterTableDropColumn) Reset()         { *m = AlterTableDropColumn{} }
 func (m *AlterTableDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropColumn) ProtoMessage()    {}
 func (*AlterTableDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 func (m *AlterTableDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1531,6 +1616,7 @@ type AlterTableReq struct {
 	//	*AlterTableReq_UpdatePolicy
 	//	*AlterTableReq_AddPartition
 	//	*AlterTableReq_RenameCol
+	//	*AlterTableReq_ModifyCol
 	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -1541,7 +1627,7 @@ func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
 func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
 func (*AlterTableReq) ProtoMessage()    {}
 func (*AlterTableReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1600,6 +1686,9 @@ type AlterTableReq_AddPartition struct {
 type AlterTableReq_RenameCol struct {
 	RenameCol *AlterTableRenameCol `protobuf:"bytes,11,opt,name=rename_col,json=renameCol,proto3,oneof" json:"rename_col,omitempty"`
 }
+type AlterTableReq_ModifyCol struct {
+	ModifyCol *AlterTableModifyCol `protobuf:"bytes,12,opt,name=modify_col,json=modifyCol,proto3,oneof" json:"modify_col,omitempty"`
+}
 
 func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()     {}
 func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()    {}
@@ -1609,6 +1698,7 @@ func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()    {}
 func (*AlterTableReq_UpdatePolicy) isAlterTableReq_Operation()  {}
 func (*AlterTableReq_AddPartition) isAlterTableReq_Operation()  {}
 func (*AlterTableReq_RenameCol) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_ModifyCol) isAlterTableReq_Operation()     {}
 
 func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
 	if m != nil {
@@ -1694,6 +1784,13 @@ func (m *AlterTableReq) GetRenameCol() *AlterTableRenameCol {
 	return nil
 }
 
+func (m *AlterTableReq) GetModifyCol() *AlterTableModifyCol {
+	if x, ok := m.GetOperation().(*AlterTableReq_ModifyCol); ok {
+		return x.ModifyCol
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1705,6 +1802,7 @@ func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 		(*AlterTableReq_UpdatePolicy)(nil),
 		(*AlterTableReq_AddPartition)(nil),
 		(*AlterTableReq_RenameCol)(nil),
+		(*AlterTableReq_ModifyCol)(nil),
 	}
 }
 
@@ -1729,7 +1827,7 @@ func (m *SchemaExtra) Reset()         { *m = SchemaExtra{} }
 func (m *SchemaExtra) String() string { return proto.CompactTextString(m) }
 func (*SchemaExtra) ProtoMessage()    {}
 func (*SchemaExtra) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 func (m *SchemaExtra) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1833,7 +1931,7 @@ func (m *Int64Map) Reset()         { *m = Int64Map{} }
 func (m *Int64Map) String() string { return proto.CompactTextString(m) }
 func (*Int64Map) ProtoMessage()    {}
 func (*Int64Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 func (m *Int64Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1882,7 +1980,7 @@ func (m *TransDestPos) Reset()         { *m = TransDestPos{} }
 func (m *TransDestPos) String() string { return proto.CompactTextString(m) }
 func (*TransDestPos) ProtoMessage()    {}
 func (*TransDestPos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 func (m *TransDestPos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2041,7 @@ func (m *BlkTransMap) Reset()         { *m = BlkTransMap{} }
 func (m *BlkTransMap) String() string { return proto.CompactTextString(m) }
 func (*BlkTransMap) ProtoMessage()    {}
 func (*BlkTransMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 func (m *BlkTransMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1990,7 +2088,7 @@ func (m *BlkTransferBooking) Reset()         { *m = BlkTransferBooking{} }
 func (m *BlkTransferBooking) String() string { return proto.CompactTextString(m) }
 func (*BlkTransferBooking) ProtoMessage()    {}
 func (*BlkTransferBooking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 func (m *BlkTransferBooking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2143,7 @@ func (m *MergeCommitEntry) Reset()         { *m = MergeCommitEntry{} }
 func (m *MergeCommitEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeCommitEntry) ProtoMessage()    {}
 func (*MergeCommitEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 func (m *MergeCommitEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2157,7 +2255,7 @@ func (m *MergeTaskEntry) Reset()         { *m = MergeTaskEntry{} }
 func (m *MergeTaskEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeTaskEntry) ProtoMessage()    {}
 func (*MergeTaskEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 func (m *MergeTaskEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2272,6 +2370,7 @@ func init() {
 	proto.RegisterType((*AlterTableComment)(nil), "api.AlterTableComment")
 	proto.RegisterType((*AlterTableRenameTable)(nil), "api.AlterTableRenameTable")
 	proto.RegisterType((*AlterTableRenameCol)(nil), "api.AlterTableRenameCol")
+	proto.RegisterType((*AlterTableModifyCol)(nil), "api.AlterTableModifyCol")
 	proto.RegisterType((*AlterTableAddColumn)(nil), "api.AlterTableAddColumn")
 	proto.RegisterType((*AlterTableAddPartition)(nil), "api.AlterTableAddPartition")
 	proto.RegisterType((*AlterTableDropColumn)(nil), "api.AlterTableDropColumn")
@@ -2290,152 +2389,156 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0x23, 0xc7,
-	0xf1, 0xd7, 0xf0, 0xcd, 0x1a, 0x3e, 0x46, 0xbd, 0xf2, 0x9a, 0x96, 0xfd, 0xdf, 0xd5, 0x7f, 0xec,
-	0xd8, 0xb2, 0x1d, 0x6b, 0x11, 0xd9, 0x49, 0x6c, 0xc3, 0xb0, 0xb1, 0xa2, 0xec, 0x15, 0x93, 0x95,
-	0xa8, 0x8c, 0xb8, 0x36, 0x60, 0x04, 0x18, 0x34, 0x67, 0x5a, 0xd4, 0x2c, 0x67, 0xba, 0x7b, 0x67,
-	0x9a, 0x5a, 0xc9, 0xd7, 0xc4, 0x5f, 0x20, 0xb7, 0xdc, 0xec, 0x53, 0x0e, 0xb9, 0xe6, 0x9c, 0xb3,
-	0x8f, 0x0e, 0xf2, 0x4e, 0x80, 0xc0, 0x70, 0x80, 0x20, 0x8f, 0x6b, 0x3e, 0x40, 0xd0, 0xd5, 0x33,
-	0x24, 0xa5, 0xdd, 0x75, 0xe2, 0x20, 0x80, 0x2f, 0x44, 0xd7, 0xaf, 0x1e, 0x5d, 0x55, 0x5d, 0xdd,
-	0x55, 0x43, 0x68, 0x52, 0x19, 0x6d, 0xc9, 0x54, 0x28, 0x41, 0xca, 0x54, 0x46, 0xeb, 0x2f, 0x4d,
-	0x22, 0x75, 0x32, 0x1b, 0x6f, 0x05, 0x22, 0xb9, 0x31, 0x11, 0x13, 0x71, 0x03, 0x79, 0xe3, 0xd9,
-	0x31, 0x52, 0x48, 0xe0, 0xca, 0xe8, 0xac, 0x77, 0x55, 0x94, 0xb0, 0x4c, 0xd1, 0x44, 0xe6, 0x00,
-	0xc8, 0x98, 0x72, 0xb3, 0x76, 0xbf, 0x0d, 0xed, 0xd1, 0xc1, 0x61, 0xc4, 0x27, 0x1e, 0xbb, 0x37,
-	0x63, 0x99, 0x22, 0x4f, 0x41, 0x53, 0xd2, 0x94, 0x26, 0x4c, 0xb1, 0xb4, 0x67, 0x6d, 0x58, 0x9b,
-	0x4d, 0x6f, 0x01, 0xbc, 0xde, 0xf8, 0xe8, 0xe3, 0xeb, 0xd6, 0x67, 0x1f, 0x5f, 0x5f, 0x71, 0x7f,
-	0x66, 0x41, 0xa7, 0xd0, 0xcc, 0xa4, 0xe0, 0x19, 0x23, 0x3d, 0xa8, 0x67, 0x4a, 0xa4, 0x6c, 0xb0,
-	0x9b, 0x2b, 0x16, 0x24, 0x79, 0x16, 0x3a, 0x19, 0x4b, 0x4f, 0xa3, 0x80, 0xdd, 0x0c, 0xc3, 0x94,
-	0x65, 0x59, 0xaf, 0x84, 0x02, 0x97, 0x50, 0xb4, 0x70, 0x42, 0xd3, 0x70, 0xb0, 0xdb, 0x2b, 0x6f,
-	0x58, 0x9b, 0x15, 0xaf, 0x20, 0xb5, 0x5b, 0x29, 0x93, 0x71, 0x14, 0xd0, 0xc1, 0x6e, 0xaf, 0x82,
-	0xbc, 0x05, 0x40, 0xae, 0x01, 0xc4, 0x62, 0x72, 0x94, 0xab, 0x56, 0x91, 0xbd, 0x84, 0x2c, 0xb9,
-	0xfd, 0x3a, 0x38, 0xa3, 0x83, 0x23, 0x95, 0x2e, 0xfb, 0x8d, 0xb6, 0xd5, 0x2c, 0xe5, 0x47, 0x6a,
-	0x1e, 0xf2, 0x1c, 0x58, 0xd2, 0xfd, 0xa9, 0x05, 0xb5, 0x77, 0x59, 0xa0, 0x44, 0x4a, 0x08, 0x54,
-	0x42, 0xaa, 0x28, 0x4a, 0xb7, 0x3c, 0x5c, 0x93, 0x6b, 0x50, 0x51, 0xe7, 0x92, 0x61, 0x68, 0xf6,
-	0x36, 0x6c, 0x61, 0x96, 0x47, 0xe7, 0x92, 0x79, 0x88, 0x93, 0x75, 0x68, 0xf0, 0x59, 0x1c, 0xd3,
-	0x71, 0xcc, 0x30, 0xba, 0x86, 0x37, 0xa7, 0x89, 0x03, 0x65, 0x9e, 0x49, 0x0c, 0xac, 0xe5, 0xe9,
-	0x25, 0x79, 0x02, 0x1a, 0x51, 0xe6, 0x07, 0x82, 0x67, 0x0a, 0x03, 0x6a, 0x78, 0xf5, 0x28, 0xeb,
-	0x6b, 0x52, 0x0b, 0xc7, 0x8c, 0xf7, 0x6a, 0x1b, 0xd6, 0x66, 0xdb, 0xd3, 0x4b, 0xed, 0x0e, 0x4d,
-	0x19, 0xed, 0xd5, 0x8d, 0x3b, 0x7a, 0xed, 0x7e, 0x07, 0xaa, 0x3b, 0x54, 0x05, 0x27, 0x64, 0x1d,
-	0xaa, 0x54, 0xa9, 0x34, 0xeb, 0x59, 0x1b, 0xe5, 0xcd, 0xe6, 0x4e, 0xe5, 0x93, 0x3f, 0x5d, 0x5f,
-	0xf1, 0x0c, 0x44, 0xbe, 0x06, 0x95, 0x53, 0x16, 0xe8, 0xe3, 0x28, 0x6f, 0xda, 0xdb, 0xf6, 0x96,
-	0xae, 0x34, 0x13, 0x62, 0x2e, 0x87, 0x6c, 0xf7, 0x5d, 0xa8, 0x8f, 0xb4, 0x9f, 0x83, 0x5d, 0x72,
-	0x05, 0xaa, 0xe1, 0xd8, 0x8f, 0x42, 0x0c, 0xbd, 0xe2, 0x55, 0xc2, 0xf1, 0x20, 0xd4, 0xa0, 0x42,
-	0xb0, 0x64, 0x40, 0xa5, 0xc1, 0xff, 0x87, 0x96, 0xa4, 0xa9, 0x8a, 0x54, 0x24, 0xb8, 0xe6, 0x99,
-	0x13, 0xb5, 0xe7, 0xd8, 0x20, 0x74, 0x7f, 0x64, 0x41, 0xe7, 0xe8, 0x9c, 0x07, 0xb7, 0xc5, 0x64,
-	0x44, 0xa3, 0xd8, 0x63, 0xf7, 0xc8, 0x4b, 0x50, 0x0f, 0xb8, 0x7f, 0x42, 0x4f, 0x19, 0xee, 0x60,
-	0x6f, 0xaf, 0x6d, 0x2d, 0xea, 0x77, 0x54, 0xac, 0xbc, 0x5a, 0xc0, 0xf7, 0xe8, 0x29, 0xcb, 0xc5,
-	0xef, 0x53, 0xae, 0xf2, 0xbc, 0x3f, 0x52, 0xfc, 0x3d, 0xca, 0x15, 0x71, 0xa1, 0xaa, 0xe6, 0x07,
-	0x60, 0x6f, 0xb7, 0x30, 0xe0, 0x3c, 0x34, 0xcf, 0xb0, 0xdc, 0xef, 0x43, 0xf7, 0x82, 0x4f, 0x99,
-	0xd4, 0xa1, 0x04, 0x53, 0xe9, 0xc7, 0x22, 0xa0, 0xda, 0xf3, 0xbc, 0x48, 0xec, 0x60, 0x2a, 0x6f,
-	0xe7, 0x10, 0x79, 0x16, 0x1a, 0x81, 0x48, 0x12, 0xca, 0xc3, 0x22, 0x9b, 0x80, 0xc6, 0xdf, 0xe6,
-	0x2a, 0x3d, 0xf7, 0xe6, 0x3c, 0xf7, 0x4d, 0x58, 0x3d, 0x4c, 0x99, 0x26, 0x23, 0xf5, 0x5e, 0x1a,
-	0x29, 0xd6, 0x4f, 0x42, 0xf2, 0x3c, 0x00, 0xd3, 0x72, 0x7e, 0x1c, 0x65, 0x0a, 0xcf, 0xe9, 0xa2,
-	0x7a, 0x13, 0xb9, 0xb7, 0xa3, 0x4c, 0xb9, 0xff, 0x28, 0x41, 0x15, 0x41, 0xf2, 0x72, 0xa1, 0x84,
-	0x55, 0xa7, 0x5d, 0xea, 0x6c, 0xaf, 0x2d, 0x94, 0xcc, 0x2f, 0xd6, 0x9f, 0x51, 0xd7, 0x4b, 0x5d,
-	0x56, 0x18, 0xe5, 0xe2, 0xb0, 0xea, 0x48, 0x0f, 0x42, 0x72, 0x1d, 0x6c, 0x5d, 0xc7, 0x63, 0x9a,
-	0xb1, 0xc5, 0x71, 0x41, 0x01, 0x0d, 0x42, 0xf2, 0x7f, 0x00, 0x46, 0x97, 0xd3, 0x84, 0x61, 0xad,
-	0x36, 0xbd, 0x26, 0x22, 0x07, 0x34, 0x61, 0xe4, 0x69, 0x68, 0xcf, 0xf5, 0x51, 0xa2, 0x8a, 0x12,
-	0xad, 0x02, 0x44, 0xa1, 0x27, 0xa1, 0x79, 0x1c, 0x15, 0x26, 0x6a, 0x28, 0xd0, 0xd0, 0x00, 0x32,
-	0x9f, 0x82, 0xf2, 0x98, 0x2a, 0xac, 0xe2, 0x22, 0x7e, 0x2c, 0x61, 0x4f, 0xc3, 0xe4, 0x69, 0xe8,
-	0xc8, 0xa9, 0x1f, 0x9c, 0xb0, 0x60, 0xea, 0x8f, 0xcf, 0x7d, 0xc5, 0x7b, 0x8d, 0x0d, 0x6b, 0xb3,
-	0xea, 0xd9, 0x72, 0xda, 0xd7, 0xe0, 0xce, 0xf9, 0x88, 0xbb, 0xfb, 0xd0, 0x9c, 0xc7, 0x4d, 0x00,
-	0x6a, 0x03, 0x9e, 0xb1, 0x54, 0x39, 0x2b, 0x7a, 0xbd, 0xcb, 0x62, 0xa6, 0x98, 0x63, 0xe9, 0xf5,
-	0x1d, 0x19, 0x52, 0xc5, 0x9c, 0x12, 0x69, 0x42, 0xf5, 0x66, 0xac, 0x58, 0xea, 0x94, 0xc9, 0x2a,
-	0xb4, 0x8f, 0x24, 0x0b, 0x22, 0x1a, 0xe7, 0x92, 0x15, 0xf7, 0x87, 0x16, 0x00, 0x1a, 0x97, 0x22,
-	0xe2, 0x8a, 0xbc, 0x08, 0xb5, 0x24, 0xe2, 0xbe, 0xca, 0xbe, 0xb0, 0x36, 0xab, 0x49, 0xc4, 0x47,
-	0x19, 0x0a, 0xd3, 0x33, 0x2d, 0x5c, 0xfa, 0x42, 0x61, 0x7a, 0x36, 0xca, 0x8a, 0xd0, 0xcb, 0x0f,
-	0x0d, 0xdd, 0xb8, 0x41, 0x15, 0x8d, 0xc5, 0xa4, 0x3f, 0x95, 0x5f, 0x99, 0x1b, 0x1f, 0x5a, 0x60,
-	0xef, 0x33, 0x45, 0xf5, 0x89, 0x7e, 0x95, 0x7e, 0xfc, 0xdd, 0x02, 0x07, 0x0f, 0x0d, 0x6f, 0xee,
-	0xa1, 0x88, 0xa3, 0xe0, 0x9c, 0x6c, 0xc1, 0x15, 0xed, 0x8c, 0xc8, 0xa2, 0x0f, 0x98, 0x7f, 0x6f,
-	0x46, 0xa3, 0x38, 0x3a, 0x66, 0xe6, 0x99, 0x6a, 0x7b, 0xab, 0x49, 0xc4, 0x87, 0x9a, 0xf3, 0xbd,
-	0x82, 0x41, 0x9e, 0x81, 0x8e, 0xf6, 0x47, 0x8c, 0xef, 0xfa, 0x82, 0xb3, 0x74, 0xc6, 0xd1, 0xaf,
-	0xb6, 0xd7, 0x4a, 0xe8, 0xd9, 0x70, 0x7c, 0x77, 0x88, 0x18, 0xb9, 0x01, 0x6b, 0x28, 0x85, 0x56,
-	0x13, 0x96, 0x4e, 0x58, 0xa8, 0x55, 0xd0, 0x33, 0x6d, 0x96, 0x9e, 0xa1, 0xd9, 0x7d, 0xe4, 0x0c,
-	0xc7, 0x77, 0xc9, 0x33, 0x50, 0x3d, 0x89, 0xb8, 0xca, 0x7a, 0x95, 0x8d, 0xf2, 0x66, 0x67, 0xbb,
-	0x83, 0xbe, 0x23, 0x7b, 0x2f, 0xe2, 0xca, 0x33, 0x4c, 0xf2, 0x3c, 0x68, 0x8f, 0xfc, 0x80, 0x1b,
-	0x9b, 0xbe, 0xb6, 0x91, 0xf7, 0xad, 0x4e, 0x12, 0xf1, 0x3e, 0x47, 0x8d, 0xa3, 0xe8, 0x03, 0xe6,
-	0xbe, 0x0a, 0x6b, 0x8b, 0x58, 0xb1, 0x01, 0xa4, 0x54, 0xd7, 0xe2, 0x06, 0xd8, 0xc1, 0x9c, 0xca,
-	0xf2, 0x4e, 0xb4, 0x0c, 0xb9, 0x2f, 0xc1, 0xea, 0xb2, 0x66, 0x92, 0x30, 0xae, 0x74, 0x8b, 0x0d,
-	0xcc, 0xb2, 0x68, 0xd2, 0x39, 0xe9, 0xee, 0xc3, 0x63, 0x0b, 0x71, 0x8f, 0xe9, 0x1b, 0x8a, 0x4b,
-	0xfd, 0x66, 0x88, 0x38, 0x34, 0x57, 0x36, 0xd7, 0x11, 0x71, 0x88, 0x37, 0xf6, 0x09, 0x68, 0x70,
-	0x76, 0xdf, 0xb0, 0x4c, 0x4b, 0xaf, 0x73, 0x76, 0x5f, 0xb3, 0x5c, 0x0e, 0x57, 0x2e, 0x9b, 0xeb,
-	0x8b, 0xf8, 0xbf, 0x33, 0xa6, 0x1f, 0xe0, 0x4c, 0x0f, 0x28, 0x3c, 0x60, 0x3e, 0x9f, 0x25, 0x79,
-	0xfa, 0xed, 0x02, 0x3b, 0x98, 0x25, 0x6e, 0xb8, 0xbc, 0xdf, 0xcd, 0x30, 0xec, 0x8b, 0x78, 0x96,
-	0x70, 0xf2, 0x0c, 0xd4, 0x02, 0x5c, 0xe5, 0x35, 0xda, 0x32, 0x7d, 0xb9, 0x2f, 0xe2, 0x5d, 0x76,
-	0xec, 0xe5, 0x3c, 0xf2, 0x1c, 0x74, 0x23, 0x7c, 0x29, 0x7c, 0x29, 0x32, 0xec, 0x4e, 0xe8, 0x41,
-	0xd5, 0xeb, 0x18, 0xf8, 0x30, 0x47, 0xdd, 0x23, 0xb8, 0x7a, 0x61, 0x97, 0xc3, 0xa2, 0x9b, 0x91,
-	0xd7, 0xa0, 0xbd, 0x68, 0x77, 0x21, 0x3b, 0x9e, 0xdf, 0x09, 0xdc, 0x6f, 0x2e, 0xb7, 0x73, 0xae,
-	0xf7, 0x5d, 0x74, 0xc6, 0x5d, 0x76, 0xec, 0xbe, 0xbf, 0x7c, 0xc4, 0xbb, 0xa9, 0x90, 0xb9, 0xef,
-	0xd7, 0xc1, 0x8e, 0xc5, 0x24, 0x0a, 0x68, 0xec, 0x47, 0xe1, 0x59, 0x5e, 0xca, 0x90, 0x43, 0x83,
-	0xf0, 0xec, 0x81, 0xb4, 0x94, 0x1e, 0x4c, 0xcb, 0x5f, 0x2a, 0xd0, 0x5e, 0x3e, 0x87, 0x7b, 0x17,
-	0x5a, 0x80, 0x75, 0xb1, 0x05, 0xcc, 0x9b, 0x7b, 0x69, 0xa9, 0xb9, 0xbb, 0x50, 0x99, 0x46, 0xdc,
-	0x34, 0x84, 0xa2, 0xa0, 0xd1, 0xe2, 0x77, 0x23, 0x1e, 0x7a, 0xc8, 0x23, 0xaf, 0x01, 0xd0, 0x30,
-	0xf4, 0xf3, 0x4c, 0x57, 0x30, 0xf2, 0xde, 0x42, 0xf2, 0xe2, 0x99, 0xec, 0xad, 0x78, 0x4d, 0x3a,
-	0x3f, 0xa0, 0x37, 0xc0, 0x0e, 0x53, 0x21, 0x0b, 0xdd, 0x2a, 0xea, 0x3e, 0x71, 0x49, 0x77, 0x91,
-	0x94, 0xbd, 0x15, 0x0f, 0xc2, 0x45, 0x8a, 0xde, 0x82, 0x56, 0x8a, 0xb5, 0xe5, 0x9b, 0xbe, 0x5e,
-	0x43, 0xf5, 0xf5, 0x4b, 0xea, 0x4b, 0xd5, 0xbc, 0xb7, 0xe2, 0xd9, 0xe9, 0x52, 0x71, 0xbf, 0x05,
-	0x9d, 0x19, 0xf6, 0x02, 0xbf, 0xb8, 0x16, 0xa6, 0xfd, 0x5c, 0xbd, 0x64, 0x22, 0xbf, 0x3f, 0x7b,
-	0x2b, 0x5e, 0xdb, 0xc8, 0x17, 0x17, 0xea, 0x0d, 0xb0, 0x0b, 0x03, 0x99, 0x4a, 0xb1, 0x27, 0x3d,
-	0xe8, 0xff, 0xe2, 0xde, 0x6a, 0xff, 0x73, 0x03, 0x99, 0x4a, 0xc9, 0x1b, 0x90, 0x9b, 0xf3, 0x25,
-	0x3e, 0x63, 0xbd, 0x26, 0xea, 0x3f, 0x76, 0x49, 0xdf, 0xbc, 0x71, 0x7b, 0x2b, 0x5e, 0xcb, 0x48,
-	0xe7, 0x6f, 0xde, 0x0e, 0xb4, 0x75, 0xda, 0xe7, 0xc5, 0xd4, 0x03, 0xd4, 0x7e, 0xf2, 0xc1, 0xcc,
-	0xcf, 0xeb, 0x4f, 0xdb, 0xa0, 0x17, 0xeb, 0x16, 0xf2, 0x0c, 0x06, 0x22, 0xee, 0xd9, 0x0f, 0x3d,
-	0xba, 0xf9, 0xf5, 0xd5, 0x47, 0x97, 0x16, 0xc4, 0x8e, 0x0d, 0x4d, 0x21, 0x59, 0x8a, 0x03, 0x90,
-	0xfb, 0xcf, 0x12, 0xd8, 0x47, 0xc1, 0x09, 0x4b, 0xe8, 0xdb, 0x67, 0x2a, 0xa5, 0xe4, 0x59, 0xe8,
-	0x72, 0x76, 0xa6, 0xb4, 0x55, 0x3f, 0x63, 0xf7, 0x74, 0x79, 0x9a, 0x02, 0x6e, 0x6b, 0xb8, 0x2f,
-	0xe2, 0x23, 0x04, 0x71, 0x6c, 0x48, 0x85, 0x94, 0x2c, 0xf4, 0xcd, 0x98, 0xaa, 0xa7, 0x27, 0x3d,
-	0x36, 0x18, 0xf0, 0x66, 0x3e, 0xa7, 0x76, 0x4c, 0x7d, 0xf8, 0xc1, 0x09, 0xe5, 0x13, 0x16, 0xe6,
-	0x13, 0x74, 0xdb, 0xa0, 0x7d, 0x03, 0x5e, 0x78, 0x5c, 0x2a, 0x17, 0x1f, 0x97, 0x47, 0xb4, 0x87,
-	0xea, 0x7f, 0xde, 0x1e, 0x6a, 0x5f, 0xa2, 0x3d, 0xd4, 0xff, 0x6d, 0x7b, 0x68, 0x7c, 0xe9, 0xf6,
-	0xd0, 0x7c, 0x68, 0x7b, 0x08, 0xa1, 0x31, 0xe0, 0xea, 0x5b, 0xaf, 0xec, 0x53, 0x49, 0x5c, 0xb0,
-	0x92, 0x7c, 0x7a, 0x34, 0x83, 0x60, 0xc1, 0xd9, 0xda, 0x37, 0x73, 0xa4, 0x95, 0xac, 0xbf, 0x02,
-	0x35, 0x43, 0xe8, 0xcf, 0x88, 0x29, 0x3b, 0xc7, 0x43, 0x29, 0x7b, 0x7a, 0x49, 0xd6, 0xa0, 0x7a,
-	0x4a, 0xe3, 0x99, 0x79, 0x7d, 0xcb, 0x9e, 0x21, 0x5e, 0x2f, 0xbd, 0x6a, 0xb9, 0xef, 0x42, 0x6b,
-	0x94, 0x52, 0x9e, 0xed, 0xb2, 0x4c, 0xbf, 0x85, 0xe4, 0x2a, 0xd4, 0xc4, 0xf8, 0xee, 0x20, 0x7f,
-	0x94, 0xaa, 0x5e, 0x4e, 0x69, 0x7c, 0x1c, 0x4f, 0x35, 0x6e, 0x9e, 0xcf, 0x9c, 0xd2, 0x78, 0x2a,
-	0xee, 0x6b, 0xbc, 0x6c, 0x70, 0x43, 0xb9, 0x3f, 0xb0, 0xc0, 0xde, 0x89, 0xa7, 0x68, 0x5b, 0x47,
-	0xf0, 0xe2, 0x22, 0x82, 0xc7, 0x4d, 0xd7, 0x5f, 0x30, 0xf3, 0x20, 0xf2, 0x0f, 0x13, 0x2b, 0x59,
-	0xbf, 0xf5, 0xb0, 0x50, 0xaa, 0x26, 0x94, 0xe7, 0x96, 0x43, 0xb1, 0xb7, 0x57, 0xcd, 0xa0, 0xbf,
-	0x14, 0xc2, 0x72, 0x74, 0x7b, 0x40, 0x8a, 0x7d, 0x8e, 0x59, 0xba, 0x23, 0xc4, 0x34, 0xe2, 0x13,
-	0xb2, 0x0d, 0x8d, 0x84, 0x4a, 0x19, 0xf1, 0x49, 0x96, 0xbb, 0xe4, 0x5c, 0x76, 0x29, 0xf7, 0x65,
-	0x2e, 0xe7, 0xfe, 0xbc, 0x04, 0x0e, 0x9e, 0x4d, 0x1f, 0x07, 0x7c, 0xe3, 0xdd, 0x43, 0x3f, 0x99,
-	0x1e, 0x83, 0x9a, 0x1a, 0xc7, 0x8b, 0xb7, 0xb6, 0xaa, 0xc6, 0xf1, 0x03, 0x33, 0x76, 0xf9, 0xf2,
-	0x8c, 0xfd, 0x4d, 0x68, 0x64, 0x8a, 0xa6, 0xca, 0xc7, 0x01, 0xe3, 0x91, 0x63, 0x54, 0xee, 0x57,
-	0x1d, 0x65, 0x47, 0x99, 0x6e, 0x24, 0x8b, 0xe2, 0xcc, 0x7a, 0xd5, 0x8d, 0xf2, 0x66, 0xcb, 0x83,
-	0xa4, 0xa8, 0xca, 0x0c, 0x3f, 0x70, 0x52, 0x46, 0x55, 0x21, 0x51, 0x43, 0x09, 0x3b, 0xc7, 0x50,
-	0xe4, 0x1b, 0x50, 0x1f, 0x9b, 0xcc, 0xe4, 0x2f, 0xe4, 0xc5, 0x03, 0x5a, 0x24, 0xce, 0x2b, 0xe4,
-	0xf4, 0xb6, 0xf9, 0x52, 0x7f, 0x3a, 0xe1, 0xd3, 0xd8, 0xf2, 0x20, 0x87, 0x6e, 0x8b, 0x40, 0x9f,
-	0x1b, 0x4b, 0x53, 0xac, 0xec, 0xa6, 0xa7, 0x97, 0xee, 0x8f, 0x4b, 0xd0, 0xc1, 0x04, 0x8e, 0x68,
-	0x36, 0xfd, 0x9f, 0xa7, 0xef, 0x71, 0xa8, 0x87, 0xe3, 0xe5, 0xe7, 0xa1, 0x16, 0x8e, 0x91, 0xe1,
-	0x42, 0x5b, 0x89, 0xfc, 0xb2, 0x2d, 0xa5, 0xc8, 0x56, 0x02, 0x9d, 0xc1, 0x04, 0x6c, 0xc1, 0x15,
-	0x96, 0xa9, 0x28, 0xc1, 0x2c, 0x25, 0x2c, 0xf1, 0x67, 0x19, 0x9d, 0x98, 0x8e, 0x53, 0xf1, 0x56,
-	0xe7, 0xac, 0x7d, 0x96, 0xdc, 0xd1, 0x0c, 0xed, 0x0b, 0x0d, 0x02, 0x31, 0xe3, 0x4a, 0xbb, 0x69,
-	0x5e, 0x84, 0x66, 0x8e, 0x0c, 0x42, 0xed, 0xcb, 0x2c, 0x63, 0xa9, 0xe6, 0x35, 0x90, 0x57, 0xd3,
-	0xa4, 0x61, 0xa4, 0xc2, 0xb4, 0xe7, 0xa6, 0x61, 0x68, 0x72, 0x10, 0xbe, 0xf0, 0x61, 0x09, 0x6a,
-	0x43, 0xd9, 0x17, 0x21, 0x23, 0x75, 0x28, 0x1f, 0x08, 0xe9, 0xac, 0x90, 0x55, 0x68, 0x0d, 0xe5,
-	0x2d, 0xa6, 0xf2, 0xaf, 0x55, 0xe7, 0xaf, 0x75, 0xe2, 0x80, 0x3d, 0x94, 0x87, 0x69, 0x5e, 0x82,
-	0xce, 0xdf, 0xea, 0xc4, 0xd6, 0x7a, 0x87, 0x11, 0x9f, 0x38, 0x9f, 0x76, 0x49, 0x0b, 0xea, 0x43,
-	0xf9, 0x4e, 0x3c, 0xcb, 0x4e, 0x9c, 0x5f, 0x74, 0x8d, 0xfe, 0xe2, 0x0b, 0xc7, 0xf9, 0x65, 0x97,
-	0x74, 0xa0, 0x39, 0x94, 0x03, 0x9e, 0x49, 0x16, 0x28, 0xe7, 0x57, 0x5d, 0xb2, 0x06, 0xdd, 0xa1,
-	0xbc, 0x19, 0x86, 0xef, 0xd0, 0x59, 0xac, 0x0e, 0x51, 0xea, 0xd7, 0x5d, 0xd2, 0x86, 0xc6, 0x50,
-	0xee, 0xd0, 0x60, 0x3a, 0x93, 0xce, 0x6f, 0xba, 0x66, 0xd3, 0x51, 0x4a, 0x03, 0x76, 0x24, 0x29,
-	0x77, 0x7e, 0xdb, 0x25, 0x57, 0xa0, 0x33, 0x94, 0x47, 0x4a, 0xa4, 0x74, 0xc2, 0x30, 0x21, 0xce,
-	0xef, 0xba, 0xe4, 0x71, 0x20, 0x43, 0x79, 0x2b, 0x16, 0x63, 0x1a, 0x2f, 0x6d, 0xfa, 0xfb, 0x2e,
-	0xb9, 0x0a, 0xab, 0x7a, 0x53, 0xc5, 0xd2, 0x80, 0x49, 0x95, 0xbb, 0xfe, 0x87, 0x2e, 0x21, 0xd0,
-	0xd6, 0x21, 0x6b, 0x12, 0x4f, 0xc2, 0xf9, 0x63, 0xf7, 0x85, 0x9f, 0x58, 0xd0, 0x9c, 0x0f, 0x20,
-	0xc4, 0x86, 0xfa, 0x80, 0x9f, 0xd2, 0x38, 0x0a, 0x9d, 0x15, 0xd2, 0x86, 0xe6, 0x7c, 0xcc, 0x70,
-	0x2c, 0xd2, 0x01, 0x58, 0x4c, 0x0e, 0x4e, 0x89, 0x74, 0xc1, 0x5e, 0x1a, 0x05, 0xcc, 0x27, 0xdf,
-	0x9d, 0xe5, 0x6e, 0xee, 0x54, 0xc8, 0x1a, 0x38, 0x05, 0x54, 0xf4, 0x6c, 0xa7, 0x4a, 0x1c, 0x68,
-	0xdd, 0x59, 0xea, 0xbc, 0x4e, 0x4d, 0x23, 0xcb, 0x7d, 0xd5, 0xd1, 0x89, 0x6f, 0xcd, 0x1b, 0xa5,
-	0xde, 0xaf, 0xf1, 0xc2, 0x2d, 0x68, 0xce, 0xdf, 0x76, 0xd2, 0x80, 0xca, 0xcd, 0x99, 0x12, 0xc6,
-	0xcb, 0x03, 0x61, 0xbe, 0x31, 0x33, 0xc7, 0x22, 0x2d, 0x68, 0xec, 0x44, 0x13, 0xe3, 0x52, 0x89,
-	0x5c, 0x81, 0x6e, 0x5f, 0x70, 0x15, 0xf1, 0x99, 0x98, 0x65, 0xf8, 0x0f, 0x81, 0x53, 0xde, 0x79,
-	0xf3, 0x93, 0xcf, 0xaf, 0x59, 0x9f, 0x7e, 0x7e, 0xcd, 0xfa, 0xec, 0xf3, 0x6b, 0x2b, 0x1f, 0xfd,
-	0xf9, 0x9a, 0xf5, 0xfe, 0xd7, 0x97, 0xfe, 0x04, 0x4c, 0xa8, 0x4a, 0xa3, 0x33, 0x91, 0x46, 0x93,
-	0x88, 0x17, 0x04, 0x67, 0x37, 0xe4, 0x74, 0x72, 0x43, 0x8e, 0x6f, 0x50, 0x19, 0x8d, 0x6b, 0xf8,
-	0x6f, 0xdf, 0xcb, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x24, 0x22, 0xee, 0x4b, 0x14, 0x00,
-	0x00,
+	// 2370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x24, 0xc5,
+	0x15, 0x77, 0xcf, 0xf7, 0xbc, 0x9e, 0x8f, 0x76, 0xad, 0x59, 0x06, 0x43, 0x76, 0x4d, 0x43, 0xc0,
+	0x40, 0xf0, 0x2a, 0x86, 0x24, 0x80, 0x10, 0x68, 0x3d, 0x86, 0xf5, 0x24, 0xeb, 0x1d, 0xa7, 0x67,
+	0x16, 0x24, 0x14, 0xa9, 0x55, 0xd3, 0x5d, 0x1e, 0xf7, 0x4e, 0x77, 0x55, 0xbb, 0xbb, 0xc6, 0x6b,
+	0x73, 0x4d, 0x50, 0xee, 0xb9, 0xe5, 0x06, 0xe7, 0x5c, 0x73, 0xc9, 0x25, 0x67, 0x8e, 0x44, 0xf9,
+	0x4e, 0xa4, 0x08, 0x91, 0x4b, 0x3e, 0xae, 0xf9, 0x03, 0xa2, 0x7a, 0xd5, 0xdd, 0x33, 0xf6, 0x9a,
+	0x05, 0xa2, 0x48, 0x5c, 0x46, 0xf5, 0x7e, 0xef, 0xa3, 0xde, 0xab, 0x7a, 0xfd, 0xde, 0xab, 0x81,
+	0x26, 0x8d, 0x83, 0xad, 0x38, 0x11, 0x52, 0x90, 0x32, 0x8d, 0x83, 0xf5, 0x17, 0xa7, 0x81, 0x3c,
+	0x9a, 0x4f, 0xb6, 0x3c, 0x11, 0xdd, 0x98, 0x8a, 0xa9, 0xb8, 0x81, 0xbc, 0xc9, 0xfc, 0x10, 0x29,
+	0x24, 0x70, 0xa5, 0x75, 0xd6, 0xbb, 0x32, 0x88, 0x58, 0x2a, 0x69, 0x14, 0x67, 0x00, 0xc4, 0x21,
+	0xe5, 0x7a, 0x6d, 0x7f, 0x0f, 0xda, 0xe3, 0x3b, 0x07, 0x01, 0x9f, 0x3a, 0xec, 0x78, 0xce, 0x52,
+	0x49, 0x9e, 0x80, 0x66, 0x4c, 0x13, 0x1a, 0x31, 0xc9, 0x92, 0x9e, 0xb1, 0x61, 0x6c, 0x36, 0x9d,
+	0x05, 0xf0, 0x5a, 0xe3, 0xc3, 0x8f, 0xae, 0x1b, 0x9f, 0x7e, 0x74, 0x7d, 0xc5, 0xfe, 0xa5, 0x01,
+	0x9d, 0x5c, 0x33, 0x8d, 0x05, 0x4f, 0x19, 0xe9, 0x41, 0x3d, 0x95, 0x22, 0x61, 0x83, 0xdd, 0x4c,
+	0x31, 0x27, 0xc9, 0x33, 0xd0, 0x49, 0x59, 0x72, 0x12, 0x78, 0xec, 0xa6, 0xef, 0x27, 0x2c, 0x4d,
+	0x7b, 0x25, 0x14, 0xb8, 0x80, 0xa2, 0x85, 0x23, 0x9a, 0xf8, 0x83, 0xdd, 0x5e, 0x79, 0xc3, 0xd8,
+	0xac, 0x38, 0x39, 0xa9, 0xdc, 0x4a, 0x58, 0x1c, 0x06, 0x1e, 0x1d, 0xec, 0xf6, 0x2a, 0xc8, 0x5b,
+	0x00, 0xe4, 0x1a, 0x40, 0x28, 0xa6, 0xa3, 0x4c, 0xb5, 0x8a, 0xec, 0x25, 0x64, 0xc9, 0xed, 0xd7,
+	0xc0, 0x1a, 0xdf, 0x19, 0xc9, 0x64, 0xd9, 0x6f, 0xb4, 0x2d, 0xe7, 0x09, 0x1f, 0xc9, 0x22, 0xe4,
+	0x02, 0x58, 0xd2, 0xfd, 0x85, 0x01, 0xb5, 0x77, 0x98, 0x27, 0x45, 0x42, 0x08, 0x54, 0x7c, 0x2a,
+	0x29, 0x4a, 0xb7, 0x1c, 0x5c, 0x93, 0x6b, 0x50, 0x91, 0x67, 0x31, 0xc3, 0xd0, 0xcc, 0x6d, 0xd8,
+	0xc2, 0x53, 0x1e, 0x9f, 0xc5, 0xcc, 0x41, 0x9c, 0xac, 0x43, 0x83, 0xcf, 0xc3, 0x90, 0x4e, 0x42,
+	0x86, 0xd1, 0x35, 0x9c, 0x82, 0x26, 0x16, 0x94, 0x79, 0x1a, 0x63, 0x60, 0x2d, 0x47, 0x2d, 0xc9,
+	0x63, 0xd0, 0x08, 0x52, 0xd7, 0x13, 0x3c, 0x95, 0x18, 0x50, 0xc3, 0xa9, 0x07, 0x69, 0x5f, 0x91,
+	0x4a, 0x38, 0x64, 0xbc, 0x57, 0xdb, 0x30, 0x36, 0xdb, 0x8e, 0x5a, 0x2a, 0x77, 0x68, 0xc2, 0x68,
+	0xaf, 0xae, 0xdd, 0x51, 0x6b, 0xfb, 0xfb, 0x50, 0xdd, 0xa1, 0xd2, 0x3b, 0x22, 0xeb, 0x50, 0xa5,
+	0x52, 0x26, 0x69, 0xcf, 0xd8, 0x28, 0x6f, 0x36, 0x77, 0x2a, 0x1f, 0xff, 0xed, 0xfa, 0x8a, 0xa3,
+	0x21, 0xf2, 0x4d, 0xa8, 0x9c, 0x30, 0x4f, 0x5d, 0x47, 0x79, 0xd3, 0xdc, 0x36, 0xb7, 0x54, 0xa6,
+	0xe9, 0x10, 0x33, 0x39, 0x64, 0xdb, 0xef, 0x40, 0x7d, 0xac, 0xfc, 0x1c, 0xec, 0x92, 0x2b, 0x50,
+	0xf5, 0x27, 0x6e, 0xe0, 0x63, 0xe8, 0x15, 0xa7, 0xe2, 0x4f, 0x06, 0xbe, 0x02, 0x25, 0x82, 0x25,
+	0x0d, 0x4a, 0x05, 0x3e, 0x09, 0xad, 0x98, 0x26, 0x32, 0x90, 0x81, 0xe0, 0x8a, 0xa7, 0x6f, 0xd4,
+	0x2c, 0xb0, 0x81, 0x6f, 0xff, 0xcc, 0x80, 0xce, 0xe8, 0x8c, 0x7b, 0xb7, 0xc5, 0x74, 0x4c, 0x83,
+	0xd0, 0x61, 0xc7, 0xe4, 0x45, 0xa8, 0x7b, 0xdc, 0x3d, 0xa2, 0x27, 0x0c, 0x77, 0x30, 0xb7, 0xd7,
+	0xb6, 0x16, 0xf9, 0x3b, 0xce, 0x57, 0x4e, 0xcd, 0xe3, 0x7b, 0xf4, 0x84, 0x65, 0xe2, 0xf7, 0x29,
+	0x97, 0xbd, 0xd2, 0xc3, 0xc5, 0xdf, 0xa5, 0x5c, 0x12, 0x1b, 0xaa, 0xb2, 0xb8, 0x00, 0x73, 0xbb,
+	0x85, 0x01, 0x67, 0xa1, 0x39, 0x9a, 0x65, 0xff, 0x08, 0xba, 0xe7, 0x7c, 0x4a, 0x63, 0x15, 0x8a,
+	0x37, 0x8b, 0xdd, 0x50, 0x78, 0x54, 0x79, 0x9e, 0x25, 0x89, 0xe9, 0xcd, 0xe2, 0xdb, 0x19, 0x44,
+	0x9e, 0x81, 0x86, 0x27, 0xa2, 0x88, 0x72, 0x3f, 0x3f, 0x4d, 0x40, 0xe3, 0x6f, 0x71, 0x99, 0x9c,
+	0x39, 0x05, 0xcf, 0x7e, 0x03, 0x56, 0x0f, 0x12, 0xa6, 0xc8, 0x40, 0xbe, 0x9b, 0x04, 0x92, 0xf5,
+	0x23, 0x9f, 0x3c, 0x07, 0xc0, 0x94, 0x9c, 0x1b, 0x06, 0xa9, 0xec, 0x19, 0x0f, 0xa8, 0x37, 0x91,
+	0x7b, 0x3b, 0x48, 0xa5, 0xfd, 0xef, 0x12, 0x54, 0x11, 0x24, 0x2f, 0xe5, 0x4a, 0x98, 0x75, 0xca,
+	0xa5, 0xce, 0xf6, 0xda, 0x42, 0x49, 0xff, 0x62, 0xfe, 0x35, 0x59, 0xbe, 0x54, 0x69, 0x85, 0x51,
+	0x2e, 0x2e, 0xab, 0x8e, 0xf4, 0xc0, 0x27, 0xd7, 0xc1, 0x54, 0x79, 0x3c, 0xa1, 0x29, 0x5b, 0x5c,
+	0x17, 0xe4, 0xd0, 0xc0, 0x27, 0xdf, 0x00, 0xd0, 0xba, 0x9c, 0x46, 0x0c, 0x73, 0xb5, 0xe9, 0x34,
+	0x11, 0xb9, 0x43, 0x23, 0x46, 0x9e, 0x82, 0x76, 0xa1, 0x8f, 0x12, 0x55, 0x94, 0x68, 0xe5, 0x20,
+	0x0a, 0x3d, 0x0e, 0xcd, 0xc3, 0x20, 0x37, 0x51, 0x43, 0x81, 0x86, 0x02, 0x90, 0xf9, 0x04, 0x94,
+	0x27, 0x54, 0x62, 0x16, 0xe7, 0xf1, 0x63, 0x0a, 0x3b, 0x0a, 0x26, 0x4f, 0x41, 0x27, 0x9e, 0xb9,
+	0xde, 0x11, 0xf3, 0x66, 0xee, 0xe4, 0xcc, 0x95, 0xbc, 0xd7, 0xd8, 0x30, 0x36, 0xab, 0x8e, 0x19,
+	0xcf, 0xfa, 0x0a, 0xdc, 0x39, 0x1b, 0x73, 0x7b, 0x1f, 0x9a, 0x45, 0xdc, 0x04, 0xa0, 0x36, 0xe0,
+	0x29, 0x4b, 0xa4, 0xb5, 0xa2, 0xd6, 0xbb, 0x2c, 0x64, 0x92, 0x59, 0x86, 0x5a, 0xdf, 0x8d, 0x7d,
+	0x2a, 0x99, 0x55, 0x22, 0x4d, 0xa8, 0xde, 0x0c, 0x25, 0x4b, 0xac, 0x32, 0x59, 0x85, 0xf6, 0x28,
+	0x66, 0x5e, 0x40, 0xc3, 0x4c, 0xb2, 0x62, 0xff, 0xc4, 0x00, 0x40, 0xe3, 0xb1, 0x08, 0xb8, 0x24,
+	0x2f, 0x40, 0x2d, 0x0a, 0xb8, 0x2b, 0xd3, 0x87, 0xe6, 0x66, 0x35, 0x0a, 0xf8, 0x38, 0x45, 0x61,
+	0x7a, 0xaa, 0x84, 0x4b, 0x0f, 0x15, 0xa6, 0xa7, 0xe3, 0x34, 0x0f, 0xbd, 0x7c, 0x69, 0xe8, 0xda,
+	0x0d, 0x2a, 0x69, 0x28, 0xa6, 0xfd, 0x59, 0xfc, 0xb5, 0xb9, 0xf1, 0x81, 0x01, 0xe6, 0x3e, 0x93,
+	0x54, 0xdd, 0xe8, 0xd7, 0xe9, 0xc7, 0xbf, 0x0c, 0xb0, 0xf0, 0xd2, 0xf0, 0xcb, 0x3d, 0x10, 0x61,
+	0xe0, 0x9d, 0x91, 0x2d, 0xb8, 0xa2, 0x9c, 0x11, 0x69, 0xf0, 0x3e, 0x73, 0x8f, 0xe7, 0x34, 0x08,
+	0x83, 0x43, 0xa6, 0xcb, 0x54, 0xdb, 0x59, 0x8d, 0x02, 0x3e, 0x54, 0x9c, 0x1f, 0xe6, 0x0c, 0xf2,
+	0x34, 0x74, 0x94, 0x3f, 0x62, 0x72, 0xcf, 0x15, 0x9c, 0x25, 0x73, 0x8e, 0x7e, 0xb5, 0x9d, 0x56,
+	0x44, 0x4f, 0x87, 0x93, 0x7b, 0x43, 0xc4, 0xc8, 0x0d, 0x58, 0x43, 0x29, 0xb4, 0x1a, 0xb1, 0x64,
+	0xca, 0x7c, 0xa5, 0xd2, 0x2b, 0x67, 0x66, 0xe9, 0x29, 0x9a, 0xdd, 0x47, 0xce, 0x70, 0x72, 0x8f,
+	0x3c, 0x0d, 0xd5, 0xa3, 0x80, 0xcb, 0xb4, 0x57, 0xd9, 0x28, 0x6f, 0x76, 0xb6, 0x3b, 0xe8, 0x3b,
+	0xb2, 0xf7, 0x02, 0x2e, 0x1d, 0xcd, 0x24, 0xcf, 0x81, 0xf2, 0xc8, 0xf5, 0xb8, 0xb6, 0xe9, 0x2a,
+	0x1b, 0x59, 0xdf, 0xea, 0x44, 0x01, 0xef, 0x73, 0xd4, 0x18, 0x05, 0xef, 0x33, 0xfb, 0x15, 0x58,
+	0x5b, 0xc4, 0x8a, 0x0d, 0x20, 0xa1, 0x2a, 0x17, 0x37, 0xc0, 0xf4, 0x0a, 0x2a, 0xcd, 0x3a, 0xd1,
+	0x32, 0x64, 0xbf, 0x08, 0xab, 0xcb, 0x9a, 0x51, 0xc4, 0xb8, 0x54, 0x2d, 0xd6, 0xd3, 0xcb, 0xbc,
+	0x49, 0x67, 0xa4, 0xfd, 0x53, 0x03, 0x1e, 0x59, 0xc8, 0x3b, 0x4c, 0x7d, 0xa2, 0xb8, 0x54, 0x45,
+	0x43, 0x84, 0xbe, 0xfe, 0x66, 0x33, 0x25, 0x11, 0xfa, 0xf8, 0xc9, 0x3e, 0x06, 0x0d, 0xce, 0xee,
+	0x6b, 0x96, 0xee, 0xe9, 0x75, 0xce, 0xee, 0xe7, 0xac, 0x84, 0x85, 0xee, 0x2c, 0xe0, 0xba, 0x98,
+	0x34, 0x9d, 0x7a, 0xc2, 0xc2, 0x1f, 0x04, 0x1c, 0x2b, 0x89, 0x97, 0x30, 0x2a, 0x99, 0x9b, 0x1e,
+	0x87, 0x79, 0x25, 0xd1, 0xc8, 0xe8, 0x38, 0xb4, 0x39, 0x5c, 0xb9, 0xe8, 0x48, 0x5f, 0x84, 0xff,
+	0xa3, 0x1b, 0x4f, 0x42, 0x2b, 0x55, 0xb3, 0x0d, 0xf7, 0x98, 0xcb, 0xe7, 0x51, 0x76, 0x73, 0x66,
+	0x8e, 0xdd, 0x99, 0x47, 0x76, 0xb8, 0xbc, 0xdf, 0xbe, 0xf0, 0x83, 0xc3, 0x33, 0xb5, 0x1f, 0x81,
+	0xca, 0xd2, 0x5e, 0xb8, 0xfe, 0xc2, 0x26, 0xff, 0x25, 0x76, 0xf3, 0x97, 0x77, 0xbb, 0xe9, 0xfb,
+	0x7d, 0x11, 0xce, 0x23, 0x4e, 0x9e, 0x86, 0x9a, 0x87, 0xab, 0xec, 0x63, 0x6a, 0x69, 0xdb, 0x7d,
+	0x11, 0xee, 0xb2, 0x43, 0x27, 0xe3, 0x91, 0x67, 0xa1, 0x1b, 0x60, 0x49, 0x73, 0x63, 0x91, 0x62,
+	0x1b, 0x45, 0x57, 0xaa, 0x4e, 0x47, 0xc3, 0x07, 0x19, 0x6a, 0x8f, 0xe0, 0xea, 0xb9, 0x5d, 0x0e,
+	0xf2, 0xb6, 0x4b, 0x5e, 0x85, 0xf6, 0xa2, 0x2f, 0xfb, 0xec, 0xb0, 0xf8, 0x78, 0x71, 0xbf, 0x42,
+	0x6e, 0xe7, 0x4c, 0xed, 0xbb, 0x68, 0xe1, 0xbb, 0xec, 0xd0, 0x7e, 0x6f, 0x39, 0x17, 0x77, 0x13,
+	0x11, 0x67, 0xbe, 0x5f, 0x07, 0x33, 0x14, 0xd3, 0xc0, 0xa3, 0xa1, 0x1b, 0xf8, 0xa7, 0xd9, 0x37,
+	0x07, 0x19, 0x34, 0xf0, 0x4f, 0x1f, 0x38, 0x96, 0xd2, 0x83, 0xc7, 0xf2, 0x51, 0x15, 0xda, 0xcb,
+	0xb7, 0x7e, 0x7c, 0xae, 0x57, 0x19, 0xe7, 0x7b, 0x55, 0x31, 0x85, 0x94, 0x96, 0xa6, 0x10, 0x1b,
+	0x2a, 0x45, 0xb2, 0xe5, 0x5f, 0x1e, 0x5a, 0x54, 0x39, 0xe7, 0x20, 0x8f, 0xbc, 0x0a, 0x40, 0x7d,
+	0xdf, 0xcd, 0x4e, 0xba, 0x82, 0x91, 0xf7, 0x16, 0x92, 0xe7, 0xef, 0x64, 0x6f, 0xc5, 0x69, 0xd2,
+	0x9c, 0x20, 0xaf, 0x83, 0xe9, 0x27, 0x22, 0xce, 0x75, 0xab, 0xa8, 0xfb, 0xd8, 0x05, 0xdd, 0xc5,
+	0xa1, 0xec, 0xad, 0x38, 0xe0, 0x17, 0x14, 0x79, 0x13, 0x5a, 0x09, 0x66, 0xb2, 0xab, 0x07, 0x90,
+	0x1a, 0xaa, 0xaf, 0x5f, 0x50, 0x5f, 0xfa, 0xea, 0xf6, 0x56, 0x1c, 0x33, 0x59, 0x90, 0xe4, 0x4d,
+	0xe8, 0xcc, 0xb1, 0x69, 0xb9, 0xf9, 0xf7, 0xab, 0xfb, 0xe4, 0xd5, 0x0b, 0x26, 0xb2, 0x0f, 0x7d,
+	0x6f, 0xc5, 0x69, 0x6b, 0xf9, 0x0c, 0x50, 0xfe, 0xe7, 0x06, 0x52, 0x99, 0xf4, 0x1a, 0x97, 0xfa,
+	0xbf, 0x28, 0x30, 0xca, 0xff, 0xcc, 0x40, 0x2a, 0x13, 0xf2, 0x3a, 0x64, 0xe6, 0xdc, 0x18, 0xeb,
+	0x6d, 0xaf, 0x89, 0xfa, 0x8f, 0x5c, 0xd0, 0xd7, 0xc5, 0x78, 0x6f, 0xc5, 0x69, 0x69, 0x69, 0x4d,
+	0x93, 0x1d, 0x68, 0xab, 0x63, 0x2f, 0x92, 0xa9, 0x07, 0xa8, 0xfd, 0xf8, 0x83, 0x27, 0x5f, 0xe4,
+	0x9f, 0xb2, 0x41, 0xcf, 0xe7, 0x2d, 0x64, 0x27, 0xe8, 0x89, 0xb0, 0x67, 0x5e, 0x7a, 0x75, 0x45,
+	0xb1, 0x50, 0x57, 0x97, 0xe4, 0x84, 0x52, 0x8d, 0xf0, 0xb3, 0x46, 0xd5, 0xd6, 0xa5, 0xaa, 0xc5,
+	0x77, 0xaf, 0x54, 0xa3, 0x9c, 0xd8, 0x31, 0xa1, 0x29, 0x62, 0x96, 0xe0, 0x90, 0x67, 0xff, 0xa7,
+	0x04, 0xe6, 0xc8, 0x3b, 0x62, 0x11, 0x7d, 0xeb, 0x54, 0x26, 0x94, 0x3c, 0x03, 0x5d, 0xce, 0x4e,
+	0xa5, 0xb2, 0xea, 0xa6, 0xec, 0x58, 0x65, 0xb6, 0xce, 0xfd, 0xb6, 0x82, 0xfb, 0x22, 0x1c, 0x21,
+	0x88, 0xa3, 0x51, 0x22, 0xe2, 0x98, 0xf9, 0xae, 0x1e, 0xc5, 0xd5, 0x84, 0xa8, 0x46, 0x23, 0x0d,
+	0xde, 0xcc, 0x66, 0xf1, 0x8e, 0x4e, 0x2d, 0xd7, 0x3b, 0xa2, 0x7c, 0xca, 0xfc, 0xec, 0x95, 0xd0,
+	0xd6, 0x68, 0x5f, 0x83, 0xe7, 0xaa, 0x60, 0xe5, 0x7c, 0x15, 0xfc, 0x9c, 0x16, 0x58, 0xfd, 0xf2,
+	0x2d, 0xb0, 0xf6, 0x15, 0x5a, 0x60, 0xfd, 0x0b, 0x5b, 0x60, 0xe3, 0x2b, 0xb7, 0xc0, 0xe6, 0xa5,
+	0x2d, 0xd0, 0x87, 0xc6, 0x80, 0xcb, 0xef, 0xbe, 0xbc, 0x4f, 0x63, 0x62, 0x83, 0x11, 0x65, 0x13,
+	0xb2, 0x1e, 0x76, 0x73, 0xce, 0xd6, 0xbe, 0x9e, 0x95, 0x8d, 0x68, 0xfd, 0x65, 0xa8, 0x69, 0x42,
+	0x3d, 0x95, 0x66, 0xec, 0x0c, 0x2f, 0xa5, 0xec, 0xa8, 0x25, 0x59, 0x83, 0xea, 0x09, 0x0d, 0xe7,
+	0xba, 0x82, 0x97, 0x1d, 0x4d, 0xbc, 0x56, 0x7a, 0xc5, 0xb0, 0xdf, 0x81, 0xd6, 0x38, 0xa1, 0x3c,
+	0xdd, 0x65, 0xa9, 0x2a, 0xa3, 0xe4, 0x2a, 0xd4, 0xc4, 0xe4, 0xde, 0x20, 0xab, 0x67, 0x55, 0x27,
+	0xa3, 0x14, 0x3e, 0x09, 0x67, 0x0a, 0xd7, 0x95, 0x37, 0xa3, 0x14, 0x9e, 0x88, 0xfb, 0x0a, 0x2f,
+	0x6b, 0x5c, 0x53, 0xf6, 0x8f, 0x0d, 0x30, 0x77, 0xc2, 0x19, 0xda, 0x56, 0x11, 0xbc, 0xb0, 0x88,
+	0xe0, 0x51, 0x3d, 0xd9, 0x2c, 0x98, 0x59, 0x10, 0xd9, 0xe3, 0xcb, 0x88, 0xd6, 0x6f, 0x5d, 0x16,
+	0x4a, 0x55, 0x87, 0xf2, 0xec, 0x72, 0x28, 0xe6, 0xf6, 0xaa, 0x7e, 0xcc, 0x2c, 0x85, 0xb0, 0x1c,
+	0xdd, 0x1e, 0x90, 0x7c, 0x9f, 0x43, 0x96, 0xec, 0x08, 0x31, 0x0b, 0xf8, 0x94, 0x6c, 0x43, 0x23,
+	0xa2, 0x71, 0x1c, 0xf0, 0x69, 0x9a, 0xb9, 0x64, 0x5d, 0x74, 0x29, 0xf3, 0xa5, 0x90, 0xb3, 0x7f,
+	0x5d, 0x02, 0x0b, 0xef, 0xa6, 0x8f, 0x8f, 0x18, 0xed, 0xdd, 0xa5, 0xcf, 0xc2, 0x47, 0xa0, 0x26,
+	0x27, 0xe1, 0xa2, 0x4c, 0x57, 0xe5, 0x24, 0x7c, 0xe0, 0x1d, 0x51, 0xbe, 0xf8, 0x8e, 0xf8, 0x0e,
+	0x34, 0x52, 0x49, 0x13, 0xe9, 0xe2, 0x10, 0xf5, 0xb9, 0xa3, 0x62, 0xe6, 0x57, 0x1d, 0x65, 0xc7,
+	0xa9, 0xea, 0x41, 0x8b, 0xe4, 0x4c, 0x7b, 0xd5, 0x8d, 0xf2, 0x66, 0xcb, 0x81, 0x28, 0xcf, 0xca,
+	0x14, 0x1f, 0x71, 0x38, 0x62, 0x64, 0x12, 0x35, 0x94, 0x30, 0x33, 0x0c, 0x45, 0xbe, 0x0d, 0xf5,
+	0x89, 0x3e, 0x99, 0xac, 0xb8, 0x9e, 0xbf, 0xa0, 0xc5, 0xc1, 0x39, 0xb9, 0x9c, 0xda, 0x36, 0x5b,
+	0xaa, 0xe7, 0x21, 0x56, 0xd5, 0x96, 0x03, 0x19, 0x74, 0x5b, 0x78, 0xea, 0xde, 0x58, 0x92, 0x60,
+	0x66, 0x37, 0x1d, 0xb5, 0xb4, 0x7f, 0x5e, 0x82, 0x0e, 0x1e, 0xe0, 0x98, 0xa6, 0xb3, 0xff, 0xfb,
+	0xf1, 0x3d, 0x0a, 0x75, 0x7f, 0xb2, 0x5c, 0x1e, 0x6a, 0xfe, 0x04, 0x19, 0x36, 0xb4, 0xa5, 0xc8,
+	0x3e, 0xb6, 0xa5, 0x23, 0x32, 0xa5, 0x40, 0x67, 0xf0, 0x00, 0xb6, 0xe0, 0x0a, 0x4b, 0x65, 0x10,
+	0xe1, 0x29, 0x45, 0x2c, 0x72, 0xe7, 0x29, 0x9d, 0xea, 0x66, 0x55, 0x71, 0x56, 0x0b, 0xd6, 0x3e,
+	0x8b, 0xee, 0x2a, 0x86, 0xf2, 0x85, 0x7a, 0x9e, 0x98, 0x73, 0xa9, 0xdc, 0xd4, 0x15, 0xa1, 0x99,
+	0x21, 0x03, 0x5f, 0xf9, 0x32, 0x4f, 0x59, 0xa2, 0x78, 0x0d, 0xe4, 0xd5, 0x14, 0xa9, 0x19, 0x89,
+	0xd0, 0x9d, 0xbd, 0xa9, 0x19, 0x8a, 0x1c, 0xf8, 0xcf, 0x7f, 0x50, 0x82, 0xda, 0x30, 0xee, 0x0b,
+	0x9f, 0x91, 0x3a, 0x94, 0xef, 0x88, 0xd8, 0x5a, 0x21, 0xab, 0xd0, 0x1a, 0xc6, 0xb7, 0x98, 0xcc,
+	0x5e, 0xe4, 0xd6, 0x3f, 0xea, 0xc4, 0x02, 0x73, 0x18, 0x1f, 0x24, 0x59, 0x0a, 0x5a, 0xff, 0xac,
+	0x13, 0x53, 0xe9, 0xa9, 0xbf, 0xa3, 0xac, 0x4f, 0xba, 0xa4, 0x05, 0xf5, 0x61, 0xfc, 0x76, 0x38,
+	0x4f, 0x8f, 0xac, 0xdf, 0x74, 0xb5, 0xfe, 0xe2, 0x15, 0x67, 0xfd, 0xb6, 0x4b, 0x3a, 0xd0, 0x1c,
+	0xc6, 0x03, 0x9e, 0xc6, 0xcc, 0x93, 0xd6, 0xef, 0xba, 0x64, 0x0d, 0xba, 0xc3, 0xf8, 0xa6, 0xef,
+	0xbf, 0x4d, 0xe7, 0xa1, 0x3c, 0x40, 0xa9, 0xdf, 0x77, 0x49, 0x1b, 0x1a, 0xc3, 0x78, 0x87, 0x7a,
+	0xb3, 0x79, 0x6c, 0xfd, 0xa1, 0xab, 0x37, 0x1d, 0x27, 0xd4, 0x63, 0xa3, 0x98, 0x72, 0xeb, 0x8f,
+	0x5d, 0x72, 0x05, 0x3a, 0xc3, 0x78, 0x24, 0x45, 0x42, 0xa7, 0x0c, 0x0f, 0xc4, 0xfa, 0x53, 0x97,
+	0x3c, 0x0a, 0x64, 0x18, 0xdf, 0x0a, 0xc5, 0x84, 0x86, 0x4b, 0x9b, 0xfe, 0xb9, 0x4b, 0xae, 0xc2,
+	0xaa, 0xda, 0x54, 0xb2, 0xc4, 0x63, 0xb1, 0xcc, 0x5c, 0xff, 0x4b, 0x97, 0x10, 0x68, 0x0f, 0x63,
+	0x4d, 0xe2, 0x4d, 0x58, 0x7f, 0xed, 0x3e, 0xff, 0x2b, 0x03, 0x9a, 0xc5, 0xec, 0x42, 0x4c, 0xa8,
+	0x0f, 0xf8, 0x09, 0x0d, 0x03, 0xdf, 0x5a, 0x21, 0x6d, 0x68, 0x16, 0x13, 0x8a, 0x65, 0x90, 0x0e,
+	0xc0, 0x62, 0xe8, 0xb0, 0x4a, 0xa4, 0x0b, 0xe6, 0xd2, 0x14, 0xa1, 0x9f, 0xb5, 0x77, 0x97, 0x07,
+	0x01, 0xab, 0x42, 0xd6, 0xc0, 0xca, 0xa1, 0xbc, 0xdd, 0x5b, 0x55, 0x62, 0x41, 0xeb, 0xee, 0x52,
+	0xd3, 0xb6, 0x6a, 0x0a, 0x59, 0x6e, 0xc9, 0x96, 0x3a, 0xf8, 0x56, 0xd1, 0x63, 0xd5, 0x7e, 0x0d,
+	0x85, 0x14, 0xad, 0x53, 0x21, 0xcd, 0xe7, 0x6f, 0x41, 0xb3, 0xa8, 0xf6, 0xa4, 0x01, 0x95, 0x9b,
+	0x73, 0x29, 0xb4, 0xdf, 0x77, 0x84, 0x7e, 0x59, 0xa7, 0x96, 0x41, 0x5a, 0xd0, 0xd8, 0x09, 0xa6,
+	0xda, 0xc9, 0x12, 0xb9, 0x02, 0xdd, 0xbe, 0xe0, 0x32, 0xe0, 0x73, 0x31, 0x4f, 0xf1, 0x7f, 0x11,
+	0xab, 0xbc, 0xf3, 0xc6, 0xc7, 0x9f, 0x5d, 0x33, 0x3e, 0xf9, 0xec, 0x9a, 0xf1, 0xe9, 0x67, 0xd7,
+	0x56, 0x3e, 0xfc, 0xfb, 0x35, 0xe3, 0xbd, 0x6f, 0x2d, 0xfd, 0xf5, 0x19, 0x51, 0x99, 0x04, 0xa7,
+	0x22, 0x09, 0xa6, 0x01, 0xcf, 0x09, 0xce, 0x6e, 0xc4, 0xb3, 0xe9, 0x8d, 0x78, 0x72, 0x83, 0xc6,
+	0xc1, 0xa4, 0x86, 0xff, 0x71, 0xbe, 0xf4, 0xdf, 0x01, 0x00, 0xcc, 0xe4, 0xac, 0xac, 0x41, 0x15,
+	0x00, 0x00,
 }
 
 func (m *TNPingRequest) Marshal() (dAtA []byte, err error) {
@@ -3317,6 +3420,20 @@ func (m *AlterTableRenameTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CreateSql) > 0 {
+		i -= len(m.CreateSql)
+		copy(dAtA[i:], m.CreateSql)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.CreateSql)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelKind) > 0 {
+		i -= len(m.RelKind)
+		copy(dAtA[i:], m.RelKind)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.RelKind)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.NewName) > 0 {
 		i -= len(m.NewName)
 		copy(dAtA[i:], m.NewName)
@@ -3380,6 +3497,57 @@ func (m *AlterTableRenameCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableModifyCol) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableModifyCol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableModifyCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SequenceNum != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != nil {
+		{
+			size, err := m.Type.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableAddColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3719,6 +3887,27 @@ func (m *AlterTableReq_RenameCol) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTableReq_ModifyCol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_ModifyCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyCol != nil {
+		{
+			size, err := m.ModifyCol.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3749,20 +3938,20 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.Hints) > 0 {
-		dAtA28 := make([]byte, len(m.Hints)*10)
-		var j27 int
+		dAtA30 := make([]byte, len(m.Hints)*10)
+		var j29 int
 		for _, num := range m.Hints {
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j29++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA30[j29] = uint8(num)
+			j29++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintApi(dAtA, i, uint64(j27))
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintApi(dAtA, i, uint64(j29))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -4574,6 +4763,14 @@ func (m *AlterTableRenameTable) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	l = len(m.RelKind)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.CreateSql)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4603,6 +4800,29 @@ func (m *AlterTableRenameCol) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableModifyCol) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Type != nil {
+		l = m.Type.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SequenceNum != 0 {
+		n += 1 + sovApi(uint64(m.SequenceNum))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableAddColumn) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4776,6 +4996,18 @@ func (m *AlterTableReq_RenameCol) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTableReq_ModifyCol) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyCol != nil {
+		l = m.ModifyCol.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
 func (m *SchemaExtra) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -7366,6 +7598,70 @@ func (m *AlterTableRenameTable) Unmarshal(dAtA []byte) error {
 			}
 			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelKind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelKind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -7522,6 +7818,144 @@ func (m *AlterTableRenameCol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableModifyCol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableModifyCol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableModifyCol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Type == nil {
+				m.Type = &plan.Type{}
+			}
+			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
+			}
+			m.SequenceNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableAddColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8170,6 +8604,41 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &AlterTableReq_RenameCol{v}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyCol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableModifyCol{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_ModifyCol{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
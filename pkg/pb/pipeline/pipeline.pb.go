@@ -4087,11 +4087,16 @@ func (m *NodeInfo) GetType() uint32 {
 }
 
 type ProcessLimitation struct {
-	Size                 int64    `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
-	BatchRows            int64    `protobuf:"varint,2,opt,name=batch_rows,json=batchRows,proto3" json:"batch_rows,omitempty"`
-	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
-	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	Size          int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	BatchRows     int64 `protobuf:"varint,2,opt,name=batch_rows,json=batchRows,proto3" json:"batch_rows,omitempty"`
+	BatchSize     int64 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	PartitionRows int64 `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
+	ReaderSize    int64 `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	MemoryQuota   int64 `protobuf:"varint,6,opt,name=memory_quota,json=memoryQuota,proto3" json:"memory_quota,omitempty"`
+	CpuShare      int64 `protobuf:"varint,7,opt,name=cpu_share,json=cpuShare,proto3" json:"cpu_share,omitempty"`
+	// the resource group of the statement and its memory budget on each CN
+	ResourceGroup        string   `protobuf:"bytes,8,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	MemoryBudget         int64    `protobuf:"varint,9,opt,name=memory_budget,json=memoryBudget,proto3" json:"memory_budget,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4165,19 +4170,49 @@ func (m *ProcessLimitation) GetReaderSize() int64 {
 	return 0
 }
 
+func (m *ProcessLimitation) GetMemoryQuota() int64 {
+	if m != nil {
+		return m.MemoryQuota
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetCpuShare() int64 {
+	if m != nil {
+		return m.CpuShare
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
+}
+
+func (m *ProcessLimitation) GetMemoryBudget() int64 {
+	if m != nil {
+		return m.MemoryBudget
+	}
+	return 0
+}
+
 type ProcessInfo struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sql                  string            `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
-	Lim                  ProcessLimitation `protobuf:"bytes,3,opt,name=lim,proto3" json:"lim"`
-	UnixTime             int64             `protobuf:"varint,4,opt,name=unix_time,json=unixTime,proto3" json:"unix_time,omitempty"`
-	AccountId            uint32            `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Snapshot             txn.CNTxnSnapshot `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot"`
-	SessionInfo          SessionInfo       `protobuf:"bytes,7,opt,name=session_info,json=sessionInfo,proto3" json:"session_info"`
-	AnalysisNodeList     []int32           `protobuf:"varint,8,rep,packed,name=analysis_node_list,json=analysisNodeList,proto3" json:"analysis_node_list,omitempty"`
-	SessionLogger        SessionLoggerInfo `protobuf:"bytes,9,opt,name=session_logger,json=sessionLogger,proto3" json:"session_logger"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id               string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sql              string            `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	Lim              ProcessLimitation `protobuf:"bytes,3,opt,name=lim,proto3" json:"lim"`
+	UnixTime         int64             `protobuf:"varint,4,opt,name=unix_time,json=unixTime,proto3" json:"unix_time,omitempty"`
+	AccountId        uint32            `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Snapshot         txn.CNTxnSnapshot `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot"`
+	SessionInfo      SessionInfo       `protobuf:"bytes,7,opt,name=session_info,json=sessionInfo,proto3" json:"session_info"`
+	AnalysisNodeList []int32           `protobuf:"varint,8,rep,packed,name=analysis_node_list,json=analysisNodeList,proto3" json:"analysis_node_list,omitempty"`
+	SessionLogger    SessionLoggerInfo `protobuf:"bytes,9,opt,name=session_logger,json=sessionLogger,proto3" json:"session_logger"`
+	// deadline is the unix nano time the statement must finish by, 0 means none.
+	Deadline             int64    `protobuf:"varint,10,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
@@ -4276,6 +4311,13 @@ func (m *ProcessInfo) GetSessionLogger() SessionLoggerInfo {
 	return SessionLoggerInfo{}
 }
 
+func (m *ProcessInfo) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
 type SessionInfo struct {
 	User                 string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
@@ -4799,301 +4841,304 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 4701 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7b, 0xcd, 0x93, 0x1c, 0x47,
-	0x56, 0xb8, 0xfa, 0xbb, 0xea, 0x75, 0xf7, 0x4c, 0x4f, 0xea, 0xab, 0x2d, 0xcb, 0xd2, 0xa8, 0x6c,
-	0xc9, 0xb3, 0xb2, 0x35, 0x5a, 0x8f, 0xd7, 0xbf, 0x9f, 0x83, 0xc5, 0xeb, 0x1d, 0xcd, 0x48, 0x4b,
-	0xaf, 0xa5, 0xd1, 0x90, 0x33, 0xc2, 0x81, 0x0f, 0x14, 0x35, 0x55, 0xd9, 0x3d, 0xe5, 0xa9, 0xae,
-	0x2c, 0xd5, 0x87, 0x34, 0xa3, 0x3f, 0x80, 0x08, 0x38, 0x70, 0xe1, 0x0f, 0x60, 0x83, 0x23, 0x11,
-	0x10, 0x04, 0xfc, 0x13, 0x7b, 0x83, 0x33, 0x07, 0x96, 0x30, 0x17, 0x08, 0x20, 0xe0, 0x00, 0xdc,
-	0x20, 0x88, 0xf7, 0x32, 0xeb, 0xa3, 0x7b, 0x5a, 0xe3, 0xcf, 0x35, 0x10, 0xe1, 0x5b, 0xe6, 0xfb,
-	0xc8, 0xca, 0x7c, 0xef, 0xe5, 0xcb, 0xf7, 0x32, 0x5f, 0xc1, 0x52, 0xe4, 0x47, 0x22, 0xf0, 0x43,
-	0xb1, 0x1e, 0xc5, 0x32, 0x95, 0xcc, 0xc8, 0xfb, 0x57, 0xee, 0x4c, 0xfc, 0xf4, 0x30, 0x3b, 0x58,
-	0x77, 0xe5, 0xf4, 0xee, 0x44, 0x4e, 0xe4, 0x5d, 0x22, 0x38, 0xc8, 0xc6, 0xd4, 0xa3, 0x0e, 0xb5,
-	0x14, 0xe3, 0x15, 0x88, 0x02, 0x27, 0xd4, 0xed, 0xe5, 0xd4, 0x9f, 0x8a, 0x24, 0x75, 0xa6, 0x51,
-	0x8e, 0x0c, 0xa4, 0x7b, 0xa4, 0xdb, 0x66, 0x7a, 0xac, 0xe9, 0xac, 0x3f, 0xab, 0x43, 0xe7, 0x91,
-	0x48, 0x12, 0x67, 0x22, 0x98, 0x05, 0x8d, 0xc4, 0xf7, 0x86, 0xb5, 0xd5, 0xda, 0xda, 0xd2, 0xc6,
-	0x60, 0xbd, 0x98, 0xd6, 0x5e, 0xea, 0xa4, 0x59, 0xc2, 0x11, 0x89, 0x34, 0xee, 0xd4, 0x1b, 0xd6,
-	0xe7, 0x69, 0x1e, 0x89, 0xf4, 0x50, 0x7a, 0x1c, 0x91, 0x6c, 0x00, 0x0d, 0x11, 0xc7, 0xc3, 0xc6,
-	0x6a, 0x6d, 0xad, 0xc7, 0xb1, 0xc9, 0x18, 0x34, 0x3d, 0x27, 0x75, 0x86, 0x4d, 0x02, 0x51, 0x9b,
-	0xbd, 0x01, 0x4b, 0x51, 0x2c, 0x5d, 0xdb, 0x0f, 0xc7, 0xd2, 0x26, 0x6c, 0x8b, 0xb0, 0x3d, 0x84,
-	0x8e, 0xc2, 0xb1, 0xdc, 0x46, 0xaa, 0x21, 0x74, 0x9c, 0xd0, 0x09, 0x4e, 0x12, 0x31, 0x6c, 0x13,
-	0x3a, 0xef, 0xb2, 0x25, 0xa8, 0xfb, 0xde, 0xb0, 0xb3, 0x5a, 0x5b, 0x6b, 0xf2, 0xba, 0xef, 0xe1,
-	0x37, 0xb2, 0xcc, 0xf7, 0x86, 0x86, 0xfa, 0x06, 0xb6, 0xd9, 0xab, 0x60, 0x1e, 0x38, 0xa9, 0x7b,
-	0x68, 0xbb, 0x61, 0x3a, 0x34, 0x89, 0xd4, 0x20, 0xc0, 0x56, 0x98, 0xb2, 0x2b, 0x60, 0xb8, 0x87,
-	0xc2, 0x3d, 0x4a, 0xb2, 0xe9, 0x10, 0x56, 0x6b, 0x6b, 0x7d, 0x5e, 0xf4, 0x11, 0x97, 0x88, 0xa7,
-	0x99, 0x08, 0x5d, 0x31, 0xec, 0x2a, 0xbe, 0xbc, 0x6f, 0x3d, 0x01, 0x73, 0x4b, 0x86, 0xa1, 0x70,
-	0x53, 0x19, 0xb3, 0xeb, 0xd0, 0xcd, 0x65, 0x60, 0x6b, 0xd9, 0xb5, 0x38, 0xe4, 0xa0, 0x91, 0xc7,
-	0xde, 0x84, 0x65, 0x37, 0xa7, 0xb6, 0xfd, 0xd0, 0x13, 0xc7, 0x24, 0xbc, 0x16, 0x5f, 0x2a, 0xc0,
-	0x23, 0x84, 0x5a, 0xff, 0x54, 0x87, 0xce, 0xde, 0x61, 0x36, 0x1e, 0x07, 0x82, 0xbd, 0x01, 0x7d,
-	0xdd, 0xdc, 0x92, 0xc1, 0xc8, 0x3b, 0xd6, 0xe3, 0xce, 0x02, 0xd9, 0x2a, 0x74, 0x35, 0x60, 0xff,
-	0x24, 0x12, 0x7a, 0xd8, 0x2a, 0x68, 0x76, 0x9c, 0x47, 0x7e, 0x48, 0x3a, 0x69, 0xf0, 0x59, 0xe0,
-	0x1c, 0x95, 0x73, 0x4c, 0x6a, 0x9a, 0xa5, 0x72, 0xe8, 0x6b, 0x9b, 0x81, 0xff, 0x4c, 0x70, 0x31,
-	0xd9, 0x0a, 0x53, 0x52, 0x56, 0x8b, 0x57, 0x41, 0x6c, 0x03, 0x2e, 0x26, 0x8a, 0xc5, 0x8e, 0x9d,
-	0x70, 0x22, 0x12, 0x3b, 0xf3, 0xc3, 0xf4, 0xff, 0xfd, 0x60, 0xd8, 0x5e, 0x6d, 0xac, 0x35, 0xf9,
-	0x79, 0x8d, 0xe4, 0x84, 0x7b, 0x42, 0x28, 0xf6, 0x7d, 0xb8, 0x30, 0xc7, 0xa3, 0x58, 0x3a, 0xab,
-	0x8d, 0xb5, 0x06, 0x67, 0x33, 0x2c, 0x23, 0xe2, 0xb8, 0x0f, 0x2b, 0x71, 0x16, 0xa2, 0x79, 0x3f,
-	0xf0, 0x83, 0x54, 0xc4, 0x7b, 0x91, 0x70, 0x49, 0xe9, 0xdd, 0x8d, 0xcb, 0xeb, 0xb4, 0x03, 0xf8,
-	0x3c, 0x9a, 0x9f, 0xe6, 0xb0, 0x7e, 0x51, 0x07, 0x63, 0xdb, 0x4f, 0x22, 0xb4, 0x06, 0x76, 0x19,
-	0x3a, 0xe3, 0x2c, 0x74, 0x4b, 0x0d, 0xb6, 0xb1, 0x3b, 0xf2, 0xd8, 0xaf, 0xc2, 0x72, 0x20, 0x5d,
-	0x27, 0xb0, 0x0b, 0x65, 0x0d, 0xeb, 0xab, 0x8d, 0xb5, 0xee, 0xc6, 0xf9, 0xd2, 0xf4, 0x0b, 0x63,
-	0xe0, 0x4b, 0x44, 0x5b, 0x1a, 0xc7, 0x07, 0x30, 0x88, 0xc5, 0x54, 0xa6, 0xa2, 0xc2, 0xde, 0x20,
-	0x76, 0x56, 0xb2, 0x7f, 0x1c, 0x3b, 0xd1, 0x8e, 0xf4, 0x04, 0x5f, 0x56, 0xb4, 0x25, 0xfb, 0x3b,
-	0x15, 0x79, 0x8a, 0x89, 0xed, 0x7b, 0xc7, 0x36, 0x7d, 0x60, 0xd8, 0x5c, 0x6d, 0xac, 0xb5, 0x4a,
-	0xe1, 0x88, 0xc9, 0xc8, 0x3b, 0x7e, 0x88, 0x18, 0xf6, 0x2e, 0x5c, 0x9a, 0x67, 0x51, 0xa3, 0x0e,
-	0x5b, 0xc4, 0x73, 0x7e, 0x86, 0x87, 0x13, 0x8a, 0xdd, 0x80, 0x5e, 0xce, 0x94, 0xa2, 0x21, 0xb5,
-	0x95, 0x6a, 0x93, 0x8a, 0x21, 0x5d, 0x86, 0x8e, 0x9f, 0xd8, 0x89, 0x1f, 0x1e, 0xd1, 0x8e, 0x33,
-	0x78, 0xdb, 0x4f, 0xf6, 0xfc, 0xf0, 0x88, 0xbd, 0x02, 0x46, 0x2c, 0x5c, 0x85, 0x31, 0x08, 0xd3,
-	0x89, 0x85, 0x8b, 0x28, 0xeb, 0x75, 0x68, 0x3d, 0x12, 0xf1, 0x44, 0xd0, 0x66, 0xf2, 0xc3, 0xa3,
-	0x3d, 0xd7, 0x09, 0x49, 0xbc, 0x06, 0x2f, 0xfa, 0xd6, 0x5f, 0xd4, 0xa0, 0xff, 0x28, 0x0b, 0x52,
-	0x7f, 0x33, 0x9e, 0x64, 0x62, 0x1a, 0xa6, 0xb8, 0x8f, 0xb7, 0xfd, 0x24, 0xd5, 0x94, 0xd4, 0x66,
-	0x6b, 0x60, 0xfe, 0x24, 0x96, 0x59, 0x74, 0xff, 0x38, 0xca, 0x15, 0x00, 0x4a, 0xd7, 0x08, 0xe1,
-	0x25, 0x92, 0xbd, 0x0d, 0xdd, 0xc7, 0xb1, 0x27, 0xe2, 0x7b, 0x27, 0x44, 0xdb, 0x38, 0x45, 0x5b,
-	0x45, 0xb3, 0xab, 0x60, 0xee, 0x89, 0xc8, 0x89, 0x1d, 0xd4, 0x0c, 0x5a, 0xbd, 0xc9, 0x4b, 0x00,
-	0xfa, 0x1e, 0x22, 0x1e, 0x79, 0xda, 0xda, 0xf3, 0xae, 0x35, 0x01, 0x73, 0x73, 0x32, 0x89, 0xc5,
-	0xc4, 0x49, 0xc9, 0x11, 0xc9, 0x88, 0xa6, 0xdb, 0xe0, 0x75, 0x19, 0x91, 0xb3, 0xc3, 0x05, 0xd4,
-	0xd5, 0x02, 0xb0, 0xcd, 0xae, 0x41, 0x53, 0x2c, 0x9e, 0x0f, 0xc1, 0xd9, 0x25, 0x68, 0xbb, 0x32,
-	0x1c, 0xfb, 0x13, 0xed, 0x22, 0x75, 0xcf, 0xfa, 0xfb, 0x3a, 0xb4, 0x68, 0x71, 0xe8, 0xca, 0x42,
-	0x21, 0x3c, 0x5b, 0x3c, 0x73, 0x82, 0x5c, 0x8a, 0x08, 0xb8, 0xff, 0xcc, 0x09, 0xd8, 0x2a, 0xb4,
-	0x70, 0x98, 0x64, 0x81, 0x6c, 0x14, 0x82, 0xdd, 0x82, 0x16, 0xea, 0x36, 0x99, 0x9d, 0x01, 0xea,
-	0xf6, 0x5e, 0xf3, 0xe7, 0x7f, 0x73, 0xfd, 0x1c, 0x57, 0x68, 0xf6, 0x26, 0x34, 0x9d, 0xc9, 0x24,
-	0x21, 0x13, 0x9b, 0xb1, 0xf2, 0x62, 0xbd, 0x9c, 0x08, 0xd8, 0x7b, 0x60, 0x2a, 0xbd, 0x21, 0x75,
-	0x8b, 0xa8, 0x2f, 0x57, 0x8e, 0x83, 0xaa, 0x4a, 0x79, 0x49, 0x89, 0x12, 0xf7, 0x13, 0xed, 0x58,
-	0xc8, 0xd0, 0x0c, 0x5e, 0x02, 0x98, 0x05, 0xbd, 0x28, 0x16, 0x9b, 0x41, 0x20, 0xdd, 0x3d, 0xff,
-	0x85, 0xd0, 0xde, 0x7d, 0x06, 0xc6, 0x6e, 0xc1, 0xd2, 0xae, 0x13, 0xa7, 0xbe, 0x13, 0x70, 0x91,
-	0x64, 0x41, 0x9a, 0x68, 0x8f, 0x3f, 0x07, 0x65, 0xeb, 0xc0, 0x66, 0x20, 0xfb, 0xb4, 0x7c, 0x73,
-	0xb5, 0xb1, 0xd6, 0xe7, 0x0b, 0x30, 0xd6, 0xbf, 0xd5, 0xa1, 0x3d, 0x0a, 0x13, 0x11, 0xd3, 0xc9,
-	0xe0, 0x8c, 0xc7, 0xc2, 0x4d, 0x85, 0xf2, 0x07, 0x4d, 0x5e, 0xf4, 0x71, 0x01, 0xfb, 0xf2, 0xe3,
-	0xd8, 0x4f, 0xc5, 0xde, 0xbb, 0x5a, 0xc5, 0x25, 0x80, 0xdd, 0x86, 0x15, 0xc7, 0xf3, 0xec, 0x9c,
-	0xda, 0x8e, 0xe5, 0xf3, 0x84, 0x9c, 0xae, 0xc1, 0x97, 0x1d, 0xcf, 0xdb, 0xd4, 0x70, 0x2e, 0x9f,
-	0x27, 0xec, 0x06, 0x34, 0x62, 0x31, 0x26, 0x85, 0x77, 0x37, 0x96, 0x95, 0x42, 0x1e, 0x1f, 0x7c,
-	0x2a, 0xdc, 0x94, 0x8b, 0x31, 0x47, 0x1c, 0xbb, 0x00, 0x2d, 0x27, 0x4d, 0x63, 0x25, 0x60, 0x93,
-	0xab, 0x0e, 0x5b, 0x87, 0xf3, 0x11, 0xce, 0x3f, 0xf5, 0x65, 0x68, 0xa7, 0xce, 0x41, 0x80, 0x47,
-	0x4f, 0xa2, 0xbd, 0xec, 0x4a, 0x81, 0xda, 0x47, 0xcc, 0xc8, 0x4b, 0xd0, 0x2f, 0xcf, 0xd3, 0x87,
-	0xce, 0x54, 0x24, 0xe4, 0x64, 0x4d, 0x7e, 0x7e, 0x96, 0x63, 0x07, 0x51, 0xec, 0x75, 0xe8, 0x97,
-	0x3c, 0xbe, 0x77, 0x4c, 0x42, 0x6e, 0xf1, 0x5e, 0x01, 0xc4, 0x03, 0xe8, 0x22, 0xb4, 0xfd, 0xc4,
-	0x16, 0xa1, 0x47, 0x67, 0xab, 0xc1, 0x5b, 0x7e, 0x72, 0x3f, 0xf4, 0xd8, 0x5b, 0x60, 0xaa, 0xaf,
-	0x78, 0x62, 0x4c, 0x27, 0x6b, 0x77, 0x63, 0x49, 0xdb, 0x1b, 0x82, 0xb7, 0xc5, 0x98, 0x1b, 0xa9,
-	0x6e, 0x59, 0xaf, 0x41, 0x6b, 0x33, 0x8e, 0x9d, 0x13, 0x5a, 0x2b, 0x36, 0x86, 0x35, 0xf2, 0x54,
-	0xaa, 0x63, 0xb9, 0xd0, 0x78, 0xe4, 0x44, 0xec, 0x26, 0xd4, 0xa7, 0x11, 0x61, 0xba, 0x1b, 0x17,
-	0x2b, 0x66, 0xe6, 0x44, 0xeb, 0x8f, 0xa2, 0xfb, 0x61, 0x1a, 0x9f, 0xf0, 0xfa, 0x34, 0xba, 0xf2,
-	0x1e, 0x74, 0x74, 0x17, 0x83, 0x90, 0x23, 0x71, 0x42, 0xea, 0x33, 0x39, 0x36, 0xf1, 0x03, 0xcf,
-	0x9c, 0x20, 0xcb, 0x0f, 0x4a, 0xd5, 0xf9, 0x95, 0xfa, 0xfb, 0x35, 0xeb, 0xdf, 0x9b, 0x60, 0x6c,
-	0x8b, 0x40, 0xe0, 0xba, 0xd0, 0x06, 0xab, 0x6a, 0xd2, 0x06, 0x30, 0x03, 0x43, 0x1a, 0xe5, 0x3b,
-	0x89, 0x4b, 0x68, 0x3b, 0x98, 0x81, 0xa1, 0xf7, 0x18, 0xdd, 0xcb, 0xdc, 0x23, 0x91, 0x92, 0x01,
-	0xf4, 0x79, 0xde, 0x45, 0xcc, 0x8e, 0xc6, 0x34, 0x15, 0x46, 0x77, 0xd9, 0x55, 0x80, 0x58, 0x3e,
-	0xb7, 0x7d, 0x8f, 0x44, 0xae, 0x9c, 0x8e, 0x11, 0xcb, 0xe7, 0x23, 0x0f, 0xc5, 0xfd, 0x6d, 0xe8,
-	0xfd, 0xff, 0xc3, 0xb0, 0xa2, 0x77, 0x0c, 0x4c, 0x6c, 0x3f, 0xb4, 0x29, 0x66, 0xd2, 0x26, 0x50,
-	0x8e, 0x49, 0x71, 0xcb, 0x28, 0xbc, 0x47, 0x47, 0xa8, 0xb6, 0x66, 0xf3, 0x0c, 0x6b, 0x5e, 0xb8,
-	0x39, 0x60, 0xf1, 0xe6, 0xb8, 0x07, 0xb0, 0x27, 0x26, 0x53, 0x11, 0xa6, 0x8f, 0x9c, 0x68, 0xd8,
-	0x25, 0xc5, 0x5b, 0xa5, 0xe2, 0x73, 0x6d, 0xad, 0x97, 0x44, 0xca, 0x0a, 0x2a, 0x5c, 0x78, 0xae,
-	0xb9, 0x4e, 0x68, 0xa7, 0x71, 0x16, 0xba, 0x4e, 0x2a, 0x86, 0x3d, 0xfa, 0x54, 0xd7, 0x75, 0xc2,
-	0x7d, 0x0d, 0xaa, 0x58, 0x70, 0xbf, 0x6a, 0xc1, 0xb7, 0x60, 0x39, 0x8a, 0xfd, 0xa9, 0x13, 0x9f,
-	0xd8, 0x47, 0xe2, 0x84, 0x94, 0xb1, 0xa4, 0x22, 0x30, 0x0d, 0xfe, 0x48, 0x9c, 0x8c, 0xbc, 0xe3,
-	0x2b, 0x1f, 0xc0, 0xf2, 0xdc, 0x04, 0xbe, 0x94, 0xdd, 0xfd, 0x6b, 0x0d, 0xcc, 0xdd, 0x58, 0x68,
-	0xaf, 0x73, 0x1d, 0xba, 0x89, 0x7b, 0x28, 0xa6, 0x0e, 0x69, 0x49, 0x8f, 0x00, 0x0a, 0x84, 0xca,
-	0x99, 0xdd, 0x57, 0xf5, 0xb3, 0xf7, 0x15, 0xce, 0x03, 0xa7, 0xdd, 0xa0, 0xcd, 0x84, 0xcd, 0xd2,
-	0x99, 0x34, 0xab, 0xce, 0x64, 0x15, 0x7a, 0x87, 0x4e, 0x62, 0x3b, 0x59, 0x2a, 0x6d, 0x57, 0x06,
-	0x64, 0x74, 0x06, 0x87, 0x43, 0x27, 0xd9, 0xcc, 0x52, 0xb9, 0x25, 0x03, 0x3c, 0x79, 0xfc, 0xc4,
-	0xce, 0x22, 0x0f, 0x65, 0xa8, 0x5c, 0xb6, 0xe1, 0x27, 0x4f, 0xa8, 0x8f, 0x36, 0x29, 0x92, 0xd4,
-	0x9f, 0x3a, 0x5a, 0xa1, 0xb6, 0x2b, 0xb3, 0x30, 0x25, 0xc7, 0xdd, 0xe0, 0x2b, 0x05, 0x8a, 0xcb,
-	0xe7, 0x5b, 0x88, 0xb0, 0xfe, 0xba, 0x0e, 0xf0, 0x50, 0xba, 0x47, 0xfb, 0x4e, 0x3c, 0x11, 0x29,
-	0x86, 0x0f, 0xb9, 0x21, 0xeb, 0x8d, 0xd6, 0x49, 0x95, 0xf9, 0xb2, 0x0d, 0xb8, 0x94, 0xeb, 0xc0,
-	0x95, 0x01, 0x85, 0x32, 0xca, 0x12, 0xb5, 0x1c, 0x99, 0xc6, 0xaa, 0x60, 0x98, 0xcc, 0x90, 0xbd,
-	0x5f, 0xea, 0x0d, 0x79, 0xd2, 0x93, 0x88, 0xf6, 0xde, 0xa2, 0xf3, 0xae, 0x5f, 0xb2, 0xef, 0x9f,
-	0x44, 0xec, 0xfb, 0x70, 0x31, 0x16, 0xe3, 0x58, 0x24, 0x87, 0x76, 0x9a, 0x54, 0x3f, 0xd6, 0xa4,
-	0x8f, 0xad, 0x68, 0xe4, 0x7e, 0x52, 0x7c, 0xeb, 0xfb, 0x70, 0x71, 0x4c, 0xe1, 0xe4, 0xfc, 0xf4,
-	0xd4, 0xb6, 0x5d, 0x51, 0xc8, 0xea, 0xec, 0x5e, 0x03, 0x4a, 0xc2, 0xd4, 0x56, 0xcc, 0x0f, 0xbf,
-	0x80, 0x84, 0x71, 0x10, 0x08, 0x3c, 0x59, 0xb6, 0x0e, 0x31, 0xd0, 0xdd, 0x16, 0x63, 0x1d, 0x65,
-	0x95, 0x00, 0x66, 0x41, 0xf3, 0x91, 0xf4, 0x04, 0x6d, 0xc2, 0xa5, 0x8d, 0xa5, 0x75, 0x4a, 0xe7,
-	0x50, 0x92, 0x08, 0xe5, 0x84, 0xb3, 0x76, 0xa0, 0x8d, 0x90, 0xc7, 0x11, 0x5b, 0x87, 0x4e, 0x4a,
-	0x12, 0x4e, 0xb4, 0xd3, 0xbc, 0x50, 0xee, 0x9d, 0x52, 0xfc, 0x3c, 0x27, 0x42, 0xdb, 0x38, 0xc0,
-	0x11, 0xb5, 0x27, 0x53, 0x1d, 0x8b, 0xc3, 0x72, 0x61, 0x9e, 0x4f, 0x42, 0xff, 0x69, 0x26, 0xd8,
-	0x87, 0xb0, 0x12, 0xc5, 0xc2, 0xf6, 0x09, 0x66, 0x67, 0x47, 0xb6, 0x9b, 0xaa, 0xec, 0x84, 0x3e,
-	0x81, 0x32, 0x2e, 0x39, 0x8e, 0xb6, 0xd2, 0x63, 0xbe, 0x14, 0xcd, 0xf4, 0xad, 0x4f, 0xe0, 0x72,
-	0x41, 0xb1, 0x27, 0x5c, 0x19, 0x7a, 0x4e, 0x7c, 0x42, 0x9e, 0x64, 0x6e, 0xec, 0xe4, 0xcb, 0x8c,
-	0xbd, 0x47, 0x63, 0xff, 0x51, 0x03, 0x96, 0x1e, 0x87, 0xdb, 0x59, 0x14, 0xf8, 0xb8, 0xbb, 0x3f,
-	0x52, 0x9b, 0x4f, 0x19, 0x7d, 0xad, 0x6a, 0xf4, 0x6b, 0x30, 0xd0, 0x5f, 0x41, 0xdd, 0x29, 0x93,
-	0xd5, 0x59, 0x99, 0x82, 0x6f, 0xc9, 0x80, 0xec, 0x95, 0x7d, 0x00, 0x17, 0x33, 0x5a, 0xb9, 0xa2,
-	0xc4, 0xfc, 0xd0, 0x7e, 0x49, 0x24, 0xc7, 0x14, 0x21, 0xb2, 0x22, 0x19, 0x05, 0x98, 0xd7, 0xa1,
-	0x5b, 0xb2, 0xe7, 0x3b, 0x0f, 0x0a, 0x42, 0x9a, 0x89, 0x0c, 0x6d, 0x2f, 0x9f, 0xb2, 0xf6, 0xfb,
-	0xb8, 0x67, 0x97, 0x64, 0xb9, 0x12, 0xf4, 0xfe, 0xbf, 0x09, 0x2b, 0x33, 0x94, 0x34, 0x8b, 0x36,
-	0xcd, 0xe2, 0x4e, 0xa9, 0xdc, 0xd9, 0xe5, 0x57, 0xbb, 0x38, 0x1f, 0xe5, 0x23, 0x97, 0xe5, 0x2c,
-	0x54, 0xef, 0x70, 0x7f, 0x12, 0xca, 0x58, 0x68, 0xcb, 0x33, 0xfc, 0x64, 0x44, 0xfd, 0x2b, 0x3b,
-	0x70, 0x61, 0xd1, 0x28, 0x0b, 0x1c, 0xdd, 0x6a, 0xd5, 0xd1, 0xcd, 0x45, 0xa1, 0xa5, 0xd3, 0x7b,
-	0x0e, 0xdd, 0x07, 0xd9, 0x8b, 0x17, 0x27, 0x2a, 0x17, 0x63, 0x3d, 0xa8, 0xed, 0xd0, 0x20, 0x75,
-	0x5e, 0xdb, 0xc1, 0x38, 0x78, 0xf7, 0x08, 0x9d, 0x1d, 0x8d, 0x61, 0x72, 0xdd, 0xc3, 0xf0, 0x75,
-	0xf7, 0x68, 0xff, 0x8c, 0xed, 0xac, 0xd0, 0x18, 0xb9, 0xdd, 0xcb, 0xfc, 0x00, 0x8f, 0x4b, 0xbd,
-	0x73, 0x8b, 0xbe, 0xf5, 0x0f, 0x75, 0x68, 0xfe, 0x54, 0xfa, 0xa1, 0xca, 0x59, 0x02, 0x3b, 0x50,
-	0x59, 0x06, 0xca, 0xba, 0x13, 0x8b, 0xe0, 0x21, 0xc6, 0xe9, 0xaf, 0x80, 0x81, 0x7a, 0x0e, 0x54,
-	0xfc, 0x4e, 0x28, 0x57, 0x2a, 0x54, 0x19, 0xc2, 0xd7, 0x16, 0x86, 0xf0, 0x45, 0x84, 0xdd, 0xfc,
-	0xbc, 0x08, 0xdb, 0x0c, 0xc4, 0x18, 0x2d, 0x2f, 0xf4, 0x74, 0xe0, 0x5c, 0x1d, 0xcc, 0x40, 0xe4,
-	0x96, 0x0c, 0x3d, 0xf6, 0x3d, 0x80, 0xd8, 0x9f, 0x1c, 0x6a, 0xca, 0xf6, 0xe9, 0xac, 0x87, 0xb0,
-	0x44, 0xca, 0xe1, 0x15, 0x9d, 0xe1, 0xda, 0xda, 0x27, 0x1d, 0xe0, 0xaa, 0xd5, 0x3a, 0x3a, 0x79,
-	0x70, 0xbe, 0x38, 0x37, 0xbe, 0x34, 0x93, 0x1b, 0x93, 0xb4, 0x68, 0xbd, 0x57, 0x01, 0x0f, 0x81,
-	0x43, 0x5b, 0x86, 0x76, 0x94, 0xe7, 0x76, 0x06, 0x42, 0x1e, 0x87, 0xbb, 0x47, 0xe8, 0xcb, 0x30,
-	0x21, 0xd4, 0x81, 0xbc, 0x39, 0x17, 0xc8, 0x5b, 0x7f, 0x59, 0x07, 0x63, 0x33, 0x4c, 0x7d, 0x92,
-	0xf7, 0x25, 0x68, 0xc7, 0x14, 0x68, 0x6b, 0x69, 0xeb, 0x5e, 0x21, 0xd1, 0xfa, 0xe7, 0x49, 0xb4,
-	0xf1, 0x25, 0x24, 0xda, 0xfc, 0xc2, 0x12, 0x6d, 0x9d, 0x25, 0xd1, 0xd9, 0xd5, 0xb7, 0xcf, 0x5c,
-	0x7d, 0x67, 0x3e, 0x8d, 0x39, 0x53, 0x1d, 0xc6, 0x57, 0x52, 0x87, 0xf5, 0xcf, 0x75, 0x30, 0x1e,
-	0x8a, 0x71, 0xfa, 0x9d, 0x05, 0x7f, 0x3b, 0x16, 0xfc, 0xc7, 0x0d, 0x30, 0x39, 0x4e, 0xef, 0x97,
-	0x28, 0xf0, 0xbb, 0x00, 0x24, 0xc8, 0xb3, 0xa5, 0x4e, 0xc2, 0xa6, 0x1c, 0x95, 0xbd, 0x03, 0x5d,
-	0x25, 0x50, 0xc5, 0xd1, 0x7a, 0x09, 0x87, 0x92, 0xfa, 0xfe, 0x69, 0x65, 0xb5, 0xbf, 0xb0, 0xb2,
-	0x3a, 0x5f, 0x59, 0x59, 0xc6, 0x37, 0xa1, 0x2c, 0xf3, 0x4c, 0x65, 0xc1, 0xbc, 0xb2, 0x7e, 0x51,
-	0x87, 0x3e, 0x29, 0x6b, 0x4f, 0x4c, 0xbf, 0x9e, 0xcf, 0x99, 0x93, 0x70, 0xe3, 0xcb, 0x4a, 0xf8,
-	0x1b, 0x72, 0x3f, 0x67, 0x4a, 0xb8, 0xfd, 0x4d, 0x48, 0xb8, 0x73, 0xa6, 0x84, 0x8d, 0x97, 0x4a,
-	0xf8, 0x6b, 0x7b, 0xf5, 0xef, 0x24, 0xbc, 0x58, 0xc2, 0x78, 0x64, 0x7e, 0x6d, 0xf3, 0xfd, 0x9f,
-	0x3c, 0x32, 0xff, 0xd7, 0x49, 0xf4, 0x5f, 0xea, 0x00, 0x7b, 0x7e, 0x38, 0x09, 0xc4, 0x77, 0x87,
-	0xe6, 0xb7, 0x73, 0x68, 0xfe, 0xac, 0x0e, 0xc6, 0x23, 0x27, 0x3e, 0xfa, 0x3f, 0x6b, 0xc3, 0xaf,
-	0x43, 0x47, 0x86, 0x55, 0x8b, 0xad, 0xd2, 0xb5, 0x65, 0xf8, 0xf5, 0x8d, 0xf2, 0x77, 0x6a, 0xd0,
-	0xd9, 0x8d, 0xa5, 0x97, 0xb9, 0xe9, 0x57, 0xb4, 0xc8, 0x2f, 0x2a, 0x9f, 0xd9, 0x89, 0x34, 0xe7,
-	0x27, 0xf2, 0xbb, 0x74, 0xf9, 0x44, 0x13, 0x79, 0xb8, 0xf1, 0x4b, 0x9e, 0x4a, 0xae, 0xf2, 0xe6,
-	0x62, 0x95, 0x5b, 0x7f, 0x58, 0x03, 0x93, 0xee, 0x00, 0xce, 0x34, 0x9c, 0xe2, 0x6b, 0xf5, 0xb3,
-	0xbf, 0x76, 0xe6, 0xb6, 0x68, 0x7c, 0xb5, 0xf0, 0xfb, 0x0f, 0x6a, 0xd0, 0xa7, 0x6b, 0x9a, 0x07,
-	0x59, 0xe8, 0xd2, 0x3d, 0xf1, 0xe2, 0x9b, 0x85, 0x55, 0x68, 0xc6, 0x22, 0xcd, 0xa7, 0xd8, 0x53,
-	0x9f, 0xd9, 0x92, 0xc1, 0xb6, 0x18, 0x73, 0xc2, 0xa0, 0x2c, 0x9c, 0x78, 0x92, 0x2c, 0x7a, 0x0a,
-	0x42, 0x38, 0xae, 0x3e, 0x72, 0x62, 0x67, 0x9a, 0xe4, 0x4f, 0x41, 0xaa, 0xc7, 0x18, 0x34, 0xe9,
-	0x5e, 0xb0, 0x45, 0x89, 0x31, 0xb5, 0xad, 0x4d, 0xb8, 0x78, 0xff, 0x38, 0x15, 0x71, 0xe8, 0x04,
-	0x98, 0x26, 0x6f, 0x6c, 0xc9, 0x40, 0x5d, 0xa5, 0xe4, 0xc4, 0xb5, 0x92, 0x18, 0x27, 0x5c, 0x7d,
-	0x7f, 0x56, 0x1d, 0xeb, 0x26, 0x74, 0xc7, 0x7e, 0x20, 0x6c, 0x39, 0x1e, 0x27, 0x22, 0xc5, 0xaf,
-	0xab, 0x16, 0x2d, 0xab, 0xc1, 0x75, 0xcf, 0xfa, 0xaf, 0x3a, 0xf4, 0xf2, 0x4f, 0xed, 0xb9, 0xce,
-	0xcb, 0x96, 0xff, 0x2a, 0x98, 0x34, 0x5a, 0xe2, 0xbf, 0x10, 0x24, 0x83, 0x06, 0x37, 0x10, 0x40,
-	0x2f, 0x37, 0x9b, 0xb0, 0x52, 0xf9, 0x94, 0x9d, 0xca, 0xd4, 0x09, 0xb4, 0x18, 0x2a, 0x77, 0xfa,
-	0x15, 0x12, 0xbe, 0x8c, 0x9d, 0xc7, 0xd4, 0xde, 0x47, 0x6a, 0x14, 0x6f, 0x71, 0x91, 0x72, 0x4a,
-	0xbc, 0x88, 0x61, 0x3f, 0x81, 0x65, 0x5c, 0xed, 0x86, 0xba, 0x95, 0xa3, 0xf5, 0xaa, 0x1d, 0x7f,
-	0xbd, 0xfc, 0xc4, 0x42, 0x99, 0xf1, 0x7e, 0x38, 0x23, 0xc2, 0xd7, 0x00, 0xdc, 0x58, 0x38, 0xa9,
-	0xb0, 0x93, 0xa7, 0x01, 0x65, 0x80, 0x26, 0x37, 0x15, 0x64, 0xef, 0x69, 0x50, 0xac, 0xb4, 0xf0,
-	0xb5, 0xa6, 0x5a, 0x29, 0xed, 0x8b, 0x3b, 0xd0, 0x95, 0xb1, 0x3f, 0xf1, 0x43, 0x75, 0xed, 0x63,
-	0x2c, 0x98, 0x2d, 0x28, 0x02, 0xba, 0x04, 0xb2, 0xa0, 0xad, 0x0c, 0x55, 0x5f, 0x9f, 0xcf, 0x38,
-	0x1d, 0x85, 0xb1, 0x5c, 0x80, 0xbd, 0x34, 0x16, 0xce, 0x94, 0xa4, 0xff, 0x26, 0x74, 0xd2, 0x83,
-	0x80, 0x2e, 0x82, 0x6b, 0x0b, 0x2f, 0x82, 0xdb, 0xe9, 0x01, 0x7e, 0xa6, 0xa2, 0xcf, 0x3a, 0x5d,
-	0xc9, 0xea, 0x1e, 0xaa, 0x2f, 0xf0, 0xa7, 0x7e, 0xaa, 0x2b, 0x02, 0x54, 0xc7, 0xea, 0x82, 0x49,
-	0x23, 0xd0, 0xd3, 0x6c, 0x17, 0xcc, 0xdf, 0x70, 0x82, 0x4c, 0x75, 0xfe, 0xb3, 0x06, 0xb0, 0xe7,
-	0x4c, 0x23, 0xb5, 0x01, 0xd8, 0x8f, 0xa1, 0x9b, 0x50, 0x4f, 0xbd, 0x18, 0xab, 0x92, 0x91, 0x8a,
-	0x84, 0x4b, 0x52, 0xdd, 0xc4, 0x5d, 0xca, 0x21, 0x29, 0xda, 0x74, 0xdb, 0xad, 0x46, 0xa0, 0x67,
-	0x00, 0x65, 0x93, 0x9a, 0x80, 0x5e, 0x00, 0x6e, 0xc2, 0x92, 0x26, 0x88, 0x44, 0xec, 0x8a, 0x50,
-	0x4d, 0xb5, 0xc6, 0xfb, 0x0a, 0xba, 0xab, 0x80, 0xec, 0x9d, 0x82, 0xcc, 0x95, 0x41, 0x36, 0x0d,
-	0x93, 0x05, 0x47, 0x81, 0x66, 0xd9, 0x52, 0x04, 0xd6, 0x46, 0xbe, 0x14, 0x9a, 0x88, 0x01, 0x4d,
-	0xfc, 0xde, 0xe0, 0x1c, 0xeb, 0x42, 0x47, 0x8f, 0x3a, 0xa8, 0xb1, 0x3e, 0x98, 0xf4, 0x7a, 0x4d,
-	0xb8, 0xba, 0xf5, 0x7b, 0x03, 0xe8, 0x8e, 0xc2, 0x24, 0x8d, 0x33, 0xb5, 0xfb, 0xcb, 0x47, 0xdf,
-	0x16, 0x3d, 0xfa, 0xea, 0xeb, 0x76, 0xb5, 0x0c, 0xba, 0x6e, 0xbf, 0x05, 0x4d, 0x27, 0x4c, 0x7d,
-	0x1d, 0x38, 0x54, 0x1e, 0xfc, 0xf3, 0x08, 0x9a, 0x13, 0x9e, 0xdd, 0x81, 0x8e, 0xae, 0x0e, 0xd0,
-	0xee, 0x71, 0x61, 0x69, 0x41, 0x4e, 0xc3, 0xd6, 0xc1, 0xf0, 0x74, 0xd9, 0x02, 0xb9, 0x82, 0x99,
-	0xa1, 0xf3, 0x82, 0x06, 0x5e, 0xd0, 0xb0, 0x1b, 0xd0, 0x70, 0x26, 0x13, 0xb2, 0x5f, 0x7a, 0x97,
-	0xc9, 0x49, 0xe9, 0x55, 0x99, 0x23, 0x8e, 0xdd, 0xd5, 0x07, 0xe9, 0xa7, 0xd2, 0x0f, 0x75, 0x25,
-	0x45, 0x65, 0xcc, 0xfc, 0xd2, 0x41, 0x1d, 0xa8, 0xe4, 0xa0, 0xef, 0x82, 0x99, 0x88, 0xa9, 0xaf,
-	0x18, 0xcc, 0x79, 0x86, 0x3c, 0x88, 0xe5, 0x46, 0x92, 0x87, 0xb3, 0xef, 0x41, 0x37, 0xa1, 0x40,
-	0x4c, 0xb1, 0x40, 0x7e, 0xa7, 0x5b, 0xb0, 0x14, 0x51, 0x1a, 0x87, 0xa4, 0x8c, 0xd8, 0xee, 0x82,
-	0x39, 0x75, 0xe2, 0x23, 0xc5, 0xd4, 0x9d, 0xff, 0x4e, 0x1e, 0x68, 0x70, 0x63, 0x9a, 0x87, 0x1c,
-	0x16, 0x34, 0x89, 0xb6, 0x97, 0xef, 0x89, 0x9c, 0x56, 0xc9, 0x1b, 0x71, 0xec, 0x2d, 0xe8, 0x44,
-	0xea, 0xd8, 0xa3, 0x37, 0x9f, 0xee, 0xc6, 0x4a, 0x49, 0xa6, 0xcf, 0x43, 0x9e, 0x53, 0xb0, 0x1f,
-	0xc1, 0x92, 0x7a, 0x9f, 0x18, 0x6b, 0xb7, 0x4f, 0xef, 0x40, 0x33, 0x4f, 0xdd, 0x33, 0xa7, 0x02,
-	0xef, 0xa7, 0x33, 0x87, 0xc4, 0x0f, 0xa1, 0x2f, 0xb4, 0xb3, 0xb1, 0x13, 0xd7, 0x09, 0x87, 0x03,
-	0x62, 0xbf, 0x74, 0xda, 0x17, 0xe1, 0x2e, 0xe3, 0x3d, 0x51, 0x75, 0xb1, 0x6b, 0xd0, 0x56, 0xb7,
-	0xd1, 0xc3, 0x15, 0xe2, 0xaa, 0x94, 0x5b, 0xa9, 0x5b, 0x6f, 0xae, 0xf1, 0xec, 0xde, 0xdc, 0x2d,
-	0xf2, 0x91, 0x38, 0x19, 0x32, 0xe2, 0x19, 0xbe, 0xec, 0x6a, 0x78, 0xe6, 0x7e, 0xf9, 0x23, 0x71,
-	0xc2, 0x36, 0x00, 0xca, 0xdb, 0xf7, 0xe1, 0xf9, 0x79, 0x53, 0x2c, 0xae, 0xde, 0xb9, 0x59, 0xdc,
-	0xba, 0xb3, 0xfb, 0xb3, 0xaf, 0x01, 0x74, 0xad, 0x3d, 0xbc, 0x40, 0xac, 0xaf, 0x2c, 0x60, 0x55,
-	0x6f, 0x08, 0x7c, 0x39, 0x9a, 0x7b, 0x54, 0x78, 0x1b, 0x0c, 0x19, 0x7b, 0x78, 0x4e, 0x9f, 0x0c,
-	0x2f, 0xd2, 0xee, 0x5d, 0xd1, 0x0f, 0x88, 0xaa, 0x56, 0x83, 0x4e, 0xe6, 0x8e, 0x54, 0x1d, 0x76,
-	0x07, 0x7a, 0x51, 0x2c, 0x3f, 0x15, 0x6e, 0xaa, 0x9c, 0xef, 0xa5, 0xd3, 0x35, 0x1e, 0x1a, 0x4f,
-	0xbe, 0xb8, 0x74, 0xae, 0x97, 0x5f, 0xe6, 0x5c, 0xd9, 0x6a, 0xee, 0x0d, 0x87, 0xa7, 0x6f, 0xae,
-	0x09, 0x81, 0xa3, 0x68, 0x3f, 0xfa, 0xca, 0xe9, 0x51, 0xb4, 0x4f, 0x1d, 0x42, 0xc7, 0x4f, 0x1e,
-	0xf8, 0x71, 0x92, 0x0e, 0xaf, 0xa8, 0x52, 0x18, 0xdd, 0x45, 0x2f, 0xec, 0x27, 0x0f, 0x9d, 0x24,
-	0x1d, 0xbe, 0x9a, 0x57, 0xcf, 0x60, 0x0f, 0x65, 0xae, 0x22, 0x53, 0xb2, 0xda, 0xab, 0xf3, 0x32,
-	0x2f, 0xee, 0x9f, 0x74, 0x88, 0x4a, 0x36, 0xfe, 0x21, 0x2c, 0x2b, 0x9e, 0x72, 0x0b, 0xbe, 0x36,
-	0x6f, 0x93, 0x33, 0x77, 0x21, 0xbc, 0x1f, 0xcf, 0x5c, 0x8d, 0x14, 0x03, 0xa0, 0xfb, 0x51, 0x03,
-	0x5c, 0x5b, 0x38, 0x40, 0xe1, 0xa8, 0xd4, 0x00, 0x45, 0xe6, 0x7f, 0x1b, 0xda, 0x9e, 0x7a, 0xf7,
-	0xbe, 0x7e, 0xca, 0x01, 0xe9, 0x77, 0x59, 0xae, 0x29, 0xd8, 0xf7, 0xa0, 0x43, 0x6f, 0x5e, 0x32,
-	0x1a, 0xae, 0xce, 0x1b, 0xb1, 0x7a, 0xab, 0xe2, 0xed, 0x40, 0xbd, 0x59, 0xbd, 0x05, 0x9d, 0x3c,
-	0x58, 0xbd, 0x31, 0xbf, 0x31, 0x75, 0xd0, 0xca, 0x73, 0x0a, 0x76, 0x13, 0x5a, 0x53, 0x74, 0xcf,
-	0x43, 0x6b, 0xde, 0xb1, 0x29, 0xaf, 0xad, 0xb0, 0xe4, 0x78, 0xe8, 0xd4, 0x54, 0xbb, 0xef, 0xf5,
-	0x53, 0x8e, 0xa7, 0x38, 0x52, 0x39, 0x24, 0xe5, 0xf1, 0xfa, 0x5b, 0x70, 0xa5, 0xfa, 0x12, 0x95,
-	0x3f, 0x53, 0xe9, 0x78, 0xe2, 0x0d, 0x1a, 0xe5, 0xc6, 0x02, 0x03, 0x9f, 0x7d, 0xd0, 0xe2, 0x97,
-	0xa3, 0x97, 0xbc, 0x74, 0xbd, 0x57, 0x1c, 0x7e, 0xe8, 0x57, 0x86, 0x37, 0x4f, 0x4d, 0xab, 0x38,
-	0x3e, 0xf3, 0x23, 0x91, 0x4e, 0xdd, 0xf7, 0xa1, 0x37, 0xce, 0x5e, 0xbc, 0x38, 0xd1, 0x61, 0xed,
-	0xf0, 0x16, 0xf1, 0x55, 0x62, 0xa7, 0xca, 0xc3, 0x0a, 0xef, 0x8e, 0x2b, 0xaf, 0x2c, 0x97, 0xa1,
-	0xe3, 0x86, 0xb6, 0xe3, 0x79, 0xf1, 0xf0, 0x4d, 0xf5, 0xb0, 0xe2, 0x86, 0x9b, 0x9e, 0x47, 0x0f,
-	0x54, 0x32, 0x12, 0x54, 0xef, 0x64, 0xfb, 0xde, 0x70, 0x4d, 0x1d, 0xc3, 0x39, 0x68, 0xe4, 0x51,
-	0x81, 0xa3, 0x13, 0x3b, 0x41, 0x20, 0x02, 0x24, 0xf8, 0x9e, 0x2e, 0x70, 0xd4, 0xa0, 0x91, 0xc7,
-	0x6e, 0x40, 0x6f, 0xea, 0x1c, 0xdb, 0x39, 0x64, 0x78, 0x5b, 0x55, 0x8f, 0x4d, 0x9d, 0xe3, 0x5d,
-	0x0d, 0x42, 0x33, 0x57, 0xa5, 0x04, 0x64, 0x6c, 0x6f, 0xcd, 0x9b, 0x79, 0x11, 0xf9, 0x73, 0xd3,
-	0x2f, 0x92, 0x00, 0x72, 0x47, 0xe4, 0x84, 0xed, 0x60, 0x63, 0xf8, 0xf6, 0x69, 0x77, 0xa4, 0x33,
-	0x17, 0x74, 0x47, 0x79, 0x12, 0xb3, 0x01, 0xa0, 0xbc, 0x35, 0x29, 0xfb, 0xce, 0x3c, 0x4f, 0x11,
-	0xda, 0x70, 0xf5, 0x8e, 0x4e, 0xaa, 0xde, 0x00, 0xa0, 0xb7, 0x29, 0xc5, 0xb3, 0x3e, 0xcf, 0x53,
-	0x44, 0x40, 0xdc, 0x7c, 0x56, 0x04, 0x43, 0xef, 0x41, 0x6f, 0x93, 0xaa, 0x50, 0xfd, 0x84, 0x5c,
-	0xcc, 0x4d, 0x68, 0x16, 0x89, 0x53, 0xe1, 0xbb, 0x88, 0xe2, 0x85, 0x18, 0x85, 0x63, 0xc9, 0x09,
-	0x6d, 0xfd, 0x69, 0x03, 0xda, 0x7b, 0x32, 0x8b, 0x5d, 0xf1, 0xf9, 0x6f, 0xfd, 0xaf, 0xe5, 0x4b,
-	0x09, 0xcb, 0xc7, 0x30, 0x35, 0x6b, 0x42, 0x57, 0x73, 0xb2, 0x06, 0x05, 0x9f, 0x45, 0x4e, 0x56,
-	0x3c, 0xe5, 0xaa, 0x7a, 0x36, 0xd5, 0x21, 0x35, 0x66, 0xc9, 0xa1, 0x27, 0x9f, 0x87, 0xa8, 0xc6,
-	0x16, 0xbd, 0xb5, 0x43, 0x0e, 0x1a, 0x79, 0x54, 0xf0, 0x93, 0x13, 0x90, 0x9d, 0xa8, 0x88, 0xb7,
-	0x97, 0x03, 0xc9, 0x5a, 0xf2, 0x3c, 0xae, 0xf3, 0x92, 0xd4, 0xfd, 0x36, 0x14, 0x05, 0x08, 0x3a,
-	0x90, 0x78, 0x79, 0x81, 0xc2, 0x06, 0x98, 0x45, 0x8d, 0xb2, 0x0e, 0x22, 0x2e, 0xac, 0x97, 0x55,
-	0xcb, 0xfb, 0x79, 0x8b, 0x97, 0x64, 0x0b, 0x32, 0xbb, 0x28, 0x96, 0x07, 0x3a, 0x08, 0x87, 0x2f,
-	0x93, 0xd9, 0xed, 0x22, 0x5f, 0x9e, 0xde, 0xfa, 0x89, 0xed, 0xca, 0x30, 0x49, 0x29, 0xc6, 0x20,
-	0xb7, 0xbd, 0x85, 0x5d, 0x2b, 0x02, 0x63, 0x47, 0x7a, 0xa4, 0x42, 0xcc, 0xa8, 0xa6, 0x6e, 0x94,
-	0xe9, 0x90, 0x8f, 0xda, 0xba, 0x04, 0x59, 0x29, 0x47, 0x97, 0x20, 0x93, 0xe8, 0x1a, 0x2a, 0xeb,
-	0xc2, 0x36, 0x1e, 0x0a, 0x91, 0x73, 0x12, 0x48, 0xc7, 0xd3, 0x0a, 0xc9, 0xbb, 0x48, 0x4d, 0xc1,
-	0x73, 0x8b, 0x6a, 0x80, 0xa8, 0x6d, 0xfd, 0x49, 0x0d, 0x56, 0x76, 0x63, 0xe9, 0x8a, 0x24, 0x79,
-	0x88, 0xe7, 0x8e, 0x43, 0x51, 0x04, 0x83, 0x26, 0x25, 0x54, 0xaa, 0xc6, 0x90, 0xda, 0x68, 0x20,
-	0xaa, 0xb4, 0xb9, 0x08, 0x9f, 0x1b, 0x5c, 0x15, 0x3b, 0x53, 0xf4, 0x5c, 0xa0, 0x89, 0xb1, 0x51,
-	0x41, 0x53, 0x2a, 0x76, 0x13, 0x96, 0xca, 0x32, 0x1f, 0x1a, 0x41, 0xd7, 0xfc, 0x16, 0x50, 0x1a,
-	0xe5, 0x3a, 0x74, 0x63, 0xe1, 0xe0, 0xc9, 0x4c, 0xc3, 0xb4, 0x88, 0x06, 0x14, 0x08, 0xc7, 0xb1,
-	0x7e, 0xbf, 0x01, 0x5d, 0x3d, 0x5f, 0x92, 0x92, 0x92, 0x48, 0xad, 0x90, 0xc8, 0x00, 0x1a, 0x98,
-	0x3d, 0x29, 0x11, 0x61, 0x93, 0xbd, 0x0b, 0x8d, 0xc0, 0x9f, 0xea, 0xa8, 0xf8, 0xd5, 0x99, 0x0d,
-	0x3d, 0xbb, 0x6a, 0x9d, 0xd3, 0x23, 0x35, 0x26, 0x5b, 0x59, 0xe8, 0x1f, 0xdb, 0xa8, 0x3f, 0x3d,
-	0x53, 0x03, 0x01, 0x68, 0x24, 0xb8, 0x54, 0xc7, 0xa5, 0x37, 0xfc, 0xdc, 0xb2, 0xfb, 0xdc, 0xd4,
-	0x90, 0x91, 0xc7, 0x7e, 0x00, 0x46, 0x12, 0x3a, 0x51, 0x72, 0x28, 0x53, 0x1d, 0x05, 0xb3, 0xf5,
-	0xf4, 0x38, 0x5c, 0xdf, 0xda, 0xd9, 0x3f, 0x0e, 0xf7, 0x34, 0x46, 0x7f, 0xac, 0xa0, 0x64, 0x3f,
-	0x82, 0x5e, 0x22, 0x92, 0x44, 0x55, 0x41, 0x8d, 0xa5, 0xb6, 0xf8, 0x8b, 0xd5, 0x28, 0x97, 0xb0,
-	0xb8, 0x6a, 0xcd, 0xdc, 0x4d, 0x4a, 0x10, 0x7b, 0x1b, 0x98, 0xa3, 0x5d, 0x84, 0x1d, 0x4a, 0x4f,
-	0x94, 0x6f, 0x23, 0x2d, 0x3e, 0xc8, 0x31, 0x68, 0x5c, 0x64, 0x83, 0xbf, 0x06, 0x4b, 0xf9, 0xd7,
-	0x02, 0x39, 0x99, 0x14, 0x89, 0xe0, 0xab, 0xa7, 0xbe, 0xf7, 0x90, 0xd0, 0x95, 0xaf, 0xf6, 0x93,
-	0x2a, 0xc2, 0xfa, 0x8f, 0x1a, 0x74, 0x2b, 0x53, 0xa3, 0xaa, 0xf8, 0x44, 0xc4, 0xf9, 0x45, 0x00,
-	0xb6, 0x11, 0x76, 0x28, 0x75, 0x81, 0xaa, 0xc9, 0xa9, 0x8d, 0xb0, 0x58, 0x06, 0x22, 0x37, 0x5d,
-	0x6c, 0xa3, 0x4b, 0xd0, 0x59, 0x87, 0x2a, 0x02, 0x24, 0xc9, 0x37, 0x79, 0xaf, 0x04, 0x8e, 0x3c,
-	0x76, 0x05, 0x0c, 0xcf, 0x49, 0x9d, 0x03, 0x27, 0xc9, 0xaf, 0x26, 0x8a, 0x3e, 0xda, 0xfe, 0x33,
-	0x11, 0xe3, 0x5c, 0xb4, 0x37, 0xc9, 0xbb, 0xa8, 0x50, 0xda, 0xc5, 0x2f, 0x64, 0xa8, 0xde, 0x4f,
-	0x7b, 0xdc, 0x40, 0xc0, 0x27, 0x32, 0x24, 0x36, 0xad, 0x3e, 0x72, 0x22, 0x26, 0xcf, 0xbb, 0xb8,
-	0x57, 0x9f, 0x66, 0x02, 0x0f, 0x62, 0x8f, 0x2a, 0x39, 0x4d, 0xde, 0xa1, 0xfe, 0xc8, 0xb3, 0xfe,
-	0xb1, 0x06, 0x2b, 0xa7, 0x64, 0x84, 0xe7, 0x1e, 0xca, 0x27, 0x2f, 0x2f, 0xea, 0xf1, 0x36, 0x76,
-	0x47, 0x1e, 0x21, 0xd2, 0x29, 0x59, 0x4c, 0x5d, 0x23, 0xd2, 0x29, 0x9a, 0xcb, 0x45, 0x68, 0xa7,
-	0xc7, 0xb4, 0x5a, 0xf5, 0xff, 0x42, 0x2b, 0x3d, 0xc6, 0x65, 0x6e, 0x82, 0x19, 0xc8, 0x89, 0x1d,
-	0x88, 0x67, 0x22, 0x20, 0x39, 0x2c, 0x6d, 0xbc, 0x71, 0x86, 0x72, 0xd6, 0x1f, 0xca, 0xc9, 0x43,
-	0xa4, 0xe5, 0x46, 0xa0, 0x5b, 0xd6, 0x4f, 0xc1, 0xc8, 0xa1, 0xcc, 0x84, 0xd6, 0xb6, 0x38, 0xc8,
-	0x26, 0x83, 0x73, 0x98, 0x7f, 0x22, 0xc7, 0xa0, 0x86, 0xad, 0x8f, 0x9d, 0x38, 0x1c, 0xd4, 0x11,
-	0x7d, 0x3f, 0x8e, 0x65, 0x3c, 0x68, 0x60, 0x73, 0xd7, 0x09, 0x7d, 0x77, 0xd0, 0xc4, 0xe6, 0x03,
-	0x27, 0x75, 0x82, 0x41, 0xcb, 0xfa, 0xf3, 0x16, 0x18, 0xbb, 0xfa, 0xeb, 0x6c, 0x1b, 0xfa, 0xc5,
-	0x3f, 0x08, 0x8b, 0xd3, 0xf1, 0xdd, 0xf9, 0x06, 0xa5, 0xe3, 0xbd, 0xa8, 0xd2, 0x9b, 0xff, 0x93,
-	0xa1, 0x7e, 0xea, 0x4f, 0x86, 0xab, 0xd0, 0x78, 0x1a, 0x9f, 0xcc, 0x5e, 0x84, 0xef, 0x06, 0x4e,
-	0xc8, 0x11, 0xcc, 0xde, 0x81, 0x2e, 0xea, 0xdd, 0x4e, 0xe8, 0x80, 0xd3, 0xa9, 0x6c, 0xf5, 0x27,
-	0x12, 0x82, 0x73, 0x40, 0x22, 0x7d, 0x08, 0xae, 0x83, 0xe1, 0x1e, 0xfa, 0x81, 0x17, 0x8b, 0x50,
-	0xdf, 0xd1, 0xb0, 0xd3, 0x53, 0xe6, 0x05, 0x0d, 0xfb, 0x31, 0x55, 0xed, 0xe4, 0x29, 0x78, 0xf5,
-	0x96, 0xf6, 0xe2, 0x4c, 0x66, 0x94, 0x53, 0xf0, 0xe5, 0x0a, 0x39, 0xed, 0xb3, 0xb2, 0xdc, 0xaf,
-	0x53, 0x2d, 0xf7, 0x53, 0xd5, 0xed, 0x45, 0xfa, 0x4b, 0xf1, 0x39, 0x05, 0x21, 0x0a, 0x41, 0xce,
-	0xdb, 0x2c, 0x02, 0x77, 0xf4, 0xdd, 0xb7, 0xa0, 0x89, 0xbb, 0x5a, 0x67, 0xb2, 0x95, 0x69, 0xe7,
-	0xe7, 0x05, 0x27, 0x3c, 0xfd, 0xe4, 0x92, 0x25, 0x87, 0xb6, 0x3a, 0x77, 0xd1, 0x91, 0x74, 0x75,
-	0x1d, 0x6d, 0x96, 0x1c, 0x6e, 0xe3, 0xc9, 0x8b, 0x56, 0x7a, 0x13, 0x96, 0xf2, 0x45, 0xea, 0x62,
-	0xa4, 0x9e, 0xaa, 0x36, 0xcc, 0xa1, 0xaa, 0x16, 0xe9, 0x43, 0x18, 0x64, 0x99, 0xef, 0x25, 0x76,
-	0x2a, 0xf3, 0xea, 0xfe, 0x61, 0x9f, 0xd6, 0x5f, 0xc9, 0x27, 0x9f, 0x64, 0xbe, 0xb7, 0x2f, 0x75,
-	0x7d, 0x7f, 0x9f, 0xe8, 0xf3, 0x2e, 0xfd, 0x0e, 0x43, 0x37, 0xa1, 0x65, 0x41, 0xa3, 0x71, 0xa0,
-	0xcb, 0x63, 0x28, 0x24, 0xd1, 0x7f, 0x01, 0xb8, 0x61, 0x3a, 0x5c, 0xd6, 0x17, 0x32, 0xfa, 0x1f,
-	0x90, 0x30, 0xb5, 0x3e, 0x84, 0x5e, 0xd5, 0x7c, 0xd0, 0x1c, 0x29, 0xd6, 0x1e, 0x9c, 0x63, 0x00,
-	0xed, 0x1d, 0x19, 0x4f, 0x9d, 0x60, 0x50, 0xc3, 0xb6, 0xaa, 0x83, 0x1d, 0xd4, 0x59, 0x0f, 0x8c,
-	0x3c, 0x08, 0x1c, 0x34, 0xac, 0x1f, 0x82, 0x91, 0xff, 0xec, 0x40, 0xe5, 0xec, 0xe8, 0x16, 0xe9,
-	0xbc, 0x54, 0xce, 0xc9, 0x40, 0x00, 0x85, 0x19, 0xf9, 0xaf, 0x3c, 0xf5, 0xf2, 0x57, 0x1e, 0xeb,
-	0xd7, 0xa1, 0x57, 0x5d, 0x5a, 0x7e, 0xe1, 0x52, 0x2b, 0x2f, 0x5c, 0x16, 0x70, 0xd1, 0x2d, 0x5d,
-	0x2c, 0xa7, 0x76, 0xe5, 0x58, 0x36, 0x10, 0x80, 0x9f, 0xb9, 0xfd, 0xdb, 0xd0, 0x56, 0xbf, 0x2d,
-	0xb1, 0x15, 0xe8, 0x3f, 0x09, 0x8f, 0x42, 0xf9, 0x3c, 0x54, 0x80, 0xc1, 0x39, 0x76, 0x1e, 0x96,
-	0xf3, 0xd5, 0xea, 0xff, 0xa3, 0x06, 0x35, 0x36, 0x80, 0x1e, 0x55, 0xbb, 0xe6, 0x90, 0x3a, 0xbb,
-	0x0a, 0xc3, 0xdd, 0x58, 0x44, 0x4e, 0x2c, 0xb6, 0x65, 0x28, 0x76, 0x64, 0xea, 0x8f, 0x4f, 0x72,
-	0x6c, 0xe3, 0xf6, 0x03, 0x68, 0xab, 0x9f, 0xa7, 0x2a, 0x5f, 0x50, 0x80, 0xc1, 0x39, 0xb6, 0x0c,
-	0xdd, 0x8f, 0x1d, 0x3f, 0xf5, 0xc3, 0xc9, 0x8e, 0x38, 0x4e, 0xd5, 0xfe, 0xc7, 0xac, 0x70, 0x50,
-	0x67, 0x4b, 0x00, 0x7a, 0x90, 0xfb, 0xa1, 0x37, 0x68, 0xdc, 0xdb, 0xfa, 0xf9, 0x67, 0xd7, 0x6a,
-	0x7f, 0xf5, 0xd9, 0xb5, 0xda, 0xdf, 0x7e, 0x76, 0xed, 0xdc, 0xcf, 0xfe, 0xee, 0x5a, 0xed, 0x93,
-	0x77, 0x2a, 0xbf, 0x86, 0x4d, 0x9d, 0x34, 0xf6, 0x8f, 0xd5, 0x7d, 0x62, 0xde, 0x09, 0xc5, 0xdd,
-	0xe8, 0x68, 0x72, 0x37, 0x3a, 0xb8, 0x9b, 0x5b, 0xc6, 0x41, 0x9b, 0xfe, 0xf8, 0x7a, 0xf7, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x07, 0x75, 0x37, 0xd3, 0x70, 0x36, 0x00, 0x00,
+	// 4744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7b, 0x4b, 0x73, 0x1c, 0x47,
+	0x72, 0x30, 0xe7, 0xdd, 0x93, 0x33, 0x03, 0x0c, 0x8a, 0xaf, 0x16, 0x45, 0x91, 0x50, 0x4b, 0xa4,
+	0x20, 0x4a, 0x04, 0x25, 0x68, 0xf5, 0x7d, 0x0a, 0xaf, 0xb5, 0x5a, 0x10, 0x20, 0xd7, 0xb3, 0x0b,
+	0x82, 0xd8, 0x02, 0x68, 0x85, 0x75, 0x70, 0xbb, 0xd1, 0x5d, 0x33, 0xe8, 0x45, 0x4f, 0x77, 0xb3,
+	0x1f, 0x24, 0xa0, 0x1f, 0xe0, 0x08, 0xfb, 0xea, 0x1f, 0xe0, 0x0d, 0x1f, 0x7d, 0x70, 0x38, 0xec,
+	0x93, 0xff, 0xc1, 0xde, 0xec, 0xb3, 0x0f, 0x5e, 0x87, 0x7c, 0xb1, 0xc3, 0x76, 0xd8, 0x07, 0xdb,
+	0x37, 0x3b, 0x1c, 0x99, 0x55, 0xd5, 0xdd, 0x33, 0x18, 0x42, 0xcf, 0x95, 0xed, 0x08, 0x9d, 0xa6,
+	0xf2, 0x51, 0x5d, 0x55, 0x99, 0x59, 0x59, 0x59, 0x59, 0x39, 0xb0, 0x14, 0xfb, 0xb1, 0x08, 0xfc,
+	0x50, 0xac, 0xc7, 0x49, 0x94, 0x45, 0xcc, 0xd0, 0xf0, 0xb5, 0xbb, 0x13, 0x3f, 0x3b, 0xca, 0x0f,
+	0xd7, 0xdd, 0x68, 0x7a, 0x6f, 0x12, 0x4d, 0xa2, 0x7b, 0xc4, 0x70, 0x98, 0x8f, 0x09, 0x22, 0x80,
+	0x5a, 0xb2, 0xe3, 0x35, 0x88, 0x03, 0x27, 0x54, 0xed, 0xe5, 0xcc, 0x9f, 0x8a, 0x34, 0x73, 0xa6,
+	0xb1, 0x26, 0x06, 0x91, 0x7b, 0xac, 0xda, 0xdd, 0xec, 0x44, 0xf1, 0x59, 0x7f, 0x5a, 0x87, 0xce,
+	0x23, 0x91, 0xa6, 0xce, 0x44, 0x30, 0x0b, 0x1a, 0xa9, 0xef, 0x99, 0xb5, 0xd5, 0xda, 0xda, 0xd2,
+	0xc6, 0x70, 0xbd, 0x98, 0xd6, 0x7e, 0xe6, 0x64, 0x79, 0xca, 0x91, 0x88, 0x3c, 0xee, 0xd4, 0x33,
+	0xeb, 0xf3, 0x3c, 0x8f, 0x44, 0x76, 0x14, 0x79, 0x1c, 0x89, 0x6c, 0x08, 0x0d, 0x91, 0x24, 0x66,
+	0x63, 0xb5, 0xb6, 0xd6, 0xe7, 0xd8, 0x64, 0x0c, 0x9a, 0x9e, 0x93, 0x39, 0x66, 0x93, 0x50, 0xd4,
+	0x66, 0xaf, 0xc3, 0x52, 0x9c, 0x44, 0xae, 0xed, 0x87, 0xe3, 0xc8, 0x26, 0x6a, 0x8b, 0xa8, 0x7d,
+	0xc4, 0x8e, 0xc2, 0x71, 0xb4, 0x8d, 0x5c, 0x26, 0x74, 0x9c, 0xd0, 0x09, 0x4e, 0x53, 0x61, 0xb6,
+	0x89, 0xac, 0x41, 0xb6, 0x04, 0x75, 0xdf, 0x33, 0x3b, 0xab, 0xb5, 0xb5, 0x26, 0xaf, 0xfb, 0x1e,
+	0x8e, 0x91, 0xe7, 0xbe, 0x67, 0x1a, 0x72, 0x0c, 0x6c, 0xb3, 0x97, 0xa1, 0x7b, 0xe8, 0x64, 0xee,
+	0x91, 0xed, 0x86, 0x99, 0xd9, 0x25, 0x56, 0x83, 0x10, 0x5b, 0x61, 0xc6, 0xae, 0x81, 0xe1, 0x1e,
+	0x09, 0xf7, 0x38, 0xcd, 0xa7, 0x26, 0xac, 0xd6, 0xd6, 0x06, 0xbc, 0x80, 0x91, 0x96, 0x8a, 0xa7,
+	0xb9, 0x08, 0x5d, 0x61, 0xf6, 0x64, 0x3f, 0x0d, 0x5b, 0x4f, 0xa0, 0xbb, 0x15, 0x85, 0xa1, 0x70,
+	0xb3, 0x28, 0x61, 0x37, 0xa1, 0xa7, 0x65, 0x60, 0x2b, 0xd9, 0xb5, 0x38, 0x68, 0xd4, 0xc8, 0x63,
+	0x6f, 0xc0, 0xb2, 0xab, 0xb9, 0x6d, 0x3f, 0xf4, 0xc4, 0x09, 0x09, 0xaf, 0xc5, 0x97, 0x0a, 0xf4,
+	0x08, 0xb1, 0xd6, 0x3f, 0xd5, 0xa1, 0xb3, 0x7f, 0x94, 0x8f, 0xc7, 0x81, 0x60, 0xaf, 0xc3, 0x40,
+	0x35, 0xb7, 0xa2, 0x60, 0xe4, 0x9d, 0xa8, 0xef, 0xce, 0x22, 0xd9, 0x2a, 0xf4, 0x14, 0xe2, 0xe0,
+	0x34, 0x16, 0xea, 0xb3, 0x55, 0xd4, 0xec, 0x77, 0x1e, 0xf9, 0x21, 0xe9, 0xa4, 0xc1, 0x67, 0x91,
+	0x73, 0x5c, 0xce, 0x89, 0xd9, 0x3c, 0xc3, 0xe5, 0xd0, 0x68, 0x9b, 0x81, 0xff, 0x4c, 0x70, 0x31,
+	0xd9, 0x0a, 0x33, 0x52, 0x56, 0x8b, 0x57, 0x51, 0x6c, 0x03, 0x2e, 0xa7, 0xb2, 0x8b, 0x9d, 0x38,
+	0xe1, 0x44, 0xa4, 0x76, 0xee, 0x87, 0xd9, 0xff, 0xfb, 0x9e, 0xd9, 0x5e, 0x6d, 0xac, 0x35, 0xf9,
+	0x45, 0x45, 0xe4, 0x44, 0x7b, 0x42, 0x24, 0xf6, 0x0e, 0x5c, 0x9a, 0xeb, 0x23, 0xbb, 0x74, 0x56,
+	0x1b, 0x6b, 0x0d, 0xce, 0x66, 0xba, 0x8c, 0xa8, 0xc7, 0x03, 0x58, 0x49, 0xf2, 0x10, 0xcd, 0xfb,
+	0xa1, 0x1f, 0x64, 0x22, 0xd9, 0x8f, 0x85, 0x4b, 0x4a, 0xef, 0x6d, 0x5c, 0x5d, 0xa7, 0x1d, 0xc0,
+	0xe7, 0xc9, 0xfc, 0x6c, 0x0f, 0xeb, 0x97, 0x75, 0x30, 0xb6, 0xfd, 0x34, 0x46, 0x6b, 0x60, 0x57,
+	0xa1, 0x33, 0xce, 0x43, 0xb7, 0xd4, 0x60, 0x1b, 0xc1, 0x91, 0xc7, 0x7e, 0x1d, 0x96, 0x83, 0xc8,
+	0x75, 0x02, 0xbb, 0x50, 0x96, 0x59, 0x5f, 0x6d, 0xac, 0xf5, 0x36, 0x2e, 0x96, 0xa6, 0x5f, 0x18,
+	0x03, 0x5f, 0x22, 0xde, 0x02, 0x66, 0x1f, 0xc2, 0x30, 0x11, 0xd3, 0x28, 0x13, 0x95, 0xee, 0x0d,
+	0xea, 0xce, 0xca, 0xee, 0x1f, 0x27, 0x4e, 0xbc, 0x1b, 0x79, 0x82, 0x2f, 0x4b, 0xde, 0xb2, 0xfb,
+	0xbb, 0x15, 0x79, 0x8a, 0x89, 0xed, 0x7b, 0x27, 0x36, 0x0d, 0x60, 0x36, 0x57, 0x1b, 0x6b, 0xad,
+	0x52, 0x38, 0x62, 0x32, 0xf2, 0x4e, 0x76, 0x90, 0xc2, 0xde, 0x83, 0x2b, 0xf3, 0x5d, 0xe4, 0x57,
+	0xcd, 0x16, 0xf5, 0xb9, 0x38, 0xd3, 0x87, 0x13, 0x89, 0xbd, 0x0a, 0x7d, 0xdd, 0x29, 0x3b, 0x8d,
+	0xe5, 0x46, 0x6b, 0xf1, 0x5e, 0x5a, 0x31, 0xa4, 0xab, 0xd0, 0xf1, 0x53, 0x3b, 0xf5, 0xc3, 0x63,
+	0xda, 0x71, 0x06, 0x6f, 0xfb, 0xe9, 0xbe, 0x1f, 0x1e, 0xb3, 0x97, 0xc0, 0x48, 0x84, 0x2b, 0x29,
+	0x06, 0x51, 0x3a, 0x89, 0x70, 0x91, 0x64, 0xbd, 0x06, 0xad, 0x47, 0x22, 0x99, 0x08, 0xda, 0x4c,
+	0x7e, 0x78, 0xbc, 0xef, 0x3a, 0x21, 0x89, 0xd7, 0xe0, 0x05, 0x6c, 0xfd, 0x79, 0x0d, 0x06, 0x8f,
+	0xf2, 0x20, 0xf3, 0x37, 0x93, 0x49, 0x2e, 0xa6, 0x61, 0x86, 0xfb, 0x78, 0xdb, 0x4f, 0x33, 0xc5,
+	0x49, 0x6d, 0xb6, 0x06, 0xdd, 0x1f, 0x25, 0x51, 0x1e, 0x3f, 0x38, 0x89, 0xb5, 0x02, 0x40, 0xea,
+	0x1a, 0x31, 0xbc, 0x24, 0xb2, 0xb7, 0xa1, 0xf7, 0x38, 0xf1, 0x44, 0x72, 0xff, 0x94, 0x78, 0x1b,
+	0x67, 0x78, 0xab, 0x64, 0x76, 0x1d, 0xba, 0xfb, 0x22, 0x76, 0x12, 0x07, 0x35, 0x83, 0x56, 0xdf,
+	0xe5, 0x25, 0x02, 0x7d, 0x0f, 0x31, 0x8f, 0x3c, 0x65, 0xed, 0x1a, 0xb4, 0x26, 0xd0, 0xdd, 0x9c,
+	0x4c, 0x12, 0x31, 0x71, 0x32, 0x72, 0x44, 0x51, 0x4c, 0xd3, 0x6d, 0xf0, 0x7a, 0x14, 0x93, 0xb3,
+	0xc3, 0x05, 0xd4, 0xe5, 0x02, 0xb0, 0xcd, 0x6e, 0x40, 0x53, 0x2c, 0x9e, 0x0f, 0xe1, 0xd9, 0x15,
+	0x68, 0xbb, 0x51, 0x38, 0xf6, 0x27, 0xca, 0x45, 0x2a, 0xc8, 0xfa, 0xfb, 0x3a, 0xb4, 0x68, 0x71,
+	0xe8, 0xca, 0x42, 0x21, 0x3c, 0x5b, 0x3c, 0x73, 0x02, 0x2d, 0x45, 0x44, 0x3c, 0x78, 0xe6, 0x04,
+	0x6c, 0x15, 0x5a, 0xf8, 0x99, 0x74, 0x81, 0x6c, 0x24, 0x81, 0xdd, 0x86, 0x16, 0xea, 0x36, 0x9d,
+	0x9d, 0x01, 0xea, 0xf6, 0x7e, 0xf3, 0x17, 0x7f, 0x73, 0xf3, 0x02, 0x97, 0x64, 0xf6, 0x06, 0x34,
+	0x9d, 0xc9, 0x24, 0x35, 0x9b, 0xf3, 0x56, 0x5e, 0xac, 0x97, 0x13, 0x03, 0x7b, 0x1f, 0xba, 0x52,
+	0x6f, 0xc8, 0xdd, 0x22, 0xee, 0xab, 0x95, 0xe3, 0xa0, 0xaa, 0x52, 0x5e, 0x72, 0xa2, 0xc4, 0xfd,
+	0x54, 0x39, 0x16, 0x32, 0x34, 0x83, 0x97, 0x08, 0x66, 0x41, 0x3f, 0x4e, 0xc4, 0x66, 0x10, 0x44,
+	0xee, 0xbe, 0xff, 0xa9, 0x50, 0xde, 0x7d, 0x06, 0xc7, 0x6e, 0xc3, 0xd2, 0x9e, 0x93, 0x64, 0xbe,
+	0x13, 0x70, 0x91, 0xe6, 0x41, 0x96, 0x2a, 0x8f, 0x3f, 0x87, 0x65, 0xeb, 0xc0, 0x66, 0x30, 0x07,
+	0xb4, 0xfc, 0xee, 0x6a, 0x63, 0x6d, 0xc0, 0x17, 0x50, 0xac, 0x7f, 0xab, 0x43, 0x7b, 0x14, 0xa6,
+	0x22, 0xa1, 0x93, 0xc1, 0x19, 0x8f, 0x85, 0x9b, 0x09, 0xe9, 0x0f, 0x9a, 0xbc, 0x80, 0x71, 0x01,
+	0x07, 0xd1, 0xc7, 0x89, 0x9f, 0x89, 0xfd, 0xf7, 0x94, 0x8a, 0x4b, 0x04, 0xbb, 0x03, 0x2b, 0x8e,
+	0xe7, 0xd9, 0x9a, 0xdb, 0x4e, 0xa2, 0xe7, 0x29, 0x39, 0x5d, 0x83, 0x2f, 0x3b, 0x9e, 0xb7, 0xa9,
+	0xf0, 0x3c, 0x7a, 0x9e, 0xb2, 0x57, 0xa1, 0x91, 0x88, 0x31, 0x29, 0xbc, 0xb7, 0xb1, 0x2c, 0x15,
+	0xf2, 0xf8, 0xf0, 0x67, 0xc2, 0xcd, 0xb8, 0x18, 0x73, 0xa4, 0xb1, 0x4b, 0xd0, 0x72, 0xb2, 0x2c,
+	0x91, 0x02, 0xee, 0x72, 0x09, 0xb0, 0x75, 0xb8, 0x18, 0xe3, 0xfc, 0x33, 0x3f, 0x0a, 0xed, 0xcc,
+	0x39, 0x0c, 0xf0, 0xe8, 0x49, 0x95, 0x97, 0x5d, 0x29, 0x48, 0x07, 0x48, 0x19, 0x79, 0x29, 0xfa,
+	0xe5, 0x79, 0xfe, 0xd0, 0x99, 0x8a, 0x94, 0x9c, 0x6c, 0x97, 0x5f, 0x9c, 0xed, 0xb1, 0x8b, 0x24,
+	0xf6, 0x1a, 0x0c, 0xca, 0x3e, 0xbe, 0x77, 0x42, 0x42, 0x6e, 0xf1, 0x7e, 0x81, 0xc4, 0x03, 0xe8,
+	0x32, 0xb4, 0xfd, 0xd4, 0x16, 0xa1, 0x47, 0x67, 0xab, 0xc1, 0x5b, 0x7e, 0xfa, 0x20, 0xf4, 0xd8,
+	0x5b, 0xd0, 0x95, 0xa3, 0x78, 0x62, 0x4c, 0x27, 0x6b, 0x6f, 0x63, 0x49, 0xd9, 0x1b, 0xa2, 0xb7,
+	0xc5, 0x98, 0x1b, 0x99, 0x6a, 0x59, 0xaf, 0x40, 0x6b, 0x33, 0x49, 0x9c, 0x53, 0x5a, 0x2b, 0x36,
+	0xcc, 0x1a, 0x79, 0x2a, 0x09, 0x58, 0x2e, 0x34, 0x1e, 0x39, 0x31, 0xbb, 0x05, 0xf5, 0x69, 0x4c,
+	0x94, 0xde, 0xc6, 0xe5, 0x8a, 0x99, 0x39, 0xf1, 0xfa, 0xa3, 0xf8, 0x41, 0x98, 0x25, 0xa7, 0xbc,
+	0x3e, 0x8d, 0xaf, 0xbd, 0x0f, 0x1d, 0x05, 0x62, 0x10, 0x72, 0x2c, 0x4e, 0x49, 0x7d, 0x5d, 0x8e,
+	0x4d, 0x1c, 0xe0, 0x99, 0x13, 0xe4, 0xfa, 0xa0, 0x94, 0xc0, 0xaf, 0xd5, 0x3f, 0xa8, 0x59, 0xff,
+	0xde, 0x04, 0x63, 0x5b, 0x04, 0x02, 0xd7, 0x85, 0x36, 0x58, 0x55, 0x93, 0x32, 0x80, 0x19, 0x1c,
+	0xf2, 0x48, 0xdf, 0x49, 0xbd, 0x84, 0xb2, 0x83, 0x19, 0x1c, 0x7a, 0x8f, 0xd1, 0xfd, 0xdc, 0x3d,
+	0x16, 0x19, 0x19, 0xc0, 0x80, 0x6b, 0x10, 0x29, 0xbb, 0x8a, 0xd2, 0x94, 0x14, 0x05, 0xb2, 0xeb,
+	0x00, 0x49, 0xf4, 0xdc, 0xf6, 0x3d, 0x12, 0xb9, 0x74, 0x3a, 0x46, 0x12, 0x3d, 0x1f, 0x79, 0x28,
+	0xee, 0x6f, 0x43, 0xef, 0xff, 0x1f, 0xcc, 0xb2, 0x0f, 0x85, 0x2b, 0xb6, 0x1f, 0xda, 0x14, 0x33,
+	0x29, 0x13, 0x28, 0xbf, 0x49, 0x71, 0xcb, 0x28, 0xbc, 0x8f, 0x44, 0x6d, 0xcd, 0xdd, 0x73, 0xac,
+	0x79, 0xe1, 0xe6, 0x80, 0xc5, 0x9b, 0xe3, 0x3e, 0xc0, 0xbe, 0x98, 0x4c, 0x45, 0x98, 0x3d, 0x72,
+	0x62, 0xb3, 0x47, 0x8a, 0xb7, 0x4a, 0xc5, 0x6b, 0x6d, 0xad, 0x97, 0x4c, 0xd2, 0x0a, 0x2a, 0xbd,
+	0xf0, 0x5c, 0x73, 0x9d, 0xd0, 0xce, 0x92, 0x3c, 0x74, 0x9d, 0x4c, 0x98, 0x7d, 0x1a, 0xaa, 0xe7,
+	0x3a, 0xe1, 0x81, 0x42, 0x55, 0x2c, 0x78, 0x50, 0xb5, 0xe0, 0xdb, 0xb0, 0x1c, 0x27, 0xfe, 0xd4,
+	0x49, 0x4e, 0xed, 0x63, 0x71, 0x4a, 0xca, 0x58, 0x92, 0x11, 0x98, 0x42, 0xff, 0x44, 0x9c, 0x8e,
+	0xbc, 0x93, 0x6b, 0x1f, 0xc2, 0xf2, 0xdc, 0x04, 0xbe, 0x94, 0xdd, 0xfd, 0x6b, 0x0d, 0xba, 0x7b,
+	0x89, 0x50, 0x5e, 0xe7, 0x26, 0xf4, 0x52, 0xf7, 0x48, 0x4c, 0x1d, 0xd2, 0x92, 0xfa, 0x02, 0x48,
+	0x14, 0x2a, 0x67, 0x76, 0x5f, 0xd5, 0xcf, 0xdf, 0x57, 0x38, 0x0f, 0x9c, 0x76, 0x83, 0x36, 0x13,
+	0x36, 0x4b, 0x67, 0xd2, 0xac, 0x3a, 0x93, 0x55, 0xe8, 0x1f, 0x39, 0xa9, 0xed, 0xe4, 0x59, 0x64,
+	0xbb, 0x51, 0x40, 0x46, 0x67, 0x70, 0x38, 0x72, 0xd2, 0xcd, 0x3c, 0x8b, 0xb6, 0xa2, 0x00, 0x4f,
+	0x1e, 0x3f, 0xb5, 0xf3, 0xd8, 0x73, 0x32, 0xed, 0xb2, 0x0d, 0x3f, 0x7d, 0x42, 0x30, 0xda, 0xa4,
+	0x48, 0x33, 0x7f, 0xea, 0x28, 0x85, 0xda, 0x6e, 0x94, 0x87, 0x19, 0x39, 0xee, 0x06, 0x5f, 0x29,
+	0x48, 0x3c, 0x7a, 0xbe, 0x85, 0x04, 0xeb, 0xaf, 0xeb, 0x00, 0x3b, 0x91, 0x7b, 0x7c, 0xe0, 0x24,
+	0x13, 0x91, 0x61, 0xf8, 0xa0, 0x0d, 0x59, 0x6d, 0xb4, 0x4e, 0x26, 0xcd, 0x97, 0x6d, 0xc0, 0x15,
+	0xad, 0x03, 0x37, 0x0a, 0x28, 0x94, 0x91, 0x96, 0xa8, 0xe4, 0xc8, 0x14, 0x55, 0x06, 0xc3, 0x64,
+	0x86, 0xec, 0x03, 0x58, 0xae, 0xf6, 0xc9, 0x4e, 0x63, 0xda, 0x7b, 0x8b, 0xce, 0xbb, 0x41, 0xd9,
+	0xfd, 0xe0, 0x34, 0x66, 0xef, 0xc0, 0xe5, 0x44, 0x8c, 0x13, 0x91, 0x1e, 0xd9, 0x59, 0x5a, 0x1d,
+	0xac, 0x49, 0x83, 0xad, 0x28, 0xe2, 0x41, 0x5a, 0x8c, 0xf5, 0x0e, 0x5c, 0x1e, 0x53, 0x38, 0x39,
+	0x3f, 0x3d, 0xb9, 0x6d, 0x57, 0x24, 0xb1, 0x3a, 0xbb, 0x57, 0x80, 0x2e, 0x61, 0x72, 0x2b, 0xea,
+	0xc3, 0x2f, 0x20, 0x61, 0x1c, 0x06, 0x02, 0x4f, 0x96, 0xad, 0x23, 0x0c, 0x74, 0xb7, 0xc5, 0x58,
+	0x45, 0x59, 0x25, 0x82, 0x59, 0xd0, 0x7c, 0x14, 0x79, 0x82, 0x36, 0xe1, 0xd2, 0xc6, 0xd2, 0x3a,
+	0xf6, 0x5b, 0x47, 0x49, 0x22, 0x96, 0x13, 0xcd, 0xda, 0x85, 0x36, 0x62, 0x1e, 0xc7, 0x6c, 0x1d,
+	0x3a, 0x19, 0x49, 0x38, 0x55, 0x4e, 0xf3, 0x52, 0xb9, 0x77, 0x4a, 0xf1, 0x73, 0xcd, 0x84, 0xb6,
+	0x71, 0x88, 0x5f, 0x54, 0x9e, 0x4c, 0x02, 0x16, 0x87, 0xe5, 0xc2, 0x3c, 0x9f, 0x84, 0xfe, 0xd3,
+	0x5c, 0xb0, 0x8f, 0x60, 0x25, 0x4e, 0x84, 0xed, 0x13, 0xce, 0xce, 0x8f, 0x6d, 0x37, 0x93, 0xb7,
+	0x13, 0x1a, 0x02, 0x65, 0x5c, 0xf6, 0x38, 0xde, 0xca, 0x4e, 0xf8, 0x52, 0x3c, 0x03, 0x5b, 0x9f,
+	0xc0, 0xd5, 0x82, 0x63, 0x5f, 0xb8, 0x51, 0xe8, 0x39, 0xc9, 0x29, 0x79, 0x92, 0xb9, 0x6f, 0xa7,
+	0x5f, 0xe6, 0xdb, 0xfb, 0xf4, 0xed, 0x3f, 0x6a, 0xc0, 0xd2, 0xe3, 0x70, 0x3b, 0x8f, 0x03, 0x1f,
+	0x77, 0xf7, 0x4f, 0xe4, 0xe6, 0x93, 0x46, 0x5f, 0xab, 0x1a, 0xfd, 0x1a, 0x0c, 0xd5, 0x28, 0xa8,
+	0x3b, 0x69, 0xb2, 0xea, 0x56, 0x26, 0xf1, 0x5b, 0x51, 0x40, 0xf6, 0xca, 0x3e, 0x84, 0xcb, 0x39,
+	0xad, 0x5c, 0x72, 0xe2, 0xfd, 0xd0, 0x7e, 0x41, 0x24, 0xc7, 0x24, 0x23, 0x76, 0x45, 0x36, 0xc4,
+	0xe1, 0x9e, 0x2e, 0xbb, 0xeb, 0x9d, 0x07, 0x05, 0x23, 0xcd, 0x24, 0x0a, 0x6d, 0x4f, 0x4f, 0x59,
+	0xf9, 0x7d, 0xdc, 0xb3, 0x4b, 0x51, 0xb9, 0x12, 0xf4, 0xfe, 0xbf, 0x05, 0x2b, 0x33, 0x9c, 0x34,
+	0x8b, 0x36, 0xcd, 0xe2, 0x6e, 0xa9, 0xdc, 0xd9, 0xe5, 0x57, 0x41, 0x9c, 0x8f, 0xf4, 0x91, 0xcb,
+	0xd1, 0x2c, 0x56, 0xed, 0x70, 0x7f, 0x12, 0x46, 0x89, 0x50, 0x96, 0x67, 0xf8, 0xe9, 0x88, 0xe0,
+	0x6b, 0xbb, 0x70, 0x69, 0xd1, 0x57, 0x16, 0x38, 0xba, 0xd5, 0xaa, 0xa3, 0x9b, 0x8b, 0x42, 0x4b,
+	0xa7, 0xf7, 0x1c, 0x7a, 0x0f, 0xf3, 0x4f, 0x3f, 0x3d, 0x95, 0x77, 0x31, 0xd6, 0x87, 0xda, 0x2e,
+	0x7d, 0xa4, 0xce, 0x6b, 0xbb, 0x18, 0x07, 0xef, 0x1d, 0xa3, 0xb3, 0xa3, 0x6f, 0x74, 0xb9, 0x82,
+	0x30, 0x7c, 0xdd, 0x3b, 0x3e, 0x38, 0x67, 0x3b, 0x4b, 0x32, 0x46, 0x6e, 0xf7, 0x73, 0x3f, 0xc0,
+	0xe3, 0x52, 0xed, 0xdc, 0x02, 0xb6, 0xfe, 0xa1, 0x0e, 0xcd, 0x1f, 0x47, 0x7e, 0x28, 0xef, 0x2c,
+	0x81, 0x1d, 0xc8, 0x5b, 0x06, 0xca, 0xba, 0x93, 0x88, 0x60, 0x07, 0xe3, 0xf4, 0x97, 0xc0, 0x70,
+	0x23, 0x45, 0xaa, 0x4b, 0x92, 0x1b, 0x05, 0x3b, 0xb3, 0x21, 0x7c, 0x6d, 0x61, 0x08, 0x5f, 0x44,
+	0xd8, 0xcd, 0xcf, 0x8b, 0xb0, 0xbb, 0x81, 0x18, 0xa3, 0xe5, 0x85, 0x9e, 0xd9, 0xaa, 0xf2, 0xd2,
+	0xc7, 0x0c, 0x24, 0x6e, 0x45, 0xa1, 0xc7, 0xde, 0x04, 0x48, 0xfc, 0xc9, 0x91, 0xe2, 0x6c, 0x9f,
+	0xbd, 0xf5, 0x10, 0x95, 0x58, 0x39, 0xbc, 0xa4, 0x6e, 0xb8, 0xb6, 0xf2, 0x49, 0x87, 0xb8, 0x6a,
+	0xb9, 0x8e, 0x8e, 0x0e, 0xce, 0x17, 0xdf, 0x8d, 0xaf, 0xcc, 0xdc, 0x8d, 0x49, 0x5a, 0xb4, 0xde,
+	0xeb, 0x80, 0x87, 0xc0, 0x91, 0x1d, 0x85, 0x76, 0xac, 0xef, 0x76, 0x06, 0x62, 0x1e, 0x87, 0x7b,
+	0xc7, 0xe8, 0xcb, 0xf0, 0x42, 0xa8, 0x02, 0xf9, 0xee, 0x5c, 0x20, 0x6f, 0xfd, 0x65, 0x1d, 0x8c,
+	0xcd, 0x30, 0xf3, 0x49, 0xde, 0x57, 0xa0, 0x9d, 0x50, 0xa0, 0xad, 0xa4, 0xad, 0xa0, 0x42, 0xa2,
+	0xf5, 0xcf, 0x93, 0x68, 0xe3, 0x4b, 0x48, 0xb4, 0xf9, 0x85, 0x25, 0xda, 0x3a, 0x4f, 0xa2, 0xb3,
+	0xab, 0x6f, 0x9f, 0xbb, 0xfa, 0xce, 0xfc, 0x35, 0xe6, 0x5c, 0x75, 0x18, 0x5f, 0x49, 0x1d, 0xd6,
+	0x3f, 0xd7, 0xc1, 0xd8, 0x11, 0xe3, 0xec, 0x3b, 0x0b, 0xfe, 0x76, 0x2c, 0xf8, 0x8f, 0x1b, 0xd0,
+	0xe5, 0x38, 0xbd, 0x5f, 0xa1, 0xc0, 0xef, 0x01, 0x90, 0x20, 0xcf, 0x97, 0x3a, 0x09, 0xfb, 0x80,
+	0x24, 0xff, 0x2e, 0xf4, 0xa4, 0x40, 0x65, 0x8f, 0xd6, 0x0b, 0x7a, 0x48, 0xa9, 0x1f, 0x9c, 0x55,
+	0x56, 0xfb, 0x0b, 0x2b, 0xab, 0xf3, 0x95, 0x95, 0x65, 0x7c, 0x13, 0xca, 0xea, 0x9e, 0xab, 0x2c,
+	0x98, 0x57, 0xd6, 0x2f, 0xeb, 0x30, 0x20, 0x65, 0xed, 0x8b, 0xe9, 0xd7, 0xf3, 0x39, 0x73, 0x12,
+	0x6e, 0x7c, 0x59, 0x09, 0x7f, 0x43, 0xee, 0xe7, 0x5c, 0x09, 0xb7, 0xbf, 0x09, 0x09, 0x77, 0xce,
+	0x95, 0xb0, 0xf1, 0x42, 0x09, 0x7f, 0x6d, 0xaf, 0xfe, 0x9d, 0x84, 0x17, 0x4b, 0x18, 0x8f, 0xcc,
+	0xaf, 0x6d, 0xbe, 0xff, 0x93, 0x47, 0xe6, 0xff, 0x3a, 0x89, 0xfe, 0x4b, 0x1d, 0x60, 0xdf, 0x0f,
+	0x27, 0x81, 0xf8, 0xee, 0xd0, 0xfc, 0x76, 0x0e, 0xcd, 0x9f, 0xd7, 0xc1, 0x78, 0xe4, 0x24, 0xc7,
+	0xff, 0x67, 0x6d, 0xf8, 0x35, 0xe8, 0x44, 0x61, 0xd5, 0x62, 0xab, 0x7c, 0xed, 0x28, 0xfc, 0xfa,
+	0x46, 0xf9, 0xbb, 0x35, 0xe8, 0xec, 0x25, 0x91, 0x97, 0xbb, 0xd9, 0x57, 0xb4, 0xc8, 0x2f, 0x2a,
+	0x9f, 0xd9, 0x89, 0x34, 0xe7, 0x27, 0xf2, 0x7b, 0x94, 0x7c, 0xa2, 0x89, 0xec, 0x6c, 0xfc, 0x8a,
+	0xa7, 0xa2, 0x55, 0xde, 0x5c, 0xac, 0x72, 0xeb, 0x0f, 0x6b, 0xd0, 0xa5, 0x1c, 0xc0, 0xb9, 0x86,
+	0x53, 0x8c, 0x56, 0x3f, 0x7f, 0xb4, 0x73, 0xb7, 0x45, 0xe3, 0xab, 0x85, 0xdf, 0x7f, 0x50, 0x83,
+	0x01, 0xa5, 0x69, 0x1e, 0xe6, 0xa1, 0x4b, 0x79, 0xe2, 0xc5, 0x99, 0x85, 0x55, 0x68, 0x26, 0x22,
+	0xd3, 0x53, 0xec, 0xcb, 0x61, 0xb6, 0xa2, 0x00, 0x93, 0x73, 0x44, 0x41, 0x59, 0x38, 0xc9, 0x24,
+	0x5d, 0xf4, 0x14, 0x84, 0x78, 0x5c, 0x3d, 0x3e, 0x40, 0x4d, 0x53, 0xfd, 0x14, 0x24, 0x21, 0x7c,
+	0x56, 0xa2, 0xbc, 0x60, 0x8b, 0x2e, 0xc6, 0xd4, 0xb6, 0x36, 0xe1, 0xf2, 0x83, 0x93, 0x4c, 0x24,
+	0xa1, 0x13, 0xe0, 0x35, 0x79, 0x03, 0xb3, 0x4d, 0x94, 0x4a, 0xd1, 0xcc, 0xb5, 0x92, 0x19, 0x27,
+	0x5c, 0x7d, 0x7f, 0x96, 0x80, 0x75, 0x0b, 0x7a, 0x63, 0x3f, 0x10, 0x76, 0x34, 0x1e, 0xa7, 0x22,
+	0xc3, 0xd1, 0x65, 0x8b, 0x96, 0xd5, 0xe0, 0x0a, 0xb2, 0xfe, 0xab, 0x0e, 0x7d, 0x3d, 0x14, 0x3e,
+	0xdc, 0xbd, 0x60, 0xf9, 0x2f, 0x43, 0x97, 0xbe, 0x96, 0xe2, 0xeb, 0x4d, 0x9d, 0xbe, 0x60, 0x20,
+	0x82, 0x5e, 0x6e, 0x36, 0x61, 0xa5, 0x32, 0x94, 0x9d, 0x45, 0x99, 0x13, 0x98, 0x8d, 0xf9, 0x9c,
+	0x7e, 0x85, 0x85, 0x2f, 0x23, 0xf0, 0x98, 0xda, 0x07, 0xc8, 0x8d, 0xe2, 0x2d, 0x12, 0x29, 0x67,
+	0xc4, 0x8b, 0x14, 0xf6, 0x23, 0x58, 0xc6, 0xd5, 0x6e, 0xc8, 0xac, 0x1c, 0xad, 0x57, 0xee, 0xf8,
+	0x9b, 0xe5, 0x10, 0x0b, 0x65, 0xc6, 0x07, 0x61, 0x15, 0xc4, 0xed, 0xe3, 0x26, 0x02, 0x33, 0x2d,
+	0xe9, 0xd3, 0x80, 0x6e, 0x80, 0x5d, 0xde, 0x95, 0x98, 0xfd, 0xa7, 0x41, 0xb1, 0xd2, 0xc2, 0xd7,
+	0x76, 0xe5, 0x4a, 0x69, 0x5f, 0xdc, 0x85, 0x5e, 0x94, 0xf8, 0x13, 0x3f, 0x94, 0x69, 0x1f, 0x63,
+	0xc1, 0x6c, 0x41, 0x32, 0x50, 0x12, 0xc8, 0x82, 0xb6, 0x34, 0x54, 0x95, 0x3e, 0x9f, 0x71, 0x3a,
+	0x92, 0x62, 0xb9, 0x00, 0xfb, 0x59, 0x22, 0x9c, 0x29, 0x49, 0xff, 0x0d, 0xe8, 0x64, 0x87, 0x01,
+	0x25, 0x82, 0x6b, 0x0b, 0x13, 0xc1, 0xed, 0xec, 0x10, 0x87, 0xa9, 0xe8, 0xb3, 0x4e, 0x29, 0x59,
+	0x05, 0xa1, 0xfa, 0x02, 0x7f, 0xea, 0x67, 0xaa, 0x22, 0x40, 0x02, 0x56, 0x0f, 0xba, 0xf4, 0x05,
+	0x7a, 0x9a, 0xed, 0x41, 0xf7, 0x37, 0x31, 0x6b, 0x43, 0xc0, 0x7f, 0xd6, 0x00, 0xf6, 0x9d, 0x69,
+	0x2c, 0x37, 0x00, 0xfb, 0x21, 0xf4, 0x52, 0x82, 0xe4, 0x8b, 0xb1, 0x2c, 0x19, 0xa9, 0x48, 0xb8,
+	0x64, 0x55, 0x4d, 0xdc, 0xa5, 0x1c, 0xd2, 0xa2, 0x4d, 0xd9, 0x6e, 0xf9, 0x05, 0x7a, 0x06, 0x90,
+	0x36, 0xa9, 0x18, 0xe8, 0x05, 0xe0, 0x16, 0x2c, 0x29, 0x86, 0x58, 0x24, 0xae, 0x08, 0xe5, 0x54,
+	0x6b, 0x7c, 0x20, 0xb1, 0x7b, 0x12, 0xc9, 0xde, 0x2d, 0xd8, 0xdc, 0x28, 0xc8, 0xa7, 0x61, 0xba,
+	0xe0, 0x28, 0x50, 0x5d, 0xb6, 0x24, 0x83, 0xb5, 0xa1, 0x97, 0x42, 0x13, 0x31, 0xa0, 0x89, 0xe3,
+	0x0d, 0x2f, 0xb0, 0x1e, 0x74, 0xd4, 0x57, 0x87, 0x35, 0x36, 0x80, 0x2e, 0xbd, 0x5e, 0x13, 0xad,
+	0x6e, 0xfd, 0xfe, 0x10, 0x7a, 0xa3, 0x30, 0xcd, 0x92, 0x5c, 0xee, 0xfe, 0xf2, 0xd1, 0xb7, 0x45,
+	0x8f, 0xbe, 0x2a, 0xdd, 0x2e, 0x97, 0x81, 0x4d, 0x76, 0x1b, 0x9a, 0x4e, 0x98, 0xf9, 0x2a, 0x70,
+	0xa8, 0x3c, 0xf8, 0xeb, 0x08, 0x9a, 0x13, 0x9d, 0xdd, 0x85, 0x8e, 0xaa, 0x0e, 0x50, 0xee, 0x71,
+	0x61, 0x69, 0x81, 0xe6, 0x61, 0xeb, 0x60, 0x78, 0xaa, 0x6c, 0xc1, 0x6c, 0xcd, 0x7f, 0x5a, 0x17,
+	0x34, 0xf0, 0x82, 0x07, 0xdf, 0x65, 0x9c, 0xc9, 0xc4, 0x6c, 0xeb, 0x77, 0x19, 0xcd, 0x4a, 0xaf,
+	0xca, 0x1c, 0x69, 0xec, 0x9e, 0x3a, 0x48, 0x7f, 0x16, 0xf9, 0xa1, 0x69, 0xcc, 0x7f, 0x53, 0x27,
+	0x1d, 0xe4, 0x81, 0x8a, 0x2d, 0xec, 0x90, 0x8a, 0xa9, 0x2f, 0x3b, 0x74, 0xe7, 0x3b, 0xe8, 0x20,
+	0x16, 0x4b, 0x66, 0x64, 0x8b, 0xbd, 0x0f, 0xbd, 0x94, 0x02, 0x31, 0xd9, 0x05, 0x74, 0x4e, 0xb7,
+	0xe8, 0x52, 0x44, 0x69, 0x1c, 0xd2, 0xa2, 0x8d, 0xe3, 0x4c, 0x9d, 0xe4, 0x58, 0x76, 0xea, 0xcd,
+	0x8f, 0xa3, 0x03, 0x0d, 0x6e, 0x4c, 0x55, 0x0b, 0x93, 0xe4, 0xc4, 0xdb, 0xd7, 0x7b, 0x42, 0xf3,
+	0x4a, 0x79, 0x23, 0x8d, 0xbd, 0x05, 0x9d, 0x58, 0x1e, 0x7b, 0xf4, 0xe6, 0xd3, 0xdb, 0x58, 0x29,
+	0xd9, 0xd4, 0x79, 0xc8, 0x35, 0x07, 0xfb, 0x01, 0x2c, 0xc9, 0xf7, 0x89, 0xb1, 0x72, 0xfb, 0xf4,
+	0x0e, 0x34, 0xf3, 0xd4, 0x3d, 0x73, 0x2a, 0xf0, 0x41, 0x56, 0x05, 0xd9, 0xf7, 0x61, 0x20, 0x94,
+	0xb3, 0xb1, 0x53, 0xac, 0x7f, 0x18, 0x52, 0xf7, 0x2b, 0x67, 0x7d, 0x11, 0xee, 0x32, 0xde, 0x17,
+	0x15, 0x88, 0xad, 0x41, 0x5b, 0x66, 0xa3, 0xcd, 0x15, 0xea, 0x55, 0x29, 0xb7, 0x92, 0x59, 0x6f,
+	0xae, 0xe8, 0xec, 0xfe, 0x5c, 0x16, 0x19, 0x13, 0xb3, 0x8c, 0xfa, 0x98, 0x2f, 0x4a, 0x0d, 0xcf,
+	0xe4, 0x97, 0x31, 0x53, 0xbe, 0x01, 0x50, 0x66, 0xdf, 0xcd, 0x8b, 0xf3, 0xa6, 0x58, 0xa4, 0xde,
+	0x79, 0xb7, 0xc8, 0xba, 0x63, 0x2d, 0x4e, 0xf5, 0x35, 0x80, 0xd2, 0xda, 0xe6, 0x25, 0xea, 0xfa,
+	0xd2, 0x82, 0xae, 0xf2, 0x0d, 0x81, 0x2f, 0xc7, 0xb3, 0x08, 0xf6, 0x36, 0x18, 0x11, 0x56, 0x56,
+	0xd8, 0x87, 0xa7, 0xe6, 0x65, 0xda, 0xbd, 0x2b, 0xea, 0x01, 0x51, 0xd6, 0x6a, 0xd0, 0xc9, 0xdc,
+	0x89, 0x24, 0xc0, 0xee, 0x62, 0x91, 0x40, 0x84, 0x2f, 0x8b, 0xd2, 0xf9, 0x5e, 0x39, 0x5b, 0xe3,
+	0xa1, 0xe8, 0xe4, 0x8b, 0x4b, 0xe7, 0x7a, 0xf5, 0x45, 0xce, 0x15, 0x33, 0xd7, 0xd2, 0x1b, 0x9a,
+	0x67, 0x58, 0x24, 0x01, 0xbf, 0xa2, 0xfc, 0xe8, 0x4b, 0x67, 0xbf, 0x22, 0x29, 0xf8, 0xae, 0xeb,
+	0xa7, 0x0f, 0xfd, 0x24, 0xcd, 0xcc, 0x6b, 0xb2, 0x14, 0x46, 0x81, 0xe8, 0x85, 0xfd, 0x74, 0xc7,
+	0x49, 0x33, 0xf3, 0x65, 0x5d, 0x3d, 0x83, 0x10, 0xca, 0x5c, 0x46, 0xa6, 0x64, 0xb5, 0xd7, 0xe7,
+	0x65, 0x5e, 0xe4, 0x9f, 0x54, 0x88, 0x8a, 0x4d, 0xf6, 0x11, 0x2c, 0xcb, 0x3e, 0xe5, 0x16, 0x7c,
+	0x65, 0xde, 0x26, 0x67, 0x72, 0x21, 0x7c, 0x90, 0x54, 0xc1, 0xf2, 0x03, 0xe8, 0x7e, 0xe4, 0x07,
+	0x6e, 0x2c, 0xfc, 0x40, 0xe1, 0xa8, 0x06, 0x49, 0x15, 0x64, 0x77, 0xa0, 0xed, 0xc9, 0x77, 0xef,
+	0x9b, 0x67, 0x1c, 0x90, 0x7a, 0x97, 0xe5, 0x8a, 0x83, 0xbd, 0x09, 0x1d, 0x7a, 0xf3, 0x8a, 0x62,
+	0x73, 0x75, 0xde, 0x88, 0xe5, 0x5b, 0x15, 0x6f, 0x07, 0xf4, 0x8b, 0x1b, 0x53, 0x07, 0xab, 0xaf,
+	0xce, 0x6f, 0x4c, 0x15, 0xb4, 0x72, 0xcd, 0xc1, 0x6e, 0x41, 0x6b, 0x8a, 0xee, 0xd9, 0xb4, 0xe6,
+	0x1d, 0x9b, 0xf4, 0xda, 0x92, 0x4a, 0x8e, 0x87, 0x4e, 0x4d, 0xb9, 0xfb, 0x5e, 0x3b, 0xe3, 0x78,
+	0x8a, 0x23, 0x95, 0x43, 0x5a, 0xb4, 0xd9, 0x6f, 0xc3, 0xb5, 0xea, 0x4b, 0x94, 0x7e, 0xa6, 0x52,
+	0xf1, 0xc4, 0xeb, 0xf4, 0x95, 0x57, 0x17, 0x18, 0xf8, 0xec, 0x83, 0x16, 0xbf, 0x1a, 0x2f, 0x26,
+	0xd0, 0xb4, 0xe4, 0xa1, 0x85, 0x7e, 0xc5, 0xbc, 0x75, 0x66, 0x5a, 0xc5, 0xf1, 0xa9, 0x8f, 0x44,
+	0x6c, 0xb3, 0x0f, 0xa0, 0x3f, 0xc6, 0xa7, 0x13, 0x15, 0xd6, 0x9a, 0xb7, 0x57, 0x6b, 0xb3, 0xb1,
+	0x53, 0xe5, 0x61, 0x85, 0xf7, 0xc6, 0x25, 0x80, 0xf5, 0x5b, 0x6e, 0x68, 0x3b, 0x9e, 0x97, 0x98,
+	0x6f, 0xc8, 0x87, 0x15, 0x37, 0xdc, 0xf4, 0x3c, 0x7a, 0xa0, 0x8a, 0x62, 0x41, 0xf5, 0x4e, 0xf8,
+	0x06, 0xbb, 0x26, 0x8f, 0x61, 0x8d, 0x1a, 0x79, 0xc8, 0x80, 0x01, 0x68, 0x10, 0x08, 0x7c, 0xe4,
+	0x34, 0xdf, 0x94, 0x0c, 0x1a, 0x35, 0xf2, 0xf0, 0x95, 0x7d, 0xea, 0x9c, 0xd8, 0x1a, 0x63, 0xde,
+	0x21, 0x8e, 0xde, 0xd4, 0x39, 0xd9, 0x53, 0x28, 0x34, 0x73, 0x59, 0x4a, 0x40, 0xc6, 0xf6, 0xd6,
+	0xbc, 0x99, 0x17, 0x91, 0x3f, 0xef, 0xfa, 0xba, 0x29, 0xdd, 0x11, 0x39, 0x61, 0x3b, 0xd8, 0x30,
+	0xdf, 0x3e, 0xeb, 0x8e, 0xd4, 0xcd, 0x05, 0xdd, 0x91, 0x6a, 0x62, 0x1f, 0xe9, 0xad, 0x49, 0xd9,
+	0x77, 0xe7, 0xfb, 0x14, 0xa1, 0x0d, 0xef, 0x66, 0xba, 0x89, 0x7d, 0xe8, 0x6d, 0x4a, 0xf6, 0x59,
+	0x9f, 0xef, 0x53, 0x44, 0x40, 0xbc, 0xfb, 0x4c, 0x37, 0xad, 0xf7, 0xa1, 0xbf, 0x49, 0x55, 0xa8,
+	0x7e, 0x4a, 0x2e, 0xe6, 0x16, 0x34, 0x8b, 0x8b, 0x53, 0xe1, 0xbb, 0x88, 0xe3, 0x53, 0x81, 0x95,
+	0xac, 0x9c, 0xc8, 0xd6, 0x9f, 0x34, 0xa0, 0xbd, 0x1f, 0xe5, 0x89, 0x2b, 0x3e, 0xff, 0xad, 0xff,
+	0x15, 0xbd, 0x94, 0xb0, 0x7c, 0x0c, 0x93, 0xb3, 0x26, 0x72, 0xf5, 0x4e, 0xd6, 0xa0, 0xe0, 0xb3,
+	0xb8, 0x93, 0x15, 0x4f, 0xb9, 0xb2, 0x9e, 0x4d, 0x02, 0xa4, 0xc6, 0x3c, 0x3d, 0xf2, 0xa2, 0xe7,
+	0x58, 0xce, 0x43, 0x91, 0x43, 0x93, 0x83, 0x46, 0x8d, 0x3c, 0x2a, 0xf8, 0xd1, 0x0c, 0x64, 0x27,
+	0x32, 0xe2, 0xed, 0x6b, 0x24, 0x59, 0x8b, 0xbe, 0xc7, 0x75, 0x5e, 0x70, 0x75, 0xbf, 0x03, 0x45,
+	0x01, 0x82, 0x69, 0x2c, 0x8c, 0x4b, 0x0b, 0x3a, 0xdb, 0x80, 0x6e, 0x51, 0xa3, 0xac, 0x82, 0x88,
+	0x4b, 0xeb, 0x05, 0x66, 0xfd, 0x40, 0xb7, 0x78, 0xc9, 0xb6, 0xe0, 0x66, 0x17, 0x27, 0xd1, 0xa1,
+	0x0a, 0xc2, 0xe1, 0xcb, 0xdc, 0xec, 0xf6, 0xb0, 0x9f, 0xbe, 0xde, 0xfa, 0x29, 0xa6, 0x06, 0xd2,
+	0xcc, 0xec, 0x69, 0xb7, 0xbd, 0x85, 0xa0, 0x15, 0x83, 0x81, 0x95, 0x99, 0xa8, 0x42, 0xbc, 0x51,
+	0x4d, 0xdd, 0x38, 0x57, 0x21, 0x1f, 0xb5, 0x55, 0x09, 0xb2, 0x54, 0x8e, 0x2a, 0x41, 0x26, 0xd1,
+	0x35, 0x08, 0x43, 0x6d, 0x3c, 0x14, 0x62, 0xe7, 0x34, 0x88, 0x1c, 0x4f, 0x29, 0x44, 0x83, 0xc8,
+	0x4d, 0xc1, 0x73, 0x8b, 0x6a, 0x80, 0xa8, 0x6d, 0xfd, 0x45, 0x1d, 0x56, 0xf6, 0x92, 0xc8, 0x15,
+	0x69, 0xba, 0x83, 0xe7, 0x8e, 0x43, 0x51, 0x04, 0x83, 0x26, 0x5d, 0xa8, 0x64, 0x8d, 0x21, 0xb5,
+	0xd1, 0x40, 0x64, 0x69, 0x73, 0x11, 0x3e, 0x37, 0xb8, 0x2c, 0x76, 0xa6, 0xe8, 0xb9, 0x20, 0x53,
+	0xc7, 0x46, 0x85, 0x4c, 0x57, 0xb1, 0x5b, 0xb0, 0x54, 0x96, 0xf9, 0xd0, 0x17, 0x54, 0xcd, 0x6f,
+	0x81, 0xa5, 0xaf, 0xdc, 0x84, 0x5e, 0x22, 0x1c, 0x3c, 0x99, 0xe9, 0x33, 0x2d, 0xe2, 0x01, 0x89,
+	0xa2, 0xef, 0xe0, 0xe6, 0x17, 0xd3, 0x28, 0x39, 0xb5, 0x9f, 0xe6, 0x51, 0xe6, 0x90, 0xd1, 0x34,
+	0x78, 0x4f, 0xe2, 0x7e, 0x8a, 0x28, 0xbc, 0x28, 0xb9, 0x71, 0x6e, 0xa7, 0x47, 0x8e, 0x7a, 0x5c,
+	0x6e, 0x70, 0xc3, 0x8d, 0xf3, 0x7d, 0x84, 0x71, 0x1e, 0x89, 0x48, 0x69, 0x4f, 0xd8, 0x13, 0x8c,
+	0x48, 0xc9, 0x6c, 0xba, 0x7c, 0xa0, 0xb1, 0xb2, 0xf8, 0xf1, 0x35, 0x18, 0xa8, 0x61, 0x0e, 0x73,
+	0x6f, 0x22, 0x64, 0x2d, 0x77, 0x83, 0xab, 0xb1, 0xef, 0x13, 0x0e, 0x5f, 0x6c, 0x7a, 0x4a, 0x76,
+	0xa4, 0x31, 0xa9, 0x9d, 0x5a, 0xa1, 0x9d, 0x21, 0x34, 0xf0, 0x26, 0x27, 0xd5, 0x85, 0x4d, 0xf6,
+	0x1e, 0x34, 0x02, 0x7f, 0xaa, 0x22, 0xf4, 0x97, 0x67, 0x9c, 0xcb, 0xac, 0x06, 0x54, 0x7e, 0x01,
+	0xb9, 0x71, 0x3d, 0x79, 0xe8, 0x9f, 0xd8, 0x68, 0x4b, 0x4a, 0x6a, 0x06, 0x22, 0xd0, 0x60, 0x51,
+	0xec, 0x8e, 0x4b, 0xf5, 0x04, 0x7a, 0x97, 0x0d, 0x78, 0x57, 0x61, 0x46, 0x1e, 0xfb, 0x1e, 0x18,
+	0x69, 0xe8, 0xc4, 0xe9, 0x51, 0x94, 0xa9, 0x88, 0x9c, 0xad, 0x63, 0x2d, 0xfe, 0xd6, 0xee, 0xc1,
+	0x49, 0xb8, 0xaf, 0x28, 0x6a, 0xb0, 0x82, 0x93, 0xfd, 0x00, 0xfa, 0xa9, 0x48, 0x53, 0x59, 0x91,
+	0x35, 0x8e, 0xcc, 0xce, 0xbc, 0xdb, 0xdf, 0x97, 0x54, 0x5c, 0xb5, 0xea, 0xdc, 0x4b, 0x4b, 0x14,
+	0x7b, 0x1b, 0x98, 0xa3, 0xdc, 0x95, 0x1d, 0x46, 0x9e, 0x28, 0xdf, 0x69, 0x5a, 0x7c, 0xa8, 0x29,
+	0x68, 0xe8, 0xb4, 0x1f, 0x7e, 0x03, 0x96, 0xf4, 0x68, 0x41, 0x34, 0x99, 0x14, 0x97, 0xd2, 0x97,
+	0xcf, 0x8c, 0xb7, 0x43, 0xe4, 0xca, 0xa8, 0x83, 0xb4, 0x4a, 0xc0, 0xc7, 0x78, 0x4f, 0x38, 0x1e,
+	0x76, 0xa1, 0x90, 0xbf, 0xc1, 0x0b, 0xd8, 0xfa, 0x8f, 0x1a, 0xf4, 0x2a, 0xd3, 0xa6, 0xea, 0xfd,
+	0x54, 0x24, 0x3a, 0x61, 0x81, 0x6d, 0xc4, 0x1d, 0x45, 0xaa, 0x90, 0xb6, 0xcb, 0xa9, 0x8d, 0xb8,
+	0x24, 0x0a, 0x84, 0xde, 0x62, 0xd8, 0x46, 0xeb, 0x50, 0xb7, 0x23, 0x59, 0xac, 0x48, 0x5a, 0x69,
+	0xf2, 0x7e, 0x89, 0x1c, 0x79, 0x34, 0x19, 0x27, 0x73, 0x0e, 0x9d, 0x54, 0xa7, 0x50, 0x0a, 0x18,
+	0xf7, 0xe8, 0x33, 0x91, 0xe0, 0x5c, 0x94, 0xd7, 0xd3, 0x20, 0x2a, 0x9b, 0xbc, 0xcd, 0xa7, 0x51,
+	0x28, 0x8d, 0xb7, 0xcf, 0x0d, 0x44, 0x7c, 0x12, 0x85, 0xd4, 0x4d, 0xa9, 0x56, 0x59, 0xad, 0x06,
+	0xd1, 0xa7, 0x3c, 0xcd, 0x05, 0x06, 0x0c, 0x1e, 0x55, 0x9c, 0x76, 0x79, 0x87, 0xe0, 0x91, 0x67,
+	0xfd, 0x63, 0x0d, 0x56, 0xce, 0xc8, 0x0f, 0xcf, 0x67, 0x94, 0x9d, 0x2e, 0x83, 0xea, 0xf3, 0x36,
+	0x82, 0x23, 0x8f, 0x08, 0xd9, 0x94, 0xac, 0xa9, 0xae, 0x08, 0xd9, 0x14, 0x4d, 0xe9, 0x32, 0xb4,
+	0xb3, 0x13, 0x5a, 0xad, 0xfc, 0x9f, 0x45, 0x2b, 0x3b, 0xc1, 0x65, 0x6e, 0x42, 0x37, 0x88, 0x26,
+	0x76, 0x20, 0x9e, 0x89, 0x80, 0xe4, 0xb0, 0xb4, 0xf1, 0xfa, 0x39, 0x8a, 0x5b, 0xdf, 0x89, 0x26,
+	0x3b, 0xc8, 0xcb, 0x8d, 0x40, 0xb5, 0xac, 0x1f, 0x83, 0xa1, 0xb1, 0xac, 0x0b, 0xad, 0x6d, 0x71,
+	0x98, 0x4f, 0x86, 0x17, 0xf0, 0x9e, 0x8c, 0x3d, 0x86, 0x35, 0x6c, 0x7d, 0xec, 0x24, 0xe1, 0xb0,
+	0x8e, 0xe4, 0x07, 0x49, 0x12, 0x25, 0xc3, 0x06, 0x36, 0xf7, 0x9c, 0xd0, 0x77, 0x87, 0x4d, 0x6c,
+	0x3e, 0x74, 0x32, 0x27, 0x18, 0xb6, 0xac, 0x3f, 0x6b, 0x81, 0xb1, 0xa7, 0x46, 0x67, 0xdb, 0x30,
+	0xd0, 0x33, 0x79, 0x41, 0xda, 0x60, 0x6f, 0xbe, 0x41, 0x69, 0x83, 0x7e, 0x5c, 0x81, 0xe6, 0xff,
+	0x71, 0x51, 0x3f, 0xf3, 0x8f, 0x8b, 0xeb, 0xd0, 0x78, 0x9a, 0x9c, 0xce, 0x26, 0xec, 0xf7, 0x02,
+	0x27, 0xe4, 0x88, 0xc6, 0xe7, 0x27, 0xd4, 0xbb, 0x2d, 0xdd, 0x8b, 0xd9, 0x9c, 0x0f, 0x4a, 0xe5,
+	0x01, 0xcd, 0x01, 0x99, 0x64, 0x1b, 0xaf, 0xdc, 0xee, 0x91, 0x1f, 0x78, 0x89, 0x08, 0x55, 0x2e,
+	0x89, 0x9d, 0x9d, 0x32, 0x2f, 0x78, 0xd8, 0x0f, 0xa9, 0xba, 0x48, 0xa7, 0x0a, 0xaa, 0xd9, 0xe4,
+	0xcb, 0x33, 0x37, 0x38, 0xcd, 0xc1, 0x97, 0x2b, 0xec, 0xb4, 0x07, 0xcb, 0xb2, 0xc4, 0x4e, 0xb5,
+	0x2c, 0x51, 0x56, 0xe1, 0x17, 0xd7, 0x74, 0xba, 0x47, 0x50, 0xb0, 0x24, 0x09, 0x74, 0xc8, 0x74,
+	0x8b, 0x0b, 0x06, 0x9e, 0x31, 0xb7, 0xa1, 0x89, 0x3b, 0x5e, 0xdd, 0xb8, 0x2b, 0xd3, 0xd6, 0xe7,
+	0x1a, 0x27, 0x3a, 0xfd, 0x19, 0x27, 0x4f, 0x8f, 0x6c, 0x19, 0x1f, 0xa0, 0x93, 0xe9, 0xa9, 0x7a,
+	0xdf, 0x3c, 0x3d, 0xda, 0xc6, 0x08, 0x01, 0xad, 0xf4, 0x16, 0x2c, 0xe9, 0x45, 0xaa, 0xa2, 0xa9,
+	0x3e, 0x71, 0x0d, 0x34, 0x56, 0xd6, 0x4c, 0x7d, 0x04, 0x43, 0xfc, 0xf7, 0x4d, 0x6a, 0x67, 0x91,
+	0xfe, 0x17, 0x82, 0x39, 0x58, 0x6d, 0xcc, 0xde, 0x7b, 0x9f, 0xe4, 0xbe, 0x77, 0x10, 0xa9, 0xff,
+	0x21, 0x0c, 0x88, 0x5f, 0x83, 0xf4, 0xb7, 0x1d, 0xca, 0xd8, 0x96, 0x85, 0x97, 0xc6, 0xa1, 0x2a,
+	0xe3, 0xa1, 0xd0, 0x49, 0xfd, 0x5b, 0x01, 0xff, 0xd5, 0xb3, 0xac, 0x12, 0x47, 0x12, 0xb5, 0x15,
+	0x66, 0xd6, 0x47, 0xd0, 0xaf, 0x9a, 0x0f, 0x9a, 0x23, 0xdd, 0x09, 0x86, 0x17, 0x18, 0x40, 0x7b,
+	0x37, 0x4a, 0xa6, 0x4e, 0x30, 0xac, 0x61, 0x5b, 0xd6, 0xeb, 0x0e, 0xeb, 0xac, 0x0f, 0x86, 0x0e,
+	0x56, 0x87, 0x0d, 0xeb, 0xfb, 0x60, 0xe8, 0x3f, 0x65, 0xe0, 0x54, 0xc8, 0x65, 0xd2, 0xb9, 0x2e,
+	0x9d, 0x93, 0x81, 0x08, 0x0a, 0x87, 0xf4, 0x5f, 0x8e, 0xea, 0xe5, 0x5f, 0x8e, 0xac, 0x9f, 0x42,
+	0xbf, 0xba, 0x34, 0x9d, 0x18, 0xaa, 0x95, 0x89, 0xa1, 0x05, 0xbd, 0x70, 0x98, 0x71, 0x12, 0x4d,
+	0xed, 0x4a, 0xf8, 0x60, 0x20, 0x02, 0x87, 0xb9, 0xf3, 0x3b, 0xd0, 0x96, 0x7f, 0xaf, 0x62, 0x2b,
+	0x30, 0x78, 0x12, 0x1e, 0x87, 0xd1, 0xf3, 0x50, 0x22, 0x86, 0x17, 0xd8, 0x45, 0x58, 0xd6, 0xab,
+	0x55, 0xff, 0xe3, 0x1a, 0xd6, 0xd8, 0x10, 0xfa, 0x54, 0x95, 0xab, 0x31, 0x75, 0x76, 0x1d, 0xcc,
+	0xbd, 0x44, 0xc4, 0x4e, 0x22, 0xb6, 0xa3, 0x50, 0xec, 0x46, 0x99, 0x3f, 0x3e, 0xd5, 0xd4, 0xc6,
+	0x9d, 0x87, 0xd0, 0x96, 0x7f, 0xf2, 0xaa, 0x8c, 0x20, 0x11, 0xc3, 0x0b, 0x6c, 0x19, 0x7a, 0x1f,
+	0x3b, 0x7e, 0xe6, 0x87, 0x93, 0x5d, 0x71, 0x92, 0xc9, 0xfd, 0x8f, 0xb7, 0xd7, 0x61, 0x9d, 0x2d,
+	0x01, 0xa8, 0x8f, 0x3c, 0x08, 0xbd, 0x61, 0xe3, 0xfe, 0xd6, 0x2f, 0x3e, 0xbb, 0x51, 0xfb, 0xab,
+	0xcf, 0x6e, 0xd4, 0xfe, 0xf6, 0xb3, 0x1b, 0x17, 0x7e, 0xfe, 0x77, 0x37, 0x6a, 0x9f, 0xbc, 0x5b,
+	0xf9, 0x0b, 0xdb, 0xd4, 0xc9, 0x12, 0xff, 0x44, 0xe6, 0x3d, 0x35, 0x10, 0x8a, 0x7b, 0xf1, 0xf1,
+	0xe4, 0x5e, 0x7c, 0x78, 0x4f, 0x5b, 0xc6, 0x61, 0x9b, 0xfe, 0x99, 0xf6, 0xde, 0x7f, 0x0f, 0x00,
+	0x33, 0xca, 0x15, 0x53, 0x18, 0x37, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -9454,6 +9499,28 @@ func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MemoryBudget != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.MemoryBudget))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ResourceGroup) > 0 {
+		i -= len(m.ResourceGroup)
+		copy(dAtA[i:], m.ResourceGroup)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.ResourceGroup)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CpuShare != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.CpuShare))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MemoryQuota != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.MemoryQuota))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ReaderSize != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
 		i--
@@ -9506,6 +9573,11 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Deadline != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size, err := m.SessionLogger.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -11801,6 +11873,19 @@ func (m *ProcessLimitation) ProtoSize() (n int) {
 	if m.ReaderSize != 0 {
 		n += 1 + sovPipeline(uint64(m.ReaderSize))
 	}
+	if m.MemoryQuota != 0 {
+		n += 1 + sovPipeline(uint64(m.MemoryQuota))
+	}
+	if m.CpuShare != 0 {
+		n += 1 + sovPipeline(uint64(m.CpuShare))
+	}
+	l = len(m.ResourceGroup)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.MemoryBudget != 0 {
+		n += 1 + sovPipeline(uint64(m.MemoryBudget))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11842,6 +11927,9 @@ func (m *ProcessInfo) ProtoSize() (n int) {
 	}
 	l = m.SessionLogger.ProtoSize()
 	n += 1 + l + sovPipeline(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovPipeline(uint64(m.Deadline))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -24013,6 +24101,95 @@ func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryQuota", wireType)
+			}
+			m.MemoryQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuShare", wireType)
+			}
+			m.CpuShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CpuShare |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBudget", wireType)
+			}
+			m.MemoryBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryBudget |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -24374,6 +24551,25 @@ func (m *ProcessInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -77,6 +77,15 @@ func (m CreateTable) IsSystemExternalRel() bool {
 	return m.TableDef.TableType == SystemExternalRel
 }
 
+// PartitionTableItems returns the partition items which own a partition table,
+// they are the subpartitions if the table is subpartitioned.
+func (p *PartitionByDef) PartitionTableItems() []*PartitionItem {
+	if p.SubPartition != nil {
+		return p.SubPartition.Partitions
+	}
+	return p.Partitions
+}
+
 func (p *PartitionByDef) GenPartitionExprString() string {
 	switch p.Type {
 	case PartitionType_HASH, PartitionType_LINEAR_HASH,
@@ -406,7 +406,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{46, 0}
 }
 
 type FrameClause_FrameType int32
@@ -431,7 +431,7 @@ func (x FrameClause_FrameType) String() string {
 }
 
 func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
 }
 
 type FrameBound_BoundType int32
@@ -459,7 +459,7 @@ func (x FrameBound_BoundType) String() string {
 }
 
 func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
 }
 
 type Node_NodeType int32
@@ -626,7 +626,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 0}
 }
 
 type Node_JoinType int32
@@ -678,7 +678,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 1}
 }
 
 type Node_AggMode int32
@@ -706,7 +706,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 2}
 }
 
 type Node_FillType int32
@@ -743,7 +743,7 @@ func (x Node_FillType) String() string {
 }
 
 func (Node_FillType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 3}
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 3}
 }
 
 type Query_StatementType int32
@@ -783,7 +783,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
 }
 
 type TransationControl_TclType int32
@@ -811,7 +811,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{70, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -839,7 +839,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{71, 0}
 }
 
 type DataControl_DclType int32
@@ -888,47 +888,48 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{76, 0}
 }
 
 type DataDefinition_DdlType int32
 
 const (
-	DataDefinition_CREATE_DATABASE     DataDefinition_DdlType = 0
-	DataDefinition_ALTER_DATABASE      DataDefinition_DdlType = 1
-	DataDefinition_DROP_DATABASE       DataDefinition_DdlType = 2
-	DataDefinition_CREATE_TABLE        DataDefinition_DdlType = 3
-	DataDefinition_ALTER_TABLE         DataDefinition_DdlType = 4
-	DataDefinition_DROP_TABLE          DataDefinition_DdlType = 5
-	DataDefinition_CREATE_INDEX        DataDefinition_DdlType = 6
-	DataDefinition_ALTER_INDEX         DataDefinition_DdlType = 7
-	DataDefinition_DROP_INDEX          DataDefinition_DdlType = 8
-	DataDefinition_TRUNCATE_TABLE      DataDefinition_DdlType = 9
-	DataDefinition_CREATE_VIEW         DataDefinition_DdlType = 10
-	DataDefinition_ALTER_VIEW          DataDefinition_DdlType = 11
-	DataDefinition_DROP_VIEW           DataDefinition_DdlType = 12
-	DataDefinition_SHOW_CREATEDATABASE DataDefinition_DdlType = 13
-	DataDefinition_SHOW_CREATETABLE    DataDefinition_DdlType = 14
-	DataDefinition_SHOW_DATABASES      DataDefinition_DdlType = 15
-	DataDefinition_SHOW_TABLES         DataDefinition_DdlType = 16
-	DataDefinition_SHOW_COLUMNS        DataDefinition_DdlType = 17
-	DataDefinition_SHOW_INDEX          DataDefinition_DdlType = 18
-	DataDefinition_SHOW_VARIABLES      DataDefinition_DdlType = 19
-	DataDefinition_SHOW_WARNINGS       DataDefinition_DdlType = 20
-	DataDefinition_SHOW_ERRORS         DataDefinition_DdlType = 21
-	DataDefinition_SHOW_STATUS         DataDefinition_DdlType = 22
-	DataDefinition_SHOW_PROCESSLIST    DataDefinition_DdlType = 23
-	DataDefinition_SHOW_TABLE_STATUS   DataDefinition_DdlType = 24
-	DataDefinition_SHOW_TARGET         DataDefinition_DdlType = 25
-	DataDefinition_SHOW_COLLATION      DataDefinition_DdlType = 26
-	DataDefinition_LOCK_TABLES         DataDefinition_DdlType = 27
-	DataDefinition_UNLOCK_TABLES       DataDefinition_DdlType = 28
-	DataDefinition_CREATE_SEQUENCE     DataDefinition_DdlType = 29
-	DataDefinition_ALTER_SEQUENCE      DataDefinition_DdlType = 30
-	DataDefinition_DROP_SEQUENCE       DataDefinition_DdlType = 31
-	DataDefinition_SHOW_SEQUENCES      DataDefinition_DdlType = 32
-	DataDefinition_SHOW_CONNECTORS     DataDefinition_DdlType = 33
-	DataDefinition_SHOW_UPGRADE        DataDefinition_DdlType = 34
+	DataDefinition_CREATE_DATABASE           DataDefinition_DdlType = 0
+	DataDefinition_ALTER_DATABASE            DataDefinition_DdlType = 1
+	DataDefinition_DROP_DATABASE             DataDefinition_DdlType = 2
+	DataDefinition_CREATE_TABLE              DataDefinition_DdlType = 3
+	DataDefinition_ALTER_TABLE               DataDefinition_DdlType = 4
+	DataDefinition_DROP_TABLE                DataDefinition_DdlType = 5
+	DataDefinition_CREATE_INDEX              DataDefinition_DdlType = 6
+	DataDefinition_ALTER_INDEX               DataDefinition_DdlType = 7
+	DataDefinition_DROP_INDEX                DataDefinition_DdlType = 8
+	DataDefinition_TRUNCATE_TABLE            DataDefinition_DdlType = 9
+	DataDefinition_CREATE_VIEW               DataDefinition_DdlType = 10
+	DataDefinition_ALTER_VIEW                DataDefinition_DdlType = 11
+	DataDefinition_DROP_VIEW                 DataDefinition_DdlType = 12
+	DataDefinition_SHOW_CREATEDATABASE       DataDefinition_DdlType = 13
+	DataDefinition_SHOW_CREATETABLE          DataDefinition_DdlType = 14
+	DataDefinition_SHOW_DATABASES            DataDefinition_DdlType = 15
+	DataDefinition_SHOW_TABLES               DataDefinition_DdlType = 16
+	DataDefinition_SHOW_COLUMNS              DataDefinition_DdlType = 17
+	DataDefinition_SHOW_INDEX                DataDefinition_DdlType = 18
+	DataDefinition_SHOW_VARIABLES            DataDefinition_DdlType = 19
+	DataDefinition_SHOW_WARNINGS             DataDefinition_DdlType = 20
+	DataDefinition_SHOW_ERRORS               DataDefinition_DdlType = 21
+	DataDefinition_SHOW_STATUS               DataDefinition_DdlType = 22
+	DataDefinition_SHOW_PROCESSLIST          DataDefinition_DdlType = 23
+	DataDefinition_SHOW_TABLE_STATUS         DataDefinition_DdlType = 24
+	DataDefinition_SHOW_TARGET               DataDefinition_DdlType = 25
+	DataDefinition_SHOW_COLLATION            DataDefinition_DdlType = 26
+	DataDefinition_LOCK_TABLES               DataDefinition_DdlType = 27
+	DataDefinition_UNLOCK_TABLES             DataDefinition_DdlType = 28
+	DataDefinition_CREATE_SEQUENCE           DataDefinition_DdlType = 29
+	DataDefinition_ALTER_SEQUENCE            DataDefinition_DdlType = 30
+	DataDefinition_DROP_SEQUENCE             DataDefinition_DdlType = 31
+	DataDefinition_SHOW_SEQUENCES            DataDefinition_DdlType = 32
+	DataDefinition_SHOW_CONNECTORS           DataDefinition_DdlType = 33
+	DataDefinition_SHOW_UPGRADE              DataDefinition_DdlType = 34
+	DataDefinition_REFRESH_MATERIALIZED_VIEW DataDefinition_DdlType = 35
 )
 
 var DataDefinition_DdlType_name = map[int32]string{
@@ -967,44 +968,46 @@ var DataDefinition_DdlType_name = map[int32]string{
 	32: "SHOW_SEQUENCES",
 	33: "SHOW_CONNECTORS",
 	34: "SHOW_UPGRADE",
+	35: "REFRESH_MATERIALIZED_VIEW",
 }
 
 var DataDefinition_DdlType_value = map[string]int32{
-	"CREATE_DATABASE":     0,
-	"ALTER_DATABASE":      1,
-	"DROP_DATABASE":       2,
-	"CREATE_TABLE":        3,
-	"ALTER_TABLE":         4,
-	"DROP_TABLE":          5,
-	"CREATE_INDEX":        6,
-	"ALTER_INDEX":         7,
-	"DROP_INDEX":          8,
-	"TRUNCATE_TABLE":      9,
-	"CREATE_VIEW":         10,
-	"ALTER_VIEW":          11,
-	"DROP_VIEW":           12,
-	"SHOW_CREATEDATABASE": 13,
-	"SHOW_CREATETABLE":    14,
-	"SHOW_DATABASES":      15,
-	"SHOW_TABLES":         16,
-	"SHOW_COLUMNS":        17,
-	"SHOW_INDEX":          18,
-	"SHOW_VARIABLES":      19,
-	"SHOW_WARNINGS":       20,
-	"SHOW_ERRORS":         21,
-	"SHOW_STATUS":         22,
-	"SHOW_PROCESSLIST":    23,
-	"SHOW_TABLE_STATUS":   24,
-	"SHOW_TARGET":         25,
-	"SHOW_COLLATION":      26,
-	"LOCK_TABLES":         27,
-	"UNLOCK_TABLES":       28,
-	"CREATE_SEQUENCE":     29,
-	"ALTER_SEQUENCE":      30,
-	"DROP_SEQUENCE":       31,
-	"SHOW_SEQUENCES":      32,
-	"SHOW_CONNECTORS":     33,
-	"SHOW_UPGRADE":        34,
+	"CREATE_DATABASE":           0,
+	"ALTER_DATABASE":            1,
+	"DROP_DATABASE":             2,
+	"CREATE_TABLE":              3,
+	"ALTER_TABLE":               4,
+	"DROP_TABLE":                5,
+	"CREATE_INDEX":              6,
+	"ALTER_INDEX":               7,
+	"DROP_INDEX":                8,
+	"TRUNCATE_TABLE":            9,
+	"CREATE_VIEW":               10,
+	"ALTER_VIEW":                11,
+	"DROP_VIEW":                 12,
+	"SHOW_CREATEDATABASE":       13,
+	"SHOW_CREATETABLE":          14,
+	"SHOW_DATABASES":            15,
+	"SHOW_TABLES":               16,
+	"SHOW_COLUMNS":              17,
+	"SHOW_INDEX":                18,
+	"SHOW_VARIABLES":            19,
+	"SHOW_WARNINGS":             20,
+	"SHOW_ERRORS":               21,
+	"SHOW_STATUS":               22,
+	"SHOW_PROCESSLIST":          23,
+	"SHOW_TABLE_STATUS":         24,
+	"SHOW_TARGET":               25,
+	"SHOW_COLLATION":            26,
+	"LOCK_TABLES":               27,
+	"UNLOCK_TABLES":             28,
+	"CREATE_SEQUENCE":           29,
+	"ALTER_SEQUENCE":            30,
+	"DROP_SEQUENCE":             31,
+	"SHOW_SEQUENCES":            32,
+	"SHOW_CONNECTORS":           33,
+	"SHOW_UPGRADE":              34,
+	"REFRESH_MATERIALIZED_VIEW": 35,
 }
 
 func (x DataDefinition_DdlType) String() string {
@@ -1012,7 +1015,36 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{77, 0}
+}
+
+type RefreshMaterializedView_Mode int32
+
+const (
+	// use the refresh mode the view is created with
+	RefreshMaterializedView_DEFAULT     RefreshMaterializedView_Mode = 0
+	RefreshMaterializedView_COMPLETE    RefreshMaterializedView_Mode = 1
+	RefreshMaterializedView_INCREMENTAL RefreshMaterializedView_Mode = 2
+)
+
+var RefreshMaterializedView_Mode_name = map[int32]string{
+	0: "DEFAULT",
+	1: "COMPLETE",
+	2: "INCREMENTAL",
+}
+
+var RefreshMaterializedView_Mode_value = map[string]int32{
+	"DEFAULT":     0,
+	"COMPLETE":    1,
+	"INCREMENTAL": 2,
+}
+
+func (x RefreshMaterializedView_Mode) String() string {
+	return proto.EnumName(RefreshMaterializedView_Mode_name, int32(x))
+}
+
+func (RefreshMaterializedView_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{86, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -1046,7 +1078,7 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{87, 0}
 }
 
 type AlterTable_AlgorithmType int32
@@ -1077,7 +1109,7 @@ func (x AlterTable_AlgorithmType) String() string {
 }
 
 func (AlterTable_AlgorithmType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{104, 0}
 }
 
 type MetadataScanInfo_MetadataScanInfoType int32
@@ -1135,7 +1167,7 @@ func (x MetadataScanInfo_MetadataScanInfoType) String() string {
 }
 
 func (MetadataScanInfo_MetadataScanInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{117, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{126, 0}
 }
 
 type Type struct {
@@ -3898,19 +3930,23 @@ func (m *PropertiesDef) GetProperties() []*Property {
 }
 
 type PartitionByDef struct {
-	Type                 PartitionType     `protobuf:"varint,1,opt,name=type,proto3,enum=plan.PartitionType" json:"type,omitempty"`
-	PartitionExpr        *PartitionExpr    `protobuf:"bytes,2,opt,name=partition_expr,json=partitionExpr,proto3" json:"partition_expr,omitempty"`
-	PartitionExpression  *Expr             `protobuf:"bytes,3,opt,name=partition_expression,json=partitionExpression,proto3" json:"partition_expression,omitempty"`
-	PartitionColumns     *PartitionColumns `protobuf:"bytes,4,opt,name=partition_columns,json=partitionColumns,proto3" json:"partition_columns,omitempty"`
-	PartitionNum         uint64            `protobuf:"varint,5,opt,name=partitionNum,proto3" json:"partitionNum,omitempty"`
-	Partitions           []*PartitionItem  `protobuf:"bytes,6,rep,name=partitions,proto3" json:"partitions,omitempty"`
-	Algorithm            int64             `protobuf:"varint,7,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	IsSubPartition       bool              `protobuf:"varint,8,opt,name=is_sub_partition,json=isSubPartition,proto3" json:"is_sub_partition,omitempty"`
-	PartitionMsg         string            `protobuf:"bytes,9,opt,name=partition_msg,json=partitionMsg,proto3" json:"partition_msg,omitempty"`
-	PartitionTableNames  []string          `protobuf:"bytes,10,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Type                PartitionType     `protobuf:"varint,1,opt,name=type,proto3,enum=plan.PartitionType" json:"type,omitempty"`
+	PartitionExpr       *PartitionExpr    `protobuf:"bytes,2,opt,name=partition_expr,json=partitionExpr,proto3" json:"partition_expr,omitempty"`
+	PartitionExpression *Expr             `protobuf:"bytes,3,opt,name=partition_expression,json=partitionExpression,proto3" json:"partition_expression,omitempty"`
+	PartitionColumns    *PartitionColumns `protobuf:"bytes,4,opt,name=partition_columns,json=partitionColumns,proto3" json:"partition_columns,omitempty"`
+	PartitionNum        uint64            `protobuf:"varint,5,opt,name=partitionNum,proto3" json:"partitionNum,omitempty"`
+	Partitions          []*PartitionItem  `protobuf:"bytes,6,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Algorithm           int64             `protobuf:"varint,7,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	IsSubPartition      bool              `protobuf:"varint,8,opt,name=is_sub_partition,json=isSubPartition,proto3" json:"is_sub_partition,omitempty"`
+	PartitionMsg        string            `protobuf:"bytes,9,opt,name=partition_msg,json=partitionMsg,proto3" json:"partition_msg,omitempty"`
+	PartitionTableNames []string          `protobuf:"bytes,10,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	// the sub partitions of the RANGE/LIST partitioned table, the rows are stored in
+	// the tables of the sub partitions and the partition expression gives the
+	// position of the sub partition in partition_table_names
+	SubPartition         *SubPartitionByDef `protobuf:"bytes,11,opt,name=sub_partition,json=subPartition,proto3" json:"sub_partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
@@ -4016,6 +4052,121 @@ func (m *PartitionByDef) GetPartitionTableNames() []string {
 	return nil
 }
 
+func (m *PartitionByDef) GetSubPartition() *SubPartitionByDef {
+	if m != nil {
+		return m.SubPartition
+	}
+	return nil
+}
+
+type SubPartitionByDef struct {
+	Type          PartitionType  `protobuf:"varint,1,opt,name=type,proto3,enum=plan.PartitionType" json:"type,omitempty"`
+	PartitionExpr *PartitionExpr `protobuf:"bytes,2,opt,name=partition_expr,json=partitionExpr,proto3" json:"partition_expr,omitempty"`
+	// the number of the sub partition of a row in its partition
+	PartitionExpression *Expr             `protobuf:"bytes,3,opt,name=partition_expression,json=partitionExpression,proto3" json:"partition_expression,omitempty"`
+	PartitionColumns    *PartitionColumns `protobuf:"bytes,4,opt,name=partition_columns,json=partitionColumns,proto3" json:"partition_columns,omitempty"`
+	// the number of the sub partitions of every partition
+	PartitionNum uint64 `protobuf:"varint,5,opt,name=partitionNum,proto3" json:"partitionNum,omitempty"`
+	Algorithm    int64  `protobuf:"varint,6,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// the sub partitions of all partitions, the sub partitions of the i-th
+	// partition are [i * partitionNum, (i + 1) * partitionNum)
+	Partitions []*PartitionItem `protobuf:"bytes,7,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// the number of the partition of a row by the partition by clause
+	ParentPartitionExpression *Expr    `protobuf:"bytes,8,opt,name=parent_partition_expression,json=parentPartitionExpression,proto3" json:"parent_partition_expression,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *SubPartitionByDef) Reset()         { *m = SubPartitionByDef{} }
+func (m *SubPartitionByDef) String() string { return proto.CompactTextString(m) }
+func (*SubPartitionByDef) ProtoMessage()    {}
+func (*SubPartitionByDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
+}
+func (m *SubPartitionByDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubPartitionByDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubPartitionByDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubPartitionByDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubPartitionByDef.Merge(m, src)
+}
+func (m *SubPartitionByDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SubPartitionByDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubPartitionByDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubPartitionByDef proto.InternalMessageInfo
+
+func (m *SubPartitionByDef) GetType() PartitionType {
+	if m != nil {
+		return m.Type
+	}
+	return PartitionType_KEY
+}
+
+func (m *SubPartitionByDef) GetPartitionExpr() *PartitionExpr {
+	if m != nil {
+		return m.PartitionExpr
+	}
+	return nil
+}
+
+func (m *SubPartitionByDef) GetPartitionExpression() *Expr {
+	if m != nil {
+		return m.PartitionExpression
+	}
+	return nil
+}
+
+func (m *SubPartitionByDef) GetPartitionColumns() *PartitionColumns {
+	if m != nil {
+		return m.PartitionColumns
+	}
+	return nil
+}
+
+func (m *SubPartitionByDef) GetPartitionNum() uint64 {
+	if m != nil {
+		return m.PartitionNum
+	}
+	return 0
+}
+
+func (m *SubPartitionByDef) GetAlgorithm() int64 {
+	if m != nil {
+		return m.Algorithm
+	}
+	return 0
+}
+
+func (m *SubPartitionByDef) GetPartitions() []*PartitionItem {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *SubPartitionByDef) GetParentPartitionExpression() *Expr {
+	if m != nil {
+		return m.ParentPartitionExpression
+	}
+	return nil
+}
+
 type PartitionExpr struct {
 	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	ExprStr              string   `protobuf:"bytes,2,opt,name=expr_str,json=exprStr,proto3" json:"expr_str,omitempty"`
@@ -4028,7 +4179,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4083,7 +4234,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4143,7 +4294,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4232,7 +4383,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4304,7 +4455,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4523,7 +4674,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{39, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4597,7 +4748,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4666,7 +4817,7 @@ func (m *HashMapStats) Reset()         { *m = HashMapStats{} }
 func (m *HashMapStats) String() string { return proto.CompactTextString(m) }
 func (*HashMapStats) ProtoMessage()    {}
 func (*HashMapStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *HashMapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4797,7 +4948,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4895,7 +5046,7 @@ func (m *RowsetExpr) Reset()         { *m = RowsetExpr{} }
 func (m *RowsetExpr) String() string { return proto.CompactTextString(m) }
 func (*RowsetExpr) ProtoMessage()    {}
 func (*RowsetExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *RowsetExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4956,7 +5107,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5004,7 +5155,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5060,7 +5211,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5125,7 +5276,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5202,7 +5353,7 @@ func (m *SampleFuncSpec) Reset()         { *m = SampleFuncSpec{} }
 func (m *SampleFuncSpec) String() string { return proto.CompactTextString(m) }
 func (*SampleFuncSpec) ProtoMessage()    {}
 func (*SampleFuncSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *SampleFuncSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5265,7 +5416,7 @@ func (m *FrameClause) Reset()         { *m = FrameClause{} }
 func (m *FrameClause) String() string { return proto.CompactTextString(m) }
 func (*FrameClause) ProtoMessage()    {}
 func (*FrameClause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *FrameClause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5328,7 +5479,7 @@ func (m *FrameBound) Reset()         { *m = FrameBound{} }
 func (m *FrameBound) String() string { return proto.CompactTextString(m) }
 func (*FrameBound) ProtoMessage()    {}
 func (*FrameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *FrameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5398,7 +5549,7 @@ func (m *OnDuplicateKeyCtx) Reset()         { *m = OnDuplicateKeyCtx{} }
 func (m *OnDuplicateKeyCtx) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKeyCtx) ProtoMessage()    {}
 func (*OnDuplicateKeyCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *OnDuplicateKeyCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5515,7 +5666,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5613,7 +5764,7 @@ func (m *ReplaceCtx) Reset()         { *m = ReplaceCtx{} }
 func (m *ReplaceCtx) String() string { return proto.CompactTextString(m) }
 func (*ReplaceCtx) ProtoMessage()    {}
 func (*ReplaceCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *ReplaceCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5732,7 +5883,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5892,7 +6043,7 @@ func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
 func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
 func (*PartitionPrune) ProtoMessage()    {}
 func (*PartitionPrune) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5947,7 +6098,7 @@ func (m *OriginTableMessageForFuzzy) Reset()         { *m = OriginTableMessageFo
 func (m *OriginTableMessageForFuzzy) String() string { return proto.CompactTextString(m) }
 func (*OriginTableMessageForFuzzy) ProtoMessage()    {}
 func (*OriginTableMessageForFuzzy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *OriginTableMessageForFuzzy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6067,7 +6218,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6496,7 +6647,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6552,7 +6703,7 @@ func (m *SnapshotTenant) Reset()         { *m = SnapshotTenant{} }
 func (m *SnapshotTenant) String() string { return proto.CompactTextString(m) }
 func (*SnapshotTenant) ProtoMessage()    {}
 func (*SnapshotTenant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *SnapshotTenant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6613,7 +6764,7 @@ func (m *ExternScan) Reset()         { *m = ExternScan{} }
 func (m *ExternScan) String() string { return proto.CompactTextString(m) }
 func (*ExternScan) ProtoMessage()    {}
 func (*ExternScan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *ExternScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6718,7 +6869,7 @@ func (m *LockTarget) Reset()         { *m = LockTarget{} }
 func (m *LockTarget) String() string { return proto.CompactTextString(m) }
 func (*LockTarget) ProtoMessage()    {}
 func (*LockTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *LockTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6832,7 +6983,7 @@ func (m *PreInsertUkCtx) Reset()         { *m = PreInsertUkCtx{} }
 func (m *PreInsertUkCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUkCtx) ProtoMessage()    {}
 func (*PreInsertUkCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *PreInsertUkCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6901,7 +7052,7 @@ func (m *PreDeleteCtx) Reset()         { *m = PreDeleteCtx{} }
 func (m *PreDeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*PreDeleteCtx) ProtoMessage()    {}
 func (*PreDeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *PreDeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6951,7 +7102,7 @@ func (m *PreInsertCtx) Reset()         { *m = PreInsertCtx{} }
 func (m *PreInsertCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertCtx) ProtoMessage()    {}
 func (*PreInsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *PreInsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7023,7 +7174,7 @@ func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
 func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFilterSpec) ProtoMessage()    {}
 func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7098,7 +7249,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7145,7 +7296,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7203,7 +7354,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7324,7 +7475,10 @@ type Query struct {
 	// load Tag
 	LoadTag bool `protobuf:"varint,6,opt,name=loadTag,proto3" json:"loadTag,omitempty"`
 	// detectSqls are sqls detect fk self refer constraint
-	DetectSqls           []string `protobuf:"bytes,7,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
+	DetectSqls []string `protobuf:"bytes,7,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
+	// returning is true if the last step of the insert/update/delete statement
+	// projects the RETURNING clause from the rows of its insert or delete node
+	Returning            bool     `protobuf:"varint,8,opt,name=returning,proto3" json:"returning,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7334,7 +7488,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7412,6 +7566,13 @@ func (m *Query) GetDetectSqls() []string {
 	return nil
 }
 
+func (m *Query) GetReturning() bool {
+	if m != nil {
+		return m.Returning
+	}
+	return false
+}
+
 type TransationControl struct {
 	// TransationControl type
 	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
@@ -7430,7 +7591,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7534,7 +7695,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7581,7 +7742,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7628,7 +7789,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7683,7 +7844,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7806,7 +7967,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7862,7 +8023,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8006,6 +8167,7 @@ type DataDefinition struct {
 	//	*DataDefinition_DropSequence
 	//	*DataDefinition_AlterSequence
 	//	*DataDefinition_CreateView
+	//	*DataDefinition_RefreshMaterializedView
 	Definition           isDataDefinition_Definition `protobuf_oneof:"definition"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
@@ -8016,7 +8178,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8105,25 +8267,29 @@ type DataDefinition_AlterSequence struct {
 type DataDefinition_CreateView struct {
 	CreateView *CreateView `protobuf:"bytes,20,opt,name=create_view,json=createView,proto3,oneof" json:"create_view,omitempty"`
 }
-
-func (*DataDefinition_CreateDatabase) isDataDefinition_Definition() {}
-func (*DataDefinition_AlterDatabase) isDataDefinition_Definition()  {}
-func (*DataDefinition_DropDatabase) isDataDefinition_Definition()   {}
-func (*DataDefinition_CreateTable) isDataDefinition_Definition()    {}
-func (*DataDefinition_AlterTable) isDataDefinition_Definition()     {}
-func (*DataDefinition_DropTable) isDataDefinition_Definition()      {}
-func (*DataDefinition_CreateIndex) isDataDefinition_Definition()    {}
-func (*DataDefinition_AlterIndex) isDataDefinition_Definition()     {}
-func (*DataDefinition_DropIndex) isDataDefinition_Definition()      {}
-func (*DataDefinition_TruncateTable) isDataDefinition_Definition()  {}
-func (*DataDefinition_ShowVariables) isDataDefinition_Definition()  {}
-func (*DataDefinition_AlterView) isDataDefinition_Definition()      {}
-func (*DataDefinition_LockTables) isDataDefinition_Definition()     {}
-func (*DataDefinition_UnlockTables) isDataDefinition_Definition()   {}
-func (*DataDefinition_CreateSequence) isDataDefinition_Definition() {}
-func (*DataDefinition_DropSequence) isDataDefinition_Definition()   {}
-func (*DataDefinition_AlterSequence) isDataDefinition_Definition()  {}
-func (*DataDefinition_CreateView) isDataDefinition_Definition()     {}
+type DataDefinition_RefreshMaterializedView struct {
+	RefreshMaterializedView *RefreshMaterializedView `protobuf:"bytes,21,opt,name=refresh_materialized_view,json=refreshMaterializedView,proto3,oneof" json:"refresh_materialized_view,omitempty"`
+}
+
+func (*DataDefinition_CreateDatabase) isDataDefinition_Definition()          {}
+func (*DataDefinition_AlterDatabase) isDataDefinition_Definition()           {}
+func (*DataDefinition_DropDatabase) isDataDefinition_Definition()            {}
+func (*DataDefinition_CreateTable) isDataDefinition_Definition()             {}
+func (*DataDefinition_AlterTable) isDataDefinition_Definition()              {}
+func (*DataDefinition_DropTable) isDataDefinition_Definition()               {}
+func (*DataDefinition_CreateIndex) isDataDefinition_Definition()             {}
+func (*DataDefinition_AlterIndex) isDataDefinition_Definition()              {}
+func (*DataDefinition_DropIndex) isDataDefinition_Definition()               {}
+func (*DataDefinition_TruncateTable) isDataDefinition_Definition()           {}
+func (*DataDefinition_ShowVariables) isDataDefinition_Definition()           {}
+func (*DataDefinition_AlterView) isDataDefinition_Definition()               {}
+func (*DataDefinition_LockTables) isDataDefinition_Definition()              {}
+func (*DataDefinition_UnlockTables) isDataDefinition_Definition()            {}
+func (*DataDefinition_CreateSequence) isDataDefinition_Definition()          {}
+func (*DataDefinition_DropSequence) isDataDefinition_Definition()            {}
+func (*DataDefinition_AlterSequence) isDataDefinition_Definition()           {}
+func (*DataDefinition_CreateView) isDataDefinition_Definition()              {}
+func (*DataDefinition_RefreshMaterializedView) isDataDefinition_Definition() {}
 
 func (m *DataDefinition) GetDefinition() isDataDefinition_Definition {
 	if m != nil {
@@ -8272,6 +8438,13 @@ func (m *DataDefinition) GetCreateView() *CreateView {
 	return nil
 }
 
+func (m *DataDefinition) GetRefreshMaterializedView() *RefreshMaterializedView {
+	if x, ok := m.GetDefinition().(*DataDefinition_RefreshMaterializedView); ok {
+		return x.RefreshMaterializedView
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DataDefinition) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -8293,6 +8466,7 @@ func (*DataDefinition) XXX_OneofWrappers() []interface{} {
 		(*DataDefinition_DropSequence)(nil),
 		(*DataDefinition_AlterSequence)(nil),
 		(*DataDefinition_CreateView)(nil),
+		(*DataDefinition_RefreshMaterializedView)(nil),
 	}
 }
 
@@ -8308,7 +8482,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8365,7 +8539,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8434,7 +8608,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8496,7 +8670,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8571,7 +8745,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8637,7 +8811,7 @@ func (m *ForeignKeyInfo) Reset()         { *m = ForeignKeyInfo{} }
 func (m *ForeignKeyInfo) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyInfo) ProtoMessage()    {}
 func (*ForeignKeyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *ForeignKeyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8719,17 +8893,21 @@ type CreateTable struct {
 	// into mo_foreign_keys
 	UpdateFkSqls []string `protobuf:"bytes,12,rep,name=updateFkSqls,proto3" json:"updateFkSqls,omitempty"`
 	// fks forward reference to me
-	FksReferToMe         []*ForeignKeyInfo `protobuf:"bytes,13,rep,name=fksReferToMe,proto3" json:"fksReferToMe,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	FksReferToMe []*ForeignKeyInfo `protobuf:"bytes,13,rep,name=fksReferToMe,proto3" json:"fksReferToMe,omitempty"`
+	// set when the table is created by create materialized view
+	MaterializedView *MaterializedView `protobuf:"bytes,14,opt,name=materialized_view,json=materializedView,proto3" json:"materialized_view,omitempty"`
+	// set by STATS_AUTO_RECALC = 0, the table is not analyzed automatically
+	DisableAutoAnalyze   bool     `protobuf:"varint,15,opt,name=disable_auto_analyze,json=disableAutoAnalyze,proto3" json:"disable_auto_analyze,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8849,6 +9027,166 @@ func (m *CreateTable) GetFksReferToMe() []*ForeignKeyInfo {
 	return nil
 }
 
+func (m *CreateTable) GetMaterializedView() *MaterializedView {
+	if m != nil {
+		return m.MaterializedView
+	}
+	return nil
+}
+
+func (m *CreateTable) GetDisableAutoAnalyze() bool {
+	if m != nil {
+		return m.DisableAutoAnalyze
+	}
+	return false
+}
+
+type MaterializedView struct {
+	// the defining query, its result is kept in the table by refresh
+	Query       string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Incremental bool   `protobuf:"varint,2,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	// cron expression of the scheduled refresh, empty for manual refresh only
+	RefreshCron string `protobuf:"bytes,3,opt,name=refresh_cron,json=refreshCron,proto3" json:"refresh_cron,omitempty"`
+	// the database unqualified names in the query are resolved in
+	QueryDatabase        string   `protobuf:"bytes,4,opt,name=query_database,json=queryDatabase,proto3" json:"query_database,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaterializedView) Reset()         { *m = MaterializedView{} }
+func (m *MaterializedView) String() string { return proto.CompactTextString(m) }
+func (*MaterializedView) ProtoMessage()    {}
+func (*MaterializedView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
+}
+func (m *MaterializedView) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaterializedView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaterializedView.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaterializedView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializedView.Merge(m, src)
+}
+func (m *MaterializedView) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MaterializedView) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializedView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaterializedView proto.InternalMessageInfo
+
+func (m *MaterializedView) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *MaterializedView) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+func (m *MaterializedView) GetRefreshCron() string {
+	if m != nil {
+		return m.RefreshCron
+	}
+	return ""
+}
+
+func (m *MaterializedView) GetQueryDatabase() string {
+	if m != nil {
+		return m.QueryDatabase
+	}
+	return ""
+}
+
+type RefreshMaterializedView struct {
+	Database string                       `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Table    string                       `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	Mode     RefreshMaterializedView_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=plan.RefreshMaterializedView_Mode" json:"mode,omitempty"`
+	// column names of the materialized view, in the order of the query's select list
+	Cols                 []string `protobuf:"bytes,4,rep,name=cols,proto3" json:"cols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshMaterializedView) Reset()         { *m = RefreshMaterializedView{} }
+func (m *RefreshMaterializedView) String() string { return proto.CompactTextString(m) }
+func (*RefreshMaterializedView) ProtoMessage()    {}
+func (*RefreshMaterializedView) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
+}
+func (m *RefreshMaterializedView) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshMaterializedView) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshMaterializedView.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshMaterializedView) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshMaterializedView.Merge(m, src)
+}
+func (m *RefreshMaterializedView) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RefreshMaterializedView) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshMaterializedView.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshMaterializedView proto.InternalMessageInfo
+
+func (m *RefreshMaterializedView) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *RefreshMaterializedView) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *RefreshMaterializedView) GetMode() RefreshMaterializedView_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return RefreshMaterializedView_DEFAULT
+}
+
+func (m *RefreshMaterializedView) GetCols() []string {
+	if m != nil {
+		return m.Cols
+	}
+	return nil
+}
+
 type AlterTableDrop struct {
 	Typ                  AlterTableDrop_Typ `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.AlterTableDrop_Typ" json:"typ,omitempty"`
 	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -8862,7 +9200,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8926,7 +9264,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8998,7 +9336,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9076,7 +9414,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9147,7 +9485,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9218,7 +9556,7 @@ func (m *AlterTableAlterReIndex) Reset()         { *m = AlterTableAlterReIndex{}
 func (m *AlterTableAlterReIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterReIndex) ProtoMessage()    {}
 func (*AlterTableAlterReIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{89}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *AlterTableAlterReIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9288,7 +9626,7 @@ func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{}
 func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddPartition) ProtoMessage()    {}
 func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9338,6 +9676,260 @@ func (m *AlterTableAddPartition) GetPartitionDef() *PartitionByDef {
 	return nil
 }
 
+type AlterTableDropPartition struct {
+	// the partition sub tables to drop
+	PartitionTableNames []string `protobuf:"bytes,1,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	// the partition definition without the dropped partitions
+	PartitionDef         *PartitionByDef `protobuf:"bytes,2,opt,name=PartitionDef,proto3" json:"PartitionDef,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AlterTableDropPartition) Reset()         { *m = AlterTableDropPartition{} }
+func (m *AlterTableDropPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableDropPartition) ProtoMessage()    {}
+func (*AlterTableDropPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
+}
+func (m *AlterTableDropPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableDropPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableDropPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableDropPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableDropPartition.Merge(m, src)
+}
+func (m *AlterTableDropPartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableDropPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableDropPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableDropPartition proto.InternalMessageInfo
+
+func (m *AlterTableDropPartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+func (m *AlterTableDropPartition) GetPartitionDef() *PartitionByDef {
+	if m != nil {
+		return m.PartitionDef
+	}
+	return nil
+}
+
+type AlterTableTruncatePartition struct {
+	PartitionTableNames  []string `protobuf:"bytes,1,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableTruncatePartition) Reset()         { *m = AlterTableTruncatePartition{} }
+func (m *AlterTableTruncatePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableTruncatePartition) ProtoMessage()    {}
+func (*AlterTableTruncatePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
+}
+func (m *AlterTableTruncatePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableTruncatePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableTruncatePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableTruncatePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableTruncatePartition.Merge(m, src)
+}
+func (m *AlterTableTruncatePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableTruncatePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableTruncatePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableTruncatePartition proto.InternalMessageInfo
+
+func (m *AlterTableTruncatePartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+type AlterTableExchangePartition struct {
+	PartitionTableName string `protobuf:"bytes,1,opt,name=partition_table_name,json=partitionTableName,proto3" json:"partition_table_name,omitempty"`
+	TableName          string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// counts the rows of the table that do not belong to the partition,
+	// it is empty for WITHOUT VALIDATION
+	ValidateSql string `protobuf:"bytes,4,opt,name=validate_sql,json=validateSql,proto3" json:"validate_sql,omitempty"`
+	// the create sql of the table, it is kept by the table swapped in
+	CreateSql            string   `protobuf:"bytes,6,opt,name=create_sql,json=createSql,proto3" json:"create_sql,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableExchangePartition) Reset()         { *m = AlterTableExchangePartition{} }
+func (m *AlterTableExchangePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableExchangePartition) ProtoMessage()    {}
+func (*AlterTableExchangePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
+}
+func (m *AlterTableExchangePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableExchangePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableExchangePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableExchangePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableExchangePartition.Merge(m, src)
+}
+func (m *AlterTableExchangePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableExchangePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableExchangePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableExchangePartition proto.InternalMessageInfo
+
+func (m *AlterTableExchangePartition) GetPartitionTableName() string {
+	if m != nil {
+		return m.PartitionTableName
+	}
+	return ""
+}
+
+func (m *AlterTableExchangePartition) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *AlterTableExchangePartition) GetValidateSql() string {
+	if m != nil {
+		return m.ValidateSql
+	}
+	return ""
+}
+
+func (m *AlterTableExchangePartition) GetCreateSql() string {
+	if m != nil {
+		return m.CreateSql
+	}
+	return ""
+}
+
+// AlterTableRedistributePartition changes the partitions of a HASH/KEY
+// partitioned table, the rows are placed again by the new partition number
+type AlterTableRedistributePartition struct {
+	PartitionDef *PartitionByDef `protobuf:"bytes,1,opt,name=partition_def,json=partitionDef,proto3" json:"partition_def,omitempty"`
+	// the sub tables of the new partitions
+	PartitionTables []*TableDef `protobuf:"bytes,2,rep,name=partition_tables,json=partitionTables,proto3" json:"partition_tables,omitempty"`
+	// the sub tables of the removed partitions
+	DropPartitionTableNames []string `protobuf:"bytes,3,rep,name=drop_partition_table_names,json=dropPartitionTableNames,proto3" json:"drop_partition_table_names,omitempty"`
+	// moves the rows placed in other partitions to them
+	RedistributeSqls     []string `protobuf:"bytes,5,rep,name=redistribute_sqls,json=redistributeSqls,proto3" json:"redistribute_sqls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableRedistributePartition) Reset()         { *m = AlterTableRedistributePartition{} }
+func (m *AlterTableRedistributePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRedistributePartition) ProtoMessage()    {}
+func (*AlterTableRedistributePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
+}
+func (m *AlterTableRedistributePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableRedistributePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableRedistributePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableRedistributePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRedistributePartition.Merge(m, src)
+}
+func (m *AlterTableRedistributePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableRedistributePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRedistributePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableRedistributePartition proto.InternalMessageInfo
+
+func (m *AlterTableRedistributePartition) GetPartitionDef() *PartitionByDef {
+	if m != nil {
+		return m.PartitionDef
+	}
+	return nil
+}
+
+func (m *AlterTableRedistributePartition) GetPartitionTables() []*TableDef {
+	if m != nil {
+		return m.PartitionTables
+	}
+	return nil
+}
+
+func (m *AlterTableRedistributePartition) GetDropPartitionTableNames() []string {
+	if m != nil {
+		return m.DropPartitionTableNames
+	}
+	return nil
+}
+
+func (m *AlterTableRedistributePartition) GetRedistributeSqls() []string {
+	if m != nil {
+		return m.RedistributeSqls
+	}
+	return nil
+}
+
 type AlterTableComment struct {
 	NewComment           string   `protobuf:"bytes,1,opt,name=new_comment,json=newComment,proto3" json:"new_comment,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -9349,7 +9941,7 @@ func (m *AlterTableComment) Reset()         { *m = AlterTableComment{} }
 func (m *AlterTableComment) String() string { return proto.CompactTextString(m) }
 func (*AlterTableComment) ProtoMessage()    {}
 func (*AlterTableComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{91}
+	return fileDescriptor_2d655ab2f7683c23, []int{98}
 }
 func (m *AlterTableComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9385,6 +9977,54 @@ func (m *AlterTableComment) GetNewComment() string {
 	return ""
 }
 
+type AlterTableStatsAutoRecalc struct {
+	// whether the table is analyzed automatically
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableStatsAutoRecalc) Reset()         { *m = AlterTableStatsAutoRecalc{} }
+func (m *AlterTableStatsAutoRecalc) String() string { return proto.CompactTextString(m) }
+func (*AlterTableStatsAutoRecalc) ProtoMessage()    {}
+func (*AlterTableStatsAutoRecalc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{99}
+}
+func (m *AlterTableStatsAutoRecalc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableStatsAutoRecalc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableStatsAutoRecalc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableStatsAutoRecalc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableStatsAutoRecalc.Merge(m, src)
+}
+func (m *AlterTableStatsAutoRecalc) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableStatsAutoRecalc) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableStatsAutoRecalc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableStatsAutoRecalc proto.InternalMessageInfo
+
+func (m *AlterTableStatsAutoRecalc) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 type AlterTableName struct {
 	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
 	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
@@ -9397,7 +10037,7 @@ func (m *AlterTableName) Reset()         { *m = AlterTableName{} }
 func (m *AlterTableName) String() string { return proto.CompactTextString(m) }
 func (*AlterTableName) ProtoMessage()    {}
 func (*AlterTableName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{92}
+	return fileDescriptor_2d655ab2f7683c23, []int{100}
 }
 func (m *AlterTableName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9454,7 +10094,7 @@ func (m *AlterAddColumn) Reset()         { *m = AlterAddColumn{} }
 func (m *AlterAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterAddColumn) ProtoMessage()    {}
 func (*AlterAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93}
+	return fileDescriptor_2d655ab2f7683c23, []int{101}
 }
 func (m *AlterAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9523,7 +10163,7 @@ func (m *AlterDropColumn) Reset()         { *m = AlterDropColumn{} }
 func (m *AlterDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterDropColumn) ProtoMessage()    {}
 func (*AlterDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{94}
+	return fileDescriptor_2d655ab2f7683c23, []int{102}
 }
 func (m *AlterDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9566,6 +10206,69 @@ func (m *AlterDropColumn) GetSeq() uint32 {
 	return 0
 }
 
+type AlterModifyColumn struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 Type     `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Seq                  uint32   `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterModifyColumn) Reset()         { *m = AlterModifyColumn{} }
+func (m *AlterModifyColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterModifyColumn) ProtoMessage()    {}
+func (*AlterModifyColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{103}
+}
+func (m *AlterModifyColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterModifyColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterModifyColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterModifyColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterModifyColumn.Merge(m, src)
+}
+func (m *AlterModifyColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterModifyColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterModifyColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterModifyColumn proto.InternalMessageInfo
+
+func (m *AlterModifyColumn) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AlterModifyColumn) GetType() Type {
+	if m != nil {
+		return m.Type
+	}
+	return Type{}
+}
+
+func (m *AlterModifyColumn) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
 type AlterTable struct {
 	Database          string                   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	TableDef          *TableDef                `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -9590,7 +10293,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95}
+	return fileDescriptor_2d655ab2f7683c23, []int{104}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9709,6 +10412,12 @@ type AlterTable_Action struct {
 	//	*AlterTable_Action_DropColumn
 	//	*AlterTable_Action_AlterReindex
 	//	*AlterTable_Action_AddPartition
+	//	*AlterTable_Action_DropPartition
+	//	*AlterTable_Action_TruncatePartition
+	//	*AlterTable_Action_ExchangePartition
+	//	*AlterTable_Action_RedistributePartition
+	//	*AlterTable_Action_ModifyColumn
+	//	*AlterTable_Action_StatsAutoRecalc
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -9719,7 +10428,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{104, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9784,17 +10493,41 @@ type AlterTable_Action_AlterReindex struct {
 type AlterTable_Action_AddPartition struct {
 	AddPartition *AlterTableAddPartition `protobuf:"bytes,10,opt,name=addPartition,proto3,oneof" json:"addPartition,omitempty"`
 }
+type AlterTable_Action_DropPartition struct {
+	DropPartition *AlterTableDropPartition `protobuf:"bytes,11,opt,name=dropPartition,proto3,oneof" json:"dropPartition,omitempty"`
+}
+type AlterTable_Action_TruncatePartition struct {
+	TruncatePartition *AlterTableTruncatePartition `protobuf:"bytes,12,opt,name=truncatePartition,proto3,oneof" json:"truncatePartition,omitempty"`
+}
+type AlterTable_Action_ExchangePartition struct {
+	ExchangePartition *AlterTableExchangePartition `protobuf:"bytes,13,opt,name=exchangePartition,proto3,oneof" json:"exchangePartition,omitempty"`
+}
+type AlterTable_Action_RedistributePartition struct {
+	RedistributePartition *AlterTableRedistributePartition `protobuf:"bytes,14,opt,name=redistributePartition,proto3,oneof" json:"redistributePartition,omitempty"`
+}
+type AlterTable_Action_ModifyColumn struct {
+	ModifyColumn *AlterModifyColumn `protobuf:"bytes,15,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTable_Action_StatsAutoRecalc struct {
+	StatsAutoRecalc *AlterTableStatsAutoRecalc `protobuf:"bytes,16,opt,name=stats_auto_recalc,json=statsAutoRecalc,proto3,oneof" json:"stats_auto_recalc,omitempty"`
+}
 
-func (*AlterTable_Action_Drop) isAlterTable_Action_Action()         {}
-func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()        {}
-func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()     {}
-func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterComment) isAlterTable_Action_Action() {}
-func (*AlterTable_Action_AlterName) isAlterTable_Action_Action()    {}
-func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()    {}
-func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action() {}
-func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_Drop) isAlterTable_Action_Action()                  {}
+func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()                 {}
+func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()              {}
+func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()            {}
+func (*AlterTable_Action_AlterComment) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_AlterName) isAlterTable_Action_Action()             {}
+func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()             {}
+func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()            {}
+func (*AlterTable_Action_AlterReindex) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_DropPartition) isAlterTable_Action_Action()         {}
+func (*AlterTable_Action_TruncatePartition) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_ExchangePartition) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_RedistributePartition) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_ModifyColumn) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_StatsAutoRecalc) isAlterTable_Action_Action()       {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -9873,6 +10606,48 @@ func (m *AlterTable_Action) GetAddPartition() *AlterTableAddPartition {
 	return nil
 }
 
+func (m *AlterTable_Action) GetDropPartition() *AlterTableDropPartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_DropPartition); ok {
+		return x.DropPartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetTruncatePartition() *AlterTableTruncatePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_TruncatePartition); ok {
+		return x.TruncatePartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetExchangePartition() *AlterTableExchangePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_ExchangePartition); ok {
+		return x.ExchangePartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetRedistributePartition() *AlterTableRedistributePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_RedistributePartition); ok {
+		return x.RedistributePartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetModifyColumn() *AlterModifyColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetStatsAutoRecalc() *AlterTableStatsAutoRecalc {
+	if x, ok := m.GetAction().(*AlterTable_Action_StatsAutoRecalc); ok {
+		return x.StatsAutoRecalc
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -9886,6 +10661,12 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_DropColumn)(nil),
 		(*AlterTable_Action_AlterReindex)(nil),
 		(*AlterTable_Action_AddPartition)(nil),
+		(*AlterTable_Action_DropPartition)(nil),
+		(*AlterTable_Action_TruncatePartition)(nil),
+		(*AlterTable_Action_ExchangePartition)(nil),
+		(*AlterTable_Action_RedistributePartition)(nil),
+		(*AlterTable_Action_ModifyColumn)(nil),
+		(*AlterTable_Action_StatsAutoRecalc)(nil),
 	}
 }
 
@@ -9905,6 +10686,7 @@ type DropTable struct {
 	UpdateFkSqls []string `protobuf:"bytes,11,rep,name=updateFkSqls,proto3" json:"updateFkSqls,omitempty"`
 	// fk child table id that refers to me
 	FkChildTblsReferToMe []uint64 `protobuf:"varint,12,rep,packed,name=fkChildTblsReferToMe,proto3" json:"fkChildTblsReferToMe,omitempty"`
+	IsMaterializedView   bool     `protobuf:"varint,13,opt,name=is_materialized_view,json=isMaterializedView,proto3" json:"is_materialized_view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -9914,7 +10696,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96}
+	return fileDescriptor_2d655ab2f7683c23, []int{105}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10027,6 +10809,13 @@ func (m *DropTable) GetFkChildTblsReferToMe() []uint64 {
 	return nil
 }
 
+func (m *DropTable) GetIsMaterializedView() bool {
+	if m != nil {
+		return m.IsMaterializedView
+	}
+	return false
+}
+
 type CreateView struct {
 	IfNotExists          bool      `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
 	Database             string    `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
@@ -10041,7 +10830,7 @@ func (m *CreateView) Reset()         { *m = CreateView{} }
 func (m *CreateView) String() string { return proto.CompactTextString(m) }
 func (*CreateView) ProtoMessage()    {}
 func (*CreateView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{97}
+	return fileDescriptor_2d655ab2f7683c23, []int{106}
 }
 func (m *CreateView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10111,7 +10900,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{98}
+	return fileDescriptor_2d655ab2f7683c23, []int{107}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10174,7 +10963,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{99}
+	return fileDescriptor_2d655ab2f7683c23, []int{108}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10238,7 +11027,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100}
+	return fileDescriptor_2d655ab2f7683c23, []int{109}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10308,7 +11097,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{101}
+	return fileDescriptor_2d655ab2f7683c23, []int{110}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10374,7 +11163,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102}
+	return fileDescriptor_2d655ab2f7683c23, []int{111}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10456,7 +11245,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{103}
+	return fileDescriptor_2d655ab2f7683c23, []int{112}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10506,7 +11295,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{104}
+	return fileDescriptor_2d655ab2f7683c23, []int{113}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10581,7 +11370,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{105}
+	return fileDescriptor_2d655ab2f7683c23, []int{114}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10679,7 +11468,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{106}
+	return fileDescriptor_2d655ab2f7683c23, []int{115}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10741,7 +11530,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{107}
+	return fileDescriptor_2d655ab2f7683c23, []int{116}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10795,7 +11584,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{108}
+	return fileDescriptor_2d655ab2f7683c23, []int{117}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10846,7 +11635,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{109}
+	return fileDescriptor_2d655ab2f7683c23, []int{118}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10924,7 +11713,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{110}
+	return fileDescriptor_2d655ab2f7683c23, []int{119}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10993,7 +11782,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{111}
+	return fileDescriptor_2d655ab2f7683c23, []int{120}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11047,7 +11836,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{112}
+	return fileDescriptor_2d655ab2f7683c23, []int{121}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11094,7 +11883,7 @@ func (m *OtherDCL) Reset()         { *m = OtherDCL{} }
 func (m *OtherDCL) String() string { return proto.CompactTextString(m) }
 func (*OtherDCL) ProtoMessage()    {}
 func (*OtherDCL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{113}
+	return fileDescriptor_2d655ab2f7683c23, []int{122}
 }
 func (m *OtherDCL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11142,7 +11931,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{114}
+	return fileDescriptor_2d655ab2f7683c23, []int{123}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11196,7 +11985,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{115}
+	return fileDescriptor_2d655ab2f7683c23, []int{124}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11242,7 +12031,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{116}
+	return fileDescriptor_2d655ab2f7683c23, []int{125}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11292,7 +12081,7 @@ func (m *MetadataScanInfo) Reset()         { *m = MetadataScanInfo{} }
 func (m *MetadataScanInfo) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfo) ProtoMessage()    {}
 func (*MetadataScanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{117}
+	return fileDescriptor_2d655ab2f7683c23, []int{126}
 }
 func (m *MetadataScanInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11421,6 +12210,7 @@ func init() {
 	proto.RegisterEnum("plan.TransationBegin_TransationMode", TransationBegin_TransationMode_name, TransationBegin_TransationMode_value)
 	proto.RegisterEnum("plan.DataControl_DclType", DataControl_DclType_name, DataControl_DclType_value)
 	proto.RegisterEnum("plan.DataDefinition_DdlType", DataDefinition_DdlType_name, DataDefinition_DdlType_value)
+	proto.RegisterEnum("plan.RefreshMaterializedView_Mode", RefreshMaterializedView_Mode_name, RefreshMaterializedView_Mode_value)
 	proto.RegisterEnum("plan.AlterTableDrop_Typ", AlterTableDrop_Typ_name, AlterTableDrop_Typ_value)
 	proto.RegisterEnum("plan.AlterTable_AlgorithmType", AlterTable_AlgorithmType_name, AlterTable_AlgorithmType_value)
 	proto.RegisterEnum("plan.MetadataScanInfo_MetadataScanInfoType", MetadataScanInfo_MetadataScanInfoType_name, MetadataScanInfo_MetadataScanInfoType_value)
@@ -11458,6 +12248,7 @@ func init() {
 	proto.RegisterType((*Property)(nil), "plan.Property")
 	proto.RegisterType((*PropertiesDef)(nil), "plan.PropertiesDef")
 	proto.RegisterType((*PartitionByDef)(nil), "plan.PartitionByDef")
+	proto.RegisterType((*SubPartitionByDef)(nil), "plan.SubPartitionByDef")
 	proto.RegisterType((*PartitionExpr)(nil), "plan.PartitionExpr")
 	proto.RegisterType((*PartitionColumns)(nil), "plan.PartitionColumns")
 	proto.RegisterType((*PartitionItem)(nil), "plan.PartitionItem")
@@ -11512,6 +12303,8 @@ func init() {
 	proto.RegisterType((*FkColName)(nil), "plan.FkColName")
 	proto.RegisterType((*ForeignKeyInfo)(nil), "plan.ForeignKeyInfo")
 	proto.RegisterType((*CreateTable)(nil), "plan.CreateTable")
+	proto.RegisterType((*MaterializedView)(nil), "plan.MaterializedView")
+	proto.RegisterType((*RefreshMaterializedView)(nil), "plan.RefreshMaterializedView")
 	proto.RegisterType((*AlterTableDrop)(nil), "plan.AlterTableDrop")
 	proto.RegisterType((*AlterTableAddFk)(nil), "plan.AlterTableAddFk")
 	proto.RegisterType((*AlterTableAddIndex)(nil), "plan.AlterTableAddIndex")
@@ -11519,10 +12312,16 @@ func init() {
 	proto.RegisterType((*AlterTableAlterIndex)(nil), "plan.AlterTableAlterIndex")
 	proto.RegisterType((*AlterTableAlterReIndex)(nil), "plan.AlterTableAlterReIndex")
 	proto.RegisterType((*AlterTableAddPartition)(nil), "plan.AlterTableAddPartition")
+	proto.RegisterType((*AlterTableDropPartition)(nil), "plan.AlterTableDropPartition")
+	proto.RegisterType((*AlterTableTruncatePartition)(nil), "plan.AlterTableTruncatePartition")
+	proto.RegisterType((*AlterTableExchangePartition)(nil), "plan.AlterTableExchangePartition")
+	proto.RegisterType((*AlterTableRedistributePartition)(nil), "plan.AlterTableRedistributePartition")
 	proto.RegisterType((*AlterTableComment)(nil), "plan.AlterTableComment")
+	proto.RegisterType((*AlterTableStatsAutoRecalc)(nil), "plan.AlterTableStatsAutoRecalc")
 	proto.RegisterType((*AlterTableName)(nil), "plan.AlterTableName")
 	proto.RegisterType((*AlterAddColumn)(nil), "plan.AlterAddColumn")
 	proto.RegisterType((*AlterDropColumn)(nil), "plan.AlterDropColumn")
+	proto.RegisterType((*AlterModifyColumn)(nil), "plan.AlterModifyColumn")
 	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
 	proto.RegisterMapType((map[uint64]*ColDef)(nil), "plan.AlterTable.ChangeTblColIdMapEntry")
 	proto.RegisterType((*AlterTable_Action)(nil), "plan.AlterTable.Action")
@@ -11553,676 +12352,709 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 10696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5b, 0x8c, 0x1b, 0x57,
-	0x96, 0x58, 0xf3, 0x4d, 0x1e, 0xb2, 0xd9, 0xd5, 0x57, 0x2d, 0x89, 0x92, 0x65, 0xa9, 0x5d, 0x96,
-	0x6d, 0x59, 0xe3, 0x91, 0x6d, 0xc9, 0x0f, 0xd9, 0x3b, 0xb3, 0x63, 0x36, 0x9b, 0xad, 0xa6, 0xc5,
-	0x26, 0x7b, 0x8a, 0x6c, 0xc9, 0x9e, 0x45, 0x52, 0x28, 0xb2, 0x8a, 0xdd, 0xe5, 0x2e, 0x56, 0xd1,
-	0x55, 0x45, 0x75, 0xb7, 0x81, 0x05, 0x26, 0x09, 0x90, 0x45, 0x02, 0xe4, 0x2b, 0xc0, 0xfe, 0x24,
-	0x1b, 0x4c, 0x36, 0x7f, 0x8b, 0x04, 0x08, 0x90, 0x00, 0x1b, 0xe4, 0x37, 0xf9, 0xd8, 0x04, 0xc1,
-	0x22, 0x40, 0x3e, 0x16, 0x49, 0x80, 0x4d, 0x30, 0xf9, 0xcf, 0x7e, 0x6c, 0xfe, 0x13, 0x9c, 0x73,
-	0x6f, 0x55, 0xdd, 0x22, 0xd9, 0x23, 0xdb, 0x33, 0x8b, 0x24, 0x3f, 0xdd, 0x75, 0xcf, 0x39, 0xf7,
-	0x7d, 0xef, 0x79, 0xdd, 0x73, 0x2f, 0x01, 0x66, 0x8e, 0xe1, 0x3e, 0x98, 0xf9, 0x5e, 0xe8, 0xb1,
-	0x3c, 0x7e, 0xdf, 0xfc, 0xe1, 0xb1, 0x1d, 0x9e, 0xcc, 0x47, 0x0f, 0xc6, 0xde, 0xf4, 0xdd, 0x63,
-	0xef, 0xd8, 0x7b, 0x97, 0x90, 0xa3, 0xf9, 0x84, 0x52, 0x94, 0xa0, 0x2f, 0x9e, 0xe9, 0x26, 0x38,
-	0xde, 0xf8, 0x54, 0x7c, 0x6f, 0x84, 0xf6, 0xd4, 0x0a, 0x42, 0x63, 0x3a, 0xe3, 0x00, 0xf5, 0x8f,
-	0x33, 0x90, 0x1f, 0x5e, 0xcc, 0x2c, 0x56, 0x87, 0xac, 0x6d, 0x36, 0x32, 0xdb, 0x99, 0x7b, 0x05,
-	0x2d, 0x6b, 0x9b, 0x6c, 0x1b, 0xaa, 0xae, 0x17, 0xf6, 0xe6, 0x8e, 0x63, 0x8c, 0x1c, 0xab, 0x91,
-	0xdd, 0xce, 0xdc, 0x2b, 0x6b, 0x32, 0x88, 0xbd, 0x02, 0x15, 0x63, 0x1e, 0x7a, 0xba, 0xed, 0x8e,
-	0xfd, 0x46, 0x8e, 0xf0, 0x65, 0x04, 0x74, 0xdc, 0xb1, 0xcf, 0xb6, 0xa0, 0x70, 0x66, 0x9b, 0xe1,
-	0x49, 0x23, 0x4f, 0x25, 0xf2, 0x04, 0x42, 0x83, 0xb1, 0xe1, 0x58, 0x8d, 0x02, 0x87, 0x52, 0x02,
-	0xa1, 0x21, 0x55, 0x52, 0xdc, 0xce, 0xdc, 0xab, 0x68, 0x3c, 0xc1, 0x6e, 0x03, 0x58, 0xee, 0x7c,
-	0xfa, 0xc2, 0x70, 0xe6, 0x56, 0xd0, 0x28, 0x11, 0x4a, 0x82, 0xa8, 0x3f, 0x81, 0xca, 0x34, 0x38,
-	0xde, 0xb7, 0x0c, 0xd3, 0xf2, 0xd9, 0x75, 0x28, 0x4d, 0x83, 0x63, 0x3d, 0x34, 0x8e, 0x45, 0x17,
-	0x8a, 0xd3, 0xe0, 0x78, 0x68, 0x1c, 0xb3, 0x1b, 0x50, 0x26, 0xc4, 0xc5, 0x8c, 0xf7, 0xa1, 0xa0,
-	0x21, 0x21, 0xf6, 0x58, 0xfd, 0x8b, 0x02, 0x94, 0xba, 0x76, 0x68, 0xf9, 0x86, 0xc3, 0xae, 0x41,
-	0xd1, 0x0e, 0xdc, 0xb9, 0xe3, 0x50, 0xf6, 0xb2, 0x26, 0x52, 0xec, 0x1a, 0x14, 0xec, 0xc7, 0x2f,
-	0x0c, 0x87, 0xe7, 0xdd, 0x5f, 0xd3, 0x78, 0x92, 0x35, 0xa0, 0x68, 0xbf, 0xff, 0x11, 0x22, 0x72,
-	0x02, 0x21, 0xd2, 0x84, 0x79, 0xf4, 0x10, 0x31, 0xf9, 0x18, 0x43, 0x69, 0xc2, 0x7c, 0xf4, 0x01,
-	0x62, 0xb0, 0xf7, 0x39, 0xc2, 0x50, 0x1a, 0x6b, 0x99, 0x53, 0x2d, 0x38, 0x00, 0xeb, 0x58, 0xcb,
-	0x3c, 0xaa, 0x65, 0xce, 0x6b, 0x29, 0x09, 0x84, 0x48, 0x13, 0x86, 0xd7, 0x52, 0x8e, 0x31, 0x71,
-	0x2d, 0x73, 0x5e, 0x4b, 0x65, 0x3b, 0x73, 0x2f, 0x4f, 0x18, 0x5e, 0xcb, 0x16, 0xe4, 0x4d, 0x84,
-	0xc3, 0x76, 0xe6, 0x5e, 0x66, 0x7f, 0x4d, 0xa3, 0x14, 0x42, 0x03, 0x84, 0x56, 0x71, 0x80, 0x11,
-	0x1a, 0x08, 0xe8, 0x08, 0xa1, 0x35, 0x1c, 0x0d, 0x84, 0x8e, 0x04, 0x74, 0x82, 0xd0, 0xf5, 0xed,
-	0xcc, 0xbd, 0x2c, 0x42, 0x31, 0xc5, 0x6e, 0x42, 0xc9, 0x34, 0x42, 0x0b, 0x11, 0x75, 0xd1, 0xe5,
-	0x08, 0x80, 0x38, 0x5c, 0x71, 0x88, 0xdb, 0x10, 0x9d, 0x8e, 0x00, 0x4c, 0x85, 0x2a, 0x92, 0x45,
-	0x78, 0x45, 0xe0, 0x65, 0x20, 0xfb, 0x10, 0x6a, 0xa6, 0x35, 0xb6, 0xa7, 0x86, 0xc3, 0xfb, 0xb4,
-	0xb9, 0x9d, 0xb9, 0x57, 0x7d, 0xb8, 0xf1, 0x80, 0xf6, 0x44, 0x8c, 0xd9, 0x5f, 0xd3, 0x52, 0x64,
-	0xec, 0x31, 0xac, 0x8b, 0xf4, 0xfb, 0x0f, 0x69, 0x60, 0x19, 0xe5, 0x53, 0x52, 0xf9, 0xde, 0x7f,
-	0xf8, 0x78, 0x7f, 0x4d, 0x4b, 0x13, 0xb2, 0xbb, 0x50, 0x8b, 0xb7, 0x08, 0x66, 0xbc, 0x22, 0x5a,
-	0x95, 0x82, 0x62, 0xb7, 0xbe, 0x0a, 0x3c, 0x17, 0x09, 0xb6, 0xc4, 0xb8, 0x45, 0x00, 0xb6, 0x0d,
-	0x60, 0x5a, 0x13, 0x63, 0xee, 0x84, 0x88, 0xbe, 0x2a, 0x06, 0x50, 0x82, 0xb1, 0xdb, 0x50, 0x99,
-	0xcf, 0xb0, 0x97, 0xcf, 0x0c, 0xa7, 0x71, 0x4d, 0x10, 0x24, 0x20, 0x2c, 0x1d, 0xd7, 0x39, 0x62,
-	0xaf, 0x8b, 0xd9, 0x8d, 0x00, 0xb8, 0x57, 0xec, 0x60, 0xc7, 0x76, 0x1b, 0x0d, 0x5a, 0xa7, 0x3c,
-	0xc1, 0x6e, 0x41, 0x2e, 0xf0, 0xc7, 0x8d, 0x1b, 0xd4, 0x4b, 0xe0, 0xbd, 0x6c, 0x9f, 0xcf, 0x7c,
-	0x0d, 0xc1, 0x3b, 0x25, 0x28, 0xd0, 0x9e, 0x51, 0x6f, 0x41, 0xf9, 0xd0, 0xf0, 0x8d, 0xa9, 0x66,
-	0x4d, 0x98, 0x02, 0xb9, 0x99, 0x17, 0x88, 0xdd, 0x82, 0x9f, 0x6a, 0x17, 0x8a, 0xcf, 0x0c, 0x1f,
-	0x71, 0x0c, 0xf2, 0xae, 0x31, 0xb5, 0x08, 0x59, 0xd1, 0xe8, 0x1b, 0x77, 0x48, 0x70, 0x11, 0x84,
-	0xd6, 0x54, 0xb0, 0x02, 0x91, 0x42, 0xf8, 0xb1, 0xe3, 0x8d, 0xc4, 0x4e, 0x28, 0x6b, 0x22, 0xa5,
-	0xfe, 0xcd, 0x0c, 0x14, 0x5b, 0x9e, 0x83, 0xc5, 0x5d, 0x87, 0x92, 0x6f, 0x39, 0x7a, 0x52, 0x5d,
-	0xd1, 0xb7, 0x9c, 0x43, 0x2f, 0x40, 0xc4, 0xd8, 0xe3, 0x08, 0xbe, 0x37, 0x8b, 0x63, 0x8f, 0x10,
-	0x51, 0x03, 0x72, 0x52, 0x03, 0x6e, 0x40, 0x39, 0x1c, 0x39, 0x3a, 0xc1, 0xf3, 0x04, 0x2f, 0x85,
-	0x23, 0xa7, 0x87, 0xa8, 0xeb, 0x50, 0x32, 0x47, 0x1c, 0x53, 0x20, 0x4c, 0xd1, 0x1c, 0x21, 0x42,
-	0xfd, 0x04, 0x2a, 0x9a, 0x71, 0x26, 0x9a, 0x71, 0x15, 0x8a, 0x58, 0x80, 0xe0, 0x72, 0x79, 0xad,
-	0x10, 0x8e, 0x9c, 0x8e, 0x89, 0x60, 0x6c, 0x84, 0x6d, 0x52, 0x1b, 0xf2, 0x5a, 0x61, 0xec, 0x39,
-	0x1d, 0x53, 0x1d, 0x02, 0xb4, 0x3c, 0xdf, 0xff, 0xde, 0x5d, 0xd8, 0x82, 0x82, 0x69, 0xcd, 0xc2,
-	0x13, 0xce, 0x20, 0x34, 0x9e, 0x50, 0xef, 0x43, 0x19, 0xe7, 0xa5, 0x6b, 0x07, 0x21, 0xbb, 0x0d,
-	0x79, 0xc7, 0x0e, 0xc2, 0x46, 0x66, 0x3b, 0xb7, 0x30, 0x6b, 0x04, 0x57, 0xb7, 0xa1, 0x7c, 0x60,
-	0x9c, 0x3f, 0xc3, 0x99, 0xc3, 0xd2, 0x68, 0x0a, 0xc5, 0x94, 0x88, 0xf9, 0xac, 0x01, 0x0c, 0x0d,
-	0xff, 0xd8, 0x0a, 0x89, 0x9f, 0xfd, 0x65, 0x06, 0xaa, 0x83, 0xf9, 0xe8, 0xeb, 0xb9, 0xe5, 0x5f,
-	0x60, 0x9b, 0xef, 0x41, 0x2e, 0xbc, 0x98, 0x51, 0x8e, 0xfa, 0xc3, 0x6b, 0xbc, 0x78, 0x09, 0xff,
-	0x00, 0x33, 0x69, 0x48, 0x82, 0x9d, 0x70, 0x3d, 0xd3, 0x8a, 0xc6, 0xa0, 0xa0, 0x15, 0x31, 0xd9,
-	0x31, 0x51, 0x28, 0x78, 0x33, 0x31, 0x0b, 0x59, 0x6f, 0xc6, 0xb6, 0xa1, 0x30, 0x3e, 0xb1, 0x1d,
-	0x93, 0x26, 0x20, 0xdd, 0x66, 0x8e, 0xc0, 0x59, 0xf2, 0xbd, 0x33, 0x3d, 0xb0, 0xbf, 0x89, 0x98,
-	0x7c, 0xc9, 0xf7, 0xce, 0x06, 0xf6, 0x37, 0x96, 0x3a, 0x14, 0x92, 0x06, 0xa0, 0x38, 0x68, 0x35,
-	0xbb, 0x4d, 0x4d, 0x59, 0xc3, 0xef, 0xf6, 0x17, 0x9d, 0xc1, 0x70, 0xa0, 0x64, 0x58, 0x1d, 0xa0,
-	0xd7, 0x1f, 0xea, 0x22, 0x9d, 0x65, 0x45, 0xc8, 0x76, 0x7a, 0x4a, 0x0e, 0x69, 0x10, 0xde, 0xe9,
-	0x29, 0x79, 0x56, 0x82, 0x5c, 0xb3, 0xf7, 0xa5, 0x52, 0xa0, 0x8f, 0x6e, 0x57, 0x29, 0xaa, 0x7f,
-	0x94, 0x85, 0x4a, 0x7f, 0xf4, 0x95, 0x35, 0x0e, 0xb1, 0xcf, 0xb8, 0x4a, 0x2d, 0xff, 0x85, 0xe5,
-	0x53, 0xb7, 0x73, 0x9a, 0x48, 0x61, 0x47, 0xcc, 0x11, 0x75, 0x2e, 0xa7, 0x65, 0xcd, 0x11, 0xd1,
-	0x8d, 0x4f, 0xac, 0xa9, 0x41, 0x9d, 0x43, 0x3a, 0x4a, 0xe1, 0xae, 0xf0, 0x46, 0x5f, 0x51, 0xf7,
-	0x72, 0x1a, 0x7e, 0xb2, 0x3b, 0x50, 0xe5, 0x65, 0xc8, 0xeb, 0x0b, 0x38, 0x68, 0x71, 0xf1, 0x15,
-	0xe5, 0xc5, 0x47, 0x39, 0xa9, 0x54, 0x8e, 0x14, 0x12, 0x8c, 0x83, 0x7a, 0x62, 0x45, 0x7b, 0xa3,
-	0xaf, 0x38, 0xb6, 0xcc, 0x57, 0xb4, 0x37, 0xfa, 0x8a, 0x50, 0x3f, 0x80, 0xcd, 0x60, 0x3e, 0x0a,
-	0xc6, 0xbe, 0x3d, 0x0b, 0x6d, 0xcf, 0xe5, 0x34, 0x15, 0xa2, 0x51, 0x64, 0x04, 0x11, 0xdf, 0x83,
-	0xf2, 0x6c, 0x3e, 0xd2, 0x6d, 0x77, 0xe2, 0x11, 0x73, 0xaf, 0x3e, 0x5c, 0xe7, 0x13, 0x73, 0x38,
-	0x1f, 0x75, 0xdc, 0x89, 0xa7, 0x95, 0x66, 0xfc, 0x43, 0x7d, 0x13, 0x4a, 0x02, 0x86, 0xd2, 0x3b,
-	0xb4, 0x5c, 0xc3, 0x0d, 0xf5, 0x58, 0xec, 0x97, 0x39, 0xa0, 0x63, 0xaa, 0xff, 0x30, 0x03, 0xca,
-	0x40, 0xaa, 0xe6, 0xc0, 0x0a, 0x8d, 0x95, 0x5c, 0xe1, 0x55, 0x00, 0x63, 0x3c, 0xf6, 0xe6, 0xbc,
-	0x18, 0xbe, 0x78, 0x2a, 0x02, 0xd2, 0x31, 0xe5, 0xb1, 0xc9, 0xa5, 0xc6, 0xe6, 0x35, 0xa8, 0x45,
-	0xf9, 0xa4, 0x0d, 0x5d, 0x15, 0xb0, 0x68, 0x74, 0x82, 0x79, 0x6a, 0x57, 0x97, 0x82, 0x39, 0xdf,
-	0xd6, 0x7f, 0x37, 0x0b, 0xe5, 0xbd, 0xb9, 0x3b, 0xc6, 0xa6, 0xb1, 0xd7, 0x21, 0x3f, 0x99, 0xbb,
-	0x63, 0x6a, 0x56, 0x2c, 0x1a, 0xe2, 0x15, 0xa1, 0x11, 0x12, 0xf7, 0x9a, 0xe1, 0x1f, 0xe3, 0x1e,
-	0x5d, 0xda, 0x6b, 0x08, 0x57, 0xff, 0x55, 0x86, 0x97, 0xb8, 0xe7, 0x18, 0xc7, 0xac, 0x0c, 0xf9,
-	0x5e, 0xbf, 0xd7, 0x56, 0xd6, 0x58, 0x0d, 0xca, 0x9d, 0xde, 0xb0, 0xad, 0xf5, 0x9a, 0x5d, 0x25,
-	0x43, 0x0b, 0x77, 0xd8, 0xdc, 0xe9, 0xb6, 0x95, 0x2c, 0x62, 0x9e, 0xf5, 0xbb, 0xcd, 0x61, 0xa7,
-	0xdb, 0x56, 0xf2, 0x1c, 0xa3, 0x75, 0x5a, 0x43, 0xa5, 0xcc, 0x14, 0xa8, 0x1d, 0x6a, 0xfd, 0xdd,
-	0xa3, 0x56, 0x5b, 0xef, 0x1d, 0x75, 0xbb, 0x8a, 0xc2, 0xae, 0xc0, 0x46, 0x0c, 0xe9, 0x73, 0xe0,
-	0x36, 0x66, 0x79, 0xd6, 0xd4, 0x9a, 0xda, 0x13, 0xe5, 0x33, 0x56, 0x86, 0x5c, 0xf3, 0xc9, 0x13,
-	0xe5, 0xe7, 0xb8, 0x07, 0x2a, 0xcf, 0x3b, 0x3d, 0xfd, 0x59, 0xb3, 0x7b, 0xd4, 0x56, 0x7e, 0x9e,
-	0x8d, 0xd2, 0x7d, 0x6d, 0xb7, 0xad, 0x29, 0x3f, 0xcf, 0xb3, 0x4d, 0xa8, 0xfd, 0xac, 0xdf, 0x6b,
-	0x1f, 0x34, 0x0f, 0x0f, 0xa9, 0x21, 0x3f, 0x2f, 0xab, 0x7f, 0x92, 0x87, 0x3c, 0xf6, 0x84, 0xa9,
-	0xc9, 0x7e, 0x8f, 0xbb, 0x88, 0x1b, 0x6e, 0x27, 0xff, 0x27, 0x7f, 0x7e, 0x67, 0x8d, 0xef, 0xf4,
-	0xd7, 0x20, 0xe7, 0xd8, 0x21, 0x4d, 0x54, 0xbc, 0x4a, 0x84, 0x0e, 0xb4, 0xbf, 0xa6, 0x21, 0x8e,
-	0xdd, 0x86, 0x0c, 0xdf, 0xf2, 0xd5, 0x87, 0x75, 0xb1, 0x8c, 0x84, 0xcc, 0xd8, 0x5f, 0xd3, 0x32,
-	0x33, 0x76, 0x0b, 0x32, 0x2f, 0xc4, 0xfe, 0xaf, 0x71, 0x3c, 0x97, 0x1a, 0x88, 0x7d, 0xc1, 0xb6,
-	0x21, 0x37, 0xf6, 0xb8, 0x86, 0x13, 0xe3, 0x39, 0x0f, 0xc5, 0xf2, 0xc7, 0x9e, 0xc3, 0x5e, 0x87,
-	0x9c, 0x6f, 0x9c, 0xd1, 0x5e, 0x89, 0xa7, 0x2b, 0x66, 0xd2, 0x48, 0xe4, 0x1b, 0x67, 0xd8, 0x88,
-	0x09, 0xed, 0x98, 0xb8, 0x11, 0xd1, 0x7c, 0x63, 0x35, 0x13, 0xb6, 0x0d, 0x99, 0x33, 0xda, 0x33,
-	0xb1, 0x50, 0x7f, 0x6e, 0xbb, 0xa6, 0x77, 0x36, 0x98, 0x59, 0x63, 0xa4, 0x38, 0x63, 0x6f, 0x40,
-	0x2e, 0x98, 0x8f, 0x68, 0xcf, 0x54, 0x1f, 0x6e, 0x2e, 0x71, 0x3f, 0xac, 0x28, 0x98, 0x8f, 0xd8,
-	0x9b, 0x90, 0x1f, 0x7b, 0xbe, 0x2f, 0xf6, 0x8d, 0x12, 0x35, 0x38, 0x62, 0xfc, 0xa8, 0xe4, 0x20,
-	0x1e, 0x2b, 0x0c, 0x49, 0x47, 0x8a, 0x89, 0x12, 0xce, 0x8b, 0x15, 0x86, 0xec, 0xae, 0x60, 0xe7,
-	0x35, 0xb9, 0xd5, 0x11, 0xb3, 0xc7, 0x72, 0x10, 0x8b, 0x93, 0x34, 0x35, 0xce, 0x49, 0x83, 0x8a,
-	0x89, 0x22, 0x2e, 0x8f, 0x6d, 0x9a, 0x1a, 0xe7, 0xec, 0x2e, 0xe4, 0x5e, 0x58, 0x63, 0x52, 0xa6,
-	0xe2, 0xda, 0xc4, 0x24, 0x3d, 0xa3, 0xee, 0x21, 0x1a, 0xe5, 0x96, 0x31, 0x3f, 0xc7, 0x6d, 0xb7,
-	0xc1, 0x25, 0x8c, 0x31, 0x3f, 0xef, 0x98, 0xc8, 0xc1, 0x5c, 0xf3, 0x05, 0x69, 0x53, 0x19, 0x0d,
-	0x3f, 0x51, 0x93, 0x0f, 0x2c, 0xc7, 0x1a, 0x87, 0xf6, 0x0b, 0x3b, 0xbc, 0x20, 0x15, 0x2a, 0xa3,
-	0xc9, 0xa0, 0x9d, 0x22, 0xe4, 0xad, 0xf3, 0x99, 0xaf, 0x3e, 0x04, 0x48, 0xea, 0xc1, 0x92, 0x1c,
-	0xcb, 0x8d, 0x34, 0x04, 0xc7, 0x72, 0x91, 0x03, 0x98, 0x46, 0x68, 0xd0, 0xf2, 0xa9, 0x69, 0xf4,
-	0xad, 0xde, 0x80, 0x4a, 0xac, 0x7a, 0xb1, 0x1a, 0x64, 0x0c, 0xc1, 0x79, 0x33, 0x86, 0x7a, 0x0f,
-	0x35, 0xa1, 0x48, 0xb9, 0x4a, 0xe3, 0x30, 0x15, 0xf1, 0xe3, 0xcc, 0x48, 0xfd, 0x11, 0xd4, 0x34,
-	0x2b, 0x98, 0x3b, 0x61, 0xcb, 0x73, 0x76, 0xad, 0x09, 0x7b, 0x07, 0x20, 0x4e, 0x07, 0x42, 0x40,
-	0x26, 0x8b, 0x69, 0xd7, 0x9a, 0x68, 0x12, 0x5e, 0xfd, 0xbd, 0x3c, 0xa9, 0x1a, 0xbb, 0x5c, 0xc6,
-	0x0b, 0x61, 0x9e, 0x91, 0x84, 0x79, 0xcc, 0xba, 0xb2, 0x69, 0x85, 0xe6, 0xc4, 0x36, 0x4d, 0xcb,
-	0x8d, 0x14, 0x17, 0x9e, 0xc2, 0xd1, 0x37, 0x9c, 0x63, 0x5a, 0xe1, 0xf5, 0x87, 0x2c, 0xaa, 0x74,
-	0x3a, 0xf3, 0xad, 0x20, 0xe0, 0x22, 0xd3, 0x70, 0x8e, 0xa3, 0xcd, 0x56, 0xf8, 0x55, 0x9b, 0xed,
-	0x06, 0x94, 0x5d, 0x2f, 0xd4, 0xc9, 0xac, 0x28, 0x52, 0x1d, 0x25, 0x61, 0x3f, 0xb1, 0xb7, 0xa0,
-	0x24, 0x14, 0x42, 0xb1, 0xca, 0xc5, 0x5e, 0xdc, 0xe5, 0x40, 0x2d, 0xc2, 0xb2, 0x06, 0xea, 0x17,
-	0xd3, 0xa9, 0xe5, 0x86, 0x91, 0x88, 0x10, 0x49, 0xf6, 0x03, 0xa8, 0x78, 0xae, 0xce, 0xb5, 0x46,
-	0xb1, 0xcc, 0xc5, 0x7a, 0xea, 0xbb, 0x47, 0x04, 0xd5, 0xca, 0x9e, 0xf8, 0xc2, 0xa6, 0x38, 0xde,
-	0x99, 0x3e, 0x36, 0x7c, 0x93, 0x96, 0x7a, 0x59, 0x2b, 0x39, 0xde, 0x59, 0xcb, 0xf0, 0x4d, 0x2e,
-	0x32, 0xbf, 0x76, 0xe7, 0x53, 0x5a, 0xde, 0xeb, 0x9a, 0x48, 0xb1, 0x5b, 0x50, 0x19, 0x3b, 0xf3,
-	0x20, 0xb4, 0xfc, 0x9d, 0x0b, 0x6e, 0x07, 0x68, 0x09, 0x00, 0xdb, 0x35, 0xf3, 0xed, 0xa9, 0xe1,
-	0x5f, 0xd0, 0x5a, 0x2e, 0x6b, 0x51, 0x12, 0x55, 0x95, 0xd9, 0xa9, 0x6d, 0x9e, 0x73, 0x63, 0x40,
-	0xe3, 0x09, 0xa4, 0x3f, 0x21, 0x53, 0x2d, 0xa0, 0xe5, 0x5a, 0xd6, 0xa2, 0x24, 0xcd, 0x03, 0x7d,
-	0xd2, 0x9a, 0xad, 0x68, 0x22, 0x95, 0xd2, 0xf7, 0x36, 0x2f, 0xd5, 0xf7, 0x58, 0x4a, 0xdf, 0xfb,
-	0x1a, 0x4a, 0x62, 0x04, 0x91, 0xe3, 0xe3, 0x9a, 0x4e, 0xb3, 0x43, 0xce, 0xf1, 0x11, 0xce, 0x5e,
-	0x87, 0x75, 0xcf, 0xb7, 0x8f, 0x6d, 0x57, 0x0f, 0x42, 0xdf, 0x76, 0x8f, 0xc5, 0xda, 0xa8, 0x71,
-	0xe0, 0x80, 0x60, 0x28, 0xa6, 0x70, 0xf6, 0x74, 0x63, 0x64, 0x3b, 0xb8, 0x77, 0x72, 0xc2, 0x0a,
-	0x9e, 0x3b, 0x4e, 0x93, 0x83, 0xd4, 0x3e, 0x94, 0xa3, 0xf1, 0xfe, 0x8d, 0xd4, 0xa9, 0xfe, 0x16,
-	0x54, 0x3b, 0xae, 0x69, 0x9d, 0xf7, 0x49, 0xf2, 0xb2, 0x77, 0x80, 0x8d, 0x7d, 0xcb, 0x08, 0x2d,
-	0xdd, 0x3a, 0x0f, 0x7d, 0x43, 0xe7, 0x96, 0x32, 0xb7, 0x52, 0x15, 0x8e, 0x69, 0x23, 0x62, 0x88,
-	0x70, 0xf5, 0xbf, 0x64, 0x60, 0xfd, 0x90, 0x4f, 0xc4, 0x53, 0xeb, 0x62, 0x97, 0xeb, 0xf2, 0xe3,
-	0x68, 0x13, 0xe5, 0x35, 0xfa, 0x66, 0xb7, 0xa1, 0x3a, 0x3b, 0xb5, 0x2e, 0xf4, 0x94, 0xde, 0x5b,
-	0x41, 0x50, 0x8b, 0xb6, 0xcb, 0xdb, 0x50, 0xf4, 0xa8, 0x76, 0x21, 0x07, 0x04, 0xfb, 0x94, 0x9a,
-	0xa5, 0x09, 0x02, 0xa6, 0xc2, 0x7a, 0x5c, 0x94, 0x2c, 0xc9, 0x45, 0x61, 0x34, 0x5d, 0x5b, 0x50,
-	0x40, 0x54, 0xd0, 0x28, 0x6c, 0xe7, 0x50, 0x79, 0xa5, 0x04, 0x7b, 0x0f, 0xd6, 0xc7, 0xde, 0x74,
-	0xa6, 0x47, 0xd9, 0x85, 0x44, 0x48, 0x6f, 0xf3, 0x2a, 0x92, 0x1c, 0xf2, 0xb2, 0xd4, 0xdf, 0xcf,
-	0x41, 0x99, 0xda, 0x20, 0x76, 0xba, 0x6d, 0x9e, 0x47, 0x3b, 0xbd, 0xa2, 0x15, 0x6c, 0x13, 0xd9,
-	0xdf, 0xab, 0x00, 0x36, 0x92, 0xe8, 0xd2, 0x7e, 0xaf, 0x10, 0x24, 0x6a, 0xca, 0xcc, 0xf0, 0xc3,
-	0xa0, 0x91, 0xe3, 0x4d, 0xa1, 0x04, 0x2e, 0xc1, 0xb9, 0x6b, 0x7f, 0x3d, 0xe7, 0xad, 0x2f, 0x6b,
-	0x22, 0xc5, 0xee, 0x81, 0xc2, 0x0b, 0xa3, 0x41, 0x97, 0x55, 0x91, 0x3a, 0xc1, 0x69, 0xcc, 0x23,
-	0x5d, 0x8f, 0xd3, 0x58, 0xe7, 0x28, 0x03, 0xf8, 0x6e, 0x07, 0x02, 0xb5, 0x11, 0x22, 0xef, 0xe3,
-	0x52, 0x7a, 0x1f, 0x37, 0xa0, 0xf4, 0xc2, 0x0e, 0x6c, 0x9c, 0xd5, 0x32, 0xdf, 0x19, 0x22, 0x29,
-	0x4d, 0x43, 0xe5, 0x65, 0xd3, 0x10, 0x77, 0xdb, 0x70, 0x8e, 0xb9, 0x12, 0x18, 0x75, 0xbb, 0xe9,
-	0x1c, 0x7b, 0xec, 0x7d, 0xb8, 0x9a, 0xa0, 0x45, 0x6f, 0xc8, 0x25, 0x42, 0x56, 0xbf, 0xc6, 0x62,
-	0x4a, 0xea, 0x11, 0x69, 0xe9, 0xf7, 0x61, 0x53, 0xca, 0x32, 0x43, 0x15, 0x20, 0x20, 0x36, 0x50,
-	0xd1, 0x36, 0x62, 0x72, 0xd2, 0x0c, 0x02, 0xf5, 0xdf, 0x65, 0x61, 0x7d, 0xcf, 0xf3, 0x2d, 0xfb,
-	0xd8, 0x4d, 0x56, 0xdd, 0x92, 0xae, 0x18, 0xad, 0xc4, 0xac, 0xb4, 0x12, 0xef, 0x40, 0x75, 0xc2,
-	0x33, 0xea, 0xe1, 0x88, 0x9b, 0x90, 0x79, 0x0d, 0x04, 0x68, 0x38, 0x72, 0x70, 0x07, 0x46, 0x04,
-	0x94, 0x39, 0x4f, 0x99, 0xa3, 0x4c, 0xc8, 0xfe, 0xd9, 0xa7, 0xc4, 0x08, 0x4d, 0xcb, 0xb1, 0x42,
-	0x3e, 0x3d, 0xf5, 0x87, 0xaf, 0x0a, 0x9d, 0x41, 0x6e, 0xd3, 0x03, 0xcd, 0x9a, 0x34, 0x49, 0x85,
-	0x40, 0xbe, 0xb8, 0x4b, 0xe4, 0x22, 0xaf, 0x60, 0xa2, 0xc5, 0x6f, 0x99, 0x97, 0xef, 0x76, 0x75,
-	0x08, 0x95, 0x18, 0x8c, 0xfa, 0xa0, 0xd6, 0x16, 0x3a, 0xe0, 0x1a, 0xab, 0x42, 0xa9, 0xd5, 0x1c,
-	0xb4, 0x9a, 0xbb, 0x6d, 0x25, 0x83, 0xa8, 0x41, 0x7b, 0xc8, 0xf5, 0xbe, 0x2c, 0xdb, 0x80, 0x2a,
-	0xa6, 0x76, 0xdb, 0x7b, 0xcd, 0xa3, 0xee, 0x50, 0xc9, 0xb1, 0x75, 0xa8, 0xf4, 0xfa, 0x7a, 0xb3,
-	0x35, 0xec, 0xf4, 0x7b, 0x4a, 0x5e, 0xfd, 0x0c, 0xca, 0xad, 0x13, 0x6b, 0x7c, 0x7a, 0xd9, 0x28,
-	0x92, 0x09, 0x66, 0x8d, 0x4f, 0x85, 0x0e, 0xb7, 0x60, 0x82, 0x59, 0xe3, 0x53, 0xf5, 0x19, 0xd4,
-	0x5a, 0x11, 0x9f, 0xbe, 0xac, 0x94, 0x87, 0x50, 0xa7, 0xcd, 0x37, 0x1e, 0x45, 0xbb, 0x2f, 0xbb,
-	0x62, 0xf7, 0xd5, 0x90, 0xa6, 0x35, 0x12, 0xdb, 0xef, 0x43, 0xa8, 0x1e, 0xfa, 0xde, 0xcc, 0xf2,
-	0x43, 0x2a, 0x56, 0x81, 0xdc, 0xa9, 0x75, 0x21, 0x4a, 0xc5, 0xcf, 0xc4, 0x48, 0xcd, 0xca, 0x46,
-	0xea, 0x43, 0x28, 0x47, 0xd9, 0xbe, 0x75, 0x9e, 0x9f, 0x20, 0x17, 0xa3, 0x3c, 0xb6, 0x15, 0x60,
-	0x65, 0x0f, 0x00, 0x66, 0x31, 0x40, 0x28, 0x04, 0x91, 0x76, 0x2a, 0x0a, 0xd7, 0x24, 0x0a, 0xf5,
-	0x2f, 0x73, 0x50, 0x3f, 0x34, 0xfc, 0xd0, 0xc6, 0xc9, 0xe1, 0xc3, 0xf0, 0x16, 0xe4, 0x69, 0xc9,
-	0x73, 0x7b, 0xf8, 0x4a, 0xac, 0xda, 0x72, 0x1a, 0x92, 0xec, 0x44, 0xc0, 0x3e, 0x85, 0xfa, 0x2c,
-	0x02, 0xeb, 0xc4, 0xcf, 0xf9, 0xd8, 0x2c, 0x66, 0xa1, 0x31, 0x5f, 0x9f, 0xc9, 0x49, 0xf6, 0x63,
-	0xd8, 0x4a, 0xe7, 0xb5, 0x82, 0x20, 0xe1, 0xa3, 0xf2, 0x64, 0x5d, 0x49, 0x65, 0xe4, 0x64, 0xac,
-	0x05, 0x9b, 0x49, 0xf6, 0xb1, 0xe7, 0xcc, 0xa7, 0x6e, 0x20, 0x74, 0xed, 0x6b, 0x0b, 0xb5, 0xb7,
-	0x38, 0x56, 0x53, 0x66, 0x0b, 0x10, 0xa6, 0x42, 0x2d, 0x86, 0xf5, 0xe6, 0x53, 0xda, 0x12, 0x79,
-	0x2d, 0x05, 0x63, 0x8f, 0x00, 0xe2, 0x74, 0xd0, 0x28, 0xd2, 0x78, 0x2e, 0xf6, 0xaf, 0x13, 0x5a,
-	0x53, 0x4d, 0x22, 0x43, 0x8d, 0x00, 0x99, 0x81, 0x6f, 0x87, 0x27, 0x53, 0xe2, 0x62, 0x39, 0x2d,
-	0x01, 0x10, 0xb3, 0x0c, 0x74, 0x34, 0xd9, 0xe2, 0x2c, 0x82, 0xa1, 0xd5, 0xed, 0x60, 0x30, 0x1f,
-	0xc5, 0xe5, 0xa2, 0x18, 0x4c, 0x7a, 0x39, 0x0d, 0x8e, 0x85, 0x61, 0x9b, 0xb4, 0xf0, 0x20, 0x38,
-	0x66, 0x0f, 0xe1, 0x6a, 0x42, 0x94, 0xf0, 0xdf, 0xa0, 0x01, 0xc4, 0xb9, 0x93, 0xe1, 0x8b, 0x99,
-	0x70, 0xa0, 0x7e, 0x0e, 0xeb, 0xa9, 0xd9, 0x79, 0xa9, 0x40, 0xbe, 0x01, 0x65, 0xfc, 0x8f, 0xe2,
-	0x58, 0x2c, 0xc0, 0x12, 0xa6, 0x07, 0xa1, 0xaf, 0x5a, 0xa0, 0x2c, 0x8e, 0x35, 0xbb, 0x4b, 0xce,
-	0x1e, 0x9a, 0x94, 0x65, 0xa7, 0x4d, 0x84, 0x42, 0xdb, 0x7d, 0x79, 0x12, 0xb3, 0xd4, 0xea, 0xa5,
-	0xc9, 0x52, 0xff, 0x71, 0x56, 0x6a, 0x33, 0x8e, 0x38, 0x7b, 0x43, 0x5e, 0x7e, 0xd2, 0xc6, 0x4d,
-	0xc6, 0x8c, 0x24, 0xce, 0xdb, 0xa0, 0x78, 0xbe, 0x69, 0xbb, 0x06, 0x39, 0x9f, 0xf8, 0x70, 0x67,
-	0x49, 0x81, 0xdb, 0x10, 0xf0, 0x43, 0x01, 0x46, 0x03, 0xc0, 0xb4, 0x62, 0x5b, 0x5e, 0x58, 0xe2,
-	0x32, 0x48, 0x96, 0x4e, 0xf9, 0xb4, 0x74, 0x7a, 0x0b, 0x2a, 0x8e, 0x15, 0x04, 0x7a, 0x78, 0x62,
-	0xb8, 0x24, 0xbf, 0xd3, 0x9d, 0x2e, 0x23, 0x72, 0x78, 0x62, 0xb8, 0x48, 0x68, 0xbb, 0xba, 0xf0,
-	0xd6, 0x17, 0x97, 0x09, 0x6d, 0x97, 0x6c, 0x1c, 0x94, 0xfb, 0x5b, 0xab, 0x26, 0x56, 0x88, 0x45,
-	0xb6, 0x3c, 0xaf, 0xea, 0xab, 0x50, 0x7a, 0x66, 0x5b, 0x67, 0x82, 0x97, 0xbd, 0xb0, 0xad, 0xb3,
-	0x88, 0x97, 0xe1, 0xb7, 0xfa, 0x9f, 0xcb, 0x50, 0x26, 0xe2, 0xdd, 0xcb, 0x9d, 0x7c, 0xdf, 0xc5,
-	0x00, 0xd8, 0x16, 0x72, 0x2a, 0xbf, 0xc2, 0xec, 0xe0, 0x52, 0xeb, 0x55, 0x00, 0x49, 0x86, 0x72,
-	0x8d, 0xa0, 0x12, 0xc6, 0xa2, 0x13, 0x35, 0x67, 0x52, 0xcc, 0x82, 0xaf, 0x1d, 0xe1, 0x13, 0x4a,
-	0x00, 0xec, 0x01, 0xd7, 0x6b, 0xc9, 0x67, 0x51, 0x92, 0x19, 0x0b, 0xf5, 0x21, 0x32, 0x73, 0x49,
-	0xd9, 0xc5, 0x04, 0xe9, 0x07, 0x96, 0x1f, 0x44, 0xdb, 0x69, 0x5d, 0x8b, 0x92, 0xc8, 0xd1, 0x50,
-	0x79, 0x12, 0x66, 0x69, 0xb4, 0x7d, 0x65, 0xed, 0x4f, 0x23, 0x02, 0x76, 0x0f, 0x4a, 0x24, 0xb2,
-	0x2d, 0x94, 0xe0, 0x12, 0xeb, 0x8c, 0x94, 0x29, 0x2d, 0x42, 0xb3, 0xb7, 0xa1, 0x30, 0x39, 0xb5,
-	0x2e, 0x82, 0xc6, 0xba, 0xcc, 0x12, 0x52, 0xb2, 0x50, 0xe3, 0x14, 0xec, 0x2e, 0xd4, 0x7d, 0x6b,
-	0xa2, 0x93, 0xdb, 0x0f, 0x85, 0x77, 0xd0, 0xa8, 0x93, 0x6c, 0xae, 0xf9, 0xd6, 0xa4, 0x85, 0xc0,
-	0xe1, 0xc8, 0x09, 0xd8, 0x9b, 0x50, 0x24, 0xa9, 0x84, 0x6a, 0xbf, 0x54, 0x73, 0x24, 0xe2, 0x34,
-	0x81, 0x65, 0x0f, 0xa1, 0x92, 0xb0, 0x8d, 0xab, 0xd4, 0xa1, 0xad, 0x05, 0x7e, 0x44, 0x6c, 0x5c,
-	0x4b, 0xc8, 0xd8, 0xfb, 0x00, 0xc2, 0x20, 0xd1, 0x47, 0x17, 0xe4, 0x48, 0xaf, 0xc6, 0x06, 0x9b,
-	0x24, 0x00, 0x65, 0xb3, 0xe5, 0x2d, 0x28, 0xa0, 0x94, 0x08, 0x1a, 0xd7, 0xa9, 0x35, 0x9b, 0x69,
-	0x11, 0x42, 0xbd, 0x23, 0x3c, 0xbb, 0x07, 0x65, 0x5c, 0x5c, 0x3a, 0x4e, 0x61, 0x43, 0xb6, 0xd0,
-	0xc4, 0x4a, 0x44, 0x2d, 0xcd, 0x3a, 0x1b, 0x7c, 0xed, 0xb0, 0xfb, 0x90, 0x37, 0xad, 0x49, 0xd0,
-	0xb8, 0x41, 0x25, 0x5e, 0x93, 0xe6, 0x12, 0x15, 0x87, 0x5d, 0x6b, 0xc2, 0x45, 0x0b, 0xd2, 0xb0,
-	0x7d, 0xa8, 0xe3, 0xd2, 0x7b, 0x48, 0x8a, 0x37, 0x0e, 0x79, 0xe3, 0x26, 0xe5, 0x7a, 0x6d, 0x21,
-	0x57, 0x4f, 0x10, 0xd1, 0x04, 0xb5, 0xdd, 0xd0, 0xbf, 0xd0, 0xd6, 0x5d, 0x19, 0xc6, 0x6e, 0x42,
-	0xd9, 0x0e, 0xba, 0xde, 0xf8, 0xd4, 0x32, 0x1b, 0xaf, 0xf0, 0xb3, 0xb7, 0x28, 0xcd, 0x3e, 0x81,
-	0x75, 0x5a, 0x8c, 0x98, 0xc4, 0xca, 0x1b, 0xb7, 0x64, 0x91, 0x37, 0x94, 0x51, 0x5a, 0x9a, 0x12,
-	0xd5, 0x2d, 0x3b, 0xd0, 0x43, 0x6b, 0x3a, 0xf3, 0x7c, 0xb4, 0xed, 0x5e, 0xe5, 0x06, 0x8f, 0x1d,
-	0x0c, 0x23, 0x10, 0xf2, 0xf9, 0xf8, 0xd8, 0x4f, 0xf7, 0x26, 0x93, 0xc0, 0x0a, 0x1b, 0xb7, 0x69,
-	0xaf, 0xd5, 0xa3, 0xd3, 0xbf, 0x3e, 0x41, 0x49, 0x29, 0x0d, 0x74, 0xf3, 0xc2, 0x35, 0xa6, 0xf6,
-	0xb8, 0x71, 0x87, 0x9b, 0x90, 0x76, 0xb0, 0xcb, 0x01, 0xb2, 0x15, 0xb7, 0x2d, 0x5b, 0x71, 0x37,
-	0x9f, 0x90, 0x15, 0x47, 0xed, 0xf9, 0x70, 0x41, 0xee, 0xa7, 0x16, 0xba, 0xa4, 0x20, 0xec, 0xaf,
-	0xc9, 0xe2, 0x7f, 0xa7, 0x00, 0x39, 0xd3, 0x9a, 0xdc, 0xfc, 0x0c, 0xd8, 0xf2, 0x48, 0xbe, 0x4c,
-	0x09, 0x29, 0x08, 0x25, 0xe4, 0xd3, 0xec, 0xe3, 0x8c, 0xfa, 0x09, 0xac, 0xa7, 0xb6, 0xe5, 0x4a,
-	0x65, 0x8a, 0x1b, 0x15, 0xc6, 0x54, 0xf8, 0x45, 0x78, 0x42, 0xfd, 0xd3, 0x1c, 0xd4, 0xf6, 0x8d,
-	0xe0, 0xe4, 0xc0, 0x98, 0x0d, 0x42, 0x23, 0x0c, 0x70, 0x6c, 0x4f, 0x8c, 0xe0, 0x64, 0x6a, 0xcc,
-	0xb8, 0x7b, 0x3c, 0xc3, 0x1d, 0x31, 0x02, 0x36, 0xb0, 0xbf, 0xb1, 0x70, 0x56, 0x31, 0xd9, 0x77,
-	0x0f, 0x9f, 0x8a, 0x63, 0x96, 0x38, 0x8d, 0x7c, 0x20, 0x38, 0x99, 0x4f, 0x26, 0x8e, 0x25, 0xf8,
-	0x55, 0x94, 0x64, 0x77, 0x61, 0x5d, 0x7c, 0x92, 0xf9, 0x76, 0x2e, 0xce, 0x5c, 0xd3, 0x40, 0xf6,
-	0x08, 0xaa, 0x02, 0x30, 0x8c, 0xb8, 0x56, 0x3d, 0x76, 0x8c, 0x25, 0x08, 0x4d, 0xa6, 0x62, 0x3f,
-	0x85, 0xab, 0x52, 0x72, 0xcf, 0xf3, 0x0f, 0xe6, 0x4e, 0x68, 0xb7, 0x7a, 0x42, 0x57, 0x7e, 0x65,
-	0x29, 0x7b, 0x42, 0xa2, 0xad, 0xce, 0x99, 0x6e, 0xed, 0x81, 0xed, 0x0a, 0x4d, 0x22, 0x0d, 0x5c,
-	0xa0, 0x32, 0xce, 0x89, 0xf7, 0xa5, 0xa9, 0x8c, 0x73, 0x5c, 0xe9, 0x02, 0x70, 0x60, 0x85, 0x27,
-	0x9e, 0x49, 0x9a, 0x44, 0xbc, 0xd2, 0x07, 0x32, 0x4a, 0x4b, 0x53, 0xe2, 0x70, 0xa2, 0x19, 0x3f,
-	0x76, 0x43, 0x32, 0x97, 0x72, 0x5a, 0x94, 0x44, 0xb9, 0xe0, 0x1b, 0xee, 0xb1, 0x15, 0x34, 0xaa,
-	0xdb, 0xb9, 0x7b, 0x19, 0x4d, 0xa4, 0xd4, 0xbf, 0x91, 0x85, 0x02, 0x9f, 0xc9, 0x57, 0xa0, 0x32,
-	0x72, 0xbc, 0xf1, 0xa9, 0xee, 0xce, 0xa7, 0x91, 0xef, 0x9c, 0x00, 0xa8, 0x5a, 0x91, 0x99, 0x13,
-	0x70, 0x1f, 0x6b, 0x46, 0xa3, 0x6f, 0x2c, 0xd2, 0x9b, 0x87, 0x58, 0x57, 0x8e, 0xa0, 0x22, 0x85,
-	0x8d, 0xf0, 0xbd, 0x33, 0x5a, 0x0d, 0x79, 0x42, 0x44, 0x49, 0x72, 0xcf, 0x93, 0x88, 0xc1, 0x4c,
-	0x05, 0xc2, 0x95, 0x09, 0xd0, 0x72, 0xc3, 0x45, 0x8f, 0x5e, 0x71, 0xc9, 0xa3, 0xc7, 0x6e, 0x03,
-	0x1a, 0x51, 0x63, 0xab, 0xef, 0x5a, 0xad, 0x1e, 0x8d, 0x70, 0x59, 0x93, 0x20, 0xec, 0xa3, 0x78,
-	0x2d, 0x52, 0x8f, 0x84, 0x2b, 0x55, 0x30, 0x4f, 0x79, 0xd5, 0x6a, 0x29, 0x3a, 0xf5, 0x39, 0x80,
-	0xe6, 0x9d, 0x05, 0x56, 0x48, 0xea, 0xd5, 0x75, 0x6a, 0x7e, 0xea, 0x54, 0xcc, 0x3b, 0x3b, 0xf4,
-	0x82, 0xe8, 0x70, 0x31, 0x1b, 0x1f, 0x2e, 0xc6, 0x9a, 0x58, 0x6e, 0xb5, 0x26, 0xa6, 0xbe, 0x0b,
-	0x25, 0x14, 0xb1, 0x46, 0x68, 0xb0, 0xbb, 0xc2, 0xcb, 0xc8, 0x55, 0x2c, 0xe1, 0xff, 0x4c, 0x6a,
-	0x15, 0x7e, 0xc7, 0x6e, 0xd4, 0x12, 0xca, 0xf3, 0x9a, 0xe4, 0xe5, 0x88, 0x59, 0xb5, 0x28, 0x50,
-	0x08, 0xed, 0x57, 0xa0, 0x82, 0x8d, 0xa5, 0x03, 0x06, 0xd1, 0xb2, 0xb2, 0xef, 0x9d, 0xb5, 0x30,
-	0xad, 0xfe, 0xd7, 0x0c, 0x54, 0xfb, 0xbe, 0x89, 0x32, 0x62, 0x30, 0xb3, 0xc6, 0x2f, 0x55, 0x1c,
-	0x51, 0xc4, 0x7b, 0x8e, 0x63, 0xc4, 0x6a, 0x17, 0x8a, 0xf8, 0x08, 0xc0, 0xde, 0x87, 0xfc, 0xc4,
-	0x31, 0x8e, 0xa9, 0xb3, 0xb1, 0x41, 0x29, 0x15, 0x1f, 0x7d, 0xef, 0x39, 0xc6, 0xb1, 0x46, 0xa4,
-	0xea, 0xef, 0xc4, 0xf5, 0xd3, 0x11, 0x84, 0x7c, 0xf0, 0xb0, 0x46, 0x87, 0x5d, 0x83, 0x96, 0x92,
-	0x61, 0x65, 0xc8, 0xef, 0xb6, 0x07, 0x2d, 0x6e, 0x46, 0xa2, 0x41, 0x39, 0xd0, 0xf7, 0x3a, 0xda,
-	0x60, 0xa8, 0xe4, 0xe9, 0xf4, 0x8c, 0x00, 0xdd, 0xe6, 0x60, 0xa8, 0x94, 0x19, 0x40, 0xf1, 0xa8,
-	0xd7, 0xf9, 0xe9, 0x51, 0x5b, 0x51, 0xd4, 0xff, 0x94, 0x01, 0x48, 0xfc, 0xe3, 0xec, 0x07, 0x50,
-	0x3d, 0xa3, 0x94, 0x2e, 0x1d, 0x9c, 0xc8, 0x7d, 0x04, 0x8e, 0x26, 0xf5, 0xe3, 0x87, 0x92, 0x35,
-	0x81, 0x62, 0x76, 0xf9, 0x04, 0xa5, 0x3a, 0x4b, 0x24, 0x34, 0x7b, 0x07, 0xca, 0x1e, 0xf6, 0x03,
-	0x49, 0x73, 0xb2, 0x8c, 0x95, 0xba, 0xaf, 0x95, 0x3c, 0x9e, 0x40, 0x71, 0x3c, 0xf1, 0x23, 0xaf,
-	0x51, 0x4c, 0xba, 0x87, 0xa0, 0x96, 0x63, 0xcc, 0x03, 0x4b, 0xe3, 0xf8, 0x98, 0xed, 0x16, 0x12,
-	0xb6, 0xab, 0xfe, 0x0c, 0xea, 0x03, 0x63, 0x3a, 0xe3, 0xcc, 0x99, 0x3a, 0xc6, 0x20, 0x8f, 0x6b,
-	0x42, 0x2c, 0x46, 0xfa, 0xc6, 0x2d, 0x76, 0x68, 0xf9, 0x63, 0xcb, 0x8d, 0x76, 0x64, 0x94, 0x44,
-	0x66, 0x7b, 0x14, 0xd8, 0xee, 0xb1, 0xe6, 0x9d, 0x45, 0xe1, 0x2b, 0x51, 0x5a, 0xfd, 0xa7, 0x19,
-	0xa8, 0x4a, 0xcd, 0x60, 0xef, 0xa6, 0x8c, 0xc7, 0x57, 0x96, 0xda, 0xc9, 0xbf, 0x25, 0x23, 0xf2,
-	0x4d, 0x28, 0x04, 0xa1, 0xe1, 0x47, 0x47, 0x2d, 0x8a, 0x94, 0x63, 0xc7, 0x9b, 0xbb, 0xa6, 0xc6,
-	0xd1, 0x4c, 0x85, 0x9c, 0xe5, 0x9a, 0x62, 0x5b, 0x2c, 0x53, 0x21, 0x52, 0xdd, 0x86, 0x4a, 0x5c,
-	0x3c, 0x2e, 0x01, 0xad, 0xff, 0x7c, 0xa0, 0xac, 0xb1, 0x0a, 0x14, 0xb4, 0x66, 0xef, 0x49, 0x5b,
-	0xc9, 0xa8, 0xff, 0x32, 0x03, 0x90, 0xe4, 0x62, 0x0f, 0x52, 0xad, 0xbd, 0xb9, 0x58, 0xea, 0x03,
-	0xfa, 0x2b, 0x35, 0xf6, 0x16, 0x54, 0xe6, 0x2e, 0x01, 0x2d, 0x53, 0xc8, 0x9d, 0x04, 0xc0, 0x6e,
-	0x41, 0x2e, 0x0a, 0x74, 0x59, 0x08, 0x2e, 0x78, 0x61, 0x38, 0xea, 0xa7, 0x50, 0x89, 0x8b, 0x63,
-	0xeb, 0x50, 0xd9, 0xeb, 0x77, 0xbb, 0xfd, 0xe7, 0x9d, 0xde, 0x13, 0x65, 0x0d, 0x93, 0x87, 0x5a,
-	0xbb, 0xd5, 0xde, 0xc5, 0x64, 0x06, 0xd7, 0x6c, 0xeb, 0x48, 0xd3, 0xda, 0xbd, 0xa1, 0xae, 0xf5,
-	0x9f, 0x2b, 0x59, 0xf5, 0x6f, 0xe5, 0x61, 0xb3, 0xef, 0xee, 0xce, 0x67, 0x8e, 0x3d, 0x36, 0x42,
-	0xeb, 0xa9, 0x75, 0xd1, 0x0a, 0xcf, 0x51, 0x9c, 0x1a, 0x61, 0xe8, 0xf3, 0xcd, 0x5c, 0xd1, 0x78,
-	0x82, 0xfb, 0xe2, 0x02, 0xcb, 0x0f, 0xc9, 0xd5, 0x28, 0xef, 0xe2, 0x3a, 0x87, 0xb7, 0x3c, 0x87,
-	0xf6, 0x32, 0xfb, 0x31, 0x5c, 0xe5, 0xfe, 0x3b, 0x4e, 0x89, 0xfa, 0xa5, 0x2e, 0x78, 0xcf, 0xe2,
-	0xd2, 0x65, 0x9c, 0x10, 0xb3, 0x22, 0x19, 0x31, 0xb5, 0x3b, 0x50, 0x4d, 0xb2, 0x73, 0x2b, 0xa0,
-	0xa2, 0x41, 0x4c, 0x48, 0x2d, 0xf1, 0x5c, 0xdd, 0x8c, 0x5a, 0xad, 0xdb, 0xe6, 0x39, 0x59, 0x46,
-	0x05, 0xad, 0xee, 0x25, 0x9d, 0x41, 0x91, 0xfb, 0x05, 0x6c, 0xa6, 0x28, 0xa9, 0x15, 0xdc, 0x36,
-	0x7a, 0x27, 0x72, 0xd5, 0x2f, 0xf4, 0x5e, 0x86, 0x60, 0x73, 0xb8, 0xf2, 0xb7, 0xe1, 0xa5, 0xa1,
-	0xc8, 0xcc, 0xec, 0x40, 0xb7, 0x8f, 0x5d, 0xcf, 0xb7, 0x04, 0x7b, 0x2f, 0xdb, 0x41, 0x87, 0xd2,
-	0x89, 0x79, 0x22, 0x9d, 0x2c, 0x73, 0x69, 0x12, 0x1d, 0xac, 0x72, 0xb4, 0xcd, 0xe5, 0x65, 0x5e,
-	0x2b, 0x51, 0xba, 0x63, 0xa2, 0x65, 0xce, 0x51, 0x91, 0xc5, 0x01, 0x64, 0x71, 0xd4, 0x08, 0xf8,
-	0x8c, 0xc3, 0x6e, 0xf6, 0x60, 0x6b, 0x55, 0x23, 0x57, 0xe8, 0x55, 0xdb, 0xb2, 0x5e, 0xb5, 0xe0,
-	0xab, 0x4a, 0x74, 0xac, 0x7f, 0x9d, 0x85, 0x4a, 0x87, 0x4f, 0x61, 0x78, 0xce, 0x5e, 0x83, 0x9c,
-	0x6f, 0x4d, 0x2e, 0x3b, 0xcd, 0x45, 0x1c, 0xbb, 0x0f, 0x9b, 0x86, 0x69, 0xea, 0xc6, 0x64, 0x62,
-	0x8d, 0x43, 0xcb, 0xd4, 0x51, 0x66, 0x8a, 0x65, 0xbb, 0x61, 0x98, 0x66, 0x53, 0xc0, 0x69, 0xfb,
-	0x73, 0xaf, 0x44, 0x64, 0x26, 0x70, 0xe7, 0x79, 0x2e, 0xf2, 0x4a, 0x08, 0x2b, 0x81, 0x34, 0x3c,
-	0xf6, 0x83, 0x48, 0xe2, 0x9a, 0xd6, 0x44, 0xf0, 0xa3, 0x7a, 0x5a, 0x2d, 0x17, 0x12, 0x98, 0xfb,
-	0xa3, 0xae, 0x2c, 0x1a, 0xb1, 0xb6, 0xc9, 0x1d, 0xdc, 0x79, 0x6d, 0x33, 0x6d, 0xc3, 0x76, 0xcc,
-	0xe0, 0x72, 0x6f, 0x46, 0xf1, 0x52, 0x6f, 0x46, 0xda, 0x4d, 0x82, 0x8b, 0xac, 0x44, 0xcb, 0x3d,
-	0x61, 0xc7, 0x1d, 0xf3, 0x5c, 0xfd, 0x47, 0x39, 0x00, 0xcd, 0x9a, 0x39, 0xc6, 0xd8, 0xfa, 0xff,
-	0x67, 0xf4, 0xee, 0x40, 0x95, 0xbb, 0x6b, 0xf5, 0xb1, 0xe7, 0x9a, 0x51, 0x4c, 0x05, 0x07, 0xb5,
-	0x3c, 0x62, 0x60, 0x2b, 0x87, 0xb7, 0xf8, 0x9d, 0x87, 0xb7, 0xf4, 0x1d, 0x86, 0xb7, 0xbc, 0x3c,
-	0xbc, 0xec, 0x33, 0x78, 0xd5, 0xb7, 0xce, 0x7c, 0x3b, 0xb4, 0xf4, 0x89, 0xef, 0x4d, 0xf5, 0xd4,
-	0x76, 0xc6, 0xd5, 0x5e, 0xa1, 0xd1, 0xb8, 0x21, 0x88, 0xf6, 0x7c, 0x6f, 0x9a, 0xde, 0xd2, 0xea,
-	0xff, 0xce, 0x43, 0xb5, 0xe9, 0x1a, 0xce, 0xc5, 0x37, 0x16, 0xc5, 0x5d, 0x90, 0xa7, 0x7e, 0x36,
-	0x0f, 0xf9, 0xb8, 0xf3, 0xf3, 0xd0, 0x0a, 0x41, 0x68, 0xc4, 0xef, 0x40, 0xd5, 0x9b, 0x87, 0x31,
-	0x9e, 0x9f, 0x90, 0x02, 0x07, 0x11, 0x41, 0x9c, 0x9f, 0xb4, 0xc6, 0x9c, 0x94, 0x9f, 0x2c, 0x88,
-	0x24, 0x7f, 0xac, 0x55, 0xc6, 0xf9, 0x89, 0x00, 0xb7, 0xb8, 0x3d, 0xa5, 0x91, 0x0f, 0xe6, 0x53,
-	0x8b, 0x8f, 0x7e, 0x8e, 0xc7, 0xb7, 0xb5, 0x04, 0x0c, 0x4b, 0x99, 0x5a, 0x53, 0xcf, 0xbf, 0xe0,
-	0xa5, 0x14, 0x79, 0x29, 0x1c, 0x44, 0xa5, 0xbc, 0x03, 0xec, 0xcc, 0xb0, 0x43, 0x3d, 0x5d, 0x14,
-	0xd7, 0xe4, 0x15, 0xc4, 0x0c, 0xe5, 0xe2, 0xae, 0x41, 0xd1, 0xb4, 0x83, 0xd3, 0x4e, 0x5f, 0x68,
-	0xf1, 0x22, 0x85, 0x5c, 0x2c, 0x78, 0xd4, 0xe9, 0xeb, 0xa3, 0x0b, 0x71, 0x84, 0x99, 0xd3, 0xca,
-	0x08, 0xd8, 0xb9, 0x08, 0xe9, 0xf0, 0x85, 0x90, 0xbc, 0xb7, 0x9c, 0xe1, 0x73, 0x4d, 0xbd, 0x8e,
-	0xf0, 0x0e, 0x82, 0x39, 0xc3, 0xbf, 0x0f, 0x9b, 0x44, 0x29, 0x3a, 0xce, 0x49, 0xab, 0x44, 0xba,
-	0x81, 0x88, 0x3e, 0xc1, 0x39, 0xed, 0x2d, 0xa8, 0xb8, 0x56, 0x78, 0xe6, 0xf9, 0xd8, 0x9a, 0x1a,
-	0x1f, 0xbd, 0x18, 0x80, 0x2a, 0x41, 0x30, 0x36, 0x5c, 0x6c, 0x3c, 0x1d, 0x6b, 0x62, 0x7b, 0x44,
-	0x1a, 0x55, 0x6a, 0x2e, 0x68, 0x08, 0x5b, 0xe7, 0x43, 0x92, 0x40, 0xd8, 0x27, 0x70, 0x23, 0x35,
-	0x1a, 0xba, 0xe1, 0xfb, 0xc6, 0x85, 0x3e, 0x35, 0xbe, 0xf2, 0x7c, 0x72, 0x7e, 0xe4, 0xb4, 0x6b,
-	0xf2, 0x20, 0x37, 0x11, 0x7d, 0x80, 0xd8, 0x4b, 0xb3, 0xda, 0xae, 0xe7, 0x37, 0x94, 0xcb, 0xb2,
-	0x22, 0x96, 0x0c, 0x76, 0x1a, 0x20, 0xb2, 0x3f, 0x02, 0x3a, 0x29, 0xcd, 0x69, 0x55, 0x82, 0xed,
-	0x10, 0x48, 0xf5, 0x25, 0x57, 0xf8, 0xa1, 0x3f, 0x77, 0x2d, 0xee, 0x3c, 0xa0, 0x4f, 0x53, 0x9c,
-	0x24, 0xc6, 0x69, 0xb6, 0x0b, 0x57, 0xb8, 0x21, 0x61, 0x99, 0xba, 0xe4, 0x22, 0xce, 0x5e, 0xee,
-	0x22, 0x66, 0x11, 0x7d, 0x0c, 0x0e, 0xd4, 0x9f, 0x67, 0xe0, 0x66, 0x9f, 0x4e, 0x35, 0x69, 0xc7,
-	0x1d, 0x58, 0x41, 0x60, 0x1c, 0xa3, 0x15, 0xb8, 0x37, 0xff, 0xe6, 0x9b, 0x0b, 0x76, 0x0f, 0x36,
-	0x0e, 0x0d, 0xdf, 0x72, 0xc3, 0x78, 0x3f, 0x0a, 0xb1, 0xb1, 0x08, 0x66, 0x8f, 0xc9, 0x0d, 0x6b,
-	0xb9, 0xe1, 0x51, 0x2c, 0x80, 0x45, 0x5b, 0xd2, 0x8e, 0xb9, 0x25, 0x2a, 0xf5, 0x4f, 0x5f, 0x81,
-	0x7c, 0xcf, 0x33, 0x2d, 0xf6, 0x1e, 0x54, 0x28, 0xba, 0x6d, 0xd9, 0xfb, 0x8f, 0x68, 0xfa, 0x43,
-	0xba, 0x50, 0xd9, 0x15, 0x5f, 0x97, 0xc7, 0xc3, 0xbd, 0x46, 0x5a, 0x1d, 0x1d, 0x1f, 0x22, 0x87,
-	0xab, 0x0a, 0x3b, 0x93, 0x0c, 0x25, 0x8e, 0xc1, 0xb1, 0x25, 0x97, 0x98, 0x6f, 0xb9, 0xa4, 0x3b,
-	0x14, 0xb4, 0x38, 0x4d, 0xba, 0xb4, 0xef, 0x21, 0x37, 0xd6, 0x29, 0x54, 0xa4, 0xb0, 0x42, 0x97,
-	0xe6, 0x78, 0x0a, 0x10, 0x7c, 0x0f, 0x2a, 0x5f, 0x79, 0xb6, 0xcb, 0x1b, 0x5e, 0x5c, 0x6a, 0xf8,
-	0xe7, 0x9e, 0xcd, 0x8f, 0x2d, 0xca, 0x5f, 0x89, 0x2f, 0xf6, 0x3a, 0x94, 0x3c, 0x97, 0x97, 0x5d,
-	0x5a, 0x2a, 0xbb, 0xe8, 0xb9, 0x5d, 0x1e, 0x82, 0xb2, 0x3e, 0x9a, 0xdb, 0x8e, 0x89, 0xcc, 0xcc,
-	0xb1, 0x26, 0xa1, 0xf0, 0xd2, 0x57, 0x09, 0xd8, 0x77, 0xbb, 0xd6, 0x24, 0x44, 0x13, 0x61, 0x62,
-	0x3b, 0xc8, 0xf4, 0xa9, 0xb0, 0xca, 0x52, 0x61, 0xc0, 0xd1, 0x54, 0xe0, 0x1b, 0x50, 0x3e, 0xf6,
-	0xbd, 0xf9, 0x0c, 0x75, 0x7e, 0x58, 0xf6, 0x8b, 0x13, 0x6e, 0xe7, 0x02, 0x7b, 0x4f, 0x9f, 0xb6,
-	0x7b, 0xac, 0x07, 0x56, 0x48, 0xd6, 0xf5, 0x42, 0xef, 0x23, 0xfc, 0xc0, 0xa2, 0x52, 0x8d, 0xe3,
-	0x63, 0x5d, 0xc4, 0xd4, 0x2c, 0x95, 0x6a, 0x1c, 0x1f, 0x53, 0xe5, 0x0f, 0x60, 0xfd, 0xcc, 0x76,
-	0xf5, 0x60, 0x66, 0x8d, 0x39, 0xed, 0xfa, 0x72, 0xb1, 0x67, 0xb6, 0x8b, 0xf6, 0x01, 0xd1, 0xcb,
-	0x06, 0x4a, 0xfd, 0xa5, 0x06, 0xca, 0x36, 0x14, 0x1c, 0x7b, 0x6a, 0x87, 0x14, 0xb4, 0xb0, 0xa0,
-	0xc1, 0x10, 0x82, 0xa9, 0x50, 0x14, 0x4e, 0x30, 0x65, 0x89, 0x44, 0x60, 0xd2, 0xc2, 0x71, 0xf3,
-	0x25, 0xc2, 0xf1, 0x1e, 0x94, 0xbc, 0xd1, 0x57, 0x3a, 0x8a, 0x71, 0xb6, 0x5a, 0x8c, 0x17, 0xbd,
-	0xd1, 0x57, 0x9a, 0x35, 0x61, 0x1f, 0xd2, 0x49, 0x81, 0xe5, 0x86, 0x7a, 0x94, 0xe1, 0xca, 0xea,
-	0x0c, 0x35, 0x4e, 0xd6, 0xe7, 0xd9, 0xde, 0x87, 0xaa, 0x4f, 0x96, 0xb3, 0x4e, 0x66, 0xf6, 0x96,
-	0x6c, 0x7a, 0x24, 0x26, 0xb5, 0x06, 0x7e, 0x62, 0x5e, 0xbf, 0x0e, 0xeb, 0x3c, 0xfa, 0x80, 0x1f,
-	0x37, 0x07, 0xe4, 0x6c, 0xad, 0x68, 0x35, 0x02, 0xf2, 0xa3, 0xe8, 0x80, 0x3d, 0x00, 0x88, 0xa4,
-	0x7a, 0x78, 0x4e, 0x91, 0xcb, 0x71, 0x53, 0xf8, 0x69, 0x6b, 0x2b, 0x3c, 0xd7, 0x2a, 0x66, 0xf4,
-	0x89, 0xac, 0x6b, 0x64, 0xbb, 0x26, 0x2e, 0x87, 0xd0, 0x38, 0x0e, 0x1a, 0x0d, 0xda, 0x2d, 0x55,
-	0x01, 0x1b, 0x1a, 0xc7, 0x01, 0xfb, 0x00, 0x6a, 0x06, 0x97, 0x9d, 0x3c, 0xba, 0xf1, 0x86, 0x6c,
-	0x26, 0x4a, 0x52, 0x55, 0xab, 0x1a, 0x92, 0x88, 0xfd, 0x18, 0x58, 0xe4, 0x61, 0x27, 0x95, 0x9b,
-	0xaf, 0x8b, 0x9b, 0x4b, 0xeb, 0x62, 0x43, 0xb8, 0xd8, 0xe3, 0x88, 0xdc, 0x8f, 0x61, 0x3d, 0xad,
-	0xeb, 0xdc, 0x5a, 0xe1, 0x53, 0xa6, 0x29, 0xd3, 0x6a, 0x63, 0x59, 0xfb, 0x79, 0x1d, 0xd6, 0x5d,
-	0x2f, 0xd4, 0xc7, 0xc6, 0xf8, 0xc4, 0xa2, 0x8c, 0xdc, 0x6f, 0x5a, 0x73, 0xbd, 0xb0, 0x15, 0xc1,
-	0x70, 0x7c, 0x22, 0x0b, 0x26, 0x3c, 0x27, 0x97, 0x69, 0x3c, 0x3e, 0xb1, 0xfa, 0x8b, 0xa2, 0x3c,
-	0xd2, 0x84, 0x71, 0x9e, 0xb8, 0x66, 0x47, 0x19, 0xee, 0xa4, 0xe6, 0x29, 0x56, 0xf9, 0x34, 0xf0,
-	0x13, 0xf5, 0xef, 0x0e, 0x54, 0x03, 0x6f, 0xee, 0x8f, 0x2d, 0x3d, 0x08, 0xad, 0x59, 0x63, 0x9b,
-	0x46, 0x14, 0x38, 0x68, 0x10, 0x5a, 0x33, 0xf6, 0x18, 0xea, 0x33, 0xdf, 0xd2, 0xa5, 0x79, 0x7a,
-	0x4d, 0xee, 0xe2, 0xa1, 0x6f, 0x25, 0x53, 0x55, 0x9b, 0x49, 0xa9, 0x28, 0xa7, 0xd4, 0x03, 0x75,
-	0x21, 0x67, 0xd2, 0x09, 0xcc, 0x99, 0x68, 0xf4, 0x3f, 0x81, 0x4d, 0x29, 0xe7, 0xfc, 0x94, 0x32,
-	0xbf, 0x9e, 0x72, 0xf1, 0x47, 0xe4, 0x47, 0xa7, 0x98, 0xbd, 0x3e, 0x4b, 0xa5, 0x59, 0x73, 0xc1,
-	0xe0, 0x42, 0xbd, 0xeb, 0x2e, 0xe5, 0xbf, 0x7e, 0x89, 0x15, 0x95, 0xb2, 0xc4, 0x9e, 0x72, 0x0f,
-	0x6f, 0x27, 0x68, 0xbb, 0x66, 0xe3, 0x0d, 0x1e, 0x36, 0x4f, 0x09, 0xf6, 0x08, 0x6a, 0xe4, 0xc6,
-	0x0b, 0x29, 0x94, 0x2f, 0x68, 0xbc, 0x29, 0x7b, 0x9c, 0xc8, 0x27, 0x4e, 0x08, 0xad, 0xea, 0xc4,
-	0xdf, 0x01, 0xfb, 0x08, 0x36, 0xb9, 0xf3, 0x4f, 0x66, 0x90, 0x6f, 0x2d, 0x2f, 0x2e, 0x22, 0xda,
-	0x4b, 0xb8, 0xa4, 0x06, 0x37, 0xfc, 0xb9, 0x4b, 0x72, 0x5e, 0xe4, 0x9c, 0xf9, 0xde, 0xc8, 0xe2,
-	0xf9, 0xef, 0x51, 0x7e, 0xd1, 0x1d, 0x8d, 0x93, 0xf1, 0xbc, 0xc4, 0x8f, 0xae, 0xf9, 0x32, 0xe8,
-	0x10, 0xf3, 0x5d, 0x52, 0x26, 0xe7, 0xec, 0x54, 0xe6, 0xdb, 0xdf, 0xa5, 0xcc, 0x1d, 0xcc, 0x47,
-	0x65, 0x32, 0xc8, 0xcf, 0xe7, 0xb6, 0xd9, 0xb8, 0xcf, 0x83, 0xfc, 0xf0, 0x9b, 0xbd, 0x01, 0x75,
-	0xdf, 0x1a, 0xcf, 0xfd, 0xc0, 0x7e, 0x61, 0xe9, 0x81, 0xed, 0x9e, 0x36, 0x7e, 0x40, 0xe3, 0xb8,
-	0x1e, 0x43, 0x07, 0xb6, 0x7b, 0x8a, 0x2b, 0xd6, 0x3a, 0x0f, 0x2d, 0xdf, 0xd5, 0x51, 0x6b, 0x6a,
-	0xbc, 0x23, 0xaf, 0xd8, 0x36, 0x21, 0x06, 0x63, 0xc3, 0xd5, 0xc0, 0x8a, 0xbf, 0xd9, 0x8f, 0x61,
-	0x23, 0xd1, 0xc2, 0x67, 0xa8, 0x82, 0x34, 0x7e, 0xb8, 0xf2, 0xf4, 0x87, 0xd4, 0x13, 0x2d, 0x39,
-	0x1a, 0xe5, 0x9a, 0x4c, 0x7a, 0x6d, 0x05, 0x7c, 0x6d, 0x3d, 0xf8, 0x56, 0x6b, 0x6b, 0x40, 0x6b,
-	0xeb, 0x4d, 0x28, 0xdb, 0x6e, 0x68, 0xf9, 0x2f, 0x0c, 0xa7, 0xf1, 0xee, 0x12, 0x03, 0x8f, 0x71,
-	0xec, 0x2e, 0x94, 0x02, 0xc7, 0x46, 0xc6, 0xd4, 0x78, 0x6f, 0x89, 0x2c, 0x42, 0xa1, 0xc4, 0x9e,
-	0xd8, 0x8e, 0xc3, 0x25, 0xf6, 0xfb, 0x4b, 0x12, 0x7b, 0xcf, 0x76, 0x1c, 0x2e, 0xb1, 0x27, 0xe2,
-	0x0b, 0xa5, 0x1c, 0xe5, 0xc0, 0xfa, 0x1f, 0x2e, 0x4b, 0x39, 0xc4, 0x3d, 0xa3, 0x7b, 0x30, 0xd5,
-	0x80, 0x7c, 0x5d, 0xdc, 0x65, 0xf7, 0x48, 0xee, 0x61, 0xda, 0x09, 0xa6, 0x41, 0x10, 0xa7, 0xd1,
-	0x58, 0x10, 0x9e, 0x3e, 0x34, 0x70, 0x3e, 0xe0, 0xe1, 0xd9, 0x1c, 0x82, 0xd6, 0xcd, 0x7b, 0xb0,
-	0x1e, 0x45, 0xb3, 0x60, 0x75, 0x41, 0xe3, 0xc3, 0xa5, 0x16, 0xa4, 0x09, 0xd8, 0x2e, 0xd4, 0x26,
-	0xa8, 0xc1, 0x4d, 0xb9, 0x42, 0xd7, 0xf8, 0x88, 0x1a, 0xb2, 0x1d, 0x49, 0xd0, 0xcb, 0x14, 0x3e,
-	0x2d, 0x95, 0x8b, 0x3d, 0x82, 0xf5, 0xc0, 0x72, 0x4d, 0x7d, 0x1a, 0x08, 0xf9, 0xfe, 0x31, 0xd5,
-	0x2b, 0x98, 0x61, 0x7c, 0xab, 0x4b, 0xab, 0x22, 0xd5, 0x41, 0xc0, 0x05, 0xfd, 0x23, 0xc0, 0xd5,
-	0xf6, 0x22, 0xc9, 0xf4, 0xf8, 0x92, 0x4c, 0x48, 0x25, 0x65, 0xc2, 0xa5, 0xa8, 0x07, 0xae, 0x31,
-	0x0b, 0x4e, 0xbc, 0xb0, 0xf1, 0x89, 0x2c, 0x7d, 0x07, 0x02, 0xaa, 0xd5, 0x90, 0x28, 0x4a, 0xa9,
-	0xbf, 0x28, 0x40, 0x39, 0xd2, 0x0a, 0x59, 0x15, 0x4a, 0x47, 0xbd, 0xa7, 0xbd, 0xfe, 0xf3, 0x9e,
-	0xb2, 0xc6, 0xea, 0x00, 0x14, 0x9a, 0xad, 0x0f, 0x5a, 0xcd, 0x1e, 0xbf, 0xb2, 0x40, 0x01, 0xe1,
-	0x3c, 0x9d, 0x65, 0x9b, 0xb0, 0xbe, 0x77, 0xd4, 0xa3, 0x50, 0x1e, 0x0e, 0xca, 0x21, 0xa8, 0xfd,
-	0x05, 0xf7, 0xe4, 0x72, 0x50, 0x1e, 0x41, 0x07, 0xcd, 0x61, 0x5b, 0xeb, 0x44, 0xa0, 0x02, 0x45,
-	0x05, 0xf5, 0x8f, 0xb4, 0x96, 0x28, 0xa9, 0x88, 0xd5, 0x1e, 0x6a, 0xfd, 0xcf, 0xdb, 0xad, 0xa1,
-	0x02, 0xec, 0x2a, 0x6c, 0xc6, 0x65, 0x44, 0xe5, 0x2b, 0x55, 0x56, 0x83, 0x72, 0x54, 0x8e, 0xb2,
-	0x85, 0xa5, 0x6a, 0xed, 0xd6, 0x91, 0x36, 0xe8, 0x3c, 0x6b, 0xeb, 0xad, 0x61, 0x5b, 0xb9, 0xca,
-	0xca, 0x90, 0x1f, 0x74, 0x7a, 0x4f, 0x95, 0x6b, 0x6c, 0x1d, 0x2a, 0xf8, 0xc5, 0x4b, 0xbf, 0xce,
-	0x18, 0xd4, 0x13, 0x5a, 0x82, 0x35, 0xc8, 0xc9, 0xfc, 0xe4, 0x89, 0x72, 0x1b, 0x8b, 0xdd, 0xed,
-	0x0c, 0x86, 0x9d, 0x5e, 0x6b, 0xa8, 0xdc, 0x61, 0x00, 0xc5, 0xbd, 0x4e, 0x77, 0xd8, 0xd6, 0x94,
-	0x6d, 0x2c, 0xef, 0xf3, 0x7e, 0xa7, 0xa7, 0xbc, 0x46, 0x01, 0xef, 0xcd, 0x83, 0xc3, 0x6e, 0x5b,
-	0x51, 0xa9, 0x96, 0xbe, 0x36, 0x54, 0x5e, 0x67, 0x15, 0x28, 0x1c, 0xf5, 0xb0, 0x6d, 0x77, 0xb1,
-	0x42, 0xfa, 0xd4, 0x9b, 0xdd, 0xae, 0xf2, 0x86, 0xe4, 0x8d, 0x7e, 0x13, 0xbf, 0x9f, 0x77, 0x7a,
-	0xbb, 0xfd, 0xe7, 0xca, 0x5b, 0x48, 0xb6, 0xa3, 0xf5, 0x9b, 0xbb, 0xad, 0xe6, 0x60, 0xa8, 0xdc,
-	0xc3, 0x02, 0x06, 0x87, 0xdd, 0xce, 0x50, 0x79, 0x1b, 0xa9, 0x9e, 0x34, 0x87, 0xfb, 0x6d, 0x4d,
-	0xb9, 0x8f, 0xdf, 0xcd, 0xc1, 0xa0, 0xad, 0x0d, 0x95, 0x87, 0xf8, 0xdd, 0xe9, 0xd1, 0xf7, 0x23,
-	0xfc, 0xde, 0x6d, 0x77, 0xdb, 0xc3, 0xb6, 0xf2, 0x01, 0x0e, 0x98, 0xd6, 0x3e, 0xec, 0x36, 0x5b,
-	0x6d, 0xe5, 0x43, 0x4c, 0x74, 0xfb, 0xad, 0xa7, 0x7a, 0xff, 0x50, 0xf9, 0x08, 0xeb, 0x20, 0x5f,
-	0xfa, 0x00, 0x07, 0xf3, 0x63, 0x1c, 0xa7, 0x38, 0x49, 0xad, 0x7b, 0x8c, 0xd5, 0x1e, 0x74, 0x7a,
-	0x47, 0x03, 0xe5, 0x13, 0x24, 0xa6, 0x4f, 0xc2, 0x7c, 0xca, 0xb6, 0x40, 0xe9, 0xf7, 0xf4, 0xdd,
-	0xa3, 0xc3, 0x6e, 0xa7, 0xd5, 0x1c, 0xb6, 0xf5, 0xa7, 0xed, 0x2f, 0x95, 0xdf, 0xc2, 0x69, 0x3f,
-	0xd4, 0xda, 0xba, 0x68, 0xc7, 0x8f, 0xa2, 0xb4, 0x68, 0xcb, 0x8f, 0xb1, 0x8a, 0x04, 0xaf, 0x1f,
-	0x3d, 0x55, 0x7e, 0x7b, 0x01, 0x34, 0x78, 0xaa, 0xfc, 0x04, 0xe7, 0x7c, 0xd8, 0x39, 0x68, 0xeb,
-	0x62, 0x30, 0x3e, 0xc3, 0x81, 0xdc, 0xeb, 0x74, 0xbb, 0x4a, 0x93, 0x1c, 0xa7, 0x4d, 0x6d, 0xd8,
-	0xa1, 0x89, 0xde, 0x61, 0x0a, 0xd4, 0xf6, 0x8e, 0x7e, 0xf6, 0xb3, 0x2f, 0x75, 0x31, 0x13, 0x2d,
-	0xf5, 0x77, 0xa1, 0x1c, 0xa9, 0xff, 0xd8, 0xfa, 0x4e, 0xaf, 0xd7, 0xd6, 0x94, 0x35, 0x2c, 0xa1,
-	0xdb, 0xde, 0x1b, 0x2a, 0x19, 0x72, 0x0e, 0x77, 0x9e, 0xec, 0x0f, 0x95, 0x2c, 0x7e, 0xf6, 0x8f,
-	0x30, 0x5b, 0x8e, 0xa6, 0xaa, 0x7d, 0xd0, 0x51, 0xf2, 0xf8, 0xd5, 0xec, 0x0d, 0x3b, 0x4a, 0x81,
-	0xa6, 0xb2, 0xd3, 0x7b, 0xd2, 0x6d, 0x2b, 0x45, 0x84, 0x1e, 0x34, 0xb5, 0xa7, 0x4a, 0x09, 0x33,
-	0x35, 0x0f, 0x0f, 0xbb, 0x5f, 0x2a, 0x65, 0x5e, 0xfe, 0x6e, 0xfb, 0x0b, 0xa5, 0xc2, 0x8a, 0x90,
-	0xed, 0x3e, 0x54, 0x40, 0xbd, 0x07, 0xa5, 0xe6, 0xf1, 0xf1, 0x01, 0x5a, 0x57, 0xd8, 0xe8, 0xa3,
-	0x6e, 0x97, 0xdf, 0xe5, 0xd9, 0xe9, 0x0f, 0x87, 0xfd, 0x03, 0x25, 0x83, 0x8b, 0x69, 0xd8, 0x3f,
-	0x54, 0xb2, 0x6a, 0x07, 0xca, 0x11, 0xd7, 0x93, 0xee, 0x55, 0x94, 0x21, 0x7f, 0xa8, 0xb5, 0x9f,
-	0xf1, 0x13, 0x8d, 0x5e, 0xfb, 0x0b, 0x6c, 0x26, 0x7e, 0x61, 0x41, 0x39, 0xac, 0x90, 0x5f, 0x80,
-	0xa0, 0x8b, 0x15, 0xdd, 0x4e, 0xaf, 0xdd, 0xd4, 0x94, 0x82, 0xfa, 0xd7, 0xa1, 0x1c, 0x6d, 0x51,
-	0x76, 0x17, 0xb2, 0xc3, 0x81, 0x70, 0x73, 0x6d, 0x3d, 0x48, 0x2e, 0xb5, 0x0e, 0xa3, 0x2f, 0x2d,
-	0x3b, 0x1c, 0xb0, 0x77, 0xa0, 0xc8, 0xaf, 0xb4, 0x08, 0x07, 0xe4, 0x56, 0x7a, 0xdb, 0x0f, 0x09,
-	0xa7, 0x09, 0x1a, 0xb5, 0x0b, 0xf5, 0x34, 0x06, 0x4d, 0x7e, 0x8e, 0x93, 0x2c, 0x54, 0x09, 0x82,
-	0xb6, 0x9e, 0xb8, 0x32, 0xb3, 0x2b, 0x62, 0x6f, 0xe2, 0xb4, 0xfa, 0x3f, 0x33, 0x00, 0x89, 0xcc,
-	0x43, 0xa9, 0x1a, 0xdb, 0x9f, 0x05, 0xe1, 0x76, 0x97, 0xc3, 0xe9, 0x2b, 0xfc, 0x58, 0x8b, 0x5d,
-	0x83, 0xe2, 0xc4, 0xf3, 0xa7, 0x46, 0x18, 0x5d, 0x98, 0xe1, 0x29, 0xd4, 0x30, 0xb9, 0xb7, 0x17,
-	0x85, 0xbb, 0x6b, 0xf1, 0xa8, 0xb0, 0xbc, 0x56, 0x13, 0xc0, 0x2e, 0xc2, 0x50, 0xfd, 0xb3, 0xdc,
-	0xb1, 0xe3, 0x05, 0x96, 0x89, 0xe6, 0x4d, 0x81, 0x24, 0x38, 0x44, 0xa0, 0x9d, 0x0b, 0xde, 0x21,
-	0x7f, 0x6a, 0xbb, 0x46, 0x68, 0x99, 0x22, 0x34, 0x45, 0x82, 0xb0, 0x57, 0xa0, 0xf2, 0x55, 0xe0,
-	0x09, 0x8b, 0x93, 0x07, 0xe4, 0x94, 0x11, 0x40, 0xd3, 0xf7, 0x2a, 0x80, 0x15, 0x8c, 0x8d, 0x19,
-	0x2f, 0xbc, 0x4c, 0x85, 0x57, 0x04, 0x64, 0xe7, 0x42, 0xfd, 0xe7, 0x39, 0x80, 0x44, 0x67, 0x4a,
-	0x79, 0x99, 0x33, 0x69, 0x2f, 0xf3, 0x43, 0xb8, 0x26, 0x82, 0xc5, 0x45, 0x04, 0xf2, 0xb9, 0x6e,
-	0xbb, 0xfa, 0xc8, 0x88, 0x1c, 0xfa, 0x4c, 0x60, 0xf9, 0xc1, 0x75, 0xc7, 0xdd, 0x31, 0x42, 0xf6,
-	0x18, 0x36, 0xe4, 0x3c, 0xe1, 0xc5, 0x2c, 0x7d, 0x20, 0x21, 0xc5, 0xde, 0xaf, 0x27, 0xd9, 0x87,
-	0x17, 0x33, 0xf6, 0x1e, 0x5c, 0xf5, 0xad, 0x89, 0x6f, 0x05, 0x27, 0x7a, 0x18, 0xc8, 0x95, 0xf1,
-	0x53, 0xf2, 0x4d, 0x81, 0x1c, 0x06, 0x71, 0x5d, 0xef, 0xc1, 0x55, 0xa1, 0x4d, 0x2d, 0x34, 0x8f,
-	0x5f, 0x68, 0xdb, 0xe4, 0x48, 0xb9, 0x75, 0xaf, 0x02, 0x08, 0x45, 0x32, 0xba, 0xc6, 0x5c, 0xd6,
-	0x2a, 0x5c, 0x69, 0x44, 0xcd, 0xff, 0x1d, 0x60, 0x76, 0xa0, 0x2f, 0x78, 0x28, 0x85, 0xdb, 0x5e,
-	0xb1, 0x83, 0xc3, 0x94, 0x77, 0xf2, 0x32, 0xe7, 0x67, 0xf9, 0x32, 0xe7, 0xe7, 0x16, 0x14, 0x48,
-	0xd7, 0x14, 0xbe, 0x48, 0x9e, 0x60, 0x2a, 0xe4, 0x71, 0x7f, 0x92, 0xcb, 0xac, 0xfe, 0xb0, 0xfe,
-	0x80, 0x2e, 0x81, 0xe3, 0xfc, 0x20, 0x54, 0x23, 0x9c, 0xfa, 0x07, 0x19, 0xa8, 0xa7, 0xf5, 0x23,
-	0x1e, 0x09, 0x96, 0x84, 0xb8, 0x15, 0x92, 0xb0, 0xb6, 0x57, 0xa0, 0x32, 0x3b, 0x15, 0xf1, 0x6c,
-	0xd1, 0xf9, 0xe9, 0xec, 0x94, 0xc7, 0xb1, 0xb1, 0xb7, 0xa1, 0x34, 0x3b, 0xe5, 0xcb, 0xe6, 0xb2,
-	0x69, 0x29, 0xce, 0x78, 0x88, 0xc9, 0xdb, 0x50, 0x9a, 0x0b, 0xd2, 0xfc, 0x65, 0xa4, 0x73, 0x22,
-	0x55, 0xb7, 0xa1, 0x26, 0x5b, 0x24, 0x4c, 0x81, 0x1c, 0xea, 0x31, 0xbc, 0x61, 0xf8, 0x89, 0x3d,
-	0xa8, 0xc9, 0xa6, 0xc7, 0xb7, 0x71, 0x80, 0xa7, 0xac, 0xf1, 0xec, 0x4b, 0xac, 0xf1, 0x6d, 0x3a,
-	0x28, 0xd7, 0x29, 0xe2, 0x65, 0xec, 0x45, 0x97, 0x5c, 0xe1, 0xc4, 0x08, 0x9a, 0xf3, 0xd0, 0x6b,
-	0x79, 0x8e, 0x38, 0x8a, 0x11, 0x21, 0xc4, 0xf9, 0xc8, 0x9b, 0x26, 0x62, 0x84, 0xff, 0x49, 0x06,
-	0x36, 0x97, 0x54, 0x6f, 0xec, 0x47, 0x72, 0x53, 0x1d, 0x3f, 0xd1, 0x16, 0x9e, 0x1a, 0xe1, 0xf8,
-	0x44, 0x9f, 0xf9, 0xd6, 0xc4, 0x3e, 0x8f, 0xae, 0xdb, 0x13, 0xec, 0x90, 0x40, 0x74, 0x2e, 0x35,
-	0x9b, 0x91, 0xc1, 0x31, 0xb5, 0x43, 0x71, 0xad, 0x14, 0x08, 0xd4, 0x25, 0x4f, 0x44, 0x74, 0x66,
-	0x9d, 0xbf, 0xe4, 0xcc, 0xba, 0x01, 0xa5, 0x13, 0xc3, 0x35, 0x1d, 0xe1, 0xf3, 0x2d, 0x6b, 0x51,
-	0x52, 0xbd, 0x05, 0xc5, 0x4e, 0xac, 0xfc, 0xc7, 0x77, 0x52, 0x73, 0xe2, 0x1e, 0xaa, 0x07, 0x95,
-	0x16, 0xdd, 0x69, 0x3d, 0x30, 0x66, 0xec, 0x3e, 0xe4, 0xa6, 0xc6, 0x4c, 0x9c, 0xb3, 0x37, 0x62,
-	0x17, 0x1c, 0xc7, 0x3e, 0x38, 0x30, 0x66, 0xfc, 0xc0, 0x0a, 0x89, 0x6e, 0x7e, 0x04, 0xe5, 0x08,
-	0xf0, 0x9d, 0x82, 0x6e, 0xfe, 0x5b, 0x0e, 0x2a, 0xbb, 0xb2, 0x9b, 0x00, 0x35, 0xb8, 0xd0, 0x9f,
-	0xbb, 0x68, 0xcd, 0x09, 0x87, 0x65, 0x75, 0x6c, 0xb8, 0x43, 0x01, 0x8a, 0x26, 0x3d, 0xfb, 0x2b,
-	0x26, 0xfd, 0x16, 0x80, 0x4f, 0x6a, 0x30, 0x69, 0xc2, 0xb9, 0xf8, 0xf8, 0xbf, 0x63, 0xa2, 0x22,
-	0xbc, 0xf2, 0x4c, 0x24, 0xff, 0xed, 0xcf, 0x44, 0x0a, 0x2b, 0xcf, 0x44, 0xfe, 0x9f, 0x39, 0xc5,
-	0x78, 0x33, 0x61, 0x9e, 0xa7, 0xd6, 0x05, 0x91, 0x55, 0x78, 0x88, 0xd0, 0x2c, 0x8e, 0x16, 0x44,
-	0xba, 0x4f, 0xa1, 0x1e, 0x0d, 0xb3, 0xe8, 0x18, 0xa4, 0x02, 0x14, 0x05, 0x8e, 0x7b, 0x40, 0xd6,
-	0x43, 0x39, 0x99, 0xde, 0x55, 0xd5, 0x5f, 0xbd, 0xab, 0xd4, 0x3f, 0xcb, 0x42, 0xe1, 0xa7, 0x73,
-	0xcb, 0xbf, 0x60, 0x1f, 0x41, 0x25, 0x08, 0xa7, 0xa1, 0xec, 0x9c, 0xbd, 0xc1, 0xb3, 0x11, 0x9e,
-	0x7c, 0xab, 0xd6, 0xd4, 0x72, 0x43, 0x6e, 0x37, 0x21, 0x2d, 0x71, 0x91, 0x2d, 0x28, 0x04, 0xa1,
-	0x35, 0xe3, 0xce, 0xe0, 0x82, 0xc6, 0x13, 0x6c, 0x1b, 0x0a, 0xae, 0x67, 0x5a, 0x41, 0xfa, 0xa8,
-	0x17, 0x75, 0x79, 0x8d, 0x23, 0x98, 0x0a, 0x45, 0x71, 0x97, 0x21, 0xbf, 0xec, 0x20, 0xe5, 0x18,
-	0x8a, 0xc2, 0xb2, 0x0c, 0x34, 0xe8, 0xa2, 0x2b, 0x2b, 0x71, 0x1a, 0x37, 0x91, 0xe3, 0x19, 0xe6,
-	0xd0, 0x38, 0x8e, 0xae, 0x74, 0x89, 0x24, 0xca, 0x56, 0xd3, 0x0a, 0xad, 0x71, 0x38, 0xf8, 0xda,
-	0x89, 0xa6, 0x4c, 0x82, 0xa8, 0x26, 0xac, 0xa7, 0x3a, 0x93, 0xb6, 0x2c, 0x50, 0x0b, 0x6b, 0x77,
-	0x51, 0x43, 0xcd, 0x48, 0x2a, 0x6e, 0x56, 0x56, 0x6b, 0x73, 0x92, 0xbe, 0x4b, 0x9a, 0xd1, 0xd1,
-	0xe1, 0x6e, 0x73, 0xd8, 0x56, 0x0a, 0xa4, 0xbf, 0xb6, 0xb5, 0x27, 0x6d, 0xa5, 0xa8, 0xfe, 0x61,
-	0x16, 0x36, 0x87, 0xbe, 0xe1, 0x06, 0x06, 0x8f, 0x32, 0x76, 0x43, 0xdf, 0x73, 0xd8, 0xa7, 0x50,
-	0x0e, 0xc7, 0x8e, 0x3c, 0xc8, 0x77, 0xa2, 0x29, 0x5d, 0x20, 0x7d, 0x30, 0x1c, 0x73, 0x13, 0xb5,
-	0x14, 0xf2, 0x0f, 0xf6, 0x43, 0x28, 0x8c, 0xac, 0x63, 0xdb, 0x15, 0xdb, 0xeb, 0xea, 0x62, 0xc6,
-	0x1d, 0x44, 0xee, 0xaf, 0x69, 0x9c, 0x8a, 0xbd, 0x07, 0xc5, 0xb1, 0x37, 0x8d, 0x38, 0x54, 0x12,
-	0x10, 0x29, 0x55, 0x84, 0xd8, 0xfd, 0x35, 0x4d, 0xd0, 0xb1, 0x8f, 0xa0, 0xec, 0x7b, 0x8e, 0x33,
-	0x32, 0xc6, 0xa7, 0x82, 0x77, 0x35, 0x16, 0xf3, 0x68, 0x02, 0xbf, 0xbf, 0xa6, 0xc5, 0xb4, 0xea,
-	0x03, 0x28, 0x89, 0xc6, 0xe2, 0x00, 0xec, 0xb4, 0x9f, 0x74, 0xc4, 0x40, 0xb6, 0xfa, 0x07, 0x07,
-	0x9d, 0x21, 0xbf, 0x79, 0xa1, 0xf5, 0xbb, 0xdd, 0x9d, 0x66, 0xeb, 0xa9, 0x92, 0xdd, 0x29, 0x43,
-	0xd1, 0xa0, 0x20, 0x3e, 0xf5, 0x6f, 0x67, 0x60, 0x63, 0xa1, 0x03, 0xec, 0x31, 0xe4, 0xa7, 0x28,
-	0x2f, 0xf9, 0xf0, 0xdc, 0x5d, 0xd9, 0x4b, 0x29, 0xcd, 0xa5, 0x28, 0xe6, 0x50, 0x3f, 0x81, 0x7a,
-	0x1a, 0x2e, 0x29, 0xba, 0xeb, 0x50, 0xd1, 0xda, 0xcd, 0x5d, 0xbd, 0xdf, 0xeb, 0x7e, 0xc9, 0xed,
-	0x45, 0x4a, 0x3e, 0xd7, 0x3a, 0xc3, 0xb6, 0x92, 0x55, 0x7f, 0x07, 0x94, 0xc5, 0x81, 0x61, 0x4f,
-	0x60, 0x63, 0xec, 0xa1, 0x05, 0xcf, 0xd9, 0x40, 0x32, 0x65, 0xb7, 0x57, 0x8c, 0xa4, 0x20, 0xa3,
-	0x19, 0xab, 0x8f, 0x53, 0x69, 0xf5, 0xaf, 0x01, 0x5b, 0x1e, 0xc1, 0xdf, 0x5c, 0xf1, 0xff, 0x2b,
-	0x03, 0xf9, 0x43, 0xc7, 0x70, 0xd9, 0xeb, 0x50, 0xa0, 0x5b, 0xb5, 0x42, 0xe8, 0x56, 0xa5, 0xed,
-	0x8b, 0xcb, 0x82, 0x70, 0xec, 0x07, 0x90, 0x0b, 0xc7, 0xd1, 0x2d, 0x93, 0xeb, 0x97, 0x2c, 0xbe,
-	0xfd, 0x35, 0x0d, 0xa9, 0xd8, 0x3d, 0xc8, 0x99, 0x66, 0x14, 0x71, 0x22, 0x94, 0xf6, 0x5d, 0x23,
-	0x34, 0x76, 0xad, 0x89, 0xed, 0xda, 0xe2, 0x16, 0x30, 0x92, 0xb0, 0x37, 0x20, 0x67, 0x8e, 0x9d,
-	0x74, 0xf8, 0x10, 0x52, 0x4a, 0x05, 0x9a, 0x63, 0x87, 0xa9, 0xb0, 0x1e, 0xfa, 0x17, 0xba, 0x3f,
-	0x77, 0xe9, 0xc4, 0x32, 0x10, 0x9a, 0x5c, 0x15, 0x45, 0xd5, 0x9c, 0x8e, 0xf7, 0x02, 0x11, 0xad,
-	0x3a, 0xf3, 0xad, 0x99, 0xe1, 0xc7, 0x3a, 0x9c, 0x1d, 0x1c, 0x72, 0xc0, 0x4e, 0x11, 0xe8, 0xf1,
-	0x1d, 0xf5, 0x1d, 0xba, 0x61, 0x8a, 0x3a, 0x8f, 0x1a, 0x7d, 0xad, 0xb8, 0x0c, 0x20, 0x30, 0xea,
-	0x9f, 0xe7, 0xa0, 0x2a, 0xb5, 0x87, 0x7d, 0x00, 0x65, 0x33, 0xbd, 0x11, 0x6f, 0x2c, 0x35, 0xfa,
-	0xc1, 0x6e, 0xb4, 0x05, 0x4d, 0xb1, 0xbc, 0x3f, 0x81, 0xf5, 0xc0, 0x0a, 0xf5, 0x17, 0x86, 0x6f,
-	0x23, 0x07, 0x0d, 0xc4, 0x30, 0x0a, 0xd7, 0xeb, 0xc0, 0x0a, 0x9f, 0x45, 0x98, 0xfd, 0x35, 0xad,
-	0x16, 0x48, 0x69, 0x52, 0xcc, 0x44, 0x97, 0x72, 0xa9, 0xa7, 0x01, 0x38, 0x70, 0x7f, 0x4d, 0x8b,
-	0xf0, 0x48, 0x6a, 0x9d, 0x5b, 0xe3, 0x79, 0x18, 0x29, 0x66, 0xeb, 0x51, 0x87, 0x08, 0x48, 0xaf,
-	0x90, 0xf0, 0x4f, 0xf6, 0x10, 0x79, 0x9d, 0xe1, 0x38, 0x1e, 0x49, 0xe4, 0x82, 0xec, 0xe7, 0xdb,
-	0x8d, 0xe1, 0xfc, 0xd5, 0x93, 0x28, 0xc5, 0xde, 0x84, 0x82, 0x17, 0x9e, 0x58, 0xbe, 0xb8, 0xe7,
-	0x17, 0xdd, 0x55, 0x45, 0xd0, 0x6e, 0xab, 0x8b, 0x2b, 0x85, 0xd0, 0xea, 0x2f, 0x32, 0x50, 0x12,
-	0x23, 0x80, 0x56, 0xf3, 0xa0, 0x3d, 0xd4, 0x9f, 0x35, 0xb5, 0x4e, 0x73, 0xa7, 0xdb, 0x16, 0x51,
-	0x4f, 0x4f, 0xb4, 0x66, 0x4f, 0xf0, 0x49, 0xad, 0xfd, 0xac, 0xff, 0xb4, 0xcd, 0xad, 0xc7, 0xdd,
-	0x76, 0xef, 0x4b, 0x25, 0xc7, 0x3d, 0x27, 0xed, 0xc3, 0xa6, 0x86, 0x5c, 0xb2, 0x0a, 0xa5, 0xf6,
-	0x17, 0xed, 0xd6, 0x11, 0xb1, 0xc9, 0x3a, 0xc0, 0x6e, 0xbb, 0xd9, 0xed, 0xf6, 0xd1, 0x94, 0x57,
-	0x8a, 0x8c, 0x41, 0xbd, 0xa5, 0xb5, 0xd1, 0xac, 0x6f, 0xb6, 0x5a, 0xfd, 0xa3, 0xde, 0x50, 0x29,
-	0x61, 0x8d, 0x4d, 0xb4, 0xb1, 0x63, 0x10, 0x5d, 0xe8, 0xdf, 0xd5, 0xfa, 0x87, 0x31, 0xa4, 0xb2,
-	0x53, 0x41, 0x25, 0x99, 0xe6, 0x4a, 0xfd, 0x83, 0x3a, 0xd4, 0xd3, 0x4b, 0x93, 0x7d, 0x0c, 0x65,
-	0xd3, 0x4c, 0xcd, 0xf1, 0xad, 0x55, 0x4b, 0xf8, 0xc1, 0xae, 0x19, 0x4d, 0x33, 0xff, 0x60, 0xaf,
-	0x45, 0x1b, 0x29, 0xbb, 0xb4, 0x91, 0xa2, 0x6d, 0xf4, 0x13, 0xd8, 0x10, 0xb7, 0x41, 0xd1, 0x5a,
-	0x1c, 0x19, 0x81, 0x95, 0xde, 0x25, 0x2d, 0x42, 0xee, 0x0a, 0xdc, 0xfe, 0x9a, 0x56, 0x1f, 0xa7,
-	0x20, 0xec, 0x47, 0x50, 0x37, 0xc8, 0xb4, 0x89, 0xf3, 0xe7, 0x65, 0x11, 0xdf, 0x44, 0x9c, 0x94,
-	0x7d, 0xdd, 0x90, 0x01, 0xb8, 0x10, 0x4d, 0xdf, 0x9b, 0x25, 0x99, 0x0b, 0xf2, 0x42, 0xdc, 0xf5,
-	0xbd, 0x99, 0x94, 0xb7, 0x66, 0x4a, 0x69, 0xf6, 0x11, 0xd4, 0x44, 0xcb, 0x13, 0x23, 0x29, 0xde,
-	0xb2, 0xbc, 0xd9, 0xa4, 0x28, 0xec, 0xaf, 0x69, 0xd5, 0x71, 0x92, 0x64, 0x8f, 0xa0, 0xca, 0x1b,
-	0x9c, 0x18, 0x4d, 0xf1, 0x5a, 0xa3, 0xd6, 0x46, 0xb9, 0xc0, 0x88, 0x53, 0xec, 0x3d, 0x00, 0x6a,
-	0x27, 0xcf, 0x53, 0x4e, 0x1d, 0x45, 0xf9, 0xde, 0x2c, 0xca, 0x52, 0x31, 0xa3, 0x84, 0xd4, 0x3c,
-	0x1e, 0x97, 0x5f, 0x59, 0x6e, 0x1e, 0x85, 0x90, 0x27, 0xcd, 0xe3, 0x71, 0xf8, 0x71, 0xf3, 0x78,
-	0x36, 0x58, 0x6a, 0x5e, 0x94, 0x8b, 0x37, 0x8f, 0x67, 0x8a, 0x9a, 0xc7, 0xf3, 0x54, 0x17, 0x9b,
-	0x17, 0x65, 0xa1, 0xe6, 0xf1, 0x1c, 0x3f, 0x5a, 0xd2, 0xcc, 0x6a, 0x97, 0x6a, 0x66, 0x38, 0x6d,
-	0x69, 0xdd, 0xec, 0x47, 0x50, 0x0f, 0x4e, 0xbc, 0x33, 0x89, 0x81, 0xac, 0xcb, 0xb9, 0x07, 0x27,
-	0xde, 0x99, 0xcc, 0x41, 0xd6, 0x03, 0x19, 0x80, 0xad, 0xe5, 0x5d, 0xa4, 0x9b, 0x37, 0x75, 0xb9,
-	0xb5, 0xd4, 0xc3, 0x67, 0xb6, 0x75, 0x86, 0xad, 0x35, 0xa2, 0x04, 0x0e, 0x4a, 0x62, 0x0e, 0x07,
-	0xe2, 0xec, 0x34, 0x75, 0xac, 0x22, 0x6a, 0x82, 0xd8, 0x46, 0x0e, 0x70, 0x6d, 0xcd, 0x5d, 0x39,
-	0x9b, 0x22, 0xaf, 0xad, 0x23, 0x37, 0x95, 0xb1, 0xc6, 0x49, 0x45, 0xd6, 0x64, 0x57, 0x04, 0xd6,
-	0xd7, 0x73, 0xcb, 0x1d, 0x5b, 0xe2, 0x94, 0x35, 0xb5, 0x2b, 0x06, 0x02, 0x97, 0xec, 0x8a, 0x08,
-	0x12, 0xaf, 0xeb, 0x38, 0x3b, 0x5b, 0x5c, 0xd7, 0x52, 0x66, 0x5a, 0xd7, 0x71, 0xd6, 0x78, 0x43,
-	0xc5, 0x79, 0xaf, 0x2c, 0x6d, 0x28, 0x29, 0x33, 0xdf, 0x50, 0x71, 0xee, 0x47, 0x20, 0x56, 0x13,
-	0x1f, 0xdc, 0xd4, 0x59, 0x2c, 0x6f, 0xb5, 0x18, 0x5d, 0x18, 0xc7, 0x29, 0xf5, 0x1f, 0x14, 0xa0,
-	0x24, 0x98, 0x07, 0xbb, 0x02, 0x1b, 0x82, 0x87, 0xed, 0x36, 0x87, 0xcd, 0x9d, 0xe6, 0x00, 0xb5,
-	0x0e, 0x06, 0x75, 0xce, 0xc4, 0x62, 0x58, 0x06, 0x19, 0x1b, 0x71, 0xb1, 0x18, 0x94, 0x45, 0xc6,
-	0x26, 0xf2, 0xf2, 0x57, 0x4d, 0x72, 0x6c, 0x03, 0xaa, 0x3c, 0x23, 0x07, 0x50, 0x54, 0x31, 0xe5,
-	0xe2, 0xe9, 0x82, 0x94, 0x85, 0xbb, 0x04, 0x8b, 0x49, 0x16, 0x0e, 0x28, 0xc5, 0x59, 0x78, 0xba,
-	0x8c, 0x8d, 0x19, 0x6a, 0x47, 0xbd, 0x56, 0x52, 0x4f, 0x85, 0x22, 0x41, 0x79, 0x31, 0xcf, 0x3a,
-	0xed, 0xe7, 0x0a, 0x60, 0x26, 0x5e, 0x0a, 0xa5, 0xab, 0xa8, 0x37, 0x51, 0x21, 0x94, 0xac, 0xb1,
-	0xeb, 0x70, 0x65, 0xb0, 0xdf, 0x7f, 0xae, 0xf3, 0x4c, 0x71, 0x17, 0xd6, 0xd9, 0x16, 0x28, 0x12,
-	0x82, 0x17, 0x5f, 0xc7, 0x2a, 0x09, 0x1a, 0x11, 0x0e, 0x94, 0x0d, 0xf2, 0xb0, 0x23, 0x6c, 0xc8,
-	0x05, 0x89, 0x82, 0x5d, 0xe1, 0x59, 0xfb, 0xdd, 0xa3, 0x83, 0xde, 0x40, 0xd9, 0xc4, 0x46, 0x10,
-	0x84, 0xb7, 0x9c, 0xc5, 0xc5, 0x24, 0xe2, 0xe7, 0x0a, 0x49, 0x24, 0x84, 0x3d, 0x6f, 0x6a, 0xbd,
-	0x4e, 0xef, 0xc9, 0x40, 0xd9, 0x8a, 0x4b, 0x6e, 0x6b, 0x5a, 0x5f, 0x1b, 0x28, 0x57, 0x63, 0xc0,
-	0x60, 0xd8, 0x1c, 0x1e, 0x0d, 0x94, 0x6b, 0x71, 0x2b, 0x0f, 0xb5, 0x7e, 0xab, 0x3d, 0x18, 0x74,
-	0x3b, 0x83, 0xa1, 0x72, 0x9d, 0x5d, 0x85, 0xcd, 0xa4, 0x45, 0x11, 0x71, 0x43, 0x6a, 0xa8, 0xf6,
-	0xa4, 0x3d, 0x54, 0x6e, 0xc4, 0xcd, 0x68, 0xf5, 0xbb, 0xdd, 0x26, 0x79, 0x84, 0x6f, 0x22, 0x11,
-	0x39, 0xb8, 0x45, 0x6f, 0x5e, 0xc1, 0x76, 0x1d, 0xf5, 0x64, 0xd0, 0x2d, 0x69, 0x69, 0x0c, 0xda,
-	0x3f, 0x3d, 0x6a, 0xf7, 0x5a, 0x6d, 0xe5, 0xd5, 0x64, 0x69, 0xc4, 0xb0, 0xdb, 0xf1, 0xd2, 0x88,
-	0x41, 0x77, 0xe2, 0x3a, 0x23, 0xd0, 0x40, 0xd9, 0xc6, 0xf2, 0x44, 0x3b, 0x7a, 0xbd, 0x76, 0x6b,
-	0x88, 0x7d, 0x7d, 0x2d, 0x1e, 0xc5, 0xa3, 0xc3, 0x27, 0x5a, 0x73, 0xb7, 0xad, 0xa8, 0x3b, 0x35,
-	0x7a, 0xff, 0x4c, 0x08, 0x39, 0xf5, 0x73, 0x60, 0xf2, 0x43, 0x42, 0xe2, 0x51, 0x03, 0x06, 0xf9,
-	0x89, 0xef, 0x4d, 0xa3, 0x5b, 0x34, 0xf8, 0xcd, 0xb6, 0xa1, 0x3a, 0x9b, 0x8f, 0xe8, 0xc8, 0x36,
-	0x09, 0xaa, 0x97, 0x41, 0xea, 0x3f, 0xcb, 0x40, 0x3d, 0x2d, 0xe0, 0x50, 0xb1, 0xb3, 0x27, 0xba,
-	0xeb, 0x85, 0xfc, 0xe2, 0x7d, 0x10, 0x79, 0x07, 0xec, 0x49, 0xcf, 0x0b, 0xe9, 0xe6, 0x3d, 0x99,
-	0x73, 0xb1, 0xbc, 0xe2, 0xa5, 0xc6, 0x69, 0xd6, 0x81, 0x2b, 0xa9, 0x77, 0x96, 0x52, 0xcf, 0x1e,
-	0x34, 0xe2, 0x57, 0x63, 0x16, 0xda, 0xaf, 0xb1, 0x60, 0xb9, 0x4f, 0x0a, 0xe4, 0x82, 0xaf, 0x1d,
-	0x71, 0x7f, 0x12, 0x3f, 0xd5, 0x7d, 0x58, 0x4f, 0xc9, 0x53, 0x72, 0x15, 0x4d, 0xd2, 0x2d, 0x2d,
-	0xdb, 0x93, 0x97, 0x37, 0x53, 0xfd, 0xa3, 0x0c, 0xd4, 0x64, 0xe9, 0xfa, 0xbd, 0x4b, 0xa2, 0xd0,
-	0x4b, 0xf1, 0xad, 0xdb, 0x66, 0x74, 0xe1, 0x3e, 0x02, 0x75, 0xe8, 0xdd, 0x47, 0xee, 0xcb, 0xda,
-	0x3b, 0x1d, 0xc4, 0xdd, 0x91, 0x41, 0x68, 0xe8, 0x52, 0x50, 0xf5, 0xde, 0x53, 0x24, 0x10, 0xc1,
-	0x9b, 0x09, 0x44, 0xbd, 0x03, 0x95, 0xbd, 0xd3, 0xe8, 0xed, 0x07, 0xf9, 0xf9, 0x89, 0x0a, 0xbf,
-	0x89, 0xa1, 0xfe, 0x71, 0x06, 0xea, 0xc9, 0x95, 0x42, 0x0a, 0xd9, 0xe0, 0xef, 0x73, 0xf1, 0xe5,
-	0x90, 0x35, 0x47, 0xc9, 0x93, 0x90, 0x59, 0xf9, 0x49, 0xc8, 0xd7, 0x45, 0x61, 0x39, 0x59, 0x06,
-	0xc5, 0x75, 0x89, 0x7b, 0x1e, 0x8f, 0xa0, 0x86, 0xff, 0x35, 0x6b, 0x62, 0xf9, 0xbe, 0x15, 0x3d,
-	0x55, 0xb6, 0x44, 0x9c, 0x22, 0x22, 0x3b, 0xc2, 0x9a, 0x08, 0x75, 0x66, 0xe5, 0xad, 0x47, 0xc4,
-	0xab, 0x7f, 0x2f, 0x0f, 0x55, 0x49, 0x57, 0xf9, 0x56, 0xcb, 0xef, 0x16, 0x54, 0x92, 0xfb, 0x74,
-	0x22, 0xb8, 0x3e, 0x06, 0xa4, 0xe6, 0x2a, 0xb7, 0x30, 0x57, 0x0d, 0x28, 0x89, 0xd8, 0x0e, 0xe1,
-	0x8b, 0x8a, 0x92, 0x69, 0x67, 0x4b, 0xe1, 0x25, 0x2e, 0xcc, 0xf7, 0xa1, 0x26, 0xbd, 0x62, 0x11,
-	0x5d, 0xce, 0x5d, 0xa4, 0xaf, 0x26, 0x2f, 0x5a, 0x04, 0xec, 0x2a, 0x14, 0x27, 0xa7, 0xba, 0x39,
-	0x8a, 0xfc, 0x18, 0x85, 0xc9, 0xe9, 0xee, 0x88, 0x5c, 0xc0, 0x93, 0x58, 0x3c, 0x97, 0xb9, 0x67,
-	0x64, 0x12, 0x09, 0xe1, 0x7b, 0x50, 0x9a, 0x9c, 0xf2, 0x98, 0xf9, 0x8a, 0x7c, 0x32, 0x9b, 0x0c,
-	0x79, 0x71, 0x72, 0x4a, 0x01, 0xf4, 0x9f, 0x80, 0xb2, 0xe0, 0xe7, 0x0a, 0x44, 0xdc, 0xd8, 0x62,
-	0xa3, 0x36, 0xd2, 0x2e, 0xaf, 0x80, 0xbd, 0x0b, 0x5b, 0x42, 0x5e, 0x1a, 0x81, 0xce, 0xe3, 0x0e,
-	0xe9, 0x8a, 0x26, 0x7f, 0xc7, 0x62, 0x93, 0xe3, 0x9a, 0xc1, 0x80, 0x30, 0xb8, 0x58, 0x55, 0xa8,
-	0x49, 0x6b, 0x97, 0xdf, 0x7f, 0xad, 0x68, 0x29, 0x18, 0x7b, 0x0c, 0xb5, 0xc9, 0x29, 0x5f, 0x0b,
-	0x43, 0xef, 0xc0, 0x12, 0x11, 0x64, 0x5b, 0x8b, 0xab, 0x80, 0x02, 0x8d, 0x52, 0x94, 0xea, 0xbf,
-	0xc9, 0x40, 0x3d, 0x51, 0x42, 0x71, 0x87, 0xb2, 0xfb, 0xf2, 0xab, 0x7b, 0x8d, 0x45, 0x3d, 0x15,
-	0x49, 0x1e, 0x0c, 0x2f, 0x66, 0xfc, 0x81, 0xa0, 0x55, 0xb7, 0x92, 0x57, 0xbd, 0x39, 0x92, 0x5b,
-	0xf5, 0xe6, 0x88, 0xfa, 0x04, 0x72, 0xc3, 0x8b, 0x19, 0x77, 0x78, 0xa0, 0x08, 0xe3, 0xc6, 0x11,
-	0x17, 0x5e, 0x74, 0x02, 0xf7, 0xb4, 0xfd, 0x25, 0xbf, 0x29, 0x74, 0xa8, 0x75, 0x0e, 0x9a, 0xda,
-	0x97, 0x74, 0x7a, 0x49, 0x42, 0x7e, 0xaf, 0xaf, 0xb5, 0x3b, 0x4f, 0x7a, 0x04, 0xc8, 0x93, 0x3b,
-	0x24, 0x69, 0x62, 0xd3, 0x34, 0xf7, 0x4e, 0xe5, 0xcb, 0x99, 0x99, 0xd4, 0xcb, 0x6d, 0xe9, 0xcb,
-	0x05, 0xd9, 0xc5, 0xcb, 0x05, 0x2c, 0xde, 0xa2, 0xf1, 0x7e, 0x67, 0x6f, 0x41, 0x7e, 0x72, 0x6a,
-	0x5d, 0xa4, 0x2d, 0x8d, 0xf4, 0xee, 0x22, 0x02, 0xf5, 0x97, 0x19, 0x60, 0xa9, 0x86, 0x70, 0xe5,
-	0xf7, 0xfb, 0xb6, 0xe5, 0x63, 0x68, 0x88, 0xe7, 0x76, 0x38, 0x95, 0xe4, 0x03, 0x15, 0x43, 0x7a,
-	0xd5, 0x4b, 0x42, 0x16, 0x92, 0x8b, 0xd3, 0xec, 0x5d, 0xe0, 0x6f, 0xa7, 0xe0, 0x8c, 0xa7, 0x7d,
-	0x0b, 0xd2, 0xe6, 0xd7, 0x12, 0x9a, 0xe4, 0xb1, 0x14, 0xf9, 0x11, 0x18, 0xee, 0x14, 0xde, 0x48,
-	0x66, 0x8d, 0x18, 0x82, 0xfa, 0xfb, 0x19, 0xb8, 0x92, 0x5e, 0x10, 0xbf, 0x5e, 0x2f, 0xd3, 0x2f,
-	0xde, 0xe4, 0x16, 0x5f, 0xbc, 0x59, 0xb5, 0x9e, 0xf2, 0x2b, 0xd7, 0xd3, 0xef, 0x65, 0x60, 0x4b,
-	0x1a, 0xfd, 0xc4, 0x5c, 0xf9, 0x2b, 0x6a, 0x99, 0xf4, 0xf0, 0x4d, 0x3e, 0xf5, 0xf0, 0x8d, 0xfa,
-	0x87, 0x19, 0xb8, 0xb6, 0xd0, 0x12, 0xcd, 0xfa, 0x2b, 0x6d, 0x4b, 0xfa, 0x81, 0x1c, 0xf2, 0x03,
-	0xf3, 0x20, 0x13, 0x1e, 0x40, 0xcf, 0xd2, 0x2f, 0xde, 0x74, 0x71, 0x1e, 0xff, 0x6d, 0xba, 0x91,
-	0x66, 0x12, 0xfe, 0xcc, 0x3e, 0x84, 0x6a, 0xa2, 0x02, 0x45, 0x97, 0x12, 0x57, 0xc6, 0x4e, 0xcb,
-	0x74, 0x2b, 0xf9, 0x62, 0xf6, 0xdb, 0xf1, 0xc5, 0xc7, 0x50, 0x8b, 0x0b, 0xde, 0xb5, 0x26, 0x69,
-	0xa7, 0xc0, 0xc2, 0x0d, 0xfa, 0x14, 0xa5, 0xfa, 0x01, 0x6c, 0x26, 0xbd, 0x68, 0x89, 0x57, 0x1f,
-	0xee, 0x40, 0xd5, 0xb5, 0xce, 0xf4, 0xe8, 0x4d, 0x08, 0x71, 0xf2, 0xed, 0x5a, 0x67, 0x82, 0x40,
-	0xdd, 0x93, 0xf9, 0x5e, 0xfc, 0x98, 0xa5, 0x63, 0xca, 0x33, 0x53, 0xf2, 0x1c, 0x33, 0x42, 0x61,
-	0x69, 0xd2, 0xc4, 0x94, 0x5c, 0xeb, 0x8c, 0xd6, 0xdc, 0x99, 0x28, 0xa7, 0x69, 0x9a, 0xe2, 0x24,
-	0x71, 0xd5, 0x05, 0xeb, 0x1b, 0x50, 0x9e, 0xf9, 0xa9, 0x99, 0x2d, 0xcd, 0x7c, 0x5e, 0xed, 0x5d,
-	0x71, 0xae, 0x7e, 0xd9, 0xa9, 0x23, 0x3f, 0x69, 0x17, 0xf7, 0x51, 0xf3, 0xc9, 0x63, 0xb7, 0x1f,
-	0x0a, 0x96, 0x87, 0xfb, 0x4f, 0xd4, 0x1c, 0x9f, 0x2e, 0x66, 0xee, 0xad, 0xd3, 0xe9, 0x22, 0xa9,
-	0x74, 0xd6, 0xd7, 0xe2, 0x68, 0x1f, 0x3f, 0xd5, 0x3f, 0x00, 0x80, 0xa4, 0xe3, 0x29, 0xe9, 0x9d,
-	0x59, 0x90, 0xde, 0xdf, 0xe9, 0x98, 0xf1, 0x03, 0xa8, 0x8f, 0xbd, 0xd9, 0x85, 0x9e, 0xe4, 0xc8,
-	0xad, 0xcc, 0x51, 0x43, 0xaa, 0x61, 0x12, 0x2a, 0xbc, 0x7c, 0x14, 0x95, 0x5f, 0x79, 0x14, 0xf5,
-	0x3e, 0x94, 0xb8, 0xef, 0x3b, 0x10, 0x41, 0xe7, 0xd7, 0x17, 0x25, 0xd3, 0x03, 0xf1, 0xbe, 0x51,
-	0x44, 0xc7, 0xda, 0x68, 0xd8, 0x8a, 0xc7, 0x5d, 0xe4, 0x10, 0xf4, 0xdb, 0xcb, 0x39, 0x23, 0x32,
-	0xfe, 0xa2, 0x80, 0x21, 0x27, 0x25, 0x89, 0x1d, 0x4e, 0x85, 0x43, 0x86, 0x24, 0x76, 0x49, 0x96,
-	0xd8, 0xc3, 0x29, 0x77, 0xc3, 0xa0, 0xc4, 0xfe, 0x21, 0x5c, 0x11, 0xe1, 0x7c, 0x98, 0x01, 0x87,
-	0x93, 0xe8, 0xf9, 0x35, 0x36, 0x71, 0x07, 0x70, 0x38, 0x25, 0x55, 0x18, 0xc9, 0xbf, 0x80, 0xad,
-	0xf1, 0x89, 0xe1, 0x1e, 0x5b, 0x7a, 0x38, 0x72, 0x74, 0x7a, 0xee, 0x4f, 0x9f, 0x1a, 0x33, 0xa1,
-	0x83, 0xbc, 0xb5, 0xd4, 0xd8, 0x16, 0x11, 0x0f, 0x47, 0x0e, 0x9d, 0xdf, 0xc7, 0x07, 0x96, 0x9b,
-	0xe3, 0x45, 0xf8, 0xc2, 0x81, 0x0e, 0x2c, 0x1e, 0xe8, 0x2c, 0xa9, 0x16, 0xd5, 0x65, 0xd5, 0xe2,
-	0xe6, 0x9f, 0xe5, 0xa1, 0x28, 0x5e, 0x88, 0xba, 0x0f, 0x79, 0xd3, 0xf7, 0x66, 0x71, 0xd0, 0xca,
-	0x0a, 0xcd, 0x80, 0x1e, 0xe5, 0x46, 0x25, 0xe2, 0x01, 0x14, 0x0d, 0xd3, 0xd4, 0x27, 0xa7, 0xe9,
-	0x43, 0x97, 0x05, 0x21, 0xbd, 0xbf, 0xa6, 0x15, 0x0c, 0x92, 0xd6, 0x1f, 0x43, 0x05, 0xe9, 0xb9,
-	0x3f, 0x29, 0x65, 0xbc, 0x2c, 0x8b, 0xd3, 0xfd, 0x35, 0xad, 0x6c, 0x44, 0xa2, 0xf5, 0xc7, 0x69,
-	0xf7, 0x15, 0x97, 0x75, 0x37, 0x97, 0xb2, 0x5e, 0xe6, 0xc8, 0xfa, 0x6d, 0xe0, 0xfe, 0x8c, 0x98,
-	0x53, 0x14, 0x64, 0xff, 0xfe, 0x12, 0x5f, 0xd9, 0x5f, 0xd3, 0x6a, 0x06, 0x8f, 0x9d, 0xe0, 0x7c,
-	0xe6, 0xc3, 0xc8, 0xb5, 0x14, 0x3f, 0x9f, 0xbb, 0x62, 0x64, 0x70, 0x9f, 0xc7, 0xfe, 0x25, 0xda,
-	0xf4, 0x98, 0xcd, 0x34, 0xa3, 0x58, 0x84, 0xd2, 0x52, 0xb6, 0x98, 0x9b, 0x50, 0xb6, 0x98, 0xb5,
-	0x3c, 0x86, 0x2a, 0x79, 0x79, 0x44, 0xbe, 0xf2, 0xd2, 0xd0, 0x26, 0xcc, 0x80, 0x7c, 0xd7, 0x09,
-	0x6b, 0x68, 0x45, 0xfd, 0xf4, 0x2d, 0xd9, 0x3d, 0x78, 0x6b, 0xe5, 0x40, 0x69, 0xb1, 0xa7, 0x90,
-	0x77, 0x56, 0xe3, 0x79, 0xd8, 0x0e, 0xd4, 0x0c, 0x49, 0x4a, 0x08, 0x5f, 0xe1, 0xad, 0x15, 0xf3,
-	0x14, 0xd3, 0x50, 0x19, 0x52, 0x3a, 0x39, 0xc3, 0xba, 0xa9, 0xc1, 0xb5, 0xd5, 0x4b, 0x59, 0x3e,
-	0x6a, 0xcf, 0xf3, 0xa3, 0x76, 0x35, 0x7d, 0x0f, 0x33, 0x7d, 0x73, 0x46, 0x3a, 0x78, 0xff, 0x0c,
-	0x0d, 0x56, 0x79, 0xf3, 0x56, 0xa1, 0x14, 0x3d, 0x54, 0x46, 0x41, 0x5b, 0xad, 0xfe, 0xe1, 0x97,
-	0x4a, 0x06, 0xc1, 0x9d, 0xde, 0x60, 0xd8, 0xec, 0x89, 0x13, 0xca, 0x4e, 0x4f, 0x9c, 0x50, 0xaa,
-	0xff, 0x21, 0x07, 0x95, 0xd8, 0xc3, 0xfa, 0xfd, 0xad, 0xd4, 0xd8, 0xfc, 0xcb, 0xc9, 0xe6, 0xdf,
-	0x82, 0x96, 0xc5, 0xcf, 0xc6, 0xf9, 0xfd, 0xdc, 0x8d, 0xb4, 0x2e, 0x13, 0x2c, 0x87, 0xf2, 0x17,
-	0xbe, 0x65, 0x28, 0xbf, 0x1c, 0xb7, 0x54, 0x4c, 0xc7, 0x2d, 0x2d, 0x3c, 0x56, 0x57, 0xa2, 0x73,
-	0x7c, 0xf9, 0xb1, 0xba, 0x4b, 0x0f, 0xf0, 0xcb, 0x97, 0x1f, 0xe0, 0xd3, 0x2f, 0x0f, 0x3c, 0xb3,
-	0xad, 0x33, 0x11, 0xbe, 0x23, 0x52, 0x69, 0xf1, 0x01, 0x2f, 0x11, 0x1f, 0xdf, 0x82, 0x15, 0xb1,
-	0x87, 0xb0, 0x35, 0x39, 0x8d, 0x1f, 0xe6, 0x49, 0xac, 0x9d, 0x1a, 0x75, 0x63, 0x25, 0x4e, 0xfd,
-	0xfb, 0x19, 0x80, 0xc4, 0x0d, 0xf9, 0x6b, 0x7b, 0x5b, 0x24, 0x83, 0x36, 0xf7, 0x2b, 0x0c, 0xda,
-	0x97, 0x5c, 0x1f, 0x55, 0xbf, 0x86, 0x4a, 0xec, 0x78, 0xfe, 0xfe, 0x6b, 0xec, 0x3b, 0x55, 0xf9,
-	0xbb, 0x91, 0xe7, 0x29, 0xf6, 0xdc, 0xfe, 0xba, 0x63, 0x91, 0xaa, 0x3e, 0xf7, 0x92, 0xea, 0xcf,
-	0xb9, 0xfb, 0x27, 0xae, 0xfc, 0x37, 0xbc, 0xb1, 0xe4, 0x35, 0x9f, 0x4f, 0xad, 0x79, 0x75, 0x2e,
-	0x7c, 0x58, 0xbf, 0x7e, 0xd5, 0xdf, 0xa9, 0xc3, 0x7f, 0x91, 0x89, 0x1c, 0x2d, 0xf1, 0x73, 0x47,
-	0x97, 0x2a, 0x5a, 0xab, 0x7d, 0x45, 0xdf, 0xa5, 0xba, 0x5f, 0x69, 0x29, 0xe6, 0x7f, 0x95, 0xa5,
-	0xf8, 0x16, 0x14, 0xb8, 0x40, 0x28, 0x5c, 0x66, 0x25, 0x72, 0xfc, 0x4b, 0x1f, 0x08, 0x55, 0x55,
-	0xa1, 0x58, 0xf2, 0xfe, 0x6e, 0x45, 0xe5, 0x46, 0x8f, 0x9b, 0x62, 0x02, 0x0d, 0xf5, 0x4a, 0x62,
-	0x30, 0x7e, 0xf7, 0x31, 0xf9, 0x8d, 0x99, 0x8a, 0xff, 0x22, 0x0b, 0xeb, 0xa9, 0x33, 0xa7, 0xef,
-	0xd1, 0x98, 0x95, 0xdc, 0x3c, 0xb7, 0x9a, 0x9b, 0x5f, 0xca, 0x58, 0xf3, 0x97, 0x33, 0xd6, 0xff,
-	0x2b, 0x12, 0x80, 0x07, 0x03, 0x8a, 0xb7, 0x48, 0xcb, 0x51, 0x30, 0x20, 0x0f, 0x66, 0x43, 0x6e,
-	0x5a, 0x4b, 0x69, 0xe5, 0xab, 0xf4, 0xf7, 0xcc, 0x4a, 0xfd, 0xfd, 0x76, 0xfc, 0xce, 0x7e, 0x67,
-	0x97, 0x1b, 0x85, 0xeb, 0x9a, 0x04, 0x61, 0x9f, 0xc0, 0x0d, 0xae, 0xd5, 0x70, 0x45, 0x4e, 0xf7,
-	0x26, 0x7a, 0xfc, 0x0a, 0xbf, 0x88, 0x76, 0xbb, 0xc6, 0x09, 0xf8, 0xeb, 0xb1, 0x93, 0x66, 0x84,
-	0x55, 0x3b, 0xb0, 0x9e, 0x3a, 0x00, 0x94, 0x7e, 0xd1, 0x23, 0x23, 0xff, 0xa2, 0x07, 0xdb, 0x86,
-	0xc2, 0xd9, 0x89, 0xe5, 0x5b, 0x2b, 0x9e, 0x80, 0xe1, 0x08, 0xf5, 0x47, 0x50, 0x93, 0x83, 0x11,
-	0xd8, 0x3b, 0x50, 0xb0, 0x43, 0x6b, 0x1a, 0x59, 0xc0, 0xd7, 0x96, 0xe3, 0x15, 0xc8, 0x08, 0xe6,
-	0x44, 0xea, 0x2f, 0x32, 0xa0, 0x2c, 0xe2, 0xa4, 0x9f, 0x1d, 0xc9, 0x5c, 0xf2, 0xb3, 0x23, 0xd9,
-	0x54, 0x23, 0x57, 0xfd, 0x72, 0x48, 0xfc, 0x0c, 0x45, 0xfe, 0x92, 0x67, 0x28, 0xd8, 0x9b, 0x50,
-	0xf6, 0x2d, 0xfa, 0x4d, 0x07, 0x33, 0xfd, 0xa4, 0x37, 0xbf, 0x05, 0x14, 0xe1, 0xd4, 0xbf, 0x93,
-	0x81, 0x92, 0x88, 0x9c, 0x58, 0x69, 0xa8, 0xbe, 0x0d, 0x25, 0xfe, 0xfb, 0x0e, 0x91, 0xe1, 0xbe,
-	0x14, 0x8c, 0x18, 0xe1, 0xd9, 0x6d, 0x1e, 0x4f, 0x92, 0x36, 0x5c, 0x0f, 0x1d, 0xc3, 0xd5, 0x08,
-	0x8e, 0x4b, 0x8d, 0xbb, 0x21, 0xd0, 0xf4, 0x0a, 0xc4, 0x55, 0x62, 0x20, 0x10, 0xaa, 0x66, 0x81,
-	0xfa, 0x63, 0x28, 0x89, 0xc8, 0x8c, 0x95, 0x4d, 0x79, 0xd9, 0x2f, 0x1e, 0x6c, 0x03, 0x24, 0xa1,
-	0x1a, 0xab, 0x4a, 0x50, 0xef, 0x43, 0x39, 0x8a, 0xce, 0xc0, 0xf5, 0x97, 0x54, 0x2d, 0x02, 0x70,
-	0xe5, 0xc6, 0x38, 0xe2, 0x9d, 0xb4, 0xae, 0x37, 0x3e, 0x25, 0x8f, 0xd8, 0xbb, 0x50, 0x76, 0xa2,
-	0xe7, 0xe7, 0x32, 0x97, 0x3f, 0x3f, 0x17, 0x13, 0xb1, 0xfb, 0x10, 0xb3, 0xe3, 0x97, 0x59, 0xcb,
-	0x6a, 0x33, 0x8a, 0x34, 0xa7, 0x55, 0xf6, 0x48, 0x78, 0x7e, 0xba, 0x74, 0x01, 0x3e, 0xe5, 0x6c,
-	0x49, 0xb5, 0x49, 0x93, 0xc8, 0xd4, 0x3a, 0xd4, 0xe4, 0x23, 0x65, 0xf5, 0x17, 0x79, 0x50, 0x0e,
-	0xac, 0xd0, 0x40, 0xa6, 0x35, 0x18, 0x1b, 0x2e, 0x75, 0xe2, 0x06, 0x94, 0xe3, 0x77, 0xad, 0x33,
-	0xd1, 0xbb, 0x98, 0x4e, 0xf4, 0xe0, 0xb3, 0x47, 0x93, 0x2a, 0x7b, 0x25, 0x80, 0x83, 0x88, 0x80,
-	0x73, 0x82, 0xd4, 0x03, 0x93, 0x65, 0x3b, 0xd8, 0xe7, 0x4f, 0x4c, 0x5e, 0xe7, 0x77, 0x7c, 0x1d,
-	0x6f, 0x4c, 0x6b, 0xb2, 0x46, 0x57, 0x7a, 0xbb, 0xde, 0x18, 0x73, 0x45, 0xd6, 0x72, 0x20, 0xe2,
-	0xf7, 0xcb, 0xc2, 0x44, 0x26, 0xf7, 0xbb, 0xb8, 0xb8, 0x19, 0x06, 0xc4, 0x99, 0x6a, 0x5a, 0x99,
-	0x03, 0x86, 0x41, 0xf4, 0x16, 0xd7, 0x58, 0x3c, 0x30, 0x9d, 0xa3, 0xb7, 0xb8, 0x5a, 0x2e, 0x05,
-	0xe2, 0xd3, 0x1b, 0xe6, 0x63, 0xf1, 0x84, 0xbc, 0x78, 0xe9, 0x0c, 0x51, 0xaf, 0xf3, 0x27, 0xb8,
-	0x7d, 0x2b, 0x08, 0xf8, 0x43, 0x0f, 0xfc, 0x0d, 0x86, 0x5a, 0x04, 0x8c, 0x5f, 0x94, 0x10, 0x8f,
-	0x96, 0x23, 0x09, 0x88, 0x17, 0x25, 0xf8, 0x93, 0xe5, 0x48, 0x70, 0x03, 0xca, 0xdf, 0x78, 0xae,
-	0x45, 0x56, 0x77, 0x95, 0x5a, 0x55, 0xc2, 0xf4, 0x81, 0x31, 0x53, 0xff, 0x7d, 0x06, 0xb6, 0x16,
-	0x47, 0x95, 0x66, 0xbb, 0x06, 0xe5, 0x56, 0xbf, 0xab, 0xf7, 0x9a, 0x07, 0x6d, 0x65, 0x8d, 0x6d,
-	0x40, 0xb5, 0xbf, 0xf3, 0x79, 0xbb, 0x35, 0xe4, 0x80, 0x0c, 0x5d, 0xd9, 0x19, 0xe8, 0xfb, 0x9d,
-	0xdd, 0xdd, 0x76, 0x8f, 0x9b, 0x18, 0xfd, 0x9d, 0xcf, 0xf5, 0x6e, 0xbf, 0xc5, 0xdf, 0x4b, 0x8e,
-	0x4e, 0xad, 0x07, 0x4a, 0x9e, 0x4e, 0x8a, 0x29, 0x26, 0x12, 0x93, 0x05, 0x1e, 0xf2, 0xf7, 0x7c,
-	0xa0, 0xb7, 0x7a, 0x43, 0xa5, 0x88, 0xa9, 0xde, 0x51, 0xb7, 0x4b, 0x29, 0x8a, 0xed, 0x69, 0xf5,
-	0x0f, 0x0e, 0xb5, 0xf6, 0x60, 0xa0, 0x0f, 0x3a, 0x3f, 0x6b, 0x2b, 0x65, 0xaa, 0x59, 0xeb, 0x3c,
-	0xe9, 0xf4, 0x38, 0xa0, 0xc2, 0x4a, 0x90, 0x3b, 0xe8, 0xf4, 0x14, 0xa0, 0x8f, 0xe6, 0x17, 0x4a,
-	0x15, 0x3f, 0x06, 0x47, 0x07, 0x4a, 0xed, 0xfe, 0x6b, 0x50, 0x93, 0x7f, 0x07, 0x80, 0xa2, 0xfc,
-	0x3c, 0xd7, 0xe2, 0xef, 0x73, 0x75, 0xbf, 0xf9, 0x40, 0xc9, 0xdc, 0xff, 0x5d, 0xe9, 0x31, 0x57,
-	0xa2, 0x11, 0x5e, 0x78, 0xba, 0x39, 0xc6, 0x2f, 0xb2, 0x90, 0xcf, 0x9d, 0xee, 0xbd, 0xec, 0x37,
-	0x07, 0xfb, 0xdc, 0x3f, 0x2f, 0x30, 0x04, 0xc8, 0x25, 0xef, 0x3a, 0xd1, 0x4d, 0x31, 0xfa, 0x8c,
-	0x0f, 0xa9, 0x0b, 0x74, 0xc5, 0xa7, 0x33, 0xc0, 0xce, 0x29, 0x50, 0xc3, 0xaf, 0x18, 0x57, 0xba,
-	0xaf, 0x42, 0x55, 0x7a, 0x8a, 0x8f, 0xea, 0x30, 0x82, 0x13, 0xf1, 0x54, 0x14, 0xda, 0x8a, 0x4a,
-	0xe6, 0xfe, 0x87, 0xc8, 0xee, 0xe5, 0x87, 0xf0, 0x00, 0x8a, 0x3d, 0xcf, 0x9f, 0x1a, 0x8e, 0xa0,
-	0xb3, 0xe6, 0x81, 0xc5, 0xa7, 0x40, 0xb3, 0xc4, 0x93, 0x79, 0x4a, 0xf6, 0xfe, 0xbb, 0x70, 0x75,
-	0xe5, 0x2b, 0x7f, 0x14, 0xb8, 0x6a, 0x4f, 0x67, 0x8e, 0xc5, 0x63, 0x2f, 0xf7, 0x2f, 0x46, 0xbe,
-	0x6d, 0x2a, 0x99, 0xfb, 0x9f, 0x41, 0xe3, 0xb2, 0x50, 0x42, 0xac, 0xa6, 0xb5, 0xdf, 0xa4, 0x70,
-	0x4d, 0x9c, 0xa1, 0xbe, 0xce, 0x53, 0x19, 0x1e, 0xed, 0xda, 0x6d, 0x53, 0x78, 0xc2, 0xfd, 0x9f,
-	0x67, 0x24, 0xa6, 0x12, 0x85, 0x83, 0xc5, 0x00, 0x31, 0xf4, 0x32, 0x48, 0xb3, 0x0c, 0x53, 0xc9,
-	0xb0, 0x6b, 0xc0, 0x52, 0xa0, 0xae, 0x37, 0x36, 0x1c, 0x25, 0x4b, 0x81, 0x08, 0x11, 0xfc, 0xb9,
-	0x6f, 0x87, 0x96, 0x92, 0x63, 0xaf, 0xc2, 0x8d, 0x18, 0xd6, 0xf5, 0xce, 0x0e, 0x7d, 0x1b, 0xad,
-	0xdf, 0x0b, 0x8e, 0xce, 0xef, 0xfc, 0xe4, 0x4f, 0x7e, 0x79, 0x3b, 0xf3, 0x1f, 0x7f, 0x79, 0x3b,
-	0xf3, 0xdf, 0x7f, 0x79, 0x7b, 0xed, 0x17, 0xff, 0xe3, 0x76, 0xe6, 0x67, 0xf2, 0x6f, 0xef, 0x4d,
-	0x8d, 0xd0, 0xb7, 0xcf, 0xf9, 0x4e, 0x88, 0x12, 0xae, 0xf5, 0xee, 0xec, 0xf4, 0xf8, 0xdd, 0xd9,
-	0xe8, 0x5d, 0x64, 0x40, 0xa3, 0x22, 0xfd, 0xca, 0xde, 0xa3, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x6f, 0xe5, 0xd4, 0x5f, 0xc5, 0x6f, 0x00, 0x00,
+	// 11220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x8c, 0x24, 0x57,
+	0x96, 0x50, 0xe7, 0x3b, 0xf3, 0xe4, 0xa3, 0xa2, 0xa2, 0x5f, 0xd9, 0xed, 0x7e, 0x94, 0xc3, 0x1e,
+	0xbb, 0xdd, 0xe3, 0x69, 0xdb, 0xe5, 0x57, 0xdb, 0x3b, 0xb3, 0x33, 0x59, 0x59, 0xd9, 0x5d, 0xe9,
+	0xce, 0xca, 0xac, 0xb9, 0x99, 0xd5, 0x6d, 0x7b, 0xb5, 0x84, 0x22, 0x33, 0x22, 0xab, 0xc2, 0x15,
+	0x19, 0x91, 0x8e, 0x88, 0xec, 0xaa, 0xb2, 0xb4, 0xd2, 0xf0, 0x10, 0x2b, 0xf8, 0x05, 0xad, 0x84,
+	0x04, 0x68, 0x58, 0xfe, 0x56, 0x20, 0xf1, 0x92, 0x40, 0x2b, 0x24, 0x3e, 0xe0, 0x63, 0x00, 0x69,
+	0x85, 0xc4, 0x17, 0x20, 0x2d, 0x68, 0xf8, 0x67, 0x3f, 0x00, 0x89, 0x2f, 0x40, 0xe7, 0xdc, 0x1b,
+	0x11, 0x37, 0x32, 0xb3, 0xdc, 0xf6, 0x78, 0x56, 0x80, 0xc4, 0x4f, 0x55, 0xdc, 0x73, 0xce, 0x7d,
+	0xdf, 0x7b, 0xee, 0x79, 0xdd, 0x9b, 0x00, 0x73, 0xc7, 0x70, 0x1f, 0xcc, 0x7d, 0x2f, 0xf4, 0xd4,
+	0x3c, 0x7e, 0xdf, 0xfc, 0xc1, 0x91, 0x1d, 0x1e, 0x2f, 0xc6, 0x0f, 0x26, 0xde, 0xec, 0xad, 0x23,
+	0xef, 0xc8, 0x7b, 0x8b, 0x90, 0xe3, 0xc5, 0x94, 0x52, 0x94, 0xa0, 0x2f, 0x9e, 0xe9, 0x26, 0x38,
+	0xde, 0xe4, 0x44, 0x7c, 0x6f, 0x84, 0xf6, 0xcc, 0x0a, 0x42, 0x63, 0x36, 0xe7, 0x00, 0xed, 0x1f,
+	0x67, 0x20, 0x3f, 0x3a, 0x9f, 0x5b, 0x6a, 0x03, 0xb2, 0xb6, 0xd9, 0xcc, 0x6c, 0x65, 0xee, 0x15,
+	0x58, 0xd6, 0x36, 0xd5, 0x2d, 0xa8, 0xba, 0x5e, 0xd8, 0x5f, 0x38, 0x8e, 0x31, 0x76, 0xac, 0x66,
+	0x76, 0x2b, 0x73, 0xaf, 0xcc, 0x64, 0x90, 0xfa, 0x12, 0x54, 0x8c, 0x45, 0xe8, 0xe9, 0xb6, 0x3b,
+	0xf1, 0x9b, 0x39, 0xc2, 0x97, 0x11, 0xd0, 0x75, 0x27, 0xbe, 0x7a, 0x05, 0x0a, 0xa7, 0xb6, 0x19,
+	0x1e, 0x37, 0xf3, 0x54, 0x22, 0x4f, 0x20, 0x34, 0x98, 0x18, 0x8e, 0xd5, 0x2c, 0x70, 0x28, 0x25,
+	0x10, 0x1a, 0x52, 0x25, 0xc5, 0xad, 0xcc, 0xbd, 0x0a, 0xe3, 0x09, 0xf5, 0x0e, 0x80, 0xe5, 0x2e,
+	0x66, 0xcf, 0x0d, 0x67, 0x61, 0x05, 0xcd, 0x12, 0xa1, 0x24, 0x88, 0xf6, 0x63, 0xa8, 0xcc, 0x82,
+	0xa3, 0x3d, 0xcb, 0x30, 0x2d, 0x5f, 0xbd, 0x0e, 0xa5, 0x59, 0x70, 0xa4, 0x87, 0xc6, 0x91, 0xe8,
+	0x42, 0x71, 0x16, 0x1c, 0x8d, 0x8c, 0x23, 0xf5, 0x06, 0x94, 0x09, 0x71, 0x3e, 0xe7, 0x7d, 0x28,
+	0x30, 0x24, 0xc4, 0x1e, 0x6b, 0x7f, 0x52, 0x80, 0x52, 0xcf, 0x0e, 0x2d, 0xdf, 0x70, 0xd4, 0x6b,
+	0x50, 0xb4, 0x03, 0x77, 0xe1, 0x38, 0x94, 0xbd, 0xcc, 0x44, 0x4a, 0xbd, 0x06, 0x05, 0xfb, 0xe1,
+	0x73, 0xc3, 0xe1, 0x79, 0xf7, 0x2e, 0x31, 0x9e, 0x54, 0x9b, 0x50, 0xb4, 0xdf, 0xf9, 0x00, 0x11,
+	0x39, 0x81, 0x10, 0x69, 0xc2, 0xbc, 0xbb, 0x8d, 0x98, 0x7c, 0x8c, 0x79, 0x77, 0x3b, 0xc2, 0x7c,
+	0xf0, 0x1e, 0x62, 0xb0, 0xf7, 0x39, 0xc2, 0x50, 0x1a, 0x6b, 0x59, 0x50, 0x2d, 0x38, 0x00, 0x75,
+	0xac, 0x65, 0x11, 0xd5, 0xb2, 0xe0, 0xb5, 0x94, 0x04, 0x42, 0xa4, 0x09, 0xc3, 0x6b, 0x29, 0xc7,
+	0x98, 0xb8, 0x96, 0x05, 0xaf, 0xa5, 0xb2, 0x95, 0xb9, 0x97, 0x27, 0x0c, 0xaf, 0xe5, 0x0a, 0xe4,
+	0x4d, 0x84, 0xc3, 0x56, 0xe6, 0x5e, 0x66, 0xef, 0x12, 0xcb, 0x9b, 0x02, 0x1a, 0x20, 0xb4, 0x8a,
+	0x03, 0x8c, 0xd0, 0x40, 0x40, 0xc7, 0x08, 0xad, 0xe1, 0x68, 0x20, 0x74, 0x2c, 0xa0, 0x53, 0x84,
+	0xd6, 0xb7, 0x32, 0xf7, 0xb2, 0x08, 0xc5, 0x94, 0x7a, 0x13, 0x4a, 0xa6, 0x11, 0x5a, 0x88, 0x68,
+	0x88, 0x2e, 0x47, 0x00, 0xc4, 0xe1, 0x8a, 0x43, 0xdc, 0x86, 0xe8, 0x74, 0x04, 0x50, 0x35, 0xa8,
+	0x22, 0x59, 0x84, 0x57, 0x04, 0x5e, 0x06, 0xaa, 0xef, 0x43, 0xcd, 0xb4, 0x26, 0xf6, 0xcc, 0x70,
+	0x78, 0x9f, 0x36, 0xb7, 0x32, 0xf7, 0xaa, 0xdb, 0x1b, 0x0f, 0x68, 0x4f, 0xc4, 0x98, 0xbd, 0x4b,
+	0x2c, 0x45, 0xa6, 0x3e, 0x84, 0xba, 0x48, 0xbf, 0xb3, 0x4d, 0x03, 0xab, 0x52, 0x3e, 0x25, 0x95,
+	0xef, 0x9d, 0xed, 0x87, 0x7b, 0x97, 0x58, 0x9a, 0x50, 0x7d, 0x15, 0x6a, 0xf1, 0x16, 0xc1, 0x8c,
+	0x97, 0x45, 0xab, 0x52, 0x50, 0xec, 0xd6, 0x17, 0x81, 0xe7, 0x22, 0xc1, 0x15, 0x31, 0x6e, 0x11,
+	0x40, 0xdd, 0x02, 0x30, 0xad, 0xa9, 0xb1, 0x70, 0x42, 0x44, 0x5f, 0x15, 0x03, 0x28, 0xc1, 0xd4,
+	0x3b, 0x50, 0x59, 0xcc, 0xb1, 0x97, 0x4f, 0x0d, 0xa7, 0x79, 0x4d, 0x10, 0x24, 0x20, 0x2c, 0x1d,
+	0xd7, 0x39, 0x62, 0xaf, 0x8b, 0xd9, 0x8d, 0x00, 0xb8, 0x57, 0xec, 0x60, 0xc7, 0x76, 0x9b, 0x4d,
+	0x5a, 0xa7, 0x3c, 0xa1, 0xde, 0x82, 0x5c, 0xe0, 0x4f, 0x9a, 0x37, 0xa8, 0x97, 0xc0, 0x7b, 0xd9,
+	0x39, 0x9b, 0xfb, 0x0c, 0xc1, 0x3b, 0x25, 0x28, 0xd0, 0x9e, 0xd1, 0x6e, 0x41, 0xf9, 0xc0, 0xf0,
+	0x8d, 0x19, 0xb3, 0xa6, 0xaa, 0x02, 0xb9, 0xb9, 0x17, 0x88, 0xdd, 0x82, 0x9f, 0x5a, 0x0f, 0x8a,
+	0x4f, 0x0d, 0x1f, 0x71, 0x2a, 0xe4, 0x5d, 0x63, 0x66, 0x11, 0xb2, 0xc2, 0xe8, 0x1b, 0x77, 0x48,
+	0x70, 0x1e, 0x84, 0xd6, 0x4c, 0xb0, 0x02, 0x91, 0x42, 0xf8, 0x91, 0xe3, 0x8d, 0xc5, 0x4e, 0x28,
+	0x33, 0x91, 0xd2, 0xfe, 0x5c, 0x06, 0x8a, 0x6d, 0xcf, 0xc1, 0xe2, 0xae, 0x43, 0xc9, 0xb7, 0x1c,
+	0x3d, 0xa9, 0xae, 0xe8, 0x5b, 0xce, 0x81, 0x17, 0x20, 0x62, 0xe2, 0x71, 0x04, 0xdf, 0x9b, 0xc5,
+	0x89, 0x47, 0x88, 0xa8, 0x01, 0x39, 0xa9, 0x01, 0x37, 0xa0, 0x1c, 0x8e, 0x1d, 0x9d, 0xe0, 0x79,
+	0x82, 0x97, 0xc2, 0xb1, 0xd3, 0x47, 0xd4, 0x75, 0x28, 0x99, 0x63, 0x8e, 0x29, 0x10, 0xa6, 0x68,
+	0x8e, 0x11, 0xa1, 0x7d, 0x04, 0x15, 0x66, 0x9c, 0x8a, 0x66, 0x5c, 0x85, 0x22, 0x16, 0x20, 0xb8,
+	0x5c, 0x9e, 0x15, 0xc2, 0xb1, 0xd3, 0x35, 0x11, 0x8c, 0x8d, 0xb0, 0x4d, 0x6a, 0x43, 0x9e, 0x15,
+	0x26, 0x9e, 0xd3, 0x35, 0xb5, 0x11, 0x40, 0xdb, 0xf3, 0xfd, 0x5f, 0xb9, 0x0b, 0x57, 0xa0, 0x60,
+	0x5a, 0xf3, 0xf0, 0x98, 0x33, 0x08, 0xc6, 0x13, 0xda, 0x7d, 0x28, 0xe3, 0xbc, 0xf4, 0xec, 0x20,
+	0x54, 0xef, 0x40, 0xde, 0xb1, 0x83, 0xb0, 0x99, 0xd9, 0xca, 0x2d, 0xcd, 0x1a, 0xc1, 0xb5, 0x2d,
+	0x28, 0xef, 0x1b, 0x67, 0x4f, 0x71, 0xe6, 0xd4, 0x2b, 0x62, 0x0a, 0xc5, 0x94, 0x88, 0xf9, 0xac,
+	0x01, 0x8c, 0x0c, 0xff, 0xc8, 0x0a, 0x89, 0x9f, 0xfd, 0xd7, 0x0c, 0x54, 0x87, 0x8b, 0xf1, 0x97,
+	0x0b, 0xcb, 0x3f, 0xc7, 0x36, 0xdf, 0x83, 0x5c, 0x78, 0x3e, 0xa7, 0x1c, 0x8d, 0xed, 0x6b, 0xbc,
+	0x78, 0x09, 0xff, 0x00, 0x33, 0x31, 0x24, 0xc1, 0x4e, 0xb8, 0x9e, 0x69, 0x45, 0x63, 0x50, 0x60,
+	0x45, 0x4c, 0x76, 0x4d, 0x3c, 0x14, 0xbc, 0xb9, 0x98, 0x85, 0xac, 0x37, 0x57, 0xb7, 0xa0, 0x30,
+	0x39, 0xb6, 0x1d, 0x93, 0x26, 0x20, 0xdd, 0x66, 0x8e, 0xc0, 0x59, 0xf2, 0xbd, 0x53, 0x3d, 0xb0,
+	0xbf, 0x8a, 0x98, 0x7c, 0xc9, 0xf7, 0x4e, 0x87, 0xf6, 0x57, 0x96, 0x36, 0x12, 0x27, 0x0d, 0x40,
+	0x71, 0xd8, 0x6e, 0xf5, 0x5a, 0x4c, 0xb9, 0x84, 0xdf, 0x9d, 0x4f, 0xbb, 0xc3, 0xd1, 0x50, 0xc9,
+	0xa8, 0x0d, 0x80, 0xfe, 0x60, 0xa4, 0x8b, 0x74, 0x56, 0x2d, 0x42, 0xb6, 0xdb, 0x57, 0x72, 0x48,
+	0x83, 0xf0, 0x6e, 0x5f, 0xc9, 0xab, 0x25, 0xc8, 0xb5, 0xfa, 0x9f, 0x29, 0x05, 0xfa, 0xe8, 0xf5,
+	0x94, 0xa2, 0xf6, 0x07, 0x59, 0xa8, 0x0c, 0xc6, 0x5f, 0x58, 0x93, 0x10, 0xfb, 0x8c, 0xab, 0xd4,
+	0xf2, 0x9f, 0x5b, 0x3e, 0x75, 0x3b, 0xc7, 0x44, 0x0a, 0x3b, 0x62, 0x8e, 0xa9, 0x73, 0x39, 0x96,
+	0x35, 0xc7, 0x44, 0x37, 0x39, 0xb6, 0x66, 0x46, 0x33, 0x27, 0xe8, 0x28, 0x85, 0xbb, 0xc2, 0x1b,
+	0x7f, 0x41, 0xdd, 0xcb, 0x31, 0xfc, 0x54, 0xef, 0x42, 0x95, 0x97, 0x21, 0xaf, 0x2f, 0xe0, 0xa0,
+	0xe5, 0xc5, 0x57, 0x94, 0x17, 0x1f, 0xe5, 0xa4, 0x52, 0x39, 0x52, 0x9c, 0x60, 0x1c, 0xd4, 0x17,
+	0x2b, 0xda, 0x1b, 0x7f, 0xc1, 0xb1, 0x65, 0xbe, 0xa2, 0xbd, 0xf1, 0x17, 0x84, 0xfa, 0x3e, 0x6c,
+	0x06, 0x8b, 0x71, 0x30, 0xf1, 0xed, 0x79, 0x68, 0x7b, 0x2e, 0xa7, 0xa9, 0x10, 0x8d, 0x22, 0x23,
+	0x88, 0xf8, 0x1e, 0x94, 0xe7, 0x8b, 0xb1, 0x6e, 0xbb, 0x53, 0x8f, 0x98, 0x7b, 0x75, 0xbb, 0xce,
+	0x27, 0xe6, 0x60, 0x31, 0xee, 0xba, 0x53, 0x8f, 0x95, 0xe6, 0xfc, 0x43, 0x7b, 0x0d, 0x4a, 0x02,
+	0x86, 0xa7, 0x77, 0x68, 0xb9, 0x86, 0x1b, 0xea, 0xf1, 0xb1, 0x5f, 0xe6, 0x80, 0xae, 0xa9, 0xfd,
+	0xf5, 0x0c, 0x28, 0x43, 0xa9, 0x9a, 0x7d, 0x2b, 0x34, 0xd6, 0x72, 0x85, 0xdb, 0x00, 0xc6, 0x64,
+	0xe2, 0x2d, 0x78, 0x31, 0x7c, 0xf1, 0x54, 0x04, 0xa4, 0x6b, 0xca, 0x63, 0x93, 0x4b, 0x8d, 0xcd,
+	0xcb, 0x50, 0x8b, 0xf2, 0x49, 0x1b, 0xba, 0x2a, 0x60, 0xd1, 0xe8, 0x04, 0x8b, 0xd4, 0xae, 0x2e,
+	0x05, 0x0b, 0xbe, 0xad, 0xff, 0x72, 0x16, 0xca, 0x8f, 0x16, 0xee, 0x04, 0x9b, 0xa6, 0xbe, 0x02,
+	0xf9, 0xe9, 0xc2, 0x9d, 0x34, 0x33, 0xf2, 0xd1, 0x10, 0xaf, 0x08, 0x46, 0x48, 0xdc, 0x6b, 0x86,
+	0x7f, 0x84, 0x7b, 0x74, 0x65, 0xaf, 0x21, 0x5c, 0xfb, 0x27, 0x19, 0x5e, 0xe2, 0x23, 0xc7, 0x38,
+	0x52, 0xcb, 0x90, 0xef, 0x0f, 0xfa, 0x1d, 0xe5, 0x92, 0x5a, 0x83, 0x72, 0xb7, 0x3f, 0xea, 0xb0,
+	0x7e, 0xab, 0xa7, 0x64, 0x68, 0xe1, 0x8e, 0x5a, 0x3b, 0xbd, 0x8e, 0x92, 0x45, 0xcc, 0xd3, 0x41,
+	0xaf, 0x35, 0xea, 0xf6, 0x3a, 0x4a, 0x9e, 0x63, 0x58, 0xb7, 0x3d, 0x52, 0xca, 0xaa, 0x02, 0xb5,
+	0x03, 0x36, 0xd8, 0x3d, 0x6c, 0x77, 0xf4, 0xfe, 0x61, 0xaf, 0xa7, 0x28, 0xea, 0x65, 0xd8, 0x88,
+	0x21, 0x03, 0x0e, 0xdc, 0xc2, 0x2c, 0x4f, 0x5b, 0xac, 0xc5, 0x1e, 0x2b, 0x3f, 0x51, 0xcb, 0x90,
+	0x6b, 0x3d, 0x7e, 0xac, 0xfc, 0x0c, 0xf7, 0x40, 0xe5, 0x59, 0xb7, 0xaf, 0x3f, 0x6d, 0xf5, 0x0e,
+	0x3b, 0xca, 0xcf, 0xb2, 0x51, 0x7a, 0xc0, 0x76, 0x3b, 0x4c, 0xf9, 0x59, 0x5e, 0xdd, 0x84, 0xda,
+	0xe7, 0x83, 0x7e, 0x67, 0xbf, 0x75, 0x70, 0x40, 0x0d, 0xf9, 0x59, 0x59, 0xfb, 0x45, 0x1e, 0xf2,
+	0xd8, 0x13, 0x55, 0x4b, 0xf6, 0x7b, 0xdc, 0x45, 0xdc, 0x70, 0x3b, 0xf9, 0x5f, 0xfc, 0xf1, 0xdd,
+	0x4b, 0x7c, 0xa7, 0xbf, 0x0c, 0x39, 0xc7, 0x0e, 0x9b, 0x59, 0x79, 0x95, 0x08, 0x19, 0x68, 0xef,
+	0x12, 0x43, 0x9c, 0x7a, 0x07, 0x32, 0x7c, 0xcb, 0x57, 0xb7, 0x1b, 0x62, 0x19, 0x89, 0x33, 0x63,
+	0xef, 0x12, 0xcb, 0xcc, 0xd5, 0x5b, 0x90, 0x79, 0x2e, 0xf6, 0x7f, 0x8d, 0xe3, 0xf9, 0xa9, 0x81,
+	0xd8, 0xe7, 0xea, 0x16, 0xe4, 0x26, 0x1e, 0x97, 0x70, 0x62, 0x3c, 0xe7, 0xa1, 0x58, 0xfe, 0xc4,
+	0x73, 0xd4, 0x57, 0x20, 0xe7, 0x1b, 0xa7, 0xcd, 0xa2, 0x3c, 0x5d, 0x31, 0x93, 0x46, 0x22, 0xdf,
+	0x38, 0xc5, 0x46, 0x4c, 0x9b, 0x25, 0xb9, 0x11, 0xd1, 0x7c, 0x63, 0x35, 0x53, 0x75, 0x0b, 0x32,
+	0xa7, 0xcd, 0xb2, 0x7c, 0xa8, 0x3f, 0xb3, 0x5d, 0xd3, 0x3b, 0x1d, 0xce, 0xad, 0x09, 0x52, 0x9c,
+	0xaa, 0xdf, 0x83, 0x5c, 0xb0, 0x18, 0xd3, 0x9e, 0xa9, 0x6e, 0x6f, 0xae, 0x70, 0x3f, 0xac, 0x28,
+	0x58, 0x8c, 0xd5, 0xd7, 0x20, 0x3f, 0xf1, 0x7c, 0xbf, 0x09, 0x72, 0x59, 0x09, 0xe3, 0x47, 0x21,
+	0x07, 0xf1, 0x58, 0x61, 0xd8, 0xac, 0xca, 0x44, 0x09, 0xe7, 0xc5, 0x0a, 0x43, 0xf5, 0x55, 0xc1,
+	0xce, 0x6b, 0x72, 0xab, 0x23, 0x66, 0x8f, 0xe5, 0x20, 0x16, 0x27, 0x69, 0x66, 0x9c, 0x35, 0xeb,
+	0x32, 0x51, 0xc4, 0xe5, 0xb1, 0x4d, 0x33, 0xe3, 0x4c, 0x7d, 0x15, 0x72, 0xcf, 0xad, 0x49, 0xb3,
+	0x21, 0xd7, 0x26, 0x26, 0xe9, 0x29, 0x75, 0x0f, 0xd1, 0x78, 0x6e, 0x19, 0x8b, 0x33, 0xdc, 0x76,
+	0x1b, 0xfc, 0x84, 0x31, 0x16, 0x67, 0x5d, 0x13, 0x39, 0x98, 0x6b, 0x3e, 0x27, 0x69, 0x2a, 0xc3,
+	0xf0, 0x13, 0x25, 0xf9, 0xc0, 0x72, 0xac, 0x49, 0x68, 0x3f, 0xb7, 0xc3, 0x73, 0x12, 0xa1, 0x32,
+	0x4c, 0x06, 0xed, 0x14, 0x21, 0x6f, 0x9d, 0xcd, 0x7d, 0x6d, 0x1b, 0x20, 0xa9, 0x07, 0x4b, 0x72,
+	0x2c, 0x37, 0x92, 0x10, 0x1c, 0xcb, 0x45, 0x0e, 0x60, 0x1a, 0xa1, 0x41, 0xcb, 0xa7, 0xc6, 0xe8,
+	0x5b, 0xbb, 0x01, 0x95, 0x58, 0xf4, 0x52, 0x6b, 0x90, 0x31, 0x04, 0xe7, 0xcd, 0x18, 0xda, 0x3d,
+	0x00, 0x81, 0x7a, 0x67, 0xfb, 0x61, 0x1a, 0x87, 0xa9, 0x88, 0x1f, 0x67, 0xc6, 0xda, 0x0f, 0xa1,
+	0xc6, 0xac, 0x60, 0xe1, 0x84, 0x6d, 0xcf, 0xd9, 0xb5, 0xa6, 0xea, 0x9b, 0x00, 0x71, 0x3a, 0x10,
+	0x07, 0x64, 0xb2, 0x98, 0x76, 0xad, 0x29, 0x93, 0xf0, 0xda, 0xef, 0xe6, 0xa1, 0x28, 0x32, 0x26,
+	0x87, 0x79, 0x46, 0x3a, 0xcc, 0x63, 0xd6, 0x95, 0x4d, 0x0b, 0x34, 0xc7, 0xb6, 0x69, 0x5a, 0x6e,
+	0x24, 0xb8, 0xf0, 0x14, 0x8e, 0xbe, 0xe1, 0x1c, 0xd1, 0x0a, 0x6f, 0x6c, 0xab, 0x51, 0xa5, 0xb3,
+	0xb9, 0x6f, 0x05, 0x01, 0x3f, 0x32, 0x0d, 0xe7, 0x28, 0xda, 0x6c, 0x85, 0xaf, 0xdb, 0x6c, 0x37,
+	0xa0, 0xec, 0x7a, 0xa1, 0x4e, 0x6a, 0x45, 0x91, 0xea, 0x28, 0x09, 0xfd, 0x49, 0x7d, 0x1d, 0x4a,
+	0x42, 0x20, 0x6c, 0x96, 0xe4, 0xbd, 0xb8, 0xcb, 0x81, 0x2c, 0xc2, 0xaa, 0x4d, 0x94, 0x2f, 0x66,
+	0x33, 0xcb, 0x0d, 0xa3, 0x23, 0x42, 0x24, 0xd5, 0xef, 0x43, 0xc5, 0x73, 0x75, 0x2e, 0x35, 0x36,
+	0x2b, 0xf2, 0x7a, 0x1a, 0xb8, 0x87, 0x04, 0x65, 0x65, 0x4f, 0x7c, 0x61, 0x53, 0x1c, 0xef, 0x54,
+	0x9f, 0x18, 0xbe, 0x49, 0x4b, 0xbd, 0xcc, 0x4a, 0x8e, 0x77, 0xda, 0x36, 0x7c, 0x93, 0x1f, 0x99,
+	0x5f, 0xba, 0x8b, 0x19, 0x2d, 0xef, 0x3a, 0x13, 0x29, 0xf5, 0x16, 0x54, 0x26, 0xce, 0x22, 0x08,
+	0x2d, 0x7f, 0xe7, 0x9c, 0xeb, 0x01, 0x2c, 0x01, 0x60, 0xbb, 0xe6, 0xbe, 0x3d, 0x33, 0xfc, 0x73,
+	0x5a, 0xcb, 0x65, 0x16, 0x25, 0x51, 0x54, 0x99, 0x9f, 0xd8, 0xe6, 0x19, 0x57, 0x06, 0x18, 0x4f,
+	0x20, 0xfd, 0x31, 0xa9, 0x6a, 0x01, 0x2d, 0xd7, 0x32, 0x8b, 0x92, 0x34, 0x0f, 0xf4, 0x49, 0x6b,
+	0xb6, 0xc2, 0x44, 0x2a, 0x25, 0xef, 0x6d, 0x5e, 0x28, 0xef, 0xa9, 0x29, 0x79, 0xef, 0x4b, 0x28,
+	0x89, 0x11, 0x54, 0xef, 0xf0, 0x35, 0x9d, 0x66, 0x87, 0x9c, 0xe3, 0x23, 0x5c, 0x7d, 0x05, 0xea,
+	0x9e, 0x6f, 0x1f, 0xd9, 0xae, 0x1e, 0x84, 0xbe, 0xed, 0x1e, 0x89, 0xb5, 0x51, 0xe3, 0xc0, 0x21,
+	0xc1, 0xf0, 0x98, 0xc2, 0xd9, 0xd3, 0x8d, 0xb1, 0xed, 0xe0, 0xde, 0xc9, 0x09, 0x2d, 0x78, 0xe1,
+	0x38, 0x2d, 0x0e, 0xd2, 0x06, 0x50, 0x8e, 0xc6, 0xfb, 0xd7, 0x52, 0xa7, 0xf6, 0x1b, 0x50, 0xed,
+	0xba, 0xa6, 0x75, 0x36, 0xa0, 0x93, 0x57, 0x7d, 0x13, 0xd4, 0x89, 0x6f, 0x19, 0xa1, 0xa5, 0x5b,
+	0x67, 0xa1, 0x6f, 0xe8, 0x5c, 0x53, 0xe6, 0x5a, 0xaa, 0xc2, 0x31, 0x1d, 0x44, 0x8c, 0x10, 0xae,
+	0xfd, 0xfb, 0x0c, 0xd4, 0x0f, 0xf8, 0x44, 0x3c, 0xb1, 0xce, 0x77, 0xb9, 0x2c, 0x3f, 0x89, 0x36,
+	0x51, 0x9e, 0xd1, 0xb7, 0x7a, 0x07, 0xaa, 0xf3, 0x13, 0xeb, 0x5c, 0x4f, 0xc9, 0xbd, 0x15, 0x04,
+	0xb5, 0x69, 0xbb, 0xbc, 0x01, 0x45, 0x8f, 0x6a, 0x6f, 0xe6, 0x64, 0xf6, 0x29, 0x35, 0x8b, 0x09,
+	0x02, 0x55, 0x83, 0x7a, 0x5c, 0x94, 0x7c, 0x92, 0x8b, 0xc2, 0x68, 0xba, 0xae, 0x40, 0x01, 0x51,
+	0x41, 0xb3, 0xb0, 0x95, 0x43, 0xe1, 0x95, 0x12, 0xea, 0xdb, 0x50, 0x9f, 0x78, 0xb3, 0xb9, 0x1e,
+	0x65, 0x17, 0x27, 0x42, 0x7a, 0x9b, 0x57, 0x91, 0xe4, 0x80, 0x97, 0xa5, 0xfd, 0x5e, 0x0e, 0xca,
+	0xd4, 0x06, 0xb1, 0xd3, 0x6d, 0xf3, 0x2c, 0xda, 0xe9, 0x15, 0x56, 0xb0, 0x4d, 0x64, 0x7f, 0xb7,
+	0x01, 0x6c, 0x24, 0xd1, 0xa5, 0xfd, 0x5e, 0x21, 0x48, 0xd4, 0x94, 0xb9, 0xe1, 0x87, 0x41, 0x33,
+	0xc7, 0x9b, 0x42, 0x09, 0x5c, 0x82, 0x0b, 0xd7, 0xfe, 0x72, 0xc1, 0x5b, 0x5f, 0x66, 0x22, 0xa5,
+	0xde, 0x03, 0x85, 0x17, 0x46, 0x83, 0x2e, 0x8b, 0x22, 0x0d, 0x82, 0xd3, 0x98, 0x47, 0xb2, 0x1e,
+	0xa7, 0xb1, 0xce, 0xf0, 0x0c, 0xe0, 0xbb, 0x1d, 0x08, 0xd4, 0x41, 0x88, 0xbc, 0x8f, 0x4b, 0xe9,
+	0x7d, 0xdc, 0x84, 0xd2, 0x73, 0x3b, 0xb0, 0x71, 0x56, 0xcb, 0x7c, 0x67, 0x88, 0xa4, 0x34, 0x0d,
+	0x95, 0x17, 0x4d, 0x43, 0xdc, 0x6d, 0xc3, 0x39, 0xe2, 0x42, 0x60, 0xd4, 0xed, 0x96, 0x73, 0xe4,
+	0xa9, 0xef, 0xc0, 0xd5, 0x04, 0x2d, 0x7a, 0x43, 0x26, 0x11, 0xd2, 0xfa, 0x99, 0x1a, 0x53, 0x52,
+	0x8f, 0x48, 0x4a, 0xbf, 0x0f, 0x9b, 0x52, 0x96, 0x39, 0x8a, 0x00, 0x01, 0xb1, 0x81, 0x0a, 0xdb,
+	0x88, 0xc9, 0x49, 0x32, 0x08, 0xb4, 0x7f, 0x99, 0x85, 0xfa, 0x23, 0xcf, 0xb7, 0xec, 0x23, 0x37,
+	0x59, 0x75, 0x2b, 0xb2, 0x62, 0xb4, 0x12, 0xb3, 0xd2, 0x4a, 0xbc, 0x0b, 0xd5, 0x29, 0xcf, 0xa8,
+	0x87, 0x63, 0xae, 0x42, 0xe6, 0x19, 0x08, 0xd0, 0x68, 0xec, 0xe0, 0x0e, 0x8c, 0x08, 0x28, 0x73,
+	0x9e, 0x32, 0x47, 0x99, 0x90, 0xfd, 0xab, 0x1f, 0x13, 0x23, 0x34, 0x2d, 0xc7, 0x0a, 0xf9, 0xf4,
+	0x34, 0xb6, 0x6f, 0x0b, 0x99, 0x41, 0x6e, 0xd3, 0x03, 0x66, 0x4d, 0x5b, 0x24, 0x42, 0x20, 0x5f,
+	0xdc, 0x25, 0x72, 0xf5, 0x63, 0x99, 0x89, 0x16, 0xbf, 0x61, 0x5e, 0xbe, 0xdb, 0xb5, 0x11, 0x54,
+	0x62, 0x30, 0xca, 0x83, 0xac, 0x23, 0x64, 0xc0, 0x4b, 0x6a, 0x15, 0x4a, 0xed, 0xd6, 0xb0, 0xdd,
+	0xda, 0xed, 0x28, 0x19, 0x44, 0x0d, 0x3b, 0x23, 0x2e, 0xf7, 0x65, 0xd5, 0x0d, 0xa8, 0x62, 0x6a,
+	0xb7, 0xf3, 0xa8, 0x75, 0xd8, 0x1b, 0x29, 0x39, 0xb5, 0x0e, 0x95, 0xfe, 0x40, 0x6f, 0xb5, 0x47,
+	0xdd, 0x41, 0x5f, 0xc9, 0x6b, 0x3f, 0x81, 0x72, 0xfb, 0xd8, 0x9a, 0x9c, 0x5c, 0x34, 0x8a, 0xa4,
+	0x82, 0x59, 0x93, 0x93, 0x66, 0x76, 0x85, 0xc9, 0x70, 0x84, 0xf6, 0x14, 0x6a, 0xed, 0x88, 0x4f,
+	0x5f, 0x54, 0xca, 0x36, 0x34, 0x68, 0xf3, 0x4d, 0xc6, 0xd1, 0xee, 0xcb, 0xae, 0xd9, 0x7d, 0x35,
+	0xa4, 0x69, 0x8f, 0xc5, 0xf6, 0x7b, 0x1f, 0xaa, 0x07, 0xbe, 0x37, 0xb7, 0xfc, 0x90, 0x8a, 0x55,
+	0x20, 0x77, 0x62, 0x9d, 0x8b, 0x52, 0xf1, 0x33, 0x51, 0x52, 0xb3, 0xb2, 0x92, 0xba, 0x0d, 0xe5,
+	0x28, 0xdb, 0x37, 0xce, 0xf3, 0x63, 0xa8, 0x8b, 0x3c, 0xb6, 0x15, 0x60, 0x65, 0x0f, 0x00, 0xe6,
+	0x31, 0x40, 0x08, 0x04, 0x91, 0x74, 0x2a, 0x0a, 0x67, 0x12, 0x85, 0xf6, 0xf7, 0xf3, 0xd0, 0x38,
+	0x30, 0xfc, 0xd0, 0xc6, 0xc9, 0xe1, 0xc3, 0xf0, 0x3a, 0xe4, 0x69, 0xc9, 0x73, 0x7d, 0xf8, 0x72,
+	0x2c, 0xda, 0x72, 0x1a, 0x3a, 0xd9, 0x89, 0x40, 0xfd, 0x18, 0x1a, 0xf3, 0x08, 0xac, 0x13, 0x3f,
+	0xe7, 0x63, 0xb3, 0x9c, 0x85, 0xc6, 0xbc, 0x3e, 0x97, 0x93, 0xea, 0x8f, 0xe0, 0x4a, 0x3a, 0xaf,
+	0x15, 0x04, 0x09, 0x1f, 0x95, 0x27, 0xeb, 0x72, 0x2a, 0x23, 0x27, 0x53, 0xdb, 0xb0, 0x99, 0x64,
+	0x9f, 0x78, 0xce, 0x62, 0xe6, 0x06, 0x42, 0xd6, 0xbe, 0xb6, 0x54, 0x7b, 0x9b, 0x63, 0x99, 0x32,
+	0x5f, 0x82, 0xa8, 0x1a, 0xd4, 0x62, 0x58, 0x7f, 0x31, 0xa3, 0x2d, 0x91, 0x67, 0x29, 0x98, 0xfa,
+	0x2e, 0x40, 0x9c, 0x0e, 0x9a, 0xc5, 0xad, 0xdc, 0x9a, 0xfe, 0x75, 0x43, 0x6b, 0xc6, 0x24, 0x32,
+	0x94, 0x08, 0x90, 0x19, 0xf8, 0x76, 0x78, 0x3c, 0x23, 0x2e, 0x96, 0x63, 0x09, 0x80, 0x98, 0x65,
+	0xa0, 0xa3, 0xca, 0x16, 0x67, 0x11, 0x0c, 0xad, 0x61, 0x07, 0xc3, 0xc5, 0x38, 0x2e, 0x17, 0x8f,
+	0xc1, 0xa4, 0x97, 0xb3, 0xe0, 0x48, 0x28, 0xb6, 0x49, 0x0b, 0xf7, 0x83, 0x23, 0x75, 0x1b, 0xae,
+	0x26, 0x44, 0x09, 0xff, 0x0d, 0x9a, 0x40, 0x9c, 0x3b, 0x19, 0xbe, 0x98, 0x09, 0x07, 0xea, 0x0f,
+	0xa1, 0x9e, 0xae, 0x9f, 0x0b, 0xec, 0xd7, 0x63, 0xe9, 0x3f, 0xbd, 0x24, 0x58, 0x2d, 0x90, 0x40,
+	0xda, 0x3f, 0xcb, 0xc1, 0xe6, 0x0a, 0xcd, 0xff, 0x5f, 0x36, 0x12, 0x2c, 0xbd, 0x02, 0x8a, 0xcb,
+	0x2b, 0x20, 0xbd, 0xa8, 0x4a, 0xdf, 0x6c, 0x51, 0x7d, 0x02, 0x2f, 0xcd, 0x0d, 0xdf, 0x72, 0x43,
+	0x7d, 0xed, 0x08, 0x94, 0x57, 0x46, 0xe0, 0x06, 0x27, 0x3f, 0x58, 0x1d, 0x07, 0xed, 0x13, 0xa8,
+	0xa7, 0xc0, 0x2f, 0x14, 0xc8, 0x6e, 0x40, 0x19, 0xff, 0xa3, 0x38, 0x26, 0x18, 0x50, 0x09, 0xd3,
+	0xc3, 0xd0, 0xd7, 0x2c, 0x50, 0x96, 0x07, 0x4d, 0x7d, 0x95, 0x8c, 0x7d, 0xf8, 0xb9, 0xc6, 0x68,
+	0x17, 0xa1, 0xd0, 0x76, 0xb3, 0x3a, 0x1b, 0x59, 0x5a, 0xb5, 0x2b, 0xa3, 0xae, 0xfd, 0xad, 0x2c,
+	0xd4, 0x53, 0x83, 0xa3, 0x7e, 0x4f, 0x5e, 0x47, 0x12, 0xe3, 0x4e, 0x96, 0x0c, 0x49, 0x1c, 0x6f,
+	0x80, 0xe2, 0xf9, 0xa6, 0xed, 0x1a, 0x64, 0x7c, 0x24, 0x38, 0x75, 0xa1, 0xce, 0x36, 0x04, 0xfc,
+	0x40, 0x80, 0x51, 0x01, 0x34, 0xad, 0xd8, 0x96, 0x23, 0x2c, 0x31, 0x32, 0x48, 0x96, 0x4e, 0xf2,
+	0x69, 0xe9, 0xe4, 0x75, 0xa8, 0x38, 0x56, 0x10, 0xe8, 0xe1, 0xb1, 0xe1, 0x36, 0x0b, 0x2b, 0x9d,
+	0x2e, 0x23, 0x72, 0x74, 0x6c, 0xb8, 0x48, 0x68, 0xbb, 0xba, 0xf0, 0xd6, 0x14, 0x57, 0x09, 0x6d,
+	0x97, 0x74, 0x5c, 0x94, 0xfb, 0xae, 0xac, 0xdb, 0xd8, 0x42, 0x2c, 0x52, 0x57, 0xf7, 0xb5, 0x76,
+	0x1b, 0x4a, 0x4f, 0x6d, 0xeb, 0x54, 0x9c, 0x65, 0xcf, 0x6d, 0xeb, 0x34, 0x3a, 0xcb, 0xf0, 0x5b,
+	0xfb, 0x77, 0x65, 0x28, 0x13, 0xf1, 0xee, 0xc5, 0x46, 0xde, 0x6f, 0xa3, 0x00, 0x6e, 0x41, 0x3e,
+	0x16, 0x35, 0x96, 0x4f, 0x44, 0xc2, 0xa0, 0xb4, 0x25, 0xc9, 0x50, 0x5c, 0x22, 0xac, 0x84, 0xb1,
+	0xe8, 0x84, 0x9a, 0x13, 0x09, 0xe6, 0xc1, 0x97, 0x8e, 0xb0, 0x09, 0x26, 0x00, 0xf5, 0x01, 0xd7,
+	0x6b, 0xc8, 0x66, 0x55, 0x92, 0x39, 0x04, 0xf5, 0x21, 0x32, 0x73, 0x90, 0xb2, 0x83, 0x09, 0x92,
+	0x0f, 0x2d, 0x3f, 0xde, 0x0c, 0x75, 0x16, 0x25, 0x91, 0x35, 0xa1, 0xf0, 0xdc, 0xac, 0xca, 0xa5,
+	0xa4, 0xa4, 0x7f, 0x46, 0x04, 0xea, 0x3d, 0x28, 0x91, 0xc8, 0x66, 0xa1, 0x04, 0x27, 0x1d, 0x9d,
+	0x91, 0x30, 0xcd, 0x22, 0xb4, 0xfa, 0x06, 0x14, 0xa6, 0x27, 0xd6, 0x79, 0xd0, 0xac, 0xcb, 0xbb,
+	0x37, 0x25, 0x0b, 0x31, 0x4e, 0xa1, 0xbe, 0x0a, 0x0d, 0xdf, 0x9a, 0xea, 0x64, 0xf6, 0x45, 0xe1,
+	0x2d, 0x68, 0x36, 0x48, 0x36, 0xab, 0xf9, 0xd6, 0xb4, 0x8d, 0xc0, 0xd1, 0xd8, 0x09, 0xd4, 0xd7,
+	0xa0, 0x48, 0x52, 0x09, 0xaa, 0x7d, 0x52, 0xcd, 0x91, 0x88, 0xc3, 0x04, 0x56, 0xdd, 0x86, 0x4a,
+	0xc2, 0xb6, 0xaf, 0x52, 0x87, 0xae, 0x2c, 0xb1, 0x0e, 0xce, 0xb3, 0x13, 0x32, 0xf5, 0x1d, 0x00,
+	0xa1, 0x90, 0xea, 0xe3, 0x73, 0x72, 0xa4, 0x54, 0x63, 0x85, 0x5d, 0x12, 0x80, 0x64, 0xb5, 0xf5,
+	0x75, 0x28, 0xa0, 0x94, 0x10, 0x34, 0xaf, 0x6f, 0xe5, 0x12, 0x89, 0x5a, 0x12, 0x6b, 0x18, 0xc7,
+	0xa3, 0x4d, 0x15, 0x17, 0x97, 0x8e, 0x53, 0xd8, 0x94, 0x35, 0x74, 0xb1, 0x12, 0x51, 0x4a, 0xb7,
+	0x4e, 0x87, 0x5f, 0x3a, 0xea, 0x7d, 0xc8, 0x9b, 0xd6, 0x34, 0x68, 0xde, 0xd8, 0xca, 0x25, 0xfc,
+	0x36, 0x5a, 0x8f, 0xa8, 0xd0, 0xf3, 0x33, 0x02, 0x69, 0xd4, 0x3d, 0x68, 0xe0, 0xd2, 0xdb, 0x26,
+	0xc5, 0x0b, 0x87, 0xbc, 0x79, 0x93, 0x72, 0xbd, 0xbc, 0x94, 0xab, 0x2f, 0x88, 0x68, 0x82, 0x3a,
+	0x6e, 0xe8, 0x9f, 0xb3, 0xba, 0x2b, 0xc3, 0xd4, 0x9b, 0x50, 0xb6, 0x83, 0x9e, 0x37, 0x39, 0xb1,
+	0xcc, 0xe6, 0x4b, 0xdc, 0xf7, 0x1a, 0xa5, 0xd5, 0x8f, 0xa0, 0x4e, 0x8b, 0x11, 0x93, 0x58, 0x79,
+	0xf3, 0x96, 0x7c, 0x76, 0x8d, 0x64, 0x14, 0x4b, 0x53, 0xa2, 0xb8, 0x6d, 0x07, 0x7a, 0x68, 0xcd,
+	0xe6, 0x9e, 0x8f, 0xba, 0xfd, 0x6d, 0xae, 0xf0, 0xda, 0xc1, 0x28, 0x02, 0xe1, 0x39, 0x1f, 0xbb,
+	0x7d, 0x75, 0x6f, 0x3a, 0x0d, 0xac, 0xb0, 0x79, 0x87, 0xf6, 0x5a, 0x23, 0xf2, 0xfe, 0x0e, 0x08,
+	0x4a, 0x4a, 0x49, 0xa0, 0x9b, 0xe7, 0xae, 0x31, 0xb3, 0x27, 0xcd, 0xbb, 0xdc, 0x84, 0x60, 0x07,
+	0xbb, 0x1c, 0x20, 0x6b, 0xf1, 0x5b, 0xb2, 0x16, 0x7f, 0xf3, 0x31, 0x69, 0xf1, 0xd4, 0x9e, 0xf7,
+	0x97, 0xe4, 0xbe, 0xd4, 0x42, 0x97, 0x04, 0x44, 0xf4, 0xb0, 0x25, 0x84, 0x3b, 0x05, 0xc8, 0x99,
+	0xd6, 0xf4, 0xe6, 0x4f, 0x40, 0x5d, 0x1d, 0xc9, 0x17, 0x09, 0xa1, 0x05, 0x21, 0x84, 0x7e, 0x9c,
+	0x7d, 0x98, 0xd1, 0x3e, 0x82, 0x7a, 0x6a, 0x5b, 0xae, 0x15, 0xa6, 0xb9, 0x52, 0x69, 0xcc, 0x84,
+	0x5d, 0x8c, 0x27, 0xb4, 0x3f, 0xca, 0x41, 0x6d, 0xcf, 0x08, 0x8e, 0xf7, 0x8d, 0xf9, 0x30, 0x34,
+	0xc2, 0x00, 0xc7, 0xf6, 0xd8, 0x08, 0x8e, 0x67, 0xc6, 0x9c, 0xbb, 0x47, 0x32, 0xdc, 0x10, 0x27,
+	0x60, 0xe8, 0x22, 0xc1, 0x59, 0xc5, 0xe4, 0xc0, 0x3d, 0x78, 0x22, 0xdc, 0x6c, 0x71, 0x1a, 0xf9,
+	0x40, 0x70, 0xbc, 0x98, 0x4e, 0x1d, 0x4b, 0xf0, 0xab, 0x28, 0xa9, 0xbe, 0x0a, 0x75, 0xf1, 0x49,
+	0xea, 0xfb, 0x99, 0xf0, 0xb9, 0xa7, 0x81, 0xea, 0xbb, 0x50, 0x15, 0x80, 0x51, 0xc4, 0xb5, 0x1a,
+	0xb1, 0x61, 0x34, 0x41, 0x30, 0x99, 0x4a, 0xfd, 0x29, 0x5c, 0x95, 0x92, 0x8f, 0x3c, 0x7f, 0x7f,
+	0xe1, 0x84, 0x76, 0xbb, 0x2f, 0x74, 0xa5, 0x97, 0x56, 0xb2, 0x27, 0x24, 0x6c, 0x7d, 0xce, 0x74,
+	0x6b, 0xf7, 0x6d, 0x57, 0x48, 0x92, 0x69, 0xe0, 0x12, 0x95, 0x71, 0xd6, 0x2c, 0xaf, 0x50, 0x19,
+	0x67, 0xb8, 0xd2, 0x05, 0x60, 0xdf, 0x0a, 0x8f, 0x3d, 0xb3, 0x59, 0x91, 0x57, 0xfa, 0x50, 0x46,
+	0xb1, 0x34, 0x25, 0x0e, 0x27, 0x9a, 0x71, 0x26, 0x6e, 0x48, 0xea, 0x72, 0x8e, 0x45, 0x49, 0x3c,
+	0x17, 0x7c, 0xc3, 0x3d, 0xb2, 0x82, 0x66, 0x75, 0x2b, 0x77, 0x2f, 0xc3, 0x44, 0x4a, 0xfb, 0xb3,
+	0x59, 0x28, 0xf0, 0x99, 0x7c, 0x09, 0x2a, 0x63, 0x0c, 0xaa, 0xd0, 0xd1, 0x6a, 0x26, 0x7c, 0x27,
+	0x04, 0x40, 0x19, 0x89, 0xd4, 0xdc, 0x80, 0xdb, 0xd8, 0x33, 0x8c, 0xbe, 0xb1, 0x48, 0x6f, 0x11,
+	0x62, 0x5d, 0x39, 0x82, 0x8a, 0x14, 0x36, 0xc2, 0xf7, 0x4e, 0x69, 0x35, 0xe4, 0x09, 0x11, 0x25,
+	0xb1, 0x0a, 0x7e, 0xc4, 0x60, 0xa6, 0x02, 0xe1, 0xca, 0x04, 0x68, 0xbb, 0xe1, 0xb2, 0x45, 0xb7,
+	0xb8, 0x62, 0xd1, 0xc5, 0xe0, 0x89, 0xa9, 0xe7, 0x4f, 0xac, 0x81, 0x6b, 0xb5, 0xfb, 0x34, 0xc2,
+	0x65, 0x26, 0x41, 0xd4, 0x0f, 0xe2, 0xb5, 0x48, 0x3d, 0x6a, 0x96, 0x65, 0xe6, 0x29, 0xaf, 0x5a,
+	0x96, 0xa2, 0xd3, 0x9e, 0x01, 0x30, 0xef, 0x34, 0xb0, 0x42, 0x12, 0xaf, 0xae, 0x53, 0xf3, 0x53,
+	0x5e, 0x51, 0xef, 0x14, 0x9d, 0x9f, 0xc2, 0xb9, 0x9c, 0x8d, 0x9d, 0xcb, 0xb1, 0x24, 0x96, 0x5b,
+	0x2f, 0x89, 0x69, 0x6f, 0x41, 0x09, 0x8f, 0x58, 0x23, 0x34, 0xd0, 0x90, 0x4e, 0x56, 0x66, 0x2e,
+	0x62, 0x09, 0xfb, 0x77, 0x52, 0xab, 0xb0, 0x3b, 0xf7, 0xa2, 0x96, 0x50, 0x9e, 0x97, 0x25, 0x2b,
+	0x57, 0xcc, 0xaa, 0x45, 0x81, 0xe2, 0xd0, 0x7e, 0x09, 0x2a, 0xd8, 0x58, 0x72, 0x30, 0x89, 0x96,
+	0xa1, 0xab, 0xb2, 0x8d, 0x69, 0xed, 0x3f, 0x64, 0xa0, 0x3a, 0xf0, 0x4d, 0x3c, 0x23, 0xd0, 0x85,
+	0xf0, 0x42, 0xc1, 0x11, 0x8f, 0x78, 0xcf, 0x71, 0x8c, 0x58, 0xec, 0xaa, 0xb0, 0x04, 0xa0, 0xbe,
+	0x03, 0xf9, 0xa9, 0x63, 0x1c, 0x35, 0x73, 0xb2, 0x41, 0x41, 0x2a, 0x3e, 0xfa, 0x46, 0x6f, 0x13,
+	0x23, 0x52, 0xed, 0xb7, 0xa0, 0x2a, 0x01, 0x53, 0x8e, 0xa7, 0x4b, 0xe4, 0xec, 0x1c, 0xb6, 0x95,
+	0x0c, 0x7a, 0xa6, 0x76, 0x3b, 0xc3, 0x36, 0x37, 0x23, 0xa0, 0x41, 0x61, 0xa8, 0x3f, 0xea, 0xb2,
+	0xe1, 0x48, 0xc9, 0x93, 0xf7, 0x94, 0x00, 0xbd, 0xd6, 0x10, 0xdd, 0x50, 0x00, 0xc5, 0xc3, 0x7e,
+	0xf7, 0xa7, 0x87, 0x1d, 0x45, 0xd1, 0xfe, 0x6d, 0x06, 0x20, 0xf1, 0x8f, 0xa8, 0xdf, 0x87, 0xea,
+	0x29, 0xa5, 0x74, 0xc9, 0x71, 0x26, 0xf7, 0x11, 0x38, 0x9a, 0xc4, 0x8f, 0x1f, 0x48, 0x6a, 0x01,
+	0x1e, 0xb3, 0xab, 0x1e, 0xb4, 0xea, 0x3c, 0x39, 0xa1, 0xd5, 0x37, 0xa1, 0xec, 0x61, 0x3f, 0x90,
+	0x34, 0x27, 0x9f, 0xb1, 0x52, 0xf7, 0x59, 0xc9, 0xf3, 0xcd, 0xe8, 0x38, 0x9e, 0xfa, 0x91, 0xd5,
+	0x30, 0x26, 0x7d, 0x84, 0xa0, 0xb6, 0x63, 0x2c, 0x02, 0x8b, 0x71, 0x7c, 0xcc, 0x76, 0x0b, 0x09,
+	0xdb, 0xd5, 0x3e, 0x87, 0xc6, 0xd0, 0x98, 0xcd, 0x39, 0x73, 0xa6, 0x8e, 0xa9, 0x90, 0xc7, 0x35,
+	0x21, 0x16, 0x23, 0x7d, 0xe3, 0x16, 0x3b, 0xb0, 0xfc, 0x89, 0xe5, 0x46, 0x3b, 0x32, 0x4a, 0x22,
+	0xb3, 0x3d, 0x0c, 0x6c, 0xf7, 0x88, 0x79, 0xa7, 0x51, 0xf8, 0x52, 0x94, 0xd6, 0xfe, 0x4e, 0x06,
+	0xaa, 0x52, 0x33, 0xd4, 0xb7, 0x52, 0x5a, 0xe0, 0x4b, 0x2b, 0xed, 0xe4, 0xdf, 0x92, 0x36, 0xf8,
+	0x1a, 0x14, 0x82, 0xd0, 0xf0, 0x23, 0x57, 0x9b, 0x22, 0xe5, 0xd8, 0xf1, 0x16, 0xae, 0xc9, 0x38,
+	0x1a, 0xfd, 0x08, 0x96, 0x6b, 0x36, 0x73, 0x17, 0x50, 0x21, 0x52, 0xdb, 0x82, 0x4a, 0x5c, 0x3c,
+	0x2e, 0x01, 0x36, 0x78, 0x36, 0x54, 0x2e, 0xa9, 0x15, 0x28, 0xb0, 0x56, 0xff, 0x71, 0x47, 0xc9,
+	0x68, 0xff, 0x28, 0x03, 0x90, 0xe4, 0x52, 0x1f, 0xa4, 0x5a, 0x7b, 0x73, 0xb9, 0xd4, 0x07, 0xf4,
+	0x57, 0x6a, 0xec, 0x2d, 0xa8, 0x2c, 0x5c, 0x02, 0x5a, 0xa6, 0x38, 0x77, 0x12, 0x00, 0x06, 0x97,
+	0x44, 0x81, 0x4e, 0x4b, 0xc1, 0x25, 0xcf, 0x0d, 0x47, 0xfb, 0x18, 0x2a, 0x71, 0x71, 0x68, 0xcb,
+	0x7a, 0x34, 0xe8, 0xf5, 0x06, 0xcf, 0xba, 0xfd, 0xc7, 0xca, 0x25, 0x4c, 0x1e, 0xb0, 0x4e, 0xbb,
+	0xb3, 0x8b, 0xc9, 0x0c, 0xae, 0xd9, 0xf6, 0x21, 0x63, 0x9d, 0xfe, 0x48, 0x67, 0x83, 0x67, 0x4a,
+	0x56, 0xfb, 0xf3, 0x79, 0xd8, 0x1c, 0xb8, 0xbb, 0x8b, 0xb9, 0x63, 0x4f, 0x8c, 0xd0, 0x7a, 0x62,
+	0x9d, 0xb7, 0xc3, 0x33, 0x3c, 0x4e, 0x8d, 0x30, 0xf4, 0xf9, 0x66, 0xae, 0x30, 0x9e, 0xe0, 0xb6,
+	0xd8, 0xc0, 0xf2, 0x43, 0x32, 0x35, 0xcb, 0xbb, 0xb8, 0xc1, 0xe1, 0x6d, 0xcf, 0xa1, 0xbd, 0xac,
+	0xfe, 0x08, 0xae, 0x72, 0xfb, 0x2d, 0xa7, 0x44, 0xf9, 0x52, 0x17, 0xbc, 0x67, 0x79, 0xe9, 0xaa,
+	0x9c, 0x10, 0xb3, 0x22, 0x19, 0xc2, 0xd0, 0x24, 0x99, 0x64, 0xe7, 0x5a, 0x40, 0x85, 0x41, 0x4c,
+	0x48, 0x2d, 0x41, 0x7b, 0x63, 0xd4, 0x6a, 0x1d, 0x7d, 0x1d, 0xa8, 0x19, 0x15, 0x58, 0xc3, 0x4b,
+	0x3a, 0x83, 0x47, 0xee, 0xa7, 0xb0, 0x99, 0xa2, 0xa4, 0x56, 0x70, 0xdd, 0xe8, 0xcd, 0xc8, 0x55,
+	0xb3, 0xd4, 0x7b, 0x19, 0x82, 0xcd, 0xe1, 0xc2, 0xdf, 0x86, 0x97, 0x86, 0x22, 0x33, 0xb3, 0x03,
+	0xdd, 0x3e, 0x72, 0x3d, 0xdf, 0x12, 0xec, 0xbd, 0x6c, 0x07, 0x5d, 0x4a, 0x27, 0xea, 0x89, 0x14,
+	0x59, 0xc0, 0x4f, 0x93, 0xc8, 0xb1, 0xce, 0xd1, 0x36, 0x3f, 0x2f, 0xf3, 0xac, 0x44, 0xe9, 0xae,
+	0x89, 0x96, 0x19, 0x8e, 0x8a, 0x34, 0x0e, 0x20, 0x8d, 0xa3, 0x46, 0xc0, 0xa7, 0x1c, 0x76, 0xb3,
+	0x0f, 0x57, 0xd6, 0x35, 0x72, 0x8d, 0x5c, 0xb5, 0x25, 0xcb, 0x55, 0x4b, 0xb6, 0xca, 0x44, 0xc6,
+	0xfa, 0xc3, 0x2c, 0x54, 0xba, 0x7c, 0x0a, 0xc3, 0x33, 0xf4, 0x50, 0xfb, 0xd6, 0xf4, 0x22, 0x6f,
+	0x3e, 0xe2, 0xd0, 0x34, 0x6d, 0x98, 0xa6, 0x6e, 0x4c, 0xa7, 0xd6, 0x24, 0xb4, 0x4c, 0x1d, 0xcf,
+	0x4c, 0xb1, 0x6c, 0x37, 0x0c, 0xd3, 0x6c, 0x09, 0x38, 0x6d, 0x7f, 0x6e, 0x95, 0x8a, 0xd4, 0x04,
+	0xea, 0x87, 0xd8, 0xec, 0x0d, 0x3b, 0x10, 0x5a, 0x02, 0x49, 0x78, 0xe8, 0x4f, 0xe3, 0x7d, 0x37,
+	0xad, 0xa9, 0xe0, 0x47, 0x8d, 0xb4, 0x58, 0x2e, 0x4e, 0x60, 0x6e, 0x8f, 0xbc, 0xbc, 0xac, 0xc4,
+	0xda, 0x26, 0x77, 0x70, 0xe4, 0xd9, 0x66, 0x5a, 0x87, 0xed, 0x9a, 0xc1, 0xc5, 0xd6, 0xac, 0xe2,
+	0xc5, 0xd6, 0xac, 0x94, 0x99, 0x0c, 0x17, 0x59, 0x89, 0x96, 0x7b, 0xc2, 0x8e, 0xbb, 0xe6, 0x99,
+	0xf6, 0x37, 0x73, 0xe8, 0x2a, 0x9d, 0x3b, 0xc6, 0xc4, 0xfa, 0x7f, 0x67, 0xf4, 0xee, 0xa2, 0x41,
+	0xc2, 0xb1, 0x42, 0xdc, 0x62, 0xae, 0x19, 0xc5, 0xd4, 0x70, 0x50, 0xdb, 0x23, 0x06, 0xb6, 0x76,
+	0x78, 0x8b, 0xdf, 0x7a, 0x78, 0x4b, 0xdf, 0x62, 0x78, 0xcb, 0xab, 0xc3, 0xab, 0xfe, 0x04, 0x6e,
+	0xfb, 0xd6, 0xa9, 0x6f, 0x87, 0x96, 0x3e, 0xf5, 0xbd, 0x99, 0x9e, 0xda, 0xce, 0xb8, 0xda, 0x2b,
+	0x34, 0x1a, 0x37, 0x04, 0xd1, 0x23, 0xdf, 0x9b, 0xa5, 0xb7, 0xb4, 0xf6, 0xbf, 0xf2, 0x50, 0x6d,
+	0xb9, 0x86, 0x73, 0xfe, 0x95, 0x45, 0x71, 0x37, 0xe4, 0xa9, 0x99, 0x2f, 0x42, 0x3e, 0xee, 0xdc,
+	0x1f, 0x5e, 0x21, 0x08, 0x8d, 0xf8, 0x5d, 0xa8, 0x7a, 0x8b, 0x30, 0xc6, 0x73, 0x0f, 0x39, 0x70,
+	0x10, 0x11, 0xc4, 0xf9, 0x49, 0x6a, 0xcc, 0x49, 0xf9, 0x49, 0x83, 0x48, 0xf2, 0xc7, 0x52, 0x65,
+	0x9c, 0x9f, 0x08, 0x70, 0x8b, 0xdb, 0x33, 0x1a, 0xf9, 0x60, 0x31, 0xb3, 0xf8, 0xe8, 0xe7, 0x78,
+	0x7c, 0x63, 0x5b, 0xc0, 0xb0, 0x94, 0x99, 0x35, 0xf3, 0xfc, 0x73, 0x5e, 0x0a, 0xb7, 0xf4, 0x01,
+	0x07, 0x51, 0x29, 0x6f, 0x82, 0x7a, 0x6a, 0xd8, 0xa1, 0x9e, 0x2e, 0x8a, 0x4b, 0xf2, 0x0a, 0x62,
+	0x46, 0x72, 0x71, 0xd7, 0xa0, 0x68, 0xda, 0xc1, 0x49, 0x77, 0x20, 0xa4, 0x78, 0x91, 0x42, 0x2e,
+	0x16, 0xbc, 0xdb, 0x1d, 0xe8, 0xe3, 0x73, 0xe1, 0xc2, 0xce, 0xb1, 0x32, 0x02, 0x76, 0xce, 0x43,
+	0x72, 0xbe, 0x11, 0x92, 0xf7, 0x96, 0x33, 0x7c, 0x2e, 0xa9, 0x37, 0x10, 0xde, 0x45, 0x30, 0x67,
+	0xf8, 0xf7, 0x61, 0x93, 0x28, 0x45, 0xc7, 0x39, 0x69, 0x95, 0x48, 0x37, 0x10, 0x31, 0x58, 0x84,
+	0x31, 0xed, 0x2d, 0xa8, 0xb8, 0x56, 0x78, 0xea, 0xf9, 0xd8, 0x9a, 0x1a, 0x1f, 0xbd, 0x18, 0x80,
+	0x22, 0x41, 0x30, 0x31, 0x5c, 0x6c, 0x7c, 0xb3, 0x2e, 0xda, 0x23, 0xd2, 0x28, 0x52, 0xf3, 0x83,
+	0x86, 0xb0, 0x0d, 0x3e, 0x24, 0x09, 0x44, 0xfd, 0x08, 0x6e, 0xa4, 0x46, 0x43, 0x37, 0x7c, 0xdf,
+	0x38, 0xd7, 0x67, 0xc6, 0x17, 0x9e, 0x4f, 0xc6, 0x8f, 0x1c, 0xbb, 0x26, 0x0f, 0x72, 0x0b, 0xd1,
+	0xfb, 0x88, 0xbd, 0x30, 0xab, 0xed, 0x7a, 0xe8, 0x15, 0xbf, 0x20, 0x2b, 0x62, 0x49, 0x61, 0xa7,
+	0x01, 0x22, 0xfd, 0x23, 0x20, 0x4f, 0x79, 0x8e, 0x55, 0x09, 0xb6, 0x43, 0x20, 0xcd, 0x97, 0x5c,
+	0x21, 0x07, 0xfe, 0xc2, 0xb5, 0xb8, 0xf1, 0x80, 0x3e, 0x4d, 0xe1, 0x49, 0x8e, 0xd3, 0xea, 0x2e,
+	0x5c, 0xe6, 0x8a, 0x84, 0x65, 0xea, 0x92, 0x35, 0x37, 0x7b, 0xb1, 0x35, 0x57, 0x8d, 0xe8, 0x63,
+	0x70, 0xa0, 0xfd, 0x2c, 0x03, 0x37, 0x07, 0xe4, 0xd5, 0xa6, 0x1d, 0xb7, 0x6f, 0x05, 0x81, 0x71,
+	0x84, 0x5a, 0xe0, 0xa3, 0xc5, 0x57, 0x5f, 0xa1, 0x0d, 0x61, 0xe3, 0x80, 0xac, 0xb8, 0xf1, 0x7e,
+	0x14, 0xc7, 0xc6, 0x32, 0x58, 0x7d, 0x48, 0x66, 0x58, 0xcb, 0x0d, 0x0f, 0xe3, 0x03, 0xb8, 0x99,
+	0x5d, 0x63, 0x98, 0x5b, 0xa1, 0xd2, 0xfe, 0xe8, 0x25, 0xc8, 0xf7, 0x3d, 0xd3, 0x52, 0xdf, 0x86,
+	0x0a, 0x45, 0x37, 0xae, 0x9a, 0xf1, 0x11, 0x4d, 0x7f, 0x48, 0x16, 0x2a, 0xbb, 0xe2, 0xeb, 0xe2,
+	0x78, 0xc8, 0x97, 0x49, 0xaa, 0x23, 0xf7, 0x31, 0x72, 0xb8, 0xaa, 0xd0, 0x33, 0x11, 0xc4, 0x38,
+	0x06, 0xc7, 0x96, 0x4c, 0x62, 0xbe, 0xe5, 0x92, 0xec, 0x50, 0x60, 0x71, 0x9a, 0x64, 0x69, 0xdf,
+	0x43, 0x6e, 0xac, 0x53, 0xa8, 0x50, 0x61, 0x8d, 0x2c, 0xcd, 0xf1, 0x14, 0x20, 0xfa, 0x36, 0x54,
+	0xbe, 0xf0, 0x6c, 0x97, 0x37, 0xbc, 0xb8, 0xd2, 0xf0, 0x4f, 0x3c, 0x9b, 0xfb, 0x1f, 0xca, 0x5f,
+	0x88, 0x2f, 0xf5, 0x15, 0x28, 0x79, 0x2e, 0x2f, 0xbb, 0xb4, 0x52, 0x76, 0xd1, 0x73, 0x7b, 0x3c,
+	0x04, 0xa9, 0x3e, 0x5e, 0xa0, 0xd1, 0x0e, 0x49, 0xad, 0x69, 0x28, 0xbc, 0x34, 0x55, 0x02, 0x0e,
+	0xdc, 0x9e, 0x35, 0xc5, 0xe0, 0x92, 0xea, 0xd4, 0x76, 0x90, 0xe9, 0x53, 0x61, 0x95, 0x95, 0xc2,
+	0x80, 0xa3, 0xa9, 0xc0, 0xef, 0x41, 0xf9, 0xc8, 0xf7, 0x16, 0x73, 0x94, 0xf9, 0x61, 0x85, 0xb2,
+	0x44, 0xb8, 0x9d, 0x73, 0xec, 0x3d, 0x7d, 0xda, 0xee, 0x91, 0x8e, 0x46, 0xa3, 0xea, 0x6a, 0xef,
+	0x23, 0xfc, 0xd0, 0xa2, 0x52, 0x8d, 0xa3, 0x23, 0x5d, 0xc4, 0x54, 0xad, 0x94, 0x6a, 0x1c, 0x1d,
+	0x51, 0xe5, 0x0f, 0xa0, 0x7e, 0x8a, 0x01, 0x15, 0x73, 0x6b, 0xc2, 0x69, 0xeb, 0xab, 0xc5, 0x9e,
+	0xda, 0x2e, 0xea, 0x07, 0x44, 0x2f, 0x2b, 0x28, 0x8d, 0x17, 0x2a, 0x28, 0x5b, 0x50, 0x70, 0xec,
+	0x99, 0x1d, 0x52, 0xd0, 0xca, 0x92, 0x04, 0x43, 0x08, 0x55, 0x83, 0xa2, 0x30, 0x82, 0x29, 0x2b,
+	0x24, 0x02, 0x93, 0x3e, 0x1c, 0x37, 0x5f, 0x70, 0x38, 0xde, 0x03, 0x8c, 0x02, 0xd5, 0xf1, 0x18,
+	0x57, 0xd7, 0x1f, 0xe3, 0x45, 0x6f, 0xfc, 0x05, 0x06, 0xbb, 0xbe, 0x0f, 0x0d, 0xe1, 0x3a, 0x89,
+	0x32, 0x5c, 0x5e, 0x9f, 0xa1, 0xc6, 0xc9, 0x06, 0x3c, 0xdb, 0x3b, 0x50, 0xf5, 0x49, 0x73, 0xd6,
+	0x49, 0xcd, 0xbe, 0x22, 0xab, 0x1e, 0x89, 0x4a, 0xcd, 0xc0, 0x8f, 0xbf, 0xf1, 0xd0, 0xe0, 0xd1,
+	0x27, 0x3c, 0xdc, 0x20, 0x20, 0x63, 0x6b, 0x85, 0xd5, 0x08, 0xc8, 0x43, 0x11, 0x02, 0xf4, 0xd1,
+	0x46, 0xa7, 0x7a, 0x78, 0xd6, 0xbc, 0x2e, 0x37, 0x85, 0x7b, 0xdb, 0xdb, 0xe1, 0x19, 0xab, 0x98,
+	0xd1, 0x27, 0xb2, 0xae, 0xb1, 0xed, 0x9a, 0xb8, 0x1c, 0x42, 0xe3, 0x28, 0x68, 0x36, 0x69, 0xb7,
+	0x54, 0x05, 0x6c, 0x64, 0x1c, 0x05, 0xea, 0x7b, 0x50, 0x33, 0xf8, 0xd9, 0xc9, 0xa3, 0x5b, 0x6f,
+	0xc8, 0x6a, 0xa2, 0x74, 0xaa, 0xb2, 0xaa, 0x91, 0x24, 0xd4, 0x0f, 0x41, 0x8d, 0x2c, 0xec, 0x24,
+	0x72, 0xf3, 0x75, 0x71, 0x73, 0x65, 0x5d, 0x6c, 0x08, 0x13, 0x7b, 0x1c, 0x91, 0xfd, 0x21, 0xd4,
+	0xd3, 0xb2, 0xce, 0xad, 0x35, 0x36, 0x65, 0x9a, 0x32, 0x56, 0x9b, 0x48, 0x29, 0x1c, 0x1f, 0x8c,
+	0xf4, 0x9a, 0x18, 0x93, 0x63, 0x8b, 0x32, 0x72, 0xbb, 0x69, 0xcd, 0xf5, 0xc2, 0x76, 0x04, 0xc3,
+	0xf1, 0x89, 0x34, 0x98, 0xf0, 0xac, 0x79, 0x47, 0x1e, 0x9f, 0x58, 0xfc, 0xc5, 0xa3, 0x5c, 0x7c,
+	0xd2, 0x3c, 0x71, 0xc9, 0x8e, 0x32, 0xdc, 0x4d, 0xcd, 0x53, 0x2c, 0xf2, 0x31, 0xf0, 0xe3, 0x6f,
+	0x0a, 0x39, 0xf6, 0x16, 0xfe, 0xc4, 0xd2, 0x83, 0xd0, 0x9a, 0x37, 0xb7, 0x68, 0x44, 0x81, 0x83,
+	0x86, 0xa1, 0x35, 0x57, 0x1f, 0x42, 0x63, 0xee, 0x5b, 0xba, 0x34, 0x4f, 0x2f, 0xcb, 0x5d, 0x3c,
+	0xf0, 0xad, 0x64, 0xaa, 0x6a, 0x73, 0x29, 0x15, 0xe5, 0x94, 0x7a, 0xa0, 0x2d, 0xe5, 0x4c, 0x3a,
+	0x51, 0x9b, 0x4b, 0x29, 0xf5, 0xc7, 0xb0, 0x29, 0xe5, 0x5c, 0x9c, 0x50, 0xe6, 0x57, 0x52, 0x26,
+	0xfe, 0x88, 0xfc, 0xf0, 0x04, 0xb3, 0x37, 0xe6, 0xa9, 0xb4, 0xda, 0x02, 0x65, 0x45, 0xee, 0x7a,
+	0x55, 0xf6, 0xec, 0xae, 0x68, 0x51, 0x29, 0x4d, 0xec, 0x09, 0xb7, 0xf0, 0x76, 0x83, 0x8e, 0x6b,
+	0x36, 0xbf, 0xc7, 0xaf, 0x4d, 0x50, 0x42, 0x7d, 0x17, 0x6a, 0x64, 0xc6, 0x0b, 0x29, 0x94, 0x33,
+	0x68, 0xbe, 0x26, 0x5b, 0x9c, 0xc8, 0x26, 0x4e, 0x08, 0x56, 0x75, 0xe2, 0xef, 0x40, 0xfd, 0x00,
+	0x36, 0xb9, 0xf1, 0x4f, 0x66, 0x90, 0xaf, 0xaf, 0x2e, 0x2e, 0x22, 0x7a, 0x94, 0x70, 0x49, 0x06,
+	0x37, 0xfc, 0x85, 0x4b, 0xe7, 0xbc, 0xc8, 0x39, 0xf7, 0xbd, 0xb1, 0xc5, 0xf3, 0xdf, 0xdb, 0xca,
+	0x25, 0xdd, 0x61, 0x9c, 0x8c, 0xe7, 0x25, 0x7e, 0x74, 0xcd, 0x97, 0x41, 0x07, 0x98, 0xef, 0x82,
+	0x32, 0x39, 0x67, 0xa7, 0x32, 0xdf, 0xf8, 0x36, 0x65, 0xee, 0x60, 0x3e, 0x2a, 0x53, 0x85, 0xfc,
+	0x62, 0x61, 0x9b, 0xcd, 0xfb, 0x3c, 0xc8, 0x13, 0xbf, 0xd1, 0x27, 0xe9, 0x5b, 0x93, 0x85, 0x1f,
+	0xd8, 0xcf, 0x2d, 0x3d, 0xb0, 0xdd, 0x93, 0xe6, 0xf7, 0x69, 0x1c, 0xeb, 0x31, 0x74, 0x68, 0xbb,
+	0x27, 0xb8, 0x62, 0xad, 0xb3, 0xd0, 0xf2, 0x5d, 0x1d, 0xa5, 0xa6, 0xe6, 0x9b, 0xf2, 0x8a, 0xed,
+	0x10, 0x62, 0x38, 0x31, 0x5c, 0x06, 0x56, 0xfc, 0xad, 0xfe, 0x08, 0x36, 0x12, 0x29, 0x7c, 0x8e,
+	0x22, 0x48, 0xf3, 0x07, 0x6b, 0xbd, 0x3f, 0x24, 0x9e, 0xb0, 0xc6, 0x3c, 0x95, 0x5e, 0x5a, 0x5b,
+	0x01, 0x5f, 0x5b, 0x0f, 0xbe, 0xd1, 0xda, 0x1a, 0x62, 0x5a, 0x7d, 0x0d, 0xca, 0xb6, 0x1b, 0x5a,
+	0x3e, 0x5a, 0x38, 0xde, 0x5a, 0x61, 0xe0, 0x31, 0x0e, 0x5d, 0xbf, 0x81, 0x63, 0x23, 0x63, 0x6a,
+	0xbe, 0xbd, 0x42, 0x16, 0xa1, 0xf0, 0xc4, 0x9e, 0xda, 0x8e, 0xc3, 0x4f, 0xec, 0x77, 0x56, 0x4e,
+	0xec, 0x47, 0xb6, 0xe3, 0xf0, 0x13, 0x7b, 0x2a, 0xbe, 0xf0, 0x94, 0xa3, 0x1c, 0x58, 0xff, 0xf6,
+	0xea, 0x29, 0x87, 0xb8, 0xa7, 0x74, 0x0f, 0xaa, 0x1a, 0x90, 0xad, 0x8b, 0x9b, 0xec, 0xde, 0x95,
+	0x7b, 0x98, 0x36, 0x82, 0x31, 0x08, 0xe2, 0x34, 0x2a, 0x0b, 0xc2, 0xd2, 0x87, 0x0a, 0xce, 0x7b,
+	0x3c, 0x3c, 0x9f, 0x43, 0x50, 0xbb, 0x79, 0x1b, 0xea, 0x51, 0x34, 0x13, 0x56, 0x17, 0x34, 0xdf,
+	0x5f, 0x69, 0x41, 0x9a, 0x40, 0xdd, 0x85, 0xda, 0x14, 0x25, 0xb8, 0x19, 0x17, 0xe8, 0x9a, 0x1f,
+	0x50, 0x43, 0xb6, 0xa2, 0x13, 0xf4, 0x22, 0x81, 0x8f, 0xa5, 0x72, 0xa9, 0xef, 0x42, 0x3d, 0xb0,
+	0x5c, 0x13, 0x63, 0x3f, 0xf8, 0x52, 0xfd, 0x70, 0x2b, 0x97, 0x30, 0xc3, 0xf8, 0x56, 0x1f, 0x9a,
+	0xb4, 0x5d, 0x73, 0x3f, 0xe0, 0x07, 0xfd, 0xbb, 0x80, 0xab, 0xed, 0x79, 0x92, 0xe9, 0xe1, 0x05,
+	0x99, 0x90, 0x4a, 0xca, 0x84, 0x4b, 0x51, 0x0f, 0x5c, 0x63, 0x1e, 0x1c, 0x7b, 0x61, 0xf3, 0x23,
+	0xf9, 0xf4, 0x1d, 0x0a, 0x28, 0xab, 0x21, 0x51, 0x94, 0xd2, 0x7e, 0x5e, 0x80, 0x72, 0x24, 0x15,
+	0x62, 0x28, 0xd7, 0x61, 0xff, 0x49, 0x7f, 0xf0, 0xac, 0xaf, 0x5c, 0x42, 0x23, 0x2b, 0x85, 0xe6,
+	0xeb, 0xc3, 0x76, 0xab, 0xcf, 0xaf, 0xac, 0xd0, 0x85, 0x00, 0x9e, 0xce, 0xaa, 0x9b, 0x50, 0x7f,
+	0x74, 0xd8, 0xa7, 0x50, 0x2e, 0x0e, 0xca, 0x21, 0xa8, 0xf3, 0x29, 0xb7, 0xe4, 0x72, 0x10, 0x06,
+	0xf1, 0xd7, 0xf7, 0x5b, 0xa3, 0x0e, 0xeb, 0x46, 0xa0, 0x02, 0x45, 0x85, 0x0d, 0x0e, 0x59, 0x5b,
+	0x94, 0x54, 0xc4, 0x6a, 0x0f, 0xd8, 0xe0, 0x93, 0x4e, 0x7b, 0xa4, 0x80, 0x7a, 0x15, 0x36, 0xe3,
+	0x32, 0xa2, 0xf2, 0x95, 0x2a, 0x1a, 0x89, 0xa3, 0x72, 0x94, 0x2b, 0x58, 0x2a, 0xeb, 0xb4, 0x0f,
+	0xd9, 0xb0, 0xfb, 0xb4, 0xa3, 0xb7, 0x47, 0x1d, 0xe5, 0x2a, 0xda, 0x0a, 0x87, 0xdd, 0xfe, 0x13,
+	0xe5, 0x1a, 0x5a, 0xe2, 0xf0, 0x8b, 0x97, 0x7e, 0x5d, 0x55, 0xa1, 0x91, 0xd0, 0x12, 0xac, 0x49,
+	0x46, 0xe6, 0xc7, 0x8f, 0x95, 0x3b, 0x58, 0xec, 0x6e, 0x77, 0x38, 0xea, 0xf6, 0xdb, 0x23, 0xe5,
+	0x2e, 0xda, 0x91, 0x1f, 0x75, 0x7b, 0xa3, 0x0e, 0x53, 0xb6, 0xb0, 0xbc, 0x4f, 0x06, 0xdd, 0xbe,
+	0xf2, 0x32, 0x42, 0x87, 0xad, 0xfd, 0x83, 0x5e, 0x47, 0xd1, 0xa8, 0x96, 0x01, 0x1b, 0x29, 0xaf,
+	0xa0, 0x45, 0xf2, 0xb0, 0x8f, 0x6d, 0x7b, 0x15, 0x2b, 0xa4, 0x4f, 0x1d, 0x6f, 0xe9, 0x7c, 0x4f,
+	0xb2, 0x46, 0xbf, 0x86, 0xdf, 0xcf, 0xba, 0xfd, 0xdd, 0xc1, 0x33, 0xe5, 0x75, 0x24, 0xdb, 0x61,
+	0x83, 0xd6, 0x6e, 0x1b, 0x8d, 0xd6, 0xf7, 0xb0, 0x80, 0xe1, 0x41, 0xaf, 0x3b, 0x52, 0xde, 0x40,
+	0xaa, 0xc7, 0xad, 0xd1, 0x5e, 0x87, 0x29, 0xf7, 0xf1, 0xbb, 0x35, 0x1c, 0x76, 0xd8, 0x48, 0xd9,
+	0xc6, 0xef, 0x6e, 0x9f, 0xbe, 0xdf, 0xc5, 0xef, 0xdd, 0x4e, 0xaf, 0x33, 0xea, 0x28, 0xef, 0xe1,
+	0x80, 0xb1, 0xce, 0x41, 0xaf, 0xd5, 0xee, 0x28, 0xef, 0x63, 0xa2, 0x37, 0x68, 0x3f, 0xd1, 0x07,
+	0x07, 0xca, 0x07, 0x58, 0x07, 0xd9, 0xd2, 0x87, 0x38, 0x98, 0x1f, 0xe2, 0x38, 0xc5, 0x49, 0x6a,
+	0xdd, 0x43, 0xac, 0x76, 0xbf, 0xdb, 0x3f, 0x1c, 0x2a, 0x1f, 0x21, 0x31, 0x7d, 0x12, 0xe6, 0x63,
+	0xf5, 0x0a, 0x28, 0x83, 0xbe, 0xbe, 0x7b, 0x78, 0xd0, 0xeb, 0xb6, 0x5b, 0xa3, 0x8e, 0xfe, 0xa4,
+	0xf3, 0x99, 0xf2, 0x1b, 0x38, 0xed, 0x07, 0xac, 0xa3, 0x8b, 0x76, 0xfc, 0x30, 0x4a, 0x8b, 0xb6,
+	0xfc, 0x08, 0xab, 0x48, 0xf0, 0xfa, 0xe1, 0x13, 0xe5, 0x37, 0x97, 0x40, 0xc3, 0x27, 0xca, 0x8f,
+	0x71, 0xce, 0x47, 0xdd, 0xfd, 0x8e, 0x2e, 0x06, 0x03, 0xaf, 0x81, 0xe4, 0x1f, 0x75, 0x7b, 0x3d,
+	0xa5, 0x45, 0x86, 0xd3, 0x16, 0x1b, 0x75, 0x69, 0xa2, 0x77, 0xf0, 0x4a, 0xc9, 0xa3, 0xc3, 0xcf,
+	0x3f, 0xff, 0x4c, 0x17, 0x33, 0xd1, 0xd6, 0x7e, 0x07, 0xca, 0x91, 0xf8, 0x8f, 0xad, 0xef, 0xf6,
+	0xfb, 0x1d, 0xbc, 0x4e, 0x55, 0x86, 0x7c, 0xaf, 0xf3, 0x68, 0xa4, 0x64, 0x10, 0xc8, 0xba, 0x8f,
+	0xf7, 0x46, 0x4a, 0x16, 0x3f, 0x07, 0x87, 0x98, 0x2d, 0x47, 0x53, 0xd5, 0xd9, 0xef, 0x2a, 0x79,
+	0xfc, 0x6a, 0xf5, 0x47, 0x5d, 0xa5, 0x40, 0x53, 0xd9, 0xed, 0x3f, 0xee, 0x75, 0x94, 0x22, 0x42,
+	0xf7, 0x5b, 0xec, 0x89, 0x52, 0xc2, 0x4c, 0xad, 0x83, 0x83, 0xde, 0x67, 0x4a, 0x99, 0x97, 0xbf,
+	0xdb, 0xf9, 0x54, 0xa9, 0xe0, 0x95, 0xac, 0xde, 0xb6, 0x02, 0xda, 0x3d, 0x28, 0xb5, 0x8e, 0x8e,
+	0xf6, 0x51, 0xbb, 0xc2, 0x46, 0x63, 0x64, 0x23, 0xdd, 0xe5, 0xda, 0x19, 0x8c, 0x46, 0x83, 0x7d,
+	0x25, 0x83, 0x8b, 0x69, 0x34, 0x38, 0x50, 0xb2, 0x5a, 0x17, 0xca, 0x11, 0xd7, 0x93, 0xee, 0xd5,
+	0x94, 0x21, 0x7f, 0xc0, 0x3a, 0x4f, 0xb9, 0x47, 0xa3, 0xdf, 0xf9, 0x14, 0x9b, 0x89, 0x5f, 0x58,
+	0x50, 0x0e, 0x2b, 0xe4, 0x17, 0x60, 0xe8, 0x62, 0x4d, 0xaf, 0xdb, 0xef, 0xb4, 0x98, 0x52, 0xd0,
+	0xfe, 0x0c, 0x94, 0xa3, 0x2d, 0xaa, 0xbe, 0x0a, 0xd9, 0xd1, 0x50, 0x98, 0xb9, 0xae, 0x3c, 0x48,
+	0x2e, 0x35, 0x8f, 0xa2, 0x2f, 0x96, 0x1d, 0x0d, 0xd5, 0x37, 0xa1, 0xc8, 0xaf, 0x34, 0x35, 0xb3,
+	0x29, 0x86, 0x29, 0x4a, 0x19, 0x11, 0x8e, 0x09, 0x1a, 0xad, 0x07, 0x8d, 0x34, 0x06, 0x55, 0x7e,
+	0x8e, 0x93, 0x34, 0x54, 0x09, 0x82, 0xba, 0x1e, 0x4f, 0x75, 0x77, 0x45, 0xec, 0x4d, 0x9c, 0xd6,
+	0xfe, 0x4b, 0x06, 0x20, 0x39, 0xf3, 0xf0, 0x54, 0x8d, 0xf5, 0xcf, 0x82, 0x30, 0xbb, 0xcb, 0xd7,
+	0x29, 0x2a, 0xdc, 0xad, 0x85, 0xa6, 0x92, 0xa9, 0xe7, 0xcf, 0x8c, 0x30, 0xba, 0x30, 0xc5, 0x53,
+	0x28, 0x61, 0x72, 0x6b, 0x2f, 0x1e, 0xee, 0xae, 0xc5, 0xc3, 0xbb, 0xf2, 0xac, 0x26, 0x80, 0x3d,
+	0x84, 0xa1, 0xf8, 0x67, 0xb9, 0x13, 0xc7, 0x0b, 0x2c, 0x13, 0xd5, 0x9b, 0x02, 0x9d, 0xe0, 0x10,
+	0x81, 0x76, 0xce, 0x79, 0x87, 0xfc, 0x99, 0xed, 0x1a, 0xa1, 0x65, 0x8a, 0xd0, 0x14, 0x09, 0x82,
+	0x06, 0x19, 0xbc, 0xc6, 0xca, 0xcf, 0x2f, 0x1e, 0x90, 0x53, 0x46, 0x00, 0x4d, 0xdf, 0x6d, 0x00,
+	0x2b, 0x98, 0x18, 0x73, 0x5e, 0x78, 0x99, 0x0a, 0xaf, 0x08, 0xc8, 0xce, 0xb9, 0xf6, 0xf7, 0x72,
+	0x00, 0x89, 0xcc, 0x94, 0xb2, 0x32, 0x67, 0xd2, 0x56, 0xe6, 0x6d, 0xb8, 0x26, 0x2e, 0x0b, 0x88,
+	0x08, 0xf4, 0x33, 0xdd, 0x76, 0xf5, 0xb1, 0x11, 0x19, 0xf4, 0x55, 0x81, 0xe5, 0x8e, 0xeb, 0xae,
+	0xbb, 0x63, 0x84, 0xea, 0x43, 0xd8, 0x90, 0xf3, 0xe0, 0xdd, 0x8b, 0xdc, 0x05, 0x77, 0x2f, 0xea,
+	0x49, 0xf6, 0xd1, 0xf9, 0x5c, 0x7d, 0x1b, 0xae, 0xfa, 0xd6, 0xd4, 0xb7, 0x82, 0x63, 0x3d, 0x0c,
+	0xe4, 0xca, 0xb8, 0x97, 0x7c, 0x53, 0x20, 0x47, 0x41, 0x5c, 0xd7, 0xdb, 0x70, 0x55, 0x48, 0x53,
+	0x4b, 0xcd, 0xe3, 0x17, 0x1a, 0x37, 0x39, 0x52, 0x6e, 0xdd, 0x6d, 0x00, 0x21, 0x48, 0x46, 0xd7,
+	0xd8, 0xcb, 0xac, 0xc2, 0x85, 0x46, 0x94, 0xfc, 0xdf, 0x04, 0xd5, 0x0e, 0xf4, 0x25, 0x0b, 0xa5,
+	0x30, 0xdb, 0x2b, 0x76, 0x70, 0x90, 0xb2, 0x4e, 0x5e, 0x64, 0xfc, 0x2c, 0x5f, 0x64, 0xfc, 0xbc,
+	0x02, 0x05, 0x92, 0x35, 0x85, 0x2d, 0x92, 0x27, 0x54, 0x0d, 0xf2, 0xb8, 0x3f, 0xc9, 0x64, 0xd6,
+	0xd8, 0x6e, 0x3c, 0x40, 0x20, 0xc9, 0xb4, 0x08, 0x65, 0x84, 0xd3, 0xfe, 0x46, 0x06, 0x1a, 0x69,
+	0xf9, 0x88, 0x47, 0x82, 0x25, 0x21, 0x6e, 0x85, 0x24, 0xac, 0xed, 0x25, 0xa8, 0xcc, 0x4f, 0x44,
+	0x3c, 0x5b, 0xe4, 0x3f, 0x9d, 0x9f, 0xf0, 0x38, 0x36, 0xf5, 0x0d, 0x28, 0xcd, 0x4f, 0xf8, 0xb2,
+	0xb9, 0x68, 0x5a, 0x8a, 0x73, 0x1e, 0x62, 0xf2, 0x06, 0x94, 0x16, 0x82, 0x34, 0x7f, 0x11, 0xe9,
+	0x82, 0x48, 0xb5, 0x2d, 0xa8, 0xc9, 0x1a, 0x09, 0x7a, 0x18, 0x50, 0x8e, 0xe1, 0x0d, 0xc3, 0x4f,
+	0xec, 0x41, 0x4d, 0x56, 0x3d, 0xbe, 0x89, 0x01, 0x3c, 0xa5, 0x8d, 0x67, 0x5f, 0xa0, 0x8d, 0x6f,
+	0x91, 0xa3, 0x5c, 0xa7, 0x88, 0x17, 0x0c, 0x93, 0xe6, 0xd6, 0x6f, 0x38, 0x36, 0x82, 0xd6, 0x22,
+	0xf4, 0xda, 0x9e, 0x23, 0x5c, 0x31, 0x22, 0x84, 0x3c, 0x1f, 0x59, 0xd3, 0x44, 0x8c, 0xf8, 0xdf,
+	0xce, 0xc0, 0xe6, 0x8a, 0xe8, 0x8d, 0xfd, 0x48, 0x5e, 0x2a, 0xc0, 0x4f, 0xd4, 0x85, 0x67, 0x46,
+	0x38, 0x39, 0xd6, 0xe7, 0xbe, 0x35, 0xb5, 0xcf, 0xa2, 0xe7, 0x16, 0x08, 0x76, 0x40, 0x20, 0xf2,
+	0x4b, 0xcd, 0xe7, 0xa4, 0x70, 0xa0, 0x41, 0x82, 0x5f, 0x2b, 0x06, 0x02, 0xf5, 0x10, 0x12, 0xfb,
+	0xac, 0xf3, 0x17, 0xf8, 0xac, 0xf1, 0x0a, 0x8e, 0xe1, 0x9a, 0x8e, 0xb0, 0xf9, 0x96, 0x59, 0x94,
+	0xd4, 0x6e, 0x41, 0xb1, 0x1b, 0x0b, 0xff, 0xf1, 0x9d, 0xe4, 0x9c, 0xb8, 0x87, 0xec, 0x41, 0xa5,
+	0x4d, 0x77, 0x9a, 0xf7, 0x8d, 0xb9, 0x7a, 0x1f, 0xef, 0xaf, 0xcd, 0x85, 0x9f, 0xbd, 0x19, 0x9b,
+	0xe0, 0x38, 0xf6, 0xc1, 0xbe, 0x31, 0xe7, 0x0e, 0x2b, 0x24, 0xba, 0xf9, 0x01, 0x94, 0x23, 0xc0,
+	0xb7, 0x0a, 0xba, 0xf9, 0x8f, 0x39, 0xa8, 0xec, 0xca, 0x66, 0x02, 0x94, 0xe0, 0x42, 0x7f, 0xe1,
+	0xa2, 0x36, 0x27, 0x0c, 0x96, 0x55, 0x34, 0xcb, 0x0a, 0x50, 0x34, 0xe9, 0xd9, 0xaf, 0x99, 0xf4,
+	0x5b, 0x80, 0xf6, 0x0c, 0xdd, 0x36, 0x49, 0x12, 0xce, 0xc5, 0xee, 0xff, 0xae, 0x89, 0x82, 0xf0,
+	0x5a, 0x9f, 0x48, 0xfe, 0x9b, 0xfb, 0x44, 0x0a, 0x6b, 0x7d, 0x22, 0xff, 0xd7, 0x78, 0x31, 0x5e,
+	0x4b, 0x98, 0x27, 0x86, 0xfb, 0x23, 0x59, 0x85, 0xc8, 0x22, 0x56, 0xf9, 0xc4, 0x3a, 0x47, 0xba,
+	0x8f, 0xa1, 0x11, 0x0d, 0xb3, 0xe8, 0x18, 0xa4, 0x02, 0x14, 0x05, 0x8e, 0xaa, 0x67, 0xf5, 0x50,
+	0x4e, 0xa6, 0x77, 0x55, 0xf5, 0xeb, 0x77, 0x95, 0xf6, 0xdf, 0xb3, 0x50, 0xf8, 0x29, 0xde, 0xc4,
+	0x54, 0x3f, 0x80, 0x4a, 0x10, 0xce, 0x42, 0xd9, 0x38, 0x7b, 0x83, 0x67, 0x23, 0x3c, 0xd9, 0x56,
+	0x2d, 0x8c, 0x44, 0xe5, 0x7a, 0x13, 0xd2, 0xe2, 0x17, 0xae, 0x1e, 0x34, 0x71, 0x70, 0x63, 0x70,
+	0x81, 0xf1, 0x04, 0x9a, 0xeb, 0xd0, 0x52, 0x1b, 0xa4, 0x5d, 0xbd, 0x28, 0xcb, 0x33, 0x8e, 0x40,
+	0x73, 0x9d, 0xb8, 0xcb, 0x92, 0x5f, 0x35, 0x90, 0x72, 0x0c, 0x45, 0x61, 0x59, 0x06, 0x2a, 0x74,
+	0xd1, 0x95, 0xa5, 0x38, 0x8d, 0x9b, 0xc8, 0xf1, 0x0c, 0x73, 0x64, 0x1c, 0x45, 0x57, 0xfa, 0x44,
+	0x12, 0xcf, 0x56, 0xd3, 0x0a, 0xad, 0x49, 0x38, 0xfc, 0xd2, 0x89, 0xa6, 0x4c, 0x82, 0xa0, 0xe7,
+	0xc1, 0xb7, 0xc2, 0x85, 0xef, 0xa2, 0x0e, 0xc9, 0x4d, 0xae, 0x09, 0x40, 0x33, 0xa1, 0x9e, 0xea,
+	0x6a, 0x5a, 0xef, 0x40, 0x19, 0xad, 0xd3, 0x43, 0xf9, 0x35, 0x23, 0x09, 0xc0, 0x59, 0x59, 0xe8,
+	0xcd, 0x49, 0xd2, 0x30, 0xc9, 0x4d, 0x87, 0x07, 0xbb, 0xad, 0x51, 0x47, 0x29, 0x90, 0x74, 0xdb,
+	0x61, 0x8f, 0x3b, 0x4a, 0x51, 0xfb, 0xfd, 0x2c, 0x6c, 0x8e, 0x7c, 0xc3, 0x0d, 0x0c, 0x1e, 0x83,
+	0xec, 0x86, 0xbe, 0xe7, 0xa8, 0x1f, 0x43, 0x39, 0x9c, 0x38, 0xf2, 0x14, 0xdc, 0x8d, 0x26, 0x7c,
+	0x89, 0xf4, 0xc1, 0x68, 0xc2, 0x15, 0xd8, 0x52, 0xc8, 0x3f, 0xd4, 0x1f, 0x40, 0x61, 0x6c, 0x1d,
+	0xd9, 0xae, 0xd8, 0x7c, 0x57, 0x97, 0x33, 0xee, 0x20, 0x12, 0x5f, 0x34, 0x21, 0x2a, 0xf5, 0x6d,
+	0xbc, 0x9f, 0x39, 0x8b, 0xf8, 0x57, 0x12, 0x2e, 0x29, 0x55, 0x84, 0x58, 0x7c, 0xb5, 0x84, 0xd3,
+	0xa9, 0x1f, 0xe0, 0x83, 0x02, 0x8e, 0x33, 0x36, 0x26, 0x27, 0x82, 0xb3, 0x35, 0x97, 0xf3, 0x30,
+	0x81, 0xdf, 0xbb, 0xc4, 0x62, 0x5a, 0xed, 0x01, 0x94, 0x44, 0x63, 0x71, 0x00, 0x76, 0x3a, 0x8f,
+	0xbb, 0x62, 0x20, 0xdb, 0x83, 0xfd, 0xfd, 0xee, 0x88, 0xdf, 0xcb, 0x61, 0x83, 0x5e, 0x6f, 0xa7,
+	0xd5, 0x7e, 0xa2, 0x64, 0x77, 0xca, 0x50, 0x34, 0x28, 0xc4, 0x4f, 0xfb, 0x8b, 0x19, 0xd8, 0x58,
+	0xea, 0x80, 0xfa, 0x10, 0xf2, 0x33, 0xcf, 0x8c, 0x86, 0xe7, 0xd5, 0xb5, 0xbd, 0x94, 0xd2, 0xfc,
+	0x8c, 0xc5, 0x1c, 0xda, 0x47, 0xd0, 0x48, 0xc3, 0x25, 0x31, 0xb8, 0x0e, 0x15, 0xd6, 0x69, 0xed,
+	0xea, 0x83, 0x7e, 0xef, 0x33, 0xae, 0x4d, 0x52, 0xf2, 0x19, 0xeb, 0x8e, 0x3a, 0x4a, 0x56, 0xfb,
+	0x2d, 0x50, 0x96, 0x07, 0x46, 0x7d, 0x0c, 0x1b, 0x78, 0x29, 0xc7, 0xb1, 0x38, 0x93, 0x48, 0xa6,
+	0xec, 0xce, 0x9a, 0x91, 0x14, 0x64, 0x34, 0x63, 0x8d, 0x49, 0x2a, 0xad, 0xfd, 0x36, 0xa8, 0xab,
+	0x23, 0xf8, 0xeb, 0x2b, 0xfe, 0xbf, 0x65, 0x20, 0x7f, 0xe0, 0x18, 0x78, 0xd9, 0xa3, 0x40, 0x77,
+	0xae, 0x9b, 0x19, 0xd9, 0x65, 0x42, 0x9b, 0x1b, 0x97, 0x05, 0xe1, 0xd4, 0xef, 0x43, 0x2e, 0x9c,
+	0x44, 0x77, 0x90, 0xae, 0x5f, 0xb0, 0xf8, 0xf0, 0xe2, 0x73, 0x38, 0x71, 0xf0, 0x5d, 0x0b, 0xd3,
+	0x8c, 0xe2, 0x51, 0x84, 0x48, 0x8f, 0x56, 0xea, 0x5d, 0x6b, 0x6a, 0xbb, 0xb6, 0xb8, 0x23, 0x8e,
+	0x24, 0x78, 0x07, 0xdc, 0x9c, 0x38, 0xe9, 0xe0, 0x22, 0xa4, 0x94, 0x0a, 0x34, 0x27, 0xf8, 0x10,
+	0x4d, 0x3d, 0xf4, 0xcf, 0x75, 0x7f, 0xe1, 0x92, 0x3f, 0x33, 0x10, 0x72, 0x5e, 0x15, 0x0f, 0xb2,
+	0x05, 0x39, 0xff, 0x02, 0x11, 0xcb, 0x3a, 0xf7, 0xad, 0xb9, 0xe1, 0xc7, 0x12, 0x1e, 0x3a, 0xcd,
+	0x08, 0x80, 0x37, 0xa8, 0xb1, 0x74, 0xed, 0x4d, 0xba, 0x7f, 0x8c, 0x12, 0x91, 0x16, 0x7d, 0xad,
+	0xb9, 0x2a, 0x20, 0x30, 0xda, 0x1f, 0xe7, 0xa0, 0x2a, 0xb5, 0x47, 0x7d, 0x0f, 0xca, 0xe6, 0xc4,
+	0x59, 0xc3, 0x0b, 0x25, 0xa2, 0x07, 0xbb, 0xd1, 0x16, 0x34, 0xf9, 0x07, 0x05, 0x41, 0x5a, 0xa1,
+	0xfe, 0xdc, 0xf0, 0x6d, 0xe4, 0xaf, 0x41, 0x33, 0x2b, 0x1b, 0x66, 0x87, 0x56, 0xf8, 0x34, 0xc2,
+	0xe0, 0x3b, 0x36, 0x81, 0x94, 0x26, 0xb1, 0x4d, 0x74, 0x29, 0x97, 0x7a, 0x38, 0x82, 0x03, 0xf1,
+	0xe1, 0x19, 0x81, 0x47, 0x52, 0xeb, 0xcc, 0x9a, 0x2c, 0xc2, 0x48, 0x6c, 0xab, 0x47, 0x1d, 0x22,
+	0x20, 0x92, 0x0a, 0xbc, 0xba, 0x8d, 0x9c, 0xd0, 0x70, 0x1c, 0x8f, 0xce, 0xeb, 0x82, 0x6c, 0x05,
+	0xdc, 0x8d, 0xe1, 0xfc, 0x4d, 0x9c, 0x28, 0x85, 0xf1, 0x52, 0x5e, 0x78, 0x6c, 0xf9, 0xcd, 0xa2,
+	0x7c, 0x74, 0x0c, 0x10, 0xb4, 0xdb, 0xee, 0xe1, 0x4a, 0x21, 0xb4, 0xf6, 0xf3, 0x0c, 0x94, 0xc4,
+	0x08, 0xa0, 0x4e, 0x8d, 0x57, 0xe9, 0x9e, 0xb6, 0x58, 0x17, 0x8d, 0x30, 0x22, 0x26, 0xea, 0x31,
+	0x6b, 0xf5, 0x05, 0x9f, 0x64, 0x9d, 0xa7, 0x83, 0x27, 0x1d, 0xae, 0x5b, 0xee, 0x76, 0xfa, 0x9f,
+	0x29, 0x39, 0x6e, 0x57, 0xe9, 0x1c, 0xb4, 0x18, 0x72, 0xc9, 0x2a, 0x94, 0x3a, 0x9f, 0x76, 0xda,
+	0x87, 0xc4, 0x26, 0x1b, 0x00, 0xbb, 0x9d, 0x56, 0xaf, 0x37, 0x40, 0x45, 0x5f, 0x29, 0xa2, 0x8d,
+	0xa4, 0xcd, 0x3a, 0xa8, 0xf4, 0xb7, 0xda, 0xed, 0xc1, 0x61, 0x7f, 0xa4, 0x94, 0xb0, 0xc6, 0x16,
+	0x6a, 0xe0, 0x31, 0x88, 0x9e, 0x7b, 0xd8, 0x65, 0x83, 0x83, 0x18, 0x52, 0xd9, 0xa9, 0xa0, 0x08,
+	0x4d, 0x73, 0xa5, 0xfd, 0xb5, 0x0d, 0x68, 0xa4, 0x97, 0xa6, 0xfa, 0x21, 0x94, 0x4d, 0x33, 0x35,
+	0xc7, 0xb7, 0xd6, 0x2d, 0xe1, 0x07, 0xbb, 0x66, 0x34, 0xcd, 0xfc, 0x03, 0x7d, 0x8f, 0x7c, 0x23,
+	0x65, 0x57, 0x36, 0x52, 0xb4, 0x8d, 0x7e, 0x0c, 0x1b, 0xe2, 0xae, 0x30, 0xea, 0x92, 0x63, 0x23,
+	0xb0, 0xd2, 0xbb, 0xa4, 0x4d, 0xc8, 0x5d, 0x81, 0xdb, 0xbb, 0xc4, 0x1a, 0x93, 0x14, 0x44, 0xfd,
+	0x21, 0x34, 0x0c, 0x52, 0x7c, 0xe2, 0xfc, 0x79, 0x59, 0x00, 0x68, 0x21, 0x4e, 0xca, 0x5e, 0x37,
+	0x64, 0x00, 0x2e, 0x44, 0xd3, 0xf7, 0xe6, 0x49, 0xe6, 0x82, 0xbc, 0x10, 0x77, 0x7d, 0x6f, 0x2e,
+	0xe5, 0xad, 0x99, 0x52, 0x1a, 0xe3, 0x51, 0x45, 0xcb, 0x13, 0x15, 0x2a, 0xde, 0xb2, 0xbc, 0xd9,
+	0x24, 0x46, 0xe0, 0xfb, 0x50, 0x93, 0x24, 0x89, 0x41, 0xcd, 0xbc, 0xc1, 0x89, 0x4a, 0x15, 0xaf,
+	0x35, 0x6a, 0x6d, 0x94, 0x0b, 0x8c, 0x38, 0xa5, 0xbe, 0x0d, 0x40, 0xed, 0xe4, 0x79, 0xca, 0x29,
+	0x47, 0x95, 0xef, 0xcd, 0xa3, 0x2c, 0x15, 0x33, 0x4a, 0x48, 0xcd, 0xe3, 0x51, 0xfb, 0x95, 0xd5,
+	0xe6, 0x51, 0x80, 0x79, 0xd2, 0x3c, 0x4a, 0x26, 0xcd, 0xe3, 0xd9, 0x60, 0xa5, 0x79, 0x51, 0x2e,
+	0x30, 0xe2, 0x54, 0xdc, 0x3c, 0x9e, 0xa7, 0xba, 0xdc, 0xbc, 0x28, 0x4b, 0xc5, 0x8c, 0x12, 0x38,
+	0x6d, 0x4b, 0x72, 0x5b, 0xed, 0x42, 0xb9, 0x0d, 0xa7, 0x2d, 0x2d, 0xb9, 0xfd, 0x10, 0x1a, 0xc1,
+	0xb1, 0x77, 0x2a, 0x31, 0x90, 0xba, 0x9c, 0x7b, 0x78, 0xec, 0x9d, 0xca, 0x1c, 0xa4, 0x1e, 0xc8,
+	0x00, 0x6c, 0x2d, 0xef, 0x22, 0xdd, 0xcb, 0x69, 0xc8, 0xad, 0xa5, 0x1e, 0xe2, 0x7d, 0x09, 0x6c,
+	0xad, 0x11, 0x25, 0x70, 0x50, 0x12, 0x65, 0x39, 0x68, 0x6e, 0xc8, 0x83, 0xd2, 0x8b, 0x74, 0x66,
+	0xac, 0x09, 0x62, 0x0d, 0x3a, 0xc0, 0xb5, 0xb5, 0x70, 0xe5, 0x6c, 0x8a, 0xbc, 0xb6, 0x0e, 0xdd,
+	0x54, 0xc6, 0x1a, 0x27, 0x15, 0x59, 0x93, 0x5d, 0x11, 0x58, 0x5f, 0x2e, 0x2c, 0x77, 0x62, 0x35,
+	0x37, 0x57, 0x77, 0xc5, 0x50, 0xe0, 0x92, 0x5d, 0x11, 0x41, 0xe2, 0x75, 0x1d, 0x67, 0x57, 0x97,
+	0xd7, 0xb5, 0x94, 0xb9, 0x66, 0x4a, 0xe9, 0x64, 0x43, 0xc5, 0x79, 0x2f, 0xaf, 0x6c, 0x28, 0x29,
+	0x73, 0xdd, 0x90, 0x01, 0x38, 0x52, 0xa2, 0xe5, 0x34, 0xb8, 0x29, 0x4f, 0x2d, 0x6f, 0xb5, 0x18,
+	0x5d, 0x98, 0xc4, 0x29, 0xf5, 0xb7, 0xe0, 0x46, 0x64, 0xef, 0x98, 0x19, 0xa1, 0xe5, 0xdb, 0x86,
+	0x63, 0x7f, 0x65, 0x99, 0xbc, 0x08, 0x7e, 0xb3, 0xe6, 0x76, 0xe4, 0x44, 0x24, 0xb2, 0x7d, 0x89,
+	0x4a, 0x94, 0x77, 0xdd, 0x5f, 0x8f, 0xd2, 0xfe, 0xb0, 0x00, 0x25, 0xc1, 0x99, 0xf0, 0x59, 0x1b,
+	0xc1, 0x20, 0x77, 0x5b, 0xa3, 0xd6, 0x4e, 0x6b, 0x88, 0x22, 0x8d, 0x0a, 0x0d, 0xce, 0x21, 0x63,
+	0x58, 0x06, 0xb9, 0x26, 0xb1, 0xc8, 0x18, 0x94, 0x45, 0xae, 0x29, 0xf2, 0xf2, 0x07, 0x75, 0x72,
+	0x68, 0x0d, 0xe5, 0x19, 0x39, 0x80, 0x02, 0x9a, 0x29, 0x17, 0x4f, 0x17, 0xa4, 0x2c, 0xdc, 0x1a,
+	0x59, 0x4c, 0xb2, 0x70, 0x40, 0x29, 0xce, 0xc2, 0xd3, 0x65, 0x6c, 0xcc, 0x88, 0x1d, 0xf6, 0xdb,
+	0x49, 0x3d, 0x15, 0xcc, 0x24, 0x8a, 0x79, 0xda, 0xed, 0x3c, 0x53, 0x00, 0x33, 0xf1, 0x52, 0x28,
+	0x5d, 0x45, 0xa1, 0x8c, 0x0a, 0xa1, 0x64, 0x4d, 0xbd, 0x0e, 0x97, 0x87, 0x7b, 0x83, 0x67, 0x3a,
+	0xcf, 0x14, 0x77, 0xa1, 0x8e, 0xa6, 0x61, 0x09, 0xc1, 0x8b, 0x6f, 0x60, 0x95, 0x04, 0x8d, 0x08,
+	0x87, 0xca, 0x06, 0x19, 0xf7, 0x11, 0x36, 0xe2, 0xa7, 0x94, 0x82, 0x5d, 0xe1, 0x59, 0x07, 0xbd,
+	0xc3, 0xfd, 0xfe, 0x50, 0xd9, 0xc4, 0x46, 0x10, 0x84, 0xb7, 0x5c, 0x8d, 0x8b, 0x49, 0xce, 0xb6,
+	0xcb, 0x74, 0xdc, 0x21, 0xec, 0x59, 0x8b, 0xf5, 0xbb, 0xfd, 0xc7, 0x43, 0xe5, 0x4a, 0x5c, 0x72,
+	0x87, 0xb1, 0x01, 0x1b, 0x2a, 0x57, 0x63, 0xc0, 0x70, 0xd4, 0x1a, 0x1d, 0x0e, 0x95, 0x6b, 0x71,
+	0x2b, 0x0f, 0xd8, 0xa0, 0xdd, 0x19, 0x0e, 0x7b, 0xdd, 0xe1, 0x48, 0xb9, 0x8e, 0x0e, 0x85, 0xa4,
+	0x45, 0x11, 0x71, 0x53, 0x6a, 0x28, 0x7b, 0xdc, 0x19, 0x29, 0x37, 0xe2, 0x66, 0xb4, 0x07, 0x3d,
+	0x7c, 0xeb, 0x68, 0xd0, 0x57, 0x6e, 0x22, 0x11, 0xd9, 0xd6, 0x45, 0x6f, 0x5e, 0xc2, 0x76, 0x1d,
+	0xf6, 0x65, 0xd0, 0x2d, 0x69, 0x69, 0x0c, 0x3b, 0x3f, 0x3d, 0xec, 0xf4, 0xdb, 0x1d, 0xe5, 0x76,
+	0xb2, 0x34, 0x62, 0xd8, 0x9d, 0x78, 0x69, 0xc4, 0xa0, 0xbb, 0x71, 0x9d, 0x11, 0x68, 0xa8, 0x6c,
+	0x61, 0x79, 0xa2, 0x1d, 0xfd, 0x7e, 0xa7, 0x3d, 0xc2, 0xbe, 0xbe, 0x1c, 0x8f, 0xe2, 0xe1, 0xc1,
+	0x63, 0x86, 0x37, 0xed, 0x35, 0xf5, 0x36, 0xdc, 0x60, 0x9d, 0x47, 0xac, 0x33, 0xdc, 0xd3, 0x23,
+	0xc7, 0x48, 0xf7, 0xf3, 0xce, 0x2e, 0x9f, 0xca, 0x57, 0x76, 0x6a, 0xf4, 0x32, 0x9f, 0x38, 0x60,
+	0xb5, 0x4f, 0x40, 0x95, 0x9f, 0xb8, 0x12, 0xcf, 0x6d, 0xa8, 0x90, 0xc7, 0x40, 0xbf, 0xe8, 0x7e,
+	0x0f, 0x7e, 0xe3, 0x75, 0x8b, 0xf9, 0x62, 0x4c, 0xce, 0xe4, 0x24, 0xdc, 0x5f, 0x06, 0x69, 0x7f,
+	0x37, 0x03, 0x8d, 0xf4, 0xe1, 0x8a, 0x42, 0xa5, 0x3d, 0xd5, 0x31, 0x2a, 0x80, 0x9e, 0x84, 0x08,
+	0x22, 0xbb, 0x85, 0x3d, 0xed, 0x7b, 0x21, 0xbd, 0x09, 0x41, 0x8a, 0x66, 0x7c, 0x56, 0xf2, 0x52,
+	0xe3, 0xb4, 0xda, 0x85, 0xcb, 0xa9, 0x17, 0xc0, 0x52, 0x0f, 0x72, 0x34, 0xe3, 0x1b, 0xcd, 0x4b,
+	0xed, 0x67, 0x6a, 0xb0, 0xda, 0x27, 0x05, 0x72, 0x78, 0x8d, 0x8d, 0xdf, 0xec, 0xc4, 0x4f, 0x6d,
+	0x0f, 0xea, 0xa9, 0xb3, 0x9c, 0x8c, 0x58, 0xd3, 0x74, 0x4b, 0xcb, 0xf6, 0xf4, 0xc5, 0xcd, 0xd4,
+	0xfe, 0x20, 0x03, 0x35, 0xf9, 0x64, 0xff, 0x95, 0x4b, 0xa2, 0xa0, 0x50, 0xf1, 0x8d, 0x36, 0x63,
+	0xf1, 0x14, 0x44, 0x04, 0xea, 0xd2, 0x8b, 0xa4, 0xdc, 0xca, 0xf6, 0xe8, 0x64, 0x18, 0x77, 0x47,
+	0x06, 0xa1, 0x0a, 0x4e, 0xe1, 0xde, 0x8f, 0x9e, 0x20, 0x81, 0x08, 0x2b, 0x4d, 0x20, 0xda, 0x5d,
+	0xa8, 0x3c, 0x3a, 0x89, 0x5e, 0x25, 0x91, 0x1f, 0x46, 0xa9, 0xf0, 0x3b, 0x22, 0xf8, 0x1a, 0x6a,
+	0x23, 0xb9, 0xec, 0x48, 0xc1, 0x24, 0xfc, 0xe5, 0x38, 0xbe, 0x1c, 0xf0, 0xe5, 0xb8, 0xf8, 0xb1,
+	0xd2, 0xac, 0xfc, 0x58, 0xe9, 0x2b, 0xa2, 0xb0, 0x9c, 0x7c, 0xfe, 0xc5, 0x75, 0xf1, 0xd2, 0x31,
+	0xdc, 0x00, 0xff, 0x33, 0x6b, 0x6a, 0xf9, 0xbe, 0x15, 0x3d, 0xa2, 0xb7, 0x42, 0x9c, 0x22, 0x22,
+	0x1d, 0xc6, 0x9a, 0x36, 0x0b, 0xf2, 0xb1, 0x91, 0xbe, 0x8f, 0x89, 0x78, 0xed, 0x2f, 0x14, 0xa0,
+	0x2a, 0xc9, 0x49, 0xdf, 0x68, 0xf9, 0xdd, 0xc2, 0x27, 0xe0, 0xa2, 0x9b, 0x7e, 0x22, 0xec, 0x3f,
+	0x06, 0xa4, 0xe6, 0x2a, 0xb7, 0x34, 0x57, 0x78, 0x6f, 0x89, 0x47, 0x9d, 0x08, 0x2b, 0x59, 0x94,
+	0x4c, 0x9b, 0x81, 0x0a, 0x2f, 0x30, 0xae, 0xbe, 0x03, 0x35, 0xfe, 0xc6, 0x88, 0x38, 0xd3, 0x8b,
+	0x5b, 0xb9, 0x35, 0xf4, 0xd5, 0xe4, 0xad, 0x95, 0x00, 0xef, 0xf7, 0x4e, 0x4f, 0x74, 0x73, 0x1c,
+	0x59, 0x58, 0x0a, 0xd3, 0x93, 0xdd, 0x31, 0x19, 0xa7, 0xa7, 0xb1, 0x68, 0x50, 0x26, 0x4c, 0x79,
+	0x1a, 0x09, 0x00, 0xf7, 0xa0, 0x34, 0x3d, 0xe1, 0xd1, 0xfc, 0x95, 0xad, 0xdc, 0xba, 0x21, 0x2f,
+	0x4e, 0x4f, 0x28, 0xb4, 0xff, 0x23, 0x50, 0x96, 0x2c, 0x70, 0x41, 0x13, 0xd6, 0x36, 0x6a, 0x23,
+	0x6d, 0x8c, 0x0b, 0xd4, 0xb7, 0xe0, 0x8a, 0x38, 0xab, 0x8d, 0x40, 0xe7, 0x11, 0x91, 0x74, 0x79,
+	0x94, 0xbf, 0xb0, 0xb2, 0xc9, 0x71, 0xad, 0x60, 0x48, 0x18, 0x5c, 0xac, 0x1a, 0xd4, 0xa4, 0xb5,
+	0xcb, 0x6f, 0xe6, 0x56, 0x58, 0x0a, 0xa6, 0x3e, 0x84, 0xda, 0xf4, 0x84, 0xaf, 0x85, 0x91, 0xb7,
+	0x6f, 0x89, 0xd8, 0xb6, 0x2b, 0xcb, 0xab, 0x80, 0x42, 0xa0, 0x52, 0x94, 0xf8, 0x24, 0xc0, 0xea,
+	0xe9, 0xdf, 0x90, 0x6d, 0x2e, 0xcb, 0x67, 0x3b, 0x53, 0x66, 0x4b, 0x10, 0xbc, 0xaa, 0x6d, 0xda,
+	0x01, 0x4d, 0x27, 0x19, 0xc0, 0x45, 0x90, 0x95, 0x78, 0xc1, 0x49, 0x15, 0x38, 0x34, 0x84, 0x8b,
+	0x58, 0x2c, 0xed, 0xaf, 0x66, 0x40, 0x59, 0x2e, 0x18, 0xb7, 0x4c, 0x62, 0x02, 0xa8, 0x44, 0xca,
+	0xca, 0x16, 0x54, 0xf1, 0x0a, 0x29, 0x59, 0xbc, 0xc4, 0x03, 0xbb, 0x65, 0x26, 0x83, 0xd0, 0xf2,
+	0x1b, 0x49, 0x32, 0x13, 0x3f, 0xb9, 0xb8, 0x2e, 0x60, 0x6d, 0xdf, 0x73, 0x31, 0x30, 0x85, 0x4a,
+	0x4b, 0x2b, 0x2c, 0x15, 0x56, 0x27, 0x68, 0xc4, 0x94, 0xb4, 0x7f, 0x9d, 0x81, 0xeb, 0x17, 0x48,
+	0x3b, 0xa9, 0x75, 0x9e, 0x59, 0x5a, 0xe7, 0xeb, 0x37, 0xfb, 0x07, 0xc2, 0x18, 0xc4, 0xaf, 0x77,
+	0x69, 0x5f, 0x2b, 0x4c, 0x3d, 0x48, 0x4c, 0x41, 0x31, 0xc7, 0xc9, 0x4b, 0x1c, 0x67, 0x9b, 0xbb,
+	0x69, 0x50, 0x15, 0x8d, 0x9e, 0x84, 0xa1, 0x67, 0x07, 0xdb, 0x03, 0xf4, 0xae, 0x8f, 0x3a, 0xfc,
+	0xda, 0x4c, 0xb7, 0xdf, 0x66, 0x9d, 0xfd, 0x4e, 0x7f, 0xd4, 0xea, 0x29, 0x59, 0xed, 0x9f, 0x67,
+	0xa0, 0x91, 0x28, 0x37, 0xc8, 0x7d, 0xd1, 0x2c, 0x9f, 0xbc, 0xf5, 0xd9, 0x5c, 0xd6, 0x7f, 0x90,
+	0x04, 0xfd, 0x2b, 0xfc, 0x59, 0xb2, 0x75, 0x77, 0xe1, 0xd7, 0xbd, 0x74, 0x94, 0x5b, 0xf7, 0xd2,
+	0x91, 0xf6, 0x18, 0x72, 0xe8, 0x55, 0x23, 0x43, 0x1a, 0x4a, 0x2f, 0x5c, 0xe9, 0xe6, 0x72, 0x0b,
+	0xf9, 0x7d, 0xd1, 0x45, 0x4e, 0xf7, 0xd3, 0x0e, 0x58, 0x77, 0xbf, 0xc5, 0x3e, 0x23, 0x9f, 0x39,
+	0xc9, 0x77, 0x8f, 0x06, 0xac, 0xd3, 0x7d, 0xdc, 0x27, 0x40, 0x9e, 0xcc, 0x6c, 0x49, 0x13, 0x5b,
+	0xa6, 0xf9, 0xe8, 0x44, 0xbe, 0x12, 0x9c, 0x49, 0xbd, 0x17, 0x99, 0xbe, 0xd2, 0x92, 0x5d, 0xbe,
+	0xd2, 0xa2, 0xc6, 0xec, 0x37, 0x1e, 0x59, 0xbc, 0x1d, 0x8f, 0x17, 0xd5, 0xd3, 0x1a, 0x6c, 0x9a,
+	0x73, 0x12, 0x81, 0xf6, 0xcb, 0x0c, 0xa8, 0xa9, 0x86, 0x70, 0xa5, 0xea, 0x57, 0x6d, 0xcb, 0x87,
+	0xd0, 0x14, 0x8f, 0x7c, 0x71, 0x2a, 0xc9, 0xf2, 0x2e, 0x86, 0xf4, 0xaa, 0x97, 0x04, 0xca, 0x24,
+	0xd7, 0xf5, 0xd5, 0xb7, 0x80, 0xbf, 0xd8, 0x84, 0xbb, 0x39, 0x6d, 0xb3, 0x92, 0x18, 0x3b, 0x4b,
+	0x68, 0x92, 0x27, 0x9a, 0xe4, 0xa7, 0xa7, 0xb8, 0x2b, 0x62, 0x23, 0x99, 0x35, 0x62, 0xf6, 0xda,
+	0xef, 0x65, 0xe0, 0x72, 0x7a, 0x41, 0x7c, 0xb7, 0x5e, 0xa6, 0xdf, 0xd9, 0xca, 0x2d, 0xbf, 0xb3,
+	0xb5, 0x6e, 0x3d, 0xe5, 0xd7, 0xae, 0xa7, 0xdf, 0xcd, 0xc0, 0x15, 0x69, 0xf4, 0x13, 0x35, 0xf8,
+	0x4f, 0xa9, 0x65, 0xd2, 0x73, 0x5b, 0xf9, 0xd4, 0x73, 0x5b, 0xda, 0xef, 0x67, 0xe0, 0xda, 0x52,
+	0x4b, 0x98, 0xf5, 0xa7, 0xda, 0x96, 0xf4, 0xb3, 0x5c, 0xe4, 0x7d, 0xe0, 0xa1, 0x4d, 0xfc, 0xda,
+	0x86, 0x9a, 0x7e, 0x67, 0x0b, 0x1d, 0x74, 0xda, 0xbf, 0x48, 0x37, 0xd2, 0x4c, 0x82, 0xee, 0x31,
+	0x46, 0x2c, 0x11, 0x6f, 0xa3, 0xab, 0xb0, 0x6b, 0x23, 0xf6, 0x65, 0xba, 0xb5, 0x67, 0x5e, 0xf6,
+	0x9b, 0x9d, 0x79, 0x0f, 0xa1, 0x16, 0x17, 0xbc, 0x6b, 0x4d, 0xd3, 0xc6, 0xa6, 0xe5, 0xb7, 0x76,
+	0x64, 0x4a, 0x9c, 0xf5, 0xeb, 0xe9, 0xe5, 0x98, 0xf4, 0xe3, 0x42, 0x37, 0x58, 0xe6, 0x62, 0x37,
+	0xd8, 0x72, 0x4b, 0xb2, 0xdf, 0xb8, 0x25, 0x3f, 0x85, 0x97, 0x92, 0x86, 0x44, 0x96, 0x92, 0xef,
+	0xd4, 0x18, 0xed, 0x9f, 0x66, 0xe4, 0x32, 0x3b, 0x67, 0x93, 0x63, 0xbc, 0x40, 0x9e, 0x94, 0x79,
+	0xd1, 0x0b, 0x28, 0x99, 0x8b, 0x5e, 0x40, 0x79, 0xd1, 0x32, 0x7b, 0x19, 0x6a, 0xcf, 0x0d, 0xc7,
+	0x36, 0xc9, 0xc6, 0x91, 0x88, 0xc6, 0x11, 0x0c, 0xa5, 0x8d, 0xdb, 0x00, 0x91, 0x11, 0x64, 0xf9,
+	0x51, 0x92, 0xe1, 0x97, 0xce, 0x27, 0xf9, 0x72, 0x4e, 0xc9, 0x7f, 0x92, 0x2f, 0x17, 0x94, 0xa2,
+	0xf6, 0xbb, 0x59, 0xb8, 0x9b, 0x34, 0x9f, 0x59, 0xa6, 0x1d, 0x84, 0xbe, 0x3d, 0x5e, 0xc8, 0xc3,
+	0xf2, 0x91, 0xec, 0x76, 0x34, 0x63, 0x87, 0xfb, 0x05, 0x03, 0x3e, 0x97, 0x06, 0xfc, 0xbb, 0xac,
+	0xb7, 0xdf, 0x80, 0x9b, 0x64, 0x88, 0x59, 0x3f, 0x23, 0x9c, 0xf9, 0x5f, 0x37, 0xe5, 0xc5, 0x24,
+	0x2d, 0x91, 0xef, 0xc3, 0xa6, 0x2f, 0xf5, 0x05, 0xc7, 0x21, 0x72, 0xef, 0x29, 0x32, 0x02, 0x05,
+	0xaf, 0x4f, 0xf2, 0xe5, 0xbc, 0x52, 0xd0, 0xde, 0x83, 0xcd, 0x64, 0x20, 0xda, 0xe2, 0x45, 0x9c,
+	0xbb, 0x50, 0x75, 0x2d, 0xbc, 0x47, 0x4e, 0x49, 0x31, 0x69, 0xe0, 0x5a, 0xa7, 0x82, 0x40, 0x7b,
+	0x1f, 0x6e, 0x24, 0xb9, 0xe8, 0x6e, 0x08, 0x4a, 0x48, 0xcc, 0x9a, 0x18, 0x0e, 0xbd, 0xe6, 0x62,
+	0xb9, 0x88, 0x88, 0x6e, 0xde, 0x44, 0x49, 0xed, 0x91, 0x7c, 0xa8, 0xc7, 0xef, 0x43, 0x3b, 0xa6,
+	0xbc, 0x36, 0x4a, 0x9e, 0x63, 0x46, 0x28, 0x6c, 0x84, 0xb4, 0x1c, 0x4a, 0xae, 0x75, 0x4a, 0x0c,
+	0xf5, 0x54, 0x94, 0xd3, 0x32, 0x4d, 0x11, 0x9c, 0xb1, 0xee, 0xcd, 0x8a, 0x1b, 0x50, 0xc6, 0xc0,
+	0x59, 0xb9, 0x80, 0xb9, 0xcf, 0xab, 0x7d, 0x55, 0x84, 0x2a, 0x5d, 0x14, 0xc8, 0x41, 0xd8, 0xe8,
+	0x8a, 0x7f, 0x3e, 0x79, 0x3f, 0xfe, 0x7d, 0x71, 0x9e, 0xe3, 0x6e, 0x16, 0x35, 0xc7, 0x01, 0x1b,
+	0x18, 0x1b, 0x85, 0x9f, 0x08, 0x09, 0xac, 0x2f, 0x45, 0xb4, 0x14, 0x7e, 0x6a, 0xba, 0x18, 0xe4,
+	0x7d, 0xcf, 0xb4, 0xa7, 0xe7, 0x5f, 0xd3, 0xe4, 0xa8, 0x5d, 0xd9, 0x17, 0xb5, 0x0b, 0x2b, 0xc8,
+	0x25, 0x15, 0xfc, 0x8f, 0x3a, 0x40, 0x32, 0xb2, 0x5f, 0x2b, 0xef, 0x7d, 0xab, 0xd0, 0x90, 0xf7,
+	0xf0, 0x0d, 0xbd, 0xf9, 0xb9, 0x9e, 0xe4, 0xc8, 0xad, 0xcd, 0x51, 0x43, 0xaa, 0x51, 0x72, 0xbd,
+	0x63, 0x35, 0x7c, 0x20, 0xbf, 0x36, 0x7c, 0xe0, 0x1d, 0x28, 0x71, 0x8f, 0x64, 0x20, 0x2e, 0x0a,
+	0x5d, 0x5f, 0x96, 0xeb, 0x1e, 0x88, 0x37, 0x09, 0x23, 0x3a, 0xb5, 0x03, 0x8d, 0xf8, 0x39, 0x2e,
+	0xf9, 0xda, 0xd0, 0x9d, 0xd5, 0x9c, 0x11, 0x19, 0x7f, 0x05, 0xc6, 0x90, 0x93, 0x92, 0x2e, 0x13,
+	0xce, 0x84, 0x99, 0x9c, 0xd8, 0x46, 0x49, 0xd6, 0x65, 0x46, 0x33, 0x6e, 0x1c, 0x47, 0xee, 0xf2,
+	0x03, 0xb8, 0x2c, 0x42, 0xb0, 0x31, 0x03, 0x0e, 0x27, 0xd1, 0xf3, 0xab, 0xc7, 0xe2, 0xde, 0xf6,
+	0x68, 0x46, 0x46, 0x02, 0x24, 0xff, 0x14, 0xae, 0x70, 0x9e, 0x88, 0xef, 0x06, 0xe9, 0xf4, 0x44,
+	0xaf, 0x8e, 0x51, 0x25, 0x5c, 0x3b, 0x7b, 0x7d, 0xa5, 0xb1, 0x6d, 0x22, 0x1e, 0x8d, 0x1d, 0x8a,
+	0xb9, 0x8a, 0x83, 0x4c, 0x36, 0x27, 0xcb, 0xf0, 0x25, 0x27, 0x3c, 0xac, 0x38, 0xe1, 0x97, 0x95,
+	0xae, 0xea, 0xaa, 0xd2, 0x75, 0xf3, 0x1f, 0x94, 0xa1, 0xc8, 0x07, 0x96, 0xde, 0xf6, 0xf1, 0xbd,
+	0x79, 0x9a, 0xbb, 0xa5, 0xcf, 0x2d, 0xfa, 0x21, 0x0d, 0x14, 0xc1, 0x1f, 0x40, 0x11, 0x63, 0x48,
+	0xa6, 0x27, 0x69, 0x57, 0xf8, 0x92, 0x88, 0x8b, 0x9e, 0x2c, 0x03, 0x3f, 0xd4, 0x0f, 0xa1, 0x82,
+	0xf4, 0xdc, 0xca, 0x9f, 0x32, 0xeb, 0xac, 0x0a, 0xa3, 0xe8, 0xd9, 0x36, 0xc4, 0xb7, 0xfa, 0xa3,
+	0xb4, 0x53, 0x81, 0x4b, 0x8a, 0x37, 0x57, 0xb2, 0x5e, 0xe4, 0x5e, 0xf8, 0x4d, 0xe0, 0x56, 0xe6,
+	0x98, 0x83, 0x15, 0x64, 0xaf, 0xeb, 0x0a, 0xbf, 0x43, 0x93, 0xb6, 0xc1, 0xe3, 0xdd, 0x28, 0x8d,
+	0x4f, 0xf2, 0xf0, 0xfc, 0xf1, 0x93, 0xf7, 0x6b, 0x46, 0x06, 0x19, 0x49, 0x6c, 0xf5, 0xc7, 0x04,
+	0x65, 0x33, 0xcd, 0x28, 0x7e, 0xac, 0xb4, 0x92, 0x2d, 0x66, 0x57, 0x94, 0x2d, 0x4a, 0xa8, 0x0f,
+	0xa1, 0x4a, 0x2c, 0x5f, 0xe4, 0x2b, 0xaf, 0x0c, 0x6d, 0xc2, 0x6d, 0xc8, 0xa3, 0x18, 0xa7, 0xd4,
+	0x76, 0xd4, 0x4f, 0xdf, 0x92, 0x9d, 0x36, 0xb7, 0xd6, 0x0e, 0x14, 0x8b, 0xfd, 0x37, 0xbc, 0xb3,
+	0x8c, 0xe7, 0x51, 0x77, 0xa0, 0x66, 0x48, 0x32, 0x56, 0x13, 0x2e, 0x28, 0x43, 0xa2, 0xa1, 0x32,
+	0xa4, 0xb4, 0xda, 0xe1, 0xee, 0x83, 0x83, 0xa5, 0x57, 0x09, 0x6f, 0xaf, 0x5b, 0x4d, 0x72, 0x29,
+	0xe9, 0x5c, 0xea, 0x4f, 0x61, 0x33, 0x5c, 0x16, 0x4f, 0x84, 0x9f, 0xe7, 0xe5, 0xe5, 0xa2, 0x56,
+	0xe4, 0x98, 0xbd, 0x4b, 0x6c, 0x35, 0x37, 0x16, 0x69, 0x2d, 0x4b, 0x27, 0xcd, 0xfa, 0xfa, 0x22,
+	0x57, 0xc4, 0x18, 0x2c, 0x72, 0x25, 0xb7, 0xfa, 0xdb, 0x70, 0x55, 0x3e, 0x4c, 0x93, 0x62, 0xb9,
+	0xed, 0xe1, 0x7b, 0xcb, 0xc5, 0xae, 0x15, 0x2f, 0xf6, 0x2e, 0xb1, 0xf5, 0xa5, 0xe0, 0xe2, 0x9d,
+	0xd1, 0x39, 0x11, 0x2d, 0x88, 0x8d, 0x95, 0xc5, 0x2b, 0x9f, 0x23, 0x38, 0x17, 0x33, 0x29, 0xad,
+	0xee, 0xc3, 0x26, 0x5d, 0xd3, 0xe4, 0xf6, 0x0c, 0x9f, 0xce, 0x64, 0xe1, 0x4a, 0xba, 0xbb, 0xdc,
+	0xb4, 0xa5, 0xa3, 0x7b, 0xef, 0x12, 0xdb, 0x08, 0xd2, 0xa0, 0x24, 0x68, 0xe4, 0x26, 0x83, 0x6b,
+	0xeb, 0xb9, 0x94, 0x1c, 0xf9, 0x96, 0xe7, 0x91, 0x6f, 0x5a, 0xfa, 0x59, 0x84, 0xf4, 0x45, 0x56,
+	0x29, 0x0e, 0xee, 0x27, 0x68, 0xa5, 0x95, 0xf9, 0x72, 0xca, 0x48, 0x50, 0x86, 0x7c, 0x7b, 0x70,
+	0x80, 0x71, 0x23, 0x55, 0x28, 0x75, 0xfb, 0xc3, 0x51, 0xab, 0x2f, 0x42, 0x82, 0xba, 0x7d, 0x11,
+	0x12, 0xa4, 0xfd, 0x4f, 0x8c, 0xa4, 0x8b, 0xbd, 0x98, 0xbf, 0xb2, 0x69, 0x36, 0x36, 0x83, 0xe4,
+	0x64, 0x33, 0xc8, 0x92, 0xfa, 0xc9, 0x85, 0x30, 0x6e, 0xdb, 0xd8, 0x48, 0x2b, 0x79, 0xc1, 0xea,
+	0xcd, 0xba, 0xc2, 0x37, 0xbc, 0x59, 0x27, 0x87, 0x11, 0x17, 0xd3, 0x61, 0xc4, 0x4b, 0x6f, 0x07,
+	0x97, 0x28, 0xac, 0x4e, 0x7e, 0x3b, 0xf8, 0x42, 0xd9, 0xbd, 0x7c, 0xb1, 0x22, 0x41, 0x3f, 0x04,
+	0x85, 0x86, 0x1b, 0x11, 0x4d, 0x2b, 0x52, 0x69, 0xc9, 0x00, 0x5e, 0x20, 0x19, 0x7c, 0x83, 0x53,
+	0x46, 0xdd, 0x86, 0x2b, 0xd3, 0x93, 0xf8, 0x9d, 0xbc, 0xc4, 0xc4, 0x57, 0xa3, 0x6e, 0xac, 0xc5,
+	0xa1, 0xe2, 0x60, 0x07, 0x6b, 0xbc, 0x7a, 0xfc, 0x05, 0x76, 0xd5, 0x0e, 0x56, 0xfc, 0x75, 0x7f,
+	0x25, 0x03, 0x90, 0x78, 0x0a, 0xbf, 0xb3, 0x53, 0x42, 0xb2, 0xfb, 0xe6, 0xbe, 0xc6, 0xee, 0xfb,
+	0x82, 0xf7, 0x1f, 0xb4, 0x2f, 0xa1, 0x12, 0xfb, 0x86, 0x7f, 0xf5, 0x55, 0xf9, 0xad, 0xaa, 0xfc,
+	0x9d, 0xc8, 0x41, 0x13, 0x3b, 0x57, 0xbf, 0xeb, 0x58, 0xa4, 0xaa, 0xcf, 0xbd, 0xa0, 0xfa, 0x33,
+	0xee, 0x25, 0x89, 0x2b, 0xff, 0x35, 0x6f, 0x45, 0x79, 0x97, 0xe4, 0x53, 0xbb, 0x44, 0x5b, 0x08,
+	0x57, 0xcf, 0x77, 0xaf, 0xfa, 0x5b, 0x75, 0xf8, 0x4f, 0x32, 0x91, 0x3f, 0x22, 0x7e, 0xaf, 0xf0,
+	0x5b, 0x5a, 0x59, 0xbf, 0x4d, 0x75, 0x5f, 0x6b, 0x74, 0xcb, 0x7f, 0x9d, 0xd1, 0xed, 0x75, 0x28,
+	0x70, 0xe9, 0xa0, 0x70, 0x91, 0xc1, 0x8d, 0xe3, 0x5f, 0xf8, 0xc2, 0xbb, 0xa6, 0x09, 0x2d, 0x83,
+	0xf7, 0xf7, 0x4a, 0x54, 0x6e, 0xf4, 0x3a, 0x3d, 0x26, 0xd0, 0xe6, 0x59, 0x49, 0x6c, 0x6f, 0xdf,
+	0x7e, 0x4c, 0x7e, 0x6d, 0x56, 0xb7, 0x7f, 0x98, 0x85, 0x7a, 0x2a, 0x2c, 0xe4, 0x57, 0x68, 0xcc,
+	0x5a, 0xfe, 0x9f, 0x5b, 0xcf, 0xff, 0x2f, 0x64, 0xc5, 0xf9, 0x8b, 0x59, 0xf1, 0xff, 0x91, 0x33,
+	0x83, 0x47, 0xf3, 0x8b, 0xc7, 0xe4, 0xcb, 0x51, 0x34, 0x3f, 0x8f, 0x46, 0x47, 0x6e, 0x5a, 0x93,
+	0xeb, 0x5d, 0xab, 0xcc, 0x65, 0xd6, 0x2a, 0x73, 0x77, 0xe2, 0x1f, 0x4a, 0xea, 0xee, 0x72, 0x7b,
+	0x47, 0x9d, 0x49, 0x10, 0x7c, 0x02, 0x84, 0x4b, 0x34, 0x5c, 0xaa, 0xd7, 0xbd, 0xa9, 0x1e, 0x61,
+	0x4d, 0x11, 0xae, 0x7e, 0x8d, 0x13, 0xf0, 0xe7, 0xff, 0xa7, 0xad, 0x08, 0xab, 0x75, 0xa1, 0x9e,
+	0x8a, 0xd1, 0x91, 0x7e, 0x92, 0x2d, 0x23, 0xff, 0x24, 0x1b, 0x46, 0x47, 0x9f, 0x1e, 0x5b, 0xbe,
+	0xb5, 0xe6, 0x0d, 0x37, 0x8e, 0xc0, 0xdf, 0x61, 0x91, 0xe3, 0x05, 0xd5, 0x37, 0xa1, 0x60, 0x87,
+	0xd6, 0x2c, 0x32, 0x26, 0x5e, 0x5b, 0x0d, 0x29, 0x24, 0x7b, 0x22, 0x27, 0xc2, 0xd8, 0x3c, 0x65,
+	0x19, 0x27, 0xfd, 0x6e, 0x5c, 0xe6, 0x82, 0xdf, 0x8d, 0xcb, 0xa6, 0x1a, 0xb9, 0xee, 0xa7, 0xdf,
+	0xe2, 0x77, 0xa4, 0xf2, 0x17, 0xbc, 0x23, 0x85, 0xd7, 0x78, 0x7d, 0x8b, 0x7e, 0x94, 0xcb, 0x6c,
+	0x16, 0x56, 0x88, 0x62, 0x9c, 0xf6, 0x97, 0x32, 0x50, 0x12, 0xc1, 0x8d, 0x6b, 0x6d, 0x0c, 0x6f,
+	0x40, 0x89, 0xff, 0x40, 0x57, 0x64, 0x93, 0x5a, 0xb9, 0x4d, 0x10, 0xe1, 0xf1, 0xba, 0x05, 0xa2,
+	0xd2, 0x66, 0x12, 0x0c, 0x79, 0x65, 0x04, 0xc7, 0xa5, 0xc6, 0x2d, 0xba, 0xa8, 0x87, 0x07, 0xe2,
+	0x2d, 0x10, 0x20, 0x10, 0x0a, 0x73, 0x81, 0xf6, 0x23, 0x28, 0x89, 0xe0, 0xc9, 0xb5, 0x4d, 0x79,
+	0xd1, 0x4f, 0x56, 0x6d, 0x01, 0x24, 0xd1, 0x94, 0xeb, 0x4a, 0xc0, 0x1f, 0x9b, 0x8b, 0x02, 0x28,
+	0x71, 0xfd, 0x25, 0x55, 0x8b, 0x1b, 0x34, 0x72, 0x63, 0x1c, 0xf1, 0xd0, 0x29, 0xc6, 0x51, 0x91,
+	0x73, 0xe1, 0x2d, 0xfc, 0xc5, 0x18, 0xf1, 0x7e, 0x6c, 0xe6, 0xe2, 0xf7, 0x63, 0x63, 0x22, 0xf5,
+	0x3e, 0xc4, 0xec, 0xf8, 0x45, 0xa6, 0x13, 0xad, 0x15, 0x5d, 0x15, 0xa3, 0x55, 0xf6, 0xae, 0xb0,
+	0x6e, 0xf6, 0xe8, 0x05, 0x9b, 0x94, 0xdd, 0x3a, 0xd5, 0x26, 0x26, 0x91, 0x69, 0x0d, 0xa8, 0xc9,
+	0x51, 0x5f, 0xda, 0xcf, 0xf3, 0xa0, 0xe0, 0xcf, 0x94, 0x21, 0xd3, 0xc2, 0x1b, 0x77, 0xd4, 0x89,
+	0x1b, 0x50, 0x8e, 0x7f, 0x98, 0x24, 0x13, 0x3d, 0x6c, 0xed, 0x44, 0xbf, 0xd8, 0xe1, 0xd1, 0xa4,
+	0xca, 0x36, 0x30, 0xe0, 0x20, 0x22, 0xe0, 0x9c, 0x20, 0xf5, 0x42, 0x74, 0xd9, 0x0e, 0xf6, 0x28,
+	0x8d, 0x0e, 0x01, 0x7c, 0x73, 0xc3, 0xf1, 0x26, 0xb4, 0x26, 0x6b, 0xf4, 0x26, 0x47, 0xcf, 0x9b,
+	0x60, 0xae, 0xc8, 0x74, 0x12, 0x88, 0x0b, 0x78, 0x65, 0x0e, 0x18, 0x91, 0x97, 0x5a, 0xbc, 0xbc,
+	0x10, 0x06, 0xc4, 0x99, 0x6a, 0xac, 0xcc, 0x01, 0xa3, 0x20, 0x7a, 0x4c, 0x73, 0x22, 0x7e, 0x21,
+	0x24, 0x47, 0x8f, 0x69, 0xe2, 0x6b, 0x9f, 0x68, 0xeb, 0xc3, 0x1f, 0xa1, 0x99, 0x88, 0xdf, 0x00,
+	0x12, 0x4f, 0x95, 0x22, 0xea, 0x15, 0xfe, 0x1b, 0x2a, 0xbe, 0x15, 0x04, 0xfc, 0xa5, 0x26, 0xfe,
+	0x88, 0x52, 0x2d, 0x02, 0xc6, 0x4f, 0x42, 0x89, 0x5f, 0x9d, 0x41, 0x12, 0x10, 0x4f, 0x42, 0x11,
+	0x88, 0x08, 0x6e, 0x40, 0xf9, 0x2b, 0xcf, 0xb5, 0xc8, 0x04, 0x53, 0xa5, 0x56, 0x95, 0x30, 0xbd,
+	0x6f, 0xcc, 0xb5, 0x7f, 0x95, 0x81, 0x2b, 0xcb, 0xa3, 0x4a, 0xb3, 0x4d, 0x2e, 0xca, 0x9e, 0xde,
+	0x6f, 0xed, 0x63, 0xd4, 0xd7, 0x06, 0x54, 0x07, 0x3b, 0x78, 0x59, 0x99, 0x03, 0x32, 0x74, 0xe7,
+	0x76, 0xa8, 0xef, 0x75, 0x77, 0x77, 0x3b, 0x7d, 0xae, 0x94, 0x0c, 0x76, 0x3e, 0xd1, 0x7b, 0x83,
+	0x36, 0xff, 0xc1, 0x8b, 0x28, 0xf6, 0x6b, 0xa8, 0xe4, 0x31, 0xc9, 0xaf, 0x2d, 0x60, 0xb2, 0xc0,
+	0xa3, 0xf2, 0x9f, 0x0d, 0xf5, 0x76, 0x7f, 0xa4, 0x14, 0x31, 0x85, 0x97, 0x42, 0xf5, 0x76, 0x14,
+	0x7e, 0x8b, 0x7e, 0x51, 0xd6, 0x19, 0x0e, 0xf5, 0x61, 0xf7, 0xf3, 0x8e, 0x52, 0xa6, 0x9a, 0x59,
+	0xf7, 0x71, 0xb7, 0xcf, 0x01, 0x15, 0xf4, 0x40, 0xee, 0x77, 0xfb, 0x0a, 0xd0, 0x47, 0xeb, 0x53,
+	0xa5, 0x8a, 0x1f, 0xc3, 0xc3, 0x7d, 0xa5, 0x76, 0xff, 0x65, 0xa8, 0xc9, 0x3f, 0xe4, 0x44, 0x81,
+	0xf8, 0x9e, 0x6b, 0xf1, 0x07, 0x36, 0x7b, 0x5f, 0xbd, 0xa7, 0x64, 0xee, 0xff, 0x8e, 0xf4, 0x1a,
+	0x3b, 0xd1, 0x08, 0x87, 0x26, 0x5d, 0xfd, 0xe6, 0x37, 0x51, 0xc9, 0x7d, 0x49, 0x17, 0x57, 0xf7,
+	0x5a, 0xc3, 0x3d, 0xee, 0xea, 0x14, 0x18, 0x02, 0xe4, 0x92, 0x87, 0x19, 0xe9, 0xaa, 0x37, 0x7d,
+	0xc6, 0xa1, 0x5e, 0x05, 0xcc, 0x48, 0x51, 0x58, 0x45, 0x0c, 0x60, 0xc2, 0xaf, 0x18, 0x57, 0xba,
+	0xaf, 0x41, 0x55, 0x7a, 0x4b, 0x97, 0xea, 0x30, 0x82, 0x63, 0xf1, 0xd6, 0x23, 0x6a, 0x97, 0x4a,
+	0xe6, 0xfe, 0xfb, 0x50, 0x17, 0x34, 0xe2, 0x25, 0x5b, 0xfc, 0x7d, 0x44, 0xbc, 0x24, 0xea, 0x08,
+	0x3a, 0x6b, 0x11, 0x58, 0x7c, 0x0a, 0x98, 0x25, 0xde, 0xbc, 0x55, 0xb2, 0xf7, 0xdf, 0x82, 0xab,
+	0x6b, 0x9f, 0xe9, 0xc5, 0xec, 0x43, 0x1b, 0x63, 0xf7, 0xf9, 0xf5, 0x88, 0xbd, 0xf3, 0xb1, 0x6f,
+	0x9b, 0x4a, 0xe6, 0xfe, 0x4f, 0xa0, 0x79, 0x51, 0xb4, 0x3f, 0x56, 0xd3, 0xde, 0x6b, 0xd1, 0x8d,
+	0x0a, 0x9c, 0xa1, 0x81, 0xce, 0x53, 0x19, 0x7e, 0x21, 0xa5, 0xd7, 0xa1, 0x20, 0xbf, 0xfb, 0x3f,
+	0xcb, 0x48, 0x4c, 0x25, 0x8a, 0xd8, 0x8e, 0x01, 0x62, 0xe8, 0x65, 0x10, 0xb3, 0x0c, 0x53, 0xc9,
+	0xa8, 0xd7, 0x40, 0x4d, 0x81, 0x7a, 0xde, 0xc4, 0x70, 0x94, 0x2c, 0x85, 0xf3, 0x45, 0xf0, 0x67,
+	0xbe, 0x1d, 0x5a, 0x4a, 0x0e, 0x43, 0xbe, 0x62, 0x58, 0xcf, 0x3b, 0x3d, 0xf0, 0x6d, 0xd4, 0x97,
+	0xcf, 0x39, 0x3a, 0xbf, 0xf3, 0xe3, 0x5f, 0xfc, 0xf2, 0x4e, 0xe6, 0xdf, 0xfc, 0xf2, 0x4e, 0xe6,
+	0x3f, 0xfd, 0xf2, 0xce, 0xa5, 0x9f, 0xff, 0xe7, 0x3b, 0x99, 0xcf, 0xe5, 0x1f, 0x4f, 0x9e, 0x19,
+	0xa1, 0x6f, 0x9f, 0xf1, 0x9d, 0x10, 0x25, 0x5c, 0xeb, 0xad, 0xf9, 0xc9, 0xd1, 0x5b, 0xf3, 0xf1,
+	0x5b, 0xc8, 0x80, 0xc6, 0x45, 0xfa, 0x99, 0xe4, 0x77, 0xff, 0xf7, 0x00, 0xbc, 0x7d, 0x1a, 0xc4,
+	0x86, 0x79, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -14705,6 +15537,18 @@ func (m *PartitionByDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SubPartition != nil {
+		{
+			size, err := m.SubPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.PartitionTableNames) > 0 {
 		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PartitionTableNames[iNdEx])
@@ -14799,6 +15643,110 @@ func (m *PartitionByDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubPartitionByDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubPartitionByDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubPartitionByDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ParentPartitionExpression != nil {
+		{
+			size, err := m.ParentPartitionExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Algorithm != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PartitionNum != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.PartitionNum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PartitionColumns != nil {
+		{
+			size, err := m.PartitionColumns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PartitionExpression != nil {
+		{
+			size, err := m.PartitionExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PartitionExpr != nil {
+		{
+			size, err := m.PartitionExpr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PartitionExpr) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15208,20 +16156,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA45 := make([]byte, len(m.RefChildTbls)*10)
-		var j44 int
+		dAtA50 := make([]byte, len(m.RefChildTbls)*10)
+		var j49 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j44++
+				j49++
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			dAtA50[j49] = uint8(num)
+			j49++
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintPlan(dAtA, i, uint64(j44))
+		i -= j49
+		copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintPlan(dAtA, i, uint64(j49))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -15459,9 +16407,9 @@ func (m *HashMapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.Ranges) > 0 {
 		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
-			f49 := math.Float64bits(float64(m.Ranges[iNdEx]))
+			f54 := math.Float64bits(float64(m.Ranges[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f49))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f54))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.Ranges)*8))
 		i--
@@ -16124,21 +17072,21 @@ func (m *OnDuplicateKeyCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA60 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j59 int
+		dAtA65 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j64 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j59++
+				j64++
 			}
-			dAtA60[j59] = uint8(num)
-			j59++
+			dAtA65[j64] = uint8(num)
+			j64++
 		}
-		i -= j59
-		copy(dAtA[i:], dAtA60[:j59])
-		i = encodeVarintPlan(dAtA, i, uint64(j59))
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintPlan(dAtA, i, uint64(j64))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -16221,20 +17169,20 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA62 := make([]byte, len(m.PartitionTableIds)*10)
-		var j61 int
+		dAtA67 := make([]byte, len(m.PartitionTableIds)*10)
+		var j66 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j61++
+				j66++
 			}
-			dAtA62[j61] = uint8(num)
-			j61++
+			dAtA67[j66] = uint8(num)
+			j66++
 		}
-		i -= j61
-		copy(dAtA[i:], dAtA62[:j61])
-		i = encodeVarintPlan(dAtA, i, uint64(j61))
+		i -= j66
+		copy(dAtA[i:], dAtA67[:j66])
+		i = encodeVarintPlan(dAtA, i, uint64(j66))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -16334,20 +17282,20 @@ func (m *ReplaceCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA66 := make([]byte, len(m.PartitionTableIds)*10)
-		var j65 int
+		dAtA71 := make([]byte, len(m.PartitionTableIds)*10)
+		var j70 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j65++
+				j70++
 			}
-			dAtA66[j65] = uint8(num)
-			j65++
+			dAtA71[j70] = uint8(num)
+			j70++
 		}
-		i -= j65
-		copy(dAtA[i:], dAtA66[:j65])
-		i = encodeVarintPlan(dAtA, i, uint64(j65))
+		i -= j70
+		copy(dAtA[i:], dAtA71[:j70])
+		i = encodeVarintPlan(dAtA, i, uint64(j70))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -16437,42 +17385,42 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if len(m.TimeConsumedArrayMinor) > 0 {
-		dAtA70 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
-		var j69 int
+		dAtA75 := make([]byte, len(m.TimeConsumedArrayMinor)*10)
+		var j74 int
 		for _, num1 := range m.TimeConsumedArrayMinor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA70[j69] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA75[j74] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j69++
+				j74++
 			}
-			dAtA70[j69] = uint8(num)
-			j69++
+			dAtA75[j74] = uint8(num)
+			j74++
 		}
-		i -= j69
-		copy(dAtA[i:], dAtA70[:j69])
-		i = encodeVarintPlan(dAtA, i, uint64(j69))
+		i -= j74
+		copy(dAtA[i:], dAtA75[:j74])
+		i = encodeVarintPlan(dAtA, i, uint64(j74))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x82
 	}
 	if len(m.TimeConsumedArrayMajor) > 0 {
-		dAtA72 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
-		var j71 int
+		dAtA77 := make([]byte, len(m.TimeConsumedArrayMajor)*10)
+		var j76 int
 		for _, num1 := range m.TimeConsumedArrayMajor {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j71++
+				j76++
 			}
-			dAtA72[j71] = uint8(num)
-			j71++
+			dAtA77[j76] = uint8(num)
+			j76++
 		}
-		i -= j71
-		copy(dAtA[i:], dAtA72[:j71])
-		i = encodeVarintPlan(dAtA, i, uint64(j71))
+		i -= j76
+		copy(dAtA[i:], dAtA77[:j76])
+		i = encodeVarintPlan(dAtA, i, uint64(j76))
 		i--
 		dAtA[i] = 0x7a
 	}
@@ -17016,21 +17964,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if len(m.SourceStep) > 0 {
-		dAtA86 := make([]byte, len(m.SourceStep)*10)
-		var j85 int
+		dAtA91 := make([]byte, len(m.SourceStep)*10)
+		var j90 int
 		for _, num1 := range m.SourceStep {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j85++
+				j90++
 			}
-			dAtA86[j85] = uint8(num)
-			j85++
+			dAtA91[j90] = uint8(num)
+			j90++
 		}
-		i -= j85
-		copy(dAtA[i:], dAtA86[:j85])
-		i = encodeVarintPlan(dAtA, i, uint64(j85))
+		i -= j90
+		copy(dAtA[i:], dAtA91[:j90])
+		i = encodeVarintPlan(dAtA, i, uint64(j90))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -17121,21 +18069,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA92 := make([]byte, len(m.BindingTags)*10)
-		var j91 int
+		dAtA97 := make([]byte, len(m.BindingTags)*10)
+		var j96 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j96++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA97[j96] = uint8(num)
+			j96++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintPlan(dAtA, i, uint64(j91))
+		i -= j96
+		copy(dAtA[i:], dAtA97[:j96])
+		i = encodeVarintPlan(dAtA, i, uint64(j96))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -17374,21 +18322,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA101 := make([]byte, len(m.Children)*10)
-		var j100 int
+		dAtA106 := make([]byte, len(m.Children)*10)
+		var j105 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA106[j105] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j100++
+				j105++
 			}
-			dAtA101[j100] = uint8(num)
-			j100++
+			dAtA106[j105] = uint8(num)
+			j105++
 		}
-		i -= j100
-		copy(dAtA[i:], dAtA101[:j100])
-		i = encodeVarintPlan(dAtA, i, uint64(j100))
+		i -= j105
+		copy(dAtA[i:], dAtA106[:j105])
+		i = encodeVarintPlan(dAtA, i, uint64(j105))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -17626,20 +18574,20 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA106 := make([]byte, len(m.PartitionTableIds)*10)
-		var j105 int
+		dAtA111 := make([]byte, len(m.PartitionTableIds)*10)
+		var j110 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA106[j105] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA111[j110] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j105++
+				j110++
 			}
-			dAtA106[j105] = uint8(num)
-			j105++
+			dAtA111[j110] = uint8(num)
+			j110++
 		}
-		i -= j105
-		copy(dAtA[i:], dAtA106[:j105])
-		i = encodeVarintPlan(dAtA, i, uint64(j105))
+		i -= j110
+		copy(dAtA[i:], dAtA111[:j110])
+		i = encodeVarintPlan(dAtA, i, uint64(j110))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -17746,21 +18694,21 @@ func (m *PreInsertUkCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Columns) > 0 {
-		dAtA111 := make([]byte, len(m.Columns)*10)
-		var j110 int
+		dAtA116 := make([]byte, len(m.Columns)*10)
+		var j115 int
 		for _, num1 := range m.Columns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA111[j110] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA116[j115] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j110++
+				j115++
 			}
-			dAtA111[j110] = uint8(num)
-			j110++
+			dAtA116[j115] = uint8(num)
+			j115++
 		}
-		i -= j110
-		copy(dAtA[i:], dAtA111[:j110])
-		i = encodeVarintPlan(dAtA, i, uint64(j110))
+		i -= j115
+		copy(dAtA[i:], dAtA116[:j115])
+		i = encodeVarintPlan(dAtA, i, uint64(j115))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -17792,21 +18740,21 @@ func (m *PreDeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Idx) > 0 {
-		dAtA113 := make([]byte, len(m.Idx)*10)
-		var j112 int
+		dAtA118 := make([]byte, len(m.Idx)*10)
+		var j117 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA113[j112] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA118[j117] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j112++
+				j117++
 			}
-			dAtA113[j112] = uint8(num)
-			j112++
+			dAtA118[j117] = uint8(num)
+			j117++
 		}
-		i -= j112
-		copy(dAtA[i:], dAtA113[:j112])
-		i = encodeVarintPlan(dAtA, i, uint64(j112))
+		i -= j117
+		copy(dAtA[i:], dAtA118[:j117])
+		i = encodeVarintPlan(dAtA, i, uint64(j117))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -17978,21 +18926,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA118 := make([]byte, len(m.List)*10)
-		var j117 int
+		dAtA123 := make([]byte, len(m.List)*10)
+		var j122 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA118[j117] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA123[j122] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j117++
+				j122++
 			}
-			dAtA118[j117] = uint8(num)
-			j117++
+			dAtA123[j122] = uint8(num)
+			j122++
 		}
-		i -= j117
-		copy(dAtA[i:], dAtA118[:j117])
-		i = encodeVarintPlan(dAtA, i, uint64(j117))
+		i -= j122
+		copy(dAtA[i:], dAtA123[:j122])
+		i = encodeVarintPlan(dAtA, i, uint64(j122))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -18111,20 +19059,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA122 := make([]byte, len(m.PartitionTableIds)*10)
-		var j121 int
+		dAtA127 := make([]byte, len(m.PartitionTableIds)*10)
+		var j126 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA122[j121] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA127[j126] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j121++
+				j126++
 			}
-			dAtA122[j121] = uint8(num)
-			j121++
+			dAtA127[j126] = uint8(num)
+			j126++
 		}
-		i -= j121
-		copy(dAtA[i:], dAtA122[:j121])
-		i = encodeVarintPlan(dAtA, i, uint64(j121))
+		i -= j126
+		copy(dAtA[i:], dAtA127[:j126])
+		i = encodeVarintPlan(dAtA, i, uint64(j126))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -18202,6 +19150,16 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Returning {
+		i--
+		if m.Returning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.DetectSqls) > 0 {
 		for iNdEx := len(m.DetectSqls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DetectSqls[iNdEx])
@@ -18259,21 +19217,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA125 := make([]byte, len(m.Steps)*10)
-		var j124 int
+		dAtA130 := make([]byte, len(m.Steps)*10)
+		var j129 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA125[j124] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA130[j129] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j124++
+				j129++
 			}
-			dAtA125[j124] = uint8(num)
-			j124++
+			dAtA130[j129] = uint8(num)
+			j129++
 		}
-		i -= j124
-		copy(dAtA[i:], dAtA125[:j124])
-		i = encodeVarintPlan(dAtA, i, uint64(j124))
+		i -= j129
+		copy(dAtA[i:], dAtA130[:j129])
+		i = encodeVarintPlan(dAtA, i, uint64(j129))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -19248,6 +20206,29 @@ func (m *DataDefinition_CreateView) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	}
 	return len(dAtA) - i, nil
 }
+func (m *DataDefinition_RefreshMaterializedView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDefinition_RefreshMaterializedView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RefreshMaterializedView != nil {
+		{
+			size, err := m.RefreshMaterializedView.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SubscriptionOption) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -19596,6 +20577,28 @@ func (m *CreateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DisableAutoAnalyze {
+		i--
+		if m.DisableAutoAnalyze {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaterializedView != nil {
+		{
+			size, err := m.MaterializedView.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.FksReferToMe) > 0 {
 		for iNdEx := len(m.FksReferToMe) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -19738,6 +20741,119 @@ func (m *CreateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaterializedView) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaterializedView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaterializedView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.QueryDatabase) > 0 {
+		i -= len(m.QueryDatabase)
+		copy(dAtA[i:], m.QueryDatabase)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.QueryDatabase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefreshCron) > 0 {
+		i -= len(m.RefreshCron)
+		copy(dAtA[i:], m.RefreshCron)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.RefreshCron)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Incremental {
+		i--
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshMaterializedView) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshMaterializedView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshMaterializedView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cols[iNdEx])
+			copy(dAtA[i:], m.Cols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Cols[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableDrop) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20149,6 +21265,216 @@ func (m *AlterTableAddPartition) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableDropPartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableDropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableDropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PartitionDef != nil {
+		{
+			size, err := m.PartitionDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableTruncatePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableTruncatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableTruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableExchangePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableExchangePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableExchangePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreateSql) > 0 {
+		i -= len(m.CreateSql)
+		copy(dAtA[i:], m.CreateSql)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.CreateSql)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidateSql) > 0 {
+		i -= len(m.ValidateSql)
+		copy(dAtA[i:], m.ValidateSql)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ValidateSql)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PartitionTableName) > 0 {
+		i -= len(m.PartitionTableName)
+		copy(dAtA[i:], m.PartitionTableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableRedistributePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableRedistributePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableRedistributePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RedistributeSqls) > 0 {
+		for iNdEx := len(m.RedistributeSqls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RedistributeSqls[iNdEx])
+			copy(dAtA[i:], m.RedistributeSqls[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.RedistributeSqls[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DropPartitionTableNames) > 0 {
+		for iNdEx := len(m.DropPartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DropPartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.DropPartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.DropPartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PartitionTables) > 0 {
+		for iNdEx := len(m.PartitionTables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartitionTables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PartitionDef != nil {
+		{
+			size, err := m.PartitionDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableComment) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20183,6 +21509,43 @@ func (m *AlterTableComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableStatsAutoRecalc) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableStatsAutoRecalc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableStatsAutoRecalc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableName) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20317,6 +21680,55 @@ func (m *AlterDropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterModifyColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Seq != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Type.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPlan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20706,6 +22118,134 @@ func (m *AlterTable_Action_AddPartition) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_DropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_DropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropPartition != nil {
+		{
+			size, err := m.DropPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_TruncatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_TruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TruncatePartition != nil {
+		{
+			size, err := m.TruncatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_ExchangePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_ExchangePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ExchangePartition != nil {
+		{
+			size, err := m.ExchangePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_RedistributePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_RedistributePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RedistributePartition != nil {
+		{
+			size, err := m.RedistributePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_StatsAutoRecalc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_StatsAutoRecalc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StatsAutoRecalc != nil {
+		{
+			size, err := m.StatsAutoRecalc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -20730,21 +22270,31 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IsMaterializedView {
+		i--
+		if m.IsMaterializedView {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.FkChildTblsReferToMe) > 0 {
-		dAtA180 := make([]byte, len(m.FkChildTblsReferToMe)*10)
-		var j179 int
+		dAtA196 := make([]byte, len(m.FkChildTblsReferToMe)*10)
+		var j195 int
 		for _, num := range m.FkChildTblsReferToMe {
 			for num >= 1<<7 {
-				dAtA180[j179] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA196[j195] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j179++
+				j195++
 			}
-			dAtA180[j179] = uint8(num)
-			j179++
+			dAtA196[j195] = uint8(num)
+			j195++
 		}
-		i -= j179
-		copy(dAtA[i:], dAtA180[:j179])
-		i = encodeVarintPlan(dAtA, i, uint64(j179))
+		i -= j195
+		copy(dAtA[i:], dAtA196[:j195])
+		i = encodeVarintPlan(dAtA, i, uint64(j195))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -20789,20 +22339,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA183 := make([]byte, len(m.ForeignTbl)*10)
-		var j182 int
+		dAtA199 := make([]byte, len(m.ForeignTbl)*10)
+		var j198 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA183[j182] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA199[j198] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j182++
+				j198++
 			}
-			dAtA183[j182] = uint8(num)
-			j182++
+			dAtA199[j198] = uint8(num)
+			j198++
 		}
-		i -= j182
-		copy(dAtA[i:], dAtA183[:j182])
-		i = encodeVarintPlan(dAtA, i, uint64(j182))
+		i -= j198
+		copy(dAtA[i:], dAtA199[:j198])
+		i = encodeVarintPlan(dAtA, i, uint64(j198))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -21355,20 +22905,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x40
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA192 := make([]byte, len(m.ForeignTbl)*10)
-		var j191 int
+		dAtA208 := make([]byte, len(m.ForeignTbl)*10)
+		var j207 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA192[j191] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA208[j207] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j191++
+				j207++
 			}
-			dAtA192[j191] = uint8(num)
-			j191++
+			dAtA208[j207] = uint8(num)
+			j207++
 		}
-		i -= j191
-		copy(dAtA[i:], dAtA192[:j191])
-		i = encodeVarintPlan(dAtA, i, uint64(j191))
+		i -= j207
+		copy(dAtA[i:], dAtA208[:j207])
+		i = encodeVarintPlan(dAtA, i, uint64(j207))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -21454,20 +23004,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA195 := make([]byte, len(m.AccountIDs)*10)
-		var j194 int
+		dAtA211 := make([]byte, len(m.AccountIDs)*10)
+		var j210 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA195[j194] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA211[j210] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j194++
+				j210++
 			}
-			dAtA195[j194] = uint8(num)
-			j194++
+			dAtA211[j210] = uint8(num)
+			j210++
 		}
-		i -= j194
-		copy(dAtA[i:], dAtA195[:j194])
-		i = encodeVarintPlan(dAtA, i, uint64(j194))
+		i -= j210
+		copy(dAtA[i:], dAtA211[:j210])
+		i = encodeVarintPlan(dAtA, i, uint64(j210))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -21679,21 +23229,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA199 := make([]byte, len(m.ParamTypes)*10)
-		var j198 int
+		dAtA215 := make([]byte, len(m.ParamTypes)*10)
+		var j214 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA199[j198] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA215[j214] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j198++
+				j214++
 			}
-			dAtA199[j198] = uint8(num)
-			j198++
+			dAtA215[j214] = uint8(num)
+			j214++
 		}
-		i -= j198
-		copy(dAtA[i:], dAtA199[:j198])
-		i = encodeVarintPlan(dAtA, i, uint64(j198))
+		i -= j214
+		copy(dAtA[i:], dAtA215[:j214])
+		i = encodeVarintPlan(dAtA, i, uint64(j214))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -21840,21 +23390,21 @@ func (m *OtherDCL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA202 := make([]byte, len(m.ParamTypes)*10)
-		var j201 int
+		dAtA218 := make([]byte, len(m.ParamTypes)*10)
+		var j217 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA202[j201] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA218[j217] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j201++
+				j217++
 			}
-			dAtA202[j201] = uint8(num)
-			j201++
+			dAtA218[j217] = uint8(num)
+			j217++
 		}
-		i -= j201
-		copy(dAtA[i:], dAtA202[:j201])
-		i = encodeVarintPlan(dAtA, i, uint64(j201))
+		i -= j217
+		copy(dAtA[i:], dAtA218[:j217])
+		i = encodeVarintPlan(dAtA, i, uint64(j217))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -23328,6 +24878,53 @@ func (m *PartitionByDef) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.SubPartition != nil {
+		l = m.SubPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubPartitionByDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.PartitionExpr != nil {
+		l = m.PartitionExpr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.PartitionExpression != nil {
+		l = m.PartitionExpression.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.PartitionColumns != nil {
+		l = m.PartitionColumns.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.PartitionNum != 0 {
+		n += 1 + sovPlan(uint64(m.PartitionNum))
+	}
+	if m.Algorithm != 0 {
+		n += 1 + sovPlan(uint64(m.Algorithm))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.ParentPartitionExpression != nil {
+		l = m.ParentPartitionExpression.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -24774,6 +26371,9 @@ func (m *Query) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.Returning {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25282,6 +26882,18 @@ func (m *DataDefinition_CreateView) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *DataDefinition_RefreshMaterializedView) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefreshMaterializedView != nil {
+		l = m.RefreshMaterializedView.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *SubscriptionOption) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -25497,6 +27109,69 @@ func (m *CreateTable) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.MaterializedView != nil {
+		l = m.MaterializedView.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.DisableAutoAnalyze {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MaterializedView) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Incremental {
+		n += 2
+	}
+	l = len(m.RefreshCron)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.QueryDatabase)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshMaterializedView) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPlan(uint64(m.Mode))
+	}
+	if len(m.Cols) > 0 {
+		for _, s := range m.Cols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25697,6 +27372,108 @@ func (m *AlterTableAddPartition) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableDropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.PartitionDef != nil {
+		l = m.PartitionDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableTruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableExchangePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartitionTableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ValidateSql)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.CreateSql)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableRedistributePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionDef != nil {
+		l = m.PartitionDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.PartitionTables) > 0 {
+		for _, e := range m.PartitionTables {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.DropPartitionTableNames) > 0 {
+		for _, s := range m.DropPartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.RedistributeSqls) > 0 {
+		for _, s := range m.RedistributeSqls {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableComment) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -25713,6 +27490,21 @@ func (m *AlterTableComment) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableStatsAutoRecalc) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableName) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -25776,6 +27568,27 @@ func (m *AlterDropColumn) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = m.Type.ProtoSize()
+	n += 1 + l + sovPlan(uint64(l))
+	if m.Seq != 0 {
+		n += 1 + sovPlan(uint64(m.Seq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -25980,6 +27793,78 @@ func (m *AlterTable_Action_AddPartition) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_DropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropPartition != nil {
+		l = m.DropPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_TruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TruncatePartition != nil {
+		l = m.TruncatePartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_ExchangePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExchangePartition != nil {
+		l = m.ExchangePartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_RedistributePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RedistributePartition != nil {
+		l = m.RedistributePartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_ModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_StatsAutoRecalc) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StatsAutoRecalc != nil {
+		l = m.StatsAutoRecalc.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -26043,6 +27928,9 @@ func (m *DropTable) ProtoSize() (n int) {
 		}
 		n += 1 + sovPlan(uint64(l)) + l
 	}
+	if m.IsMaterializedView {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -32788,6 +34676,42 @@ func (m *PartitionByDef) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubPartition == nil {
+				m.SubPartition = &SubPartitionByDef{}
+			}
+			if err := m.SubPartition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -32810,7 +34734,7 @@ func (m *PartitionByDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionExpr) Unmarshal(dAtA []byte) error {
+func (m *SubPartitionByDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32833,15 +34757,34 @@ func (m *PartitionExpr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionExpr: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubPartitionByDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionExpr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubPartitionByDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PartitionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionExpr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32868,18 +34811,18 @@ func (m *PartitionExpr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expr == nil {
-				m.Expr = &Expr{}
+			if m.PartitionExpr == nil {
+				m.PartitionExpr = &PartitionExpr{}
 			}
-			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartitionExpr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExprStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionExpression", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -32889,78 +34832,345 @@ func (m *PartitionExpr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExprStr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
+			if m.PartitionExpression == nil {
+				m.PartitionExpression = &Expr{}
+			}
+			if err := m.PartitionExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionColumns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PartitionColumns) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			if m.PartitionColumns == nil {
+				m.PartitionColumns = &PartitionColumns{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.PartitionColumns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionNum", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionColumns: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionColumns: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PartitionNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionItem{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentPartitionExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentPartitionExpression == nil {
+				m.ParentPartitionExpression = &Expr{}
+			}
+			if err := m.ParentPartitionExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionExpr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionExpr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionExpr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExprStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExprStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionColumns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionColumns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionColumns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42230,6 +44440,26 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			}
 			m.DetectSqls = append(m.DetectSqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Returning = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -43911,62 +46141,11 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			}
 			m.Definition = &DataDefinition_CreateView{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubscriptionOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshMaterializedView", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -43976,55 +46155,141 @@ func (m *SubscriptionOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Publication", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			v := &RefreshMaterializedView{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Publication = string(dAtA[iNdEx:postIndex])
+			m.Definition = &DataDefinition_RefreshMaterializedView{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publication", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publication = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45067,13 +47332,1000 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FkCols = append(m.FkCols, &FkColName{})
+			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTables = append(m.PartitionTables, &TableDef{})
+			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAsSelectSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateAsSelectSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateFkSqls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateFkSqls = append(m.UpdateFkSqls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FksReferToMe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FksReferToMe = append(m.FksReferToMe, &ForeignKeyInfo{})
+			if err := m.FksReferToMe[len(m.FksReferToMe)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaterializedView", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaterializedView == nil {
+				m.MaterializedView = &MaterializedView{}
+			}
+			if err := m.MaterializedView.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoAnalyze", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoAnalyze = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaterializedView) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaterializedView: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaterializedView: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshCron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshCron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryDatabase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryDatabase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshMaterializedView) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshMaterializedView: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshMaterializedView: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= RefreshMaterializedView_Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fkey == nil {
+				m.Fkey = &ForeignKeyDef{}
+			}
+			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45083,29 +48335,27 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkCols = append(m.FkCols, &FkColName{})
-			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45132,80 +48382,18 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionTables = append(m.PartitionTables, &TableDef{})
-			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAsSelectSql", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CreateAsSelectSql = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateFkSqls", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			if m.IndexInfo == nil {
+				m.IndexInfo = &CreateTable{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UpdateFkSqls = append(m.UpdateFkSqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FksReferToMe", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45215,26 +48403,12 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FksReferToMe = append(m.FksReferToMe, &ForeignKeyInfo{})
-			if err := m.FksReferToMe[len(m.FksReferToMe)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IndexTableExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -45257,7 +48431,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45280,17 +48454,17 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.Typ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45300,14 +48474,27 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45335,9 +48522,41 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
 			}
@@ -45391,7 +48610,7 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45414,10 +48633,10 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -45486,7 +48705,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45514,13 +48733,13 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45530,28 +48749,12 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Fkey == nil {
-				m.Fkey = &ForeignKeyDef{}
-			}
-			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Visible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -45574,7 +48777,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45597,10 +48800,10 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAlterReIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAlterReIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -45633,43 +48836,11 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.DbName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45697,13 +48868,13 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45713,33 +48884,29 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IndexInfo == nil {
-				m.IndexInfo = &CreateTable{}
-			}
-			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoParamList", wireType)
 			}
-			var v int
+			m.IndexAlgoParamList = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45749,12 +48916,11 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.IndexAlgoParamList |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IndexTableExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -45777,7 +48943,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45800,17 +48966,17 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAddPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAddPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45820,29 +48986,31 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.Definitions = append(m.Definitions, &PartitionItem{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45852,29 +49020,31 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTables = append(m.PartitionTables, &TableDef{})
+			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -45884,55 +49054,27 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45956,7 +49098,7 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45979,15 +49121,15 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46015,13 +49157,13 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46031,76 +49173,28 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Visible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -46123,7 +49217,7 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableTruncatePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46146,15 +49240,15 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAlterReIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableTruncatePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAlterReIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableTruncatePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46182,11 +49276,62 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableExchangePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableExchangePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableExchangePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46214,11 +49359,11 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46246,13 +49391,13 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoParamList", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateSql", wireType)
 			}
-			m.IndexAlgoParamList = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46262,11 +49407,56 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexAlgoParamList |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidateSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -46289,7 +49479,7 @@ func (m *AlterTableAlterReIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
+func (m *AlterTableRedistributePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46312,15 +49502,15 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddPartition: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableRedistributePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableRedistributePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46347,8 +49537,10 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Definitions = append(m.Definitions, &PartitionItem{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
+			}
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46388,9 +49580,9 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPartitionTableNames", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46400,27 +49592,55 @@ func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionDef == nil {
-				m.PartitionDef = &PartitionByDef{}
+			m.DropPartitionTableNames = append(m.DropPartitionTableNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributeSqls", wireType)
 			}
-			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedistributeSqls = append(m.RedistributeSqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46527,6 +49747,77 @@ func (m *AlterTableComment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableStatsAutoRecalc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableStatsAutoRecalc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableStatsAutoRecalc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -46832,17 +50123,106 @@ func (m *AlterDropColumn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterDropColumn: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterDropColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterDropColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
+			}
+			m.Idx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Idx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterModifyColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterModifyColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterDropColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterModifyColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Idx = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -46852,12 +50232,58 @@ func (m *AlterDropColumn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Idx |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
@@ -47748,6 +51174,216 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AddPartition{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableDropPartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_DropPartition{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableTruncatePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_TruncatePartition{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableExchangePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_ExchangePartition{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableRedistributePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_RedistributePartition{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterModifyColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_ModifyColumn{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsAutoRecalc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableStatsAutoRecalc{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_StatsAutoRecalc{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -48242,6 +51878,26 @@ func (m *DropTable) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field FkChildTblsReferToMe", wireType)
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMaterializedView", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMaterializedView = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -286,6 +286,7 @@ type StatsInfo struct {
 	ApproxObjectNumber   int64                    `protobuf:"varint,10,opt,name=ApproxObjectNumber,proto3" json:"ApproxObjectNumber,omitempty"`
 	TableCnt             float64                  `protobuf:"fixed64,11,opt,name=TableCnt,proto3" json:"TableCnt,omitempty"`
 	TableName            string                   `protobuf:"bytes,12,opt,name=TableName,proto3" json:"TableName,omitempty"`
+	HistogramMap         map[string]*Histogram    `protobuf:"bytes,13,rep,name=HistogramMap,proto3" json:"HistogramMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *StatsInfo) Reset()         { *m = StatsInfo{} }
@@ -405,6 +406,103 @@ func (m *StatsInfo) GetTableName() string {
 	return ""
 }
 
+func (m *StatsInfo) GetHistogramMap() map[string]*Histogram {
+	if m != nil {
+		return m.HistogramMap
+	}
+	return nil
+}
+
+// Histogram is the equi-depth histogram and the most common values of a column
+// sampled by ANALYZE TABLE, the values are encoded like MinValMap.
+type Histogram struct {
+	// Bounds are the ascending bucket bounds starting from the min value, and
+	// Fractions are the fractions of the non-null rows not greater than them.
+	Bounds    []float64 `protobuf:"fixed64,1,rep,packed,name=Bounds,proto3" json:"Bounds,omitempty"`
+	Fractions []float64 `protobuf:"fixed64,2,rep,packed,name=Fractions,proto3" json:"Fractions,omitempty"`
+	// MCVs are the most common values, MCVFreqs are their fractions of the
+	// non-null rows.
+	MCVs       []float64 `protobuf:"fixed64,3,rep,packed,name=MCVs,proto3" json:"MCVs,omitempty"`
+	MCVFreqs   []float64 `protobuf:"fixed64,4,rep,packed,name=MCVFreqs,proto3" json:"MCVFreqs,omitempty"`
+	Distinct   float64   `protobuf:"fixed64,5,opt,name=Distinct,proto3" json:"Distinct,omitempty"`
+	SampleRows int64     `protobuf:"varint,6,opt,name=SampleRows,proto3" json:"SampleRows,omitempty"`
+}
+
+func (m *Histogram) Reset()         { *m = Histogram{} }
+func (m *Histogram) String() string { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()    {}
+func (*Histogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3f8e561c9795adb, []int{3}
+}
+func (m *Histogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Histogram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Histogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Histogram.Merge(m, src)
+}
+func (m *Histogram) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Histogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_Histogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Histogram proto.InternalMessageInfo
+
+func (m *Histogram) GetBounds() []float64 {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+func (m *Histogram) GetFractions() []float64 {
+	if m != nil {
+		return m.Fractions
+	}
+	return nil
+}
+
+func (m *Histogram) GetMCVs() []float64 {
+	if m != nil {
+		return m.MCVs
+	}
+	return nil
+}
+
+func (m *Histogram) GetMCVFreqs() []float64 {
+	if m != nil {
+		return m.MCVFreqs
+	}
+	return nil
+}
+
+func (m *Histogram) GetDistinct() float64 {
+	if m != nil {
+		return m.Distinct
+	}
+	return 0
+}
+
+func (m *Histogram) GetSampleRows() int64 {
+	if m != nil {
+		return m.SampleRows
+	}
+	return 0
+}
+
 type StatsInfoKey struct {
 	DatabaseID uint64 `protobuf:"varint,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty"`
 	TableID    uint64 `protobuf:"varint,2,opt,name=TableID,proto3" json:"TableID,omitempty"`
@@ -414,7 +512,7 @@ func (m *StatsInfoKey) Reset()         { *m = StatsInfoKey{} }
 func (m *StatsInfoKey) String() string { return proto.CompactTextString(m) }
 func (*StatsInfoKey) ProtoMessage()    {}
 func (*StatsInfoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{3}
+	return fileDescriptor_a3f8e561c9795adb, []int{4}
 }
 func (m *StatsInfoKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +563,7 @@ func (m *StatsInfoKeys) Reset()         { *m = StatsInfoKeys{} }
 func (m *StatsInfoKeys) String() string { return proto.CompactTextString(m) }
 func (*StatsInfoKeys) ProtoMessage()    {}
 func (*StatsInfoKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{4}
+	return fileDescriptor_a3f8e561c9795adb, []int{5}
 }
 func (m *StatsInfoKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,12 +604,14 @@ func init() {
 	proto.RegisterType((*ShuffleRange)(nil), "statsinfo.ShuffleRange")
 	proto.RegisterType((*StatsInfo)(nil), "statsinfo.StatsInfo")
 	proto.RegisterMapType((map[string]uint64)(nil), "statsinfo.StatsInfo.DataTypeMapEntry")
+	proto.RegisterMapType((map[string]*Histogram)(nil), "statsinfo.StatsInfo.HistogramMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.MaxValMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.MinValMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.NdvMapEntry")
 	proto.RegisterMapType((map[string]uint64)(nil), "statsinfo.StatsInfo.NullCntMapEntry")
 	proto.RegisterMapType((map[string]*ShuffleRange)(nil), "statsinfo.StatsInfo.ShuffleRangeMapEntry")
 	proto.RegisterMapType((map[string]uint64)(nil), "statsinfo.StatsInfo.SizeMapEntry")
+	proto.RegisterType((*Histogram)(nil), "statsinfo.Histogram")
 	proto.RegisterType((*StatsInfoKey)(nil), "statsinfo.StatsInfoKey")
 	proto.RegisterType((*StatsInfoKeys)(nil), "statsinfo.StatsInfoKeys")
 }
@@ -519,61 +619,68 @@ func init() {
 func init() { proto.RegisterFile("statsinfo.proto", fileDescriptor_a3f8e561c9795adb) }
 
 var fileDescriptor_a3f8e561c9795adb = []byte{
-	// 849 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0x8e, 0x63, 0x37, 0x4d, 0x5e, 0x67, 0xdb, 0xd5, 0xa8, 0xea, 0x8e, 0x2a, 0x64, 0x4c, 0x00,
-	0xc9, 0xac, 0xd8, 0x44, 0x84, 0xcb, 0xb2, 0x7c, 0x48, 0xed, 0x16, 0x68, 0xb5, 0x9b, 0x2e, 0x9a,
-	0x94, 0x3d, 0xc0, 0x69, 0x1c, 0x26, 0xae, 0xa9, 0x63, 0x5b, 0xfe, 0x28, 0x4e, 0x7f, 0x05, 0x3f,
-	0x81, 0x9f, 0xb3, 0xc7, 0x3d, 0xee, 0x09, 0xa1, 0xf6, 0xc0, 0x8f, 0xe0, 0x82, 0xe6, 0x1d, 0x3b,
-	0x71, 0x8b, 0x55, 0xd4, 0x53, 0xe6, 0x79, 0xe7, 0x79, 0x9e, 0x99, 0x79, 0xf3, 0xcc, 0x18, 0xb6,
-	0xd3, 0x8c, 0x67, 0xa9, 0x1f, 0xce, 0xa3, 0x61, 0x9c, 0x44, 0x59, 0x44, 0x7a, 0xab, 0xc2, 0xde,
-	0x13, 0xcf, 0xcf, 0xce, 0x72, 0x77, 0x38, 0x8b, 0x16, 0x23, 0x2f, 0xf2, 0xa2, 0x11, 0x32, 0xdc,
-	0x7c, 0x8e, 0x08, 0x01, 0x8e, 0x94, 0x72, 0xf0, 0xb7, 0x06, 0xe6, 0xf4, 0x2c, 0x9f, 0xcf, 0x03,
-	0x71, 0x24, 0x78, 0x4c, 0x1e, 0x83, 0xf1, 0x52, 0xcc, 0x33, 0xaa, 0xd9, 0x9a, 0x63, 0x8e, 0x77,
-	0x87, 0xeb, 0x95, 0x6a, 0x2c, 0x86, 0x1c, 0xf2, 0x29, 0x6c, 0x30, 0xdf, 0x3b, 0xcb, 0x68, 0xfb,
-	0x4e, 0xb2, 0x22, 0x91, 0x87, 0xa0, 0xbf, 0x10, 0x4b, 0xaa, 0xdb, 0x9a, 0xa3, 0x31, 0x39, 0x24,
-	0x3b, 0xb0, 0xf1, 0x9a, 0x07, 0xb9, 0xa0, 0x06, 0xd6, 0x14, 0x20, 0xbb, 0xd0, 0x39, 0x12, 0x68,
-	0xbb, 0x61, 0x6b, 0x8e, 0xce, 0x4a, 0x44, 0xb6, 0xa0, 0x3d, 0xbd, 0xa4, 0x1d, 0xac, 0xb5, 0xa7,
-	0x97, 0x52, 0x7d, 0x92, 0x07, 0x41, 0x4a, 0x37, 0xb1, 0xa4, 0x00, 0xa1, 0xb0, 0xc9, 0xc4, 0x85,
-	0x48, 0x52, 0x41, 0xbb, 0xb6, 0xe6, 0x74, 0x59, 0x05, 0x07, 0xef, 0xda, 0xd0, 0x2f, 0xb7, 0xc5,
-	0x78, 0xe8, 0x09, 0xf2, 0x1e, 0xf4, 0x8e, 0xd3, 0x69, 0x96, 0x9c, 0x2e, 0x63, 0x81, 0xe7, 0xed,
-	0xb2, 0x75, 0xa1, 0x5c, 0xae, 0xbd, 0x5a, 0xee, 0x31, 0x18, 0xa7, 0x89, 0x10, 0xb8, 0xff, 0x3b,
-	0x1a, 0x23, 0x39, 0xf2, 0xa8, 0x13, 0x3f, 0x2c, 0x8f, 0x25, 0x87, 0x58, 0xe1, 0x05, 0x9e, 0x48,
-	0x56, 0x78, 0x41, 0x08, 0x18, 0x13, 0x3f, 0x4c, 0x69, 0xc7, 0xd6, 0x9d, 0x3e, 0xc3, 0x31, 0xd6,
-	0x78, 0x21, 0x4f, 0xa4, 0x6a, 0xbc, 0xc0, 0x1a, 0x8b, 0x7e, 0x4b, 0x69, 0xd7, 0xd6, 0x1d, 0x9d,
-	0xe1, 0x78, 0x7d, 0xf4, 0x1e, 0x16, 0xcb, 0xa3, 0xef, 0x42, 0x67, 0xc2, 0x8b, 0x97, 0x22, 0xa4,
-	0xa0, 0x1a, 0xa7, 0x90, 0x64, 0x7f, 0x17, 0x70, 0x2f, 0xa5, 0xa6, 0xad, 0x3b, 0x5d, 0xa6, 0x80,
-	0x6c, 0xd4, 0xab, 0x0b, 0x91, 0x04, 0x3c, 0xa6, 0x7d, 0xdc, 0x55, 0x05, 0xe5, 0xcc, 0x8f, 0xa1,
-	0x3f, 0x8f, 0x92, 0x05, 0x7d, 0xa0, 0x66, 0x4a, 0x28, 0x57, 0x60, 0x22, 0xcd, 0x83, 0x8c, 0x6e,
-	0xd9, 0xba, 0xa3, 0xb1, 0x12, 0x0d, 0xfe, 0xe9, 0x42, 0x6f, 0x2a, 0xfb, 0x71, 0x1c, 0xce, 0x23,
-	0xf2, 0x14, 0x3a, 0x27, 0xbf, 0x5c, 0x4c, 0x78, 0x4c, 0x35, 0x5b, 0x77, 0xcc, 0xb1, 0x5d, 0xef,
-	0x55, 0xc5, 0x1a, 0x2a, 0xca, 0xb7, 0x61, 0x96, 0x2c, 0x59, 0xc9, 0x27, 0xfb, 0xd0, 0x9b, 0xf8,
-	0xe1, 0x6b, 0x1e, 0x48, 0x71, 0x1b, 0xc5, 0x1f, 0x36, 0x8a, 0x57, 0x2c, 0xa5, 0x5f, 0xab, 0xd0,
-	0x82, 0x17, 0xa5, 0x85, 0x7e, 0x97, 0x45, 0xc5, 0xaa, 0x2c, 0x2a, 0x4c, 0xbe, 0x07, 0xf3, 0x90,
-	0x67, 0x5c, 0xa6, 0x40, 0x9a, 0x18, 0x68, 0xf2, 0x71, 0xa3, 0x49, 0x8d, 0xa7, 0x6c, 0xea, 0x4a,
-	0x72, 0x08, 0x20, 0xff, 0x99, 0xe7, 0x61, 0x26, 0x7d, 0x36, 0xd0, 0xe7, 0xa3, 0xe6, 0x66, 0xac,
-	0x68, 0xca, 0xa6, 0xa6, 0x23, 0x5f, 0xc2, 0xe6, 0xd4, 0xbf, 0xc4, 0xad, 0x74, 0xd0, 0xe2, 0x83,
-	0x46, 0x8b, 0x92, 0xa3, 0xf4, 0x95, 0x82, 0x4c, 0x61, 0xbb, 0x9e, 0x79, 0x69, 0xb2, 0x89, 0x26,
-	0x9f, 0x34, 0x9b, 0xdc, 0xe4, 0x2a, 0xb3, 0xdb, 0x0e, 0xc4, 0x06, 0xf3, 0x20, 0x88, 0x66, 0xe7,
-	0x27, 0xf9, 0xc2, 0x15, 0x09, 0xde, 0x33, 0x9d, 0xd5, 0x4b, 0x64, 0x0c, 0x3b, 0xfb, 0xb3, 0x59,
-	0x9e, 0xf0, 0x4c, 0xbc, 0x72, 0x7f, 0x15, 0xb3, 0xac, 0xa4, 0xf6, 0x90, 0xda, 0x38, 0x47, 0x86,
-	0x40, 0xf6, 0xe3, 0x38, 0x89, 0x8a, 0x1b, 0x0a, 0x15, 0xe5, 0x86, 0x19, 0xb2, 0x07, 0xdd, 0x53,
-	0xee, 0x06, 0xe2, 0x79, 0x98, 0x51, 0x13, 0x73, 0xba, 0xc2, 0xf2, 0x6a, 0xe3, 0xf8, 0x84, 0x2f,
-	0x04, 0xc6, 0xbb, 0xc7, 0xd6, 0x85, 0xbd, 0x2f, 0xc0, 0xac, 0xa5, 0x4f, 0xde, 0xcd, 0x73, 0xb1,
-	0xc4, 0x17, 0xa0, 0xc7, 0xe4, 0x50, 0xde, 0x98, 0x0b, 0x7c, 0x98, 0xda, 0xea, 0x61, 0x42, 0xf0,
-	0xac, 0xfd, 0x54, 0xdb, 0xfb, 0x0a, 0xb6, 0x6e, 0x66, 0xef, 0xde, 0xea, 0x1b, 0xb1, 0xbb, 0x97,
-	0xfa, 0x1b, 0x78, 0x78, 0x3b, 0x6f, 0xff, 0xa7, 0x37, 0xea, 0xfa, 0xaf, 0x61, 0xfb, 0x56, 0xce,
-	0xee, 0x25, 0x7f, 0x06, 0xfd, 0x7a, 0xc6, 0xee, 0xa5, 0xfd, 0x19, 0x76, 0x9a, 0xa2, 0xd5, 0xe0,
-	0xf1, 0xa4, 0xee, 0x61, 0x8e, 0x1f, 0xfd, 0xf7, 0x9d, 0x45, 0x87, 0x9a, 0xf9, 0xe0, 0x08, 0xfa,
-	0xab, 0x04, 0xcb, 0xcf, 0x8a, 0x05, 0x20, 0xfb, 0xe4, 0xf2, 0x54, 0x1c, 0x1f, 0xa2, 0xb7, 0xc1,
-	0x6a, 0x15, 0xf9, 0xbe, 0x61, 0x16, 0x8e, 0x0f, 0xcb, 0x8d, 0x56, 0x70, 0x70, 0x00, 0x0f, 0xea,
-	0x4e, 0x29, 0xf9, 0x0c, 0x0c, 0xf9, 0x5b, 0x3e, 0x64, 0x8f, 0x9a, 0xee, 0xcc, 0x0b, 0xb1, 0x3c,
-	0x30, 0xde, 0xfc, 0xf9, 0x7e, 0x8b, 0x21, 0xf5, 0xe0, 0x87, 0x37, 0x57, 0x96, 0xf6, 0xf6, 0xca,
-	0xd2, 0xfe, 0xba, 0xb2, 0x5a, 0xbf, 0x5f, 0x5b, 0xad, 0x3f, 0xae, 0x2d, 0xed, 0xed, 0xb5, 0xd5,
-	0x7a, 0x77, 0x6d, 0xb5, 0x7e, 0x1a, 0xd7, 0xbe, 0xce, 0x0b, 0x9e, 0x25, 0x7e, 0x11, 0x25, 0xbe,
-	0xe7, 0x87, 0x15, 0x08, 0xc5, 0x28, 0x3e, 0xf7, 0x46, 0xb1, 0x3b, 0x5a, 0x2d, 0xe5, 0x76, 0xf0,
-	0x4b, 0xfd, 0xf9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x87, 0xb6, 0x7b, 0xf6, 0x07, 0x00,
-	0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xae, 0xe3, 0x34, 0x4d, 0x4e, 0xd2, 0x76, 0x19, 0x55, 0xdd, 0x51, 0x85, 0x82, 0x09, 0x3f,
+	0x0a, 0x15, 0xdb, 0x88, 0x70, 0xb3, 0x2c, 0x3f, 0x52, 0xd3, 0x50, 0x5a, 0x76, 0xd3, 0x45, 0x93,
+	0x6e, 0x2f, 0xe0, 0x6a, 0x92, 0x9d, 0xa4, 0xa6, 0x8e, 0x6d, 0xec, 0x49, 0x71, 0xfa, 0x14, 0x3c,
+	0x01, 0xe2, 0x05, 0x78, 0x8f, 0xbd, 0xdc, 0xcb, 0xbd, 0x42, 0xa8, 0xbd, 0xe0, 0x35, 0xd0, 0x9c,
+	0x71, 0x9c, 0x49, 0xd7, 0x2a, 0x2a, 0x57, 0x99, 0xef, 0xcc, 0xf7, 0x7d, 0xf6, 0x1c, 0x9f, 0x73,
+	0x26, 0xb0, 0x19, 0x4b, 0x2e, 0x63, 0xd7, 0x1f, 0x05, 0x7b, 0x61, 0x14, 0xc8, 0x80, 0x54, 0xb2,
+	0xc0, 0xce, 0xa3, 0xb1, 0x2b, 0xcf, 0xa7, 0x83, 0xbd, 0x61, 0x30, 0x69, 0x8d, 0x83, 0x71, 0xd0,
+	0x42, 0xc6, 0x60, 0x3a, 0x42, 0x84, 0x00, 0x57, 0x5a, 0xd9, 0xf8, 0xc7, 0x82, 0x6a, 0xff, 0x7c,
+	0x3a, 0x1a, 0x79, 0xe2, 0x48, 0xf0, 0x90, 0xec, 0x42, 0xf1, 0x99, 0x18, 0x49, 0x6a, 0x39, 0x56,
+	0xb3, 0xda, 0xde, 0xde, 0x5b, 0x3c, 0xc9, 0x60, 0x31, 0xe4, 0x90, 0x4f, 0x61, 0x95, 0xb9, 0xe3,
+	0x73, 0x49, 0x0b, 0x77, 0x92, 0x35, 0x89, 0x3c, 0x00, 0xfb, 0xa9, 0x98, 0x51, 0xdb, 0xb1, 0x9a,
+	0x16, 0x53, 0x4b, 0xb2, 0x05, 0xab, 0x67, 0xdc, 0x9b, 0x0a, 0x5a, 0xc4, 0x98, 0x06, 0x64, 0x1b,
+	0x4a, 0x47, 0x02, 0x6d, 0x57, 0x1d, 0xab, 0x69, 0xb3, 0x14, 0x91, 0x0d, 0x28, 0xf4, 0xaf, 0x68,
+	0x09, 0x63, 0x85, 0xfe, 0x95, 0x52, 0x9f, 0x4c, 0x3d, 0x2f, 0xa6, 0x6b, 0x18, 0xd2, 0x80, 0x50,
+	0x58, 0x63, 0xe2, 0x52, 0x44, 0xb1, 0xa0, 0x65, 0xc7, 0x6a, 0x96, 0xd9, 0x1c, 0x36, 0xde, 0x14,
+	0xa0, 0x96, 0xbe, 0x16, 0xe3, 0xfe, 0x58, 0x90, 0x77, 0xa1, 0x72, 0x1c, 0xf7, 0x65, 0x74, 0x3a,
+	0x0b, 0x05, 0x9e, 0xb7, 0xcc, 0x16, 0x81, 0xf4, 0x71, 0x85, 0xec, 0x71, 0xbb, 0x50, 0x3c, 0x8d,
+	0x84, 0xa0, 0xf6, 0x9d, 0x67, 0x45, 0x8e, 0x3a, 0x6a, 0xcf, 0xf5, 0xd3, 0x63, 0xa9, 0x25, 0x46,
+	0x78, 0x42, 0x57, 0xd3, 0x08, 0x4f, 0x08, 0x81, 0x62, 0xcf, 0xf5, 0x63, 0x5a, 0x72, 0xec, 0x66,
+	0x8d, 0xe1, 0x1a, 0x63, 0x3c, 0x51, 0x27, 0xd2, 0x31, 0x9e, 0x60, 0x8c, 0x05, 0xbf, 0xc6, 0xb4,
+	0xec, 0xd8, 0x4d, 0x9b, 0xe1, 0x7a, 0x71, 0xf4, 0x0a, 0x06, 0xd3, 0xa3, 0x6f, 0x43, 0xa9, 0xc7,
+	0x93, 0x67, 0xc2, 0xa7, 0xa0, 0x13, 0xa7, 0x91, 0x62, 0x1f, 0x7a, 0x7c, 0x1c, 0xd3, 0xaa, 0x63,
+	0x37, 0xcb, 0x4c, 0x03, 0x95, 0xa8, 0xe7, 0x97, 0x22, 0xf2, 0x78, 0x48, 0x6b, 0xf8, 0x56, 0x73,
+	0xa8, 0x76, 0x5e, 0xf8, 0xee, 0x28, 0x88, 0x26, 0x74, 0x5d, 0xef, 0xa4, 0x50, 0x3d, 0x81, 0x89,
+	0x78, 0xea, 0x49, 0xba, 0xe1, 0xd8, 0x4d, 0x8b, 0xa5, 0xa8, 0xf1, 0x3b, 0x40, 0xa5, 0xaf, 0xf2,
+	0x71, 0xec, 0x8f, 0x02, 0xf2, 0x18, 0x4a, 0x27, 0x2f, 0x2f, 0x7b, 0x3c, 0xa4, 0x96, 0x63, 0x37,
+	0xab, 0x6d, 0xc7, 0xcc, 0xd5, 0x9c, 0xb5, 0xa7, 0x29, 0xdf, 0xfa, 0x32, 0x9a, 0xb1, 0x94, 0x4f,
+	0xf6, 0xa1, 0xd2, 0x73, 0xfd, 0x33, 0xee, 0x29, 0x71, 0x01, 0xc5, 0x1f, 0xe4, 0x8a, 0x33, 0x96,
+	0xd6, 0x2f, 0x54, 0x68, 0xc1, 0x93, 0xd4, 0xc2, 0xbe, 0xcb, 0x82, 0x27, 0xcb, 0x16, 0x73, 0x4c,
+	0xbe, 0x83, 0x6a, 0x97, 0x4b, 0xae, 0xaa, 0x40, 0x99, 0x14, 0xd1, 0xe4, 0xa3, 0x5c, 0x13, 0x83,
+	0xa7, 0x6d, 0x4c, 0x25, 0xe9, 0x02, 0xa8, 0x2f, 0x73, 0xe0, 0x4b, 0xe5, 0xb3, 0x8a, 0x3e, 0x1f,
+	0xe6, 0x27, 0x23, 0xa3, 0x69, 0x1b, 0x43, 0x47, 0xbe, 0x84, 0xb5, 0xbe, 0x7b, 0x85, 0xaf, 0x52,
+	0x42, 0x8b, 0xf7, 0x73, 0x2d, 0x52, 0x8e, 0xd6, 0xcf, 0x15, 0xa4, 0x0f, 0x9b, 0x66, 0xcd, 0x2b,
+	0x93, 0x35, 0x34, 0xf9, 0x24, 0xdf, 0x64, 0x99, 0xab, 0xcd, 0x6e, 0x3b, 0x10, 0x07, 0xaa, 0x1d,
+	0x2f, 0x18, 0x5e, 0x9c, 0x4c, 0x27, 0x03, 0x11, 0x61, 0x9f, 0xd9, 0xcc, 0x0c, 0x91, 0x36, 0x6c,
+	0xed, 0x0f, 0x87, 0xd3, 0x88, 0x4b, 0xf1, 0x7c, 0xf0, 0xb3, 0x18, 0xca, 0x94, 0x5a, 0x41, 0x6a,
+	0xee, 0x1e, 0xd9, 0x03, 0xb2, 0x1f, 0x86, 0x51, 0x90, 0x2c, 0x29, 0x74, 0x29, 0xe7, 0xec, 0x90,
+	0x1d, 0x28, 0x9f, 0xf2, 0x81, 0x27, 0x0e, 0x7c, 0x49, 0xab, 0x58, 0xa7, 0x19, 0x56, 0xad, 0x8d,
+	0xeb, 0x13, 0x3e, 0x11, 0x58, 0xde, 0x15, 0xb6, 0x08, 0x90, 0xef, 0xa1, 0x76, 0xe4, 0xc6, 0x32,
+	0x18, 0x47, 0x7c, 0xa2, 0x32, 0xb2, 0x8e, 0x19, 0xf9, 0x38, 0x37, 0x23, 0x26, 0x51, 0xa7, 0x63,
+	0x49, 0xbb, 0xf3, 0x05, 0x54, 0x8d, 0x4a, 0x56, 0x7d, 0x7e, 0x21, 0x66, 0x38, 0x4d, 0x2a, 0x4c,
+	0x2d, 0x55, 0xf7, 0x5d, 0xe2, 0x90, 0x2b, 0xe8, 0x21, 0x87, 0xe0, 0x49, 0xe1, 0xb1, 0xb5, 0xf3,
+	0x15, 0x6c, 0x2c, 0xd7, 0xf1, 0xbd, 0xd5, 0x3c, 0xf9, 0xbf, 0xea, 0x6f, 0xe0, 0xc1, 0xed, 0xda,
+	0xfd, 0x2f, 0x7d, 0xd1, 0xd4, 0x7f, 0x0d, 0x9b, 0xb7, 0x6a, 0xf6, 0x5e, 0xf2, 0x27, 0x50, 0x33,
+	0xeb, 0xf5, 0x5e, 0xda, 0x9f, 0x60, 0x2b, 0xaf, 0x4c, 0x73, 0x3c, 0x1e, 0x99, 0x1e, 0xd5, 0xf6,
+	0xc3, 0xb7, 0x67, 0x36, 0x3a, 0x98, 0xe6, 0x2f, 0xe0, 0x9d, 0xb7, 0xbe, 0x78, 0x8e, 0xf3, 0xee,
+	0xb2, 0xf3, 0x96, 0xe1, 0x9c, 0xc9, 0x0d, 0xdb, 0xc6, 0x9f, 0x16, 0x54, 0xb2, 0x0d, 0x35, 0x46,
+	0x3b, 0xc1, 0xd4, 0x7f, 0x19, 0xe3, 0x80, 0xb4, 0x58, 0x8a, 0x54, 0xd5, 0x1e, 0x46, 0x7c, 0x28,
+	0xdd, 0xc0, 0x8f, 0x71, 0xfc, 0x59, 0x6c, 0x11, 0xc0, 0xcb, 0xe1, 0xe0, 0x2c, 0xc6, 0xa1, 0x66,
+	0x31, 0x5c, 0xab, 0x1e, 0xe8, 0x1d, 0x9c, 0x1d, 0x46, 0xe2, 0x97, 0x18, 0xe7, 0x94, 0xc5, 0x32,
+	0xac, 0xf6, 0xba, 0x6e, 0x2c, 0x5d, 0x7f, 0x28, 0xd3, 0x7b, 0x27, 0xc3, 0xa4, 0x0e, 0xd0, 0xe7,
+	0x93, 0xd0, 0x13, 0x78, 0xb5, 0xe8, 0x3b, 0xd5, 0x88, 0x34, 0x8e, 0xa0, 0x96, 0xb5, 0x80, 0xba,
+	0xa9, 0xeb, 0x00, 0xaa, 0x5c, 0x06, 0x3c, 0x16, 0xc7, 0x5d, 0x4c, 0x44, 0x91, 0x19, 0x11, 0x75,
+	0x65, 0x60, 0x7b, 0x1d, 0x77, 0xd3, 0xef, 0x35, 0x87, 0x8d, 0x0e, 0xac, 0x9b, 0x4e, 0x31, 0xf9,
+	0x0c, 0x8a, 0xea, 0x37, 0xbd, 0x1b, 0x1e, 0xe6, 0x35, 0xdd, 0x53, 0x31, 0xeb, 0x14, 0x5f, 0xfd,
+	0xf5, 0xde, 0x0a, 0x43, 0x6a, 0xe7, 0x87, 0x57, 0xd7, 0x75, 0xeb, 0xf5, 0x75, 0xdd, 0xfa, 0xfb,
+	0xba, 0xbe, 0xf2, 0xdb, 0x4d, 0x7d, 0xe5, 0x8f, 0x9b, 0xba, 0xf5, 0xfa, 0xa6, 0xbe, 0xf2, 0xe6,
+	0xa6, 0xbe, 0xf2, 0x63, 0xdb, 0xf8, 0xc3, 0x33, 0xe1, 0x32, 0x72, 0x93, 0x20, 0x72, 0xc7, 0xae,
+	0x3f, 0x07, 0xbe, 0x68, 0x85, 0x17, 0xe3, 0x56, 0x38, 0x68, 0x65, 0x8f, 0x1a, 0x94, 0xf0, 0xcf,
+	0xcf, 0xe7, 0xff, 0x0e, 0x00, 0xb1, 0x50, 0x01, 0xf7, 0x49, 0x09, 0x00, 0x00,
 }
 
 func (m *ShuffleHeap) Marshal() (dAtA []byte, err error) {
@@ -838,6 +945,32 @@ func (m *StatsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HistogramMap) > 0 {
+		for k := range m.HistogramMap {
+			v := m.HistogramMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintStatsinfo(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintStatsinfo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintStatsinfo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.TableName) > 0 {
 		i -= len(m.TableName)
 		copy(dAtA[i:], m.TableName)
@@ -1000,6 +1133,80 @@ func (m *StatsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SampleRows != 0 {
+		i = encodeVarintStatsinfo(dAtA, i, uint64(m.SampleRows))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Distinct != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Distinct))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if len(m.MCVFreqs) > 0 {
+		for iNdEx := len(m.MCVFreqs) - 1; iNdEx >= 0; iNdEx-- {
+			f11 := math.Float64bits(float64(m.MCVFreqs[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f11))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.MCVFreqs)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MCVs) > 0 {
+		for iNdEx := len(m.MCVs) - 1; iNdEx >= 0; iNdEx-- {
+			f12 := math.Float64bits(float64(m.MCVs[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f12))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.MCVs)*8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fractions) > 0 {
+		for iNdEx := len(m.Fractions) - 1; iNdEx >= 0; iNdEx-- {
+			f13 := math.Float64bits(float64(m.Fractions[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f13))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Fractions)*8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bounds) > 0 {
+		for iNdEx := len(m.Bounds) - 1; iNdEx >= 0; iNdEx-- {
+			f14 := math.Float64bits(float64(m.Bounds[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f14))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Bounds)*8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StatsInfoKey) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1265,6 +1472,46 @@ func (m *StatsInfo) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStatsinfo(uint64(l))
 	}
+	if len(m.HistogramMap) > 0 {
+		for k, v := range m.HistogramMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovStatsinfo(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovStatsinfo(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovStatsinfo(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Histogram) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounds) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Bounds)*8)) + len(m.Bounds)*8
+	}
+	if len(m.Fractions) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Fractions)*8)) + len(m.Fractions)*8
+	}
+	if len(m.MCVs) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.MCVs)*8)) + len(m.MCVs)*8
+	}
+	if len(m.MCVFreqs) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.MCVFreqs)*8)) + len(m.MCVFreqs)*8
+	}
+	if m.Distinct != 0 {
+		n += 9
+	}
+	if m.SampleRows != 0 {
+		n += 1 + sovStatsinfo(uint64(m.SampleRows))
+	}
 	return n
 }
 
@@ -2968,6 +3215,431 @@ func (m *StatsInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistogramMap == nil {
+				m.HistogramMap = make(map[string]*Histogram)
+			}
+			var mapkey string
+			var mapvalue *Histogram
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Histogram{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStatsinfo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HistogramMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatsinfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatsinfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Bounds = append(m.Bounds, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Bounds) == 0 {
+					m.Bounds = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Bounds = append(m.Bounds, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Fractions = append(m.Fractions, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Fractions) == 0 {
+					m.Fractions = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Fractions = append(m.Fractions, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fractions", wireType)
+			}
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.MCVs = append(m.MCVs, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.MCVs) == 0 {
+					m.MCVs = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.MCVs = append(m.MCVs, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MCVs", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.MCVFreqs = append(m.MCVFreqs, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.MCVFreqs) == 0 {
+					m.MCVFreqs = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.MCVFreqs = append(m.MCVFreqs, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MCVFreqs", wireType)
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distinct", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Distinct = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleRows", wireType)
+			}
+			m.SampleRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatsinfo(dAtA[iNdEx:])
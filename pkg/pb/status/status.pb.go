@@ -50,6 +50,7 @@ const (
 	SessionField_CLIENT_HOST     SessionField = 16
 	SessionField_ROLE            SessionField = 17
 	SessionField_PROXY_HOST      SessionField = 18
+	SessionField_QUEUE_WAIT_TIME SessionField = 19
 )
 
 var SessionField_name = map[int32]string{
@@ -72,6 +73,7 @@ var SessionField_name = map[int32]string{
 	16: "CLIENT_HOST",
 	17: "ROLE",
 	18: "PROXY_HOST",
+	19: "QUEUE_WAIT_TIME",
 }
 
 var SessionField_value = map[string]int32{
@@ -94,6 +96,7 @@ var SessionField_value = map[string]int32{
 	"CLIENT_HOST":     16,
 	"ROLE":            17,
 	"PROXY_HOST":      18,
+	"QUEUE_WAIT_TIME": 19,
 }
 
 func (x SessionField) String() string {
@@ -145,7 +148,9 @@ type Session struct {
 	// FromProxy denotes whether the session is dispatched from proxy
 	FromProxy bool `protobuf:"varint,19,opt,name=FromProxy,proto3" json:"FromProxy,omitempty"`
 	// ProxyHost is the host address of proxy connection.
-	ProxyHost            string   `protobuf:"bytes,20,opt,name=ProxyHost,proto3" json:"ProxyHost,omitempty"`
+	ProxyHost string `protobuf:"bytes,20,opt,name=ProxyHost,proto3" json:"ProxyHost,omitempty"`
+	// QueueWaitTime is the milliseconds the query waits in the admission queue.
+	QueueWaitTime        int64    `protobuf:"varint,21,opt,name=QueueWaitTime,proto3" json:"QueueWaitTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -324,6 +329,13 @@ func (m *Session) GetProxyHost() string {
 	return ""
 }
 
+func (m *Session) GetQueueWaitTime() int64 {
+	if m != nil {
+		return m.QueueWaitTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("status.SessionField", SessionField_name, SessionField_value)
 	proto.RegisterType((*Session)(nil), "status.Session")
@@ -332,47 +344,49 @@ func init() {
 func init() { proto.RegisterFile("status.proto", fileDescriptor_dfe4fce6682daf5b) }
 
 var fileDescriptor_dfe4fce6682daf5b = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6e, 0xda, 0x40,
-	0x14, 0xc7, 0x63, 0x42, 0x0c, 0x3c, 0xbe, 0x9c, 0x97, 0xa8, 0x1a, 0x45, 0x15, 0x41, 0x55, 0x17,
-	0xa8, 0x52, 0x41, 0x6a, 0x4f, 0x00, 0xb6, 0xa3, 0x58, 0x22, 0x76, 0xb0, 0x8d, 0x94, 0x74, 0x13,
-	0x01, 0x99, 0xb8, 0x56, 0xb1, 0x07, 0xd9, 0x83, 0x94, 0xdc, 0xa2, 0x27, 0xa8, 0x7a, 0x9c, 0x2c,
-	0x7b, 0x82, 0xb6, 0xa2, 0xa7, 0xe8, 0xae, 0x9a, 0x19, 0xbe, 0xb2, 0xec, 0xee, 0xfd, 0x7f, 0xef,
-	0xc3, 0xff, 0xf7, 0x26, 0x01, 0x6a, 0x39, 0x9f, 0xf0, 0x65, 0xde, 0x5d, 0x64, 0x8c, 0x33, 0xd4,
-	0x95, 0x3a, 0x7b, 0x1f, 0xc5, 0xfc, 0xf3, 0x72, 0xda, 0x9d, 0xb1, 0xa4, 0x17, 0xb1, 0x88, 0xf5,
-	0x64, 0x7a, 0xba, 0x7c, 0x90, 0x4a, 0x0a, 0x19, 0xa9, 0xb6, 0xb3, 0xf3, 0x88, 0xb1, 0x68, 0x4e,
-	0x77, 0x55, 0x3c, 0x4e, 0x68, 0xce, 0x27, 0xc9, 0x42, 0x15, 0xbc, 0xf9, 0x5b, 0x84, 0x52, 0x40,
-	0xf3, 0x3c, 0x66, 0x29, 0xbe, 0x02, 0xdd, 0x65, 0xf7, 0xd4, 0xb1, 0x88, 0xd6, 0xd6, 0x3a, 0x15,
-	0x7f, 0xad, 0x04, 0x37, 0x59, 0x9a, 0x3a, 0x16, 0x29, 0xb4, 0xb5, 0x4e, 0xdd, 0x5f, 0x2b, 0x7c,
-	0x0d, 0x95, 0x75, 0xab, 0x63, 0x91, 0x43, 0xd9, 0xb2, 0x03, 0x48, 0xa0, 0xd4, 0x9f, 0xcd, 0xd8,
-	0x32, 0xe5, 0xa4, 0x28, 0x73, 0x1b, 0x89, 0x08, 0xc5, 0x71, 0x4e, 0x33, 0x72, 0x24, 0xb1, 0x8c,
-	0x05, 0xbb, 0x64, 0x39, 0x27, 0xba, 0x62, 0x22, 0xc6, 0x06, 0x14, 0xac, 0x01, 0x29, 0x49, 0x52,
-	0xb0, 0x06, 0x78, 0x09, 0xb5, 0xf5, 0xf8, 0x80, 0x4f, 0x32, 0x4e, 0xca, 0x6d, 0xad, 0x53, 0xfd,
-	0x70, 0xd6, 0x55, 0x3b, 0x76, 0x37, 0x3b, 0x76, 0xc3, 0xcd, 0x8e, 0x83, 0xf2, 0xf3, 0xcf, 0xf3,
-	0x83, 0xaf, 0xbf, 0xce, 0x35, 0xff, 0x45, 0xa7, 0xf0, 0x66, 0xb2, 0x24, 0x99, 0xa4, 0xf7, 0xa4,
-	0xa2, 0xbc, 0xad, 0xa5, 0xf0, 0xe1, 0xa4, 0x0f, 0x8c, 0x80, 0xf2, 0x21, 0x62, 0x3c, 0x85, 0xa3,
-	0xf0, 0x51, 0xec, 0x58, 0x95, 0x50, 0x09, 0x6c, 0x43, 0x35, 0xe0, 0x13, 0x4e, 0x13, 0x9a, 0x72,
-	0xc7, 0x22, 0x35, 0x99, 0xdb, 0x47, 0xf8, 0x16, 0xea, 0x5b, 0x19, 0x3e, 0x2d, 0x28, 0xa9, 0xcb,
-	0x9a, 0x97, 0x50, 0x5c, 0x71, 0xb4, 0xa4, 0xd9, 0x93, 0xac, 0x68, 0xa8, 0x2b, 0x6e, 0x81, 0x9c,
-	0x31, 0x1a, 0x06, 0x6c, 0x99, 0xcd, 0xa8, 0xac, 0x68, 0xae, 0x67, 0xec, 0x43, 0xb4, 0x00, 0x64,
-	0x8b, 0xba, 0x8b, 0xf1, 0x1f, 0x77, 0xd9, 0xeb, 0xc3, 0x16, 0x80, 0x39, 0x8f, 0x69, 0xca, 0xe5,
-	0x4b, 0x1c, 0xcb, 0x0f, 0xed, 0x11, 0x71, 0x1b, 0x9f, 0xcd, 0x29, 0x41, 0x75, 0x1b, 0x11, 0x0b,
-	0xf7, 0x17, 0x19, 0x4b, 0xae, 0x33, 0xf6, 0xf8, 0x44, 0x4e, 0xda, 0x5a, 0xa7, 0xec, 0xef, 0x80,
-	0xc8, 0xca, 0x40, 0x0e, 0x3c, 0x55, 0xbb, 0x6d, 0xc1, 0xbb, 0x6f, 0x85, 0xed, 0x83, 0x5e, 0xc4,
-	0x74, 0x7e, 0x8f, 0x55, 0x28, 0xb9, 0x9e, 0x65, 0xdf, 0x39, 0x96, 0x71, 0x20, 0x84, 0xe9, 0xb9,
-	0xae, 0x10, 0x1a, 0x36, 0x00, 0x02, 0x3b, 0x08, 0x1c, 0x4f, 0xea, 0x82, 0x48, 0xf6, 0x4d, 0xd3,
-	0x1b, 0xbb, 0xa1, 0x71, 0x88, 0x65, 0x28, 0x8e, 0x03, 0xdb, 0x37, 0x8a, 0x22, 0xba, 0xf4, 0x82,
-	0xd0, 0x38, 0x42, 0x5d, 0xfc, 0xed, 0x18, 0x3a, 0x1e, 0x43, 0x7d, 0xd3, 0x18, 0x84, 0x7d, 0x3f,
-	0x34, 0x4a, 0x6a, 0xf0, 0xd5, 0x55, 0xdf, 0xb5, 0x8c, 0xb2, 0xe8, 0x70, 0xdc, 0x0b, 0xcf, 0xa8,
-	0x20, 0x80, 0x1e, 0xde, 0xc8, 0xf1, 0x80, 0x06, 0xd4, 0x82, 0xb0, 0x1f, 0xda, 0x57, 0xb6, 0x1b,
-	0x0a, 0x52, 0x45, 0x84, 0xc6, 0x8e, 0x84, 0xb7, 0xd7, 0xb6, 0x51, 0x13, 0xa6, 0x46, 0x63, 0xdb,
-	0xbf, 0x55, 0xba, 0x8e, 0x27, 0xd0, 0x0c, 0x46, 0xc3, 0xbb, 0xc0, 0x1b, 0xfb, 0xa6, 0xad, 0x60,
-	0x03, 0x9b, 0x50, 0x55, 0x45, 0xea, 0xf3, 0x4d, 0x01, 0xcc, 0xa1, 0x23, 0xc6, 0x48, 0xab, 0x86,
-	0xb0, 0xe0, 0x7b, 0x43, 0xdb, 0x38, 0x16, 0x03, 0xaf, 0x7d, 0xef, 0xe6, 0x56, 0x65, 0x70, 0xd0,
-	0x7f, 0x5e, 0xb5, 0xb4, 0x1f, 0xab, 0x96, 0xf6, 0x7b, 0xd5, 0x3a, 0xf8, 0xfe, 0xa7, 0xa5, 0x7d,
-	0xea, 0xed, 0xfd, 0xfb, 0x27, 0x13, 0x9e, 0xc5, 0x8f, 0x2c, 0x8b, 0xa3, 0x38, 0xdd, 0x88, 0x94,
-	0xf6, 0x16, 0x5f, 0xa2, 0xde, 0x62, 0xda, 0x53, 0xbf, 0x17, 0x53, 0x5d, 0xbe, 0xfe, 0xc7, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xea, 0x8c, 0x6e, 0xcd, 0x4e, 0x04, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x5d, 0x6a, 0xdb, 0x4a,
+	0x14, 0xc7, 0x23, 0xc7, 0x9f, 0xc7, 0x5f, 0x93, 0x49, 0xee, 0x65, 0x08, 0x17, 0xc7, 0x5c, 0xee,
+	0x83, 0xb9, 0x50, 0x1b, 0xda, 0x15, 0xd8, 0x92, 0x42, 0x04, 0xb6, 0x14, 0x4b, 0x63, 0x92, 0xf4,
+	0xc5, 0xd8, 0xce, 0xc4, 0x15, 0xb5, 0x34, 0x46, 0x1a, 0x41, 0xb2, 0x8b, 0xee, 0xa0, 0xdd, 0x43,
+	0x37, 0x91, 0xc7, 0xae, 0xa0, 0x2d, 0xe9, 0x46, 0xca, 0xcc, 0xf8, 0x2b, 0x8f, 0x7d, 0x3b, 0xff,
+	0xdf, 0xf9, 0xd0, 0xff, 0x1c, 0x09, 0x41, 0x2d, 0x15, 0x33, 0x91, 0xa5, 0xdd, 0x75, 0xc2, 0x05,
+	0xc7, 0x45, 0xad, 0xce, 0xdf, 0x2c, 0x43, 0xf1, 0x21, 0x9b, 0x77, 0x17, 0x3c, 0xea, 0x2d, 0xf9,
+	0x92, 0xf7, 0x54, 0x7a, 0x9e, 0x3d, 0x28, 0xa5, 0x84, 0x8a, 0x74, 0xdb, 0xf9, 0xc5, 0x92, 0xf3,
+	0xe5, 0x8a, 0xed, 0xab, 0x44, 0x18, 0xb1, 0x54, 0xcc, 0xa2, 0xb5, 0x2e, 0xf8, 0xf7, 0x73, 0x01,
+	0x4a, 0x01, 0x4b, 0xd3, 0x90, 0xc7, 0xf8, 0x6f, 0x28, 0xba, 0xfc, 0x9e, 0x39, 0x16, 0x31, 0xda,
+	0x46, 0xa7, 0xe2, 0x6f, 0x94, 0xe4, 0x26, 0x8f, 0x63, 0xc7, 0x22, 0xb9, 0xb6, 0xd1, 0xa9, 0xfb,
+	0x1b, 0x85, 0xff, 0x81, 0xca, 0xa6, 0xd5, 0xb1, 0xc8, 0xb1, 0x6a, 0xd9, 0x03, 0x4c, 0xa0, 0xd4,
+	0x5f, 0x2c, 0x78, 0x16, 0x0b, 0x92, 0x57, 0xb9, 0xad, 0xc4, 0x18, 0xf2, 0x93, 0x94, 0x25, 0xa4,
+	0xa0, 0xb0, 0x8a, 0x25, 0xbb, 0xe2, 0xa9, 0x20, 0x45, 0xcd, 0x64, 0x8c, 0x1b, 0x90, 0xb3, 0x06,
+	0xa4, 0xa4, 0x48, 0xce, 0x1a, 0xe0, 0x2b, 0xa8, 0x6d, 0xc6, 0x07, 0x62, 0x96, 0x08, 0x52, 0x6e,
+	0x1b, 0x9d, 0xea, 0xdb, 0xf3, 0xae, 0xde, 0xb1, 0xbb, 0xdd, 0xb1, 0x4b, 0xb7, 0x3b, 0x0e, 0xca,
+	0xcf, 0xdf, 0x2f, 0x8e, 0x3e, 0xfd, 0xb8, 0x30, 0xfc, 0x57, 0x9d, 0xd2, 0x9b, 0xc9, 0xa3, 0x68,
+	0x16, 0xdf, 0x93, 0x8a, 0xf6, 0xb6, 0x91, 0xd2, 0x87, 0x13, 0x3f, 0x70, 0x02, 0xda, 0x87, 0x8c,
+	0xf1, 0x19, 0x14, 0xe8, 0xa3, 0xdc, 0xb1, 0xaa, 0xa0, 0x16, 0xb8, 0x0d, 0xd5, 0x40, 0xcc, 0x04,
+	0x8b, 0x58, 0x2c, 0x1c, 0x8b, 0xd4, 0x54, 0xee, 0x10, 0xe1, 0xff, 0xa0, 0xbe, 0x93, 0xf4, 0x69,
+	0xcd, 0x48, 0x5d, 0xd5, 0xbc, 0x86, 0xf2, 0x8a, 0xe3, 0x8c, 0x25, 0x4f, 0xaa, 0xa2, 0xa1, 0xaf,
+	0xb8, 0x03, 0x6a, 0xc6, 0x78, 0x18, 0xf0, 0x2c, 0x59, 0x30, 0x55, 0xd1, 0xdc, 0xcc, 0x38, 0x84,
+	0xd8, 0x02, 0x50, 0x2d, 0xfa, 0x2e, 0xe8, 0x0f, 0xee, 0x72, 0xd0, 0x87, 0x5b, 0x00, 0xe6, 0x2a,
+	0x64, 0xb1, 0x50, 0x6f, 0xe2, 0x44, 0x3d, 0xe8, 0x80, 0xc8, 0xdb, 0xf8, 0x7c, 0xc5, 0x08, 0xd6,
+	0xb7, 0x91, 0xb1, 0x74, 0x7f, 0x99, 0xf0, 0xe8, 0x3a, 0xe1, 0x8f, 0x4f, 0xe4, 0xb4, 0x6d, 0x74,
+	0xca, 0xfe, 0x1e, 0xc8, 0xac, 0x0a, 0xd4, 0xc0, 0x33, 0xbd, 0xdb, 0x0e, 0xc8, 0xdd, 0xc6, 0x19,
+	0xcb, 0xd8, 0xcd, 0x2c, 0x14, 0xd2, 0x1b, 0xf9, 0xab, 0x6d, 0x74, 0x8e, 0xfd, 0xd7, 0xf0, 0xff,
+	0xaf, 0xb9, 0xdd, 0x6b, 0xbf, 0x0c, 0xd9, 0xea, 0x1e, 0x57, 0xa1, 0xe4, 0x7a, 0x96, 0x3d, 0x75,
+	0x2c, 0x74, 0x24, 0x85, 0xe9, 0xb9, 0xae, 0x14, 0x06, 0x6e, 0x00, 0x04, 0x76, 0x10, 0x38, 0x9e,
+	0xd2, 0x39, 0x99, 0xec, 0x9b, 0xa6, 0x37, 0x71, 0x29, 0x3a, 0xc6, 0x65, 0xc8, 0x4f, 0x02, 0xdb,
+	0x47, 0x79, 0x19, 0x5d, 0x79, 0x01, 0x45, 0x05, 0x5c, 0x94, 0x5f, 0x18, 0x2a, 0xe2, 0x13, 0xa8,
+	0x6f, 0x1b, 0x03, 0xda, 0xf7, 0x29, 0x2a, 0xe9, 0xc1, 0xa3, 0x51, 0xdf, 0xb5, 0x50, 0x59, 0x76,
+	0x38, 0xee, 0xa5, 0x87, 0x2a, 0x18, 0xa0, 0x48, 0x6f, 0xd5, 0x78, 0xc0, 0x08, 0x6a, 0x01, 0xed,
+	0x53, 0x7b, 0x64, 0xbb, 0x54, 0x92, 0x2a, 0xc6, 0xd0, 0xd8, 0x13, 0x7a, 0x77, 0x6d, 0xa3, 0x9a,
+	0x34, 0x35, 0x9e, 0xd8, 0xfe, 0x9d, 0xd6, 0x75, 0x7c, 0x0a, 0xcd, 0x60, 0x3c, 0x9c, 0x06, 0xde,
+	0xc4, 0x37, 0x6d, 0x0d, 0x1b, 0xb8, 0x09, 0x55, 0x5d, 0xa4, 0x1f, 0xdf, 0x94, 0xc0, 0x1c, 0x3a,
+	0x72, 0x8c, 0xb2, 0x8a, 0xa4, 0x05, 0xdf, 0x1b, 0xda, 0xe8, 0x44, 0x0e, 0xbc, 0xf6, 0xbd, 0xdb,
+	0x3b, 0x9d, 0xc1, 0x72, 0xe0, 0x78, 0x62, 0x4f, 0xec, 0xe9, 0x4d, 0xdf, 0xa1, 0x53, 0xea, 0x8c,
+	0x6c, 0x74, 0x3a, 0xe8, 0x3f, 0xbf, 0xb4, 0x8c, 0x6f, 0x2f, 0x2d, 0xe3, 0xe7, 0x4b, 0xeb, 0xe8,
+	0xcb, 0xaf, 0x96, 0xf1, 0xbe, 0x77, 0xf0, 0xe7, 0x88, 0x66, 0x22, 0x09, 0x1f, 0x79, 0x12, 0x2e,
+	0xc3, 0x78, 0x2b, 0x62, 0xd6, 0x5b, 0x7f, 0x5c, 0xf6, 0xd6, 0xf3, 0x9e, 0xfe, 0xd5, 0xcc, 0x8b,
+	0xea, 0xc3, 0x79, 0xf7, 0x7b, 0x00, 0x0c, 0xef, 0xb7, 0x44, 0x89, 0x04, 0x00, 0x00,
 }
 
 func (m *Session) Marshal() (dAtA []byte, err error) {
@@ -399,6 +413,13 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QueueWaitTime != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.QueueWaitTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.ProxyHost) > 0 {
 		i -= len(m.ProxyHost)
 		copy(dAtA[i:], m.ProxyHost)
@@ -646,6 +667,9 @@ func (m *Session) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovStatus(uint64(l))
 	}
+	if m.QueueWaitTime != 0 {
+		n += 2 + sovStatus(uint64(m.QueueWaitTime))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1304,6 +1328,25 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			}
 			m.ProxyHost = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueWaitTime", wireType)
+			}
+			m.QueueWaitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueWaitTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(dAtA[iNdEx:])
@@ -104,6 +104,12 @@ const (
 	TaskCode_ConnectorKafkaSink TaskCode = 4
 	// MergeObject is for the merge object task.
 	TaskCode_MergeObject TaskCode = 5
+	// MaterializedViewRefresh is for the scheduled refresh of a materialized view.
+	TaskCode_MaterializedViewRefresh TaskCode = 6
+	// EventSchedule is for the execution of a scheduled event.
+	TaskCode_EventSchedule TaskCode = 7
+	// StatsAutoAnalyze is for the background refresh of the table statistics.
+	TaskCode_StatsAutoAnalyze TaskCode = 8
 )
 
 var TaskCode_name = map[int32]string{
@@ -113,15 +119,21 @@ var TaskCode_name = map[int32]string{
 	3: "MetricStorageUsage",
 	4: "ConnectorKafkaSink",
 	5: "MergeObject",
+	6: "MaterializedViewRefresh",
+	7: "EventSchedule",
+	8: "StatsAutoAnalyze",
 }
 
 var TaskCode_value = map[string]int32{
-	"TestOnly":           0,
-	"SystemInit":         1,
-	"MetricLogMerge":     2,
-	"MetricStorageUsage": 3,
-	"ConnectorKafkaSink": 4,
-	"MergeObject":        5,
+	"TestOnly":                0,
+	"SystemInit":              1,
+	"MetricLogMerge":          2,
+	"MetricStorageUsage":      3,
+	"ConnectorKafkaSink":      4,
+	"MergeObject":             5,
+	"MaterializedViewRefresh": 6,
+	"EventSchedule":           7,
+	"StatsAutoAnalyze":        8,
 }
 
 func (x TaskCode) String() string {
@@ -1039,83 +1051,87 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x8f, 0x1b, 0x45,
-	0x10, 0xf6, 0xf8, 0xed, 0xf2, 0x23, 0x43, 0x07, 0xad, 0x46, 0x56, 0xd8, 0x58, 0x26, 0x88, 0xd5,
-	0x4a, 0x78, 0xc1, 0x04, 0x44, 0x22, 0x81, 0xb2, 0x6b, 0x2f, 0xca, 0x92, 0x6c, 0x12, 0xf5, 0x7a,
-	0x2f, 0x88, 0x4b, 0x7b, 0x5c, 0x99, 0x0c, 0xb6, 0x7b, 0x4c, 0x4f, 0x4f, 0x58, 0x1f, 0xf8, 0x03,
-	0x39, 0x71, 0x83, 0x4b, 0x24, 0xee, 0xfc, 0x08, 0xae, 0x39, 0xe6, 0xc8, 0x89, 0x47, 0xe0, 0x27,
-	0x70, 0x45, 0x42, 0xdd, 0x3d, 0x2f, 0x6f, 0x00, 0x69, 0xa5, 0xdc, 0x5c, 0x5f, 0x3d, 0xa6, 0xea,
-	0xab, 0x47, 0x1b, 0x40, 0xb2, 0x70, 0x3e, 0x58, 0x89, 0x40, 0x06, 0xa4, 0xac, 0x7e, 0x77, 0xdf,
-	0xf1, 0x7c, 0xf9, 0x28, 0x9a, 0x0e, 0xdc, 0x60, 0xb9, 0xe7, 0x05, 0x5e, 0xb0, 0xa7, 0x95, 0xd3,
-	0xe8, 0xa1, 0x96, 0xb4, 0xa0, 0x7f, 0x19, 0xa7, 0xee, 0x55, 0x2f, 0x08, 0xbc, 0x05, 0x66, 0x56,
-	0xd2, 0x5f, 0x62, 0x28, 0xd9, 0x72, 0x15, 0x1b, 0x74, 0x96, 0x28, 0xd9, 0x8c, 0x49, 0x66, 0xe4,
-	0xfe, 0x77, 0x16, 0xb4, 0x26, 0x2c, 0x9c, 0x1f, 0xc7, 0x30, 0xe9, 0x40, 0xf1, 0x68, 0xec, 0x58,
-	0x3d, 0x6b, 0xa7, 0x41, 0x8b, 0x47, 0x63, 0xb2, 0x0b, 0xf5, 0xc3, 0x33, 0x74, 0x23, 0x19, 0x08,
-	0xa7, 0xd8, 0xb3, 0x76, 0x3a, 0xc3, 0xce, 0x40, 0x67, 0xa9, 0xbc, 0x46, 0xc1, 0x0c, 0x69, 0xaa,
-	0x27, 0x0e, 0xd4, 0x46, 0x01, 0x97, 0x78, 0x26, 0x9d, 0x52, 0xcf, 0xda, 0x69, 0xd1, 0x44, 0x24,
-	0xef, 0x41, 0xed, 0xfe, 0x4a, 0xfa, 0x01, 0x0f, 0x9d, 0x72, 0xcf, 0xda, 0x69, 0x0e, 0x5f, 0xcb,
-	0x82, 0xc4, 0x8a, 0x83, 0xf2, 0xb3, 0x5f, 0xae, 0x16, 0x68, 0x62, 0xd7, 0xff, 0xa9, 0x08, 0xcd,
-	0x9c, 0x9a, 0x5c, 0x83, 0xf6, 0x31, 0x3b, 0xa3, 0x28, 0xc5, 0x7a, 0xa2, 0x8a, 0xd2, 0x39, 0xb6,
-	0xe9, 0x26, 0xa8, 0xac, 0xb4, 0x74, 0xc4, 0x25, 0x8a, 0xc7, 0x6c, 0xa1, 0x73, 0x2e, 0xd1, 0x4d,
-	0x50, 0x59, 0x8d, 0x71, 0xc1, 0xd6, 0xe3, 0x48, 0x30, 0x15, 0x5d, 0xa7, 0x5b, 0xa2, 0x9b, 0x20,
-	0xe9, 0x41, 0x73, 0x14, 0x70, 0x37, 0x12, 0x02, 0xb9, 0xbb, 0xd6, 0x89, 0xb7, 0x69, 0x1e, 0x22,
-	0x1f, 0x40, 0xf5, 0x2e, 0x9b, 0xe2, 0x22, 0x74, 0x2a, 0xbd, 0xd2, 0x4e, 0x73, 0xf8, 0xc6, 0x4b,
-	0x55, 0x0d, 0x8c, 0xfe, 0x90, 0x4b, 0xb1, 0xa6, 0xb1, 0xb1, 0xe2, 0x94, 0x62, 0x18, 0x44, 0xc2,
-	0x45, 0xa7, 0xaa, 0xe9, 0x88, 0x39, 0x4d, 0x50, 0x9a, 0xea, 0xbb, 0x37, 0xa0, 0x99, 0x0b, 0x41,
-	0x6c, 0x28, 0xcd, 0x71, 0x1d, 0xf7, 0x47, 0xfd, 0x24, 0xaf, 0x43, 0xe5, 0x31, 0x5b, 0x44, 0xa8,
-	0x2b, 0x6d, 0x50, 0x23, 0xdc, 0x2c, 0x7e, 0x64, 0xf5, 0xaf, 0x67, 0x9f, 0x51, 0x7e, 0xa3, 0x07,
-	0xa7, 0xda, 0xaf, 0x4c, 0xd5, 0x4f, 0xb2, 0x05, 0xd5, 0x63, 0x5c, 0x06, 0x62, 0xad, 0x1d, 0xcb,
-	0x34, 0x96, 0xfa, 0x77, 0xa0, 0x6d, 0x1a, 0x8a, 0x14, 0xc3, 0x68, 0x21, 0xc9, 0x35, 0x28, 0xab,
-	0x3e, 0x6b, 0xdf, 0xce, 0xd0, 0x4e, 0x33, 0x8d, 0x16, 0x52, 0xf7, 0x5f, 0x6b, 0x55, 0x1a, 0x87,
-	0x42, 0xc4, 0x43, 0xd2, 0xa0, 0x46, 0xe8, 0xff, 0x55, 0x84, 0xc6, 0x7e, 0xb8, 0xe6, 0xae, 0xa2,
-	0x24, 0x37, 0x5b, 0x65, 0x3d, 0x5b, 0xd7, 0xa1, 0x9e, 0xcc, 0x9d, 0x76, 0x6b, 0x0e, 0x49, 0x46,
-	0x60, 0xa2, 0x89, 0xe7, 0x22, 0xb5, 0x24, 0x7d, 0x68, 0x3d, 0x60, 0x02, 0xb9, 0x54, 0x56, 0x47,
-	0x63, 0xdd, 0xbb, 0x06, 0xdd, 0xc0, 0xc8, 0x0e, 0x54, 0x4f, 0x24, 0x93, 0x91, 0x19, 0xb7, 0x34,
-	0x6b, 0xa5, 0x35, 0x38, 0x8d, 0xf5, 0x64, 0x1b, 0x40, 0xa1, 0x34, 0xe2, 0x1c, 0x85, 0x53, 0xd1,
-	0xb1, 0x72, 0x88, 0xae, 0x6b, 0x15, 0xb8, 0x8f, 0x74, 0xa3, 0xda, 0xd4, 0x08, 0x6a, 0x80, 0xee,
-	0xb2, 0x50, 0xde, 0x46, 0x26, 0xe4, 0x14, 0x99, 0x74, 0x6a, 0x66, 0x80, 0x36, 0x40, 0xd2, 0x85,
-	0xfa, 0x48, 0x20, 0x93, 0xb8, 0x2f, 0x9d, 0xba, 0x36, 0x48, 0x65, 0x33, 0x5c, 0xcb, 0xd5, 0x02,
-	0x25, 0xce, 0xf6, 0xa5, 0xd3, 0xd0, 0xea, 0x3c, 0x44, 0x6e, 0x9c, 0x6b, 0x84, 0x03, 0x9a, 0xa2,
-	0xcb, 0xa6, 0x94, 0x0d, 0x15, 0xdd, 0xb4, 0xec, 0xff, 0x69, 0xa9, 0x2f, 0x07, 0xfc, 0x15, 0xb2,
-	0xde, 0x35, 0x11, 0x0f, 0xcf, 0x56, 0x22, 0x66, 0x3c, 0x95, 0x95, 0xee, 0x1e, 0x9e, 0x49, 0xb5,
-	0x81, 0x9a, 0xef, 0x12, 0x4d, 0x65, 0xd5, 0xad, 0x89, 0xf0, 0x3d, 0x0f, 0x85, 0xd9, 0xda, 0x8a,
-	0xce, 0x63, 0x03, 0xdb, 0xe0, 0xa9, 0x7a, 0x8e, 0xa7, 0x2e, 0xd4, 0x4f, 0x57, 0x33, 0xa3, 0x33,
-	0x24, 0xa7, 0x72, 0xff, 0x47, 0x0b, 0xec, 0x51, 0xc0, 0x39, 0xba, 0x32, 0x10, 0x63, 0x94, 0xcc,
-	0x5f, 0x84, 0xe4, 0x0a, 0x34, 0x26, 0x6c, 0xba, 0xc0, 0x7b, 0x6c, 0x89, 0xf1, 0x9e, 0x64, 0x00,
-	0xf9, 0x38, 0x3b, 0x44, 0x45, 0xbd, 0xb2, 0x6f, 0x9a, 0xda, 0xcf, 0x87, 0x19, 0xc4, 0x56, 0x66,
-	0x71, 0x13, 0x9f, 0xee, 0x4d, 0x68, 0xe5, 0x15, 0x17, 0x5a, 0xc7, 0x9f, 0x2d, 0xa8, 0x25, 0x49,
-	0xf6, 0xa0, 0x39, 0xc6, 0xd0, 0x15, 0xbe, 0x0e, 0x16, 0xfb, 0xe7, 0x21, 0x55, 0xc6, 0xbe, 0xeb,
-	0x06, 0x11, 0x97, 0x47, 0x63, 0x1d, 0xab, 0x4d, 0x33, 0x40, 0x5d, 0xda, 0x58, 0x88, 0x9b, 0x91,
-	0x88, 0x9a, 0xaf, 0x10, 0x05, 0x67, 0x71, 0x2f, 0x1a, 0x34, 0x95, 0xb3, 0x1d, 0xad, 0xe4, 0x76,
-	0x94, 0x7c, 0x08, 0x8d, 0xb4, 0xfa, 0x78, 0xc6, 0xb6, 0xfe, 0x9d, 0x94, 0xdb, 0x05, 0x9a, 0x99,
-	0x1e, 0x34, 0xd2, 0x72, 0xfa, 0x7f, 0x97, 0x01, 0xc6, 0x0c, 0x97, 0xaf, 0x74, 0xe2, 0x36, 0x18,
-	0x28, 0xfd, 0x0f, 0x03, 0xe5, 0x4d, 0x06, 0x76, 0xa1, 0xae, 0xe2, 0x4e, 0xd6, 0x2b, 0xd4, 0x85,
-	0x6e, 0xbc, 0x58, 0x0a, 0xa5, 0xa9, 0xfe, 0xdc, 0xf6, 0x57, 0x5f, 0xda, 0xfe, 0x77, 0x8d, 0x3e,
-	0xbe, 0x25, 0xb5, 0xff, 0xb8, 0x25, 0x39, 0x1b, 0xf2, 0xd9, 0xf9, 0xcb, 0x50, 0xd7, 0x05, 0x77,
-	0x07, 0xe6, 0x65, 0x1e, 0x24, 0x2f, 0xf3, 0x60, 0x92, 0xbc, 0xcc, 0x07, 0x75, 0x55, 0xf8, 0xb7,
-	0xbf, 0x5e, 0xb5, 0xce, 0xdf, 0x8f, 0xb7, 0x53, 0x86, 0xf5, 0x7d, 0x68, 0x0e, 0xdb, 0xe6, 0xd3,
-	0x31, 0x48, 0xd3, 0x71, 0xba, 0x95, 0x5b, 0x20, 0xb8, 0xc0, 0xf7, 0xb2, 0x35, 0xbb, 0x95, 0x5b,
-	0xb3, 0xe6, 0x45, 0x22, 0x24, 0x5e, 0xe4, 0x26, 0x54, 0x0e, 0xb9, 0x3a, 0x65, 0xad, 0x0b, 0xb8,
-	0x1b, 0x17, 0xf2, 0x09, 0xd4, 0x54, 0xe5, 0x34, 0xe2, 0x4e, 0xfb, 0x02, 0xde, 0x89, 0xd3, 0xee,
-	0xf7, 0x56, 0xbe, 0x4f, 0xa4, 0x09, 0x35, 0x53, 0xd8, 0xcc, 0x2e, 0x28, 0x41, 0x35, 0xd3, 0xe7,
-	0x9e, 0x6d, 0x91, 0xb6, 0x9a, 0xf5, 0xf8, 0xc4, 0xda, 0x45, 0x02, 0x50, 0x7d, 0xc0, 0xa2, 0x10,
-	0x67, 0x76, 0x89, 0x34, 0xe2, 0xe5, 0xb0, 0xcb, 0xa4, 0x05, 0xf5, 0x11, 0xe3, 0x2e, 0x2e, 0x70,
-	0x66, 0x57, 0xc8, 0x65, 0xb8, 0xa4, 0xce, 0xea, 0x12, 0x29, 0x7e, 0x15, 0x61, 0xa8, 0x3c, 0xab,
-	0x84, 0x40, 0x47, 0x7b, 0x66, 0x58, 0x4d, 0x19, 0x1a, 0xb7, 0x0c, 0xac, 0xef, 0x7e, 0x63, 0xa6,
-	0x51, 0xbf, 0x91, 0x2d, 0xa8, 0x4f, 0x30, 0x94, 0xf7, 0xf9, 0x62, 0x6d, 0x17, 0x48, 0x07, 0xe0,
-	0x64, 0x1d, 0x4a, 0x5c, 0x1e, 0x71, 0x5f, 0xda, 0x96, 0x0a, 0x79, 0x8c, 0x52, 0xf8, 0xee, 0xdd,
-	0xc0, 0x3b, 0x46, 0xe1, 0xa1, 0x5d, 0x24, 0x5b, 0x40, 0x0c, 0x76, 0x22, 0x03, 0xc1, 0x3c, 0x3c,
-	0x0d, 0x99, 0x87, 0x76, 0x49, 0xe1, 0xe9, 0x22, 0xde, 0x61, 0x0f, 0xe7, 0xec, 0xc4, 0xe7, 0x73,
-	0xbb, 0x4c, 0x2e, 0x41, 0x53, 0xbb, 0xde, 0x9f, 0x7e, 0x89, 0xae, 0xb4, 0x2b, 0xbb, 0x6f, 0x01,
-	0x64, 0x4f, 0xb5, 0xe2, 0xe2, 0x24, 0x72, 0x5d, 0x0c, 0x43, 0xbb, 0xa0, 0x8a, 0xff, 0x94, 0xf9,
-	0xaa, 0x46, 0x6b, 0xf7, 0x8b, 0x6c, 0x67, 0xc8, 0x15, 0xa8, 0x9d, 0xf2, 0x39, 0x0f, 0xbe, 0xe6,
-	0x76, 0xa1, 0x7b, 0xe9, 0xc9, 0xd3, 0x5e, 0x53, 0xc1, 0x31, 0x44, 0x86, 0x40, 0xd2, 0x0f, 0xa6,
-	0x29, 0xd8, 0x56, 0xb7, 0xfb, 0xe4, 0x69, 0x6f, 0x4b, 0x19, 0xbe, 0xac, 0x3d, 0x18, 0x3d, 0xff,
-	0x7d, 0xdb, 0x7a, 0xf6, 0x62, 0xdb, 0x7a, 0xfe, 0x62, 0xdb, 0xfa, 0xed, 0xc5, 0x76, 0xe1, 0x87,
-	0x3f, 0xb6, 0xad, 0xcf, 0xf3, 0x7f, 0x6d, 0x97, 0x4c, 0x0a, 0xff, 0x2c, 0x10, 0xbe, 0xe7, 0xf3,
-	0x44, 0xe0, 0xb8, 0xb7, 0x9a, 0x7b, 0x7b, 0xab, 0xe9, 0x9e, 0x1a, 0xfa, 0x69, 0x55, 0x8f, 0xc2,
-	0xfb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xe6, 0xd5, 0x09, 0x24, 0x0b, 0x00, 0x00,
+	// 1270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x8f, 0x1b, 0xc5,
+	0x16, 0x9e, 0xf6, 0xdb, 0xc7, 0x8f, 0x74, 0x2a, 0xd1, 0xdc, 0x96, 0x6f, 0xee, 0xc4, 0xf2, 0x0d,
+	0x62, 0x34, 0x12, 0x1e, 0x30, 0x01, 0x91, 0x48, 0xa0, 0xcc, 0xd8, 0x83, 0x32, 0x24, 0x93, 0x44,
+	0x35, 0x1e, 0x16, 0x88, 0x4d, 0xb9, 0x7d, 0xd2, 0xd3, 0xb8, 0x5d, 0x6d, 0xaa, 0xab, 0x93, 0x71,
+	0x7e, 0x42, 0x56, 0xec, 0x60, 0x13, 0x89, 0x3d, 0x3f, 0x82, 0x05, 0x9b, 0x2c, 0xb3, 0x64, 0xc5,
+	0x23, 0xf0, 0x13, 0xd8, 0x22, 0xa1, 0xaa, 0x7e, 0xda, 0x01, 0xa4, 0x91, 0xb2, 0xf3, 0xf9, 0xce,
+	0xa3, 0xcf, 0xf9, 0xce, 0xa3, 0x0c, 0x20, 0x59, 0x30, 0xeb, 0x2f, 0x84, 0x2f, 0x7d, 0x52, 0x52,
+	0xbf, 0x3b, 0x6f, 0x39, 0xae, 0x3c, 0x0d, 0x27, 0x7d, 0xdb, 0x9f, 0xef, 0x3a, 0xbe, 0xe3, 0xef,
+	0x6a, 0xe5, 0x24, 0x7c, 0xa8, 0x25, 0x2d, 0xe8, 0x5f, 0x91, 0x53, 0xe7, 0xaa, 0xe3, 0xfb, 0x8e,
+	0x87, 0x99, 0x95, 0x74, 0xe7, 0x18, 0x48, 0x36, 0x5f, 0xc4, 0x06, 0xed, 0x39, 0x4a, 0x36, 0x65,
+	0x92, 0x45, 0x72, 0xef, 0x6b, 0x03, 0x9a, 0x63, 0x16, 0xcc, 0x8e, 0x62, 0x98, 0xb4, 0xa1, 0x70,
+	0x38, 0xb2, 0x8c, 0xae, 0xb1, 0x5d, 0xa7, 0x85, 0xc3, 0x11, 0xd9, 0x81, 0xda, 0xc1, 0x19, 0xda,
+	0xa1, 0xf4, 0x85, 0x55, 0xe8, 0x1a, 0xdb, 0xed, 0x41, 0xbb, 0xaf, 0xb3, 0x54, 0x5e, 0x43, 0x7f,
+	0x8a, 0x34, 0xd5, 0x13, 0x0b, 0xaa, 0x43, 0x9f, 0x4b, 0x3c, 0x93, 0x56, 0xb1, 0x6b, 0x6c, 0x37,
+	0x69, 0x22, 0x92, 0x77, 0xa0, 0x7a, 0x7f, 0x21, 0x5d, 0x9f, 0x07, 0x56, 0xa9, 0x6b, 0x6c, 0x37,
+	0x06, 0x17, 0xb3, 0x20, 0xb1, 0x62, 0xbf, 0xf4, 0xfc, 0xa7, 0xab, 0x1b, 0x34, 0xb1, 0xeb, 0x7d,
+	0x5f, 0x80, 0x46, 0x4e, 0x4d, 0xae, 0x41, 0xeb, 0x88, 0x9d, 0x51, 0x94, 0x62, 0x39, 0x56, 0x45,
+	0xe9, 0x1c, 0x5b, 0x74, 0x15, 0x54, 0x56, 0x5a, 0x3a, 0xe4, 0x12, 0xc5, 0x23, 0xe6, 0xe9, 0x9c,
+	0x8b, 0x74, 0x15, 0x54, 0x56, 0x23, 0xf4, 0xd8, 0x72, 0x14, 0x0a, 0xa6, 0xa2, 0xeb, 0x74, 0x8b,
+	0x74, 0x15, 0x24, 0x5d, 0x68, 0x0c, 0x7d, 0x6e, 0x87, 0x42, 0x20, 0xb7, 0x97, 0x3a, 0xf1, 0x16,
+	0xcd, 0x43, 0xe4, 0x3d, 0xa8, 0xdc, 0x65, 0x13, 0xf4, 0x02, 0xab, 0xdc, 0x2d, 0x6e, 0x37, 0x06,
+	0xff, 0x7b, 0xa5, 0xaa, 0x7e, 0xa4, 0x3f, 0xe0, 0x52, 0x2c, 0x69, 0x6c, 0xac, 0x38, 0xa5, 0x18,
+	0xf8, 0xa1, 0xb0, 0xd1, 0xaa, 0x68, 0x3a, 0x62, 0x4e, 0x13, 0x94, 0xa6, 0xfa, 0xce, 0x0d, 0x68,
+	0xe4, 0x42, 0x10, 0x13, 0x8a, 0x33, 0x5c, 0xc6, 0xfd, 0x51, 0x3f, 0xc9, 0x65, 0x28, 0x3f, 0x62,
+	0x5e, 0x88, 0xba, 0xd2, 0x3a, 0x8d, 0x84, 0x9b, 0x85, 0x0f, 0x8c, 0xde, 0xf5, 0xec, 0x33, 0xca,
+	0x6f, 0xf8, 0xe0, 0x44, 0xfb, 0x95, 0xa8, 0xfa, 0x49, 0x36, 0xa1, 0x72, 0x84, 0x73, 0x5f, 0x2c,
+	0xb5, 0x63, 0x89, 0xc6, 0x52, 0xef, 0x0e, 0xb4, 0xa2, 0x86, 0x22, 0xc5, 0x20, 0xf4, 0x24, 0xb9,
+	0x06, 0x25, 0xd5, 0x67, 0xed, 0xdb, 0x1e, 0x98, 0x69, 0xa6, 0xa1, 0x27, 0x15, 0x4e, 0xb5, 0x56,
+	0xa5, 0x71, 0x20, 0x44, 0x3c, 0x24, 0x75, 0x1a, 0x09, 0xbd, 0x3f, 0x0a, 0x50, 0xdf, 0x0b, 0x96,
+	0xdc, 0x56, 0x94, 0xe4, 0x66, 0xab, 0xa4, 0x67, 0xeb, 0x3a, 0xd4, 0x92, 0xb9, 0xd3, 0x6e, 0x8d,
+	0x01, 0xc9, 0x08, 0x4c, 0x34, 0xf1, 0x5c, 0xa4, 0x96, 0xa4, 0x07, 0xcd, 0x07, 0x4c, 0x20, 0x97,
+	0xca, 0xea, 0x70, 0xa4, 0x7b, 0x57, 0xa7, 0x2b, 0x18, 0xd9, 0x86, 0xca, 0xb1, 0x64, 0x32, 0x8c,
+	0xc6, 0x2d, 0xcd, 0x5a, 0x69, 0x23, 0x9c, 0xc6, 0x7a, 0xb2, 0x05, 0xa0, 0x50, 0x1a, 0x72, 0x8e,
+	0xc2, 0x2a, 0xeb, 0x58, 0x39, 0x44, 0xd7, 0xb5, 0xf0, 0xed, 0x53, 0xdd, 0xa8, 0x16, 0x8d, 0x04,
+	0x35, 0x40, 0x77, 0x59, 0x20, 0x6f, 0x23, 0x13, 0x72, 0x82, 0x4c, 0x5a, 0xd5, 0x68, 0x80, 0x56,
+	0x40, 0xd2, 0x81, 0xda, 0x50, 0x20, 0x93, 0xb8, 0x27, 0xad, 0x9a, 0x36, 0x48, 0xe5, 0x68, 0xb8,
+	0xe6, 0x0b, 0x0f, 0x25, 0x4e, 0xf7, 0xa4, 0x55, 0xd7, 0xea, 0x3c, 0x44, 0x6e, 0xac, 0x35, 0xc2,
+	0x02, 0x4d, 0xd1, 0xa5, 0xa8, 0x94, 0x15, 0x15, 0x5d, 0xb5, 0xec, 0xfd, 0x6e, 0xa8, 0x2f, 0xfb,
+	0xfc, 0x35, 0xb2, 0xde, 0x89, 0x22, 0x1e, 0x9c, 0x2d, 0x44, 0xcc, 0x78, 0x2a, 0x2b, 0xdd, 0x3d,
+	0x3c, 0x93, 0x6a, 0x03, 0x35, 0xdf, 0x45, 0x9a, 0xca, 0xaa, 0x5b, 0x63, 0xe1, 0x3a, 0x0e, 0x8a,
+	0x68, 0x6b, 0xcb, 0x3a, 0x8f, 0x15, 0x6c, 0x85, 0xa7, 0xca, 0x1a, 0x4f, 0x1d, 0xa8, 0x9d, 0x2c,
+	0xa6, 0x91, 0x2e, 0x22, 0x39, 0x95, 0x7b, 0xdf, 0x19, 0x60, 0x0e, 0x7d, 0xce, 0xd1, 0x96, 0xbe,
+	0x18, 0xa1, 0x64, 0xae, 0x17, 0x90, 0x2b, 0x50, 0x1f, 0xb3, 0x89, 0x87, 0xf7, 0xd8, 0x1c, 0xe3,
+	0x3d, 0xc9, 0x00, 0xf2, 0x61, 0x76, 0x88, 0x0a, 0x7a, 0x65, 0xff, 0x1f, 0xd5, 0xbe, 0x1e, 0xa6,
+	0x1f, 0x5b, 0x45, 0x8b, 0x9b, 0xf8, 0x74, 0x6e, 0x42, 0x33, 0xaf, 0x38, 0xd7, 0x3a, 0xfe, 0x68,
+	0x40, 0x35, 0x49, 0xb2, 0x0b, 0x8d, 0x11, 0x06, 0xb6, 0x70, 0x75, 0xb0, 0xd8, 0x3f, 0x0f, 0xa9,
+	0x32, 0xf6, 0x6c, 0xdb, 0x0f, 0xb9, 0x3c, 0x1c, 0xe9, 0x58, 0x2d, 0x9a, 0x01, 0xea, 0xd2, 0xc6,
+	0x42, 0xdc, 0x8c, 0x44, 0xd4, 0x7c, 0x05, 0x28, 0x38, 0x8b, 0x7b, 0x51, 0xa7, 0xa9, 0x9c, 0xed,
+	0x68, 0x39, 0xb7, 0xa3, 0xe4, 0x7d, 0xa8, 0xa7, 0xd5, 0xc7, 0x33, 0xb6, 0xf9, 0xf7, 0xa4, 0xdc,
+	0xde, 0xa0, 0x99, 0xe9, 0x7e, 0x3d, 0x2d, 0xa7, 0xf7, 0x67, 0x09, 0x60, 0xc4, 0x70, 0xfe, 0x5a,
+	0x27, 0x6e, 0x85, 0x81, 0xe2, 0xbf, 0x30, 0x50, 0x5a, 0x65, 0x60, 0x07, 0x6a, 0x2a, 0xee, 0x78,
+	0xb9, 0x40, 0xab, 0xbc, 0xfe, 0x62, 0x29, 0x94, 0xa6, 0xfa, 0xb5, 0xed, 0xaf, 0xbc, 0xb2, 0xfd,
+	0x6f, 0x47, 0xfa, 0xf8, 0x96, 0x54, 0xff, 0xe1, 0x96, 0xe4, 0x6c, 0xc8, 0x27, 0xeb, 0x97, 0xa1,
+	0xa6, 0x0b, 0xee, 0xf4, 0xa3, 0x97, 0xb9, 0x9f, 0xbc, 0xcc, 0xfd, 0x71, 0xf2, 0x32, 0xef, 0xd7,
+	0x54, 0xe1, 0x5f, 0xfd, 0x7c, 0xd5, 0x58, 0xbf, 0x1f, 0x6f, 0xa6, 0x0c, 0xeb, 0xfb, 0xd0, 0x18,
+	0xb4, 0xa2, 0x4f, 0xc7, 0x20, 0x4d, 0xb4, 0xe4, 0x56, 0x6e, 0x81, 0xe0, 0x1c, 0xdf, 0xcb, 0xd6,
+	0xec, 0x56, 0x6e, 0xcd, 0x1a, 0xe7, 0x89, 0x90, 0x78, 0x91, 0x9b, 0x50, 0x3e, 0xe0, 0xea, 0x94,
+	0x35, 0xcf, 0xe1, 0x1e, 0xb9, 0x90, 0x8f, 0xa0, 0xaa, 0x2a, 0xa7, 0x21, 0xb7, 0x5a, 0xe7, 0xf0,
+	0x4e, 0x9c, 0x76, 0xbe, 0x31, 0xf2, 0x7d, 0x22, 0x0d, 0xa8, 0x46, 0x85, 0x4d, 0xcd, 0x0d, 0x25,
+	0xa8, 0x66, 0xba, 0xdc, 0x31, 0x0d, 0xd2, 0x82, 0x7a, 0x7a, 0x62, 0xcd, 0x02, 0x01, 0xa8, 0x3c,
+	0x60, 0x61, 0x80, 0x53, 0xb3, 0x48, 0xea, 0xf1, 0x72, 0x98, 0x25, 0xd2, 0x84, 0xda, 0x90, 0x71,
+	0x1b, 0x3d, 0x9c, 0x9a, 0x65, 0x72, 0x09, 0x2e, 0xa8, 0xb3, 0x3a, 0x47, 0x8a, 0x5f, 0x86, 0x18,
+	0x28, 0xcf, 0x0a, 0x21, 0xd0, 0xd6, 0x9e, 0x19, 0x56, 0x55, 0x86, 0x91, 0x5b, 0x06, 0xd6, 0x76,
+	0x7e, 0x30, 0xa2, 0x71, 0xd4, 0x8f, 0x64, 0x13, 0x6a, 0x63, 0x0c, 0xe4, 0x7d, 0xee, 0x2d, 0xcd,
+	0x0d, 0xd2, 0x06, 0x38, 0x5e, 0x06, 0x12, 0xe7, 0x87, 0xdc, 0x95, 0xa6, 0xa1, 0x62, 0x1e, 0xa1,
+	0x14, 0xae, 0x7d, 0xd7, 0x77, 0x8e, 0x50, 0x38, 0x68, 0x16, 0xc8, 0x26, 0x90, 0x08, 0x3b, 0x96,
+	0xbe, 0x60, 0x0e, 0x9e, 0x04, 0xcc, 0x41, 0xb3, 0xa8, 0xf0, 0x74, 0x13, 0xef, 0xb0, 0x87, 0x33,
+	0x76, 0xec, 0xf2, 0x99, 0x59, 0x22, 0x17, 0xa0, 0xa1, 0x5d, 0xef, 0x4f, 0xbe, 0x40, 0x5b, 0x9a,
+	0x65, 0xf2, 0x5f, 0xf8, 0xcf, 0x11, 0x93, 0x28, 0x5c, 0xe6, 0xb9, 0x4f, 0x70, 0xfa, 0xa9, 0x8b,
+	0x8f, 0x29, 0x3e, 0x14, 0x18, 0x9c, 0x9a, 0x15, 0x72, 0x11, 0x5a, 0x07, 0x8f, 0x90, 0xcb, 0x63,
+	0xfb, 0x14, 0xa7, 0xa1, 0x87, 0x66, 0x95, 0x5c, 0x06, 0x53, 0xb1, 0x18, 0xec, 0x85, 0xd2, 0xdf,
+	0xe3, 0xcc, 0x5b, 0x3e, 0x41, 0xb3, 0xb6, 0xf3, 0x06, 0x40, 0xf6, 0xe2, 0x2b, 0x4a, 0x8f, 0x43,
+	0xdb, 0xc6, 0x20, 0x30, 0x37, 0x14, 0x87, 0x1f, 0x33, 0x57, 0x51, 0x65, 0xec, 0x7c, 0x9e, 0xad,
+	0x1e, 0xb9, 0x02, 0xd5, 0x13, 0x3e, 0xe3, 0xfe, 0x63, 0x6e, 0x6e, 0x74, 0x2e, 0x3c, 0x7d, 0xd6,
+	0x6d, 0x28, 0x38, 0x86, 0xc8, 0x00, 0x48, 0x9a, 0x76, 0x5a, 0x88, 0x69, 0x74, 0x3a, 0x4f, 0x9f,
+	0x75, 0x37, 0x95, 0xe1, 0xab, 0xda, 0xfd, 0xe1, 0x8b, 0x5f, 0xb7, 0x8c, 0xe7, 0x2f, 0xb7, 0x8c,
+	0x17, 0x2f, 0xb7, 0x8c, 0x5f, 0x5e, 0x6e, 0x6d, 0x7c, 0xfb, 0xdb, 0x96, 0xf1, 0x59, 0xfe, 0x1f,
+	0xf2, 0x9c, 0x49, 0xe1, 0x9e, 0xf9, 0xc2, 0x75, 0x5c, 0x9e, 0x08, 0x1c, 0x77, 0x17, 0x33, 0x67,
+	0x77, 0x31, 0xd9, 0x55, 0xbb, 0x33, 0xa9, 0xe8, 0x89, 0x7a, 0xf7, 0xaf, 0x01, 0x00, 0x72, 0x1b,
+	0x8c, 0x25, 0x6b, 0x0b, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
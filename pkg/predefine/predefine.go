@@ -26,37 +26,37 @@ import (
 	"github.com/robfig/cron/v3"
 )
 
-// genInitCronTaskSQL Generate `insert` statement for creating system cron tasks, which works on the `mo_task`.`sys_cron_task` table.
-func GenInitCronTaskSQL() (string, error) {
-	cronParser := cron.NewParser(
-		cron.Second |
-			cron.Minute |
-			cron.Hour |
-			cron.Dom |
-			cron.Month |
-			cron.Dow |
-			cron.Descriptor)
+var cronParser = cron.NewParser(
+	cron.Second |
+		cron.Minute |
+		cron.Hour |
+		cron.Dom |
+		cron.Month |
+		cron.Dow |
+		cron.Descriptor)
 
-	createCronTask := func(value task.TaskMetadata, cronExpr string) (*task.CronTask, error) {
-		sche, err := cronParser.Parse(cronExpr)
-		if err != nil {
-			return nil, err
-		}
+func createCronTask(value task.TaskMetadata, cronExpr string) (*task.CronTask, error) {
+	sche, err := cronParser.Parse(cronExpr)
+	if err != nil {
+		return nil, err
+	}
 
-		now := time.Now().UnixMilli()
-		next := sche.Next(time.UnixMilli(now))
+	now := time.Now().UnixMilli()
+	next := sche.Next(time.UnixMilli(now))
 
-		return &task.CronTask{
-			Metadata:     value,
-			CronExpr:     cronExpr,
-			NextTime:     next.UnixMilli(),
-			TriggerTimes: 0,
-			CreateAt:     now,
-			UpdateAt:     now,
-		}, nil
-	}
+	return &task.CronTask{
+		Metadata:     value,
+		CronExpr:     cronExpr,
+		NextTime:     next.UnixMilli(),
+		TriggerTimes: 0,
+		CreateAt:     now,
+		UpdateAt:     now,
+	}, nil
+}
 
-	cronTasks := make([]*task.CronTask, 0, 2)
+// genInitCronTaskSQL Generate `insert` statement for creating system cron tasks, which works on the `mo_task`.`sys_cron_task` table.
+func GenInitCronTaskSQL() (string, error) {
+	cronTasks := make([]*task.CronTask, 0, 3)
 	task1, err := createCronTask(export.MergeTaskMetadata(task.TaskCode_MetricLogMerge), export.MergeTaskCronExprEvery05Min)
 	if err != nil {
 		return "", err
@@ -69,6 +69,36 @@ func GenInitCronTaskSQL() (string, error) {
 	}
 	cronTasks = append(cronTasks, task2)
 
+	task3, err := createCronTask(autoAnalyzeTaskMetadata(), catalog.AutoAnalyzeCronExpr)
+	if err != nil {
+		return "", err
+	}
+	cronTasks = append(cronTasks, task3)
+
+	return genInsertCronTasksSQL(cronTasks)
+}
+
+// GenAutoAnalyzeCronTaskSQL generates the `insert` statement creating the cron
+// task of the auto analyze in the clusters created before it.
+func GenAutoAnalyzeCronTaskSQL() (string, error) {
+	t, err := createCronTask(autoAnalyzeTaskMetadata(), catalog.AutoAnalyzeCronExpr)
+	if err != nil {
+		return "", err
+	}
+	return genInsertCronTasksSQL([]*task.CronTask{t})
+}
+
+// autoAnalyzeTaskMetadata returns the metadata of the cron task of the auto
+// analyze, which is created with the cluster.
+func autoAnalyzeTaskMetadata() task.TaskMetadata {
+	return task.TaskMetadata{
+		ID:       catalog.AutoAnalyzeCronTask,
+		Executor: task.TaskCode_StatsAutoAnalyze,
+		Options:  task.TaskOptions{Concurrency: 1},
+	}
+}
+
+func genInsertCronTasksSQL(cronTasks []*task.CronTask) (string, error) {
 	sql := fmt.Sprintf(`insert into %s.sys_cron_task (
                            task_metadata_id,
 						   task_metadata_executor,
@@ -793,6 +793,12 @@ func generateConstExpressionExecutor(proc *process.Process, typ types.Type, con
 					return nil, err1
 				}
 				vec, err = vector.NewConstArray(typ, array, 1, proc.Mp())
+			} else if typ.Oid.IsQuantizedArray() {
+				array, err1 := types.StringToQuantizedArrayToBytes(typ.Oid, sval)
+				if err1 != nil {
+					return nil, err1
+				}
+				vec, err = vector.NewConstBytes(typ, array, 1, proc.Mp())
 			} else {
 				vec, err = vector.NewConstBytes(constSType, []byte(sval), 1, proc.Mp())
 			}
@@ -667,6 +667,11 @@ func isLegalLine(param *tree.ExternParam, cols []*plan.ColDef, fields []csvparse
 			if err != nil {
 				return false
 			}
+		case types.T_array_int8, types.T_array_bit:
+			_, err := types.StringToQuantizedArrayToBytes(id, field.Val)
+			if err != nil {
+				return false
+			}
 		case types.T_json:
 			if param.Format == tree.CSV {
 				field.Val = fmt.Sprintf("%v", strings.Trim(field.Val, "\""))
@@ -1587,6 +1592,16 @@ func getOneRowData(bat *batch.Batch, line []csvparser.Field, rowIdx int, param *
 				return err
 			}
 			buf.Reset()
+		case types.T_array_int8, types.T_array_bit:
+			arrBytes, err := types.StringToQuantizedArrayToBytes(id, field.Val)
+			if err != nil {
+				return err
+			}
+			err = vector.SetBytesAt(vec, rowIdx, arrBytes, mp)
+			if err != nil {
+				return err
+			}
+			buf.Reset()
 		case types.T_json:
 			var jsonBytes []byte
 			if param.Extern.Format != tree.CSV {
@@ -177,7 +177,12 @@ func (ctr *container) probe(ap *Argument, proc *process.Process, anal process.An
 		switch ctr.bat.Vecs[centroidColPos].GetType().Oid {
 		case types.T_array_float32:
 			tblEmbeddingF32IsNull := ctr.inBat.Vecs[tblColPos].IsNull(uint64(j))
-			tblEmbeddingF32 = types.BytesToArray[float32](ctr.inBat.Vecs[tblColPos].GetBytesAt(j))
+			if ctr.inBat.Vecs[tblColPos].GetType().Oid == types.T_array_int8 {
+				// veci8 columns are clustered with vecf32 centroids.
+				tblEmbeddingF32 = types.Int8ArrayToFloatArray[float32](types.BytesToInt8Array(ctr.inBat.Vecs[tblColPos].GetBytesAt(j)))
+			} else {
+				tblEmbeddingF32 = types.BytesToArray[float32](ctr.inBat.Vecs[tblColPos].GetBytesAt(j))
+			}
 
 			//// NOTE: make sure you normalize_l2 probe vector once.
 			//normalizeTblEmbeddingPtrF32 = arrayF32Pool.Get().(*[]float32)
@@ -241,6 +246,26 @@ func (ctr *container) probe(ap *Argument, proc *process.Process, anal process.An
 			}
 			//*normalizeTblEmbeddingPtrF64 = normalizeTblEmbeddingF64
 			//arrayF64Pool.Put(normalizeTblEmbeddingPtrF64)
+		case types.T_array_bit:
+			// vecbit columns are clustered by hamming distance with vecbit centroids.
+			tblEmbeddingBitIsNull := ctr.inBat.Vecs[tblColPos].IsNull(uint64(j))
+			tblEmbeddingBit := ctr.inBat.Vecs[tblColPos].GetBytesAt(j)
+			for i = 0; i < buildCount; i++ {
+				clusterEmbeddingBitIsNull := ctr.bat.Vecs[centroidColPos].IsNull(uint64(i))
+				if tblEmbeddingBitIsNull || clusterEmbeddingBitIsNull {
+					leastDistance = 0
+					leastClusterIndex = i
+				} else {
+					dist, err := moarray.HammingDistance(ctr.bat.Vecs[centroidColPos].GetBytesAt(i), tblEmbeddingBit)
+					if err != nil {
+						return err
+					}
+					if dist < leastDistance {
+						leastDistance = dist
+						leastClusterIndex = i
+					}
+				}
+			}
 		}
 		for k, rp := range ap.Result {
 			if rp.Rel == 0 {
@@ -151,6 +151,10 @@ func processlist(_ int, proc *process.Process, arg *Argument, result *vm.CallRes
 					if err := vector.AppendBytes(bat.Vecs[i], []byte(session.GetProxyHost()), false, mp); err != nil {
 						return false, err
 					}
+				case status.SessionField_QUEUE_WAIT_TIME:
+					if err := vector.AppendFixed(bat.Vecs[i], session.GetQueueWaitTime(), false, mp); err != nil {
+						return false, err
+					}
 				}
 			}
 		}
@@ -0,0 +1,191 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// admissionColumnSize is the estimated bytes of a value kept in the hash maps
+// and the sorts of a query.
+const admissionColumnSize = 16
+
+// admissionController admits the statements run by Compile.Run on this CN. The
+// TP queries, the internal statements and the statements of the transactions
+// holding locks are admitted at once. The others are queued if admitting them
+// exceeds the max number of the running statements or the max estimated memory
+// of them, and are admitted in FIFO order.
+type admissionController struct {
+	sync.Mutex
+	// maxConcurrency and maxMemory are the limits, 0 means no limit
+	maxConcurrency int64
+	maxMemory      int64
+	running        int64
+	memory         int64
+	waiters        []*admissionWaiter
+}
+
+type admissionWaiter struct {
+	memory int64
+	// admitted is closed once the statement is admitted
+	admitted chan struct{}
+}
+
+var admission = &admissionController{}
+
+// SetAdmissionLimits sets the max number and the max estimated memory in bytes
+// of the running statements of this CN, 0 means no limit.
+func SetAdmissionLimits(maxConcurrency int, maxMemory int64) {
+	admission.setLimits(int64(maxConcurrency), maxMemory)
+}
+
+func (ac *admissionController) setLimits(maxConcurrency, maxMemory int64) {
+	ac.Lock()
+	defer ac.Unlock()
+	ac.maxConcurrency = max(maxConcurrency, 0)
+	ac.maxMemory = max(maxMemory, 0)
+	ac.admitWaitersLocked()
+}
+
+// fitsLocked returns whether a statement with the estimated memory can run
+// now. A statement estimated to use more memory than the limit runs alone.
+func (ac *admissionController) fitsLocked(memory int64) bool {
+	if ac.maxConcurrency > 0 && ac.running >= ac.maxConcurrency {
+		return false
+	}
+	return ac.maxMemory == 0 || ac.running == 0 || ac.memory+memory <= ac.maxMemory
+}
+
+func (ac *admissionController) admitLocked(memory int64) {
+	ac.running++
+	ac.memory += memory
+	v2.PipelineAdmissionRunningQueueSizeGauge.Set(float64(ac.running))
+}
+
+func (ac *admissionController) admitWaitersLocked() {
+	for len(ac.waiters) > 0 && ac.fitsLocked(ac.waiters[0].memory) {
+		w := ac.waiters[0]
+		ac.waiters = ac.waiters[1:]
+		ac.admitLocked(w.memory)
+		close(w.admitted)
+	}
+	v2.PipelineAdmissionWaitingQueueSizeGauge.Set(float64(len(ac.waiters)))
+}
+
+func (ac *admissionController) enabled() bool {
+	ac.Lock()
+	defer ac.Unlock()
+	return ac.maxConcurrency > 0 || ac.maxMemory > 0
+}
+
+// admit waits until the statement with the estimated memory is admitted or
+// the ctx is done. The statement is admitted at once if wait is false. The
+// wait is recorded into the profile of the statement, and the statement
+// admitted must call the release returned when it finishes.
+func (ac *admissionController) admit(ctx context.Context, memory int64, wait bool, sp *process.StmtProfile) (func(), error) {
+	ac.Lock()
+	if ac.maxConcurrency == 0 && ac.maxMemory == 0 {
+		ac.Unlock()
+		return func() {}, nil
+	}
+	release := func() { ac.release(memory) }
+	if !wait || len(ac.waiters) == 0 && ac.fitsLocked(memory) {
+		ac.admitLocked(memory)
+		ac.Unlock()
+		return release, nil
+	}
+	w := &admissionWaiter{memory: memory, admitted: make(chan struct{})}
+	ac.waiters = append(ac.waiters, w)
+	v2.PipelineAdmissionWaitingQueueSizeGauge.Set(float64(len(ac.waiters)))
+	ac.Unlock()
+
+	start := time.Now()
+	sp.BeginQueueWait()
+	defer func() {
+		sp.EndQueueWait()
+		v2.PipelineAdmissionWaitDurationHistogram.Observe(time.Since(start).Seconds())
+	}()
+
+	select {
+	case <-w.admitted:
+		return release, nil
+	case <-ctx.Done():
+	}
+
+	ac.Lock()
+	defer ac.Unlock()
+	select {
+	case <-w.admitted:
+		// admitted while it is cancelled, give the slot to the others
+		ac.running--
+		ac.memory -= memory
+		v2.PipelineAdmissionRunningQueueSizeGauge.Set(float64(ac.running))
+	default:
+		for i := range ac.waiters {
+			if ac.waiters[i] == w {
+				ac.waiters = append(ac.waiters[:i], ac.waiters[i+1:]...)
+				break
+			}
+		}
+	}
+	ac.admitWaitersLocked()
+	return nil, ctx.Err()
+}
+
+func (ac *admissionController) release(memory int64) {
+	ac.Lock()
+	defer ac.Unlock()
+	ac.running--
+	ac.memory -= memory
+	v2.PipelineAdmissionRunningQueueSizeGauge.Set(float64(ac.running))
+	ac.admitWaitersLocked()
+}
+
+// estimateQueryMemory estimates the memory of the query by the rows kept in
+// its hash maps and sorts.
+func estimateQueryMemory(qry *plan.Query) int64 {
+	var rows float64
+	for _, n := range qry.GetNodes() {
+		if n.Stats == nil {
+			continue
+		}
+		var nodeRows float64
+		switch {
+		case n.Stats.HashmapStats != nil:
+			nodeRows = n.Stats.HashmapStats.HashmapSize
+		case n.NodeType == plan.Node_SORT && n.Limit == nil:
+			nodeRows = n.Stats.Outcnt
+		}
+		rows += nodeRows * float64(max(len(n.ProjectList), 1))
+	}
+	return int64(rows * admissionColumnSize)
+}
+
+// admit admits the statement by the admission controller of this CN. The
+// statement of a transaction holding locks is admitted at once, the statements
+// queued before it may be waiting for its locks.
+func (c *Compile) admit() (func(), error) {
+	if c.isInternal || c.IsTpQuery() || c.pn == nil || c.pn.GetQuery() == nil || !admission.enabled() {
+		return func() {}, nil
+	}
+	wait := c.proc.TxnOperator == nil || c.proc.TxnOperator.LockTableCount() == 0
+	return admission.admit(c.proc.Ctx, estimateQueryMemory(c.pn.GetQuery()), wait, c.proc.GetStmtProfile())
+}
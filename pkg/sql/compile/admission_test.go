@@ -0,0 +1,151 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmissionControllerQueue(t *testing.T) {
+	ac := &admissionController{}
+	ac.setLimits(1, 0)
+	ctx := context.Background()
+
+	release1, err := ac.admit(ctx, 0, true, &process.StmtProfile{})
+	require.NoError(t, err)
+
+	admitted := make(chan func(), 2)
+	sp2 := &process.StmtProfile{}
+	go func() {
+		release, err := ac.admit(ctx, 0, true, sp2)
+		require.NoError(t, err)
+		admitted <- release
+	}()
+	require.Eventually(t, func() bool {
+		ac.Lock()
+		defer ac.Unlock()
+		return len(ac.waiters) == 1
+	}, time.Second*5, time.Millisecond)
+
+	time.Sleep(time.Millisecond * 10)
+	release1()
+	release2 := <-admitted
+	require.GreaterOrEqual(t, sp2.GetQueueWait(), time.Millisecond*10)
+	release2()
+
+	ac.Lock()
+	defer ac.Unlock()
+	require.Equal(t, int64(0), ac.running)
+	require.Empty(t, ac.waiters)
+}
+
+func TestAdmissionControllerMemory(t *testing.T) {
+	ac := &admissionController{}
+	ac.setLimits(0, 100)
+	ctx := context.Background()
+
+	// a statement exceeding the limit runs alone
+	release1, err := ac.admit(ctx, 200, true, &process.StmtProfile{})
+	require.NoError(t, err)
+
+	ctx2, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+	defer cancel()
+	_, err = ac.admit(ctx2, 10, true, &process.StmtProfile{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release1()
+	release2, err := ac.admit(ctx, 60, true, &process.StmtProfile{})
+	require.NoError(t, err)
+	release3, err := ac.admit(ctx, 40, true, &process.StmtProfile{})
+	require.NoError(t, err)
+	release2()
+	release3()
+
+	ac.Lock()
+	defer ac.Unlock()
+	require.Equal(t, int64(0), ac.memory)
+	require.Empty(t, ac.waiters)
+}
+
+func TestAdmissionControllerNoLimit(t *testing.T) {
+	ac := &admissionController{}
+	for i := 0; i < 10; i++ {
+		_, err := ac.admit(context.Background(), 1<<30, true, &process.StmtProfile{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(0), ac.running)
+}
+
+func TestCompileAdmitInternal(t *testing.T) {
+	defer SetAdmissionLimits(0, 0)
+	SetAdmissionLimits(1, 0)
+	release, err := admission.admit(context.Background(), 0, true, &process.StmtProfile{})
+	require.NoError(t, err)
+	defer release()
+
+	// the internal statements are admitted even if the limit is reached
+	c := &Compile{isInternal: true}
+	release2, err := c.admit()
+	require.NoError(t, err)
+	release2()
+}
+
+func TestCompileAdmitHoldingLocks(t *testing.T) {
+	defer SetAdmissionLimits(0, 0)
+	SetAdmissionLimits(1, 0)
+	release, err := admission.admit(context.Background(), 0, true, &process.StmtProfile{})
+	require.NoError(t, err)
+	defer release()
+
+	// the statement of the transaction holding locks is admitted at once
+	ctrl := gomock.NewController(t)
+	txnOp := mock_frontend.NewMockTxnOperator(ctrl)
+	txnOp.EXPECT().LockTableCount().Return(int32(1))
+	c := &Compile{
+		execType: plan2.ExecTypeAP_ONECN,
+		pn:       &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{}}},
+		proc:     &process.Process{TxnOperator: txnOp, Ctx: context.Background()},
+	}
+	release2, err := c.admit()
+	require.NoError(t, err)
+	admission.Lock()
+	require.Equal(t, int64(2), admission.running)
+	admission.Unlock()
+	release2()
+}
+
+func TestEstimateQueryMemory(t *testing.T) {
+	qry := &plan.Query{
+		Nodes: []*plan.Node{
+			{NodeType: plan.Node_TABLE_SCAN, Stats: &plan.Stats{Outcnt: 1000}},
+			{
+				NodeType:    plan.Node_JOIN,
+				ProjectList: make([]*plan.Expr, 2),
+				Stats:       &plan.Stats{HashmapStats: &plan.HashMapStats{HashmapSize: 100}},
+			},
+			{NodeType: plan.Node_SORT, Stats: &plan.Stats{Outcnt: 10}},
+		},
+	}
+	require.Equal(t, int64((100*2+10)*admissionColumnSize), estimateQueryMemory(qry))
+}
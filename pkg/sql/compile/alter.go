@@ -23,6 +23,8 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -31,6 +33,9 @@ import (
 
 func (s *Scope) AlterTableCopy(c *Compile) error {
 	qry := s.Plan.GetDdl().GetAlterTable()
+	if qry.GetTableDef().GetIsTemporary() {
+		return s.alterTempTableCopy(c)
+	}
 	dbName := qry.Database
 	if dbName == "" {
 		dbName = c.db
@@ -47,7 +52,13 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		return err
 	}
 
+	var online *onlineDDL
 	if c.proc.TxnOperator.Txn().IsPessimistic() {
+		// 0. fill the copy table online before the origin table is locked if possible
+		if online, err = prepareOnlineAlterTable(c, originRel, dbName, qry); err != nil {
+			return err
+		}
+
 		var retryErr error
 		// 1. lock origin table metadata in catalog
 		if err = lockMoTable(c, dbName, tblName, lock.LockMode_Exclusive); err != nil {
@@ -76,28 +87,40 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		}
 	}
 
-	// 3. create temporary replica table which doesn't have foreign key constraints
-	err = c.runSql(qry.CreateTmpTableSql)
-	if err != nil {
-		c.proc.Info(c.ctx, "Create copy table for alter table",
-			zap.String("databaseName", c.db),
-			zap.String("origin tableName", qry.GetTableDef().Name),
-			zap.String("copy tableName", qry.CopyTableDef.Name),
-			zap.String("CreateTmpTableSql", qry.CreateTmpTableSql),
-			zap.Error(err))
-		return err
-	}
+	if online != nil {
+		// 3-4. replay the changes left to the copy table filled online
+		if err = online.finish(c); err != nil {
+			c.proc.Info(c.ctx, "finish online copy table for alter table",
+				zap.String("databaseName", c.db),
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("copy tableName", qry.CopyTableDef.Name),
+				zap.Error(err))
+			return err
+		}
+	} else {
+		// 3. create temporary replica table which doesn't have foreign key constraints
+		err = c.runSql(qry.CreateTmpTableSql)
+		if err != nil {
+			c.proc.Info(c.ctx, "Create copy table for alter table",
+				zap.String("databaseName", c.db),
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("copy tableName", qry.CopyTableDef.Name),
+				zap.String("CreateTmpTableSql", qry.CreateTmpTableSql),
+				zap.Error(err))
+			return err
+		}
 
-	// 4. copy the original table data to the temporary replica table
-	err = c.runSql(qry.InsertTmpDataSql)
-	if err != nil {
-		c.proc.Info(c.ctx, "insert data to copy table for alter table",
-			zap.String("databaseName", c.db),
-			zap.String("origin tableName", qry.GetTableDef().Name),
-			zap.String("copy tableName", qry.CopyTableDef.Name),
-			zap.String("InsertTmpDataSql", qry.InsertTmpDataSql),
-			zap.Error(err))
-		return err
+		// 4. copy the original table data to the temporary replica table
+		err = c.runSql(qry.InsertTmpDataSql)
+		if err != nil {
+			c.proc.Info(c.ctx, "insert data to copy table for alter table",
+				zap.String("databaseName", c.db),
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("copy tableName", qry.CopyTableDef.Name),
+				zap.String("InsertTmpDataSql", qry.InsertTmpDataSql),
+				zap.Error(err))
+			return err
+		}
 	}
 
 	// 5. drop original table
@@ -143,6 +166,30 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		}
 	}
 
+	// 5.3 delete all partition tables and partition records of the original table
+	if qry.TableDef.Partition != nil {
+		for _, name := range qry.TableDef.Partition.PartitionTableNames {
+			if err = dbSource.Delete(c.ctx, name); err != nil {
+				c.proc.Info(c.ctx, "delete all partition table of origin table for alter table",
+					zap.String("databaseName", c.db),
+					zap.String("origin tableName", qry.GetTableDef().Name),
+					zap.String("origin tableName partition table", name),
+					zap.Error(err))
+				return err
+			}
+		}
+
+		deleteSql := fmt.Sprintf(deleteMoTablePartitionsWithTableIdFormat, qry.GetTableDef().TblId)
+		if err = c.runSql(deleteSql); err != nil {
+			c.proc.Info(c.ctx, "delete all partition meta data of origin table in `mo_table_partitions` for alter table",
+				zap.String("databaseName", c.db),
+				zap.String("origin tableName", qry.GetTableDef().Name),
+				zap.String("delete all partition sql", deleteSql),
+				zap.Error(err))
+			return err
+		}
+	}
+
 	//6. obtain relation for new tables
 	newRel, err := dbSource.Relation(c.ctx, qry.CopyTableDef.Name, nil)
 	if err != nil {
@@ -172,6 +219,9 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 			zap.Error(err))
 		return err
 	}
+	if err = c.runSql(fmt.Sprintf(updateMoTableAnalyzeTableIdFormat, newRel.GetTableID(c.ctx), qry.GetTableDef().TblId)); err != nil {
+		return err
+	}
 	//--------------------------------------------------------------------------------------------------------------
 	{
 		// 8. invoke reindex for the new table, if it contains ivf index.
@@ -247,6 +297,94 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 			}
 		}
 	}
+	if online != nil {
+		return online.switchTo(c)
+	}
+	return nil
+}
+
+// alterTempTableCopy alters a temporary table by copying its data to a new temporary
+// table like AlterTableCopy. The temporary tables only live in the temporary engine of
+// the session, so there is nothing to lock or to update in the catalog.
+func (s *Scope) alterTempTableCopy(c *Compile) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+	dbName := qry.Database
+	if dbName == "" {
+		dbName = c.db
+	}
+	tblName := qry.GetTableDef().GetName()
+	copyTblName := qry.CopyTableDef.Name
+
+	tmpDBSource, err := c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	originRel, err := tmpDBSource.Relation(c.ctx, engine.GetTempTableName(dbName, tblName), nil)
+	if err != nil {
+		return err
+	}
+
+	// 1. create the temporary replica table and copy the original table data to it
+	if err = c.runSqlWithTempEngine(qry.CreateTmpTableSql); err != nil {
+		c.proc.Info(c.ctx, "Create copy table for alter temporary table",
+			zap.String("databaseName", dbName),
+			zap.String("origin tableName", tblName),
+			zap.String("copy tableName", copyTblName),
+			zap.String("CreateTmpTableSql", qry.CreateTmpTableSql),
+			zap.Error(err))
+		return err
+	}
+	if err = c.runSqlWithTempEngine(qry.InsertTmpDataSql); err != nil {
+		c.proc.Info(c.ctx, "insert data to copy table for alter temporary table",
+			zap.String("databaseName", dbName),
+			zap.String("origin tableName", tblName),
+			zap.String("copy tableName", copyTblName),
+			zap.String("InsertTmpDataSql", qry.InsertTmpDataSql),
+			zap.Error(err))
+		return err
+	}
+
+	// 2. drop the original table and its index tables
+	if err = tmpDBSource.Delete(c.ctx, engine.GetTempTableName(dbName, tblName)); err != nil {
+		return err
+	}
+	for _, indexDef := range qry.TableDef.Indexes {
+		if indexDef.TableExist {
+			if err = tmpDBSource.Delete(c.ctx, engine.GetTempTableName(dbName, indexDef.IndexTableName)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, col := range qry.TableDef.Cols {
+		if col.Typ.AutoIncr {
+			if err = incrservice.GetAutoIncrementService(c.ctx).Delete(
+				c.ctx,
+				originRel.GetTableID(c.ctx),
+				c.proc.TxnOperator); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	// 3. rename the replica table into the original table
+	newRel, err := tmpDBSource.Relation(c.ctx, engine.GetTempTableName(dbName, copyTblName), nil)
+	if err != nil {
+		return err
+	}
+	req := api.NewRenameTableReq(newRel.GetDBID(c.ctx), newRel.GetTableID(c.ctx),
+		engine.GetTempTableName(dbName, copyTblName), engine.GetTempTableName(dbName, tblName))
+	tmp, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	if err = newRel.TableRenameInTxn(c.ctx, [][]byte{tmp}); err != nil {
+		c.proc.Info(c.ctx, "Rename copy tableName to origin tableName for alter temporary table",
+			zap.String("origin tableName", tblName),
+			zap.String("copy table name", copyTblName),
+			zap.Error(err))
+		return err
+	}
 	return nil
 }
 
@@ -220,6 +220,7 @@ func (c *Compile) clear() {
 	c.needLockMeta = false
 	c.isInternal = false
 	c.lastAllocID = 0
+	c.ivfProbe = nil
 
 	for k := range c.metaTables {
 		delete(c.metaTables, k)
@@ -322,6 +323,12 @@ func (c *Compile) Compile(ctx context.Context, pn *plan.Plan, fill func(*batch.B
 	// session info and callback function to write back query result.
 	// XXX u is really a bad name, I'm not sure if `session` or `user` will be more suitable.
 	c.fill = fill
+	if c.ivfProbe == nil {
+		// the rows are held until the search finds enough of them
+		if c.ivfProbe = newIvfProbe(pn, c.proc.Mp(), fill); c.ivfProbe != nil {
+			c.fill = c.ivfProbe.hold
+		}
+	}
 
 	c.pn = pn
 
@@ -439,6 +446,8 @@ func (c *Compile) run(s *Scope) error {
 		return s.TruncateTable(c)
 	case Replace:
 		return s.replace(c)
+	case RefreshMaterializedView:
+		return s.RefreshMaterializedView(c)
 	}
 	return nil
 }
@@ -540,10 +549,33 @@ func (c *Compile) Run(_ uint64) (result *util2.RunResult, err error) {
 	}
 
 	v2.TxnStatementTotalCounter.Inc()
+	release, err := c.admit()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if c.ivfProbe != nil {
+		defer c.ivfProbe.reset()
+	}
+
 	runC = c
 	for {
 		if err = runC.runOnce(); err == nil {
-			break
+			if c.ivfProbe == nil || c.ivfProbe.enough() {
+				break
+			}
+			// the pre-filtered vector search is run again with more lists probed
+			widenC, err := c.prepareIvfProbe(runC)
+			if err != nil {
+				return nil, err
+			}
+			if widenC == nil {
+				break
+			}
+			releaseRunC()
+			runC = widenC
+			continue
 		}
 
 		c.fatalLog(retryTimes, err)
@@ -583,6 +615,11 @@ func (c *Compile) Run(_ uint64) (result *util2.RunResult, err error) {
 	if c.shouldReturnCtxErr() {
 		return nil, c.proc.Ctx.Err()
 	}
+	if c.ivfProbe != nil {
+		if err = c.ivfProbe.flush(); err != nil {
+			return nil, err
+		}
+	}
 	result.AffectRows = runC.getAffectedRows()
 
 	if c.proc.TxnOperator != nil {
@@ -611,6 +648,12 @@ func (c *Compile) prepareRetry(defChanged bool) (*Compile, error) {
 
 	var e error
 	runC := NewCompile(c.addr, c.db, c.sql, c.tenant, c.uid, c.proc.Ctx, c.e, c.proc, c.stmt, c.isInternal, c.cnLabel, c.startAt)
+	// the rows held by the failed run are dropped, and c.pn is the plan widened by
+	// prepareIvfProbe if any.
+	if c.ivfProbe != nil {
+		c.ivfProbe.reset()
+		runC.ivfProbe = c.ivfProbe
+	}
 	defer func() {
 		if e != nil {
 			runC.Release()
@@ -850,6 +893,11 @@ func (c *Compile) compileScope(ctx context.Context, pn *plan.Plan) ([]*Scope, er
 				newScope(DropIndex).
 					withPlan(pn),
 			}, nil
+		case plan.DataDefinition_REFRESH_MATERIALIZED_VIEW:
+			return []*Scope{
+				newScope(RefreshMaterializedView).
+					withPlan(pn),
+			}, nil
 		case plan.DataDefinition_SHOW_DATABASES,
 			plan.DataDefinition_SHOW_TABLES,
 			plan.DataDefinition_SHOW_COLUMNS,
@@ -1093,27 +1141,28 @@ func (c *Compile) compileSteps(qry *plan.Query, ss []*Scope, step int32) (*Scope
 		return ss[0], nil
 	}
 	var rs *Scope
-	switch qry.StmtType {
-	case plan.Query_DELETE:
+	switch {
+	case qry.Returning && step == qry.Steps[len(qry.Steps)-1]:
+		// the rows of the RETURNING clause are output like the select statement
+	case qry.StmtType == plan.Query_DELETE:
 		return ss[0], nil
-	case plan.Query_INSERT:
+	case qry.StmtType == plan.Query_INSERT:
 		return ss[0], nil
-	case plan.Query_UPDATE:
+	case qry.StmtType == plan.Query_UPDATE:
 		return ss[0], nil
-	default:
-		if c.IsTpQuery() {
-			rs = ss[len(ss)-1]
-		} else {
-			rs = c.newMergeScope(ss)
-		}
-		updateScopesLastFlag([]*Scope{rs})
-		c.setAnalyzeCurrent([]*Scope{rs}, c.anal.curr)
-		rs.appendInstruction(vm.Instruction{
-			Op: vm.Output,
-			Arg: output.NewArgument().
-				WithFunc(c.fill),
-		})
 	}
+	if c.IsTpQuery() {
+		rs = ss[len(ss)-1]
+	} else {
+		rs = c.newMergeScope(ss)
+	}
+	updateScopesLastFlag([]*Scope{rs})
+	c.setAnalyzeCurrent([]*Scope{rs}, c.anal.curr)
+	rs.appendInstruction(vm.Instruction{
+		Op: vm.Output,
+		Arg: output.NewArgument().
+			WithFunc(c.fill),
+	})
 	return rs, nil
 }
 
@@ -1414,7 +1463,7 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 
 		if n.Stats.Cost*float64(SingleLineSizeEstimate) >
 			float64(DistributedThreshold) &&
-			!arg.DeleteCtx.CanTruncate {
+			!arg.DeleteCtx.CanTruncate && !c.anal.qry.Returning {
 			c.proc.Infof(c.ctx, "delete of '%s' write s3\n", c.sql)
 			rs := c.newDeleteMergeScope(arg, ss)
 			rs.appendInstruction(vm.Instruction{
@@ -1544,8 +1593,9 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 		}
 
 		currentFirstFlag := c.anal.isFirst
-		toWriteS3 := n.Stats.GetCost()*float64(SingleLineSizeEstimate) >
-			float64(DistributedThreshold) || c.anal.qry.LoadTag
+		// the rows of the RETURNING clause are the rows passed through the insert operator
+		toWriteS3 := (n.Stats.GetCost()*float64(SingleLineSizeEstimate) >
+			float64(DistributedThreshold) || c.anal.qry.LoadTag) && !c.anal.qry.Returning
 
 		if toWriteS3 {
 			c.proc.Debugf(c.ctx, "insert of '%s' write s3\n", c.sql)
@@ -3905,6 +3955,11 @@ func (c *Compile) generateCPUNumber(cpunum, blocks int) int {
 	if cpunum <= 0 || blocks <= 0 || c.IsTpQuery() {
 		return 1
 	}
+	// the resource group of the statement may only use a share of the CPUs,
+	// fewer pipelines run in parallel, and they are throttled by the share
+	if share := c.proc.Lim.CPUShare; share > 0 && share < 100 {
+		cpunum = max(1, cpunum*int(share)/100)
+	}
 
 	if cpunum <= blocks {
 		return cpunum
@@ -4046,10 +4101,7 @@ func (c *Compile) expandRanges(n *plan.Node, rel engine.Relation, blockFilterLis
 			}
 		} else {
 			partitionInfo := n.TableDef.Partition
-			partitionNum := int(partitionInfo.PartitionNum)
-			partitionTableNames := partitionInfo.PartitionTableNames
-			for i := 0; i < partitionNum; i++ {
-				partTableName := partitionTableNames[i]
+			for i, partTableName := range partitionInfo.PartitionTableNames {
 				subrelation, err := db.Relation(ctx, partTableName, c.proc)
 				if err != nil {
 					return nil, err
@@ -4845,7 +4897,42 @@ func (c *Compile) runSql(sql string) error {
 	return nil
 }
 
+// runSqlWithTempEngine is like runSql, but the temporary tables of the
+// session are visible to the sql.
+func (c *Compile) runSqlWithTempEngine(sql string) error {
+	if sql == "" {
+		return nil
+	}
+	entireEngine, ok := c.e.(*engine.EntireEngine)
+	if !ok || entireEngine.TempEngine == nil {
+		return moerr.NewInternalError(c.ctx, "temporary engine not init yet")
+	}
+	v, ok := moruntime.ProcessLevelRuntime().GetGlobalVariables(moruntime.InternalSQLExecutor)
+	if !ok {
+		panic("missing lock service")
+	}
+	exec := v.(executor.SQLExecutor)
+	opts := executor.Options{}.
+		WithDisableIncrStatement().
+		WithTxn(c.proc.TxnOperator).
+		WithDatabase(c.db).
+		WithTimeZone(c.proc.SessionInfo.TimeZone).
+		WithTempEngine(entireEngine.TempEngine)
+	res, err := exec.Exec(c.proc.Ctx, sql, opts)
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return nil
+}
+
 func (c *Compile) runSqlWithResult(sql string) (executor.Result, error) {
+	return c.runSqlWithResultInDatabase(sql, c.db)
+}
+
+// runSqlWithResultInDatabase is like runSqlWithResult, but unqualified names
+// in the sql are resolved in the given database.
+func (c *Compile) runSqlWithResultInDatabase(sql string, db string) (executor.Result, error) {
 	v, ok := moruntime.ProcessLevelRuntime().GetGlobalVariables(moruntime.InternalSQLExecutor)
 	if !ok {
 		panic("missing lock service")
@@ -4856,7 +4943,7 @@ func (c *Compile) runSqlWithResult(sql string) (executor.Result, error) {
 		// All these sub-sql's need to be rolled back and retried en masse when they conflict in pessimistic mode
 		WithDisableIncrStatement().
 		WithTxn(c.proc.TxnOperator).
-		WithDatabase(c.db).
+		WithDatabase(db).
 		WithTimeZone(c.proc.SessionInfo.TimeZone)
 	return exec.Exec(c.proc.Ctx, sql, opts)
 }
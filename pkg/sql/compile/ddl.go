@@ -40,6 +40,7 @@ import (
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/util"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -121,7 +122,30 @@ func (s *Scope) DropDatabase(c *Compile) error {
 	if err != nil {
 		return err
 	}
-	return nil
+
+	//4. delete all materialized view records under the database from mo_catalog.mo_mviews
+	deleteSql = fmt.Sprintf(deleteMoMviewsWithDatabaseFormat, util.EscapeSQLString(dbName))
+	if err = c.runSql(deleteSql); err != nil {
+		return err
+	}
+
+	//5. delete all triggers under the database from mo_catalog.mo_triggers
+	if triggersDisabled(dbName) {
+		return nil
+	}
+	deleteSql = fmt.Sprintf(deleteMoTriggersWithDatabaseFormat, util.EscapeSQLString(dbName))
+	if err = c.runSql(deleteSql); err != nil {
+		return err
+	}
+
+	//6. delete all events under the database and their history, the cron tasks of
+	// the events stop themselves when they find the events are gone
+	deleteSql = fmt.Sprintf(deleteMoEventsWithDatabaseFormat, util.EscapeSQLString(dbName))
+	if err = c.runSql(deleteSql); err != nil {
+		return err
+	}
+	deleteSql = fmt.Sprintf(deleteMoEventHistoryWithDatabaseFormat, util.EscapeSQLString(dbName))
+	return c.runSql(deleteSql)
 }
 
 func (s *Scope) removeFkeysRelationships(c *Compile, dbName string) error {
@@ -348,6 +372,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	var oldName, newName string
 	var addCol []*plan.AlterAddColumn
 	var dropCol []*plan.AlterDropColumn
+	var modifyCol []*plan.AlterModifyColumn
 	var changePartitionDef *plan.PartitionByDef
 
 	cols := tableDef.Cols
@@ -591,6 +616,11 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 		case *plan.AlterTable_Action_AlterComment:
 			alterKinds = addAlterKind(alterKinds, api.AlterKind_UpdateComment)
 			comment = act.AlterComment.NewComment
+		case *plan.AlterTable_Action_StatsAutoRecalc:
+			enabled := act.StatsAutoRecalc.Enabled
+			if err = c.runSql(fmt.Sprintf(upsertMoTableAnalyzeAutoAnalyzeFormat, tblId, enabled, enabled)); err != nil {
+				return err
+			}
 		case *plan.AlterTable_Action_AlterName:
 			alterKinds = addAlterKind(alterKinds, api.AlterKind_RenameTable)
 			oldName = act.AlterName.OldName
@@ -609,6 +639,9 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			}
 			act.AddColumn.Pos = pos
 			addCol = append(addCol, act.AddColumn)
+		case *plan.AlterTable_Action_ModifyColumn:
+			alterKinds = append(alterKinds, api.AlterKind_ModifyColumn)
+			modifyCol = append(modifyCol, act.ModifyColumn)
 		case *plan.AlterTable_Action_AddPartition:
 			alterKinds = append(alterKinds, api.AlterKind_AddPartition)
 			changePartitionDef = act.AddPartition.PartitionDef
@@ -630,6 +663,56 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			if err != nil {
 				return err
 			}
+		case *plan.AlterTable_Action_DropPartition:
+			// the alter request of ADD PARTITION replaces the partition definition of the table
+			alterKinds = append(alterKinds, api.AlterKind_AddPartition)
+			changePartitionDef = act.DropPartition.PartitionDef
+			for _, name := range act.DropPartition.PartitionTableNames {
+				if err = dbSource.Delete(c.ctx, name); err != nil {
+					return err
+				}
+			}
+
+			// the remaining partitions are renumbered
+			err = c.runSql(fmt.Sprintf(deleteMoTablePartitionsWithTableIdFormat, tblId))
+			if err != nil {
+				return err
+			}
+			insertMoTablePartitionSql := genInsertMoTablePartitionsSql(databaseId, tblId, changePartitionDef, changePartitionDef.PartitionTableItems())
+			err = c.runSql(insertMoTablePartitionSql)
+			if err != nil {
+				return err
+			}
+		case *plan.AlterTable_Action_TruncatePartition:
+			if err = lockTablesExclusive(c, dbSource, dbName, act.TruncatePartition.PartitionTableNames); err != nil {
+				return err
+			}
+			for _, name := range act.TruncatePartition.PartitionTableNames {
+				if _, err = dbSource.Truncate(c.ctx, name); err != nil {
+					return err
+				}
+			}
+		case *plan.AlterTable_Action_ExchangePartition:
+			if err = exchangeTablePartition(c, dbSource, dbName, act.ExchangePartition); err != nil {
+				return err
+			}
+		case *plan.AlterTable_Action_RedistributePartition:
+			// the alter request of ADD PARTITION replaces the partition definition of the table
+			alterKinds = append(alterKinds, api.AlterKind_AddPartition)
+			changePartitionDef = act.RedistributePartition.PartitionDef
+			if err = redistributeTablePartition(c, dbSource, act.RedistributePartition); err != nil {
+				return err
+			}
+
+			err = c.runSql(fmt.Sprintf(deleteMoTablePartitionsWithTableIdFormat, tblId))
+			if err != nil {
+				return err
+			}
+			insertMoTablePartitionSql := genInsertMoTablePartitionsSql(databaseId, tblId, changePartitionDef, changePartitionDef.PartitionTableItems())
+			err = c.runSql(insertMoTablePartitionSql)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -694,6 +777,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 
 	var addColIdx int
 	var dropColIdx int
+	var modifyColIdx int
 	constraint := make([][]byte, 0)
 	for _, kind := range alterKinds {
 		var req *api.AlterTableReq
@@ -717,6 +801,10 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 		case api.AlterKind_DropColumn:
 			req = api.NewRemoveColumnReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), dropCol[dropColIdx].Idx, dropCol[dropColIdx].Seq)
 			dropColIdx++
+		case api.AlterKind_ModifyColumn:
+			modify := modifyCol[modifyColIdx]
+			modifyColIdx++
+			req = api.NewModifyColumnReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), modify.Name, &modify.Type, modify.Seq)
 		case api.AlterKind_AddPartition:
 			req = api.NewAddPartitionReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), changePartitionDef)
 		default:
@@ -733,6 +821,15 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 		return err
 	}
 
+	// the triggers follow the renamed table
+	if newName != "" && !triggersDisabled(dbName) {
+		updateSql := fmt.Sprintf(updateMoTriggersTableNameFormat,
+			util.EscapeSQLString(newName), util.EscapeSQLString(dbName), util.EscapeSQLString(oldName))
+		if err = c.runSql(updateSql); err != nil {
+			return err
+		}
+	}
+
 	// remove refChildTbls for drop foreign key clause
 	//remove the child table id -- tblId from the parent table -- fkTblId
 	for _, fkTblId := range removeRefChildTbls {
@@ -916,6 +1013,22 @@ func (s *Scope) CreateTable(c *Compile) error {
 		}
 	}
 
+	if mv := qry.GetMaterializedView(); mv != nil {
+		if err = s.createMaterializedViewMeta(c, dbName, tblName, mv); err != nil {
+			return err
+		}
+	}
+
+	if qry.GetDisableAutoAnalyze() {
+		newRelation, err := dbSource.Relation(c.ctx, tblName, nil)
+		if err != nil {
+			return err
+		}
+		if err = c.runSql(fmt.Sprintf(upsertMoTableAnalyzeAutoAnalyzeFormat, newRelation.GetTableID(c.ctx), false, false)); err != nil {
+			return err
+		}
+	}
+
 	//update mo_foreign_keys
 	for _, sql := range qry.UpdateFkSqls {
 		err = c.runSql(sql)
@@ -1494,6 +1607,24 @@ func (s *Scope) CreateTempTable(c *Compile) error {
 func (s *Scope) CreateIndex(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateIndex()
 
+	d, err := c.e.Database(c.ctx, qry.Database, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	databaseId := d.GetDatabaseId(c.ctx)
+
+	r, err := d.Relation(c.ctx, qry.Table, nil)
+	if err != nil {
+		return err
+	}
+	tableId := r.GetTableID(c.ctx)
+
+	// the index table is filled online before the table is locked if possible
+	online, err := prepareOnlineCreateIndex(c, r, qry.Database, qry)
+	if err != nil {
+		return err
+	}
+
 	{
 		// lockMoTable will lock Table  mo_catalog.mo_tables
 		// for the row with db_name=dbName & table_name = tblName。
@@ -1505,63 +1636,64 @@ func (s *Scope) CreateIndex(c *Compile) error {
 		if err := lockMoTable(c, dbName, tblName, lock.LockMode_Exclusive); err != nil {
 			return err
 		}
+		if online != nil {
+			// the writers committed before the lock of the table are all
+			// visible once the table is locked.
+			if err := lockTable(c.ctx, c.e, c.proc, r, dbName, nil, false); err != nil {
+				return err
+			}
+			if err := online.finish(c); err != nil {
+				return err
+			}
+		}
 	}
 
-	d, err := c.e.Database(c.ctx, qry.Database, c.proc.TxnOperator)
-	if err != nil {
-		return err
-	}
-	databaseId := d.GetDatabaseId(c.ctx)
-
-	r, err := d.Relation(c.ctx, qry.Table, nil)
-	if err != nil {
-		return err
-	}
-	tableId := r.GetTableID(c.ctx)
-
 	originalTableDef := plan2.DeepCopyTableDef(qry.TableDef, true)
 	indexInfo := qry.GetIndex() // IndexInfo is named same as planner's IndexInfo
 	indexTableDef := indexInfo.GetTableDef()
 
-	// indexName -> meta      -> indexDef[0]
-	//     		 -> centroids -> indexDef[1]
-	//     		 -> entries   -> indexDef[2]
-	multiTableIndexes := make(map[string]*MultiTableIndex)
-	for _, indexDef := range indexTableDef.Indexes {
-
-		indexAlgo := indexDef.IndexAlgo
-		if indexDef.Unique {
-			// 1. Unique Index related logic
-			err = s.handleUniqueIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
-		} else if !indexDef.Unique && catalog.IsRegularIndexAlgo(indexAlgo) {
-			// 2. Regular Secondary index
-			err = s.handleRegularSecondaryIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
-		} else if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexAlgo) {
-			// 3. Master index
-			err = s.handleMasterIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
-		} else if !indexDef.Unique && catalog.IsIvfIndexAlgo(indexAlgo) {
-			// 4. IVF indexDefs are aggregated and handled later
-			if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
-				multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
-					IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
-					IndexDefs: make(map[string]*plan.IndexDef),
+	// the index table is already created and filled if the index is created online
+	if online == nil {
+		// indexName -> meta      -> indexDef[0]
+		//     		 -> centroids -> indexDef[1]
+		//     		 -> entries   -> indexDef[2]
+		multiTableIndexes := make(map[string]*MultiTableIndex)
+		for _, indexDef := range indexTableDef.Indexes {
+
+			indexAlgo := indexDef.IndexAlgo
+			if indexDef.Unique {
+				// 1. Unique Index related logic
+				err = s.handleUniqueIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
+			} else if !indexDef.Unique && catalog.IsRegularIndexAlgo(indexAlgo) {
+				// 2. Regular Secondary index
+				err = s.handleRegularSecondaryIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
+			} else if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexAlgo) {
+				// 3. Master index
+				err = s.handleMasterIndexTable(c, indexDef, qry.Database, originalTableDef, indexInfo)
+			} else if !indexDef.Unique && catalog.IsIvfIndexAlgo(indexAlgo) {
+				// 4. IVF indexDefs are aggregated and handled later
+				if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
+					multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
+						IndexAlgo: catalog.ToLower(indexDef.IndexAlgo),
+						IndexDefs: make(map[string]*plan.IndexDef),
+					}
 				}
+				multiTableIndexes[indexDef.IndexName].IndexDefs[catalog.ToLower(indexDef.IndexAlgoTableType)] = indexDef
+			}
+			if err != nil {
+				return err
 			}
-			multiTableIndexes[indexDef.IndexName].IndexDefs[catalog.ToLower(indexDef.IndexAlgoTableType)] = indexDef
-		}
-		if err != nil {
-			return err
 		}
-	}
 
-	for _, multiTableIndex := range multiTableIndexes {
-		switch multiTableIndex.IndexAlgo {
-		case catalog.MoIndexIvfFlatAlgo.ToString():
-			err = s.handleVectorIvfFlatIndex(c, multiTableIndex.IndexDefs, qry.Database, originalTableDef, indexInfo)
-		}
+		for _, multiTableIndex := range multiTableIndexes {
+			switch multiTableIndex.IndexAlgo {
+			case catalog.MoIndexIvfFlatAlgo.ToString():
+				err = s.handleVectorIvfFlatIndex(c, multiTableIndex.IndexDefs, qry.Database, originalTableDef, indexInfo)
+			}
 
-		if err != nil {
-			return err
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1596,6 +1728,9 @@ func (s *Scope) CreateIndex(c *Compile) error {
 			return err
 		}
 	}
+	if online != nil {
+		return online.switchTo(c)
+	}
 	return nil
 }
 
@@ -2053,6 +2188,9 @@ func (s *Scope) TruncateTable(c *Compile) error {
 	if err != nil {
 		return err
 	}
+	if err = c.runSql(fmt.Sprintf(updateMoTableAnalyzeTableIdFormat, newId, oldId)); err != nil {
+		return err
+	}
 	c.addAffectedRows(uint64(affectedRows))
 	return nil
 }
@@ -2218,6 +2356,22 @@ func (s *Scope) DropTable(c *Compile) error {
 		}
 	}
 
+	// delete the materialized view record in mo_catalog.mo_mviews
+	if qry.IsMaterializedView {
+		deleteSql := fmt.Sprintf(deleteMoMviewsFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(tblName))
+		if err = c.runSql(deleteSql); err != nil {
+			return err
+		}
+	}
+
+	// delete the triggers of the table in mo_catalog.mo_triggers
+	if !qry.IsView && !isTemp && !triggersDisabled(dbName) {
+		deleteSql := fmt.Sprintf(deleteMoTriggersFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(tblName))
+		if err = c.runSql(deleteSql); err != nil {
+			return err
+		}
+	}
+
 	if isTemp {
 		if err := dbSource.Delete(c.ctx, engine.GetTempTableName(dbName, tblName)); err != nil {
 			return err
@@ -3308,3 +3462,107 @@ func lockMoTable(
 	}
 	return nil
 }
+
+// lockTablesExclusive locks the metadata and the data of the tables in the database
+// exclusively, the tables are replaced by the statement.
+func lockTablesExclusive(c *Compile, dbSource engine.Database, dbName string, tableNames []string) error {
+	if !c.proc.TxnOperator.Txn().IsPessimistic() {
+		return nil
+	}
+	for _, name := range tableNames {
+		if err := lockMoTable(c, dbName, name, lock.LockMode_Exclusive); err != nil {
+			return err
+		}
+		rel, err := dbSource.Relation(c.ctx, name, nil)
+		if err != nil {
+			return err
+		}
+		if err = doLockTable(c.e, c.proc, rel, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exchangeTablePartition swaps the sub table of the partition and the table by renaming
+// them in the transaction, the rows are not copied:
+//
+//	partition -> tmp, table -> partition, tmp -> table
+//
+// The table swapped in as the partition becomes a sub table, and the one swapped out
+// becomes an ordinary table with the create sql of the table.
+func exchangeTablePartition(c *Compile, dbSource engine.Database, dbName string, exchange *plan.AlterTableExchangePartition) error {
+	partitionTableName, tableName := exchange.PartitionTableName, exchange.TableName
+	if err := lockTablesExclusive(c, dbSource, dbName, []string{partitionTableName, tableName}); err != nil {
+		return err
+	}
+
+	if exchange.ValidateSql != "" {
+		res, err := c.runSqlWithResult(exchange.ValidateSql)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+		if len(res.Batches) > 0 && res.Batches[0].Vecs[0].Length() > 0 {
+			if vector.GetFixedAt[int64](res.Batches[0].Vecs[0], 0) > 0 {
+				return moerr.NewErrRowDoesNotMatchPartition(c.ctx)
+			}
+		}
+	}
+
+	partitionRel, err := dbSource.Relation(c.ctx, partitionTableName, nil)
+	if err != nil {
+		return err
+	}
+	tmpName := fmt.Sprintf("%s_exchange_%d", partitionTableName, partitionRel.GetTableID(c.ctx))
+	renames := []struct {
+		oldName, newName   string
+		relKind, createSql string
+	}{
+		{oldName: partitionTableName, newName: tmpName},
+		{oldName: tableName, newName: partitionTableName, relKind: catalog.SystemPartitionRel},
+		{oldName: tmpName, newName: tableName, relKind: catalog.SystemOrdinaryRel, createSql: exchange.CreateSql},
+	}
+	for _, rename := range renames {
+		rel, err := dbSource.Relation(c.ctx, rename.oldName, nil)
+		if err != nil {
+			return err
+		}
+		req := api.NewRenameTableWithKindReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx),
+			rename.oldName, rename.newName, rename.relKind, rename.createSql)
+		data, err := req.Marshal()
+		if err != nil {
+			return err
+		}
+		if err = rel.TableRenameInTxn(c.ctx, [][]byte{data}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redistributeTablePartition creates the sub tables of the new partitions, moves the rows
+// placed in other partitions to them and drops the sub tables of the removed partitions.
+func redistributeTablePartition(c *Compile, dbSource engine.Database, redistribute *plan.AlterTableRedistributePartition) error {
+	for _, table := range redistribute.PartitionTables {
+		storageCols := planColsToExeCols(table.GetCols())
+		storageDefs, err := planDefsToExeDefs(table)
+		if err != nil {
+			return err
+		}
+		if err = dbSource.Create(c.ctx, table.GetName(), append(storageCols, storageDefs...)); err != nil {
+			return err
+		}
+	}
+	for _, sql := range redistribute.RedistributeSqls {
+		if err := c.runSql(sql); err != nil {
+			return err
+		}
+	}
+	for _, name := range redistribute.DropPartitionTableNames {
+		if err := dbSource.Delete(c.ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
@@ -196,6 +197,32 @@ func (s *Scope) handleIvfIndexCentroidsTable(c *Compile, indexDef *plan.IndexDef
 		qryDatabase,
 		originalTableDef.Name,
 	)
+	centroidSQL := "cast(`__mo_index_unnest_cols`.`value` as VARCHAR)"
+	// veci8 and vecbit columns are clustered in float32, see plan.ivfCentroidTypeId.
+	for _, col := range originalTableDef.Cols {
+		if col.Name != indexColumnName {
+			continue
+		}
+		switch types.T(col.Typ.Id) {
+		case types.T_array_int8, types.T_array_bit:
+			sampleSQL = fmt.Sprintf("(select cast(`%s` as vecf32(%d)) as `%s` from %s)",
+				indexColumnName,
+				col.Typ.Width,
+				indexColumnName,
+				sampleSQL,
+			)
+		}
+		if col.Typ.Id == int32(types.T_array_bit) {
+			// the squared l2 distance of the 0/1 vectors is their hamming distance, and a bit of
+			// the centroid is set if it is set in the most vectors of the cluster.
+			centroidParamsDistFn = catalog.IndexAlgoParamOpType_l2
+			centroidSQL = fmt.Sprintf("cast(cast(`__mo_index_unnest_cols`.`value` as vecf32(%d)) - 0.5 as vecbit(%d))",
+				col.Typ.Width,
+				col.Typ.Width,
+			)
+		}
+		break
+	}
 
 	// 3. Insert into centroids table
 	insertSQL := fmt.Sprintf("insert into `%s`.`%s` (`%s`, `%s`, `%s`)",
@@ -222,7 +249,7 @@ func (s *Scope) handleIvfIndexCentroidsTable(c *Compile, indexDef *plan.IndexDef
 		"SELECT "+
 		"(SELECT CAST(`%s` AS BIGINT) FROM `%s` WHERE `%s` = 'version'), "+
 		"ROW_NUMBER() OVER(), "+
-		"cast(`__mo_index_unnest_cols`.`value` as VARCHAR) "+
+		"%s "+
 		"FROM "+
 		"(SELECT cluster_centers(`%s` kmeans '%d,%s,%s,%s') AS `__mo_index_centroids_string` FROM %s ) AS `__mo_index_centroids_tbl` "+
 		"CROSS JOIN "+
@@ -233,6 +260,8 @@ func (s *Scope) handleIvfIndexCentroidsTable(c *Compile, indexDef *plan.IndexDef
 		metadataTableName,
 		catalog.SystemSI_IVFFLAT_TblCol_Metadata_key,
 
+		centroidSQL,
+
 		indexColumnName,
 		centroidParamsLists,
 		centroidParamsDistFn,
@@ -0,0 +1,104 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+// ivfProbe runs the pre-filtered vector search of a query again with the probe
+// widened while it finds fewer rows than the limit, see plan2.WidenIvfProbe. The
+// rows of a run are held until the run is known to be the last one, they are at
+// most the limit of the query.
+type ivfProbe struct {
+	mp    *mpool.MPool
+	limit int64
+	// fill writes the rows of the last run.
+	fill func(*batch.Batch) error
+	rows int64
+	bats []*batch.Batch
+}
+
+// newIvfProbe returns nil if the plan is not a pre-filtered vector search.
+func newIvfProbe(pn *plan.Plan, mp *mpool.MPool, fill func(*batch.Batch) error) *ivfProbe {
+	limit, ok := plan2.GetIvfProbeLimit(pn)
+	if !ok {
+		return nil
+	}
+	return &ivfProbe{mp: mp, limit: limit, fill: fill}
+}
+
+// hold is the fill of the runs, the end of a run is not written until it is
+// known to be the last one.
+func (p *ivfProbe) hold(bat *batch.Batch) error {
+	if bat == nil {
+		return nil
+	}
+	dup, err := bat.Dup(p.mp)
+	if err != nil {
+		return err
+	}
+	p.bats = append(p.bats, dup)
+	p.rows += int64(bat.RowCount())
+	return nil
+}
+
+// enough returns whether the last run has found enough rows.
+func (p *ivfProbe) enough() bool {
+	return p.rows >= p.limit
+}
+
+// flush writes the rows of the last run.
+func (p *ivfProbe) flush() error {
+	defer p.reset()
+	for _, bat := range p.bats {
+		if err := p.fill(bat); err != nil {
+			return err
+		}
+	}
+	return p.fill(nil)
+}
+
+// reset drops the rows of the last run.
+func (p *ivfProbe) reset() {
+	for _, bat := range p.bats {
+		bat.Clean(p.mp)
+	}
+	p.bats = p.bats[:0]
+	p.rows = 0
+}
+
+// prepareIvfProbe compiles the plan of the pre-filtered vector search with the
+// probe widened, it returns nil if all the lists are probed already. The widened
+// plan replaces the plan of c, so a retry of the statement keeps the widening.
+func (c *Compile) prepareIvfProbe(runC *Compile) (*Compile, error) {
+	pn, ok, err := plan2.WidenIvfProbe(c.proc.Ctx, runC.pn)
+	if err != nil || !ok {
+		return nil, err
+	}
+	c.ivfProbe.reset()
+
+	widenC := NewCompile(c.addr, c.db, c.sql, c.tenant, c.uid, c.proc.Ctx, c.e, c.proc, c.stmt, c.isInternal, c.cnLabel, c.startAt)
+	widenC.ivfProbe = c.ivfProbe
+	if err = widenC.Compile(c.proc.Ctx, pn, c.fill); err != nil {
+		widenC.Release()
+		return nil, err
+	}
+	c.pn = pn
+	return widenC, nil
+}
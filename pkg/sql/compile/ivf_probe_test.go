@@ -0,0 +1,128 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+func TestIvfProbe(t *testing.T) {
+	mp := mpool.MustNewZero()
+	newBatch := func(vals ...int64) *batch.Batch {
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		for _, v := range vals {
+			require.NoError(t, vector.AppendFixed(bat.Vecs[0], v, false, mp))
+		}
+		bat.SetRowCount(len(vals))
+		return bat
+	}
+
+	var filled []int64
+	ends := 0
+	fill := func(bat *batch.Batch) error {
+		if bat == nil {
+			ends++
+			return nil
+		}
+		filled = append(filled, vector.MustFixedCol[int64](bat.Vecs[0])...)
+		return nil
+	}
+
+	// the plan is not a pre-filtered vector search
+	require.Nil(t, newIvfProbe(&plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{}}}, mp, fill))
+
+	p := &ivfProbe{mp: mp, limit: 3, fill: fill}
+
+	// the first run does not find enough rows, nothing is written
+	bat := newBatch(1, 2)
+	require.NoError(t, p.hold(bat))
+	bat.Clean(mp)
+	require.NoError(t, p.hold(nil))
+	require.False(t, p.enough())
+	require.Empty(t, filled)
+	p.reset()
+
+	// the rows of the last run are written
+	for _, vals := range [][]int64{{3, 4}, {5}} {
+		bat = newBatch(vals...)
+		require.NoError(t, p.hold(bat))
+		bat.Clean(mp)
+	}
+	require.True(t, p.enough())
+	require.NoError(t, p.flush())
+	require.Equal(t, []int64{3, 4, 5}, filled)
+	require.Equal(t, 1, ends)
+	require.Empty(t, p.bats)
+	require.Zero(t, p.rows)
+	require.Zero(t, mp.CurrNB())
+}
+
+func TestPrepareIvfProbe(t *testing.T) {
+	ctx := defines.AttachAccountId(context.TODO(), catalog.System_Account)
+	tc := newTestCase("select * from (select * from R order by uid limit 10) t order by uid limit 5", t)
+	ctrl := gomock.NewController(t)
+	tc.proc.TxnClient, tc.proc.TxnOperator = newTestTxnClientAndOp(ctrl)
+	tc.proc.Ctx = ctx
+
+	// mark the sorts as the pre-filtered vector search probing 1 of 4 lists
+	var sorts []*plan.Node
+	for _, node := range tc.pn.GetQuery().Nodes {
+		if node.NodeType == plan.Node_SORT {
+			sorts = append(sorts, node)
+		}
+	}
+	require.Len(t, sorts, 2)
+	sorts[0].ExtraOptions = "ivf_probe_centroids"
+	sorts[1].ExtraOptions = "ivf_probe:1,4"
+	limit, ok := plan2.GetIvfProbeLimit(tc.pn)
+	require.True(t, ok)
+	require.Equal(t, int64(5), limit)
+
+	c := NewCompile("test", "test", tc.sql, "", "", ctx, tc.e, tc.proc, tc.stmt, false, nil, time.Now())
+	require.NoError(t, c.Compile(ctx, tc.pn, testPrint))
+	require.NotNil(t, c.ivfProbe)
+	defer c.Release()
+
+	widenC, err := c.prepareIvfProbe(c)
+	require.NoError(t, err)
+	defer widenC.Release()
+	require.Equal(t, "ivf_probe:2,4", widenC.pn.GetQuery().Nodes[sorts[1].NodeId].ExtraOptions)
+
+	// the retry keeps the widened probe and drops the rows held by the failed run
+	require.NoError(t, c.ivfProbe.hold(batch.EmptyBatch))
+	runC, err := c.prepareRetry(false)
+	require.NoError(t, err)
+	defer runC.Release()
+	require.Equal(t, "ivf_probe:2,4", runC.pn.GetQuery().Nodes[sorts[1].NodeId].ExtraOptions)
+	require.Same(t, c.ivfProbe, runC.ivfProbe)
+	require.Zero(t, c.ivfProbe.rows)
+	require.Empty(t, c.ivfProbe.bats)
+}
@@ -0,0 +1,420 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+)
+
+const (
+	mviewRefreshComplete    = "complete"
+	mviewRefreshIncremental = "incremental"
+
+	// mviewMaxDeltaKeys is the max number of the rows changed since the last
+	// refresh which an incremental refresh reads by their primary keys, a
+	// complete refresh is cheaper than filtering by more keys.
+	mviewMaxDeltaKeys = 8192
+)
+
+// changedKeysReader is implemented by the relations which find the primary
+// keys of the rows changed from the logtail kept in memory.
+type changedKeysReader interface {
+	ChangedPrimaryKeys(ctx context.Context, from, to types.TS, mp *mpool.MPool) (*vector.Vector, bool, error)
+}
+
+type mviewMeta struct {
+	query         string
+	queryDatabase string
+	mode          string
+	lastRefreshTS string
+}
+
+// createMaterializedViewMeta records the definition of a new materialized view in mo_catalog.mo_mviews.
+func (s *Scope) createMaterializedViewMeta(c *Compile, dbName, tblName string, mv *plan.MaterializedView) error {
+	mode := mviewRefreshComplete
+	if mv.Incremental {
+		mode = mviewRefreshIncremental
+	}
+	sql := fmt.Sprintf(insertMoMviewsFormat,
+		util.EscapeSQLString(dbName),
+		util.EscapeSQLString(tblName),
+		util.EscapeSQLString(mv.Query),
+		util.EscapeSQLString(mv.QueryDatabase),
+		mode,
+		util.EscapeSQLString(mv.RefreshCron))
+	return c.runSql(sql)
+}
+
+// RefreshMaterializedView recomputes the content of a materialized view.
+//
+// A complete refresh deletes all rows of the view and runs its query again.
+// An incremental refresh only aggregates the rows of the source table changed
+// since the last refresh, which are read by their primary keys at the snapshot
+// of the last refresh and the current one, and merges the result into the rows
+// of the groups changed in the view. It falls back to a complete refresh if the
+// changes are not all kept in memory, e.g. they are flushed or the snapshot of
+// the last refresh has expired, or the deleted rows can not be merged, see
+// IncrementalMaterializedView.
+func (s *Scope) RefreshMaterializedView(c *Compile) error {
+	qry := s.Plan.GetDdl().GetRefreshMaterializedView()
+	dbName := qry.GetDatabase()
+	tblName := qry.GetTable()
+
+	dbSource, err := c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	rel, err := dbSource.Relation(c.ctx, tblName, nil)
+	if err != nil {
+		return err
+	}
+	// refreshes of the same view are serialized by the table lock
+	if c.proc.TxnOperator.Txn().IsPessimistic() {
+		if err = lockTable(c.ctx, c.e, c.proc, rel, dbName, nil, false); err != nil {
+			return err
+		}
+	}
+
+	meta, err := s.getMaterializedViewMeta(c, dbName, tblName)
+	if err != nil {
+		return err
+	}
+
+	incremental := meta.mode == mviewRefreshIncremental
+	switch qry.GetMode() {
+	case plan.RefreshMaterializedView_COMPLETE:
+		incremental = false
+	case plan.RefreshMaterializedView_INCREMENTAL:
+		if !incremental {
+			return moerr.NewInvalidInput(c.ctx, "materialized view '%s' is not created with refresh incremental", tblName)
+		}
+	}
+
+	var view *plan2.IncrementalMaterializedView
+	if meta.mode == mviewRefreshIncremental {
+		stmts, err := parsers.Parse(c.ctx, dialect.MYSQL, meta.query, 1)
+		if err != nil {
+			return err
+		}
+		sel, ok := stmts[0].(*tree.Select)
+		if !ok {
+			return moerr.NewInternalError(c.ctx, "invalid query of materialized view '%s'", tblName)
+		}
+		if view, err = plan2.AnalyzeIncrementalMaterializedView(c.ctx, sel, meta.queryDatabase); err != nil {
+			return err
+		}
+	}
+
+	ts := c.proc.TxnOperator.SnapshotTS()
+	if incremental {
+		err = s.refreshMaterializedViewIncrementally(c, qry, meta, view, ts)
+	} else {
+		err = s.refreshMaterializedViewCompletely(c, qry, meta, view, ts)
+	}
+	if err != nil {
+		return err
+	}
+	return c.runSql(fmt.Sprintf(updateMoMviewsLastRefreshFormat, ts.DebugString(),
+		util.EscapeSQLString(dbName), util.EscapeSQLString(tblName)))
+}
+
+func (s *Scope) refreshMaterializedViewCompletely(
+	c *Compile,
+	qry *plan.RefreshMaterializedView,
+	meta mviewMeta,
+	view *plan2.IncrementalMaterializedView,
+	ts timestamp.Timestamp) error {
+	if err := c.runSql(fmt.Sprintf("delete from `%s`.`%s`", qry.Database, qry.Table)); err != nil {
+		return err
+	}
+
+	// the view of an incremental refresh must be exactly the result at the
+	// snapshot recorded, which the next refresh computes the changes from.
+	query := meta.query
+	if view != nil {
+		query = view.AggregateSql(
+			fmt.Sprintf("select * from `%s`.`%s` {MO_TS = '%s'}", view.Database, view.Table, ts.DebugString()),
+			qry.Cols)
+	}
+	res, err := c.runSqlWithResultInDatabase(
+		fmt.Sprintf("insert into `%s`.`%s` (%s) %s", qry.Database, qry.Table, quoteMviewColumns(qry.Cols), query),
+		meta.queryDatabase)
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return nil
+}
+
+func (s *Scope) refreshMaterializedViewIncrementally(
+	c *Compile,
+	qry *plan.RefreshMaterializedView,
+	meta mviewMeta,
+	view *plan2.IncrementalMaterializedView,
+	ts timestamp.Timestamp) error {
+	if meta.lastRefreshTS == "" {
+		return s.refreshMaterializedViewCompletely(c, qry, meta, view, ts)
+	}
+	last, err := timestamp.ParseTimestamp(meta.lastRefreshTS)
+	if err != nil {
+		return err
+	}
+
+	srcDb, err := c.e.Database(c.ctx, view.Database, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	srcRel, err := srcDb.Relation(c.ctx, view.Table, nil)
+	if err != nil {
+		return err
+	}
+	srcDef := srcRel.GetTableDef(c.ctx)
+	reader, ok := srcRel.(changedKeysReader)
+	if !ok || srcDef.Pkey == nil || srcDef.Pkey.CompPkeyCol != nil || len(srcDef.Pkey.Names) != 1 {
+		return s.refreshMaterializedViewCompletely(c, qry, meta, view, ts)
+	}
+	pkCol := getColDefByName(srcDef, srcDef.Pkey.PkeyColName)
+	if pkCol == nil {
+		return s.refreshMaterializedViewCompletely(c, qry, meta, view, ts)
+	}
+
+	// the delta is the rows of the primary keys changed since the last refresh
+	keys, ok, err := reader.ChangedPrimaryKeys(c.ctx, types.TimestampToTS(last), types.TimestampToTS(ts), c.proc.Mp())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// the changes are flushed, or the snapshot of the last refresh has
+		// expired
+		return s.refreshMaterializedViewCompletely(c, qry, meta, view, ts)
+	}
+	if keys == nil {
+		return nil
+	}
+	literals, ok := formatMviewKeys(keys, plan2.FormatColType(pkCol.Typ))
+	keys.Free(c.proc.Mp())
+	if !ok || len(literals) > mviewMaxDeltaKeys {
+		return s.refreshMaterializedViewCompletely(c, qry, meta, view, ts)
+	}
+
+	// the primary key is read as well to keep duplicated rows apart.
+	var srcCols []string
+	for _, col := range srcDef.Cols {
+		if !col.Hidden || col.Name == catalog.FakePrimaryKeyColName {
+			srcCols = append(srcCols, col.Name)
+		}
+	}
+	delta := func(at timestamp.Timestamp) string {
+		return fmt.Sprintf("select %s from `%s`.`%s` {MO_TS = '%s'} where `%s` in (%s)",
+			quoteMviewColumns(srcCols), view.Database, view.Table, at.DebugString(),
+			pkCol.Name, strings.Join(literals, ", "))
+	}
+	inserted, deleted := view.AggregateSql(delta(ts), qry.Cols), view.AggregateSql(delta(last), qry.Cols)
+	if !view.CanMergeDeletes() {
+		deletedCnt, err := s.countMaterializedViewDelta(c, delta(last))
+		if err != nil {
+			return err
+		}
+		if deletedCnt > 0 {
+			return s.refreshMaterializedViewCompletely(c, qry, meta, view, ts)
+		}
+	}
+
+	deleteSql, insertSql := mviewMergeSql(qry, view, inserted, deleted, ts)
+	if err = c.runSql(deleteSql); err != nil {
+		return err
+	}
+	res, err := c.runSqlWithResultInDatabase(insertSql, meta.queryDatabase)
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return nil
+}
+
+// mviewMergeSql returns the sqls replacing the rows of the groups changed in
+// the view with the rows merged from the aggregated changes.
+func mviewMergeSql(
+	qry *plan.RefreshMaterializedView,
+	view *plan2.IncrementalMaterializedView,
+	inserted, deleted string,
+	ts timestamp.Timestamp) (string, string) {
+	var keyCols, on, merged, negated []string
+	countStar := ""
+	for i, kind := range view.Columns {
+		col := fmt.Sprintf("`%s`", qry.Cols[i])
+		switch kind {
+		case plan2.MaterializedViewGroupKey:
+			keyCols = append(keyCols, col)
+			// null safe, the planner does not support <=> as a join condition
+			on = append(on, fmt.Sprintf("(`mo_mview`.%s = `mo_mview_changed`.%s or (`mo_mview`.%s is null and `mo_mview_changed`.%s is null))", col, col, col, col))
+			merged = append(merged, col)
+			negated = append(negated, col)
+		case plan2.MaterializedViewSum, plan2.MaterializedViewCount, plan2.MaterializedViewCountStar:
+			merged = append(merged, fmt.Sprintf("sum(%s)", col))
+			negated = append(negated, fmt.Sprintf("-%s", col))
+			if kind == plan2.MaterializedViewCountStar {
+				countStar = col
+			}
+		case plan2.MaterializedViewMin:
+			merged = append(merged, fmt.Sprintf("min(%s)", col))
+			negated = append(negated, col)
+		case plan2.MaterializedViewMax:
+			merged = append(merged, fmt.Sprintf("max(%s)", col))
+			negated = append(negated, col)
+		}
+	}
+
+	// only the rows of the groups changed are merged and replaced, a view
+	// without group keys has one row. The rows of the view are read at the
+	// snapshot of the refresh, since they are deleted below before the merged
+	// rows are inserted.
+	viewRows := fmt.Sprintf("select %s from `%s`.`%s` {MO_TS = '%s'}",
+		quoteMviewColumns(qry.Cols), qry.Database, qry.Table, ts.DebugString())
+	deleteSql := fmt.Sprintf("delete from `%s`.`%s`", qry.Database, qry.Table)
+	if len(keyCols) > 0 {
+		changed := fmt.Sprintf("(select %s from (%s) as `mo_mview_inserted` union select %s from (%s) as `mo_mview_deleted`) as `mo_mview_changed`",
+			strings.Join(keyCols, ", "), inserted, strings.Join(keyCols, ", "), deleted)
+		qualified := make([]string, len(qry.Cols))
+		for i, col := range qry.Cols {
+			qualified[i] = fmt.Sprintf("`mo_mview`.`%s`", col)
+		}
+		viewRows = fmt.Sprintf("select %s from `%s`.`%s` {MO_TS = '%s'} as `mo_mview` join %s on %s",
+			strings.Join(qualified, ", "), qry.Database, qry.Table, ts.DebugString(), changed, strings.Join(on, " and "))
+		deleteSql = fmt.Sprintf("delete `mo_mview` from `%s`.`%s` as `mo_mview` join %s on %s",
+			qry.Database, qry.Table, changed, strings.Join(on, " and "))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("select %s from (%s union all %s union all select %s from (%s) as `mo_mview_deleted`) as `mo_mview_merged`",
+		strings.Join(merged, ", "), viewRows, inserted, strings.Join(negated, ", "), deleted))
+	if len(keyCols) > 0 {
+		sb.WriteString(fmt.Sprintf(" group by %s", strings.Join(keyCols, ", ")))
+		// groups of which all rows are deleted
+		if countStar != "" {
+			sb.WriteString(fmt.Sprintf(" having sum(%s) <> 0", countStar))
+		}
+	}
+
+	return deleteSql, fmt.Sprintf("insert into `%s`.`%s` (%s) %s",
+		qry.Database, qry.Table, quoteMviewColumns(qry.Cols), sb.String())
+}
+
+// formatMviewKeys formats the primary keys as the sql literals of the type,
+// ok is false if the type of the keys is not supported.
+func formatMviewKeys(keys *vector.Vector, typ string) ([]string, bool) {
+	literals := make([]string, keys.Length())
+	for i := range literals {
+		var v string
+		switch keys.GetType().Oid {
+		case types.T_int8:
+			v = strconv.FormatInt(int64(vector.GetFixedAt[int8](keys, i)), 10)
+		case types.T_int16:
+			v = strconv.FormatInt(int64(vector.GetFixedAt[int16](keys, i)), 10)
+		case types.T_int32:
+			v = strconv.FormatInt(int64(vector.GetFixedAt[int32](keys, i)), 10)
+		case types.T_int64:
+			v = strconv.FormatInt(vector.GetFixedAt[int64](keys, i), 10)
+		case types.T_uint8:
+			v = strconv.FormatUint(uint64(vector.GetFixedAt[uint8](keys, i)), 10)
+		case types.T_uint16:
+			v = strconv.FormatUint(uint64(vector.GetFixedAt[uint16](keys, i)), 10)
+		case types.T_uint32:
+			v = strconv.FormatUint(uint64(vector.GetFixedAt[uint32](keys, i)), 10)
+		case types.T_uint64:
+			v = strconv.FormatUint(vector.GetFixedAt[uint64](keys, i), 10)
+		case types.T_char, types.T_varchar, types.T_text:
+			v = fmt.Sprintf("'%s'", util.EscapeSQLString(keys.GetStringAt(i)))
+		case types.T_date:
+			v = fmt.Sprintf("'%s'", vector.GetFixedAt[types.Date](keys, i).String())
+		case types.T_datetime:
+			v = fmt.Sprintf("'%s'", vector.GetFixedAt[types.Datetime](keys, i).String2(keys.GetType().Scale))
+		case types.T_decimal64:
+			v = vector.GetFixedAt[types.Decimal64](keys, i).Format(keys.GetType().Scale)
+		case types.T_decimal128:
+			v = vector.GetFixedAt[types.Decimal128](keys, i).Format(keys.GetType().Scale)
+		default:
+			return nil, false
+		}
+		literals[i] = fmt.Sprintf("cast(%s as %s)", v, typ)
+	}
+	return literals, true
+}
+
+func (s *Scope) countMaterializedViewDelta(c *Compile, sql string) (int64, error) {
+	res, err := c.runSqlWithResult(fmt.Sprintf("select count(*) from (%s) as `mo_mview_delta`", sql))
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var cnt int64
+	res.ReadRows(func(_ int, cols []*vector.Vector) bool {
+		cnt = executor.GetFixedRows[int64](cols[0])[0]
+		return false
+	})
+	return cnt, nil
+}
+
+func (s *Scope) getMaterializedViewMeta(c *Compile, dbName, tblName string) (mviewMeta, error) {
+	var meta mviewMeta
+	res, err := c.runSqlWithResult(fmt.Sprintf(selectMoMviewsFormat, util.EscapeSQLString(dbName), util.EscapeSQLString(tblName)))
+	if err != nil {
+		return meta, err
+	}
+	defer res.Close()
+
+	found := false
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		if rows == 0 {
+			return true
+		}
+		meta.query = executor.GetStringRows(cols[0])[0]
+		meta.queryDatabase = executor.GetStringRows(cols[1])[0]
+		meta.mode = executor.GetStringRows(cols[2])[0]
+		meta.lastRefreshTS = executor.GetStringRows(cols[3])[0]
+		found = true
+		return false
+	})
+	if !found {
+		return meta, moerr.NewInternalError(c.ctx, "can not find the definition of materialized view '%s'", tblName)
+	}
+	return meta, nil
+}
+
+func quoteMviewColumns(cols []string) string {
+	quoted := make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = fmt.Sprintf("`%s`", col)
+	}
+	return strings.Join(quoted, ", ")
+}
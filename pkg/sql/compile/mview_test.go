@@ -0,0 +1,93 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+func TestFormatMviewKeys(t *testing.T) {
+	mp := mpool.MustNewZero()
+	keys := vector.NewVec(types.T_int64.ToType())
+	defer keys.Free(mp)
+	require.NoError(t, vector.AppendFixed(keys, int64(1), false, mp))
+	require.NoError(t, vector.AppendFixed(keys, int64(-2), false, mp))
+	literals, ok := formatMviewKeys(keys, "BIGINT")
+	require.True(t, ok)
+	require.Equal(t, []string{"cast(1 as BIGINT)", "cast(-2 as BIGINT)"}, literals)
+
+	strs := vector.NewVec(types.T_varchar.ToType())
+	defer strs.Free(mp)
+	require.NoError(t, vector.AppendBytes(strs, []byte("a'b"), false, mp))
+	literals, ok = formatMviewKeys(strs, "VARCHAR(10)")
+	require.True(t, ok)
+	require.Equal(t, []string{"cast('a''b' as VARCHAR(10))"}, literals)
+
+	floats := vector.NewVec(types.T_float64.ToType())
+	defer floats.Free(mp)
+	require.NoError(t, vector.AppendFixed(floats, float64(1), false, mp))
+	_, ok = formatMviewKeys(floats, "DOUBLE")
+	require.False(t, ok)
+}
+
+func TestMviewMergeSql(t *testing.T) {
+	ctx := context.TODO()
+	parse := func(sql string) tree.Statement {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, sql, 1)
+		require.NoError(t, err, sql)
+		return stmt
+	}
+	ts := timestamp.Timestamp{PhysicalTime: 10}
+
+	view, err := plan2.AnalyzeIncrementalMaterializedView(ctx,
+		parse("select a, count(*), sum(b) from t group by a").(*tree.Select), "db")
+	require.NoError(t, err)
+	qry := &plan.RefreshMaterializedView{Database: "db", Table: "mv", Cols: []string{"a", "cnt", "s"}}
+	delta := "select `a`, `b` from `db`.`t` where `a` in (cast(1 as INT))"
+	inserted, deleted := view.AggregateSql(delta, qry.Cols), view.AggregateSql(delta, qry.Cols)
+
+	// only the rows of the groups changed are replaced
+	deleteSql, insertSql := mviewMergeSql(qry, view, inserted, deleted, ts)
+	del, ok := parse(deleteSql).(*tree.Delete)
+	require.True(t, ok)
+	require.NotNil(t, del.TableRefs)
+	require.Contains(t, deleteSql, "(`mo_mview`.`a` = `mo_mview_changed`.`a` or (`mo_mview`.`a` is null and `mo_mview_changed`.`a` is null))")
+	_, ok = parse(insertSql).(*tree.Insert)
+	require.True(t, ok)
+	require.Contains(t, insertSql, "having sum(`cnt`) <> 0")
+
+	// the view without group keys has one row
+	view, err = plan2.AnalyzeIncrementalMaterializedView(ctx,
+		parse("select count(*), sum(b) from t").(*tree.Select), "db")
+	require.NoError(t, err)
+	qry.Cols = []string{"cnt", "s"}
+	deleteSql, insertSql = mviewMergeSql(qry, view, view.AggregateSql(delta, qry.Cols), view.AggregateSql(delta, qry.Cols), ts)
+	require.Equal(t, "delete from `db`.`mv`", deleteSql)
+	_, ok = parse(insertSql).(*tree.Insert)
+	require.True(t, ok)
+}
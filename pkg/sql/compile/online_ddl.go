@@ -0,0 +1,706 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/util"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae"
+)
+
+const (
+	onlineDDLPhaseCopying    = "copying"
+	onlineDDLPhaseCatchingUp = "catching up"
+	onlineDDLPhaseSwitching  = "switching"
+	onlineDDLPhaseFinished   = "finished"
+	onlineDDLPhaseFailed     = "failed"
+)
+
+const (
+	// onlineDDLFlag is the system variable to enable the online DDL.
+	onlineDDLFlag = "online_ddl"
+	// onlineDDLMaxRounds is the max rounds to replay the changes in background.
+	onlineDDLMaxRounds = 16
+	// onlineDDLSwitchThreshold is the number of the changes small enough to be
+	// replayed while the table is locked.
+	onlineDDLSwitchThreshold = 1024
+	// onlineDDLReplayBatchSize is the max number of the keys replayed by one sql.
+	onlineDDLReplayBatchSize = 4096
+	// onlineDDLIdleTimeout is the time a job of a CN not in the cluster must
+	// be idle for before it is taken as interrupted.
+	onlineDDLIdleTimeout = 10 * time.Minute
+)
+
+// onlineDDL copies the rows of a table to a target table, the copy table of
+// ALTER TABLE or the index table of CREATE INDEX, without blocking the writers
+// of the table:
+//
+//  1. the changes of the table are captured from the logtail, see disttae.ChangeCapture.
+//  2. the job is recorded in mo_online_ddl, the target table is created and a snapshot of the table is copied to it
+//     in a background transaction.
+//  3. the changes captured are replayed to the target table in background
+//     transactions round by round, until few changes are left.
+//  4. the DDL transaction locks the table, replays the remaining changes and
+//     switches to the target table.
+//
+// The changes are replayed by the primary keys: the rows of the keys are deleted
+// from the target table and copied from the table again, so a key replayed more
+// than once is harmless.
+//
+// The job is recorded before the target table is created and is marked finished
+// by the DDL transaction switching to the target table, so the target table of a
+// job interrupted, e.g. by a crash of the CN, is found and dropped by the next
+// online DDL, see dropInterrupted.
+type onlineDDL struct {
+	id        string
+	key       string
+	accountID uint32
+	database  string
+	table     string
+	target    string
+	statement string
+	// cnAddr is the address of the CN running the job.
+	cnAddr string
+
+	eng        *disttae.Engine
+	databaseID uint64
+	tableID    uint64
+	capture    *disttae.ChangeCapture
+
+	// pkType is the type of the primary key, or the type of the composite
+	// primary key column if the primary key has more than one column.
+	pkType    types.Type
+	pkSeqnum  uint16
+	composite bool
+	// srcKeyCol and dstKeyCol are the columns holding the primary key in the
+	// table and in the target table.
+	srcKeyCol string
+	dstKeyCol string
+
+	createSQL string
+	copySQL   string
+	// copyWithFilter returns the copy sql only copying the rows matching the filter.
+	copyWithFilter func(filter string) string
+	timeZone       *time.Location
+
+	// readyTS is the latest commit ts after the work in background is done, the
+	// DDL transaction must read the target table at a snapshot not less than it.
+	readyTS timestamp.Timestamp
+	// switched is set if the DDL transaction has switched to the target table.
+	switched bool
+
+	rowsTotal  uint64
+	rowsCopied uint64
+	changes    uint64
+	rounds     int
+}
+
+var onlineDDLJobs = struct {
+	sync.Mutex
+	m map[string]*onlineDDL
+}{m: make(map[string]*onlineDDL)}
+
+func onlineDDLKey(txnOp client.TxnOperator, target string) string {
+	return hex.EncodeToString(txnOp.Txn().ID) + "/" + target
+}
+
+// findOnlineDDL returns the job started by the statement if the statement
+// is retried in the transaction.
+func findOnlineDDL(c *Compile, target string) *onlineDDL {
+	onlineDDLJobs.Lock()
+	defer onlineDDLJobs.Unlock()
+	return onlineDDLJobs.m[onlineDDLKey(c.proc.TxnOperator, target)]
+}
+
+// prepareOnlineAlterTable copies the table to the copy table of the ALTER
+// TABLE online. It returns nil if the table can not be altered online.
+func prepareOnlineAlterTable(c *Compile, rel engine.Relation, dbName string, qry *plan.AlterTable) (*onlineDDL, error) {
+	copyDef := qry.GetCopyTableDef()
+	if job := findOnlineDDL(c, copyDef.Name); job != nil {
+		return job, nil
+	}
+
+	tableDef := qry.GetTableDef()
+	for _, indexDef := range copyDef.Indexes {
+		if !indexDef.Unique && !catalog.IsRegularIndexAlgo(indexDef.IndexAlgo) {
+			return nil, nil
+		}
+	}
+	// the primary key must be copied unchanged
+	srcPk, dstPk := tableDef.Pkey, copyDef.Pkey
+	if srcPk == nil || dstPk == nil || len(srcPk.Names) != len(dstPk.Names) {
+		return nil, nil
+	}
+	for i, name := range srcPk.Names {
+		srcCol := getColDefByName(tableDef, name)
+		dstCol := getColDefByName(copyDef, dstPk.Names[i])
+		if srcCol == nil || dstCol == nil {
+			return nil, nil
+		}
+		if newCol, ok := qry.ChangeTblColIdMap[srcCol.ColId]; !ok || newCol.Name != dstCol.Name {
+			return nil, nil
+		}
+		if srcCol.Typ.Id != dstCol.Typ.Id || srcCol.Typ.Width != dstCol.Typ.Width ||
+			srcCol.Typ.Scale != dstCol.Typ.Scale {
+			return nil, nil
+		}
+	}
+
+	o := &onlineDDL{
+		target:    copyDef.Name,
+		createSQL: qry.CreateTmpTableSql,
+		copySQL:   qry.InsertTmpDataSql,
+		dstKeyCol: dstPk.PkeyColName,
+	}
+	o.copyWithFilter = func(filter string) string {
+		return o.copySQL + " WHERE " + filter
+	}
+	if ok, err := o.init(c, rel, dbName, tableDef); err != nil || !ok {
+		return nil, err
+	}
+	return o, o.start(c)
+}
+
+// prepareOnlineCreateIndex creates and fills the index table of the CREATE
+// INDEX online. It returns nil if the index can not be created online.
+func prepareOnlineCreateIndex(c *Compile, rel engine.Relation, dbName string, qry *plan.CreateIndex) (*onlineDDL, error) {
+	indexInfo := qry.GetIndex()
+	indexDefs := indexInfo.GetTableDef().GetIndexes()
+	if len(indexDefs) != 1 || len(indexInfo.GetIndexTables()) != 1 {
+		return nil, nil
+	}
+	indexDef := indexDefs[0]
+	if !indexDef.Unique && !catalog.IsRegularIndexAlgo(indexDef.IndexAlgo) {
+		return nil, nil
+	}
+	if job := findOnlineDDL(c, indexDef.IndexTableName); job != nil {
+		return job, nil
+	}
+
+	tableDef := qry.GetTableDef()
+	o := &onlineDDL{
+		target:    indexDef.IndexTableName,
+		createSQL: genCreateIndexTableSql(indexInfo.GetIndexTables()[0], indexDef, dbName),
+		copySQL:   genInsertIndexTableSql(tableDef, indexDef, dbName, indexDef.Unique),
+		dstKeyCol: catalog.IndexTablePrimaryColName,
+	}
+	o.copyWithFilter = func(filter string) string {
+		return genInsertIndexTableSqlWithFilter(tableDef, indexDef, dbName, indexDef.Unique, filter)
+	}
+	if ok, err := o.init(c, rel, dbName, tableDef); err != nil || !ok {
+		return nil, err
+	}
+	return o, o.start(c)
+}
+
+// init checks whether the table can be copied online and initializes the job.
+func (o *onlineDDL) init(c *Compile, rel engine.Relation, dbName string, tableDef *plan.TableDef) (bool, error) {
+	if tableDef.IsTemporary || tableDef.Partition != nil || tableDef.Pkey == nil {
+		return false, nil
+	}
+	pkName := tableDef.Pkey.PkeyColName
+	if pkName == "" || pkName == catalog.FakePrimaryKeyColName {
+		return false, nil
+	}
+	pkCol := getColDefByName(tableDef, pkName)
+	if pkCol == nil {
+		return false, nil
+	}
+	o.composite = pkName == catalog.CPrimaryKeyColName
+	o.pkType = types.New(types.T(pkCol.Typ.Id), pkCol.Typ.Width, pkCol.Typ.Scale)
+	if !o.composite && !isOnlineDDLKeyType(o.pkType.Oid) {
+		return false, nil
+	}
+
+	// the changes are captured from the logtail of the disttae engine
+	entireEngine, ok := c.e.(*engine.EntireEngine)
+	if !ok {
+		return false, nil
+	}
+	if o.eng, ok = entireEngine.Engine.(*disttae.Engine); !ok {
+		return false, nil
+	}
+	// the DDL transaction must be able to lock the table and read the changes
+	// committed by others, and it must not have written anything the background
+	// transactions can not see.
+	txnOp := c.proc.TxnOperator
+	if !txnOp.Txn().IsPessimistic() || !txnOp.Txn().IsRCIsolation() {
+		return false, nil
+	}
+	// the locks of a retried statement are still held, the background
+	// transactions reading the table would wait for them forever.
+	if txnOp.IsRetry() {
+		return false, nil
+	}
+	if ws, ok := txnOp.GetWorkspace().(*disttae.Transaction); !ok || !ws.ReadOnly() {
+		return false, nil
+	}
+	if val, err := c.proc.GetResolveVariableFunc()(onlineDDLFlag, true, false); err != nil {
+		return false, err
+	} else if fmt.Sprintf("%v", val) != "1" {
+		return false, nil
+	}
+
+	accountID, err := defines.GetAccountId(c.proc.Ctx)
+	if err != nil {
+		return false, err
+	}
+	rowsTotal, err := rel.Rows(c.ctx)
+	if err != nil {
+		return false, err
+	}
+	o.id = uuid.NewString()
+	o.key = onlineDDLKey(txnOp, o.target)
+	o.accountID = accountID
+	o.database = dbName
+	o.table = tableDef.Name
+	o.statement = c.sql
+	o.cnAddr = c.addr
+	o.databaseID = rel.GetDBID(c.ctx)
+	o.tableID = rel.GetTableID(c.ctx)
+	o.pkSeqnum = uint16(pkCol.Seqnum)
+	o.srcKeyCol = pkName
+	o.timeZone = c.proc.SessionInfo.TimeZone
+	o.rowsTotal = rowsTotal
+	return true, nil
+}
+
+// start runs the steps of the job before the table is locked.
+func (o *onlineDDL) start(c *Compile) (err error) {
+	ctx := c.proc.Ctx
+	if o.capture, err = o.eng.StartChangeCapture(ctx, o.databaseID, o.tableID, o.pkSeqnum, o.pkType); err != nil {
+		return err
+	}
+	onlineDDLJobs.Lock()
+	onlineDDLJobs.m[o.key] = o
+	onlineDDLJobs.Unlock()
+	defer func() {
+		if err != nil {
+			o.closeAsync(false, err)
+		}
+	}()
+
+	exec, err := getInternalSqlExecutor()
+	if err != nil {
+		return err
+	}
+	o.dropInterrupted(ctx, exec)
+
+	// the job must be recorded before the target table is created, otherwise
+	// the target table can not be found if the job is interrupted.
+	res, err := exec.Exec(ctx, fmt.Sprintf(insertMoOnlineDDLFormat, o.id,
+		util.EscapeSQLString(o.database), util.EscapeSQLString(o.table), util.EscapeSQLString(o.target),
+		util.EscapeSQLString(o.cnAddr), util.EscapeSQLString(o.statement),
+		onlineDDLPhaseCopying, o.rowsTotal), executor.Options{})
+	if err != nil {
+		return err
+	}
+	res.Close()
+
+	opts := executor.Options{}.
+		WithDatabase(o.database).
+		WithTimeZone(o.timeZone)
+
+	// create the target table and copy a snapshot of the table to it
+	err = exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		res, err := txn.Exec(o.createSQL, executor.StatementOption{})
+		if err != nil {
+			return err
+		}
+		res.Close()
+		if res, err = txn.Exec(o.copySQL, executor.StatementOption{}); err != nil {
+			return err
+		}
+		o.rowsCopied = res.AffectedRows
+		res.Close()
+		return nil
+	}, opts)
+	if err != nil {
+		return err
+	}
+
+	// replay the changes committed during copying until few changes are left.
+	// If the replay failed in background, e.g. a unique key is changed between
+	// the rows replayed, all the changes left are replayed after the table is
+	// locked.
+	o.updateProgress(ctx, onlineDDLPhaseCatchingUp, nil)
+	for o.rounds < onlineDDLMaxRounds && o.capture.Err() == nil {
+		replayed, err := o.catchUp(ctx, exec, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			c.proc.Info(ctx, "replay changes in background for online ddl",
+				zap.String("job", o.id),
+				zap.String("target", o.target),
+				zap.Error(err))
+			break
+		}
+		o.rounds++
+		o.changes += uint64(replayed)
+		o.updateProgress(ctx, onlineDDLPhaseCatchingUp, nil)
+		if replayed <= onlineDDLSwitchThreshold {
+			break
+		}
+	}
+	// the changes lost can not be replayed after the table is locked, fail the
+	// DDL before the table is locked.
+	if err = o.capture.Err(); err != nil {
+		return err
+	}
+
+	// the job is not updated in background any more, the DDL transaction
+	// reading it at readyTS marks it finished without conflicts.
+	o.updateProgress(ctx, onlineDDLPhaseSwitching, nil)
+	o.readyTS = c.proc.TxnClient.GetLatestCommitTS()
+	c.proc.TxnOperator.AppendEventCallback(client.ClosedEvent, func(e client.TxnEvent) {
+		o.closeAsync(e.Txn.Status == txn.TxnStatus_Committed, nil)
+	})
+	return nil
+}
+
+// catchUp replays the changes committed before the snapshot of a background
+// transaction, and returns the number of the keys replayed.
+func (o *onlineDDL) catchUp(ctx context.Context, exec executor.SQLExecutor, opts executor.Options) (int, error) {
+	var keys [][]byte
+	err := exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		var err error
+		keys, err = o.capture.Collect(ctx, types.TimestampToTS(txn.Txn().SnapshotTS()))
+		if err != nil {
+			return err
+		}
+		return o.replay(keys, func(sql string) error {
+			res, err := txn.Exec(sql, executor.StatementOption{})
+			if err != nil {
+				return err
+			}
+			res.Close()
+			return nil
+		})
+	}, opts)
+	if err != nil {
+		o.capture.Requeue(keys)
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+// finish replays the changes left in the DDL transaction, it must be called
+// after the table is locked.
+func (o *onlineDDL) finish(c *Compile) error {
+	txnOp := c.proc.TxnOperator
+	if txnOp.SnapshotTS().Less(o.readyTS) {
+		// the target table is written by the background transactions after the
+		// DDL transaction started, retry the statement at a newer snapshot.
+		ts, err := c.proc.TxnClient.WaitLogTailAppliedAt(c.ctx, o.readyTS)
+		if err != nil {
+			return err
+		}
+		if err = txnOp.UpdateSnapshot(c.ctx, ts); err != nil {
+			return err
+		}
+		return moerr.NewTxnNeedRetry(c.ctx)
+	}
+	// no one can change the table after it is locked, so all the changes are
+	// captured once the logtail is applied at the snapshot.
+	if _, err := c.proc.TxnClient.WaitLogTailAppliedAt(c.ctx, txnOp.SnapshotTS()); err != nil {
+		return err
+	}
+
+	keys, err := o.capture.Collect(c.ctx, types.MaxTs())
+	if err != nil {
+		// some changes are not captured, the table is not copied again under
+		// the lock, the DDL fails and can be retried.
+		return err
+	}
+	o.rounds++
+	o.changes += uint64(len(keys))
+	return o.replay(keys, c.runSql)
+}
+
+// switchTo marks the job finished in the DDL transaction switching to the
+// target table, it must be called after the DDL transaction has switched.
+func (o *onlineDDL) switchTo(c *Compile) error {
+	o.switched = true
+	return c.runSql(fmt.Sprintf(updateMoOnlineDDLFormat, onlineDDLPhaseFinished,
+		o.rowsCopied, o.changes, o.rounds, "", o.id))
+}
+
+// replay deletes the rows of the keys from the target table, and copies the
+// rows of the keys from the table again.
+func (o *onlineDDL) replay(keys [][]byte, run func(sql string) error) error {
+	var filters []string
+	for i := 0; i < len(keys); i += onlineDDLReplayBatchSize {
+		end := i + onlineDDLReplayBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		literals, err := formatOnlineDDLKeys(keys[i:end], o.pkType, o.composite)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, strings.Join(literals, ", "))
+	}
+	for _, filter := range filters {
+		sql := fmt.Sprintf("delete from `%s`.`%s` where `%s` in (%s)", o.database, o.target, o.dstKeyCol, filter)
+		if err := run(sql); err != nil {
+			return err
+		}
+	}
+	for _, filter := range filters {
+		if err := run(o.copyWithFilter(fmt.Sprintf("`%s` in (%s)", o.srcKeyCol, filter))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closeAsync stops the job, the target table is dropped unless the DDL
+// transaction has switched to it and committed. It is called in the callback of
+// the transaction, so the work is done in another goroutine.
+func (o *onlineDDL) closeAsync(committed bool, cause error) {
+	onlineDDLJobs.Lock()
+	delete(onlineDDLJobs.m, o.key)
+	onlineDDLJobs.Unlock()
+	o.eng.StopChangeCapture(o.capture)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(defines.AttachAccountId(context.Background(), o.accountID), time.Minute)
+		defer cancel()
+		if committed && o.switched {
+			o.updateProgress(ctx, onlineDDLPhaseFinished, nil)
+			return
+		}
+		o.execProgressSql(ctx, fmt.Sprintf("drop table if exists `%s`.`%s`", o.database, o.target))
+		if cause == nil {
+			cause = moerr.NewInternalErrorNoCtx("the ddl transaction is rolled back")
+		}
+		o.updateProgress(ctx, onlineDDLPhaseFailed, cause)
+	}()
+}
+
+// dropInterrupted drops the target tables of the jobs interrupted in the account.
+// A job not finished is interrupted if it belongs to this CN and is not running,
+// or its CN has left the cluster and it has been idle for onlineDDLIdleTimeout.
+// The errors are just logged, the target tables are dropped by the next DDL.
+func (o *onlineDDL) dropInterrupted(ctx context.Context, exec executor.SQLExecutor) {
+	type job struct {
+		id       string
+		database string
+		target   string
+		cnAddr   string
+		idle     int64
+	}
+	var jobs []job
+	res, err := exec.Exec(ctx, fmt.Sprintf(getUnfinishedMoOnlineDDLFormat,
+		onlineDDLPhaseCopying, onlineDDLPhaseCatchingUp, onlineDDLPhaseSwitching), executor.Options{})
+	if err != nil {
+		logutil.Error("get interrupted online ddl failed",
+			zap.String("job", o.id),
+			zap.Error(err))
+		return
+	}
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			jobs = append(jobs, job{
+				id:       cols[0].GetStringAt(i),
+				database: cols[1].GetStringAt(i),
+				target:   cols[2].GetStringAt(i),
+				cnAddr:   cols[3].GetStringAt(i),
+				idle:     vector.GetFixedAt[int64](cols[4], i),
+			})
+		}
+		return true
+	})
+	res.Close()
+
+	for _, j := range jobs {
+		if j.cnAddr == o.cnAddr {
+			if isOnlineDDLRunning(j.id) {
+				continue
+			}
+		} else if time.Duration(j.idle)*time.Second < onlineDDLIdleTimeout || isCNInCluster(j.cnAddr) {
+			continue
+		}
+		// the job is left unfinished if its target table is not dropped
+		for _, sql := range []string{
+			fmt.Sprintf("drop table if exists `%s`.`%s`", j.database, j.target),
+			fmt.Sprintf(updateMoOnlineDDLPhaseFormat, onlineDDLPhaseFailed,
+				"the job is interrupted", util.EscapeSQLString(j.id)),
+		} {
+			res, err := exec.Exec(ctx, sql, executor.Options{})
+			if err != nil {
+				logutil.Error("drop interrupted online ddl failed",
+					zap.String("job", j.id),
+					zap.String("sql", sql),
+					zap.Error(err))
+				break
+			}
+			res.Close()
+		}
+	}
+}
+
+func isOnlineDDLRunning(id string) bool {
+	onlineDDLJobs.Lock()
+	defer onlineDDLJobs.Unlock()
+	for _, job := range onlineDDLJobs.m {
+		if job.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func isCNInCluster(addr string) bool {
+	found := false
+	clusterservice.GetMOCluster().GetCNService(clusterservice.NewSelector(), func(cn metadata.CNService) bool {
+		found = cn.PipelineServiceAddress == addr
+		return !found
+	})
+	return found
+}
+
+func (o *onlineDDL) updateProgress(ctx context.Context, phase string, cause error) {
+	var msg string
+	if cause != nil {
+		msg = cause.Error()
+	}
+	o.execProgressSql(ctx, fmt.Sprintf(updateMoOnlineDDLFormat, phase,
+		o.rowsCopied, o.changes, o.rounds, util.EscapeSQLString(msg), o.id))
+}
+
+// execProgressSql runs the sql in a new transaction, the progress of the job is
+// only for display, so the errors are just logged.
+func (o *onlineDDL) execProgressSql(ctx context.Context, sql string) {
+	opts := executor.Options{}.WithDatabase(o.database)
+	exec, err := getInternalSqlExecutor()
+	if err != nil {
+		logutil.Error("online ddl progress failed",
+			zap.String("job", o.id),
+			zap.Error(err))
+		return
+	}
+	if res, err := exec.Exec(ctx, sql, opts); err != nil {
+		logutil.Error("online ddl progress failed",
+			zap.String("job", o.id),
+			zap.String("sql", sql),
+			zap.Error(err))
+	} else {
+		res.Close()
+	}
+}
+
+func getInternalSqlExecutor() (executor.SQLExecutor, error) {
+	v, ok := moruntime.ProcessLevelRuntime().GetGlobalVariables(moruntime.InternalSQLExecutor)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("missing internal sql executor")
+	}
+	return v.(executor.SQLExecutor), nil
+}
+
+func getColDefByName(tableDef *plan.TableDef, name string) *plan.ColDef {
+	for _, col := range tableDef.Cols {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// isOnlineDDLKeyType returns whether the captured primary keys of the type can
+// be formatted to sql literals exactly.
+func isOnlineDDLKeyType(oid types.T) bool {
+	switch oid {
+	case types.T_bool,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_time, types.T_datetime,
+		types.T_char, types.T_varchar, types.T_uuid:
+		return true
+	}
+	return false
+}
+
+// formatOnlineDDLKeys formats the captured primary keys to sql literals. The
+// composite primary keys are compared with the composite primary key column
+// by their encoded bytes.
+func formatOnlineDDLKeys(keys [][]byte, typ types.Type, composite bool) ([]string, error) {
+	literals := make([]string, 0, len(keys))
+	for _, key := range keys {
+		tuple, err := types.Unpack(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(tuple) != 1 {
+			return nil, moerr.NewInternalErrorNoCtx("invalid primary key %s", tuple.String())
+		}
+		if composite {
+			literals = append(literals, fmt.Sprintf("unhex('%s')", hex.EncodeToString(tuple[0].([]byte))))
+			continue
+		}
+
+		var literal string
+		switch v := tuple[0].(type) {
+		case bool, int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+			literal = fmt.Sprintf("%v", v)
+		case types.Decimal64:
+			literal = v.Format(typ.Scale)
+		case types.Decimal128:
+			literal = v.Format(typ.Scale)
+		case types.Date:
+			literal = "'" + v.String() + "'"
+		case types.Time:
+			literal = "'" + v.String2(typ.Scale) + "'"
+		case types.Datetime:
+			literal = "'" + v.String2(typ.Scale) + "'"
+		case []byte:
+			if typ.Oid == types.T_uuid {
+				literal = "'" + types.Uuid(v).ToString() + "'"
+			} else {
+				literal = "'" + util.EscapeSQLString(string(v)) + "'"
+			}
+		default:
+			return nil, moerr.NewInternalErrorNoCtx("unsupported primary key %s", tuple.String())
+		}
+		literals = append(literals, literal)
+	}
+	return literals, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+)
+
+func TestFormatOnlineDDLKeys(t *testing.T) {
+	packer := types.NewPacker(mpool.MustNewZero())
+	defer packer.FreeMem()
+	encode := func(f func()) []byte {
+		packer.Reset()
+		f()
+		return packer.Bytes()
+	}
+
+	keys := [][]byte{
+		encode(func() { packer.EncodeInt64(-1) }),
+		encode(func() { packer.EncodeInt64(42) }),
+	}
+	literals, err := formatOnlineDDLKeys(keys, types.T_int64.ToType(), false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-1", "42"}, literals)
+
+	keys = [][]byte{encode(func() { packer.EncodeStringType([]byte("it's")) })}
+	literals, err = formatOnlineDDLKeys(keys, types.T_varchar.ToType(), false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"'it''s'"}, literals)
+
+	date, err := types.ParseDateCast("2024-01-02")
+	require.NoError(t, err)
+	keys = [][]byte{encode(func() { packer.EncodeDate(date) })}
+	literals, err = formatOnlineDDLKeys(keys, types.T_date.ToType(), false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"'2024-01-02'"}, literals)
+
+	// the composite primary keys are compared by their encoded bytes
+	keys = [][]byte{encode(func() { packer.EncodeStringType([]byte{0x01, 0xab}) })}
+	literals, err = formatOnlineDDLKeys(keys, types.T_varchar.ToType(), true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"unhex('01ab')"}, literals)
+}
+
+func TestGenInsertIndexTableSqlWithFilter(t *testing.T) {
+	tableDef := &plan.TableDef{
+		Name: "t",
+		Pkey: &plan.PrimaryKeyDef{PkeyColName: "a", Names: []string{"a"}},
+	}
+	indexDef := &plan.IndexDef{IndexTableName: "idx", Parts: []string{"b"}}
+	require.Equal(t,
+		"insert into  `db`.`idx` select (b), a from `db`.`t` where (b) is not null and `a` in (1, 2);",
+		genInsertIndexTableSqlWithFilter(tableDef, indexDef, "db", false, "`a` in (1, 2)"))
+
+	indexDef.Parts = []string{"b", "c"}
+	require.Equal(t,
+		"insert into  `db`.`idx` select serial_full(b,c), a from `db`.`t` where `a` in (1, 2);",
+		genInsertIndexTableSqlWithFilter(tableDef, indexDef, "db", false, "`a` in (1, 2)"))
+
+	tableDef.Pkey = &plan.PrimaryKeyDef{PkeyColName: catalog.CPrimaryKeyColName, Names: []string{"a", "b"}}
+	require.Equal(t,
+		"insert into  `db`.`idx` select serial(b,c), serial(a,b) from `db`.`t` where serial(b,c) is not null and `__mo_cpkey_col` in (unhex('01'));",
+		genInsertIndexTableSqlWithFilter(tableDef, indexDef, "db", true, "`__mo_cpkey_col` in (unhex('01'))"))
+}
+
+func TestDropInterruptedOnlineDDL(t *testing.T) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.ClusterService,
+		clusterservice.NewMOCluster(nil, time.Second,
+			clusterservice.WithDisableRefresh(),
+			clusterservice.WithServices([]metadata.CNService{{PipelineServiceAddress: "cn2:18000"}}, nil)))
+
+	running := &onlineDDL{id: "running", key: "running"}
+	onlineDDLJobs.Lock()
+	onlineDDLJobs.m[running.key] = running
+	onlineDDLJobs.Unlock()
+	defer func() {
+		onlineDDLJobs.Lock()
+		delete(onlineDDLJobs.m, running.key)
+		onlineDDLJobs.Unlock()
+	}()
+
+	mp := mpool.MustNewZero()
+	var executed []string
+	exec := executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		executed = append(executed, sql)
+		if !strings.HasPrefix(sql, "select") {
+			return executor.Result{}, nil
+		}
+		varchar := types.T_varchar.ToType()
+		mr := executor.NewMemResult([]types.Type{varchar, varchar, varchar, varchar, types.T_int64.ToType()}, mp)
+		mr.NewBatch()
+		// the jobs of this CN are interrupted if they are not running, the jobs
+		// of the other CNs are interrupted if their CNs left and they are idle.
+		require.NoError(t, executor.AppendStringRows(mr, 0, []string{"running", "stopped", "left", "idle", "alive"}))
+		require.NoError(t, executor.AppendStringRows(mr, 1, []string{"db", "db", "db", "db", "db"}))
+		require.NoError(t, executor.AppendStringRows(mr, 2, []string{"t0", "t1", "t2", "t3", "t4"}))
+		require.NoError(t, executor.AppendStringRows(mr, 3, []string{"cn1:18000", "cn1:18000", "cn3:18000", "cn3:18000", "cn2:18000"}))
+		require.NoError(t, executor.AppendFixedRows(mr, 4, []int64{3600, 3600, 3600, 10, 3600}))
+		return mr.GetResult(), nil
+	})
+
+	o := &onlineDDL{id: "new", cnAddr: "cn1:18000"}
+	o.dropInterrupted(context.Background(), exec)
+	require.Equal(t, []string{
+		fmt.Sprintf(getUnfinishedMoOnlineDDLFormat, onlineDDLPhaseCopying, onlineDDLPhaseCatchingUp, onlineDDLPhaseSwitching),
+		"drop table if exists `db`.`t1`",
+		fmt.Sprintf(updateMoOnlineDDLPhaseFormat, onlineDDLPhaseFailed, "the job is interrupted", "stopped"),
+		"drop table if exists `db`.`t2`",
+		fmt.Sprintf(updateMoOnlineDDLPhaseFormat, onlineDDLPhaseFailed, "the job is interrupted", "left"),
+	}, executed)
+}
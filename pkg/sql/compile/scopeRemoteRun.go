@@ -197,10 +197,21 @@ func cnMessageHandle(receiver *messageReceiverOnServer) error {
 		return err
 
 	case pipeline.Method_PipelineMessage:
-		c := receiver.newCompile()
+		// the pipeline stops at the deadline of the statement, but the error
+		// is still sent back by receiver.ctx.
+		ctx := receiver.ctx
+		if deadline := receiver.procBuildHelper.deadline; deadline != 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, time.Unix(0, deadline))
+			defer cancel()
+		}
+		c := receiver.newCompile(ctx)
 		// decode and rewrite the scope.
 		s, err := decodeScope(receiver.scopeData, c.proc, true, c.e)
 		defer func() {
+			if quota := c.proc.Mp().Quota(); quota != nil {
+				quota.Release()
+			}
 			c.proc.AnalInfos = nil
 			c.anal.analInfos = nil
 			c.Release()
@@ -89,6 +89,8 @@ type processHelper struct {
 	sessionInfo      process.SessionInfo
 	analysisNodeList []int32
 	StmtId           uuid.UUID
+	// deadline is the unix nano time the pipeline must finish by, 0 means none.
+	deadline int64
 }
 
 // messageSenderOnClient is a structure
@@ -355,16 +357,22 @@ func (receiver *messageReceiverOnServer) acquireMessage() (*pipeline.Message, er
 	return message, nil
 }
 
-// newCompile make and return a new compile to run a pipeline.
-func (receiver *messageReceiverOnServer) newCompile() *Compile {
+// newCompile make and return a new compile to run a pipeline under the ctx.
+func (receiver *messageReceiverOnServer) newCompile(ctx context.Context) *Compile {
 	// compile is almost surely wanting a small or middle pool.  Later.
 	mp, err := mpool.NewMPool("compile", 0, mpool.NoFixed)
 	if err != nil {
 		panic(err)
 	}
 	pHelper, cnInfo := receiver.procBuildHelper, receiver.cnInformation
+	// the pipeline has the memory quota of the statement on this CN, which
+	// charges the memory budget of its resource group on this CN too
+	budget := mpool.GroupQuota(pHelper.lim.ResourceGroup, pHelper.lim.MemoryBudget)
+	if pHelper.lim.MemoryQuota > 0 || budget != nil {
+		mp.SetQuota(mpool.NewChildQuota(pHelper.lim.MemoryQuota, budget))
+	}
 	proc := process.New(
-		receiver.ctx,
+		ctx,
 		mp,
 		pHelper.txnClient,
 		pHelper.txnOperator,
@@ -519,6 +527,7 @@ func generateProcessHelper(data []byte, cli client.TxnClient) (processHelper, er
 		accountId:        procInfo.AccountId,
 		txnClient:        cli,
 		analysisNodeList: procInfo.GetAnalysisNodeList(),
+		deadline:         procInfo.GetDeadline(),
 	}
 	result.txnOperator, err = cli.NewWithSnapshot(procInfo.Snapshot)
 	if err != nil {
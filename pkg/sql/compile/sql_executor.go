@@ -158,11 +158,12 @@ func (s *sqlExecutor) maybeWaitCommittedLogApplied(opts executor.Options) {
 func (s *sqlExecutor) getCompileContext(
 	ctx context.Context,
 	proc *process.Process,
+	eng engine.Engine,
 	db string) *compilerContext {
 	return newCompilerContext(
 		ctx,
 		db,
-		s.eng,
+		eng,
 		proc)
 }
 
@@ -296,13 +297,14 @@ func (exec *txnExecutor) Exec(
 	proc.SetVectorPoolSize(0)
 	proc.SessionInfo.TimeZone = exec.opts.GetTimeZone()
 	proc.SessionInfo.Buf = exec.s.buf
-	proc.SessionInfo.StorageEngine = exec.s.eng
+	eng := exec.getEngine()
+	proc.SessionInfo.StorageEngine = eng
 	defer func() {
 		proc.CleanValueScanBatchs()
 		proc.FreeVectors()
 	}()
 
-	compileContext := exec.s.getCompileContext(exec.ctx, proc, exec.getDatabase())
+	compileContext := exec.s.getCompileContext(exec.ctx, proc, eng, exec.getDatabase())
 	compileContext.SetRootSql(sql)
 
 	pn, err := plan.BuildPlan(compileContext, stmts[0], false)
@@ -310,12 +312,14 @@ func (exec *txnExecutor) Exec(
 		return executor.Result{}, err
 	}
 
-	c := NewCompile(exec.s.addr, exec.getDatabase(), sql, "", "", exec.ctx, exec.s.eng, proc, stmts[0], false, nil, receiveAt)
+	// the statements of the internal executor are internal, they are not queued
+	// by the admission control, which may be waiting for them
+	c := NewCompile(exec.s.addr, exec.getDatabase(), sql, "", "", exec.ctx, eng, proc, stmts[0], true, nil, receiveAt)
 	defer c.Release()
 	c.disableRetry = exec.opts.DisableIncrStatement()
 	c.SetBuildPlanFunc(func() (*plan.Plan, error) {
 		return plan.BuildPlan(
-			exec.s.getCompileContext(exec.ctx, proc, exec.getDatabase()),
+			exec.s.getCompileContext(exec.ctx, proc, eng, exec.getDatabase()),
 			stmts[0], false)
 	})
 
@@ -415,6 +419,18 @@ func (exec *txnExecutor) rollback(err error) error {
 	return errors.Join(err, exec.opts.Txn().Rollback(exec.ctx))
 }
 
+// getEngine returns the engine to execute the sql, the temporary tables of a
+// session are only visible if the temporary engine is set in the options.
+func (exec *txnExecutor) getEngine() engine.Engine {
+	if tempEngine := exec.opts.TempEngine(); tempEngine != nil {
+		return &engine.EntireEngine{
+			Engine:     exec.s.eng,
+			TempEngine: tempEngine,
+		}
+	}
+	return exec.s.eng
+}
+
 func (exec *txnExecutor) getDatabase() string {
 	if exec.database != "" {
 		return exec.database
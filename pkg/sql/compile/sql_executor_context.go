@@ -353,6 +353,16 @@ func (c *compilerContext) getRelation(
 
 	table, err := db.Relation(ctx, tableName, nil)
 	if err != nil {
+		// the temporary tables are visible if the engine of the temporary tables is set
+		if entireEngine, ok := c.engine.(*engine.EntireEngine); ok && entireEngine.TempEngine != nil {
+			tmpDB, e := entireEngine.Database(ctx, defines.TEMPORARY_DBNAME, txnOpt)
+			if e != nil {
+				return nil, nil, err
+			}
+			if table, e = tmpDB.Relation(ctx, engine.GetTempTableName(dbName, tableName), nil); e == nil {
+				return ctx, table, nil
+			}
+		}
 		return nil, nil, err
 	}
 	return ctx, table, nil
@@ -65,6 +65,7 @@ const (
 	DropSequence
 	AlterSequence
 	Replace
+	RefreshMaterializedView
 )
 
 // Source contains information of a relation which will be used in execution.
@@ -287,6 +288,9 @@ type Compile struct {
 	disableRetry bool
 
 	lastAllocID int32
+
+	// ivfProbe widens the probe of the pre-filtered vector search of the query.
+	ivfProbe *ivfProbe
 }
 
 type RemoteReceivRegInfo struct {
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -84,6 +85,50 @@ var (
 	//deleteMoTablePartitionsWithTableIdAndIndexNameFormat = `delete from mo_catalog.mo_table_partitions where table_id = %v and name = '%s';`
 )
 
+var (
+	// the auto analyze option and the last analyze of the table are kept when its table id changes
+	updateMoTableAnalyzeTableIdFormat = `update mo_catalog.mo_table_analyze set table_id = %v where table_id = %v;`
+	// the auto analyze option of the table set by STATS_AUTO_RECALC
+	upsertMoTableAnalyzeAutoAnalyzeFormat = `insert into mo_catalog.mo_table_analyze (table_id, auto_analyze) values (%v, %v) on duplicate key update auto_analyze = %v;`
+)
+
+var (
+	insertMoMviewsFormat = "insert into mo_catalog.mo_mviews(database_name, mv_name, mv_query, query_database, refresh_mode, refresh_cron, last_refresh_ts, created_time) " +
+		"values ('%s', '%s', '%s', '%s', '%s', '%s', '', current_timestamp());"
+	selectMoMviewsFormat             = "select mv_query, query_database, refresh_mode, last_refresh_ts from mo_catalog.mo_mviews where database_name = '%s' and mv_name = '%s';"
+	updateMoMviewsLastRefreshFormat  = "update mo_catalog.mo_mviews set last_refresh_ts = '%s' where database_name = '%s' and mv_name = '%s';"
+	deleteMoMviewsFormat             = "delete from mo_catalog.mo_mviews where database_name = '%s' and mv_name = '%s';"
+	deleteMoMviewsWithDatabaseFormat = "delete from mo_catalog.mo_mviews where database_name = '%s';"
+)
+
+var (
+	deleteMoTriggersFormat             = "delete from mo_catalog.mo_triggers where database_name = '%s' and table_name = '%s';"
+	deleteMoTriggersWithDatabaseFormat = "delete from mo_catalog.mo_triggers where database_name = '%s';"
+	updateMoTriggersTableNameFormat    = "update mo_catalog.mo_triggers set table_name = '%s' where database_name = '%s' and table_name = '%s';"
+)
+
+var (
+	insertMoOnlineDDLFormat        = "insert into mo_catalog.mo_online_ddl(job_id, database_name, table_name, target_name, cn_addr, statement, phase, rows_total, rows_copied, changes_replayed, rounds, error_message, start_time, update_time) values ('%s', '%s', '%s', '%s', '%s', '%s', '%s', %d, 0, 0, 0, '', current_timestamp(), current_timestamp());"
+	updateMoOnlineDDLFormat        = "update mo_catalog.mo_online_ddl set phase = '%s', rows_copied = %d, changes_replayed = %d, rounds = %d, error_message = '%s', update_time = current_timestamp() where job_id = '%s';"
+	updateMoOnlineDDLPhaseFormat   = "update mo_catalog.mo_online_ddl set phase = '%s', error_message = '%s', update_time = current_timestamp() where job_id = '%s';"
+	getUnfinishedMoOnlineDDLFormat = "select job_id, database_name, target_name, cn_addr, timestampdiff(second, update_time, current_timestamp()) from mo_catalog.mo_online_ddl where phase in ('%s', '%s', '%s');"
+)
+
+var (
+	deleteMoEventsWithDatabaseFormat       = "delete from mo_catalog.mo_events where database_name = '%s';"
+	deleteMoEventHistoryWithDatabaseFormat = "delete from mo_catalog.mo_event_history where database_name = '%s';"
+)
+
+// triggersDisabled reports whether the tables in the database can not have triggers.
+func triggersDisabled(dbName string) bool {
+	switch dbName {
+	case catalog.MO_CATALOG, catalog.MO_SYSTEM, catalog.MO_SYSTEM_METRICS, catalog.MOTaskDB,
+		"information_schema", "mysql":
+		return true
+	}
+	return false
+}
+
 // genCreateIndexTableSql: Generate ddl statements for creating index table
 func genCreateIndexTableSql(indexTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string) string {
 	var sql string
@@ -154,6 +199,10 @@ func genCreateIndexTableSqlForIvfIndex(indexTableDef *plan.TableDef, indexDef *p
 			sql += fmt.Sprintf("VECF32(%d)", planCol.Typ.Width)
 		case types.T_array_float64:
 			sql += fmt.Sprintf("VECF64(%d)", planCol.Typ.Width)
+		case types.T_array_int8:
+			sql += fmt.Sprintf("VECI8(%d)", planCol.Typ.Width)
+		case types.T_array_bit:
+			sql += fmt.Sprintf("VECBIT(%d)", planCol.Typ.Width)
 		default:
 			sql += typeId.String()
 		}
@@ -208,6 +257,15 @@ func genInsertIndexTableSql(originTableDef *plan.TableDef, indexDef *plan.IndexD
 	return insertSQL
 }
 
+// genInsertIndexTableSqlWithFilter: Generate an insert statement for inserting the rows matching the filter into the index table
+func genInsertIndexTableSqlWithFilter(originTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string, isUnique bool, filter string) string {
+	insertSQL := strings.TrimSuffix(genInsertIndexTableSql(originTableDef, indexDef, DBName, isUnique), ";")
+	if strings.HasSuffix(insertSQL, "is not null") {
+		return insertSQL + " and " + filter + ";"
+	}
+	return insertSQL + " where " + filter + ";"
+}
+
 // genInsertIndexTableSqlForMasterIndex: Create inserts for master index table
 func genInsertIndexTableSqlForMasterIndex(originTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string) []string {
 	// insert data into index table
@@ -436,7 +494,7 @@ func makeInsertTablePartitionsSQL(ctx context.Context, dbSource engine.Database,
 				return "", nil
 			}
 
-			insertMoTablePartitionSql := genInsertMoTablePartitionsSql(databaseId, tableId, partitionByDef, partitionByDef.Partitions)
+			insertMoTablePartitionSql := genInsertMoTablePartitionsSql(databaseId, tableId, partitionByDef, partitionByDef.PartitionTableItems())
 			return insertMoTablePartitionSql, nil
 		}
 	}
@@ -0,0 +1,210 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// hintArgs is the number of the arguments the hints accept, -1 means any.
+var hintArgs = map[string][2]int{
+	tree.HintJoinOrder:        {1, -1},
+	tree.HintHashJoin:         {0, -1},
+	tree.HintNoShuffle:        {0, 0},
+	tree.HintUseIndex:         {1, -1},
+	tree.HintForceOneCN:       {0, 0},
+	tree.HintMaxExecutionTime: {1, 1},
+}
+
+// isHintKeyword returns whether the optimizer hints can follow the token.
+func isHintKeyword(typ int) bool {
+	switch typ {
+	case SELECT, INSERT, REPLACE, UPDATE, DELETE:
+		return true
+	}
+	return false
+}
+
+// HintComment is an optimizer hint comment following the HintKeyword-th keyword
+// which can be followed by the hints.
+type HintComment struct {
+	Keyword int
+	Text    string
+}
+
+// outermostSelectClause returns the SELECT clause of the statement which is
+// not in a subquery or a set operation, its hints are the hints of the statement.
+func outermostSelectClause(node any) *tree.SelectClause {
+	switch s := node.(type) {
+	case *tree.SelectClause:
+		return s
+	case *tree.Select:
+		if s == nil {
+			return nil
+		}
+		return outermostSelectClause(s.Select)
+	case *tree.ParenSelect:
+		return outermostSelectClause(s.Select)
+	case *tree.ExplainStmt:
+		return outermostSelectClause(s.Statement)
+	case *tree.ExplainAnalyze:
+		return outermostSelectClause(s.Statement)
+	case *tree.CreatePlanBaseline:
+		return outermostSelectClause(s.Stmt)
+	case *tree.PrepareStmt:
+		return outermostSelectClause(s.Stmt)
+	}
+	return nil
+}
+
+// ParseOptimizerHints parses the hints formatted by tree.OptimizerHints, like
+// "/*+ JOIN_ORDER(t1, t2) */".
+func ParseOptimizerHints(text string) *tree.OptimizerHints {
+	text = strings.TrimSpace(text)
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/*+"), "*/")
+	return parseOptimizerHints(text)
+}
+
+// parseOptimizerHints parses the text in the /*+ ... */ comment, like
+// "JOIN_ORDER(t1, t2) NO_SHUFFLE". Like MySQL, the hints not recognized are
+// ignored with a warning, and a syntax error ignores the rest of the comment.
+func parseOptimizerHints(text string) *tree.OptimizerHints {
+	hints := &tree.OptimizerHints{}
+	p := hintParser{text: text}
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return hints
+		}
+		start := p.pos
+		name, ok := p.name()
+		if !ok {
+			hints.Warnings = append(hints.Warnings, p.syntaxError(start))
+			return hints
+		}
+		hint := &tree.OptimizerHint{Name: strings.ToUpper(name)}
+		p.skipBlank()
+		if p.peek() == '(' {
+			p.pos++
+			if hint.Args, ok = p.args(); !ok {
+				hints.Warnings = append(hints.Warnings, p.syntaxError(start))
+				return hints
+			}
+		}
+
+		args, ok := hintArgs[hint.Name]
+		switch {
+		case !ok:
+			hints.Warnings = append(hints.Warnings,
+				fmt.Sprintf("Unrecognized optimizer hint '%s' is ignored", name))
+		case len(hint.Args) < args[0] || (args[1] >= 0 && len(hint.Args) > args[1]):
+			hints.Warnings = append(hints.Warnings,
+				fmt.Sprintf("Wrong number of arguments for optimizer hint '%s', it is ignored", hint.Name))
+		case hint.Name == tree.HintMaxExecutionTime && !isHintUint32(hint.Args[0]):
+			hints.Warnings = append(hints.Warnings,
+				fmt.Sprintf("Invalid argument '%s' for optimizer hint '%s', it is ignored", hint.Args[0], hint.Name))
+		default:
+			hints.Hints = append(hints.Hints, hint)
+		}
+	}
+}
+
+// isHintUint32 returns whether the argument is a number of uint32, like the
+// milliseconds of MAX_EXECUTION_TIME.
+func isHintUint32(arg string) bool {
+	_, err := strconv.ParseUint(arg, 10, 32)
+	return err == nil
+}
+
+type hintParser struct {
+	text string
+	pos  int
+}
+
+func (p *hintParser) eof() bool {
+	return p.pos >= len(p.text)
+}
+
+func (p *hintParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+func (p *hintParser) skipBlank() {
+	for !p.eof() {
+		switch p.peek() {
+		case ' ', '\n', '\r', '\t':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// name scans an identifier, a quoted one or a qualified one like db.t.
+func (p *hintParser) name() (string, bool) {
+	if p.peek() == '`' {
+		end := strings.IndexByte(p.text[p.pos+1:], '`')
+		if end <= 0 {
+			return "", false
+		}
+		name := p.text[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return name, true
+	}
+	start := p.pos
+	for !p.eof() {
+		ch := uint16(p.peek())
+		if !isLetter(ch) && !isDigit(ch) && ch != '.' && ch != '@' {
+			break
+		}
+		p.pos++
+	}
+	return p.text[start:p.pos], p.pos > start
+}
+
+// args scans the arguments separated by commas or blanks until ')'.
+func (p *hintParser) args() ([]string, bool) {
+	var args []string
+	for {
+		p.skipBlank()
+		switch p.peek() {
+		case ')':
+			p.pos++
+			return args, true
+		case ',':
+			if len(args) == 0 {
+				return nil, false
+			}
+			p.pos++
+			continue
+		}
+		arg, ok := p.name()
+		if !ok {
+			return nil, false
+		}
+		args = append(args, arg)
+	}
+}
+
+func (p *hintParser) syntaxError(start int) string {
+	return fmt.Sprintf("Optimizer hint syntax error near '%s'", strings.TrimSpace(p.text[start:]))
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func TestParseOptimizerHints(t *testing.T) {
+	hints := parseOptimizerHints(" JOIN_ORDER(a, b) hash_join(`t 1`) NO_SHUFFLE USE_INDEX(t idx1 idx2) FORCE_ONE_CN ")
+	require.Empty(t, hints.Warnings)
+	require.Equal(t, "/*+ JOIN_ORDER(a, b) HASH_JOIN(t 1) NO_SHUFFLE USE_INDEX(t, idx1, idx2) FORCE_ONE_CN */",
+		tree.String(hints, dialect.MYSQL))
+
+	hints = parseOptimizerHints("FOO(a) NO_SHUFFLE(t) JOIN_ORDER() HASH_JOIN(t")
+	require.Empty(t, hints.Hints)
+	require.Equal(t, []string{
+		"Unrecognized optimizer hint 'FOO' is ignored",
+		"Wrong number of arguments for optimizer hint 'NO_SHUFFLE', it is ignored",
+		"Wrong number of arguments for optimizer hint 'JOIN_ORDER', it is ignored",
+		"Optimizer hint syntax error near 'HASH_JOIN(t'",
+	}, hints.Warnings)
+}
+
+func TestParseStatementHints(t *testing.T) {
+	stmts, err := Parse(context.TODO(),
+		"select /*+ NO_SHUFFLE */ * from t; select /* NO_SHUFFLE */ 1; "+
+			"insert into t select /*+ FORCE_ONE_CN */ * from s; select 1 /*+ NO_SHUFFLE */", 1)
+	require.NoError(t, err)
+	require.Len(t, stmts, 4)
+
+	hints := tree.GetOptimizerHints(stmts[0])
+	require.NotNil(t, hints)
+	require.Equal(t, tree.HintNoShuffle, hints.Hints[0].Name)
+	require.Nil(t, tree.GetOptimizerHints(stmts[1]))
+	// the hints of the SELECT of INSERT belong to its query block
+	require.Nil(t, tree.GetOptimizerHints(stmts[2]))
+	clause := stmts[2].(*tree.Insert).Rows.Select.(*tree.SelectClause)
+	require.NotNil(t, clause.Hints)
+	require.Equal(t, tree.HintForceOneCN, clause.Hints.Hints[0].Name)
+	// the hints must follow the first keyword
+	require.Nil(t, tree.GetOptimizerHints(stmts[3]))
+}
+
+func TestQueryBlockHints(t *testing.T) {
+	stmt, err := ParseOne(context.TODO(),
+		"select /*+ NO_SHUFFLE */ * from t where a in (select /*+ HASH_JOIN(s) FOO */ a from s, u where s.b = u.b)", 1)
+	require.NoError(t, err)
+	hints := tree.GetOptimizerHints(stmt)
+	require.NotNil(t, hints)
+	require.Len(t, hints.Hints, 1)
+	require.Equal(t, tree.HintNoShuffle, hints.Hints[0].Name)
+	// the warnings of the subqueries are reported by the statement
+	require.Equal(t, []string{"Unrecognized optimizer hint 'FOO' is ignored"}, hints.Warnings)
+	clause := stmt.(*tree.Select).Select.(*tree.SelectClause)
+	require.Nil(t, clause.Hints)
+	subquery := clause.Where.Expr.(*tree.ComparisonExpr).Right.(*tree.Subquery).Select.(*tree.ParenSelect).Select.Select.(*tree.SelectClause)
+	require.NotNil(t, subquery.Hints)
+	require.Equal(t, "/*+ HASH_JOIN(s) */", tree.String(subquery.Hints, dialect.MYSQL))
+
+	stmt, err = ParseOne(context.TODO(),
+		"select * from (select /*+ JOIN_ORDER(b, a) */ * from a, b) x, (select /*+ USE_INDEX(c idx) */ * from c) y", 1)
+	require.NoError(t, err)
+	require.Nil(t, tree.GetOptimizerHints(stmt))
+	require.Equal(t, "select * from (select /*+ JOIN_ORDER(b, a) */ * from a cross join b) as x cross join (select /*+ USE_INDEX(c, idx) */ * from c) as y",
+		tree.String(stmt, dialect.MYSQL))
+
+	stmt, err = ParseOne(context.TODO(), "prepare s from select /*+ HASH_JOIN(t) */ * from t", 1)
+	require.NoError(t, err)
+	hints = tree.GetOptimizerHints(stmt)
+	require.NotNil(t, hints)
+	require.Equal(t, tree.HintHashJoin, hints.Hints[0].Name)
+}
+
+func TestPlanBaselineHints(t *testing.T) {
+	hints := ParseOptimizerHints("/*+ JOIN_ORDER(b, a) USE_INDEX(a, idx1) USE_INDEX(b) */")
+	require.Empty(t, hints.Warnings)
+	require.Equal(t, "/*+ JOIN_ORDER(b, a) USE_INDEX(a, idx1) USE_INDEX(b) */", tree.String(hints, dialect.MYSQL))
+
+	stmt, err := ParseOne(context.TODO(), "create plan baseline for select /*+ JOIN_ORDER(b, a) */ * from a, b where a.x = b.y and a.z = 'abc'", 1)
+	require.NoError(t, err)
+	hints = tree.GetOptimizerHints(stmt)
+	require.NotNil(t, hints)
+	require.Equal(t, tree.HintJoinOrder, hints.Hints[0].Name)
+	require.Equal(t, "select * from a cross join b where a.x = b.y and a.z = ?",
+		tree.StringWithOpts(stmt.(*tree.CreatePlanBaseline).Stmt, dialect.MYSQL, tree.WithNormalizeValues()))
+}
+
+func TestMaxExecutionTimeHint(t *testing.T) {
+	hints := parseOptimizerHints("MAX_EXECUTION_TIME(1000)")
+	require.Empty(t, hints.Warnings)
+	require.Equal(t, "/*+ MAX_EXECUTION_TIME(1000) */", tree.String(hints, dialect.MYSQL))
+
+	hints = parseOptimizerHints("MAX_EXECUTION_TIME(abc) MAX_EXECUTION_TIME(4294967296) MAX_EXECUTION_TIME()")
+	require.Empty(t, hints.Hints)
+	require.Equal(t, []string{
+		"Invalid argument 'abc' for optimizer hint 'MAX_EXECUTION_TIME', it is ignored",
+		"Invalid argument '4294967296' for optimizer hint 'MAX_EXECUTION_TIME', it is ignored",
+		"Wrong number of arguments for optimizer hint 'MAX_EXECUTION_TIME', it is ignored",
+	}, hints.Warnings)
+}
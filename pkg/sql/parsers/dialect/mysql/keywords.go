@@ -33,6 +33,7 @@ func init() {
 		"any":                        ANY,
 		"as":                         AS,
 		"asc":                        ASC,
+		"at":                         AT,
 		"ascii":                      ASCII,
 		"asensitive":                 UNUSED,
 		"auto_increment":             AUTO_INCREMENT,
@@ -41,7 +42,7 @@ func init() {
 		"avg_row_length":             AVG_ROW_LENGTH,
 		"avg":                        AVG,
 		"bsi":                        BSI,
-		"before":                     UNUSED,
+		"before":                     BEFORE,
 		"begin":                      BEGIN,
 		"between":                    BETWEEN,
 		"bigint":                     BIGINT,
@@ -86,18 +87,25 @@ func init() {
 		"committed":                  COMMITTED,
 		"commit":                     COMMIT,
 		"compact":                    COMPACT,
-		"condition":                  UNUSED,
+		"condition":                  CONDITION,
 		"constraint":                 CONSTRAINT,
 		"consistent":                 CONSISTENT,
-		"continue":                   UNUSED,
+		"continue":                   CONTINUE,
 		"connection":                 CONNECTION,
 		"connect":                    CONNECT,
 		"convert":                    CONVERT,
 		"config":                     CONFIG,
 		"connector":                  CONNECTOR,
+		"complete":                   COMPLETE,
+		"completion":                 COMPLETION,
+		"every":                      EVERY,
+		"incremental":                INCREMENTAL,
+		"materialized":               MATERIALIZED,
+		"refresh":                    REFRESH,
 		"connectors":                 CONNECTORS,
 		"cipher":                     CIPHER,
 		"chain":                      CHAIN,
+		"close":                      CLOSE,
 		"client":                     CLIENT,
 		"san":                        SAN,
 		"strict":                     STRICT,
@@ -119,7 +127,7 @@ func init() {
 		"current_user":               CURRENT_USER,
 		"current_role":               CURRENT_ROLE,
 		"curtime":                    CURTIME,
-		"cursor":                     UNUSED,
+		"cursor":                     CURSOR,
 		"daemon":                     DAEMON,
 		"database":                   DATABASE,
 		"databases":                  DATABASES,
@@ -160,10 +168,12 @@ func init() {
 		"duplicate":                  DUPLICATE,
 		"delay_key_write":            DELAY_KEY_WRITE,
 		"drainer":                    DRAINER,
-		"each":                       UNUSED,
+		"dot":                        DOT,
+		"each":                       EACH,
 		"else":                       ELSE,
 		"elseif":                     ELSEIF,
 		"enclosed":                   ENCLOSED,
+		"ends":                       ENDS,
 		"encryption":                 ENCRYPTION,
 		"engine":                     ENGINE,
 		"end":                        END,
@@ -172,7 +182,7 @@ func init() {
 		"escape":                     ESCAPE,
 		"escaped":                    ESCAPED,
 		"exists":                     EXISTS,
-		"exit":                       UNUSED,
+		"exit":                       EXIT,
 		"explain":                    EXPLAIN,
 		"expansion":                  EXPANSION,
 		"extended":                   EXTENDED,
@@ -184,7 +194,7 @@ func init() {
 		"events":                     EVENTS,
 		"engines":                    ENGINES,
 		"false":                      FALSE,
-		"fetch":                      UNUSED,
+		"fetch":                      FETCH,
 		"first":                      FIRST,
 		"after":                      AFTER,
 		"float":                      FLOAT_TYPE,
@@ -194,6 +204,7 @@ func init() {
 		"force":                      FORCE,
 		"foreign":                    FOREIGN,
 		"format":                     FORMAT,
+		"found":                      FOUND,
 		"from":                       FROM,
 		"full":                       FULL,
 		"fulltext":                   FULLTEXT,
@@ -201,6 +212,7 @@ func init() {
 		"fields":                     FIELDS,
 		"file":                       FILE,
 		"fixed":                      FIXED,
+		"follows":                    FOLLOWS,
 		"generated":                  UNUSED,
 		"geometry":                   GEOMETRY,
 		"geometrycollection":         GEOMETRYCOLLECTION,
@@ -296,6 +308,8 @@ func init() {
 		"month":                      MONTH,
 		"mode":                       MODE,
 		"memory":                     MEMORY,
+		"message_text":               MESSAGE_TEXT,
+		"mysql_errno":                MYSQL_ERRNO,
 		"modifies":                   UNUSED,
 		"multilinestring":            MULTILINESTRING,
 		"multipoint":                 MULTIPOINT,
@@ -352,6 +366,7 @@ func init() {
 		"pack_keys":                  PACK_KEYS,
 		"point":                      POINT,
 		"polygon":                    POLYGON,
+		"precedes":                   PRECEDES,
 		"precision":                  UNUSED,
 		"primary":                    PRIMARY,
 		"processlist":                PROCESSLIST,
@@ -383,7 +398,7 @@ func init() {
 		"replace":                    REPLACE,
 		"replication":                REPLICATION,
 		"require":                    REQUIRE,
-		"resignal":                   UNUSED,
+		"resignal":                   RESIGNAL,
 		"restrict":                   RESTRICT,
 		"resume":                     RESUME,
 		"recursive":                  RECURSIVE,
@@ -402,6 +417,7 @@ func init() {
 		"row_number":                 ROW_NUMBER,
 		"rtree":                      RTREE,
 		"schema":                     SCHEMA,
+		"schedule":                   SCHEDULE,
 		"schemas":                    SCHEMAS,
 		"second":                     SECOND,
 		"select":                     SELECT,
@@ -413,16 +429,16 @@ func init() {
 		"share":                      SHARE,
 		"show":                       SHOW,
 		"shutdown":                   SHUTDOWN,
-		"signal":                     UNUSED,
+		"signal":                     SIGNAL,
 		"signed":                     SIGNED,
 		"simple":                     SIMPLE,
 		"smallint":                   SMALLINT,
 		"spatial":                    SPATIAL,
 		"specific":                   UNUSED,
 		"sql":                        SQL,
-		"sqlexception":               UNUSED,
-		"sqlstate":                   UNUSED,
-		"sqlwarning":                 UNUSED,
+		"sqlexception":               SQLEXCEPTION,
+		"sqlstate":                   SQLSTATE,
+		"sqlwarning":                 SQLWARNING,
 		"sql_big_result":             SQL_BIG_RESULT,
 		"sql_cache":                  SQL_CACHE,
 		"sql_calc_found_rows":        UNUSED,
@@ -434,6 +450,7 @@ func init() {
 		"sliding":                    SLIDING,
 		"start":                      START,
 		"starting":                   STARTING,
+		"starts":                     STARTS,
 		"status":                     STATUS,
 		"stats_auto_recalc":          STATS_AUTO_RECALC,
 		"stats_persistent":           STATS_PERSISTENT,
@@ -498,6 +515,7 @@ func init() {
 		"with":                       WITH,
 		"without":                    WITHOUT,
 		"validation":                 VALIDATION,
+		"exchange":                   EXCHANGE,
 		"write":                      WRITE,
 		"warnings":                   WARNINGS,
 		"work":                       WORK,
@@ -581,6 +599,18 @@ func init() {
 		"modump":                     MODUMP,
 		"low_cardinality":            LOW_CARDINALITY,
 		"preceding":                  PRECEDING,
+		"preserve":                   PRESERVE,
+		"plan":                       PLAN,
+		"baseline":                   BASELINE,
+		"baselines":                  BASELINES,
+		"pin":                        PIN,
+		"unpin":                      UNPIN,
+		"evolve":                     EVOLVE,
+		"resource":                   RESOURCE,
+		"cpu_share":                  CPU_SHARE,
+		"max_concurrency":            MAX_CONCURRENCY,
+		"memory_budget":              MEMORY_BUDGET,
+		"matched":                    MATCHED,
 		"following":                  FOLLOWING,
 		"fill":                       FILL,
 		"groups":                     GROUPS,
@@ -591,6 +621,7 @@ func init() {
 		"kmeans":                     KMEANS,
 		"column_number":              COLUMN_NUMBER,
 		"returns":                    RETURNS,
+		"returning":                  RETURNING,
 		"extension":                  EXTENSION,
 		"query_result":               QUERY_RESULT,
 		"mysql_compatibility_mode":   MYSQL_COMPATIBILITY_MODE,
@@ -615,6 +646,8 @@ func init() {
 		"stages":                     STAGES,
 		"credentials":                CREDENTIALS,
 		"vecf32":                     VECF32,
+		"veci8":                      VECI8,
+		"vecbit":                     VECBIT,
 		"vecf64":                     VECF64,
 		"backup":                     BACKUP,
 		"filesystem":                 FILESYSTEM,
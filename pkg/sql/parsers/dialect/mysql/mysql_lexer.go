@@ -71,6 +71,12 @@ type Lexer struct {
 	stmts      []tree.Statement
 	paramIndex int
 	lower      int64
+
+	// selects are the SELECT keywords of the query blocks not reduced yet, see
+	// Scanner.HintKeyword.
+	selects []int
+	// hintWarnings are the warnings of the hints of the statement.
+	hintWarnings []string
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string, lower int64) *Lexer {
@@ -89,6 +95,13 @@ func (l *Lexer) GetParamIndex() int {
 func (l *Lexer) Lex(lval *yySymType) int {
 	typ, str := l.scanner.Scan()
 	l.scanner.LastToken = str
+	l.scanner.HintFlag = isHintKeyword(typ)
+	if l.scanner.HintFlag {
+		l.scanner.HintKeyword++
+		if typ == SELECT {
+			l.selects = append(l.selects, l.scanner.HintKeyword)
+		}
+	}
 
 	switch typ {
 	case INTEGRAL:
@@ -161,9 +174,67 @@ func (l *Lexer) Error(err string) {
 }
 
 func (l *Lexer) AppendStmt(stmt tree.Statement) {
+	// the hints of the outermost SELECT clause and the hints not taken by the
+	// query blocks belong to the statement, the parser only looks ahead the ';'
+	// or the end of the input before the statement is appended.
+	var hints *tree.OptimizerHints
+	if clause := outermostSelectClause(stmt); clause != nil && clause.Hints != nil {
+		hints = &tree.OptimizerHints{Hints: clause.Hints.Hints}
+		clause.Hints = nil
+	}
+	if rest := l.takeHints(0); rest != nil {
+		if hints == nil {
+			hints = &tree.OptimizerHints{}
+		}
+		hints.Hints = append(hints.Hints, rest.Hints...)
+	}
+	// the warnings of all the query blocks are reported by the statement
+	if len(l.hintWarnings) > 0 {
+		if hints == nil {
+			hints = &tree.OptimizerHints{}
+		}
+		hints.Warnings = l.hintWarnings
+		l.hintWarnings = nil
+	}
+	if hints != nil {
+		tree.SetOptimizerHints(stmt, hints)
+	}
+	l.selects = l.selects[:0]
 	l.stmts = append(l.stmts, stmt)
 }
 
+// takeSelectHints returns the hints of the SELECT clause reduced, it is the
+// last SELECT not reduced yet since the query blocks are nested.
+func (l *Lexer) takeSelectHints() *tree.OptimizerHints {
+	if len(l.selects) == 0 {
+		return nil
+	}
+	keyword := l.selects[len(l.selects)-1]
+	l.selects = l.selects[:len(l.selects)-1]
+	return l.takeHints(keyword)
+}
+
+// takeHints takes the hints following the keyword, or all the hints if the
+// keyword is 0.
+func (l *Lexer) takeHints(keyword int) *tree.OptimizerHints {
+	var hints *tree.OptimizerHints
+	rest := l.scanner.Hints[:0]
+	for _, comment := range l.scanner.Hints {
+		if keyword != 0 && comment.Keyword != keyword {
+			rest = append(rest, comment)
+			continue
+		}
+		if hints == nil {
+			hints = &tree.OptimizerHints{}
+		}
+		parsed := parseOptimizerHints(comment.Text)
+		hints.Append(parsed)
+		l.hintWarnings = append(l.hintWarnings, parsed.Warnings...)
+	}
+	l.scanner.Hints = rest
+	return hints
+}
+
 func (l *Lexer) toInt(lval *yySymType, str string) int {
 	ival, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
@@ -259,6 +259,28 @@ import (
 
     snapshotObject tree.ObjectInfo
 
+    mviewOption *tree.MaterializedViewOption
+    refreshMode tree.RefreshMode
+
+    triggerTiming tree.TriggerTiming
+    triggerEvent tree.TriggerEvent
+    triggerOrder *tree.TriggerOrder
+
+    conditionValue *tree.ConditionValue
+    conditionValues []*tree.ConditionValue
+    handlerAction tree.HandlerAction
+    signalInfo *tree.SignalInfo
+    signalInfos []*tree.SignalInfo
+    signalInfoItem tree.SignalInfoItem
+
+    eventSchedule *tree.EventSchedule
+    eventCompletion tree.EventCompletion
+    eventStatus tree.EventStatus
+    eventOptions tree.EventOptions
+    resourceGroupOptions tree.ResourceGroupOptions
+    mergeWhen *tree.MergeWhen
+    mergeWhens []*tree.MergeWhen
+    alterEvent *tree.AlterEvent
 }
 
 %token LEX_ERROR
@@ -320,7 +342,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64 VECI8 VECBIT
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8 S3OPTION STAGEOPTION
 
@@ -380,7 +402,7 @@ import (
 %token <str> MAX_QUERIES_PER_HOUR MAX_UPDATES_PER_HOUR MAX_CONNECTIONS_PER_HOUR MAX_USER_CONNECTIONS
 
 // Explain
-%token <str> FORMAT VERBOSE CONNECTION TRIGGERS PROFILES
+%token <str> FORMAT VERBOSE CONNECTION TRIGGERS PROFILES DOT
 
 // Load
 %token <str> LOAD INLINE INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES ROWS IMPORT DISCARD JSONTYPE
@@ -419,8 +441,29 @@ import (
 // Source
 %token <str> SOURCE STREAM HEADERS CONNECTOR CONNECTORS DAEMON PAUSE CANCEL TASK RESUME
 
+// Materialized view
+%token <str> MATERIALIZED REFRESH COMPLETE INCREMENTAL EVERY
+
+// Trigger
+%token <str> BEFORE EACH FOLLOWS PRECEDES
+
+// Event
+%token <str> SCHEDULE AT STARTS ENDS COMPLETION PRESERVE
+
+// Plan baseline
+%token <str> PLAN BASELINE BASELINES PIN UNPIN EVOLVE
+
+// Resource group
+%token <str> RESOURCE CPU_SHARE MAX_CONCURRENCY MEMORY_BUDGET
+
+// Merge
+%token <str> MATCHED
+
+// Returning
+%token <str> RETURNING
+
 // Match
-%token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
+%token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION EXCHANGE
 
 // upgrade
 %token <str> UPGRADE RETRY
@@ -455,6 +498,10 @@ import (
 // Iteration
 %token <str> LOOP WHILE LEAVE ITERATE UNTIL
 
+// Cursor, condition handling
+%token <str> CURSOR FETCH CLOSE CONDITION CONTINUE EXIT FOUND SQLSTATE SQLWARNING SQLEXCEPTION
+%token <str> SIGNAL RESIGNAL MESSAGE_TEXT MYSQL_ERRNO
+
 // Call
 %token <str> CALL
 
@@ -502,9 +549,41 @@ import (
 %type <statement> replace_stmt
 %type <statement> do_stmt
 %type <statement> declare_stmt
+%type <statement> open_cursor_stmt fetch_cursor_stmt close_cursor_stmt signal_stmt resignal_stmt handler_stmt
+%type <conditionValue> condition_value handler_condition signal_value
+%type <conditionValues> handler_condition_list
+%type <handlerAction> handler_action
+%type <signalInfos> signal_info_opt signal_info_list
+%type <signalInfo> signal_info
+%type <signalInfoItem> signal_info_item
+%type <str> value_opt
 %type <statement> values_stmt
 %type <statement> call_stmt
 %type <statement> mo_dump_stmt
+%type <statement> refresh_mview_stmt
+%type <mviewOption> mview_refresh_opt
+%type <refreshMode> mview_refresh_mode mview_refresh_mode_opt
+%type <statement> create_trigger_stmt drop_trigger_stmt trigger_body
+%type <triggerTiming> trigger_timing
+%type <triggerEvent> trigger_event
+%type <triggerOrder> trigger_order_opt
+%type <statement> create_event_stmt alter_event_stmt drop_event_stmt event_body event_body_opt
+%type <statement> create_plan_baseline_stmt alter_plan_baseline_stmt drop_plan_baseline_stmt
+%type <statement> create_resource_group_stmt alter_resource_group_stmt drop_resource_group_stmt
+%type <resourceGroupOptions> resource_group_options resource_group_options_opt
+%type <int64Val> resource_group_value
+%type <str> resource_group_user_opt
+%type <eventSchedule> event_schedule
+%type <eventCompletion> event_completion event_completion_opt
+%type <eventStatus> event_status_opt
+%type <eventOptions> event_options
+%type <statement> merge_stmt
+%type <mergeWhen> merge_when
+%type <mergeWhens> merge_when_list
+%type <expr> merge_cond_opt
+%type <identifierList> merge_insert_columns_opt
+%type <alterEvent> alter_event_tail
+%type <tableName> event_rename_opt
 %type <statement> load_extension_stmt
 %type <statement> kill_stmt
 %type <statement> backup_stmt snapshot_restore_stmt
@@ -539,7 +618,7 @@ import (
 
 %type <select> select_stmt select_no_parens
 %type <selectStatement> simple_select select_with_parens simple_select_clause
-%type <selectExprs> select_expression_list
+%type <selectExprs> select_expression_list returning_opt
 %type <selectExpr> select_expression
 %type <tableExprs> table_name_wild_list
 %type <joinTableExpr>  table_references join_table
@@ -723,7 +802,7 @@ import (
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
-%type <boolVal> local_opt
+%type <boolVal> local_opt without_validation_opt
 %type <duplicateKey> duplicate_opt
 %type <fields> load_fields field_item export_fields
 %type <fieldsList> field_item_list
@@ -858,6 +937,11 @@ block_type_stmt:
 |   leave_stmt
 |   normal_stmt
 |   declare_stmt
+|   open_cursor_stmt
+|   fetch_cursor_stmt
+|   close_cursor_stmt
+|   signal_stmt
+|   resignal_stmt
     {
         $$ = $1
     }
@@ -866,6 +950,115 @@ block_type_stmt:
         $$ = tree.Statement(nil)
     }
 
+handler_stmt:
+    block_stmt
+|   case_stmt
+|   if_stmt
+|   loop_stmt
+|   repeat_stmt
+|   while_stmt
+|   iterate_stmt
+|   leave_stmt
+|   normal_stmt
+|   open_cursor_stmt
+|   fetch_cursor_stmt
+|   close_cursor_stmt
+|   signal_stmt
+|   resignal_stmt
+
+open_cursor_stmt:
+    OPEN ident
+    {
+        $$ = &tree.OpenCursor{Name: tree.Identifier($2.Compare())}
+    }
+
+fetch_cursor_stmt:
+    FETCH ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{Name: tree.Identifier($2.Compare()), Variables: $4}
+    }
+|   FETCH FROM ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{Name: tree.Identifier($3.Compare()), Variables: $5}
+    }
+|   FETCH NEXT FROM ident INTO var_name_list
+    {
+        $$ = &tree.FetchCursor{Name: tree.Identifier($4.Compare()), Variables: $6}
+    }
+
+close_cursor_stmt:
+    CLOSE ident
+    {
+        $$ = &tree.CloseCursor{Name: tree.Identifier($2.Compare())}
+    }
+
+signal_stmt:
+    SIGNAL signal_value signal_info_opt
+    {
+        $$ = &tree.Signal{Condition: $2, Info: $3}
+    }
+
+resignal_stmt:
+    RESIGNAL signal_info_opt
+    {
+        $$ = &tree.Signal{Resignal: true, Info: $2}
+    }
+|   RESIGNAL signal_value signal_info_opt
+    {
+        $$ = &tree.Signal{Resignal: true, Condition: $2, Info: $3}
+    }
+
+signal_value:
+    SQLSTATE value_opt STRING
+    {
+        $$ = &tree.ConditionValue{Type: tree.ConditionSqlState, SqlState: $3}
+    }
+|   ident
+    {
+        $$ = &tree.ConditionValue{Type: tree.ConditionName, Name: tree.Identifier($1.Compare())}
+    }
+
+value_opt:
+    {
+        $$ = ""
+    }
+|   VALUE
+
+signal_info_opt:
+    {
+        $$ = nil
+    }
+|   SET signal_info_list
+    {
+        $$ = $2
+    }
+
+signal_info_list:
+    signal_info
+    {
+        $$ = []*tree.SignalInfo{$1}
+    }
+|   signal_info_list ',' signal_info
+    {
+        $$ = append($1, $3)
+    }
+
+signal_info:
+    signal_info_item '=' expression
+    {
+        $$ = &tree.SignalInfo{Item: $1, Value: $3}
+    }
+
+signal_info_item:
+    MESSAGE_TEXT
+    {
+        $$ = tree.SignalMessageText
+    }
+|   MYSQL_ERRNO
+    {
+        $$ = tree.SignalMysqlErrno
+    }
+
 stmt:
     normal_stmt
     {
@@ -889,6 +1082,7 @@ normal_stmt:
 |   insert_stmt
 |   replace_stmt
 |   delete_stmt
+|   merge_stmt
 |   drop_stmt
 |   truncate_table_stmt
 |   explain_stmt
@@ -916,6 +1110,46 @@ normal_stmt:
 |   kill_stmt
 |   backup_stmt
 |   snapshot_restore_stmt
+|   refresh_mview_stmt
+
+refresh_mview_stmt:
+    REFRESH MATERIALIZED VIEW table_name mview_refresh_mode_opt
+    {
+        $$ = tree.NewRefreshMaterializedView($4, $5)
+    }
+
+mview_refresh_mode_opt:
+    {
+        $$ = tree.RefreshModeDefault
+    }
+|   mview_refresh_mode
+
+mview_refresh_mode:
+    COMPLETE
+    {
+        $$ = tree.RefreshModeComplete
+    }
+|   INCREMENTAL
+    {
+        $$ = tree.RefreshModeIncremental
+    }
+
+mview_refresh_opt:
+    {
+        $$ = &tree.MaterializedViewOption{}
+    }
+|   REFRESH mview_refresh_mode
+    {
+        $$ = &tree.MaterializedViewOption{Mode: $2}
+    }
+|   REFRESH EVERY STRING
+    {
+        $$ = &tree.MaterializedViewOption{RefreshCron: $3}
+    }
+|   REFRESH mview_refresh_mode REFRESH EVERY STRING
+    {
+        $$ = &tree.MaterializedViewOption{Mode: $2, RefreshCron: $5}
+    }
 
 
 backup_stmt:
@@ -2608,7 +2842,7 @@ update_stmt:
     }
 
 update_no_with_stmt:
-    UPDATE priority_opt ignore_opt table_reference SET update_list where_expression_opt order_by_opt limit_opt
+    UPDATE priority_opt ignore_opt table_reference SET update_list where_expression_opt order_by_opt limit_opt returning_opt
     {
         // Single-table syntax
         $$ = &tree.Update{
@@ -2617,6 +2851,7 @@ update_no_with_stmt:
             Where: $7,
             OrderBy: $8,
             Limit: $9,
+            Returning: $10,
         }
     }
 |    UPDATE priority_opt ignore_opt table_references SET update_list where_expression_opt
@@ -2629,6 +2864,73 @@ update_no_with_stmt:
         }
     }
 
+merge_stmt:
+    MERGE INTO aliased_table_name USING table_reference ON expression merge_when_list
+    {
+        $$ = &tree.Merge{
+            Table: $3,
+            Source: $5,
+            On: $7,
+            Whens: $8,
+        }
+    }
+
+merge_when_list:
+    merge_when
+    {
+        $$ = []*tree.MergeWhen{$1}
+    }
+|   merge_when_list merge_when
+    {
+        $$ = append($1, $2)
+    }
+
+merge_when:
+    WHEN MATCHED merge_cond_opt THEN UPDATE SET update_list
+    {
+        $$ = &tree.MergeWhen{
+            Matched: true,
+            Cond: $3,
+            Action: tree.MergeUpdate,
+            Exprs: $7,
+        }
+    }
+|   WHEN MATCHED merge_cond_opt THEN DELETE
+    {
+        $$ = &tree.MergeWhen{
+            Matched: true,
+            Cond: $3,
+            Action: tree.MergeDelete,
+        }
+    }
+|   WHEN NOT MATCHED merge_cond_opt THEN INSERT merge_insert_columns_opt VALUES row_value
+    {
+        $$ = &tree.MergeWhen{
+            Cond: $4,
+            Action: tree.MergeInsert,
+            Columns: $7,
+            Values: $9,
+        }
+    }
+
+merge_cond_opt:
+    {
+        $$ = nil
+    }
+|   AND expression
+    {
+        $$ = $2
+    }
+
+merge_insert_columns_opt:
+    {
+        $$ = nil
+    }
+|   '(' insert_column_list ')'
+    {
+        $$ = $2
+    }
+
 update_list:
     update_value
     {
@@ -2810,6 +3112,24 @@ explain_stmt:
             $$ = explainStmt
         }
     }
+|   explain_sym FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, "text")
+        optionElem := tree.MakeOptionElem("format", $4)
+        options := tree.MakeOptions(optionElem)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
+|   explain_sym ANALYZE FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($6, "text")
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("format", $5)
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym FORCE execute_stmt
 {
     $$ = tree.NewExplainStmt($3, "text")
@@ -2849,6 +3169,7 @@ explain_option_key:
 explain_foramt_value:
     JSON
 |   TEXT
+|   DOT
 
 
 prepare_sym:
@@ -2951,8 +3272,52 @@ alter_stmt:
 |   alter_publication_stmt
 |   alter_stage_stmt
 |   alter_sequence_stmt
+|   alter_event_stmt
+|   alter_plan_baseline_stmt
+|   alter_resource_group_stmt
 // |    alter_ddl_stmt
 
+alter_resource_group_stmt:
+    ALTER RESOURCE GROUP ident resource_group_options
+    {
+        $$ = tree.NewAlterResourceGroup($4.Compare(), $5, tree.ResourceGroupSetOptions, "", "")
+    }
+|   ALTER RESOURCE GROUP ident ADD ACCOUNT ident resource_group_user_opt
+    {
+        $$ = tree.NewAlterResourceGroup($4.Compare(), tree.ResourceGroupOptions{}, tree.ResourceGroupAddBinding, $7.Compare(), $8)
+    }
+|   ALTER RESOURCE GROUP ident DROP ACCOUNT ident resource_group_user_opt
+    {
+        $$ = tree.NewAlterResourceGroup($4.Compare(), tree.ResourceGroupOptions{}, tree.ResourceGroupDropBinding, $7.Compare(), $8)
+    }
+
+resource_group_user_opt:
+    {
+        $$ = ""
+    }
+|   USER ident
+    {
+        $$ = $2.Compare()
+    }
+
+alter_plan_baseline_stmt:
+    ALTER PLAN BASELINE STRING PIN
+    {
+        $$ = tree.NewAlterPlanBaseline($4, tree.PlanBaselinePin, "")
+    }
+|   ALTER PLAN BASELINE STRING PIN STRING
+    {
+        $$ = tree.NewAlterPlanBaseline($4, tree.PlanBaselinePin, $6)
+    }
+|   ALTER PLAN BASELINE STRING UNPIN
+    {
+        $$ = tree.NewAlterPlanBaseline($4, tree.PlanBaselineUnpin, "")
+    }
+|   ALTER PLAN BASELINE STRING EVOLVE
+    {
+        $$ = tree.NewAlterPlanBaseline($4, tree.PlanBaselineEvolve, "")
+    }
+
 alter_sequence_stmt:
     ALTER SEQUENCE exists_opt table_name alter_as_datatype_opt increment_by_opt min_value_opt max_value_opt start_with_opt alter_cycle_opt
     {
@@ -3083,6 +3448,92 @@ partition_option:
         }
         $$ = tree.AlterPartitionOption(opt)
     }
+|    EXCHANGE PARTITION ident WITH TABLE table_name without_validation_opt
+    {
+        var typ = tree.AlterPartitionExchangePartition
+        var partitionName = tree.Identifier($3.Compare())
+        var table = $6
+        var withoutValidation = $7
+        opt := tree.NewAlterPartitionExchangePartitionClause(
+            typ,
+            partitionName,
+            table,
+            withoutValidation,
+        )
+        $$ = tree.AlterPartitionOption(opt)
+    }
+|    ADD PARTITION PARTITIONS INTEGRAL
+    {
+        var typ = tree.AlterPartitionAddPartition
+        opt := tree.NewAlterPartitionAddPartitionClause(
+            typ,
+            nil,
+        )
+        switch v := $4.(type) {
+        case uint64:
+            opt.PartitionNum = v
+        case int64:
+            opt.PartitionNum = uint64(v)
+        default:
+            yylex.Error("parse integral fail")
+            goto ret1
+        }
+        $$ = tree.AlterPartitionOption(opt)
+    }
+|    COALESCE PARTITION INTEGRAL
+    {
+        var typ = tree.AlterPartitionCoalescePartition
+        var partitionNum uint64
+        switch v := $3.(type) {
+        case uint64:
+            partitionNum = v
+        case int64:
+            partitionNum = uint64(v)
+        default:
+            yylex.Error("parse integral fail")
+            goto ret1
+        }
+        opt := tree.NewAlterPartitionCoalescePartitionClause(
+            typ,
+            partitionNum,
+        )
+        $$ = tree.AlterPartitionOption(opt)
+    }
+|    REORGANIZE PARTITION
+    {
+        var typ = tree.AlterPartitionReorganizePartition
+        opt := tree.NewAlterPartitionReorganizePartitionClause(
+            typ,
+            nil,
+            nil,
+        )
+        $$ = tree.AlterPartitionOption(opt)
+    }
+|    REORGANIZE PARTITION PartitionNameList INTO '(' partition_list ')'
+    {
+        var typ = tree.AlterPartitionReorganizePartition
+        var partitionNames = $3
+        var partitions = $6
+        opt := tree.NewAlterPartitionReorganizePartitionClause(
+            typ,
+            partitionNames,
+            partitions,
+        )
+        $$ = tree.AlterPartitionOption(opt)
+    }
+
+without_validation_opt:
+    {
+        $$ = false
+    }
+|   WITH VALIDATION
+    {
+        $$ = false
+    }
+|   WITHOUT VALIDATION
+    {
+        $$ = true
+    }
 
 AllOrPartitionNameList:
 	ALL
@@ -3847,6 +4298,10 @@ show_target:
     {
         $$ = &tree.ShowTarget{DbName: $3, Type: tree.ShowEvents}
     }
+|    PLAN BASELINES
+    {
+        $$ = &tree.ShowTarget{Type: tree.ShowPlanBaselines}
+    }
 |    PLUGINS
     {
         $$ = &tree.ShowTarget{Type: tree.ShowPlugins}
@@ -4178,6 +4633,34 @@ drop_ddl_stmt:
 |   drop_stage_stmt
 |   drop_connector_stmt
 |   drop_snapshot_stmt
+|   drop_trigger_stmt
+|   drop_event_stmt
+|   drop_plan_baseline_stmt
+|   drop_resource_group_stmt
+
+drop_resource_group_stmt:
+    DROP RESOURCE GROUP exists_opt ident
+    {
+        $$ = tree.NewDropResourceGroup($4, $5.Compare())
+    }
+
+drop_plan_baseline_stmt:
+    DROP PLAN BASELINE exists_opt STRING
+    {
+        $$ = tree.NewDropPlanBaseline($4, $5)
+    }
+
+drop_event_stmt:
+    DROP EVENT exists_opt table_name
+    {
+        $$ = tree.NewDropEvent($3, $4)
+    }
+
+drop_trigger_stmt:
+    DROP TRIGGER exists_opt table_name
+    {
+        $$ = tree.NewDropTrigger($3, $4)
+    }
 
 drop_sequence_stmt:
     DROP SEQUENCE exists_opt table_name_list
@@ -4256,6 +4739,14 @@ drop_table_stmt:
         var names = $4
         $$ = tree.NewDropTable(ifExists, names)
     }
+|   DROP MATERIALIZED VIEW exists_opt table_name_list
+    {
+        var ifExists = $4
+        var names = $5
+        t := tree.NewDropTable(ifExists, names)
+        t.IsMaterializedView = true
+        $$ = t
+    }
 
 drop_connector_stmt:
     DROP CONNECTOR exists_opt table_name_list
@@ -4330,7 +4821,7 @@ delete_stmt:
     }
 
 delete_without_using_stmt:
-    DELETE priority_opt quick_opt ignore_opt FROM table_name partition_clause_opt as_opt_id where_expression_opt order_by_opt limit_opt
+    DELETE priority_opt quick_opt ignore_opt FROM table_name partition_clause_opt as_opt_id where_expression_opt order_by_opt limit_opt returning_opt
     {
         // Single-Table Syntax
         t := &tree.AliasedTableExpr {
@@ -4344,6 +4835,7 @@ delete_without_using_stmt:
             Where: $9,
             OrderBy: $10,
             Limit: $11,
+            Returning: $12,
         }
     }
 |    DELETE priority_opt quick_opt ignore_opt table_name_wild_list FROM table_references where_expression_opt
@@ -4479,23 +4971,34 @@ replace_data:
 	}
 
 insert_stmt:
-    INSERT into_table_name partition_clause_opt insert_data on_duplicate_key_update_opt
+    INSERT into_table_name partition_clause_opt insert_data on_duplicate_key_update_opt returning_opt
     {
         ins := $4
         ins.Table = $2
         ins.PartitionNames = $3
         ins.OnDuplicateUpdate = $5
+        ins.Returning = $6
         $$ = ins
     }
-|   INSERT IGNORE into_table_name partition_clause_opt insert_data
+|   INSERT IGNORE into_table_name partition_clause_opt insert_data returning_opt
     {
         ins := $5
         ins.Table = $3
         ins.PartitionNames = $4
         ins.OnDuplicateUpdate = []*tree.UpdateExpr{nil}
+        ins.Returning = $6
         $$ = ins
     }
 
+returning_opt:
+    {
+        $$ = nil
+    }
+|   RETURNING select_expression_list
+    {
+        $$ = $2
+    }
+
 accounts_list:
     account_name
     {
@@ -5274,6 +5777,7 @@ simple_select_clause:
             Where: $5,
             GroupBy: $6,
             Having: $7,
+            Hints: yylex.(*Lexer).takeSelectHints(),
         }
     }
 |    SELECT select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
@@ -5286,6 +5790,7 @@ simple_select_clause:
             GroupBy: $6,
             Having: $7,
             Option: $2,
+            Hints: yylex.(*Lexer).takeSelectHints(),
         }
     }
 
@@ -5800,12 +6305,251 @@ create_ddl_stmt:
 |   create_extension_stmt
 |   create_sequence_stmt
 |   create_procedure_stmt
+|   create_trigger_stmt
+|   create_event_stmt
+|   create_plan_baseline_stmt
+|   create_resource_group_stmt
 |   create_source_stmt
 |   create_connector_stmt
 |   pause_daemon_task_stmt
 |   cancel_daemon_task_stmt
 |   resume_daemon_task_stmt
 
+create_plan_baseline_stmt:
+    CREATE PLAN BASELINE FOR select_stmt
+    {
+        $$ = tree.NewCreatePlanBaseline($5)
+    }
+
+create_resource_group_stmt:
+    CREATE RESOURCE GROUP not_exists_opt ident resource_group_options_opt
+    {
+        $$ = tree.NewCreateResourceGroup($4, $5.Compare(), $6)
+    }
+
+resource_group_options_opt:
+    {
+        $$ = tree.ResourceGroupOptions{}
+    }
+|   resource_group_options
+
+resource_group_options:
+    CPU_SHARE resource_group_value
+    {
+        $$ = tree.ResourceGroupOptions{HasCPUShare: true, CPUShare: $2}
+    }
+|   MAX_CONCURRENCY resource_group_value
+    {
+        $$ = tree.ResourceGroupOptions{HasMaxConcurrency: true, MaxConcurrency: $2}
+    }
+|   MEMORY_BUDGET resource_group_value
+    {
+        $$ = tree.ResourceGroupOptions{HasMemoryBudget: true, MemoryBudget: $2}
+    }
+|   resource_group_options CPU_SHARE resource_group_value
+    {
+        $$ = $1
+        $$.HasCPUShare, $$.CPUShare = true, $3
+    }
+|   resource_group_options MAX_CONCURRENCY resource_group_value
+    {
+        $$ = $1
+        $$.HasMaxConcurrency, $$.MaxConcurrency = true, $3
+    }
+|   resource_group_options MEMORY_BUDGET resource_group_value
+    {
+        $$ = $1
+        $$.HasMemoryBudget, $$.MemoryBudget = true, $3
+    }
+
+resource_group_value:
+    equal_opt INTEGRAL
+    {
+        val, ok := $2.(int64)
+        if !ok {
+            yylex.Error("resource group option value is out of range")
+            return 1
+        }
+        $$ = val
+    }
+
+create_trigger_stmt:
+    CREATE view_list_opt TRIGGER not_exists_opt table_name trigger_timing trigger_event ON table_name FOR EACH ROW trigger_order_opt trigger_body
+    {
+        $$ = tree.NewCreateTrigger($4, $5, $6, $7, $9, $13, $14)
+    }
+|   CREATE TRIGGER not_exists_opt table_name trigger_timing trigger_event ON table_name FOR EACH ROW trigger_order_opt trigger_body
+    {
+        $$ = tree.NewCreateTrigger($3, $4, $5, $6, $8, $12, $13)
+    }
+
+trigger_timing:
+    BEFORE
+    {
+        $$ = tree.TriggerBefore
+    }
+|   AFTER
+    {
+        $$ = tree.TriggerAfter
+    }
+
+trigger_event:
+    INSERT
+    {
+        $$ = tree.TriggerInsert
+    }
+|   UPDATE
+    {
+        $$ = tree.TriggerUpdate
+    }
+|   DELETE
+    {
+        $$ = tree.TriggerDelete
+    }
+
+trigger_order_opt:
+    {
+        $$ = nil
+    }
+|   FOLLOWS ident
+    {
+        $$ = &tree.TriggerOrder{Follows: true, Name: tree.Identifier($2.Compare())}
+    }
+|   PRECEDES ident
+    {
+        $$ = &tree.TriggerOrder{Name: tree.Identifier($2.Compare())}
+    }
+
+trigger_body:
+    block_stmt
+|   normal_stmt
+
+create_event_stmt:
+    CREATE view_list_opt EVENT not_exists_opt table_name ON SCHEDULE event_schedule event_completion_opt event_options DO event_body
+    {
+        options := $10
+        options.Completion = $9
+        $$ = tree.NewCreateEvent($4, $5, $8, options, $12)
+    }
+|   CREATE EVENT not_exists_opt table_name ON SCHEDULE event_schedule event_completion_opt event_options DO event_body
+    {
+        options := $9
+        options.Completion = $8
+        $$ = tree.NewCreateEvent($3, $4, $7, options, $11)
+    }
+
+event_schedule:
+    AT expression
+    {
+        $$ = &tree.EventSchedule{At: $2}
+    }
+|   EVERY expression time_unit
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: strings.ToLower($3)}
+    }
+|   EVERY expression time_unit STARTS expression
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: strings.ToLower($3), Starts: $5}
+    }
+|   EVERY expression time_unit ENDS expression
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: strings.ToLower($3), Ends: $5}
+    }
+|   EVERY expression time_unit STARTS expression ENDS expression
+    {
+        $$ = &tree.EventSchedule{Every: $2, Unit: strings.ToLower($3), Starts: $5, Ends: $7}
+    }
+
+event_completion_opt:
+    {
+        $$ = tree.EventCompletionDefault
+    }
+|   ON COMPLETION event_completion
+    {
+        $$ = $3
+    }
+
+event_completion:
+    PRESERVE
+    {
+        $$ = tree.EventPreserve
+    }
+|   NOT PRESERVE
+    {
+        $$ = tree.EventNotPreserve
+    }
+
+event_options:
+    event_status_opt
+    {
+        $$ = tree.EventOptions{Status: $1}
+    }
+|   event_status_opt COMMENT_KEYWORD STRING
+    {
+        $$ = tree.EventOptions{Status: $1, HasComment: true, Comment: $3}
+    }
+
+event_status_opt:
+    {
+        $$ = tree.EventStatusDefault
+    }
+|   ENABLE
+    {
+        $$ = tree.EventEnable
+    }
+|   DISABLE
+    {
+        $$ = tree.EventDisable
+    }
+
+event_body:
+    block_stmt
+|   normal_stmt
+
+alter_event_stmt:
+    ALTER EVENT table_name alter_event_tail
+    {
+        $4.Name = $3
+        $$ = $4
+    }
+|   ALTER EVENT table_name ON SCHEDULE event_schedule event_completion_opt alter_event_tail
+    {
+        $8.Name = $3
+        $8.Schedule = $6
+        $8.Options.Completion = $7
+        $$ = $8
+    }
+|   ALTER EVENT table_name ON COMPLETION event_completion alter_event_tail
+    {
+        $7.Name = $3
+        $7.Options.Completion = $6
+        $$ = $7
+    }
+
+alter_event_tail:
+    event_rename_opt event_options event_body_opt
+    {
+        $$ = tree.NewAlterEvent(nil, nil, tree.EventCompletionDefault, $1, $2, $3)
+    }
+
+event_rename_opt:
+    {
+        $$ = nil
+    }
+|   RENAME TO table_name
+    {
+        $$ = $3
+    }
+
+event_body_opt:
+    {
+        $$ = nil
+    }
+|   DO event_body
+    {
+        $$ = $2
+    }
+
 create_extension_stmt:
     CREATE EXTENSION extension_lang AS extension_name FILE STRING
     {
@@ -7199,6 +7943,16 @@ create_table_stmt:
         t.ClusterByOption = $11
         $$ = t
     }
+|   CREATE MATERIALIZED VIEW not_exists_opt table_name mview_refresh_opt AS select_stmt
+    {
+        t := tree.NewCreateTable()
+        t.IsAsSelect = true
+        t.IfNotExists = $4
+        t.Table = *$5
+        t.MaterializedView = $6
+        t.AsSource = $8
+        $$ = t
+    }
 |   CREATE DYNAMIC TABLE not_exists_opt table_name AS select_stmt source_option_list_opt
     {
         t := tree.NewCreateTable()
@@ -7621,7 +8375,7 @@ sub_partition_opt:
 |   SUBPARTITION BY sub_partition_method sub_partition_num_opt
     {
         var IsSubPartition = true
-        var PType = $3
+        var PType = $3.PType
         var Num = uint64($4)
         $$ = tree.NewPartitionBy2(
             IsSubPartition,
@@ -11312,6 +12066,32 @@ char_type:
             },
         }
     }
+|   VECI8 length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
+|   VECBIT length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
 |   ENUM '(' enum_values ')'
     {
         locale := ""
@@ -11378,6 +12158,82 @@ declare_stmt:
             DefaultVal: $5,
         }
     }
+|   DECLARE ident CURSOR FOR select_stmt
+    {
+        $$ = &tree.DeclareCursor{
+            Name: tree.Identifier($2.Compare()),
+            Select: $5,
+        }
+    }
+|   DECLARE ident CONDITION FOR condition_value
+    {
+        $$ = &tree.DeclareCondition{
+            Name: tree.Identifier($2.Compare()),
+            Value: $5,
+        }
+    }
+|   DECLARE handler_action HANDLER FOR handler_condition_list handler_stmt
+    {
+        $$ = &tree.DeclareHandler{
+            Action: $2,
+            Conditions: $5,
+            Body: $6,
+        }
+    }
+
+handler_action:
+    CONTINUE
+    {
+        $$ = tree.HandlerContinue
+    }
+|   EXIT
+    {
+        $$ = tree.HandlerExit
+    }
+
+condition_value:
+    SQLSTATE value_opt STRING
+    {
+        $$ = &tree.ConditionValue{Type: tree.ConditionSqlState, SqlState: $3}
+    }
+|   INTEGRAL
+    {
+        code, ok := $1.(int64)
+        if !ok || code <= 0 || code > 0xffff {
+            yylex.Error("invalid mysql error code")
+            goto ret1
+        }
+        $$ = &tree.ConditionValue{Type: tree.ConditionErrorCode, ErrorCode: uint16(code)}
+    }
+
+handler_condition_list:
+    handler_condition
+    {
+        $$ = []*tree.ConditionValue{$1}
+    }
+|   handler_condition_list ',' handler_condition
+    {
+        $$ = append($1, $3)
+    }
+
+handler_condition:
+    condition_value
+|   ident
+    {
+        $$ = &tree.ConditionValue{Type: tree.ConditionName, Name: tree.Identifier($1.Compare())}
+    }
+|   SQLWARNING
+    {
+        $$ = &tree.ConditionValue{Type: tree.ConditionSqlWarning}
+    }
+|   NOT FOUND
+    {
+        $$ = &tree.ConditionValue{Type: tree.ConditionNotFound}
+    }
+|   SQLEXCEPTION
+    {
+        $$ = &tree.ConditionValue{Type: tree.ConditionSqlException}
+    }
 
 spatial_type:
     GEOMETRY
@@ -11749,6 +12605,32 @@ non_reserved_keyword:
 |   COLUMN_FORMAT
 |   CONNECTOR
 |   CONNECTORS
+|   COMPLETE
+|   EVERY
+|   INCREMENTAL
+|   MATERIALIZED
+|   REFRESH
+|   BEFORE
+|   EACH
+|   FOLLOWS
+|   PRECEDES
+|   SCHEDULE
+|   AT
+|   STARTS
+|   ENDS
+|   COMPLETION
+|   PRESERVE
+|   PLAN
+|   BASELINE
+|   BASELINES
+|   PIN
+|   UNPIN
+|   EVOLVE
+|   RESOURCE
+|   CPU_SHARE
+|   MAX_CONCURRENCY
+|   MEMORY_BUDGET
+|   MATCHED
 |	COLLATION
 |   SECONDARY_ENGINE_ATTRIBUTE
 |   STREAM
@@ -11763,6 +12645,7 @@ non_reserved_keyword:
 |   DYNAMIC
 |   DISK
 |   DO
+|   DOT
 |   DOUBLE
 |   DIRECTORY
 |   DUPLICATE
@@ -11793,6 +12676,8 @@ non_reserved_keyword:
 |   JSON
 |   VECF32
 |   VECF64
+|   VECI8
+|   VECBIT
 |   KEY_BLOCK_SIZE
 |   LISTS
 |   OP_TYPE
@@ -11830,6 +12715,10 @@ non_reserved_keyword:
 |   ONLY
 |   OPTIMIZE
 |   OPEN
+|   CLOSE
+|   FOUND
+|   MESSAGE_TEXT
+|   MYSQL_ERRNO
 |   OPTION
 |   PACK_KEYS
 |   PARTIAL
@@ -12017,6 +12906,7 @@ non_reserved_keyword:
 |	VISIBLE
 |	WITHOUT
 |	VALIDATION
+|	EXCHANGE
 |	ZONEMAP
 |	MEDIAN
 |	PUMP
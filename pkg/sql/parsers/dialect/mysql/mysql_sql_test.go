@@ -330,6 +330,21 @@ var (
 		}, {
 			input:  "explain (analyze true,verbose false,format json) select * from emp",
 			output: "explain (analyze true,verbose false,format json) select * from emp",
+		}, {
+			input:  "explain (format dot) select * from emp",
+			output: "explain (format dot) select * from emp",
+		}, {
+			input:  "explain format = json select * from emp",
+			output: "explain (format json) select * from emp",
+		}, {
+			input:  "explain format=dot select * from emp",
+			output: "explain (format dot) select * from emp",
+		}, {
+			input:  "explain analyze format = json select * from emp",
+			output: "explain (analyze,format json) select * from emp",
+		}, {
+			input:  "select dot from t",
+			output: "select dot from t",
 		}, {
 			input:  "with t11 as (select * from t1) update t11 join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
 			output: "with t11 as (select * from t1) update t11 inner join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
@@ -779,6 +794,150 @@ var (
 			output: "create table t (a int, b char, constraint p1 primary key idx using none (a, b))",
 		}, {
 			input: "create table t (a int, b char, primary key idx (a, b))",
+		}, {
+			input:  "create materialized view mv as select a, sum(b) from t1 group by a",
+			output: "create materialized view mv as select a, sum(b) from t1 group by a",
+		}, {
+			input:  "create materialized view if not exists db.mv refresh incremental refresh every '0 */5 * * * *' as select a, count(*) from t1 group by a",
+			output: "create materialized view if not exists db.mv refresh incremental refresh every '0 */5 * * * *' as select a, count(*) from t1 group by a",
+		}, {
+			input:  "create materialized view mv refresh every '@every 10m' as select * from t1",
+			output: "create materialized view mv refresh every '@every 10m' as select * from t1",
+		}, {
+			input:  "refresh materialized view mv",
+			output: "refresh materialized view mv",
+		}, {
+			input:  "refresh materialized view db.mv complete",
+			output: "refresh materialized view db.mv complete",
+		}, {
+			input:  "drop materialized view if exists mv",
+			output: "drop materialized view if exists mv",
+		}, {
+			input:  "select refresh, complete from materialized",
+			output: "select refresh, complete from materialized",
+		}, {
+			input:  "create trigger trg before insert on t1 for each row set new.a = new.a + 1",
+			output: "create trigger trg before insert on t1 for each row set new.a = new.a + 1",
+		}, {
+			input:  "create trigger if not exists db.trg after update on db.t1 for each row follows trg0 begin insert into audit values (old.a, new.a); update t2 set c = c + 1; end",
+			output: "create trigger if not exists db.trg after update on db.t1 for each row follows trg0 begin insert into audit values (old.a, new.a); update t2 set c = c + 1; end",
+		}, {
+			input:  "create definer = root trigger trg before delete on t1 for each row precedes trg0 delete from t2 where a = old.a",
+			output: "create trigger trg before delete on t1 for each row precedes trg0 delete from t2 where a = old.a",
+		}, {
+			input:  "drop trigger trg",
+			output: "drop trigger trg",
+		}, {
+			input:  "drop trigger if exists db.trg",
+			output: "drop trigger if exists db.trg",
+		}, {
+			input:  "select before, each, follows, precedes from t1",
+			output: "select before, each, follows, precedes from t1",
+		}, {
+			input:  "create event ev on schedule every 1 hour do insert into t1 values (1)",
+			output: "create event ev on schedule every 1 hour do insert into t1 values (1)",
+		}, {
+			input:  "create definer = root event if not exists db.ev on schedule every 2 day starts '2024-01-01 00:00:00' ends current_timestamp + interval 1 month on completion preserve disable comment 'daily' do begin delete from t1 where a < 10; insert into t2 select * from t3; end",
+			output: "create event if not exists db.ev on schedule every 2 day starts 2024-01-01 00:00:00 ends current_timestamp() + interval(1, month) on completion preserve disable comment 'daily' do begin delete from t1 where a < 10; insert into t2 select * from t3; end",
+		}, {
+			input:  "create event ev on schedule at current_timestamp + interval 1 hour on completion not preserve do update t1 set a = a + 1",
+			output: "create event ev on schedule at current_timestamp() + interval(1, hour) on completion not preserve do update t1 set a = a + 1",
+		}, {
+			input:  "alter event ev on schedule every 30 minute ends '2025-01-01' on completion preserve rename to db.ev2 enable comment 'x' do delete from t1",
+			output: "alter event ev on schedule every 30 minute ends 2025-01-01 on completion preserve rename to db.ev2 enable comment 'x' do delete from t1",
+		}, {
+			input:  "alter event ev on completion not preserve",
+			output: "alter event ev on completion not preserve",
+		}, {
+			input:  "alter event ev disable",
+			output: "alter event ev disable",
+		}, {
+			input:  "drop event if exists db.ev",
+			output: "drop event if exists db.ev",
+		}, {
+			input:  "create plan baseline for select a from t1 where b = 1",
+			output: "create plan baseline for select a from t1 where b = 1",
+		}, {
+			input:  "alter plan baseline 'd1' pin",
+			output: "alter plan baseline 'd1' pin",
+		}, {
+			input:  "alter plan baseline 'd1' pin 'p1'",
+			output: "alter plan baseline 'd1' pin 'p1'",
+		}, {
+			input:  "alter plan baseline 'd1' unpin",
+			output: "alter plan baseline 'd1' unpin",
+		}, {
+			input:  "alter plan baseline 'd1' evolve",
+			output: "alter plan baseline 'd1' evolve",
+		}, {
+			input:  "drop plan baseline if exists 'd1'",
+			output: "drop plan baseline if exists 'd1'",
+		}, {
+			input:  "show plan baselines like 'd%'",
+			output: "show plan baselines like d%",
+		}, {
+			input:  "select plan, baseline, baselines, pin, unpin, evolve from t1",
+			output: "select plan, baseline, baselines, pin, unpin, evolve from t1",
+		}, {
+			input:  "create resource group if not exists rg1 cpu_share 50 max_concurrency = 4 memory_budget = 1073741824",
+			output: "create resource group if not exists rg1 cpu_share = 50 max_concurrency = 4 memory_budget = 1073741824",
+		}, {
+			input:  "create resource group rg1",
+			output: "create resource group rg1",
+		}, {
+			input:  "alter resource group rg1 max_concurrency 8",
+			output: "alter resource group rg1 max_concurrency = 8",
+		}, {
+			input:  "alter resource group rg1 add account acc1",
+			output: "alter resource group rg1 add account acc1",
+		}, {
+			input:  "alter resource group rg1 drop account acc1 user u1",
+			output: "alter resource group rg1 drop account acc1 user u1",
+		}, {
+			input:  "drop resource group if exists rg1",
+			output: "drop resource group if exists rg1",
+		}, {
+			input:  "select resource, cpu_share, max_concurrency, memory_budget from t1",
+			output: "select resource, cpu_share, max_concurrency, memory_budget from t1",
+		}, {
+			input:  "select schedule, at, starts, ends, completion, preserve from t1",
+			output: "select schedule, at, starts, ends, completion, preserve from t1",
+		}, {
+			input:  "create trigger trg before insert on t1 for each row begin declare done int default 0; declare v int; declare cur cursor for select a from t2 where b = new.b; declare continue handler for not found set done = 1; open cur; fetch cur into v; fetch next from cur into v; close cur; end",
+			output: "create trigger trg before insert on t1 for each row begin declare done int default 0; declare v int default null; declare cur cursor for select a from t2 where b = new.b; declare continue handler for not found set done = 1; open cur; fetch cur into v; fetch cur into v; close cur; end",
+		}, {
+			input:  "create trigger trg before insert on t1 for each row begin declare no_table condition for 1146; declare dup condition for sqlstate value '23000'; declare exit handler for sqlexception, sqlwarning, no_table, sqlstate '42S02', 1062 begin resignal; end; if new.a < 0 then signal sqlstate '45000' set message_text = 'negative', mysql_errno = 1644; end if; end",
+			output: "create trigger trg before insert on t1 for each row begin declare no_table condition for 1146; declare dup condition for sqlstate '23000'; declare exit handler for sqlexception, sqlwarning, no_table, sqlstate '42S02', 1062 begin resignal; end; if new.a < 0 then signal sqlstate '45000' set message_text = negative, mysql_errno = 1644; end if; end",
+		}, {
+			input:  "create trigger trg before insert on t1 for each row begin declare continue handler for sqlexception resignal set message_text = 'failed'; signal dup; resignal sqlstate '45000'; end",
+			output: "create trigger trg before insert on t1 for each row begin declare continue handler for sqlexception resignal set message_text = failed; signal dup; resignal sqlstate '45000'; end",
+		}, {
+			input:  "select close, found, message_text, mysql_errno from t1",
+			output: "select close, found, message_text, mysql_errno from t1",
+		}, {
+			input:  "merge into t1 as t using t2 as s on t.a = s.a when matched and s.c = 0 then delete when matched then update set t.b = s.b, c = default when not matched then insert (a, b) values (s.a, s.b + 1)",
+			output: "merge into t1 as t using t2 as s on t.a = s.a when matched and s.c = 0 then delete when matched then update set t.b = s.b, c = default when not matched then insert (a, b) values (s.a, s.b + 1)",
+		}, {
+			input:  "merge into db.t1 using (select a, b from t2 where b > 1) s on t1.a = s.a when not matched and s.b < 10 then insert values (s.a, s.b)",
+			output: "merge into db.t1 using (select a, b from t2 where b > 1) as s on t1.a = s.a when not matched and s.b < 10 then insert values (s.a, s.b)",
+		}, {
+			input:  "select matched, merge from t1",
+			output: "select matched, merge from t1",
+		}, {
+			input:  "insert into t1 (b) values (1), (2) returning a, b * 2 as c",
+			output: "insert into t1 (b) values (1), (2) returning a, b * 2 as c",
+		}, {
+			input:  "insert into t1 select * from t2 returning *",
+			output: "insert into t1 select * from t2 returning *",
+		}, {
+			input:  "insert into t1 values (1, 2) on duplicate key update b = 3 returning t1.*",
+			output: "insert into t1 values (1, 2) on duplicate key update b = 3 returning t1.*",
+		}, {
+			input:  "update t1 set b = b + 1 where a > 1 returning a, b",
+			output: "update t1 set b = b + 1 where a > 1 returning a, b",
+		}, {
+			input:  "delete from t1 where a > 1 limit 10 returning *",
+			output: "delete from t1 where a > 1 limit 10 returning *",
 		}, {
 			input:  "create dynamic table t as select a from t1",
 			output: "create dynamic table t as select a from t1",
@@ -2389,6 +2548,34 @@ var (
 			input:  "ALTER TABLE t1 TRUNCATE PARTITION ALL",
 			output: "alter table t1 truncate partition all",
 		},
+		{
+			input:  "ALTER TABLE t1 EXCHANGE PARTITION p0 WITH TABLE t2",
+			output: "alter table t1 exchange partition p0 with table t2",
+		},
+		{
+			input:  "ALTER TABLE db1.t1 EXCHANGE PARTITION p0 WITH TABLE db1.t2 WITH VALIDATION",
+			output: "alter table db1.t1 exchange partition p0 with table db1.t2",
+		},
+		{
+			input:  "ALTER TABLE t1 EXCHANGE PARTITION p0 WITH TABLE t2 WITHOUT VALIDATION",
+			output: "alter table t1 exchange partition p0 with table t2 without validation",
+		},
+		{
+			input:  "ALTER TABLE t1 ADD PARTITION PARTITIONS 2",
+			output: "alter table t1 add partition partitions 2",
+		},
+		{
+			input:  "ALTER TABLE t1 COALESCE PARTITION 2",
+			output: "alter table t1 coalesce partition 2",
+		},
+		{
+			input:  "ALTER TABLE t1 REORGANIZE PARTITION",
+			output: "alter table t1 reorganize partition",
+		},
+		{
+			input:  "ALTER TABLE t1 REORGANIZE PARTITION p0, p1 INTO (PARTITION p2, PARTITION p3)",
+			output: "alter table t1 reorganize partition p0, p1 into (partition p2, partition p3)",
+		},
 		{
 			input:  "ALTER TABLE titles partition by range(to_days(from_date)) (partition p01 values less than (to_days('1985-12-31')), partition p02 values less than (to_days('1986-12-31')), partition p03 values less than (to_days('1987-12-31')))",
 			output: "alter table titles partition by range(to_days(from_date)) (partition p01 values less than (to_days(1985-12-31)), partition p02 values less than (to_days(1986-12-31)), partition p03 values less than (to_days(1987-12-31)))",
@@ -49,6 +49,14 @@ type Scanner struct {
 	PrePos      int
 	buf         string
 
+	// HintFlag is set if the last token can be followed by the optimizer hints.
+	HintFlag bool
+	// HintKeyword is the number of the tokens scanned which can be followed by
+	// the optimizer hints, the hints are taken by the query block of the token.
+	HintKeyword int
+	// Hints are the optimizer hint comments not taken by the parser.
+	Hints []HintComment
+
 	strBuilder *bytes.Buffer
 }
 
@@ -59,6 +67,9 @@ func NewScanner(dialectType dialect.DialectType, sql string) *Scanner {
 	scanner.LastError = nil
 	scanner.posVarIndex = 0
 	scanner.MysqlSpecialComment = nil
+	scanner.HintFlag = false
+	scanner.HintKeyword = 0
+	scanner.Hints = scanner.Hints[:0]
 	scanner.Pos = 0
 	scanner.Line = 0
 	scanner.Col = 0
@@ -187,6 +198,17 @@ func (s *Scanner) Scan() (int, string) {
 					return LEX_ERROR, ""
 				}
 				return s.Scan()
+			case '+':
+				id, str := s.scanCommentTypeBlock()
+				if id == LEX_ERROR {
+					return id, str
+				}
+				// the optimizer hints only follow the first keyword of a query block,
+				// the others are just comments.
+				if s.HintFlag {
+					s.Hints = append(s.Hints, HintComment{Keyword: s.HintKeyword, Text: str[3 : len(str)-2]})
+				}
+				return s.Scan()
 			default:
 				id, str := s.scanCommentTypeBlock()
 				if id == LEX_ERROR {
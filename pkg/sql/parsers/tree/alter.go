@@ -177,6 +177,24 @@ func init() {
 		reuse.DefaultOptions[AlterPartitionTruncatePartitionClause](), //.
 	) // WithEnableChecker()
 
+	reuse.CreatePool[AlterPartitionExchangePartitionClause](
+		func() *AlterPartitionExchangePartitionClause { return &AlterPartitionExchangePartitionClause{} },
+		func(a *AlterPartitionExchangePartitionClause) { a.reset() },
+		reuse.DefaultOptions[AlterPartitionExchangePartitionClause](), //.
+	) // WithEnableChecker()
+
+	reuse.CreatePool[AlterPartitionCoalescePartitionClause](
+		func() *AlterPartitionCoalescePartitionClause { return &AlterPartitionCoalescePartitionClause{} },
+		func(a *AlterPartitionCoalescePartitionClause) { a.reset() },
+		reuse.DefaultOptions[AlterPartitionCoalescePartitionClause](), //.
+	) // WithEnableChecker()
+
+	reuse.CreatePool[AlterPartitionReorganizePartitionClause](
+		func() *AlterPartitionReorganizePartitionClause { return &AlterPartitionReorganizePartitionClause{} },
+		func(a *AlterPartitionReorganizePartitionClause) { a.reset() },
+		reuse.DefaultOptions[AlterPartitionReorganizePartitionClause](), //.
+	) // WithEnableChecker()
+
 	reuse.CreatePool[AccountsSetOption](
 		func() *AccountsSetOption { return &AccountsSetOption{} },
 		func(a *AccountsSetOption) { a.reset() },
@@ -631,6 +649,12 @@ func (node *AlterTable) reset() {
 			opt.Free()
 		case *AlterPartitionTruncatePartitionClause:
 			opt.Free()
+		case *AlterPartitionExchangePartitionClause:
+			opt.Free()
+		case *AlterPartitionCoalescePartitionClause:
+			opt.Free()
+		case *AlterPartitionReorganizePartitionClause:
+			opt.Free()
 		default:
 			if opt != nil {
 				panic(fmt.Sprintf("miss Free for %v", node.PartitionOption))
@@ -1437,6 +1461,8 @@ type AlterPartitionAddPartitionClause struct {
 	alterPartitionOptionImpl
 	Typ        AlterPartitionOptionType
 	Partitions []*Partition
+	// PartitionNum is the number of partitions of ADD PARTITION PARTITIONS num
+	PartitionNum uint64
 }
 
 func NewAlterPartitionAddPartitionClause(typ AlterPartitionOptionType, partitions []*Partition) *AlterPartitionAddPartitionClause {
@@ -1451,6 +1477,10 @@ func (node *AlterPartitionAddPartitionClause) Free() {
 }
 
 func (node *AlterPartitionAddPartitionClause) Format(ctx *FmtCtx) {
+	if node.PartitionNum > 0 {
+		ctx.WriteString(fmt.Sprintf(" add partition partitions %d", node.PartitionNum))
+		return
+	}
 	ctx.WriteString(" add partition (")
 	isFirst := true
 	for _, partition := range node.Partitions {
@@ -1543,3 +1573,124 @@ func (node AlterPartitionTruncatePartitionClause) TypeName() string {
 func (node *AlterPartitionTruncatePartitionClause) reset() {
 	*node = AlterPartitionTruncatePartitionClause{}
 }
+
+// AlterPartitionExchangePartitionClause is
+// EXCHANGE PARTITION partition_name WITH TABLE tbl_name [{WITH | WITHOUT} VALIDATION]
+type AlterPartitionExchangePartitionClause struct {
+	alterPartitionOptionImpl
+	Typ               AlterPartitionOptionType
+	PartitionName     Identifier
+	Table             *TableName
+	WithoutValidation bool
+}
+
+func NewAlterPartitionExchangePartitionClause(typ AlterPartitionOptionType, partitionName Identifier, table *TableName, withoutValidation bool) *AlterPartitionExchangePartitionClause {
+	a := reuse.Alloc[AlterPartitionExchangePartitionClause](nil)
+	a.Typ = typ
+	a.PartitionName = partitionName
+	a.Table = table
+	a.WithoutValidation = withoutValidation
+	return a
+}
+
+func (node *AlterPartitionExchangePartitionClause) Free() {
+	reuse.Free[AlterPartitionExchangePartitionClause](node, nil)
+}
+
+func (node *AlterPartitionExchangePartitionClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(" exchange partition ")
+	node.PartitionName.Format(ctx)
+	ctx.WriteString(" with table ")
+	node.Table.Format(ctx)
+	if node.WithoutValidation {
+		ctx.WriteString(" without validation")
+	}
+}
+
+func (node AlterPartitionExchangePartitionClause) TypeName() string {
+	return "tree.AlterPartitionExchangePartitionClause"
+}
+
+func (node *AlterPartitionExchangePartitionClause) reset() {
+	*node = AlterPartitionExchangePartitionClause{}
+}
+
+// AlterPartitionCoalescePartitionClause is
+// COALESCE PARTITION num
+type AlterPartitionCoalescePartitionClause struct {
+	alterPartitionOptionImpl
+	Typ          AlterPartitionOptionType
+	PartitionNum uint64
+}
+
+func NewAlterPartitionCoalescePartitionClause(typ AlterPartitionOptionType, partitionNum uint64) *AlterPartitionCoalescePartitionClause {
+	a := reuse.Alloc[AlterPartitionCoalescePartitionClause](nil)
+	a.Typ = typ
+	a.PartitionNum = partitionNum
+	return a
+}
+
+func (node *AlterPartitionCoalescePartitionClause) Free() {
+	reuse.Free[AlterPartitionCoalescePartitionClause](node, nil)
+}
+
+func (node *AlterPartitionCoalescePartitionClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(fmt.Sprintf(" coalesce partition %d", node.PartitionNum))
+}
+
+func (node AlterPartitionCoalescePartitionClause) TypeName() string {
+	return "tree.AlterPartitionCoalescePartitionClause"
+}
+
+func (node *AlterPartitionCoalescePartitionClause) reset() {
+	*node = AlterPartitionCoalescePartitionClause{}
+}
+
+// AlterPartitionReorganizePartitionClause is
+// REORGANIZE PARTITION [partition_names INTO (partition_definitions)]
+type AlterPartitionReorganizePartitionClause struct {
+	alterPartitionOptionImpl
+	Typ            AlterPartitionOptionType
+	PartitionNames IdentifierList
+	Partitions     []*Partition
+}
+
+func NewAlterPartitionReorganizePartitionClause(typ AlterPartitionOptionType, partitionNames IdentifierList, partitions []*Partition) *AlterPartitionReorganizePartitionClause {
+	a := reuse.Alloc[AlterPartitionReorganizePartitionClause](nil)
+	a.Typ = typ
+	a.PartitionNames = partitionNames
+	a.Partitions = partitions
+	return a
+}
+
+func (node *AlterPartitionReorganizePartitionClause) Free() {
+	reuse.Free[AlterPartitionReorganizePartitionClause](node, nil)
+}
+
+func (node *AlterPartitionReorganizePartitionClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(" reorganize partition")
+	if len(node.PartitionNames) == 0 {
+		return
+	}
+	ctx.WriteString(" ")
+	node.PartitionNames.Format(ctx)
+	ctx.WriteString(" into (")
+	for i, partition := range node.Partitions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		partition.Format(ctx)
+	}
+	ctx.WriteString(")")
+}
+
+func (node AlterPartitionReorganizePartitionClause) TypeName() string {
+	return "tree.AlterPartitionReorganizePartitionClause"
+}
+
+func (node *AlterPartitionReorganizePartitionClause) reset() {
+	for _, item := range node.Partitions {
+		item.Free()
+	}
+	*node = AlterPartitionReorganizePartitionClause{}
+}
@@ -937,6 +937,9 @@ type CreateTable struct {
 	IsAsLike           bool
 	LikeTableName      TableName
 	SubscriptionOption *SubscriptionOption
+	// MaterializedView is set for CREATE MATERIALIZED VIEW, which is a
+	// create table as select whose data is kept up to date by refresh.
+	MaterializedView *MaterializedViewOption
 }
 
 func NewCreateTable() *CreateTable {
@@ -944,6 +947,19 @@ func NewCreateTable() *CreateTable {
 }
 
 func (node *CreateTable) Format(ctx *FmtCtx) {
+	if node.MaterializedView != nil {
+		ctx.WriteString("create materialized view")
+		if node.IfNotExists {
+			ctx.WriteString(" if not exists")
+		}
+		ctx.WriteByte(' ')
+		node.Table.Format(ctx)
+		node.MaterializedView.Format(ctx)
+		ctx.WriteString(" as ")
+		node.AsSource.Format(ctx)
+		return
+	}
+
 	ctx.WriteString("create")
 	if node.Temporary {
 		ctx.WriteString(" temporary")
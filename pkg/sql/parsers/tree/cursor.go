@@ -0,0 +1,65 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// OpenCursor is OPEN cursor_name
+type OpenCursor struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *OpenCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("open ")
+	ctx.WriteString(string(node.Name))
+}
+
+func (node *OpenCursor) GetStatementType() string { return "Open Cursor" }
+func (node *OpenCursor) GetQueryType() string     { return QueryTypeOth }
+
+// FetchCursor is FETCH [[NEXT] FROM] cursor_name INTO var_name [, var_name] ...
+type FetchCursor struct {
+	statementImpl
+	Name      Identifier
+	Variables []string
+}
+
+func (node *FetchCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("fetch ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" into ")
+	for i, v := range node.Variables {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(v)
+	}
+}
+
+func (node *FetchCursor) GetStatementType() string { return "Fetch Cursor" }
+func (node *FetchCursor) GetQueryType() string     { return QueryTypeOth }
+
+// CloseCursor is CLOSE cursor_name
+type CloseCursor struct {
+	statementImpl
+	Name Identifier
+}
+
+func (node *CloseCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("close ")
+	ctx.WriteString(string(node.Name))
+}
+
+func (node *CloseCursor) GetStatementType() string { return "Close Cursor" }
+func (node *CloseCursor) GetQueryType() string     { return QueryTypeOth }
@@ -14,6 +14,8 @@
 
 package tree
 
+import "strconv"
+
 // Declare statement
 type Declare struct {
 	statementImpl
@@ -34,3 +36,111 @@ func (node *Declare) Format(ctx *FmtCtx) {
 
 func (node *Declare) GetStatementType() string { return "Declare" }
 func (node *Declare) GetQueryType() string     { return QueryTypeOth }
+
+// DeclareCursor is DECLARE cursor_name CURSOR FOR select_statement
+type DeclareCursor struct {
+	statementImpl
+	Name   Identifier
+	Select *Select
+}
+
+func (node *DeclareCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" cursor for ")
+	node.Select.Format(ctx)
+}
+
+func (node *DeclareCursor) GetStatementType() string { return "Declare Cursor" }
+func (node *DeclareCursor) GetQueryType() string     { return QueryTypeOth }
+
+type ConditionType int
+
+const (
+	ConditionSqlState ConditionType = iota
+	ConditionErrorCode
+	ConditionName
+	ConditionSqlWarning
+	ConditionNotFound
+	ConditionSqlException
+)
+
+// ConditionValue is the condition of DECLARE ... CONDITION, DECLARE ... HANDLER and SIGNAL
+type ConditionValue struct {
+	Type      ConditionType
+	SqlState  string
+	ErrorCode uint16
+	Name      Identifier
+}
+
+func (node *ConditionValue) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case ConditionSqlState:
+		ctx.WriteString("sqlstate '")
+		ctx.WriteString(node.SqlState)
+		ctx.WriteByte('\'')
+	case ConditionErrorCode:
+		ctx.WriteString(strconv.FormatUint(uint64(node.ErrorCode), 10))
+	case ConditionName:
+		ctx.WriteString(string(node.Name))
+	case ConditionSqlWarning:
+		ctx.WriteString("sqlwarning")
+	case ConditionNotFound:
+		ctx.WriteString("not found")
+	case ConditionSqlException:
+		ctx.WriteString("sqlexception")
+	}
+}
+
+// DeclareCondition is DECLARE condition_name CONDITION FOR condition_value
+type DeclareCondition struct {
+	statementImpl
+	Name  Identifier
+	Value *ConditionValue
+}
+
+func (node *DeclareCondition) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" condition for ")
+	node.Value.Format(ctx)
+}
+
+func (node *DeclareCondition) GetStatementType() string { return "Declare Condition" }
+func (node *DeclareCondition) GetQueryType() string     { return QueryTypeOth }
+
+type HandlerAction int
+
+const (
+	HandlerContinue HandlerAction = iota
+	HandlerExit
+)
+
+// DeclareHandler is DECLARE {CONTINUE | EXIT} HANDLER FOR condition_value [, condition_value] ... statement
+type DeclareHandler struct {
+	statementImpl
+	Action     HandlerAction
+	Conditions []*ConditionValue
+	Body       Statement
+}
+
+func (node *DeclareHandler) Format(ctx *FmtCtx) {
+	ctx.WriteString("declare ")
+	if node.Action == HandlerExit {
+		ctx.WriteString("exit")
+	} else {
+		ctx.WriteString("continue")
+	}
+	ctx.WriteString(" handler for ")
+	for i, cond := range node.Conditions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		cond.Format(ctx)
+	}
+	ctx.WriteByte(' ')
+	node.Body.Format(ctx)
+}
+
+func (node *DeclareHandler) GetStatementType() string { return "Declare Handler" }
+func (node *DeclareHandler) GetQueryType() string     { return QueryTypeOth }
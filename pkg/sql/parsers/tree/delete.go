@@ -24,6 +24,8 @@ type Delete struct {
 	OrderBy        OrderBy
 	Limit          *Limit
 	With           *With
+	Returning      SelectExprs
+	Hints          *OptimizerHints
 }
 
 func (node *Delete) Format(ctx *FmtCtx) {
@@ -63,6 +65,10 @@ func (node *Delete) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Limit.Format(ctx)
 	}
+	if len(node.Returning) > 0 {
+		ctx.WriteString(" returning ")
+		node.Returning.Format(ctx)
+	}
 }
 
 func (node *Delete) GetStatementType() string { return "Delete" }
@@ -110,10 +110,16 @@ type DropTable struct {
 	statementImpl
 	IfExists bool
 	Names    TableNames
+	// IsMaterializedView is set for DROP MATERIALIZED VIEW
+	IsMaterializedView bool
 }
 
 func (node *DropTable) Format(ctx *FmtCtx) {
-	ctx.WriteString("drop table")
+	if node.IsMaterializedView {
+		ctx.WriteString("drop materialized view")
+	} else {
+		ctx.WriteString("drop table")
+	}
 	if node.IfExists {
 		ctx.WriteString(" if exists")
 	}
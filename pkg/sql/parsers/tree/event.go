@@ -0,0 +1,278 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/reuse"
+)
+
+func init() {
+	reuse.CreatePool[CreateEvent](
+		func() *CreateEvent { return &CreateEvent{} },
+		func(c *CreateEvent) { c.reset() },
+		reuse.DefaultOptions[CreateEvent](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[AlterEvent](
+		func() *AlterEvent { return &AlterEvent{} },
+		func(a *AlterEvent) { a.reset() },
+		reuse.DefaultOptions[AlterEvent](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[DropEvent](
+		func() *DropEvent { return &DropEvent{} },
+		func(d *DropEvent) { d.reset() },
+		reuse.DefaultOptions[DropEvent](), //.
+	) //WithEnableChecker()
+}
+
+// EventSchedule is the ON SCHEDULE clause of the event
+//
+//	AT timestamp | EVERY interval [STARTS timestamp] [ENDS timestamp]
+type EventSchedule struct {
+	// At is the execution time of the one time event
+	At Expr
+	// Every and Unit are the interval of the recurring event
+	Every  Expr
+	Unit   string
+	Starts Expr
+	Ends   Expr
+}
+
+func (node *EventSchedule) Format(ctx *FmtCtx) {
+	if node.At != nil {
+		ctx.WriteString("at ")
+		node.At.Format(ctx)
+		return
+	}
+	ctx.WriteString("every ")
+	node.Every.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(node.Unit)
+	if node.Starts != nil {
+		ctx.WriteString(" starts ")
+		node.Starts.Format(ctx)
+	}
+	if node.Ends != nil {
+		ctx.WriteString(" ends ")
+		node.Ends.Format(ctx)
+	}
+}
+
+type EventCompletion int
+
+const (
+	EventCompletionDefault EventCompletion = iota
+	EventPreserve
+	EventNotPreserve
+)
+
+func (c EventCompletion) String() string {
+	if c == EventPreserve {
+		return "PRESERVE"
+	}
+	return "NOT PRESERVE"
+}
+
+type EventStatus int
+
+const (
+	EventStatusDefault EventStatus = iota
+	EventEnable
+	EventDisable
+)
+
+func (s EventStatus) String() string {
+	if s == EventDisable {
+		return "DISABLED"
+	}
+	return "ENABLED"
+}
+
+// EventOptions are the options shared by CREATE EVENT and ALTER EVENT
+//
+//	[ON COMPLETION [NOT] PRESERVE] [ENABLE | DISABLE] [COMMENT 'string']
+type EventOptions struct {
+	Completion EventCompletion
+	Status     EventStatus
+	HasComment bool
+	Comment    string
+}
+
+func (node *EventOptions) Format(ctx *FmtCtx) {
+	if node.Completion != EventCompletionDefault {
+		ctx.WriteString(" on completion ")
+		ctx.WriteString(strings.ToLower(node.Completion.String()))
+	}
+	switch node.Status {
+	case EventEnable:
+		ctx.WriteString(" enable")
+	case EventDisable:
+		ctx.WriteString(" disable")
+	}
+	if node.HasComment {
+		ctx.WriteString(" comment ")
+		ctx.WriteString("'" + node.Comment + "'")
+	}
+}
+
+// CreateEvent CREATE EVENT statement
+//
+//	CREATE EVENT [IF NOT EXISTS] event_name ON SCHEDULE schedule
+//	[ON COMPLETION [NOT] PRESERVE] [ENABLE | DISABLE] [COMMENT 'string'] DO event_body
+type CreateEvent struct {
+	statementImpl
+	IfNotExists bool
+	Name        *TableName
+	Schedule    *EventSchedule
+	Options     EventOptions
+	Body        Statement
+}
+
+func NewCreateEvent(ifNotExists bool, name *TableName, schedule *EventSchedule, options EventOptions, body Statement) *CreateEvent {
+	c := reuse.Alloc[CreateEvent](nil)
+	c.IfNotExists = ifNotExists
+	c.Name = name
+	c.Schedule = schedule
+	c.Options = options
+	c.Body = body
+	return c
+}
+
+func (node *CreateEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("create event ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on schedule ")
+	node.Schedule.Format(ctx)
+	node.Options.Format(ctx)
+	ctx.WriteString(" do ")
+	node.Body.Format(ctx)
+}
+
+func (node *CreateEvent) GetStatementType() string { return "Create Event" }
+func (node *CreateEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *CreateEvent) Free() {
+	reuse.Free[CreateEvent](node, nil)
+}
+
+func (node CreateEvent) TypeName() string { return "tree.CreateEvent" }
+
+func (node *CreateEvent) reset() {
+	*node = CreateEvent{}
+}
+
+// AlterEvent ALTER EVENT statement
+//
+//	ALTER EVENT event_name [ON SCHEDULE schedule] [ON COMPLETION [NOT] PRESERVE]
+//	[RENAME TO new_event_name] [ENABLE | DISABLE] [COMMENT 'string'] [DO event_body]
+type AlterEvent struct {
+	statementImpl
+	Name *TableName
+	// the nil Schedule, Rename and Body are not changed
+	Schedule *EventSchedule
+	Rename   *TableName
+	Options  EventOptions
+	Body     Statement
+}
+
+func NewAlterEvent(name *TableName, schedule *EventSchedule, completion EventCompletion, rename *TableName,
+	options EventOptions, body Statement) *AlterEvent {
+	a := reuse.Alloc[AlterEvent](nil)
+	a.Name = name
+	a.Schedule = schedule
+	a.Rename = rename
+	a.Options = options
+	a.Options.Completion = completion
+	a.Body = body
+	return a
+}
+
+func (node *AlterEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter event ")
+	node.Name.Format(ctx)
+	if node.Schedule != nil {
+		ctx.WriteString(" on schedule ")
+		node.Schedule.Format(ctx)
+	}
+	if node.Options.Completion != EventCompletionDefault {
+		ctx.WriteString(" on completion ")
+		ctx.WriteString(strings.ToLower(node.Options.Completion.String()))
+	}
+	if node.Rename != nil {
+		ctx.WriteString(" rename to ")
+		node.Rename.Format(ctx)
+	}
+	options := node.Options
+	options.Completion = EventCompletionDefault
+	options.Format(ctx)
+	if node.Body != nil {
+		ctx.WriteString(" do ")
+		node.Body.Format(ctx)
+	}
+}
+
+func (node *AlterEvent) GetStatementType() string { return "Alter Event" }
+func (node *AlterEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *AlterEvent) Free() {
+	reuse.Free[AlterEvent](node, nil)
+}
+
+func (node AlterEvent) TypeName() string { return "tree.AlterEvent" }
+
+func (node *AlterEvent) reset() {
+	*node = AlterEvent{}
+}
+
+// DropEvent DROP EVENT statement
+type DropEvent struct {
+	statementImpl
+	IfExists bool
+	Name     *TableName
+}
+
+func NewDropEvent(ifExists bool, name *TableName) *DropEvent {
+	d := reuse.Alloc[DropEvent](nil)
+	d.IfExists = ifExists
+	d.Name = name
+	return d
+}
+
+func (node *DropEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop event ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropEvent) GetStatementType() string { return "Drop Event" }
+func (node *DropEvent) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *DropEvent) Free() {
+	reuse.Free[DropEvent](node, nil)
+}
+
+func (node DropEvent) TypeName() string { return "tree.DropEvent" }
+
+func (node *DropEvent) reset() {
+	*node = DropEvent{}
+}
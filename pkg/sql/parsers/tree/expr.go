@@ -1630,6 +1630,10 @@ type ParamExpr struct {
 }
 
 func (node *ParamExpr) Format(ctx *FmtCtx) {
+	if node.Offset > 0 && node.Offset <= len(ctx.paramValues) {
+		ctx.WriteString(ctx.paramValues[node.Offset-1])
+		return
+	}
 	ctx.WriteByte('?')
 }
 
@@ -28,6 +28,10 @@ type FmtCtx struct {
 	// quoteString string
 	quoteString       bool
 	singleQuoteString bool
+	// normalizeValues writes the values as '?', see WithNormalizeValues
+	normalizeValues bool
+	// paramValues are written for the parameters, see WithParamValues
+	paramValues []string
 }
 
 func NewFmtCtx(dialectType dialect.DialectType, opts ...FmtCtxOption) *FmtCtx {
@@ -58,6 +62,22 @@ func WithSingleQuoteString() FmtCtxOption {
 	})
 }
 
+// WithNormalizeValues writes all the values as '?', so the statements only
+// different in the values are formatted as the same text.
+func WithNormalizeValues() FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.normalizeValues = true
+	})
+}
+
+// WithParamValues writes the parameters of the prepared statement as the values,
+// which are the SQL literals in the order of the parameters.
+func WithParamValues(values []string) FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.paramValues = values
+	})
+}
+
 // NodeFormatter for formatted output of the node.
 type NodeFormatter interface {
 	Format(ctx *FmtCtx)
@@ -130,6 +150,9 @@ func (ctx *FmtCtx) PrintExpr(currentExpr Expr, expr Expr, left bool) {
 }
 
 func (ctx *FmtCtx) WriteValue(t P_TYPE, v string) (int, error) {
+	if ctx.normalizeValues {
+		return ctx.WriteString("?")
+	}
 	if ctx.quoteString {
 		switch t {
 		case P_char:
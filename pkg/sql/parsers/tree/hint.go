@@ -0,0 +1,136 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// Optimizer hints supported in the /*+ ... */ comment.
+const (
+	// HintJoinOrder JOIN_ORDER(t1, t2, ...) joins the tables in the order.
+	HintJoinOrder = "JOIN_ORDER"
+	// HintHashJoin HASH_JOIN(t1, ...) joins the tables by hash join, not by index.
+	HintHashJoin = "HASH_JOIN"
+	// HintNoShuffle NO_SHUFFLE disables the shuffle of the query.
+	HintNoShuffle = "NO_SHUFFLE"
+	// HintUseIndex USE_INDEX(t idx1, ...) only uses the indexes for the table.
+	HintUseIndex = "USE_INDEX"
+	// HintForceOneCN FORCE_ONE_CN runs the query on one CN.
+	HintForceOneCN = "FORCE_ONE_CN"
+	// HintMaxExecutionTime MAX_EXECUTION_TIME(n) interrupts the SELECT if it
+	// runs longer than n milliseconds, 0 means no limit.
+	HintMaxExecutionTime = "MAX_EXECUTION_TIME"
+)
+
+// OptimizerHint is a hint like NAME(arg1, arg2, ...) in the /*+ ... */ comment
+// following the SELECT, INSERT, REPLACE, UPDATE or DELETE keyword.
+type OptimizerHint struct {
+	// Name is the upper case name of the hint
+	Name string
+	Args []string
+}
+
+func (node *OptimizerHint) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Name)
+	if len(node.Args) == 0 {
+		return
+	}
+	ctx.WriteByte('(')
+	for i, arg := range node.Args {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(arg)
+	}
+	ctx.WriteByte(')')
+}
+
+// OptimizerHints is the hints of a statement. The hints not recognized are
+// dropped with a warning, they never fail the statement.
+type OptimizerHints struct {
+	Hints    []*OptimizerHint
+	Warnings []string
+}
+
+func (node *OptimizerHints) Format(ctx *FmtCtx) {
+	ctx.WriteString("/*+ ")
+	for i, hint := range node.Hints {
+		if i > 0 {
+			ctx.WriteByte(' ')
+		}
+		hint.Format(ctx)
+	}
+	ctx.WriteString(" */")
+}
+
+// Append appends the hints and the warnings of other.
+func (node *OptimizerHints) Append(other *OptimizerHints) {
+	node.Hints = append(node.Hints, other.Hints...)
+	node.Warnings = append(node.Warnings, other.Warnings...)
+}
+
+// SetOptimizerHints attaches the hints to the statement, it returns false if
+// the statement does not support hints.
+func SetOptimizerHints(stmt Statement, hints *OptimizerHints) bool {
+	switch s := stmt.(type) {
+	case *Select:
+		s.Hints = hints
+	case *ParenSelect:
+		return SetOptimizerHints(s.Select, hints)
+	case *Insert:
+		s.Hints = hints
+	case *Replace:
+		s.Hints = hints
+	case *Update:
+		s.Hints = hints
+	case *Delete:
+		s.Hints = hints
+	case *ExplainStmt:
+		return SetOptimizerHints(s.Statement, hints)
+	case *ExplainAnalyze:
+		return SetOptimizerHints(s.Statement, hints)
+	case *CreatePlanBaseline:
+		return SetOptimizerHints(s.Stmt, hints)
+	case *PrepareStmt:
+		return SetOptimizerHints(s.Stmt, hints)
+	default:
+		return false
+	}
+	return true
+}
+
+// GetOptimizerHints returns the hints attached to the statement.
+func GetOptimizerHints(stmt Statement) *OptimizerHints {
+	switch s := stmt.(type) {
+	case *Select:
+		return s.Hints
+	case *ParenSelect:
+		return GetOptimizerHints(s.Select)
+	case *Insert:
+		return s.Hints
+	case *Replace:
+		return s.Hints
+	case *Update:
+		return s.Hints
+	case *Delete:
+		return s.Hints
+	case *ExplainStmt:
+		return GetOptimizerHints(s.Statement)
+	case *ExplainAnalyze:
+		return GetOptimizerHints(s.Statement)
+	case *CreatePlanBaseline:
+		return GetOptimizerHints(s.Stmt)
+	case *PrepareStmt:
+		return GetOptimizerHints(s.Stmt)
+	}
+	return nil
+}
@@ -26,6 +26,8 @@ type Insert struct {
 	OnDuplicateUpdate UpdateExprs
 	IsRestore         bool
 	FromDataTenantID  uint32
+	Returning         SelectExprs
+	Hints             *OptimizerHints
 }
 
 func (node *Insert) Format(ctx *FmtCtx) {
@@ -56,6 +58,10 @@ func (node *Insert) Format(ctx *FmtCtx) {
 		ctx.WriteString(" on duplicate key update ")
 		node.OnDuplicateUpdate.Format(ctx)
 	}
+	if len(node.Returning) > 0 {
+		ctx.WriteString(" returning ")
+		node.Returning.Format(ctx)
+	}
 }
 
 func (node *Insert) GetStatementType() string { return "Insert" }
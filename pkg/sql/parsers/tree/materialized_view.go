@@ -0,0 +1,99 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "github.com/matrixorigin/matrixone/pkg/common/reuse"
+
+func init() {
+	reuse.CreatePool[RefreshMaterializedView](
+		func() *RefreshMaterializedView { return &RefreshMaterializedView{} },
+		func(r *RefreshMaterializedView) { r.reset() },
+		reuse.DefaultOptions[RefreshMaterializedView](), //.
+	) //WithEnableChecker()
+}
+
+type RefreshMode int
+
+const (
+	// RefreshModeDefault uses the refresh mode of the materialized view.
+	RefreshModeDefault RefreshMode = iota
+	RefreshModeComplete
+	RefreshModeIncremental
+)
+
+func (m RefreshMode) String() string {
+	switch m {
+	case RefreshModeComplete:
+		return "complete"
+	case RefreshModeIncremental:
+		return "incremental"
+	}
+	return ""
+}
+
+// MaterializedViewOption is the refresh clause of CREATE MATERIALIZED VIEW
+//
+//	[REFRESH {COMPLETE | INCREMENTAL}] [REFRESH EVERY 'cron']
+type MaterializedViewOption struct {
+	Mode        RefreshMode
+	RefreshCron string
+}
+
+func (node *MaterializedViewOption) Format(ctx *FmtCtx) {
+	if node.Mode != RefreshModeDefault {
+		ctx.WriteString(" refresh ")
+		ctx.WriteString(node.Mode.String())
+	}
+	if node.RefreshCron != "" {
+		ctx.WriteString(" refresh every ")
+		ctx.WriteString("'" + node.RefreshCron + "'")
+	}
+}
+
+// RefreshMaterializedView REFRESH MATERIALIZED VIEW statement
+type RefreshMaterializedView struct {
+	statementImpl
+	Name *TableName
+	Mode RefreshMode
+}
+
+func NewRefreshMaterializedView(name *TableName, mode RefreshMode) *RefreshMaterializedView {
+	r := reuse.Alloc[RefreshMaterializedView](nil)
+	r.Name = name
+	r.Mode = mode
+	return r
+}
+
+func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("refresh materialized view ")
+	node.Name.Format(ctx)
+	if node.Mode != RefreshModeDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Mode.String())
+	}
+}
+
+func (node *RefreshMaterializedView) GetStatementType() string { return "Refresh Materialized View" }
+func (node *RefreshMaterializedView) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *RefreshMaterializedView) Free() {
+	reuse.Free[RefreshMaterializedView](node, nil)
+}
+
+func (node RefreshMaterializedView) TypeName() string { return "tree.RefreshMaterializedView" }
+
+func (node *RefreshMaterializedView) reset() {
+	*node = RefreshMaterializedView{}
+}
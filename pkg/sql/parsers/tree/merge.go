@@ -0,0 +1,98 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// Merge MERGE INTO statement
+//
+//	MERGE INTO target [AS alias] USING source ON condition
+//	WHEN MATCHED [AND condition] THEN UPDATE SET col = expr, ...
+//	WHEN MATCHED [AND condition] THEN DELETE
+//	WHEN NOT MATCHED [AND condition] THEN INSERT [(col, ...)] VALUES (expr, ...)
+type Merge struct {
+	statementImpl
+	Table  TableExpr
+	Source TableExpr
+	On     Expr
+	Whens  []*MergeWhen
+}
+
+func (node *Merge) Format(ctx *FmtCtx) {
+	ctx.WriteString("merge into ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" using ")
+	node.Source.Format(ctx)
+	ctx.WriteString(" on ")
+	node.On.Format(ctx)
+	for _, when := range node.Whens {
+		ctx.WriteByte(' ')
+		when.Format(ctx)
+	}
+}
+
+func (node *Merge) GetStatementType() string { return "Merge" }
+func (node *Merge) GetQueryType() string     { return QueryTypeDML }
+
+type MergeActionType int
+
+const (
+	MergeUpdate MergeActionType = iota
+	MergeDelete
+	MergeInsert
+)
+
+// MergeWhen is one WHEN clause of the MERGE statement, the first clause whose
+// condition is satisfied by the row decides the action on it.
+type MergeWhen struct {
+	NodeFormatter
+	Matched bool
+	// Cond is nil if the clause has no AND condition
+	Cond   Expr
+	Action MergeActionType
+	// Exprs are the assignments of WHEN MATCHED THEN UPDATE
+	Exprs UpdateExprs
+	// Columns and Values are the row of WHEN NOT MATCHED THEN INSERT
+	Columns IdentifierList
+	Values  Exprs
+}
+
+func (node *MergeWhen) Format(ctx *FmtCtx) {
+	if node.Matched {
+		ctx.WriteString("when matched")
+	} else {
+		ctx.WriteString("when not matched")
+	}
+	if node.Cond != nil {
+		ctx.WriteString(" and ")
+		node.Cond.Format(ctx)
+	}
+	ctx.WriteString(" then ")
+	switch node.Action {
+	case MergeUpdate:
+		ctx.WriteString("update set ")
+		node.Exprs.Format(ctx)
+	case MergeDelete:
+		ctx.WriteString("delete")
+	case MergeInsert:
+		ctx.WriteString("insert")
+		if node.Columns != nil {
+			ctx.WriteString(" (")
+			node.Columns.Format(ctx)
+			ctx.WriteByte(')')
+		}
+		ctx.WriteString(" values (")
+		node.Values.Format(ctx)
+		ctx.WriteByte(')')
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "github.com/matrixorigin/matrixone/pkg/common/reuse"
+
+func init() {
+	reuse.CreatePool[CreatePlanBaseline](
+		func() *CreatePlanBaseline { return &CreatePlanBaseline{} },
+		func(c *CreatePlanBaseline) { c.reset() },
+		reuse.DefaultOptions[CreatePlanBaseline](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[AlterPlanBaseline](
+		func() *AlterPlanBaseline { return &AlterPlanBaseline{} },
+		func(a *AlterPlanBaseline) { a.reset() },
+		reuse.DefaultOptions[AlterPlanBaseline](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[DropPlanBaseline](
+		func() *DropPlanBaseline { return &DropPlanBaseline{} },
+		func(d *DropPlanBaseline) { d.reset() },
+		reuse.DefaultOptions[DropPlanBaseline](), //.
+	) //WithEnableChecker()
+}
+
+// CreatePlanBaseline CREATE PLAN BASELINE FOR select_stmt
+//
+// It pins the plan built for the statement, with the hints in it if any, as
+// the plan baseline of the statements with the same digest.
+type CreatePlanBaseline struct {
+	statementImpl
+	Stmt Statement
+}
+
+func NewCreatePlanBaseline(stmt Statement) *CreatePlanBaseline {
+	c := reuse.Alloc[CreatePlanBaseline](nil)
+	c.Stmt = stmt
+	return c
+}
+
+func (node *CreatePlanBaseline) Format(ctx *FmtCtx) {
+	ctx.WriteString("create plan baseline for ")
+	node.Stmt.Format(ctx)
+}
+
+func (node *CreatePlanBaseline) GetStatementType() string { return "Create Plan Baseline" }
+func (node *CreatePlanBaseline) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *CreatePlanBaseline) Free() {
+	reuse.Free[CreatePlanBaseline](node, nil)
+}
+
+func (node CreatePlanBaseline) TypeName() string { return "tree.CreatePlanBaseline" }
+
+func (node *CreatePlanBaseline) reset() {
+	*node = CreatePlanBaseline{}
+}
+
+type PlanBaselineAction int
+
+const (
+	// PlanBaselinePin pins a captured plan of the digest
+	PlanBaselinePin PlanBaselineAction = iota
+	// PlanBaselineUnpin unpins the pinned plan of the digest
+	PlanBaselineUnpin
+	// PlanBaselineEvolve pins the plan chosen by the optimizer now if it is
+	// cheaper than the pinned one or the pinned one is no longer valid
+	PlanBaselineEvolve
+)
+
+func (a PlanBaselineAction) String() string {
+	switch a {
+	case PlanBaselinePin:
+		return "pin"
+	case PlanBaselineUnpin:
+		return "unpin"
+	case PlanBaselineEvolve:
+		return "evolve"
+	}
+	return "unknown"
+}
+
+// AlterPlanBaseline ALTER PLAN BASELINE 'digest' {PIN ['plan_digest'] | UNPIN | EVOLVE}
+type AlterPlanBaseline struct {
+	statementImpl
+	Digest string
+	Action PlanBaselineAction
+	// PlanDigest is the plan pinned, the latest captured one if empty
+	PlanDigest string
+}
+
+func NewAlterPlanBaseline(digest string, action PlanBaselineAction, planDigest string) *AlterPlanBaseline {
+	a := reuse.Alloc[AlterPlanBaseline](nil)
+	a.Digest = digest
+	a.Action = action
+	a.PlanDigest = planDigest
+	return a
+}
+
+func (node *AlterPlanBaseline) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter plan baseline '")
+	ctx.WriteString(node.Digest)
+	ctx.WriteString("' ")
+	ctx.WriteString(node.Action.String())
+	if node.PlanDigest != "" {
+		ctx.WriteString(" '")
+		ctx.WriteString(node.PlanDigest)
+		ctx.WriteByte('\'')
+	}
+}
+
+func (node *AlterPlanBaseline) GetStatementType() string { return "Alter Plan Baseline" }
+func (node *AlterPlanBaseline) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *AlterPlanBaseline) Free() {
+	reuse.Free[AlterPlanBaseline](node, nil)
+}
+
+func (node AlterPlanBaseline) TypeName() string { return "tree.AlterPlanBaseline" }
+
+func (node *AlterPlanBaseline) reset() {
+	*node = AlterPlanBaseline{}
+}
+
+// DropPlanBaseline DROP PLAN BASELINE [IF EXISTS] 'digest'
+type DropPlanBaseline struct {
+	statementImpl
+	IfExists bool
+	Digest   string
+}
+
+func NewDropPlanBaseline(ifExists bool, digest string) *DropPlanBaseline {
+	d := reuse.Alloc[DropPlanBaseline](nil)
+	d.IfExists = ifExists
+	d.Digest = digest
+	return d
+}
+
+func (node *DropPlanBaseline) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop plan baseline ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	ctx.WriteByte('\'')
+	ctx.WriteString(node.Digest)
+	ctx.WriteByte('\'')
+}
+
+func (node *DropPlanBaseline) GetStatementType() string { return "Drop Plan Baseline" }
+func (node *DropPlanBaseline) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *DropPlanBaseline) Free() {
+	reuse.Free[DropPlanBaseline](node, nil)
+}
+
+func (node DropPlanBaseline) TypeName() string { return "tree.DropPlanBaseline" }
+
+func (node *DropPlanBaseline) reset() {
+	*node = DropPlanBaseline{}
+}
@@ -31,6 +31,7 @@ type Replace struct {
 	PartitionNames IdentifierList
 	Columns        IdentifierList
 	Rows           *Select
+	Hints          *OptimizerHints
 }
 
 func (node *Replace) Format(ctx *FmtCtx) {
@@ -0,0 +1,205 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/reuse"
+)
+
+func init() {
+	reuse.CreatePool[CreateResourceGroup](
+		func() *CreateResourceGroup { return &CreateResourceGroup{} },
+		func(c *CreateResourceGroup) { c.reset() },
+		reuse.DefaultOptions[CreateResourceGroup](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[AlterResourceGroup](
+		func() *AlterResourceGroup { return &AlterResourceGroup{} },
+		func(a *AlterResourceGroup) { a.reset() },
+		reuse.DefaultOptions[AlterResourceGroup](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[DropResourceGroup](
+		func() *DropResourceGroup { return &DropResourceGroup{} },
+		func(d *DropResourceGroup) { d.reset() },
+		reuse.DefaultOptions[DropResourceGroup](), //.
+	) //WithEnableChecker()
+}
+
+// ResourceGroupOptions are the options shared by CREATE RESOURCE GROUP and
+// ALTER RESOURCE GROUP
+//
+//	[CPU_SHARE [=] percent] [MAX_CONCURRENCY [=] n] [MEMORY_BUDGET [=] bytes]
+//
+// An option not given keeps its default on CREATE and its value on ALTER.
+type ResourceGroupOptions struct {
+	HasCPUShare       bool
+	CPUShare          int64
+	HasMaxConcurrency bool
+	MaxConcurrency    int64
+	HasMemoryBudget   bool
+	MemoryBudget      int64
+}
+
+func (node *ResourceGroupOptions) Format(ctx *FmtCtx) {
+	if node.HasCPUShare {
+		ctx.WriteString(fmt.Sprintf(" cpu_share = %d", node.CPUShare))
+	}
+	if node.HasMaxConcurrency {
+		ctx.WriteString(fmt.Sprintf(" max_concurrency = %d", node.MaxConcurrency))
+	}
+	if node.HasMemoryBudget {
+		ctx.WriteString(fmt.Sprintf(" memory_budget = %d", node.MemoryBudget))
+	}
+}
+
+// CreateResourceGroup CREATE RESOURCE GROUP [IF NOT EXISTS] name [options]
+type CreateResourceGroup struct {
+	statementImpl
+	IfNotExists bool
+	Name        string
+	Options     ResourceGroupOptions
+}
+
+func NewCreateResourceGroup(ifNotExists bool, name string, options ResourceGroupOptions) *CreateResourceGroup {
+	c := reuse.Alloc[CreateResourceGroup](nil)
+	c.IfNotExists = ifNotExists
+	c.Name = name
+	c.Options = options
+	return c
+}
+
+func (node *CreateResourceGroup) Format(ctx *FmtCtx) {
+	ctx.WriteString("create resource group ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	ctx.WriteString(node.Name)
+	node.Options.Format(ctx)
+}
+
+func (node *CreateResourceGroup) GetStatementType() string { return "Create Resource Group" }
+func (node *CreateResourceGroup) GetQueryType() string     { return QueryTypeDCL }
+
+func (node *CreateResourceGroup) Free() {
+	reuse.Free[CreateResourceGroup](node, nil)
+}
+
+func (node CreateResourceGroup) TypeName() string { return "tree.CreateResourceGroup" }
+
+func (node *CreateResourceGroup) reset() {
+	*node = CreateResourceGroup{}
+}
+
+type ResourceGroupAction int
+
+const (
+	// ResourceGroupSetOptions changes the options of the group
+	ResourceGroupSetOptions ResourceGroupAction = iota
+	// ResourceGroupAddBinding binds an account, or a user of it, to the group
+	ResourceGroupAddBinding
+	// ResourceGroupDropBinding removes the binding of an account, or a user of it
+	ResourceGroupDropBinding
+)
+
+// AlterResourceGroup ALTER RESOURCE GROUP name {options | {ADD | DROP} ACCOUNT account [USER user]}
+type AlterResourceGroup struct {
+	statementImpl
+	Name    string
+	Action  ResourceGroupAction
+	Options ResourceGroupOptions
+	Account string
+	// User is the user of the account bound, the whole account if empty
+	User string
+}
+
+func NewAlterResourceGroup(name string, options ResourceGroupOptions, action ResourceGroupAction, account, user string) *AlterResourceGroup {
+	a := reuse.Alloc[AlterResourceGroup](nil)
+	a.Name = name
+	a.Options = options
+	a.Action = action
+	a.Account = account
+	a.User = user
+	return a
+}
+
+func (node *AlterResourceGroup) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter resource group ")
+	ctx.WriteString(node.Name)
+	switch node.Action {
+	case ResourceGroupSetOptions:
+		node.Options.Format(ctx)
+		return
+	case ResourceGroupAddBinding:
+		ctx.WriteString(" add account ")
+	case ResourceGroupDropBinding:
+		ctx.WriteString(" drop account ")
+	}
+	ctx.WriteString(node.Account)
+	if node.User != "" {
+		ctx.WriteString(" user ")
+		ctx.WriteString(node.User)
+	}
+}
+
+func (node *AlterResourceGroup) GetStatementType() string { return "Alter Resource Group" }
+func (node *AlterResourceGroup) GetQueryType() string     { return QueryTypeDCL }
+
+func (node *AlterResourceGroup) Free() {
+	reuse.Free[AlterResourceGroup](node, nil)
+}
+
+func (node AlterResourceGroup) TypeName() string { return "tree.AlterResourceGroup" }
+
+func (node *AlterResourceGroup) reset() {
+	*node = AlterResourceGroup{}
+}
+
+// DropResourceGroup DROP RESOURCE GROUP [IF EXISTS] name
+type DropResourceGroup struct {
+	statementImpl
+	IfExists bool
+	Name     string
+}
+
+func NewDropResourceGroup(ifExists bool, name string) *DropResourceGroup {
+	d := reuse.Alloc[DropResourceGroup](nil)
+	d.IfExists = ifExists
+	d.Name = name
+	return d
+}
+
+func (node *DropResourceGroup) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop resource group ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	ctx.WriteString(node.Name)
+}
+
+func (node *DropResourceGroup) GetStatementType() string { return "Drop Resource Group" }
+func (node *DropResourceGroup) GetQueryType() string     { return QueryTypeDCL }
+
+func (node *DropResourceGroup) Free() {
+	reuse.Free[DropResourceGroup](node, nil)
+}
+
+func (node DropResourceGroup) TypeName() string { return "tree.DropResourceGroup" }
+
+func (node *DropResourceGroup) reset() {
+	*node = DropResourceGroup{}
+}
@@ -33,6 +33,7 @@ type Select struct {
 	With           *With
 	Ep             *ExportParam
 	SelectLockInfo *SelectLockInfo
+	Hints          *OptimizerHints
 }
 
 func (node *Select) Format(ctx *FmtCtx) {
@@ -303,10 +304,17 @@ type SelectClause struct {
 	GroupBy  GroupBy
 	Having   *Where
 	Option   string
+	// Hints is the hints of the query block, the hints of the outermost
+	// query block are the hints of the statement, see Select.Hints.
+	Hints *OptimizerHints
 }
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("select ")
+	if node.Hints != nil && len(node.Hints.Hints) > 0 {
+		node.Hints.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	if node.Distinct {
 		ctx.WriteString("distinct ")
 	}
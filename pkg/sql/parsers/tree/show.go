@@ -179,6 +179,7 @@ const (
 	ShowProfile
 	ShowProfiles
 	ShowPrivileges
+	ShowPlanBaselines
 )
 
 func (s ShowType) String() string {
@@ -203,6 +204,8 @@ func (s ShowType) String() string {
 		return "profiles"
 	case ShowPrivileges:
 		return "privileges"
+	case ShowPlanBaselines:
+		return "plan baselines"
 	default:
 		return "not implemented"
 	}
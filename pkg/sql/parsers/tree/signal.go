@@ -0,0 +1,75 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type SignalInfoItem int
+
+const (
+	SignalMessageText SignalInfoItem = iota
+	SignalMysqlErrno
+)
+
+func (item SignalInfoItem) String() string {
+	if item == SignalMysqlErrno {
+		return "mysql_errno"
+	}
+	return "message_text"
+}
+
+// SignalInfo is the condition_information_item_name = simple_value_specification of SIGNAL
+type SignalInfo struct {
+	Item  SignalInfoItem
+	Value Expr
+}
+
+// Signal is SIGNAL condition_value [SET signal_information_item [, signal_information_item] ...]
+// or RESIGNAL [condition_value] [SET signal_information_item [, signal_information_item] ...]
+type Signal struct {
+	statementImpl
+	Resignal bool
+	// Condition is nil for RESIGNAL of the condition being handled
+	Condition *ConditionValue
+	Info      []*SignalInfo
+}
+
+func (node *Signal) Format(ctx *FmtCtx) {
+	if node.Resignal {
+		ctx.WriteString("resignal")
+	} else {
+		ctx.WriteString("signal")
+	}
+	if node.Condition != nil {
+		ctx.WriteByte(' ')
+		node.Condition.Format(ctx)
+	}
+	for i, info := range node.Info {
+		if i == 0 {
+			ctx.WriteString(" set ")
+		} else {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(info.Item.String())
+		ctx.WriteString(" = ")
+		info.Value.Format(ctx)
+	}
+}
+
+func (node *Signal) GetStatementType() string {
+	if node.Resignal {
+		return "Resignal"
+	}
+	return "Signal"
+}
+func (node *Signal) GetQueryType() string { return QueryTypeOth }
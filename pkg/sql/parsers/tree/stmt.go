@@ -245,6 +245,50 @@ func (node *DropProcedure) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
 
+func (node *CreateTrigger) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropTrigger) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *CreateEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropEvent) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *CreatePlanBaseline) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterPlanBaseline) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropPlanBaseline) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *CreateResourceGroup) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterResourceGroup) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropResourceGroup) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
 func (node *CallStmt) StmtKind() StmtKind {
 	return MakeStmtKind(OUTPUT_UNDEFINED, RESP_BY_SITUATION, EXEC_IN_FRONTEND)
 }
@@ -303,6 +347,13 @@ func (node *Deallocate) StmtKind() StmtKind {
 }
 
 func (node *Update) StmtKind() StmtKind {
+	if len(node.Returning) > 0 {
+		return defaultResRowTyp
+	}
+	return defaultStatusTyp
+}
+
+func (node *Merge) StmtKind() StmtKind {
 	return defaultStatusTyp
 }
 
@@ -339,6 +390,9 @@ func (node *ShowCreateTable) StmtKind() StmtKind {
 }
 
 func (node *Insert) StmtKind() StmtKind {
+	if len(node.Returning) > 0 {
+		return defaultResRowTyp
+	}
 	return defaultStatusTyp
 }
 
@@ -455,7 +509,14 @@ func (node *TruncateTable) StmtKind() StmtKind {
 	return defaultStatusTyp
 }
 
+func (node *RefreshMaterializedView) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
 func (node *Delete) StmtKind() StmtKind {
+	if len(node.Returning) > 0 {
+		return defaultResRowTyp
+	}
 	return defaultStatusTyp
 }
 
@@ -503,6 +564,18 @@ func (node *Declare) StmtKind() StmtKind {
 	return defaultStatusTyp
 }
 
+func (node *DeclareCursor) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
+func (node *DeclareCondition) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
+func (node *DeclareHandler) StmtKind() StmtKind {
+	return defaultStatusTyp
+}
+
 func (node *CreateExtension) StmtKind() StmtKind {
 	return MakeStmtKind(OUTPUT_UNDEFINED, RESP_STATUS, EXEC_IN_FRONTEND)
 }
@@ -0,0 +1,178 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/reuse"
+)
+
+func init() {
+	reuse.CreatePool[CreateTrigger](
+		func() *CreateTrigger { return &CreateTrigger{} },
+		func(c *CreateTrigger) { c.reset() },
+		reuse.DefaultOptions[CreateTrigger](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[DropTrigger](
+		func() *DropTrigger { return &DropTrigger{} },
+		func(d *DropTrigger) { d.reset() },
+		reuse.DefaultOptions[DropTrigger](), //.
+	) //WithEnableChecker()
+}
+
+type TriggerTiming int
+
+const (
+	TriggerBefore TriggerTiming = iota
+	TriggerAfter
+)
+
+func (t TriggerTiming) String() string {
+	if t == TriggerAfter {
+		return "AFTER"
+	}
+	return "BEFORE"
+}
+
+type TriggerEvent int
+
+const (
+	TriggerInsert TriggerEvent = iota
+	TriggerUpdate
+	TriggerDelete
+)
+
+func (e TriggerEvent) String() string {
+	switch e {
+	case TriggerUpdate:
+		return "UPDATE"
+	case TriggerDelete:
+		return "DELETE"
+	}
+	return "INSERT"
+}
+
+// TriggerOrder is the {FOLLOWS | PRECEDES} other_trigger clause
+type TriggerOrder struct {
+	Follows bool
+	Name    Identifier
+}
+
+func (node *TriggerOrder) Format(ctx *FmtCtx) {
+	if node.Follows {
+		ctx.WriteString("follows ")
+	} else {
+		ctx.WriteString("precedes ")
+	}
+	ctx.WriteString(string(node.Name))
+}
+
+// CreateTrigger CREATE TRIGGER statement
+//
+//	CREATE TRIGGER [IF NOT EXISTS] trigger_name {BEFORE | AFTER} {INSERT | UPDATE | DELETE}
+//	ON tbl_name FOR EACH ROW [{FOLLOWS | PRECEDES} other_trigger_name] trigger_body
+type CreateTrigger struct {
+	statementImpl
+	IfNotExists bool
+	Name        *TableName
+	Timing      TriggerTiming
+	Event       TriggerEvent
+	Table       *TableName
+	Order       *TriggerOrder
+	Body        Statement
+}
+
+func NewCreateTrigger(ifNotExists bool, name *TableName, timing TriggerTiming, event TriggerEvent,
+	table *TableName, order *TriggerOrder, body Statement) *CreateTrigger {
+	c := reuse.Alloc[CreateTrigger](nil)
+	c.IfNotExists = ifNotExists
+	c.Name = name
+	c.Timing = timing
+	c.Event = event
+	c.Table = table
+	c.Order = order
+	c.Body = body
+	return c
+}
+
+func (node *CreateTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("create trigger ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Timing.String()))
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Event.String()))
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" for each row ")
+	if node.Order != nil {
+		node.Order.Format(ctx)
+		ctx.WriteByte(' ')
+	}
+	node.Body.Format(ctx)
+}
+
+func (node *CreateTrigger) GetStatementType() string { return "Create Trigger" }
+func (node *CreateTrigger) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *CreateTrigger) Free() {
+	reuse.Free[CreateTrigger](node, nil)
+}
+
+func (node CreateTrigger) TypeName() string { return "tree.CreateTrigger" }
+
+func (node *CreateTrigger) reset() {
+	*node = CreateTrigger{}
+}
+
+// DropTrigger DROP TRIGGER statement
+type DropTrigger struct {
+	statementImpl
+	IfExists bool
+	Name     *TableName
+}
+
+func NewDropTrigger(ifExists bool, name *TableName) *DropTrigger {
+	d := reuse.Alloc[DropTrigger](nil)
+	d.IfExists = ifExists
+	d.Name = name
+	return d
+}
+
+func (node *DropTrigger) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop trigger ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropTrigger) GetStatementType() string { return "Drop Trigger" }
+func (node *DropTrigger) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *DropTrigger) Free() {
+	reuse.Free[DropTrigger](node, nil)
+}
+
+func (node DropTrigger) TypeName() string { return "tree.DropTrigger" }
+
+func (node *DropTrigger) reset() {
+	*node = DropTrigger{}
+}
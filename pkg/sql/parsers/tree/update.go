@@ -25,12 +25,14 @@ import (
 // update statement
 type Update struct {
 	statementImpl
-	Tables  TableExprs
-	Exprs   UpdateExprs
-	Where   *Where
-	OrderBy OrderBy
-	Limit   *Limit
-	With    *With
+	Tables    TableExprs
+	Exprs     UpdateExprs
+	Where     *Where
+	OrderBy   OrderBy
+	Limit     *Limit
+	With      *With
+	Returning SelectExprs
+	Hints     *OptimizerHints
 }
 
 func (node *Update) Format(ctx *FmtCtx) {
@@ -60,6 +62,10 @@ func (node *Update) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Limit.Format(ctx)
 	}
+	if len(node.Returning) > 0 {
+		ctx.WriteString(" returning ")
+		node.Returning.Format(ctx)
+	}
 }
 
 func (node *Update) GetStatementType() string { return "Update" }
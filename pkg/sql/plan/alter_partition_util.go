@@ -16,9 +16,18 @@ package plan
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 )
 
 // AddTablePartitions will add a new partition to the table.
@@ -31,8 +40,8 @@ func AddTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *
 	switch partInfo.Type {
 	case plan.PartitionType_KEY, plan.PartitionType_LINEAR_KEY, plan.PartitionType_HASH, plan.PartitionType_LINEAR_HASH:
 		// Adding partitions to hash/key is actually a reorganization of partition operations,
-		// not just changing metadata! Error not supported
-		return nil, moerr.NewNotSupported(ctx.GetContext(), "ADD PARTITION to HASH/KEY partitioned table")
+		// not just changing metadata! It is built by AddHashTablePartitions
+		return nil, moerr.NewInternalError(ctx.GetContext(), "ADD PARTITION to HASH/KEY partitioned table must redistribute the rows")
 	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS:
 		if len(spec.Partitions) == 0 {
 			return nil, moerr.NewPartitionsMustBeDefined(ctx.GetContext(), "RANGE")
@@ -44,22 +53,14 @@ func AddTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *
 	default:
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "Unsupported Add Partition")
 	}
+	if partInfo.SubPartition != nil {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "ADD PARTITION to subpartitioned table")
+	}
 
-	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
-	bindContext := NewBindContext(builder, nil)
-	nodeID := builder.appendNode(&plan.Node{
-		NodeType:    plan.Node_TABLE_SCAN,
-		Stats:       nil,
-		ObjRef:      nil,
-		TableDef:    alterTable.TableDef,
-		BindingTags: []int32{builder.genNewTag()},
-	}, bindContext)
-
-	err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
+	partitionBinder, err := newAlterPartitionBinder(ctx, alterTable.TableDef)
 	if err != nil {
 		return nil, err
 	}
-	partitionBinder := NewPartitionBinder(builder, bindContext)
 	alterAddPartition, err := buildAddPartitionClause(ctx.GetContext(), partitionBinder, spec, alterTable.TableDef)
 	if err != nil {
 		return nil, err
@@ -100,3 +101,695 @@ func buildAddPartitionClause(ctx context.Context, partitionBinder *PartitionBind
 	}
 	return nil, nil
 }
+
+// DropTablePartitions will drop the partitions of the table.
+// The sub tables of the dropped partitions are dropped with their rows and the
+// partition definition is rebuilt from the remaining partitions.
+func DropTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionDropPartitionClause) (*plan.AlterTableDropPartition, error) {
+	partInfo := alterTable.TableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+
+	var builder partitionBuilder
+	switch partInfo.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS:
+		builder = &rangePartitionBuilder{}
+	case plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+		builder = &listPartitionBuilder{}
+	default:
+		return nil, moerr.NewErrOnlyOnRangeListPartition(ctx.GetContext(), "DROP")
+	}
+	if spec.OnAllPartitions {
+		return nil, moerr.NewErrDropLastPartition(ctx.GetContext())
+	}
+	if err := checkPartitionRowsReferenced(ctx, alterTable.TableDef, "drop"); err != nil {
+		return nil, err
+	}
+
+	dropped, err := findPartitions(ctx.GetContext(), partInfo, spec.PartitionNames, "DROP")
+	if err != nil {
+		return nil, err
+	}
+	if len(dropped) == len(partInfo.Partitions) {
+		return nil, moerr.NewErrDropLastPartition(ctx.GetContext())
+	}
+
+	partitionBy, err := restorePartitionOption(ctx.GetContext(), partInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(partitionBy.Partitions) != len(partInfo.Partitions) {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "partition definition of table %s is inconsistent", alterTable.TableDef.Name)
+	}
+
+	newPartitionDef := DeepCopyPartitionByDef(partInfo)
+	newPartitionDef.Partitions = newPartitionDef.Partitions[:0]
+	newPartitionDef.PartitionTableNames = newPartitionDef.PartitionTableNames[:0]
+	partitions := partitionBy.Partitions[:0]
+	dropPartitionTableNames := make([]string, 0, len(dropped))
+	for i, partition := range DeepCopyPartitionByDef(partInfo).Partitions {
+		if dropped[i] {
+			dropPartitionTableNames = append(dropPartitionTableNames, partition.PartitionTableName)
+			continue
+		}
+		partition.OrdinalPosition = uint32(len(newPartitionDef.Partitions) + 1)
+		newPartitionDef.Partitions = append(newPartitionDef.Partitions, partition)
+		newPartitionDef.PartitionTableNames = append(newPartitionDef.PartitionTableNames, partition.PartitionTableName)
+		partitions = append(partitions, partitionBy.Partitions[i])
+	}
+	partitionBy.Partitions = partitions
+	newPartitionDef.PartitionNum = uint64(len(newPartitionDef.Partitions))
+
+	// the sub tables of a subpartitioned table belong to the subpartitions
+	if subPartition := newPartitionDef.SubPartition; subPartition != nil {
+		subPartitionNum := int(subPartition.PartitionNum)
+		subPartitions := subPartition.Partitions
+		subPartition.Partitions = subPartition.Partitions[:0]
+		dropPartitionTableNames = dropPartitionTableNames[:0]
+		newPartitionDef.PartitionTableNames = newPartitionDef.PartitionTableNames[:0]
+		for i, subPartitionItem := range subPartitions {
+			if dropped[i/subPartitionNum] {
+				dropPartitionTableNames = append(dropPartitionTableNames, subPartitionItem.PartitionTableName)
+				continue
+			}
+			subPartitionItem.OrdinalPosition = uint32(len(subPartition.Partitions) + 1)
+			subPartition.Partitions = append(subPartition.Partitions, subPartitionItem)
+			newPartitionDef.PartitionTableNames = append(newPartitionDef.PartitionTableNames, subPartitionItem.PartitionTableName)
+		}
+	}
+
+	// Regenerate partition calculation expression, the positions of the remaining partitions are changed
+	partitionBinder, err := newAlterPartitionBinder(ctx, alterTable.TableDef)
+	if err != nil {
+		return nil, err
+	}
+	err = builder.buildEvalPartitionExpression(ctx.GetContext(), partitionBinder, partitionBy, newPartitionDef)
+	if err != nil {
+		return nil, err
+	}
+	if newPartitionDef.SubPartition != nil {
+		err = buildSubPartitionExpression(ctx.GetContext(), partitionBinder, partitionBy, newPartitionDef)
+		if err != nil {
+			return nil, err
+		}
+	}
+	newPartitionDef.PartitionMsg = tree.StringWithOpts(partitionBy, dialect.MYSQL, tree.WithSingleQuoteString())
+
+	return &plan.AlterTableDropPartition{
+		PartitionTableNames: dropPartitionTableNames,
+		PartitionDef:        newPartitionDef,
+	}, nil
+}
+
+// TruncateTablePartitions will remove all rows from the partitions of the table.
+func TruncateTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionTruncatePartitionClause) (*plan.AlterTableTruncatePartition, error) {
+	partInfo := alterTable.TableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+
+	if err := checkPartitionRowsReferenced(ctx, alterTable.TableDef, "truncate"); err != nil {
+		return nil, err
+	}
+
+	if spec.OnAllPartitions {
+		return &plan.AlterTableTruncatePartition{
+			PartitionTableNames: DeepCopyStringList(partInfo.PartitionTableNames),
+		}, nil
+	}
+
+	if partInfo.SubPartition != nil {
+		partitionTableNames, err := findSubPartitionTables(ctx.GetContext(), partInfo, spec.PartitionNames, "TRUNCATE")
+		if err != nil {
+			return nil, err
+		}
+		return &plan.AlterTableTruncatePartition{
+			PartitionTableNames: partitionTableNames,
+		}, nil
+	}
+
+	truncated, err := findPartitions(ctx.GetContext(), partInfo, spec.PartitionNames, "TRUNCATE")
+	if err != nil {
+		return nil, err
+	}
+	partitionTableNames := make([]string, 0, len(truncated))
+	for i, partition := range partInfo.Partitions {
+		if truncated[i] {
+			partitionTableNames = append(partitionTableNames, partition.PartitionTableName)
+		}
+	}
+	return &plan.AlterTableTruncatePartition{
+		PartitionTableNames: partitionTableNames,
+	}, nil
+}
+
+// checkPartitionRowsReferenced checks the rows of the partitions can be removed without the
+// foreign key actions, which are skipped when the partition tables are dropped or truncated.
+func checkPartitionRowsReferenced(ctx CompilerContext, tableDef *TableDef, op string) error {
+	enabled, err := IsForeignKeyChecksEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	// the rows of the other partitions of a self referenced table may refer to the removed rows
+	if enabled && len(tableDef.RefChildTbls) > 0 {
+		return moerr.NewInternalError(ctx.GetContext(), "can not %s partition of table '%v' referenced by some foreign key constraint", op, tableDef.Name)
+	}
+	return nil
+}
+
+// findSubPartitionTables returns the sub tables of the named partitions or subpartitions of
+// the subpartitioned table in the order of the subpartitions, a partition stands for all its
+// subpartitions.
+func findSubPartitionTables(ctx context.Context, partitionDef *plan.PartitionByDef, names tree.IdentifierList, op string) ([]string, error) {
+	subPartition := partitionDef.SubPartition
+	found := make(map[int]bool)
+	for _, name := range names {
+		ok := false
+		for i, partition := range partitionDef.Partitions {
+			if strings.EqualFold(partition.PartitionName, string(name)) {
+				for j := 0; j < int(subPartition.PartitionNum); j++ {
+					found[i*int(subPartition.PartitionNum)+j] = true
+				}
+				ok = true
+				break
+			}
+		}
+		for i, subPartitionItem := range subPartition.Partitions {
+			if ok {
+				break
+			}
+			if strings.EqualFold(subPartitionItem.PartitionName, string(name)) {
+				found[i] = true
+				ok = true
+			}
+		}
+		if !ok {
+			return nil, moerr.NewErrDropPartitionNonExistent(ctx, op)
+		}
+	}
+	partitionTableNames := make([]string, 0, len(found))
+	for i, subPartitionItem := range subPartition.Partitions {
+		if found[i] {
+			partitionTableNames = append(partitionTableNames, subPartitionItem.PartitionTableName)
+		}
+	}
+	return partitionTableNames, nil
+}
+
+// ExchangeTablePartition will swap the rows of a partition and a non-partitioned table.
+//
+// The table must have the same columns and primary key as the partitioned table, and no
+// index, foreign key or auto increment column. The rows are not copied, the sub table of
+// the partition and the table are swapped by renaming them in the transaction of the
+// statement:
+//
+//	partition -> tmp, table -> partition, tmp -> table
+func ExchangeTablePartition(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionExchangePartitionClause) (*plan.AlterTableExchangePartition, error) {
+	tableDef := alterTable.TableDef
+	partInfo := tableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+	if len(tableDef.Indexes) > 0 {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION of table with index")
+	}
+	if partInfo.SubPartition != nil {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION of subpartitioned table")
+	}
+	// the exchanged rows are not checked by the foreign keys
+	if len(tableDef.Fkeys) > 0 || len(tableDef.RefChildTbls) > 0 {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION of table having foreign keys")
+	}
+
+	partitionIdx := -1
+	for i, partition := range partInfo.Partitions {
+		if strings.EqualFold(partition.PartitionName, string(spec.PartitionName)) {
+			partitionIdx = i
+			break
+		}
+	}
+	if partitionIdx == -1 {
+		return nil, moerr.NewErrUnknownPartition(ctx.GetContext(), string(spec.PartitionName), tableDef.Name)
+	}
+	partition := partInfo.Partitions[partitionIdx]
+
+	schemaName, tableName := string(spec.Table.Schema()), string(spec.Table.Name())
+	if schemaName == "" {
+		schemaName = ctx.DefaultDatabase()
+	}
+	// the privilege of ALTER TABLE is checked on the database of the partitioned table
+	if schemaName != alterTable.Database {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION with table in another database")
+	}
+	_, exchangeTableDef := ctx.Resolve(schemaName, tableName, Snapshot{TS: &timestamp.Timestamp{}})
+	if exchangeTableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), schemaName, tableName)
+	}
+	if exchangeTableDef.Partition != nil {
+		return nil, moerr.NewErrPartitionExchangePartTable(ctx.GetContext(), tableName)
+	}
+	if exchangeTableDef.IsTemporary || exchangeTableDef.ViewSql != nil ||
+		exchangeTableDef.TableType != catalog.SystemOrdinaryRel {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION with %s.%s", schemaName, tableName)
+	}
+	if len(exchangeTableDef.Fkeys) > 0 || len(exchangeTableDef.RefChildTbls) > 0 {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION with table having foreign keys")
+	}
+	if len(exchangeTableDef.Indexes) > 0 {
+		return nil, moerr.NewErrTablesDifferentMetadata(ctx.GetContext())
+	}
+
+	// the tables are swapped, so they must have the same columns in the same order,
+	// including the hidden ones, and the same primary key
+	var cols, exchangeCols []*ColDef
+	for _, col := range tableDef.Cols {
+		if col.Name != catalog.Row_ID {
+			cols = append(cols, col)
+		}
+	}
+	for _, col := range exchangeTableDef.Cols {
+		if col.Name != catalog.Row_ID {
+			exchangeCols = append(exchangeCols, col)
+		}
+	}
+	if len(cols) != len(exchangeCols) {
+		return nil, moerr.NewErrTablesDifferentMetadata(ctx.GetContext())
+	}
+	for i, col := range cols {
+		exchangeCol := exchangeCols[i]
+		if !strings.EqualFold(col.Name, exchangeCol.Name) ||
+			col.Hidden != exchangeCol.Hidden ||
+			col.Typ.Id != exchangeCol.Typ.Id ||
+			col.Typ.Width != exchangeCol.Typ.Width ||
+			col.Typ.Scale != exchangeCol.Typ.Scale ||
+			col.Typ.NotNullable != exchangeCol.Typ.NotNullable {
+			return nil, moerr.NewErrTablesDifferentMetadata(ctx.GetContext())
+		}
+		// the auto increment values are kept by table id, they are not swapped
+		if col.Typ.AutoIncr || exchangeCol.Typ.AutoIncr {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION of table with auto increment column")
+		}
+	}
+	if tableDef.Pkey.PkeyColName != exchangeTableDef.Pkey.PkeyColName ||
+		!slices.EqualFunc(tableDef.Pkey.Names, exchangeTableDef.Pkey.Names, strings.EqualFold) {
+		return nil, moerr.NewErrTablesDifferentMetadata(ctx.GetContext())
+	}
+
+	exchangePartition := &plan.AlterTableExchangePartition{
+		PartitionTableName: partition.PartitionTableName,
+		TableName:          tableName,
+		CreateSql:          exchangeTableDef.Createsql,
+	}
+
+	if !spec.WithoutValidation {
+		partitionBy, err := restorePartitionOption(ctx.GetContext(), partInfo)
+		if err != nil {
+			return nil, err
+		}
+		partitionExprAst, err := buildPartitionExprAst(ctx.GetContext(), partitionBy, partInfo)
+		if err != nil {
+			return nil, err
+		}
+		exchangePartition.ValidateSql = fmt.Sprintf("select count(*) from `%s`.`%s` where coalesce(%s, -1) != %d",
+			formatStr(schemaName), formatStr(tableName),
+			tree.StringWithOpts(partitionExprAst, dialect.MYSQL, tree.WithSingleQuoteString()), partitionIdx)
+	}
+
+	return exchangePartition, nil
+}
+
+// AddHashTablePartitions will add new partitions to the HASH/KEY partitioned table.
+// The new partitions are appended after the existing partitions, and all rows are
+// placed again by the new partition number.
+func AddHashTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionAddPartitionClause) (*plan.AlterTableRedistributePartition, error) {
+	partInfo := alterTable.TableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+	if !isHashOrKeyPartition(partInfo.Type) {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "ADD PARTITION of %s partitioned table can not redistribute the rows", partInfo.Type)
+	}
+
+	partitionBy, err := restorePartitionOption(ctx.GetContext(), partInfo)
+	if err != nil {
+		return nil, err
+	}
+	partitions := restoreHashPartitions(partitionBy, partInfo)
+	if spec.PartitionNum > 0 {
+		for i := uint64(0); i < spec.PartitionNum; i++ {
+			name := fmt.Sprintf("p%d", len(partitions))
+			partitions = append(partitions, tree.NewPartition(tree.Identifier(name), nil, nil, nil))
+		}
+	} else {
+		for _, partition := range spec.Partitions {
+			if partition.Values != nil {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Only RANGE/LIST PARTITIONING can use VALUES in partition definition")
+			}
+			partitions = append(partitions, partition)
+		}
+	}
+	if len(partitions) == len(partInfo.Partitions) {
+		return nil, moerr.NewErrAddPartitionNoNewPartition(ctx.GetContext())
+	}
+	return buildRedistributePartition(ctx, alterTable, partitionBy, partitions)
+}
+
+// CoalesceTablePartitions will remove the last partitions of the HASH/KEY partitioned
+// table, and all rows are placed again by the new partition number.
+func CoalesceTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionCoalescePartitionClause) (*plan.AlterTableRedistributePartition, error) {
+	partInfo := alterTable.TableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+	if !isHashOrKeyPartition(partInfo.Type) {
+		return nil, moerr.NewErrCoalesceOnlyOnHashPartition(ctx.GetContext())
+	}
+	if spec.PartitionNum == 0 {
+		return nil, moerr.NewErrCoalescePartitionNoPartition(ctx.GetContext())
+	}
+	if spec.PartitionNum >= uint64(len(partInfo.Partitions)) {
+		return nil, moerr.NewErrDropLastPartition(ctx.GetContext())
+	}
+
+	partitionBy, err := restorePartitionOption(ctx.GetContext(), partInfo)
+	if err != nil {
+		return nil, err
+	}
+	partitions := restoreHashPartitions(partitionBy, partInfo)
+	partitions = partitions[:len(partitions)-int(spec.PartitionNum)]
+	return buildRedistributePartition(ctx, alterTable, partitionBy, partitions)
+}
+
+// ReorganizeTablePartitions will rebuild the partitions of the HASH/KEY partitioned table.
+// Without partition names all partitions are rebuilt, otherwise the named partitions
+// are replaced by the same number of new partitions.
+func ReorganizeTablePartitions(ctx CompilerContext, alterTable *plan.AlterTable, spec *tree.AlterPartitionReorganizePartitionClause) (*plan.AlterTableRedistributePartition, error) {
+	partInfo := alterTable.TableDef.GetPartition()
+	if partInfo == nil {
+		return nil, moerr.NewErrPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+	if !isHashOrKeyPartition(partInfo.Type) {
+		if len(spec.PartitionNames) == 0 {
+			return nil, moerr.NewErrReorgNoParam(ctx.GetContext())
+		}
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "REORGANIZE PARTITION of RANGE/LIST partitioned table")
+	}
+	if len(spec.PartitionNames) != len(spec.Partitions) {
+		return nil, moerr.NewErrReorgHashOnlyOnSameNumber(ctx.GetContext())
+	}
+
+	reorganized, err := findPartitions(ctx.GetContext(), partInfo, spec.PartitionNames, "REORGANIZE")
+	if err != nil {
+		return nil, err
+	}
+	partitionBy, err := restorePartitionOption(ctx.GetContext(), partInfo)
+	if err != nil {
+		return nil, err
+	}
+	partitions := restoreHashPartitions(partitionBy, partInfo)
+	// the new partitions take the positions of the reorganized partitions in order
+	next := 0
+	for i := range partitions {
+		if !reorganized[i] {
+			continue
+		}
+		if spec.Partitions[next].Values != nil {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "Only RANGE/LIST PARTITIONING can use VALUES in partition definition")
+		}
+		partitions[i] = spec.Partitions[next]
+		next++
+	}
+	return buildRedistributePartition(ctx, alterTable, partitionBy, partitions)
+}
+
+// buildRedistributePartition builds the new partition definition of the HASH/KEY
+// partitioned table from the partitions, and the statements moving the rows placed
+// in other partitions to the sub tables of their new partitions.
+func buildRedistributePartition(ctx CompilerContext, alterTable *plan.AlterTable, partitionBy *tree.PartitionOption, partitions []*tree.Partition) (*plan.AlterTableRedistributePartition, error) {
+	tableDef := alterTable.TableDef
+	partInfo := tableDef.Partition
+	if err := checkPartitionCount(ctx.GetContext(), len(partitions)); err != nil {
+		return nil, err
+	}
+
+	newPartitionDef := DeepCopyPartitionByDef(partInfo)
+	newPartitionDef.PartitionNum = uint64(len(partitions))
+	newPartitionDef.Partitions = nil
+	newPartitionDef.PartitionTableNames = nil
+	if err := buildPartitionDefs(ctx.GetContext(), newPartitionDef, partitions); err != nil {
+		return nil, err
+	}
+
+	oldPartitionTables := make(map[string]bool, len(partInfo.PartitionTableNames))
+	for _, name := range partInfo.PartitionTableNames {
+		oldPartitionTables[name] = true
+	}
+	redistribute := &plan.AlterTableRedistributePartition{
+		PartitionDef: newPartitionDef,
+	}
+	newPartitionTables := make(map[string]bool, len(partitions))
+	for _, partition := range newPartitionDef.Partitions {
+		ok, partitionTableName := util.MakeNameOfPartitionTable(partition.PartitionName, tableDef.Name)
+		if !ok {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "invalid partition table name %s", partitionTableName)
+		}
+		partition.PartitionTableName = partitionTableName
+		newPartitionDef.PartitionTableNames = append(newPartitionDef.PartitionTableNames, partitionTableName)
+		newPartitionTables[partitionTableName] = true
+		if !oldPartitionTables[partitionTableName] {
+			redistribute.PartitionTables = append(redistribute.PartitionTables, newPartitionSubTableDef(tableDef, partitionTableName))
+		}
+	}
+	for _, name := range partInfo.PartitionTableNames {
+		if !newPartitionTables[name] {
+			redistribute.DropPartitionTableNames = append(redistribute.DropPartitionTableNames, name)
+		}
+	}
+
+	// the partitions are listed only if they are not the default ones
+	partitionBy.PartBy.Num = newPartitionDef.PartitionNum
+	partitionBy.Partitions = nil
+	for i, partition := range partitions {
+		if string(partition.Name) != fmt.Sprintf("p%d", i) || len(partition.Options) > 0 {
+			partitionBy.Partitions = partitions
+			break
+		}
+	}
+
+	var builder partitionBuilder = &hashPartitionBuilder{}
+	if partInfo.Type == plan.PartitionType_KEY || partInfo.Type == plan.PartitionType_LINEAR_KEY {
+		builder = &keyPartitionBuilder{}
+	}
+	partitionBinder, err := newAlterPartitionBinder(ctx, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	err = builder.buildEvalPartitionExpression(ctx.GetContext(), partitionBinder, partitionBy, newPartitionDef)
+	if err != nil {
+		return nil, err
+	}
+	newPartitionDef.PartitionMsg = tree.StringWithOpts(partitionBy, dialect.MYSQL, tree.WithSingleQuoteString())
+
+	partitionExprAst, err := buildPartitionExprAst(ctx.GetContext(), partitionBy, newPartitionDef)
+	if err != nil {
+		return nil, err
+	}
+	partitionExpr := tree.StringWithOpts(partitionExprAst, dialect.MYSQL, tree.WithSingleQuoteString())
+
+	var cols []*ColDef
+	for _, col := range tableDef.Cols {
+		if !col.Hidden {
+			cols = append(cols, col)
+		}
+	}
+	colList := formatColumnList(cols)
+	qualified := func(name string) string {
+		return fmt.Sprintf("`%s`.`%s`", formatStr(alterTable.Database), formatStr(name))
+	}
+	newIndexes := make(map[string]int, len(newPartitionDef.PartitionTableNames))
+	for i, name := range newPartitionDef.PartitionTableNames {
+		newIndexes[name] = i
+	}
+	// every row is moved once, from the sub table holding it to the sub table of
+	// its new partition, the rows inserted into a sub table are in its partition
+	// and never moved again. If the partition number is not changed, the rows of
+	// a partition are still in the partition at the same position.
+	sameNum := len(partInfo.PartitionTableNames) == len(newPartitionDef.PartitionTableNames)
+	for k, name := range partInfo.PartitionTableNames {
+		j, kept := newIndexes[name]
+		if sameNum && (!kept || j == k) {
+			if !kept {
+				redistribute.RedistributeSqls = append(redistribute.RedistributeSqls,
+					fmt.Sprintf("insert into %s (%s) select %s from %s",
+						qualified(newPartitionDef.PartitionTableNames[k]), colList, colList, qualified(name)))
+			}
+			continue
+		}
+		for i, target := range newPartitionDef.PartitionTableNames {
+			if kept && i == j {
+				continue
+			}
+			redistribute.RedistributeSqls = append(redistribute.RedistributeSqls,
+				fmt.Sprintf("insert into %s (%s) select %s from %s where %s = %d", qualified(target), colList, colList, qualified(name), partitionExpr, i))
+		}
+		if kept {
+			redistribute.RedistributeSqls = append(redistribute.RedistributeSqls,
+				fmt.Sprintf("delete from %s where %s != %d", qualified(name), partitionExpr, j))
+		}
+	}
+	return redistribute, nil
+}
+
+// restoreHashPartitions returns the partition definitions of the HASH/KEY partitioned table,
+// the default ones are generated if the partitions are not listed in the partition by clause.
+func restoreHashPartitions(partitionBy *tree.PartitionOption, partitionDef *plan.PartitionByDef) []*tree.Partition {
+	if len(partitionBy.Partitions) == len(partitionDef.Partitions) {
+		return slices.Clone(partitionBy.Partitions)
+	}
+	partitions := make([]*tree.Partition, len(partitionDef.Partitions))
+	for i, partition := range partitionDef.Partitions {
+		partitions[i] = tree.NewPartition(tree.Identifier(partition.PartitionName), nil, nil, nil)
+	}
+	return partitions
+}
+
+func isHashOrKeyPartition(typ plan.PartitionType) bool {
+	switch typ {
+	case plan.PartitionType_KEY, plan.PartitionType_LINEAR_KEY, plan.PartitionType_HASH, plan.PartitionType_LINEAR_HASH:
+		return true
+	}
+	return false
+}
+
+// newPartitionSubTableDef returns the definition of a sub table of the partitioned table.
+// There is no index or foreign key for the sub table.
+func newPartitionSubTableDef(tableDef *TableDef, name string) *TableDef {
+	return &TableDef{
+		Name: name,
+		Cols: deepCopyTableCols(tableDef.Cols, true),
+		Pkey: tableDef.GetPkey(),
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   catalog.SystemRelAttr_Kind,
+								Value: catalog.SystemPartitionRel,
+							},
+							{
+								Key:   catalog.SystemRelAttr_CreateSQL,
+								Value: "",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// formatColumnList returns the backquoted names of the columns separated by commas.
+func formatColumnList(cols []*ColDef) string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = "`" + formatStr(col.Name) + "`"
+	}
+	return strings.Join(names, ", ")
+}
+
+// newAlterPartitionBinder returns the binder of the partition expressions of the table.
+func newAlterPartitionBinder(ctx CompilerContext, tableDef *TableDef) (*PartitionBinder, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       nil,
+		ObjRef:      nil,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+
+	err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
+	if err != nil {
+		return nil, err
+	}
+	return NewPartitionBinder(builder, bindContext), nil
+}
+
+// restorePartitionOption regenerates the syntax tree of the partition by clause of the table.
+func restorePartitionOption(ctx context.Context, partitionDef *plan.PartitionByDef) (*tree.PartitionOption, error) {
+	ast, err := mysql.ParseOne(ctx, "create table t1() "+partitionDef.PartitionMsg, 1)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := ast.(*tree.CreateTable)
+	if !ok || createTable.PartitionOption == nil {
+		return nil, moerr.NewInternalError(ctx, "Partition definition statement Parser restore failed")
+	}
+	return createTable.PartitionOption, nil
+}
+
+// findPartitions returns the positions of the named partitions, op is the operation
+// reported when a partition does not exist.
+func findPartitions(ctx context.Context, partitionDef *plan.PartitionByDef, names tree.IdentifierList, op string) (map[int]bool, error) {
+	positions := make(map[int]bool, len(names))
+	for _, name := range names {
+		found := false
+		for i, partition := range partitionDef.Partitions {
+			if strings.EqualFold(partition.PartitionName, string(name)) {
+				positions[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewErrDropPartitionNonExistent(ctx, op)
+		}
+	}
+	return positions, nil
+}
+
+// buildPartitionExprAst returns the syntax tree of the expression computing the partition
+// number of a row, it is the same expression as the partition expression of the table.
+func buildPartitionExprAst(ctx context.Context, stmt *tree.PartitionOption, partitionDef *plan.PartitionByDef) (tree.Expr, error) {
+	if stmt.SubPartBy != nil && partitionDef.SubPartition != nil {
+		partitionAst, err := buildPartitionExprAst(ctx, &tree.PartitionOption{PartBy: stmt.PartBy, Partitions: stmt.Partitions}, partitionDef)
+		if err != nil {
+			return nil, err
+		}
+		subPartitionAst, err := buildSubPartitionExprAst(ctx, stmt, partitionDef.SubPartition)
+		if err != nil {
+			return nil, err
+		}
+		return genSubPartitionAst(partitionAst, subPartitionAst, partitionDef.SubPartition.PartitionNum), nil
+	}
+	switch partitionType := stmt.PartBy.PType.(type) {
+	case *tree.RangeType:
+		if partitionType.ColumnList == nil {
+			return buildRangeCaseWhenExpr(partitionType.Expr, stmt.Partitions)
+		}
+		return buildRangeColumnsCaseWhenExpr(partitionType.ColumnList, stmt.Partitions)
+	case *tree.ListType:
+		if partitionType.ColumnList == nil {
+			return buildListCaseWhenExpr(partitionType.Expr, stmt.Partitions)
+		}
+		return buildListColumnsCaseWhenExpr(partitionType.ColumnList, stmt.Partitions)
+	case *tree.HashType:
+		return genPartitionAst(tree.Exprs{partitionType.Expr}, int64(partitionDef.PartitionNum)), nil
+	case *tree.KeyType:
+		var astExprs tree.Exprs
+		for _, col := range partitionType.ColumnList {
+			astExprs = append(astExprs, col)
+		}
+		// KEY() uses the primary key of the table
+		if len(astExprs) == 0 && partitionDef.PartitionColumns != nil {
+			for _, col := range partitionDef.PartitionColumns.PartitionColumns {
+				astExprs = append(astExprs, tree.SetUnresolvedName(col))
+			}
+		}
+		return genPartitionAst(astExprs, int64(partitionDef.PartitionNum)), nil
+	}
+	return nil, moerr.NewNotSupported(ctx, "partition type %s", partitionDef.Type)
+}
@@ -0,0 +1,311 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPartitionTestRuntime sets the internal sql executor used while building a create table
+func setupPartitionTestRuntime() {
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupProcessLevelRuntime(rt)
+	moruntime.ProcessLevelRuntime().SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+}
+
+func buildPartitionedAlterTable(t *testing.T, mock *MockOptimizer, sql string) *plan.AlterTable {
+	setupPartitionTestRuntime()
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	return &plan.AlterTable{
+		Database: "tpch",
+		TableDef: logicPlan.GetDdl().GetCreateTable().GetTableDef(),
+	}
+}
+
+func TestDropTablePartitions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := `create table t1 (a int, b int) partition by range (a) (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than maxvalue)`
+	alterTable := buildPartitionedAlterTable(t, mock, sql)
+	partInfo := alterTable.TableDef.Partition
+
+	dropPartition, err := DropTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionDropPartitionClause(tree.AlterPartitionDropPartition, tree.IdentifierList{"P1"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{partInfo.Partitions[1].PartitionTableName}, dropPartition.PartitionTableNames)
+
+	def := dropPartition.PartitionDef
+	require.Equal(t, uint64(2), def.PartitionNum)
+	require.Equal(t, []string{partInfo.PartitionTableNames[0], partInfo.PartitionTableNames[2]}, def.PartitionTableNames)
+	require.Equal(t, "p0", def.Partitions[0].PartitionName)
+	require.Equal(t, "p2", def.Partitions[1].PartitionName)
+	require.Equal(t, uint32(2), def.Partitions[1].OrdinalPosition)
+	require.NotContains(t, def.PartitionMsg, "p1")
+	require.NotNil(t, def.PartitionExpression)
+	// the definition of the table is not changed
+	require.Equal(t, uint64(3), partInfo.PartitionNum)
+
+	errCases := []struct {
+		names tree.IdentifierList
+		all   bool
+		code  uint16
+	}{
+		{names: tree.IdentifierList{"p3"}, code: moerr.ErrDropPartitionNonExistent},
+		{names: tree.IdentifierList{"p0", "p1", "p2"}, code: moerr.ErrDropLastPartition},
+		{all: true, code: moerr.ErrDropLastPartition},
+	}
+	for _, c := range errCases {
+		spec := tree.NewAlterPartitionDropPartitionClause(tree.AlterPartitionDropPartition, c.names)
+		spec.OnAllPartitions = c.all
+		_, err = DropTablePartitions(mock.CurrentContext(), alterTable, spec)
+		require.True(t, moerr.IsMoErrCode(err, c.code), err)
+	}
+
+	sql = "create table t2 (a int, b int) partition by hash (a) partitions 2"
+	alterTable = buildPartitionedAlterTable(t, mock, sql)
+	_, err = DropTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionDropPartitionClause(tree.AlterPartitionDropPartition, tree.IdentifierList{"p0"}))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrOnlyOnRangeListPartition), err)
+}
+
+func TestTruncateTablePartitions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := `create table t1 (a int, b int) partition by list (a) (
+		partition p0 values in (1, 2),
+		partition p1 values in (3, 4),
+		partition p2 values in (5, 6))`
+	alterTable := buildPartitionedAlterTable(t, mock, sql)
+	partInfo := alterTable.TableDef.Partition
+
+	truncatePartition, err := TruncateTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionTruncatePartitionClause(tree.AlterPartitionTruncatePartition, tree.IdentifierList{"p2", "p0"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{partInfo.PartitionTableNames[0], partInfo.PartitionTableNames[2]}, truncatePartition.PartitionTableNames)
+
+	spec := tree.NewAlterPartitionTruncatePartitionClause(tree.AlterPartitionTruncatePartition, nil)
+	spec.OnAllPartitions = true
+	truncatePartition, err = TruncateTablePartitions(mock.CurrentContext(), alterTable, spec)
+	require.NoError(t, err)
+	require.Equal(t, partInfo.PartitionTableNames, truncatePartition.PartitionTableNames)
+
+	_, err = TruncateTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionTruncatePartitionClause(tree.AlterPartitionTruncatePartition, tree.IdentifierList{"p3"}))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDropPartitionNonExistent), err)
+}
+
+func TestExchangeTablePartition(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := `create table t1 (
+		n_nationkey int not null primary key,
+		n_name varchar(25) not null,
+		n_regionkey int not null,
+		n_comment varchar(152)
+	) partition by range (n_nationkey) (
+		partition p0 values less than (10),
+		partition p1 values less than maxvalue)`
+	alterTable := buildPartitionedAlterTable(t, mock, sql)
+	// align the column types with the mock table nation
+	_, nation := mock.CurrentContext().Resolve("tpch", "nation", Snapshot{TS: &timestamp.Timestamp{}})
+	for i, col := range alterTable.TableDef.Cols {
+		if !col.Hidden {
+			col.Typ = nation.Cols[i].Typ
+		}
+	}
+
+	exchange := func(partitionName, tableName string, withoutValidation bool) (*plan.AlterTableExchangePartition, error) {
+		spec := tree.NewAlterPartitionExchangePartitionClause(tree.AlterPartitionExchangePartition,
+			tree.Identifier(partitionName), tree.NewTableName(tree.Identifier(tableName), tree.ObjectNamePrefix{}, nil), withoutValidation)
+		return ExchangeTablePartition(mock.CurrentContext(), alterTable, spec)
+	}
+
+	exchangePartition, err := exchange("p1", "nation", false)
+	require.NoError(t, err)
+	partitionTableName := alterTable.TableDef.Partition.Partitions[1].PartitionTableName
+	require.Equal(t, partitionTableName, exchangePartition.PartitionTableName)
+	require.Equal(t, "nation", exchangePartition.TableName)
+	require.Equal(t, nation.Createsql, exchangePartition.CreateSql)
+	require.Contains(t, exchangePartition.ValidateSql, "from `tpch`.`nation` where")
+	require.True(t, strings.HasSuffix(exchangePartition.ValidateSql, "!= 1"))
+
+	exchangePartition, err = exchange("p0", "nation", true)
+	require.NoError(t, err)
+	require.Empty(t, exchangePartition.ValidateSql)
+
+	errCases := []struct {
+		partitionName string
+		tableName     string
+		code          uint16
+	}{
+		{partitionName: "p2", tableName: "nation", code: moerr.ErrUnknownPartition},
+		{partitionName: "p0", tableName: "nation2", code: moerr.ErrTablesDifferentMetadata},
+		{partitionName: "p0", tableName: "region", code: moerr.ErrTablesDifferentMetadata},
+		{partitionName: "p0", tableName: "no_such_table", code: moerr.ErrNoSuchTable},
+	}
+	for _, c := range errCases {
+		_, err = exchange(c.partitionName, c.tableName, false)
+		require.True(t, moerr.IsMoErrCode(err, c.code), err)
+	}
+}
+
+func TestRedistributeTablePartitions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "create table t1 (a int primary key, b int) partition by hash (a) partitions 4"
+	alterTable := buildPartitionedAlterTable(t, mock, sql)
+	partInfo := alterTable.TableDef.Partition
+
+	// ADD PARTITION PARTITIONS 2
+	spec := tree.NewAlterPartitionAddPartitionClause(tree.AlterPartitionAddPartition, nil)
+	spec.PartitionNum = 2
+	redistribute, err := AddHashTablePartitions(mock.CurrentContext(), alterTable, spec)
+	require.NoError(t, err)
+	def := redistribute.PartitionDef
+	require.Equal(t, uint64(6), def.PartitionNum)
+	require.Equal(t, "p5", def.Partitions[5].PartitionName)
+	require.Equal(t, uint32(6), def.Partitions[5].OrdinalPosition)
+	require.Equal(t, partInfo.PartitionTableNames, def.PartitionTableNames[:4])
+	require.Len(t, redistribute.PartitionTables, 2)
+	require.Equal(t, def.PartitionTableNames[4], redistribute.PartitionTables[0].Name)
+	require.Empty(t, redistribute.DropPartitionTableNames)
+	require.Equal(t, "partition by hash (a) partitions 6", def.PartitionMsg)
+	// the rows of every sub table are moved to the other 5 sub tables once
+	require.Len(t, redistribute.RedistributeSqls, 4*(5+1))
+	require.True(t, strings.HasSuffix(redistribute.RedistributeSqls[4], "% 6 = 5"))
+	require.True(t, strings.HasPrefix(redistribute.RedistributeSqls[5], "delete from"))
+	require.True(t, strings.HasSuffix(redistribute.RedistributeSqls[5], "% 6 != 0"))
+	// the definition of the table is not changed
+	require.Equal(t, uint64(4), partInfo.PartitionNum)
+
+	// ADD PARTITION (PARTITION px)
+	spec = tree.NewAlterPartitionAddPartitionClause(tree.AlterPartitionAddPartition,
+		[]*tree.Partition{tree.NewPartition("px", nil, nil, nil)})
+	redistribute, err = AddHashTablePartitions(mock.CurrentContext(), alterTable, spec)
+	require.NoError(t, err)
+	require.Equal(t, "partition by hash (a) partitions 5 (partition p0, partition p1, partition p2, partition p3, partition px)",
+		redistribute.PartitionDef.PartitionMsg)
+
+	// COALESCE PARTITION 3
+	redistribute, err = CoalesceTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionCoalescePartitionClause(tree.AlterPartitionCoalescePartition, 3))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), redistribute.PartitionDef.PartitionNum)
+	require.Empty(t, redistribute.PartitionTables)
+	require.Equal(t, partInfo.PartitionTableNames[1:], redistribute.DropPartitionTableNames)
+
+	// REORGANIZE PARTITION p1 INTO (PARTITION px)
+	redistribute, err = ReorganizeTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionReorganizePartitionClause(tree.AlterPartitionReorganizePartition,
+			tree.IdentifierList{"p1"}, []*tree.Partition{tree.NewPartition("px", nil, nil, nil)}))
+	require.NoError(t, err)
+	require.Equal(t, "px", redistribute.PartitionDef.Partitions[1].PartitionName)
+	require.Len(t, redistribute.PartitionTables, 1)
+	require.Equal(t, []string{partInfo.PartitionTableNames[1]}, redistribute.DropPartitionTableNames)
+	// only the rows of the reorganized partition are moved
+	require.Len(t, redistribute.RedistributeSqls, 1)
+	require.NotContains(t, redistribute.RedistributeSqls[0], "where")
+
+	// REORGANIZE PARTITION
+	redistribute, err = ReorganizeTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionReorganizePartitionClause(tree.AlterPartitionReorganizePartition, nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, partInfo.PartitionTableNames, redistribute.PartitionDef.PartitionTableNames)
+	require.Empty(t, redistribute.PartitionTables)
+	require.Empty(t, redistribute.DropPartitionTableNames)
+	require.Empty(t, redistribute.RedistributeSqls)
+
+	// REORGANIZE PARTITION p0, p1 INTO (PARTITION p1, PARTITION p0) swaps the sub tables
+	redistribute, err = ReorganizeTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionReorganizePartitionClause(tree.AlterPartitionReorganizePartition,
+			tree.IdentifierList{"p0", "p1"}, []*tree.Partition{tree.NewPartition("p1", nil, nil, nil), tree.NewPartition("p0", nil, nil, nil)}))
+	require.NoError(t, err)
+	require.Len(t, redistribute.RedistributeSqls, 2*(3+1))
+	require.True(t, strings.HasSuffix(redistribute.RedistributeSqls[3], "% 4 != 1"))
+
+	spec = tree.NewAlterPartitionAddPartitionClause(tree.AlterPartitionAddPartition,
+		[]*tree.Partition{tree.NewPartition("p0", nil, nil, nil)})
+	_, err = AddHashTablePartitions(mock.CurrentContext(), alterTable, spec)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSameNamePartition), err)
+	_, err = AddHashTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionAddPartitionClause(tree.AlterPartitionAddPartition, nil))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAddPartitionNoNewPartition), err)
+	_, err = CoalesceTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionCoalescePartitionClause(tree.AlterPartitionCoalescePartition, 0))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCoalescePartitionNoPartition), err)
+	_, err = CoalesceTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionCoalescePartitionClause(tree.AlterPartitionCoalescePartition, 4))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDropLastPartition), err)
+	_, err = ReorganizeTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionReorganizePartitionClause(tree.AlterPartitionReorganizePartition,
+			tree.IdentifierList{"p1", "p2"}, []*tree.Partition{tree.NewPartition("px", nil, nil, nil)}))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrReorgHashOnlyOnSameNumber), err)
+
+	sql = "create table t2 (a int, b int) partition by range (a) (partition p0 values less than (10), partition p1 values less than maxvalue)"
+	alterTable = buildPartitionedAlterTable(t, mock, sql)
+	_, err = CoalesceTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionCoalescePartitionClause(tree.AlterPartitionCoalescePartition, 1))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCoalesceOnlyOnHashPartition), err)
+	_, err = ReorganizeTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionReorganizePartitionClause(tree.AlterPartitionReorganizePartition, nil, nil))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrReorgNoParam), err)
+}
+
+func TestRedistributeKeyTablePartitions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "create table t1 (a int primary key, b int) partition by key () partitions 2"
+	alterTable := buildPartitionedAlterTable(t, mock, sql)
+
+	spec := tree.NewAlterPartitionAddPartitionClause(tree.AlterPartitionAddPartition, nil)
+	spec.PartitionNum = 1
+	redistribute, err := AddHashTablePartitions(mock.CurrentContext(), alterTable, spec)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), redistribute.PartitionDef.PartitionNum)
+	require.NotNil(t, redistribute.PartitionDef.PartitionExpression)
+	require.True(t, strings.HasSuffix(redistribute.RedistributeSqls[1], "% 3 = 2"))
+}
+
+func TestForeignKeyOnPartitionedTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := `create table t1 (a int primary key, b int, foreign key (b) references nation(n_nationkey))
+		partition by range (a) (partition p0 values less than (10), partition p1 values less than maxvalue)`
+	alterTable := buildPartitionedAlterTable(t, mock, sql)
+	require.Len(t, alterTable.TableDef.Fkeys, 1)
+
+	_, err := ExchangeTablePartition(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionExchangePartitionClause(tree.AlterPartitionExchangePartition, "p0", tree.NewTableName("nation", tree.ObjectNamePrefix{}, nil), false))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported), err)
+
+	// the rows of the referenced table can not be removed without the foreign key actions
+	alterTable.TableDef.Fkeys = nil
+	alterTable.TableDef.RefChildTbls = []uint64{1}
+	_, err = DropTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionDropPartitionClause(tree.AlterPartitionDropPartition, tree.IdentifierList{"p0"}))
+	require.Error(t, err)
+	_, err = TruncateTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionTruncatePartitionClause(tree.AlterPartitionTruncatePartition, tree.IdentifierList{"p0"}))
+	require.Error(t, err)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 func checkDropColumnWithPrimaryKey(colName string, pkey *plan.PrimaryKeyDef, ctx CompilerContext) error {
@@ -60,6 +61,26 @@ func checkAlterColumnWithPartitionKeys(colName string, tblInfo *TableDef, ctx Co
 	return nil
 }
 
+// isForeignKeyOnlyAlter returns true if the options only add or drop foreign keys, the
+// foreign keys are checked on the logical table, so they work for the partitioned table.
+func isForeignKeyOnlyAlter(options tree.AlterTableOptions) bool {
+	for _, option := range options {
+		switch opt := option.(type) {
+		case *tree.AlterOptionAdd:
+			if _, ok := opt.Def.(*tree.ForeignKey); !ok {
+				return false
+			}
+		case *tree.AlterOptionDrop:
+			if opt.Typ != tree.AlterTableDropForeignKey {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func checkDropColumnWithCluster(colName string, tblInfo *TableDef, ctx CompilerContext) error {
 	//if tblInfo.ClusterBy != nil {
 	//	// We do not support drop column that dependent foreign keys constraints
@@ -85,7 +85,7 @@ func (builder *QueryBuilder) applyIndicesForFilters(nodeID int32, node *plan.Nod
 	// 1. Master Index Check
 	{
 		masterIndexes := make([]*plan.IndexDef, 0)
-		for _, indexDef := range node.TableDef.Indexes {
+		for _, indexDef := range builder.hintedIndexes(node) {
 			if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexDef.IndexAlgo) {
 				masterIndexes = append(masterIndexes, indexDef)
 			}
@@ -168,7 +168,7 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 
 		// 1.a if there are no table scans with multi-table indexes, skip
 		multiTableIndexes := make(map[string]*MultiTableIndex)
-		for _, indexDef := range scanNode.TableDef.Indexes {
+		for _, indexDef := range builder.hintedIndexes(scanNode) {
 			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
 				if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 					multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
@@ -199,17 +199,17 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 
 		// 1.d if the order by argument order is not of the form dist_func(col, const), swap and see
 		// if that works. if not, skip
-		if isRuntimeConstExpr(distFnExpr.Args[0]) && distFnExpr.Args[1].GetCol() != nil {
+		if isRuntimeConstExpr(distFnExpr.Args[0]) && getVectorIndexColRef(distFnExpr.Args[1]) != nil {
 			distFnExpr.Args[0], distFnExpr.Args[1] = distFnExpr.Args[1], distFnExpr.Args[0]
 		}
 		if !isRuntimeConstExpr(distFnExpr.Args[1]) {
 			goto END0
 		}
-		if distFnExpr.Args[0].GetCol() == nil {
+		if getVectorIndexColRef(distFnExpr.Args[0]) == nil {
 			goto END0
 		}
 		// NOTE: here we assume the first argument is the column to order by
-		colPosOrderBy := distFnExpr.Args[0].GetCol().ColPos
+		colPosOrderBy := getVectorIndexColRef(distFnExpr.Args[0]).ColPos
 
 		// 1.d if the distance function in sortNode is not indexed for that column in any of the IVFFLAT index, skip
 		distanceFunctionIndexed := false
@@ -256,8 +256,14 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 			goto END0
 		}
 
+		// 1.e if the scan is filtered, decide whether to widen the probe or to skip the index
+		filterPlan := builder.planIvfFilteredSearch(sortNode, scanNode, multiTableIndexWithSortDistFn)
+		if filterPlan.bruteForce {
+			goto END0
+		}
+
 		newSortNode := builder.applyIndicesForSortUsingVectorIndex(nodeID, projNode, sortNode, scanNode,
-			colRefCnt, idxColMap, multiTableIndexWithSortDistFn, colPosOrderBy)
+			colRefCnt, idxColMap, multiTableIndexWithSortDistFn, colPosOrderBy, filterPlan)
 
 		// TODO: consult with nitao and aungr
 		projNode.Children[0] = newSortNode
@@ -293,7 +299,7 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 		pkPos = node.TableDef.Name2ColIndex[node.TableDef.Pkey.Names[0]]
 	}
 
-	indexes := node.TableDef.Indexes
+	indexes := builder.hintedIndexes(node)
 	sort.Slice(indexes, func(i, j int) bool {
 		return (indexes[i].Unique && !indexes[j].Unique) || (indexes[i].Unique == indexes[j].Unique && len(indexes[i].Parts) > len(indexes[j].Parts))
 	})
@@ -789,7 +795,7 @@ END0:
 		}
 
 		idxTag := builder.genNewTag()
-		idxDef := node.TableDef.Indexes[idxPos]
+		idxDef := indexes[idxPos]
 		//idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, *ts)
 		idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, *scanSnapshot)
 		builder.addNameByColRef(idxTag, idxTableDef)
@@ -871,7 +877,7 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 	}
 
 	leftChild := builder.qry.Nodes[node.Children[0]]
-	if leftChild.NodeType != plan.Node_TABLE_SCAN {
+	if leftChild.NodeType != plan.Node_TABLE_SCAN || builder.isHashJoinHinted(leftChild) {
 		return nodeID
 	}
 
@@ -928,7 +934,7 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 		return nodeID
 	}
 
-	indexes := leftChild.TableDef.Indexes
+	indexes := builder.hintedIndexes(leftChild)
 	condIdx := make([]int, 0, len(col2Cond))
 	for _, idxDef := range indexes {
 		if !idxDef.TableExist {
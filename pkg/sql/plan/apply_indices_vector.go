@@ -15,6 +15,11 @@
 package plan
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -34,22 +39,70 @@ var (
 			2. Nodes that require BindingTags: TableScan, Project
 	*/
 	distFuncOpTypes = map[string]string{
-		"l2_distance":     "vector_l2_ops",
-		"inner_product":   "vector_ip_ops",
-		"cosine_distance": "vector_cosine_ops",
+		"l2_distance":      "vector_l2_ops",
+		"inner_product":    "vector_ip_ops",
+		"cosine_distance":  "vector_cosine_ops",
+		"hamming_distance": "vector_hamming_ops",
 	}
 	distFuncInternalDistFunc = map[string]string{
-		"l2_distance":     "l2_distance_sq",
-		"inner_product":   "spherical_distance",
-		"cosine_distance": "spherical_distance",
+		"l2_distance":      "l2_distance_sq",
+		"inner_product":    "spherical_distance",
+		"cosine_distance":  "spherical_distance",
+		"hamming_distance": "hamming_distance",
 	}
 	textType = types.T_text.ToType() // return type of @probe_limit
 )
 
+const (
+	// ivfFilterModePost probes the centroids first and filters the joined rows afterwards.
+	ivfFilterModePost = "post"
+	// ivfFilterModePre probes the centroids the filtered rows are expected to fill the limit with,
+	// and widens the probe until enough rows survive the scalar filter.
+	ivfFilterModePre = "pre"
+	// ivfFilterModeAuto chooses between the pre-filtered index probe and a brute-force scan by cost.
+	ivfFilterModeAuto = "auto"
+)
+
+// ivfFilterPlan is the outcome of planning a vector search whose table scan has a filter.
+type ivfFilterPlan struct {
+	// bruteForce means the index should not be used at all.
+	bruteForce bool
+	// minProbeLimit is the lower bound of centroids to probe in the first round, the probe is
+	// widened by the rounds after until the limit is filled, see WidenIvfProbe. 0 means
+	// @probe_limit is used as is.
+	minProbeLimit int64
+	// lists is the number of the lists of the index.
+	lists int64
+}
+
+const (
+	// ivfProbeOptionFormat marks the sort of a pre-filtered vector search in its ExtraOptions with
+	// the centroids probed at least and the lists of the index.
+	ivfProbeOptionFormat = "ivf_probe:%d,%d"
+	// ivfProbeCentroidsOption marks the sort of the centroids below it, the node ids are not
+	// kept by the optimizer.
+	ivfProbeCentroidsOption = "ivf_probe_centroids"
+)
+
+// getVectorIndexColRef returns the column referenced by the vector argument of a distance function.
+// A veci8 column is implicitly cast to vecf32 when compared with a vecf32 literal, so the cast is
+// looked through.
+func getVectorIndexColRef(expr *plan.Expr) *plan.ColRef {
+	if col := expr.GetCol(); col != nil {
+		return col
+	}
+	if fn := expr.GetF(); fn != nil && fn.Func.ObjName == "cast" {
+		if col := fn.Args[0].GetCol(); col != nil && fn.Args[0].Typ.Id == int32(types.T_array_int8) {
+			return col
+		}
+	}
+	return nil
+}
+
 // You replace Sort Node with a new Project Node
 func (builder *QueryBuilder) applyIndicesForSortUsingVectorIndex(nodeID int32, projNode, sortNode, scanNode *plan.Node,
 	colRefCnt map[[2]int32]int, idxColMap map[[2]int32]*plan.Expr, multiTableIndexWithSortDistFn *MultiTableIndex,
-	colPosOrderBy int32) int32 {
+	colPosOrderBy int32, filterPlan ivfFilterPlan) int32 {
 
 	var pkPos = scanNode.TableDef.Name2ColIndex[scanNode.TableDef.Pkey.PkeyColName] //TODO: watch out.
 
@@ -100,7 +153,8 @@ func (builder *QueryBuilder) applyIndicesForSortUsingVectorIndex(nodeID int32, p
 	metaForCurrVersion1, castMetaValueColToBigInt, _ := makeMetaTblScanWhereKeyEqVersionAndCastVersion(builder, builder.ctxByNode[nodeID],
 		idxTableDefs, idxObjRefs, idxTags, "meta")
 	centroidsForCurrVersionAndProbeLimit, _ := makeCentroidsSingleJoinMetaOnCurrVersionOrderByL2Dist(builder,
-		builder.ctxByNode[nodeID], idxTableDefs, idxObjRefs, idxTags, metaForCurrVersion1, distFnExpr, sortDirection, castMetaValueColToBigInt)
+		builder.ctxByNode[nodeID], idxTableDefs, idxObjRefs, idxTags, metaForCurrVersion1, distFnExpr, sortDirection, castMetaValueColToBigInt,
+		filterPlan.minProbeLimit)
 
 	// 2.c Create Entries Node
 	entriesTblScan, _ := makeEntriesTblScan(builder, builder.ctxByNode[nodeID], idxTableDefs, idxObjRefs, idxTags)
@@ -191,6 +245,13 @@ func (builder *QueryBuilder) applyIndicesForSortUsingVectorIndex(nodeID int32, p
 		sortTblByL2Distance := makeInnerJoinOrderByL2Distance(builder, builder.ctxByNode[nodeID],
 			distFnExpr, tlbJoinEntries, sortDirection, idxTableDefs, idxTags, sortNode)
 
+		// 3. Mark the sort to widen the probe if the filtered rows can not fill the limit
+		if filterPlan.minProbeLimit > 0 {
+			builder.qry.Nodes[sortTblByL2Distance].ExtraOptions = fmt.Sprintf(ivfProbeOptionFormat,
+				filterPlan.minProbeLimit, filterPlan.lists)
+			builder.qry.Nodes[centroidsForCurrVersionAndProbeLimit].ExtraOptions = ivfProbeCentroidsOption
+		}
+
 		return sortTblByL2Distance
 	}
 }
@@ -223,7 +284,8 @@ func makeMetaTblScanWhereKeyEqVersionAndCastVersion(builder *QueryBuilder, bindC
 
 func makeCentroidsSingleJoinMetaOnCurrVersionOrderByL2Dist(builder *QueryBuilder, bindCtx *BindContext,
 	indexTableDefs []*TableDef, idxRefs []*ObjectRef, idxTags map[string]int32,
-	metaTableScanId int32, distFnExpr *plan.Function, sortDirection plan.OrderBySpec_OrderByFlag, castMetaValueColToBigInt *Expr) (int32, error) {
+	metaTableScanId int32, distFnExpr *plan.Function, sortDirection plan.OrderBySpec_OrderByFlag, castMetaValueColToBigInt *Expr,
+	minProbeLimit int64) (int32, error) {
 
 	// 1. Scan <version, centroid_id, centroid> from centroids table
 	centroidsScanId, scanCols, _ := makeHiddenTblScanWithBindingTag(builder, bindCtx, indexTableDefs[1], idxRefs[1],
@@ -304,6 +366,13 @@ func makeCentroidsSingleJoinMetaOnCurrVersionOrderByL2Dist(builder *QueryBuilder
 		return -1, err
 	}
 
+	// 4.5 CASE WHEN probe < min_probe THEN min_probe ELSE probe END, when the filter needs a wider probe
+	if minProbeLimit > 0 {
+		if ifNullLimitExpr, err = makeIvfProbeLimitAtLeast(builder.GetContext(), ifNullLimitExpr, minProbeLimit); err != nil {
+			return -1, err
+		}
+	}
+
 	sortCentroidsByL2DistanceId := builder.appendNode(&plan.Node{
 		NodeType: plan.Node_SORT,
 		Children: []int32{joinMetaAndCentroidsId},
@@ -319,6 +388,210 @@ func makeCentroidsSingleJoinMetaOnCurrVersionOrderByL2Dist(builder *QueryBuilder
 	return sortCentroidsByL2DistanceId, nil
 }
 
+// makeIvfProbeLimitAtLeast returns CASE WHEN probe < min_probe THEN min_probe ELSE probe END.
+func makeIvfProbeLimitAtLeast(ctx context.Context, probeLimitExpr *Expr, minProbeLimit int64) (*Expr, error) {
+	minProbeLimitExpr := makePlan2Uint64ConstExprWithType(uint64(minProbeLimit))
+	lessThanMin, err := BindFuncExprImplByPlanExpr(ctx, "<", []*plan.Expr{
+		DeepCopyExpr(probeLimitExpr),
+		minProbeLimitExpr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return BindFuncExprImplByPlanExpr(ctx, "case", []*plan.Expr{
+		lessThanMin,
+		DeepCopyExpr(minProbeLimitExpr),
+		probeLimitExpr,
+	})
+}
+
+// planIvfFilteredSearch decides how a vector search over a filtered table scan should use the IVFFLAT index.
+// In "pre" mode the first round probes as many centroids as the filtered rows are expected to fill the
+// limit with, and the probe is doubled round by round until the limit is filled. In "auto" mode the
+// index is additionally dropped in favour of a brute-force scan when that is cheaper than the first round.
+func (builder *QueryBuilder) planIvfFilteredSearch(sortNode, scanNode *plan.Node, multiTableIndex *MultiTableIndex) ivfFilterPlan {
+	if len(scanNode.FilterList) == 0 || scanNode.Stats == nil {
+		return ivfFilterPlan{}
+	}
+
+	mode := builder.getIvfFilterMode()
+	if mode == ivfFilterModePost {
+		return ivfFilterPlan{}
+	}
+
+	k := getLiteralLimitAndOffset(sortNode)
+	if k <= 0 {
+		return ivfFilterPlan{}
+	}
+
+	lists := getIvfIndexLists(multiTableIndex)
+	tableCnt := scanNode.Stats.TableCnt
+	if lists <= 0 || tableCnt < 1 {
+		return ivfFilterPlan{}
+	}
+
+	selectivity := scanNode.Stats.Selectivity
+	if selectivity <= 0 {
+		selectivity = 1 / tableCnt
+	}
+	if selectivity > 1 {
+		selectivity = 1
+	}
+	rowsPerList := tableCnt / float64(lists)
+
+	probe := calcIvfPreFilterProbeLimit(k, lists, rowsPerList, selectivity)
+	if mode == ivfFilterModeAuto && isIvfBruteForceCheaper(selectivity*tableCnt, lists, probe, rowsPerList) {
+		return ivfFilterPlan{bruteForce: true}
+	}
+	return ivfFilterPlan{minProbeLimit: probe, lists: lists}
+}
+
+func (builder *QueryBuilder) getIvfFilterMode() string {
+	v, err := builder.compCtx.ResolveVariable("ivf_filter_mode", true, false)
+	if err != nil || v == nil {
+		return ivfFilterModePost
+	}
+	switch mode := strings.ToLower(fmt.Sprintf("%v", v)); mode {
+	case ivfFilterModePre, ivfFilterModeAuto:
+		return mode
+	default:
+		return ivfFilterModePost
+	}
+}
+
+// getLiteralLimitAndOffset returns limit + offset of the sort node, or 0 if they are not constants.
+func getLiteralLimitAndOffset(sortNode *plan.Node) int64 {
+	if sortNode.Limit == nil {
+		return 0
+	}
+	limit, ok := getU64Literal(sortNode.Limit)
+	if !ok {
+		return 0
+	}
+	if sortNode.Offset != nil {
+		offset, ok := getU64Literal(sortNode.Offset)
+		if !ok {
+			return 0
+		}
+		limit += offset
+	}
+	return int64(limit)
+}
+
+func getU64Literal(expr *plan.Expr) (uint64, bool) {
+	if cExpr, ok := expr.Expr.(*plan.Expr_Lit); ok {
+		if c, ok := cExpr.Lit.Value.(*plan.Literal_U64Val); ok {
+			return c.U64Val, true
+		}
+	}
+	return 0, false
+}
+
+func getIvfIndexLists(multiTableIndex *MultiTableIndex) int64 {
+	idxDef := multiTableIndex.IndexDefs[catalog.SystemSI_IVFFLAT_TblType_Metadata]
+	if idxDef == nil {
+		return 0
+	}
+	params, err := catalog.IndexParamsStringToMap(idxDef.IndexAlgoParams)
+	if err != nil {
+		return 0
+	}
+	lists, err := strconv.ParseInt(params[catalog.IndexAlgoParamLists], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return lists
+}
+
+// calcIvfPreFilterProbeLimit estimates the centroids probed in the first round by doubling them until the
+// rows that are expected to pass the filter in those centroids can fill k, or until every centroid is probed.
+func calcIvfPreFilterProbeLimit(k, lists int64, rowsPerList, selectivity float64) int64 {
+	probe := int64(1)
+	for probe < lists && float64(probe)*rowsPerList*selectivity < float64(k) {
+		probe *= 2
+	}
+	if probe > lists {
+		probe = lists
+	}
+	return probe
+}
+
+// isIvfBruteForceCheaper compares the distance computations of both plans. The filtered table is
+// scanned either way, so brute force pays one distance per filtered row, while the first round of
+// the index pays one per centroid plus one per entry of the probed lists. The rounds after are not
+// counted, as the first round is expected to fill the limit.
+func isIvfBruteForceCheaper(filteredCnt float64, lists, probe int64, rowsPerList float64) bool {
+	indexCost := float64(lists) + float64(probe)*rowsPerList
+	return filteredCnt <= indexCost
+}
+
+// getIvfProbeSortNode returns the sort of the pre-filtered vector search returning the rows of the
+// query, the sort of the centroids below it, and the centroids probed at least and the lists it is
+// marked with.
+func getIvfProbeSortNode(pn *Plan) (sortNode, centroidsNode *plan.Node, probe, lists int64, ok bool) {
+	qry := pn.GetQuery()
+	if qry == nil || len(qry.Steps) != 1 {
+		return nil, nil, 0, 0, false
+	}
+	node := qry.Nodes[qry.Steps[0]]
+	for node.NodeType == plan.Node_PROJECT && len(node.Children) == 1 {
+		node = qry.Nodes[node.Children[0]]
+	}
+	if node.NodeType != plan.Node_SORT || node.ExtraOptions == "" {
+		return nil, nil, 0, 0, false
+	}
+	if _, err := fmt.Sscanf(node.ExtraOptions, ivfProbeOptionFormat, &probe, &lists); err != nil || probe <= 0 {
+		return nil, nil, 0, 0, false
+	}
+	if centroidsNode = findIvfProbeCentroidsNode(qry, node); centroidsNode == nil {
+		return nil, nil, 0, 0, false
+	}
+	return node, centroidsNode, probe, lists, true
+}
+
+func findIvfProbeCentroidsNode(qry *plan.Query, node *plan.Node) *plan.Node {
+	for _, child := range node.Children {
+		childNode := qry.Nodes[child]
+		if childNode.NodeType == plan.Node_SORT && childNode.ExtraOptions == ivfProbeCentroidsOption {
+			return childNode
+		}
+		if found := findIvfProbeCentroidsNode(qry, childNode); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// GetIvfProbeLimit returns the rows the pre-filtered vector search of the query returns if it finds
+// enough rows in the lists probed. It returns false if the query is not such a search.
+func GetIvfProbeLimit(pn *Plan) (int64, bool) {
+	sortNode, _, _, _, ok := getIvfProbeSortNode(pn)
+	if !ok || sortNode.Limit == nil {
+		return 0, false
+	}
+	limit, ok := getU64Literal(sortNode.Limit)
+	return int64(limit), ok
+}
+
+// WidenIvfProbe returns a copy of the plan of the pre-filtered vector search probing twice the
+// centroids at least. It returns false if all the lists are probed already.
+func WidenIvfProbe(ctx context.Context, pn *Plan) (*Plan, bool, error) {
+	if _, _, probe, lists, ok := getIvfProbeSortNode(pn); !ok || probe >= lists {
+		return nil, false, nil
+	}
+	widened := DeepCopyPlan(pn)
+	sortNode, centroidsNode, probe, lists, _ := getIvfProbeSortNode(widened)
+	probe = min(probe*2, lists)
+
+	limit, err := makeIvfProbeLimitAtLeast(ctx, centroidsNode.Limit, probe)
+	if err != nil {
+		return nil, false, err
+	}
+	centroidsNode.Limit = limit
+	sortNode.ExtraOptions = fmt.Sprintf(ivfProbeOptionFormat, probe, lists)
+	return widened, true, nil
+}
+
 func makeEntriesTblScan(builder *QueryBuilder, bindCtx *BindContext, indexTableDefs []*TableDef, idxRefs []*ObjectRef, idxTags map[string]int32) (int32, error) {
 
 	// 1. Scan <version, centroid_id_fk, origin_pk, embedding> from entries table
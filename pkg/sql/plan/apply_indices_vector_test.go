@@ -0,0 +1,115 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcIvfPreFilterProbeLimit(t *testing.T) {
+	// no filter, one list already has enough rows
+	require.Equal(t, int64(1), calcIvfPreFilterProbeLimit(10, 100, 1000, 1))
+	// 1% selectivity, 10 rows per list survive, need 4 lists for k=30
+	require.Equal(t, int64(4), calcIvfPreFilterProbeLimit(30, 100, 1000, 0.01))
+	// very selective filter, widened up to all lists
+	require.Equal(t, int64(100), calcIvfPreFilterProbeLimit(10, 100, 1000, 0.00001))
+	require.Equal(t, int64(1), calcIvfPreFilterProbeLimit(10, 1, 1000, 0.00001))
+}
+
+func TestIsIvfBruteForceCheaper(t *testing.T) {
+	// 50 filtered rows vs 100 centroids + 1 list of 1000 entries
+	require.True(t, isIvfBruteForceCheaper(50, 100, 1, 1000))
+	// 500k filtered rows vs 100 centroids + 4 lists of 1000 entries
+	require.False(t, isIvfBruteForceCheaper(500000, 100, 4, 1000))
+}
+
+func TestGetLiteralLimitAndOffset(t *testing.T) {
+	node := &plan.Node{}
+	require.Equal(t, int64(0), getLiteralLimitAndOffset(node))
+
+	node.Limit = makePlan2Uint64ConstExprWithType(10)
+	require.Equal(t, int64(10), getLiteralLimitAndOffset(node))
+
+	node.Offset = makePlan2Uint64ConstExprWithType(5)
+	require.Equal(t, int64(15), getLiteralLimitAndOffset(node))
+
+	node.Limit = MakePlan2StringConstExprWithType("10")
+	require.Equal(t, int64(0), getLiteralLimitAndOffset(node))
+}
+
+func TestGetIvfIndexLists(t *testing.T) {
+	idx := &MultiTableIndex{
+		IndexDefs: map[string]*plan.IndexDef{
+			catalog.SystemSI_IVFFLAT_TblType_Metadata: {
+				IndexAlgoParams: `{"lists":"16","op_type":"vector_l2_ops"}`,
+			},
+		},
+	}
+	require.Equal(t, int64(16), getIvfIndexLists(idx))
+	require.Equal(t, int64(0), getIvfIndexLists(&MultiTableIndex{IndexDefs: map[string]*plan.IndexDef{}}))
+}
+
+func TestWidenIvfProbe(t *testing.T) {
+	ctx := context.TODO()
+	probeLimit := makePlan2Uint64ConstExprWithType(1)
+	qry := &plan.Query{
+		Steps: []int32{2},
+		Nodes: []*plan.Node{
+			{NodeId: 0, NodeType: plan.Node_SORT, Limit: probeLimit, ExtraOptions: ivfProbeCentroidsOption},
+			{NodeId: 1, NodeType: plan.Node_SORT, Children: []int32{0},
+				Limit:        makePlan2Uint64ConstExprWithType(10),
+				ExtraOptions: fmt.Sprintf(ivfProbeOptionFormat, 2, 5)},
+			{NodeId: 2, NodeType: plan.Node_PROJECT, Children: []int32{1}},
+		},
+	}
+	pn := &Plan{Plan: &plan.Plan_Query{Query: qry}}
+
+	limit, ok := GetIvfProbeLimit(pn)
+	require.True(t, ok)
+	require.Equal(t, int64(10), limit)
+
+	// the probe is doubled up to the lists, the plan is copied
+	widened, ok, err := WidenIvfProbe(ctx, pn)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, fmt.Sprintf(ivfProbeOptionFormat, 4, 5), widened.GetQuery().Nodes[1].ExtraOptions)
+	require.Equal(t, probeLimit, qry.Nodes[0].Limit)
+	require.Equal(t, uint64(10), widened.GetQuery().Nodes[1].Limit.GetLit().GetU64Val())
+	// CASE WHEN probe < 4 THEN 4 ELSE probe END
+	caseExpr := widened.GetQuery().Nodes[0].Limit.GetF()
+	require.Equal(t, "case", caseExpr.Func.ObjName)
+	require.Equal(t, uint64(4), caseExpr.Args[1].GetLit().GetU64Val())
+
+	widened, ok, err = WidenIvfProbe(ctx, widened)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, fmt.Sprintf(ivfProbeOptionFormat, 5, 5), widened.GetQuery().Nodes[1].ExtraOptions)
+
+	// all the lists are probed
+	_, ok, err = WidenIvfProbe(ctx, widened)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the search does not return the rows of the query
+	qry.Steps = []int32{0}
+	_, ok = GetIvfProbeLimit(pn)
+	require.False(t, ok)
+}
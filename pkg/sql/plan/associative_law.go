@@ -115,7 +115,7 @@ func (builder *QueryBuilder) applyAssociativeLawRule3(nodeID int32) int32 {
 }
 
 func (builder *QueryBuilder) applyAssociativeLaw(nodeID int32) int32 {
-	if builder.optimizerHints != nil && builder.optimizerHints.joinOrdering != 0 {
+	if (builder.optimizerHints != nil && builder.optimizerHints.joinOrdering != 0) || builder.hasJoinOrderHint() {
 		return nodeID
 	}
 	nodeID = builder.applyAssociativeLawRule1(nodeID)
@@ -92,22 +92,29 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		// check existence
 		if b.GetContext() != nil && b.GetContext().Value(defines.InSp{}) != nil && b.GetContext().Value(defines.InSp{}).(bool) {
 			tmpScope := b.GetContext().Value(defines.VarScopeKey{}).(*[]map[string]interface{})
-			for i := len(*tmpScope) - 1; i >= 0; i-- {
-				curScope := (*tmpScope)[i]
-				if _, ok := curScope[strings.ToLower(exprImpl.Parts[0])]; ok {
-					typ := types.T_text.ToType()
-					expr = &Expr{
-						Typ: makePlan2Type(&typ),
-						Expr: &plan.Expr_V{
-							V: &plan.VarRef{
-								Name:   exprImpl.Parts[0],
-								System: false,
-								Global: false,
+			names := []string{exprImpl.Parts[0]}
+			if exprImpl.NumParts == 2 {
+				// the NEW.col and OLD.col of a trigger are saved as "new.col" and "old.col"
+				names = []string{exprImpl.Parts[1] + "." + exprImpl.Parts[0], exprImpl.Parts[0]}
+			}
+			for _, name := range names {
+				for i := len(*tmpScope) - 1; i >= 0; i-- {
+					curScope := (*tmpScope)[i]
+					if _, ok := curScope[strings.ToLower(name)]; ok {
+						typ := types.T_text.ToType()
+						expr = &Expr{
+							Typ: makePlan2Type(&typ),
+							Expr: &plan.Expr_V{
+								V: &plan.VarRef{
+									Name:   name,
+									System: false,
+									Global: false,
+								},
 							},
-						},
+						}
+						err = nil
+						return
 					}
-					err = nil
-					return
 				}
 			}
 		}
@@ -51,6 +51,7 @@ func NewBindContext(builder *QueryBuilder, parent *BindContext) *BindContext {
 			bc.isTryBindingCTE = parent.isTryBindingCTE
 		}
 		bc.snapshot = parent.snapshot
+		bc.hints = parent.hints
 	}
 
 	return bc
@@ -34,6 +34,8 @@ func runBuildSelectByBinder(stmtType plan.Query_StatementType, ctx CompilerConte
 	}()
 
 	builder := NewQueryBuilder(stmtType, ctx, isPrepareStmt, skipStats)
+	builder.stmtHints = stmt.Hints
+	builder.recordShape = !skipStats
 	bindCtx := NewBindContext(builder, nil)
 	if IsSnapshotValid(ctx.GetSnapshot()) {
 		bindCtx.snapshot = ctx.GetSnapshot()
@@ -105,6 +107,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement, isPrepareStmt bool) (*P
 		return buildTableUpdate(stmt, ctx, isPrepareStmt)
 	case *tree.Delete:
 		return buildDelete(stmt, ctx, isPrepareStmt)
+	case *tree.Merge:
+		return buildMerge(stmt, ctx, isPrepareStmt)
 	case *tree.BeginTransaction:
 		return buildBeginTransaction(stmt, ctx)
 	case *tree.CommitTransaction:
@@ -129,6 +133,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement, isPrepareStmt bool) (*P
 		return buildAlterSequence(stmt, ctx)
 	case *tree.DropView:
 		return buildDropView(stmt, ctx)
+	case *tree.RefreshMaterializedView:
+		return buildRefreshMaterializedView(stmt, ctx)
 	case *tree.CreateView:
 		return buildCreateView(stmt, ctx)
 	case *tree.CreateSource:
@@ -193,7 +199,7 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement, isPrepareStmt bool) (*P
 		return buildLoad(stmt, ctx, isPrepareStmt)
 	case *tree.PrepareStmt, *tree.PrepareString:
 		return buildPrepare(stmt, ctx)
-	case *tree.Do, *tree.Declare:
+	case *tree.Do, *tree.Declare, *tree.DeclareCursor, *tree.DeclareCondition, *tree.DeclareHandler:
 		return nil, moerr.NewNotSupported(ctx.GetContext(), tree.String(stmt, dialect.MYSQL))
 	case *tree.ValuesStatement:
 		return buildValues(stmt, ctx, isPrepareStmt)
@@ -250,7 +256,10 @@ func GetResultColumnsFromPlan(p *Plan) []*ColDef {
 		case plan.Query_SELECT:
 			return getResultColumnsByProjectionlist(logicPlan.Query)
 		default:
-			// insert/update/delete statement will return nil
+			// insert/update/delete statement will return nil, except the RETURNING clause
+			if logicPlan.Query.Returning {
+				return getResultColumnsByProjectionlist(logicPlan.Query)
+			}
 			return nil
 		}
 	case *plan.Plan_Tcl:
@@ -210,7 +210,8 @@ func checkAddColumnType(ctx context.Context, colType *plan.Type, columnName stri
 		}
 	}
 
-	if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) {
+	if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) ||
+		types.T(colType.Id).IsQuantizedArray() {
 		if colType.GetWidth() > types.MaxArrayDimension {
 			return moerr.NewInvalidInput(ctx, "vector width (%d) is too long", colType.GetWidth())
 		}
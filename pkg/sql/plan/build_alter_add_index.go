@@ -0,0 +1,98 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// AddIndex will add a unique or secondary index to the copy table, the index
+// tables are created together with the copy table.
+func AddIndex(ctx CompilerContext, alterPlan *plan.AlterTable, spec tree.TableDef, alterCtx *AlterTableContext) error {
+	tableDef := alterPlan.CopyTableDef
+
+	colMap := make(map[string]*ColDef)
+	for _, col := range tableDef.Cols {
+		colMap[col.Name] = col
+	}
+	// Check whether the composite primary key column is included
+	if tableDef.Pkey != nil && tableDef.Pkey.CompPkeyCol != nil {
+		colMap[tableDef.Pkey.CompPkeyCol.Name] = tableDef.Pkey.CompPkeyCol
+	}
+
+	constrNames := make(map[string]bool)
+	for _, idx := range tableDef.Indexes {
+		constrNames[strings.ToLower(idx.IndexName)] = true
+	}
+
+	oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
+	indexInfo := &plan.CreateTable{TableDef: &TableDef{}}
+	switch def := spec.(type) {
+	case *tree.UniqueIndex:
+		if err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts); err != nil {
+			return err
+		}
+		indexName := def.GetIndexName()
+		if err := checkDuplicateConstraint(constrNames, indexName, false, ctx.GetContext()); err != nil {
+			return err
+		}
+		if len(indexName) == 0 {
+			setEmptyUniqueIndexName(constrNames, def)
+		}
+		if err := buildUniqueIndexTable(indexInfo, []*tree.UniqueIndex{def}, colMap, oriPriKeyName, ctx); err != nil {
+			return err
+		}
+	case *tree.Index:
+		if err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts); err != nil {
+			return err
+		}
+		if err := checkDuplicateConstraint(constrNames, def.Name, false, ctx.GetContext()); err != nil {
+			return err
+		}
+		if len(def.Name) == 0 {
+			setEmptyIndexName(constrNames, def)
+		}
+		if err := buildSecondaryIndexDef(indexInfo, []*tree.Index{def}, colMap, oriPriKeyName, ctx); err != nil {
+			return err
+		}
+	default:
+		return moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", spec)
+	}
+
+	tableDef.Indexes = append(tableDef.Indexes, indexInfo.TableDef.Indexes...)
+	return nil
+}
+
+// DropIndex will drop the index from the copy table.
+func DropIndex(ctx CompilerContext, alterPlan *plan.AlterTable, indexName string, alterCtx *AlterTableContext) error {
+	tableDef := alterPlan.CopyTableDef
+
+	found := false
+	tableDef.Indexes = RemoveIf[*IndexDef](tableDef.Indexes, func(indexDef *IndexDef) bool {
+		if indexDef.IndexName == indexName {
+			found = true
+			return true
+		}
+		return false
+	})
+	if !found {
+		return moerr.NewErrCantDropFieldOrKey(ctx.GetContext(), indexName)
+	}
+	return nil
+}
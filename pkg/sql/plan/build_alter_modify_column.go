@@ -18,11 +18,14 @@ import (
 	"context"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 )
 
 // ModifyColumn Can change a column definition but not its name.
@@ -122,22 +125,221 @@ func isColumnWithPartition(colName string, partitionDef *PartitionByDef) bool {
 }
 
 // checkChangeTypeCompatible checks whether changes column type to another is compatible and can be changed.
+// The data of the column is converted by the cast of the insert into the copy table, so the
+// conversion must also be supported by the cast function.
 func checkChangeTypeCompatible(ctx context.Context, origin *plan.Type, to *plan.Type) error {
 	// Deal with the same type.
 	if origin.Id == to.Id {
 		return nil
-	} else {
-		if (origin.Id == int32(types.T_time) || origin.Id == int32(types.T_timestamp) || origin.Id == int32(types.T_date) || origin.Id == int32(types.T_datetime) || origin.Id == int32(types.T_char) || origin.Id == int32(types.T_varchar) || origin.Id == int32(types.T_json) || origin.Id == int32(types.T_uuid)) &&
-			to.Id == int32(types.T_binary) {
-			return moerr.NewNotSupported(ctx, "currently unsupport change from original type %v to %v ", origin.Id, to.Id)
+	}
+	if !isColumnTypeChangeAllowed(types.T(origin.Id), types.T(to.Id)) {
+		return moerr.NewNotSupported(ctx, "currently unsupport change from original type %v to %v ", types.T(origin.Id), types.T(to.Id))
+	}
+	return nil
+}
+
+type columnTypeClass int
+
+const (
+	otherTypeClass columnTypeClass = iota
+	numericTypeClass
+	stringTypeClass
+	temporalTypeClass
+	jsonTypeClass
+	enumTypeClass
+	uuidTypeClass
+	vectorTypeClass
+)
+
+// columnTypeChangeMatrix is the classes of types that a column type can be changed to, as MySQL does.
+var columnTypeChangeMatrix = map[columnTypeClass][]columnTypeClass{
+	numericTypeClass:  {numericTypeClass, stringTypeClass, temporalTypeClass, enumTypeClass},
+	stringTypeClass:   {numericTypeClass, stringTypeClass, temporalTypeClass, jsonTypeClass, enumTypeClass, uuidTypeClass, vectorTypeClass},
+	temporalTypeClass: {numericTypeClass, stringTypeClass, temporalTypeClass},
+	jsonTypeClass:     {stringTypeClass},
+	enumTypeClass:     {numericTypeClass, stringTypeClass},
+	uuidTypeClass:     {stringTypeClass},
+	vectorTypeClass:   {stringTypeClass, vectorTypeClass},
+}
+
+func getColumnTypeClass(t types.T) columnTypeClass {
+	switch {
+	case t.IsInteger(), t.IsFloat(), t.IsDecimal(), t == types.T_bit, t == types.T_bool:
+		return numericTypeClass
+	case t.IsMySQLString():
+		return stringTypeClass
+	case t.IsDateRelate():
+		return temporalTypeClass
+	case t == types.T_json:
+		return jsonTypeClass
+	case t == types.T_enum:
+		return enumTypeClass
+	case t == types.T_uuid:
+		return uuidTypeClass
+	case t.IsArrayRelate(), t.IsQuantizedArray():
+		return vectorTypeClass
+	}
+	return otherTypeClass
+}
+
+func isColumnTypeChangeAllowed(from, to types.T) bool {
+	fromClass, toClass := getColumnTypeClass(from), getColumnTypeClass(to)
+	allowed := false
+	for _, class := range columnTypeChangeMatrix[fromClass] {
+		if class == toClass {
+			allowed = true
+			break
 		}
+	}
+	if !allowed {
+		return false
+	}
 
-		if (origin.Id == int32(types.T_binary) || origin.Id == int32(types.T_decimal64) || origin.Id == int32(types.T_decimal128) || origin.Id == int32(types.T_float32) || origin.Id == int32(types.T_float64)) &&
-			(to.Id == int32(types.T_time) || to.Id == int32(types.T_timestamp) || to.Id == int32(types.T_date) || to.Id == int32(types.T_datetime)) {
-			return moerr.NewNotSupported(ctx, "currently unsupport change from original type %v to %v ", origin.Id, to.Id)
+	switch {
+	case toClass == temporalTypeClass && fromClass == numericTypeClass:
+		// only the integers like 20240102 can be taken as a date or time
+		return from.IsInteger()
+	case to == types.T_binary:
+		return fromClass == numericTypeClass || from == types.T_varbinary || from == types.T_blob || from == types.T_text
+	case toClass == enumTypeClass:
+		// the insert converts the strings and the indexes to the enum values itself
+		return fromClass == stringTypeClass || from.IsInteger()
+	}
+	return function.IfTypeCastSupported(from, to)
+}
+
+// isColumnTypeWidening checks whether the new type only widens the original type of the column.
+// The strings are stored with variable length, so widening them doesn't change the data.
+// Other widening like INT to BIGINT changes the storage format of the column, and the BINARY
+// values are zero-padded to the width, so they are copied with the cast.
+func isColumnTypeWidening(origin *plan.Type, to *plan.Type) bool {
+	if origin.Id != to.Id || origin.AutoIncr != to.AutoIncr {
+		return false
+	}
+	switch types.T(origin.Id) {
+	case types.T_char, types.T_varchar, types.T_varbinary:
+		return to.Width >= origin.Width
+	}
+	return false
+}
+
+// buildModifyColumnInplace builds the in place modify of the column if the MODIFY/CHANGE COLUMN only
+// widens the type of the column, e.g. VARCHAR(10) to VARCHAR(100), and keeps the other attributes.
+// Only the metadata of the column is changed, the table is not copied.
+func buildModifyColumnInplace(ctx CompilerContext, tableDef *TableDef, option tree.AlterTableOption) (*plan.AlterModifyColumn, bool, error) {
+	var colName string
+	var specNewColumn *tree.ColumnTableDef
+	var pos *tree.ColumnPosition
+	switch opt := option.(type) {
+	case *tree.AlterTableModifyColumnClause:
+		specNewColumn, pos = opt.NewColumn, opt.Position
+		colName = specNewColumn.Name.Parts[0]
+	case *tree.AlterTableChangeColumnClause:
+		specNewColumn, pos = opt.NewColumn, opt.Position
+		colName = opt.OldColumnName.Parts[0]
+		if specNewColumn.Name.Parts[0] != colName {
+			return nil, false, nil
 		}
+	default:
+		return nil, false, nil
 	}
-	return nil
+	if pos != nil && pos.Typ != tree.ColumnPositionNone {
+		return nil, false, nil
+	}
+
+	col := FindColumn(tableDef.Cols, colName)
+	if col == nil || col.Hidden || col.OnUpdate != nil || col.Default == nil {
+		return nil, false, nil
+	}
+	// the keys and the partitions keep their own copies of the column
+	if isColumnWithKey(tableDef, col) || isColumnWithPartition(col.Name, tableDef.Partition) {
+		return nil, false, nil
+	}
+
+	colType, err := getTypeFromAst(ctx.GetContext(), specNewColumn.Type)
+	if err != nil {
+		return nil, false, err
+	}
+	if err = checkAddColumnType(ctx.GetContext(), &colType, colName); err != nil {
+		return nil, false, err
+	}
+	if !isColumnTypeWidening(&col.Typ, &colType) {
+		return nil, false, nil
+	}
+
+	comment := ""
+	for _, attr := range specNewColumn.Attributes {
+		switch attribute := attr.(type) {
+		case *tree.AttributeNull, *tree.AttributeDefault:
+		case *tree.AttributeComment:
+			comment = attribute.CMT.String()
+		default:
+			return nil, false, nil
+		}
+	}
+	if comment != col.Comment {
+		return nil, false, nil
+	}
+	defaultValue, err := buildDefaultExpr(specNewColumn, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, false, err
+	}
+	if defaultValue.NullAbility != col.Default.NullAbility || defaultValue.OriginString != col.Default.OriginString {
+		return nil, false, nil
+	}
+
+	return &plan.AlterModifyColumn{
+		Name: col.Name,
+		Type: colType,
+		Seq:  col.Seqnum,
+	}, true, nil
+}
+
+// isModifyColumnInplace checks whether all the alter options can be done by buildModifyColumnInplace.
+func isModifyColumnInplace(ctx CompilerContext, tableDef *TableDef, options []tree.AlterTableOption) bool {
+	if len(options) == 0 {
+		return false
+	}
+	for _, option := range options {
+		if _, ok, err := buildModifyColumnInplace(ctx, tableDef, option); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isColumnWithKey checks whether the column is a part of the primary key, the cluster by key,
+// an index or a foreign key. The columns of the tables referred by foreign keys are all taken
+// as keys, the check of them is left to CheckModifyColumnForeignkeyConstraint.
+func isColumnWithKey(tableDef *TableDef, col *ColDef) bool {
+	if col.Primary || col.ClusterBy {
+		return true
+	}
+	if tableDef.Pkey != nil {
+		for _, name := range tableDef.Pkey.Names {
+			if name == col.Name {
+				return true
+			}
+		}
+	}
+	if tableDef.ClusterBy != nil && util.GetClusterByColumnOrder(tableDef.ClusterBy.Name, col.Name) != -1 {
+		return true
+	}
+	for _, indexDef := range tableDef.Indexes {
+		for _, part := range indexDef.Parts {
+			if catalog.ResolveAlias(part) == col.Name {
+				return true
+			}
+		}
+	}
+	for _, fkey := range tableDef.Fkeys {
+		for _, colId := range fkey.Cols {
+			if colId == col.ColId {
+				return true
+			}
+		}
+	}
+	return len(tableDef.RefChildTbls) > 0
 }
 
 // CheckModifyColumnForeignkeyConstraint check for table column foreign key dependencies, including
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 )
@@ -89,11 +90,16 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 			case *tree.PrimaryKeyIndex:
 				err = AddPrimaryKey(ctx, alterTablePlan, optionAdd, alterTableCtx)
 			case *tree.ForeignKey:
+				if tableDef.IsTemporary {
+					return nil, moerr.NewNYI(ctx.GetContext(), "add foreign key for temporary table")
+				}
 				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
-			case *tree.UniqueIndex:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
-			case *tree.Index:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+			case *tree.UniqueIndex, *tree.Index:
+				// the indexes of the temporary tables are rebuilt with the copy table
+				if !tableDef.IsTemporary {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
+				}
+				err = AddIndex(ctx, alterTablePlan, optionAdd, alterTableCtx)
 			case *tree.ColumnTableDef:
 				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", optionAdd)
 			default:
@@ -104,10 +110,11 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 			case tree.AlterTableDropColumn:
 				//return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
 				err = DropColumn(ctx, alterTablePlan, string(option.Name), alterTableCtx)
-			case tree.AlterTableDropIndex:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
-			case tree.AlterTableDropKey:
-				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
+			case tree.AlterTableDropIndex, tree.AlterTableDropKey:
+				if !tableDef.IsTemporary {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now. %v", option)
+				}
+				err = DropIndex(ctx, alterTablePlan, string(option.Name), alterTableCtx)
 			case tree.AlterTableDropPrimaryKey:
 				err = DropPrimaryKey(ctx, alterTablePlan, alterTableCtx)
 			case tree.AlterTableDropForeignKey:
@@ -145,6 +152,13 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 		}
 	}
 
+	if partitionOption, ok := stmt.PartitionOption.(*tree.AlterPartitionRedefinePartitionClause); ok {
+		// the partitions of the copy table are built by its create table statement
+		alterTablePlan.CopyTableDef.Partition = &plan.PartitionByDef{
+			PartitionMsg: tree.StringWithOpts(partitionOption.PartitionOption, dialect.MYSQL, tree.WithSingleQuoteString()),
+		}
+	}
+
 	createTmpDdl, err := restoreDDL(ctx, alterTablePlan.CopyTableDef, schemaName, alterTableCtx.copyTableName)
 	if err != nil {
 		return nil, err
@@ -160,7 +174,9 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 	alterTablePlan.ChangeTblColIdMap = alterTableCtx.changColDefMap
 	alterTablePlan.UpdateFkSqls = append(alterTablePlan.UpdateFkSqls, alterTableCtx.UpdateSqls...)
 	//delete copy table records from mo_catalog.mo_foreign_keys
-	alterTablePlan.UpdateFkSqls = append(alterTablePlan.UpdateFkSqls, getSqlForDeleteTable(schemaName, alterTableCtx.copyTableName))
+	if !tableDef.IsTemporary {
+		alterTablePlan.UpdateFkSqls = append(alterTablePlan.UpdateFkSqls, getSqlForDeleteTable(schemaName, alterTableCtx.copyTableName))
+	}
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
 			Ddl: &plan.DataDefinition{
@@ -177,7 +193,9 @@ func buildAlterTableCopy(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, err
 // skipConstraint: Skip foreign key and index constraints
 func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblName string) (string, error) {
 	var createStr string
-	if tableDef.TableType == catalog.SystemOrdinaryRel {
+	if tableDef.IsTemporary {
+		createStr = fmt.Sprintf("CREATE TEMPORARY TABLE `%s`.`%s` (", formatStr(schemaName), formatStr(tblName))
+	} else if tableDef.TableType == catalog.SystemOrdinaryRel {
 		createStr = fmt.Sprintf("CREATE TABLE `%s`.`%s` (", formatStr(schemaName), formatStr(tblName))
 	} else if tableDef.TableType == catalog.SystemExternalRel {
 		createStr = fmt.Sprintf("CREATE EXTERNAL TABLE `%s`.`%s` (", formatStr(schemaName), formatStr(tblName))
@@ -618,10 +636,6 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 		return nil, moerr.NewNoSuchTable(ctx.GetContext(), schemaName, tableName)
 	}
 
-	if tableDef.IsTemporary {
-		return nil, moerr.NewNYI(ctx.GetContext(), "alter table for temporary table")
-	}
-
 	if tableDef.ViewSql != nil {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "you should use alter view statemnt for View")
 	}
@@ -637,7 +651,7 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 		return nil, moerr.NewInternalError(ctx.GetContext(), "only the sys account can alter the cluster table")
 	}
 
-	if tableDef.Partition != nil && stmt.Options != nil {
+	if tableDef.Partition != nil && stmt.Options != nil && !isForeignKeyOnlyAlter(stmt.Options) {
 		return nil, moerr.NewInvalidInput(ctx.GetContext(), "can't add/drop column for partition table now")
 	}
 
@@ -645,6 +659,24 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 		if stmt.Options != nil {
 			return nil, moerr.NewParseError(ctx.GetContext(), "Unsupported multi schema change")
 		}
+		if tableDef.IsTemporary {
+			return nil, moerr.NewPartitionNoTemporary(ctx.GetContext())
+		}
+		// re-partitioning moves the rows to the new partitions
+		if _, ok := stmt.PartitionOption.(*tree.AlterPartitionRedefinePartitionClause); ok {
+			return buildAlterTableCopy(stmt, ctx)
+		}
+		return buildAlterTableInplace(stmt, ctx)
+	}
+
+	// the temporary tables have no catalog metadata to be altered in place,
+	// they are always rebuilt by copying the data.
+	if tableDef.IsTemporary {
+		return buildAlterTableCopy(stmt, ctx)
+	}
+
+	// widening the string columns only changes the metadata of the columns
+	if isModifyColumnInplace(ctx, tableDef, stmt.Options) {
 		return buildAlterTableInplace(stmt, ctx)
 	}
 
@@ -760,7 +792,8 @@ func buildNotNullColumnVal(col *ColDef) string {
 	} else if col.Typ.Id == int32(types.T_enum) {
 		enumvalues := strings.Split(col.Typ.Enumvalues, ",")
 		defaultValue = enumvalues[0]
-	} else if col.Typ.Id == int32(types.T_array_float32) || col.Typ.Id == int32(types.T_array_float64) {
+	} else if col.Typ.Id == int32(types.T_array_float32) || col.Typ.Id == int32(types.T_array_float64) ||
+		types.T(col.Typ.Id).IsQuantizedArray() {
 		if col.Typ.Width > 0 {
 			zerosWithCommas := strings.Repeat("0,", int(col.Typ.Width)-1)
 			arrayAsString := zerosWithCommas + "0" // final zero
@@ -14,7 +14,15 @@
 
 package plan
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
 
 func TestAlterTable1(t *testing.T) {
 	//sql := "ALTER TABLE t1 ADD (d TIMESTAMP, e INT not null);"
@@ -40,3 +48,176 @@ func TestAlterTableAddColumns(t *testing.T) {
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
+
+func TestAlterTemporaryTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mock.ctxt.tables["t1"].IsTemporary = true
+
+	buildAlter := func(sql string) (*plan.AlterTable, error) {
+		logicPlan, err := buildSingleStmt(mock, t, sql)
+		if err != nil {
+			return nil, err
+		}
+		return logicPlan.GetDdl().GetAlterTable(), nil
+	}
+
+	// the temporary tables are always altered by copying the data
+	alterTable, err := buildAlter("alter table t1 add index idx_b (b)")
+	require.NoError(t, err)
+	require.Equal(t, plan.AlterTable_COPY, alterTable.AlgorithmType)
+	require.True(t, strings.HasPrefix(alterTable.CreateTmpTableSql, "CREATE TEMPORARY TABLE"))
+	require.Contains(t, alterTable.CreateTmpTableSql, "KEY `idx_b` (`b`)")
+	require.Empty(t, alterTable.UpdateFkSqls)
+
+	alterTable, err = buildAlter("alter table t1 add column c int, add unique index uk_c (c)")
+	require.NoError(t, err)
+	require.Contains(t, alterTable.CreateTmpTableSql, "`c` INT")
+	require.Contains(t, alterTable.CreateTmpTableSql, "UNIQUE KEY `uk_c` (`c`)")
+
+	alterTable, err = buildAlter("alter table t1 modify b varchar(20)")
+	require.NoError(t, err)
+	require.Equal(t, plan.AlterTable_COPY, alterTable.AlgorithmType)
+	require.True(t, alterTable.CopyTableDef.IsTemporary)
+
+	_, err = buildAlter("alter table t1 drop index idx_b")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCantDropFieldOrKey))
+	_, err = buildAlter("alter table t1 add index idx_a (a), add index idx_a (b)")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateKey))
+
+	// the index added by the same statement can be dropped
+	alterTable, err = buildAlter("alter table t1 add index idx_b (b), drop index idx_b")
+	require.NoError(t, err)
+	require.NotContains(t, alterTable.CreateTmpTableSql, "idx_b")
+
+	_, err = buildAlter("alter table t1 add constraint fk_a foreign key (a) references t2 (a)")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNYI))
+}
+
+func TestAlterTableModifyColumnType(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	buildAlter := func(sql string) (*plan.AlterTable, error) {
+		logicPlan, err := buildSingleStmt(mock, t, sql)
+		if err != nil {
+			return nil, err
+		}
+		return logicPlan.GetDdl().GetAlterTable(), nil
+	}
+
+	// widening the strings only changes the metadata
+	alterTable, err := buildAlter("alter table t1 modify b varchar(100) not null")
+	require.NoError(t, err)
+	require.Equal(t, plan.AlterTable_INPLACE, alterTable.AlgorithmType)
+	modifyColumn := alterTable.Actions[0].GetModifyColumn()
+	require.NotNil(t, modifyColumn)
+	require.Equal(t, "b", modifyColumn.Name)
+	require.Equal(t, int32(types.T_varchar), modifyColumn.Type.Id)
+	require.Equal(t, int32(100), modifyColumn.Type.Width)
+
+	alterTable, err = buildAlter("alter table t1 change b b varchar(100) not null")
+	require.NoError(t, err)
+	require.Equal(t, plan.AlterTable_INPLACE, alterTable.AlgorithmType)
+
+	// the others are converted by copying the data
+	for _, sql := range []string{
+		"alter table t1 modify b varchar(100)",
+		"alter table t1 modify b varchar(100) not null comment 'abc'",
+		"alter table t1 modify b varchar(100) not null first",
+		"alter table t1 change b c varchar(100) not null",
+		"alter table t1 modify b text",
+		"alter table t1 modify b json",
+		"alter table t1 modify b datetime",
+		"alter table t1 modify a decimal(20, 2)",
+		"alter table t1 modify a varchar(20)",
+	} {
+		alterTable, err = buildAlter(sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, plan.AlterTable_COPY, alterTable.AlgorithmType, sql)
+	}
+
+	// the index added together uses the widened column
+	alterTable, err = buildAlter("alter table t1 add unique index uk_b (b), modify b varchar(100) not null")
+	require.NoError(t, err)
+	require.Equal(t, plan.AlterTable_INPLACE, alterTable.AlgorithmType)
+	indexTableDef := alterTable.Actions[0].GetAddIndex().IndexInfo.IndexTables[0]
+	require.Equal(t, int32(100), indexTableDef.Cols[0].Typ.Width)
+	require.NotNil(t, alterTable.Actions[1].GetModifyColumn())
+}
+
+func TestColumnTypeChangeAllowed(t *testing.T) {
+	cases := []struct {
+		from, to types.T
+		allowed  bool
+	}{
+		{types.T_int32, types.T_int64, true},
+		{types.T_int64, types.T_int8, true},
+		{types.T_int32, types.T_decimal128, true},
+		{types.T_float64, types.T_decimal64, true},
+		{types.T_decimal64, types.T_varchar, true},
+		{types.T_varchar, types.T_int32, true},
+		{types.T_varchar, types.T_json, true},
+		{types.T_text, types.T_json, true},
+		{types.T_json, types.T_varchar, true},
+		{types.T_date, types.T_datetime, true},
+		{types.T_datetime, types.T_date, true},
+		{types.T_timestamp, types.T_datetime, true},
+		{types.T_varchar, types.T_date, true},
+		{types.T_date, types.T_int64, true},
+		{types.T_int64, types.T_datetime, true},
+		{types.T_varchar, types.T_enum, true},
+		{types.T_enum, types.T_varchar, true},
+		{types.T_uuid, types.T_varchar, true},
+		{types.T_varchar, types.T_uuid, true},
+		{types.T_float64, types.T_date, false},
+		{types.T_decimal128, types.T_datetime, false},
+		{types.T_json, types.T_int64, false},
+		{types.T_json, types.T_date, false},
+		{types.T_date, types.T_json, false},
+		{types.T_uuid, types.T_int64, false},
+		{types.T_varchar, types.T_binary, false},
+		{types.T_datetime, types.T_binary, false},
+		{types.T_int64, types.T_array_float32, false},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.allowed, isColumnTypeChangeAllowed(c.from, c.to), "%s -> %s", c.from, c.to)
+	}
+}
+
+func TestColumnTypeWidening(t *testing.T) {
+	typ := func(id types.T, width int32) *plan.Type {
+		return &plan.Type{Id: int32(id), Width: width}
+	}
+	require.True(t, isColumnTypeWidening(typ(types.T_varchar, 10), typ(types.T_varchar, 100)))
+	require.True(t, isColumnTypeWidening(typ(types.T_char, 10), typ(types.T_char, 20)))
+	require.True(t, isColumnTypeWidening(typ(types.T_varbinary, 10), typ(types.T_varbinary, 20)))
+	require.False(t, isColumnTypeWidening(typ(types.T_varchar, 100), typ(types.T_varchar, 10)))
+	require.False(t, isColumnTypeWidening(typ(types.T_int32, 0), typ(types.T_int64, 0)))
+	// the BINARY values are zero-padded to the width
+	require.False(t, isColumnTypeWidening(typ(types.T_binary, 10), typ(types.T_binary, 20)))
+}
+
+func TestStatsAutoRecalc(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	setupPartitionTestRuntime()
+
+	logicPlan, err := buildSingleStmt(mock, t, "create table t9 (a int primary key) stats_auto_recalc = 0")
+	require.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	require.True(t, createTable.DisableAutoAnalyze)
+	require.Empty(t, createTable.UpdateFkSqls)
+	logicPlan, err = buildSingleStmt(mock, t, "create table t9 (a int primary key) stats_auto_recalc = 1")
+	require.NoError(t, err)
+	require.False(t, logicPlan.GetDdl().GetCreateTable().DisableAutoAnalyze)
+
+	// the option does not change the comment of the table
+	logicPlan, err = buildSingleStmt(mock, t, "alter table t1 stats_auto_recalc = 0")
+	require.NoError(t, err)
+	alterTable := logicPlan.GetDdl().GetAlterTable()
+	require.Len(t, alterTable.Actions, 1)
+	require.Nil(t, alterTable.Actions[0].GetAlterComment())
+	require.False(t, alterTable.Actions[0].GetStatsAutoRecalc().GetEnabled())
+	require.Empty(t, alterTable.UpdateFkSqls)
+	logicPlan, err = buildSingleStmt(mock, t, "alter table t1 stats_auto_recalc = default")
+	require.NoError(t, err)
+	require.True(t, logicPlan.GetDdl().GetAlterTable().Actions[0].GetStatsAutoRecalc().GetEnabled())
+}
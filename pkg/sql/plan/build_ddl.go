@@ -749,6 +749,12 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		return nil, err
 	}
 
+	if stmt.MaterializedView != nil {
+		if err = buildCreateMaterializedView(stmt, ctx, createTable); err != nil {
+			return nil, err
+		}
+	}
+
 	v, ok := getAutoIncrementOffsetFromVariables(ctx)
 	if ok {
 		createTable.TableDef.AutoIncrOffset = v
@@ -795,6 +801,9 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			if opt.Value != 0 {
 				createTable.TableDef.AutoIncrOffset = opt.Value - 1
 			}
+		case *tree.TableOptionStatsAutoRecalc:
+			// the tables are analyzed automatically unless STATS_AUTO_RECALC = 0
+			createTable.DisableAutoAnalyze = !opt.Default && opt.Value == 0 && !stmt.Temporary
 
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
@@ -813,7 +822,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			*tree.TableOptionDelayKeyWrite, *tree.TableOptionEncryption, *tree.TableOptionEngine, *tree.TableOptionEngineAttr,
 			*tree.TableOptionKeyBlockSize, *tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionPackKeys,
 			*tree.TableOptionPassword, *tree.TableOptionRowFormat, *tree.TableOptionStartTrans, *tree.TableOptionSecondaryEngineAttr,
-			*tree.TableOptionStatsAutoRecalc, *tree.TableOptionStatsPersistent, *tree.TableOptionStatsSamplePages,
+			*tree.TableOptionStatsPersistent, *tree.TableOptionStatsSamplePages,
 			*tree.TableOptionTablespace, *tree.TableOptionUnion:
 
 		default:
@@ -886,14 +895,9 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false, false)
 	bindContext := NewBindContext(builder, nil)
 
-	// set partition(unsupport now)
+	// set partition
+	// the foreign keys are checked on the logical table, the partition tables have no foreign keys
 	if stmt.PartitionOption != nil {
-		// Foreign keys are not yet supported in conjunction with partitioning
-		// see: https://dev.mysql.com/doc/relnotes/mysql/8.0/en/news-8-0-14.html
-		if len(createTable.TableDef.Fkeys) > 0 {
-			return nil, moerr.NewErrForeignKeyOnPartitioned(ctx.GetContext())
-		}
-
 		nodeID := builder.appendNode(&plan.Node{
 			NodeType:    plan.Node_TABLE_SCAN,
 			Stats:       nil,
@@ -958,11 +962,12 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		}}
 
 	partitionDef := createTable.TableDef.Partition
-	partitionTableDefs := make([]*TableDef, partitionDef.PartitionNum)
+	// every subpartition of a subpartitioned table owns a partition table
+	partitionItems := partitionDef.PartitionTableItems()
+	partitionTableDefs := make([]*TableDef, len(partitionItems))
 
-	partitionTableNames := make([]string, partitionDef.PartitionNum)
-	for i := 0; i < int(partitionDef.PartitionNum); i++ {
-		part := partitionDef.Partitions[i]
+	partitionTableNames := make([]string, len(partitionItems))
+	for i, part := range partitionItems {
 		ok, partitionTableName := util.MakeNameOfPartitionTable(part.GetPartitionName(), mainTableName)
 		if !ok {
 			return moerr.NewInvalidInput(ctx, "invalid partition table name %s", partitionTableName)
@@ -985,7 +990,7 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 }
 
 // buildPartitionByClause build partition by clause info and semantic check.
-// Currently, sub partition and partition value verification are not supported
+// Currently, partition value verification is not supported
 func buildPartitionByClause(ctx context.Context, partitionBinder *PartitionBinder, stmt *tree.CreateTable, tableDef *TableDef) (err error) {
 	var builder partitionBuilder
 	switch stmt.PartitionOption.PartBy.PType.(type) {
@@ -998,7 +1003,13 @@ func buildPartitionByClause(ctx context.Context, partitionBinder *PartitionBinde
 	case *tree.ListType:
 		builder = &listPartitionBuilder{}
 	}
-	return builder.build(ctx, partitionBinder, stmt, tableDef)
+	if err = builder.build(ctx, partitionBinder, stmt, tableDef); err != nil {
+		return err
+	}
+	if stmt.PartitionOption.SubPartBy != nil {
+		return buildSubPartition(ctx, partitionBinder, stmt, tableDef)
+	}
+	return nil
 }
 
 func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *plan.CreateTable, asSelectCols []*ColDef) error {
@@ -1023,7 +1034,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					return moerr.NewInvalidInput(ctx.GetContext(), "string width (%d) is too long", colType.GetWidth())
 				}
 			}
-			if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) {
+			if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) ||
+				types.T(colType.Id).IsQuantizedArray() {
 				if colType.GetWidth() > types.MaxArrayDimension {
 					return moerr.NewInvalidInput(ctx.GetContext(), "vector width (%d) is too long", colType.GetWidth())
 				}
@@ -1051,7 +1063,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					if colType.GetId() == int32(types.T_json) {
 						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in primary key", def.Name.Parts[0]))
 					}
-					if colType.GetId() == int32(types.T_array_float32) || colType.GetId() == int32(types.T_array_float64) {
+					if colType.GetId() == int32(types.T_array_float32) || colType.GetId() == int32(types.T_array_float64) ||
+						types.T(colType.GetId()).IsQuantizedArray() {
 						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in primary key", def.Name.Parts[0]))
 					}
 					if colType.GetId() == int32(types.T_enum) {
@@ -1547,7 +1560,8 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 			}
-			if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) {
+			if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) ||
+				types.T(colMap[name].Typ.Id).IsQuantizedArray() {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
 			}
 
@@ -1800,7 +1814,8 @@ func buildRegularSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 		if colMap[name].Typ.Id == int32(types.T_json) {
 			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 		}
-		if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) {
+		if colMap[name].Typ.Id == int32(types.T_array_float32) || colMap[name].Typ.Id == int32(types.T_array_float64) ||
+			types.T(colMap[name].Typ.Id).IsQuantizedArray() {
 			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
 		}
 
@@ -1928,8 +1943,22 @@ func buildIvfFlatSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 		if _, ok := colMap[name]; !ok {
 			return nil, nil, moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
 		}
-		if colMap[name].Typ.Id != int32(types.T_array_float32) && colMap[name].Typ.Id != int32(types.T_array_float64) {
-			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT only supports VECFXX column types")
+		opType := catalog.IndexAlgoParamOpType_l2
+		if indexInfo.IndexOption != nil && len(indexInfo.IndexOption.AlgoParamVectorOpType) > 0 {
+			opType = catalog.ToLower(indexInfo.IndexOption.AlgoParamVectorOpType)
+		}
+		switch types.T(colMap[name].Typ.Id) {
+		case types.T_array_float32, types.T_array_float64, types.T_array_int8:
+			if opType == catalog.IndexAlgoParamOpType_hamming {
+				return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT only supports op_type '%s' on VECBIT column types", opType)
+			}
+		case types.T_array_bit:
+			// vecbit columns are clustered by hamming distance, see ivfCentroidTypeId.
+			if opType != catalog.IndexAlgoParamOpType_hamming {
+				return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT only supports op_type '%s' on VECBIT column types", catalog.IndexAlgoParamOpType_hamming)
+			}
+		default:
+			return nil, nil, moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT only supports VECFXX, VECI8 and VECBIT column types")
 		}
 
 	}
@@ -2044,7 +2073,7 @@ func buildIvfFlatSecondaryIndexDef(ctx CompilerContext, indexInfo *tree.Index, c
 			Name: catalog.SystemSI_IVFFLAT_TblCol_Centroids_centroid,
 			Alg:  plan.CompressType_Lz4,
 			Typ: Type{
-				Id:    colMap[indexInfo.KeyParts[0].ColName.Parts[0]].Typ.Id,
+				Id:    ivfCentroidTypeId(colMap[indexInfo.KeyParts[0].ColName.Parts[0]].Typ.Id),
 				Width: colMap[indexInfo.KeyParts[0].ColName.Parts[0]].Typ.Width,
 			},
 			Default: &plan.Default{
@@ -2361,6 +2390,11 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 		isView := (tableDef.ViewSql != nil)
 		dropTable.IsView = isView
 
+		dropTable.IsMaterializedView = IsMaterializedView(tableDef)
+		if stmt.IsMaterializedView && !dropTable.IsMaterializedView {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "'%s' is not a materialized view", dropTable.Table)
+		}
+
 		if isView && !dropTable.IfExists {
 			// drop table v0, v0 is view
 			return nil, moerr.NewNoSuchTable(ctx.GetContext(), dropTable.Database, dropTable.Table)
@@ -2823,6 +2857,15 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 		colMap[tableDef.Pkey.CompPkeyCol.Name] = tableDef.Pkey.CompPkeyCol
 	}
 
+	// the indexes added by the statement use the widened columns
+	for _, option := range stmt.Options {
+		if modifyColumn, ok, _ := buildModifyColumnInplace(ctx, tableDef, option); ok {
+			col := DeepCopyColDef(colMap[modifyColumn.Name])
+			col.Typ = modifyColumn.Type
+			colMap[col.Name] = col
+		}
+	}
+
 	var primaryKeys []string
 	var indexs []string
 	var detectSqls []string
@@ -3130,7 +3173,8 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				}
 			}
 
-			if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) {
+			if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) ||
+				types.T(colType.Id).IsQuantizedArray() {
 				if colType.GetWidth() > types.MaxArrayDimension {
 					return nil, moerr.NewInvalidInput(ctx.GetContext(), "vector width (%d) is too long", colType.GetWidth())
 				}
@@ -3150,7 +3194,8 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					if colType.GetId() == int32(types.T_json) {
 						return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in primary key", opt.Column.Name.Parts[0]))
 					}
-					if colType.GetId() == int32(types.T_array_float32) || colType.GetId() == int32(types.T_array_float64) {
+					if colType.GetId() == int32(types.T_array_float32) || colType.GetId() == int32(types.T_array_float64) ||
+						types.T(colType.GetId()).IsQuantizedArray() {
 						return nil, moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in primary key", opt.Column.Name.Parts[0]))
 					}
 					pks = append(pks, opt.Column.Name.Parts[0])
@@ -3229,6 +3274,19 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					},
 				},
 			}
+		case *tree.AlterTableModifyColumnClause, *tree.AlterTableChangeColumnClause:
+			modifyColumn, ok, err := buildModifyColumnInplace(ctx, tableDef, opt)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now.")
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_ModifyColumn{
+					ModifyColumn: modifyColumn,
+				},
+			}
 		case *tree.TableOptionAutoIncrement:
 			return nil, moerr.NewInvalidInput(ctx.GetContext(), "Can't set AutoIncr column value.")
 		case *tree.AlterOptionAlterCheck, *tree.TableOptionCharset:
@@ -3239,6 +3297,14 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 					},
 				},
 			}
+		case *tree.TableOptionStatsAutoRecalc:
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_StatsAutoRecalc{
+					StatsAutoRecalc: &plan.AlterTableStatsAutoRecalc{
+						Enabled: opt.Default || opt.Value != 0,
+					},
+				},
+			}
 		default:
 			return nil, moerr.NewInvalidInput(ctx.GetContext(), "Do not support this stmt now.")
 		}
@@ -3248,6 +3314,19 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 		alterPartitionOption := stmt.PartitionOption
 		switch partitionOption := alterPartitionOption.(type) {
 		case *tree.AlterPartitionAddPartitionClause:
+			if partInfo := alterTable.TableDef.GetPartition(); partInfo != nil && isHashOrKeyPartition(partInfo.Type) {
+				alterTableRedistributePartition, err := AddHashTablePartitions(ctx, alterTable, partitionOption)
+				if err != nil {
+					return nil, err
+				}
+
+				alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_RedistributePartition{
+						RedistributePartition: alterTableRedistributePartition,
+					},
+				})
+				break
+			}
 			alterTableAddPartition, err := AddTablePartitions(ctx, alterTable, partitionOption)
 			if err != nil {
 				return nil, err
@@ -3259,11 +3338,63 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				},
 			})
 		case *tree.AlterPartitionDropPartitionClause:
-			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table drop partition clause")
+			alterTableDropPartition, err := DropTablePartitions(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_DropPartition{
+					DropPartition: alterTableDropPartition,
+				},
+			})
 		case *tree.AlterPartitionTruncatePartitionClause:
-			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table truncate partition clause")
+			alterTableTruncatePartition, err := TruncateTablePartitions(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_TruncatePartition{
+					TruncatePartition: alterTableTruncatePartition,
+				},
+			})
+		case *tree.AlterPartitionExchangePartitionClause:
+			alterTableExchangePartition, err := ExchangeTablePartition(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_ExchangePartition{
+					ExchangePartition: alterTableExchangePartition,
+				},
+			})
+		case *tree.AlterPartitionCoalescePartitionClause:
+			alterTableRedistributePartition, err := CoalesceTablePartitions(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_RedistributePartition{
+					RedistributePartition: alterTableRedistributePartition,
+				},
+			})
+		case *tree.AlterPartitionReorganizePartitionClause:
+			alterTableRedistributePartition, err := ReorganizeTablePartitions(ctx, alterTable, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+
+			alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_RedistributePartition{
+					RedistributePartition: alterTableRedistributePartition,
+				},
+			})
 		case *tree.AlterPartitionRedefinePartitionClause:
-			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table partition by clause")
+			// re-partitioning copies the rows, it is built by buildAlterTableCopy
+			return nil, moerr.NewInternalError(ctx.GetContext(), "alter table partition by clause must use the copy algorithm")
 		}
 	}
 
@@ -346,6 +346,12 @@ func TestBuildCreateTable(t *testing.T) {
 			"	UNIQUE KEY (`PRIMARY`)," +
 			"	UNIQUE KEY (`PRIMARY`, col3)" +
 			");",
+
+		`CREATE TABLE t4 (
+			col1 INT NOT NULL KEY,
+			col2 VECBIT(16),
+			KEY idx1 USING IVFFLAT (col2) LISTS = 2 OP_TYPE 'vector_hamming_ops'
+		);`,
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
@@ -405,6 +411,18 @@ func TestBuildCreateTableError(t *testing.T) {
 			col4 INT NOT NULL,
 			UNIQUE KEY uk1 ((col1 + col3))
 		);`,
+
+		`CREATE TABLE t4 (
+			col1 INT NOT NULL KEY,
+			col2 VECBIT(16),
+			KEY idx1 USING IVFFLAT (col2) LISTS = 2 OP_TYPE 'vector_l2_ops'
+		);`,
+
+		`CREATE TABLE t4 (
+			col1 INT NOT NULL KEY,
+			col2 VECF32(16),
+			KEY idx1 USING IVFFLAT (col2) LISTS = 2 OP_TYPE 'vector_hamming_ops'
+		);`,
 	}
 	runTestShouldError(mock, t, sqlerrs)
 }
@@ -37,6 +37,7 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 		return nil, err
 	}
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx, isPrepareStmt, false)
+	builder.stmtHints = stmt.Hints
 
 	queryBindCtx := NewBindContext(builder, nil)
 	lastNodeId, err := deleteToSelect(builder, queryBindCtx, stmt, true, tblInfo)
@@ -67,7 +68,7 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 	beginIdx := 0
 	// needLockTable := !tblInfo.isMulti && stmt.Where == nil && stmt.Limit == nil
 	// todo will do not lock table now.
-	isDeleteWithoutFilters := !tblInfo.isMulti && stmt.Where == nil && stmt.Limit == nil
+	isDeleteWithoutFilters := !tblInfo.isMulti && stmt.Where == nil && stmt.Limit == nil && len(stmt.Returning) == 0
 	needLockTable := isDeleteWithoutFilters
 	for i, tableDef := range tblInfo.tableDefs {
 		deleteBindCtx := NewBindContext(builder, nil)
@@ -86,9 +87,9 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 		delPlanCtx.isDeleteWithoutFilters = isDeleteWithoutFilters
 
 		if tableDef.Partition != nil {
-			partTableIds := make([]uint64, tableDef.Partition.PartitionNum)
-			partTableNames := make([]string, tableDef.Partition.PartitionNum)
-			for j, partition := range tableDef.Partition.Partitions {
+			partTableIds := make([]uint64, len(tableDef.Partition.PartitionTableNames))
+			partTableNames := make([]string, len(tableDef.Partition.PartitionTableNames))
+			for j, partition := range tableDef.Partition.PartitionTableItems() {
 				_, partTableDef := ctx.Resolve(tblInfo.objRef[i].SchemaName, partition.PartitionTableName, Snapshot{TS: &timestamp.Timestamp{}})
 				partTableIds[j] = partTableDef.TblId
 				partTableNames[j] = partition.PartitionTableName
@@ -117,6 +118,11 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, isPrepareStmt bool) (*P
 	}
 
 	reduceSinkSinkScanNodes(query)
+	if len(stmt.Returning) > 0 {
+		if err = buildReturning(builder, query, stmt.Returning, getDmlTableAlias(tblInfo, 0), tblInfo.tableDefs[0], plan.Node_DELETE); err != nil {
+			return nil, err
+		}
+	}
 	ReCalcQueryStats(builder, query)
 	reCheckifNeedLockWholeTable(builder)
 	query.StmtType = plan.Query_DELETE
@@ -1834,9 +1834,9 @@ func makeDeleteNodeInfo(ctx CompilerContext, objRef *ObjectRef, tableDef *TableD
 			delNodeInfo.partTableIDs = partSubs.partTableIDs
 			delNodeInfo.partTableNames = partSubs.partTableNames
 		} else {
-			partTableIds := make([]uint64, tableDef.Partition.PartitionNum)
-			partTableNames := make([]string, tableDef.Partition.PartitionNum)
-			for i, partition := range tableDef.Partition.Partitions {
+			partTableIds := make([]uint64, len(tableDef.Partition.PartitionTableNames))
+			partTableNames := make([]string, len(tableDef.Partition.PartitionTableNames))
+			for i, partition := range tableDef.Partition.PartitionTableItems() {
 				_, partTableDef := ctx.Resolve(objRef.SchemaName, partition.PartitionTableName, Snapshot{TS: &timestamp.Timestamp{}})
 				partTableIds[i] = partTableDef.TblId
 				partTableNames[i] = partition.PartitionTableName
@@ -1879,9 +1879,9 @@ func getPartTableIdsAndNames(ctx CompilerContext, objRef *ObjectRef, tableDef *T
 	var partTableIds []uint64
 	var partTableNames []string
 	if tableDef.Partition != nil {
-		partTableIds = make([]uint64, tableDef.Partition.PartitionNum)
-		partTableNames = make([]string, tableDef.Partition.PartitionNum)
-		for i, partition := range tableDef.Partition.Partitions {
+		partTableIds = make([]uint64, len(tableDef.Partition.PartitionTableNames))
+		partTableNames = make([]string, len(tableDef.Partition.PartitionTableNames))
+		for i, partition := range tableDef.Partition.PartitionTableItems() {
 			_, partTableDef := ctx.Resolve(objRef.SchemaName, partition.PartitionTableName, Snapshot{TS: &timestamp.Timestamp{}})
 			partTableIds[i] = partTableDef.TblId
 			partTableNames[i] = partition.PartitionTableName
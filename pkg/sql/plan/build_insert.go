@@ -79,6 +79,7 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 	// }
 
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx, isPrepareStmt, false)
+	builder.stmtHints = stmt.Hints
 	builder.haveOnDuplicateKey = len(stmt.OnDuplicateUpdate) > 0
 	if stmt.IsRestore {
 		oldSnapshot := builder.compCtx.GetSnapshot()
@@ -323,6 +324,11 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool, isPrepa
 	}
 	query.DetectSqls = sqls
 	reduceSinkSinkScanNodes(query)
+	if len(stmt.Returning) > 0 {
+		if err = buildReturning(builder, query, stmt.Returning, tblName, tableDef, plan.Node_INSERT); err != nil {
+			return nil, err
+		}
+	}
 	ReCalcQueryStats(builder, query)
 	reCheckifNeedLockWholeTable(builder)
 	return &Plan{
@@ -933,7 +939,7 @@ func remapPartExprColRef(expr *Expr, colMap map[int]int, tableDef *TableDef) boo
 }
 
 func getRewriteToReplaceStmt(tableDef *TableDef, stmt *tree.Insert, info *dmlSelectInfo, isPrepareStmt bool) *tree.Replace {
-	if len(info.onDuplicateIdx) == 0 {
+	if len(info.onDuplicateIdx) == 0 || len(stmt.Returning) > 0 {
 		return nil
 	}
 	if _, ok := stmt.Rows.Select.(*tree.ValuesClause); !ok {
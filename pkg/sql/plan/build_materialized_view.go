@@ -0,0 +1,341 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// materializedViewCreateSqlPrefix is the prefix of the create sql stored for a
+// materialized view, which is the only thing telling it apart from the table
+// created by a plain create table as select.
+const materializedViewCreateSqlPrefix = "create materialized view"
+
+// IsMaterializedView returns true if the table is created by create materialized view.
+func IsMaterializedView(tableDef *TableDef) bool {
+	return tableDef != nil && strings.HasPrefix(tableDef.Createsql, materializedViewCreateSqlPrefix)
+}
+
+// MaterializedViewColumn tells how a column of an incrementally refreshed
+// materialized view is merged with the aggregated changes of the source table.
+type MaterializedViewColumn int
+
+const (
+	MaterializedViewGroupKey MaterializedViewColumn = iota
+	MaterializedViewSum
+	MaterializedViewCount
+	MaterializedViewCountStar
+	MaterializedViewMin
+	MaterializedViewMax
+)
+
+// IncrementalMaterializedView is the shape of a materialized view query which
+// can be refreshed incrementally:
+//
+//	select k1, ..., agg1(x1), ... from db.t [where ...] [group by k1, ...]
+//
+// where every aggregate is one of SUM, COUNT, MIN and MAX without DISTINCT,
+// and every group by expression is in the select list.
+type IncrementalMaterializedView struct {
+	Database string
+	Table    string
+	// Alias is the name the columns of the source table are qualified with
+	Alias   string
+	Columns []MaterializedViewColumn
+
+	clause *tree.SelectClause
+}
+
+// AnalyzeIncrementalMaterializedView checks whether the materialized view query
+// can be refreshed incrementally and returns how to merge its columns.
+func AnalyzeIncrementalMaterializedView(ctx context.Context, stmt *tree.Select, defaultDatabase string) (*IncrementalMaterializedView, error) {
+	notSupported := func(reason string) error {
+		return moerr.NewNotSupported(ctx, "incremental refresh of materialized view %s", reason)
+	}
+
+	if stmt.With != nil || stmt.OrderBy != nil || stmt.Limit != nil || stmt.Ep != nil || stmt.SelectLockInfo != nil {
+		return nil, notSupported("with WITH, ORDER BY, LIMIT, INTO or FOR UPDATE")
+	}
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if !ok {
+		return nil, notSupported("on set operations")
+	}
+	if clause.Distinct || clause.Having != nil {
+		return nil, notSupported("with DISTINCT or HAVING")
+	}
+	if clause.From == nil || len(clause.From.Tables) != 1 {
+		return nil, notSupported("on more than one table")
+	}
+
+	tblExpr := clause.From.Tables[0]
+	if join, ok := tblExpr.(*tree.JoinTableExpr); ok {
+		if join.Right != nil {
+			return nil, notSupported("on joins")
+		}
+		tblExpr = join.Left
+	}
+	aliased, ok := tblExpr.(*tree.AliasedTableExpr)
+	if !ok {
+		return nil, notSupported("on derived tables")
+	}
+	tblName, ok := aliased.Expr.(*tree.TableName)
+	if !ok {
+		return nil, notSupported("on derived tables")
+	}
+	if tblName.AtTsExpr != nil {
+		return nil, notSupported("on snapshot reads")
+	}
+
+	view := &IncrementalMaterializedView{
+		Database: string(tblName.SchemaName),
+		Table:    string(tblName.ObjectName),
+		Alias:    string(aliased.As.Alias),
+		Columns:  make([]MaterializedViewColumn, len(clause.Exprs)),
+		clause:   clause,
+	}
+	if view.Database == "" {
+		view.Database = defaultDatabase
+	}
+	if view.Alias == "" {
+		view.Alias = view.Table
+	}
+
+	isGroupKey := make([]bool, len(clause.Exprs))
+	for _, g := range clause.GroupBy {
+		pos := -1
+		switch e := g.(type) {
+		case *tree.NumVal:
+			if n, ok := constant.Int64Val(e.Value); ok && n >= 1 && int(n) <= len(clause.Exprs) {
+				pos = int(n) - 1
+			}
+		case *tree.UnresolvedName:
+			if e.NumParts == 1 {
+				for i, se := range clause.Exprs {
+					if se.As != nil && !se.As.Empty() && e.CStrParts[0] != nil && se.As.Compare() == e.CStrParts[0].Compare() {
+						pos = i
+						break
+					}
+				}
+			}
+		}
+		if pos < 0 {
+			key := tree.String(g, dialect.MYSQL)
+			for i, se := range clause.Exprs {
+				if tree.String(se.Expr, dialect.MYSQL) == key {
+					pos = i
+					break
+				}
+			}
+		}
+		if pos < 0 {
+			return nil, notSupported("when a GROUP BY expression is not selected")
+		}
+		isGroupKey[pos] = true
+	}
+
+	for i, se := range clause.Exprs {
+		if isGroupKey[i] {
+			view.Columns[i] = MaterializedViewGroupKey
+			continue
+		}
+		if _, ok := se.Expr.(tree.UnqualifiedStar); ok {
+			return nil, notSupported("with select *")
+		}
+		fn, ok := se.Expr.(*tree.FuncExpr)
+		if !ok || fn.WindowSpec != nil || fn.Type == tree.FUNC_TYPE_DISTINCT || len(fn.Exprs) != 1 {
+			return nil, notSupported("on expressions other than SUM, COUNT, MIN and MAX")
+		}
+		ref, ok := fn.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok {
+			return nil, notSupported("on expressions other than SUM, COUNT, MIN and MAX")
+		}
+		switch strings.ToLower(ref.Parts[0]) {
+		case "sum":
+			view.Columns[i] = MaterializedViewSum
+		case "count":
+			view.Columns[i] = MaterializedViewCount
+			if v, ok := fn.Exprs[0].(*tree.NumVal); ok && v.String() == "*" {
+				view.Columns[i] = MaterializedViewCountStar
+			}
+		case "min":
+			view.Columns[i] = MaterializedViewMin
+		case "max":
+			view.Columns[i] = MaterializedViewMax
+		default:
+			return nil, notSupported("on expressions other than SUM, COUNT, MIN and MAX")
+		}
+	}
+	return view, nil
+}
+
+// HasGroupKey returns true if the query has a group by clause.
+func (v *IncrementalMaterializedView) HasGroupKey() bool {
+	return len(v.clause.GroupBy) > 0
+}
+
+// CanMergeDeletes returns true if rows deleted from the source table can be
+// subtracted from the view. MIN and MAX can not be recomputed from the deleted
+// rows alone, and COUNT(*) is needed to know when a group becomes empty.
+func (v *IncrementalMaterializedView) CanMergeDeletes() bool {
+	hasCountStar := false
+	for _, c := range v.Columns {
+		switch c {
+		case MaterializedViewMin, MaterializedViewMax:
+			return false
+		case MaterializedViewCountStar:
+			hasCountStar = true
+		}
+	}
+	return hasCountStar || !v.HasGroupKey()
+}
+
+// AggregateSql returns the view query with its source table replaced by the
+// rows produced by the sql, the i-th selected column is named cols[i].
+func (v *IncrementalMaterializedView) AggregateSql(sql string, cols []string) string {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	fmtCtx.WriteString("select ")
+	for i, se := range v.clause.Exprs {
+		if i > 0 {
+			fmtCtx.WriteString(", ")
+		}
+		se.Expr.Format(fmtCtx)
+		fmtCtx.WriteString(fmt.Sprintf(" as `%s`", cols[i]))
+	}
+	fmtCtx.WriteString(fmt.Sprintf(" from (%s) as `%s`", sql, v.Alias))
+	if v.clause.Where != nil {
+		fmtCtx.WriteByte(' ')
+		v.clause.Where.Format(fmtCtx)
+	}
+	if len(v.clause.GroupBy) > 0 {
+		fmtCtx.WriteByte(' ')
+		v.clause.GroupBy.Format(fmtCtx)
+	}
+	return fmtCtx.String()
+}
+
+func buildCreateMaterializedView(stmt *tree.CreateTable, ctx CompilerContext, createTable *plan.CreateTable) error {
+	opt := stmt.MaterializedView
+	if opt.RefreshCron != "" {
+		cronParser := cron.NewParser(
+			cron.Second |
+				cron.Minute |
+				cron.Hour |
+				cron.Dom |
+				cron.Month |
+				cron.Dow |
+				cron.Descriptor)
+		if _, err := cronParser.Parse(opt.RefreshCron); err != nil {
+			return moerr.NewInvalidInput(ctx.GetContext(), "invalid refresh cron expression '%s'", opt.RefreshCron)
+		}
+	}
+
+	if opt.Mode == tree.RefreshModeIncremental {
+		view, err := AnalyzeIncrementalMaterializedView(ctx.GetContext(), stmt.AsSource, ctx.DefaultDatabase())
+		if err != nil {
+			return err
+		}
+		_, srcDef := ctx.Resolve(view.Database, view.Table, Snapshot{TS: &timestamp.Timestamp{}})
+		if srcDef == nil {
+			return moerr.NewNoSuchTable(ctx.GetContext(), view.Database, view.Table)
+		}
+		if srcDef.TableType != catalog.SystemOrdinaryRel || srcDef.ViewSql != nil {
+			return moerr.NewNotSupported(ctx.GetContext(), "incremental refresh of materialized view on '%s'", view.Table)
+		}
+		// subqueries in the where clause read other tables, whose changes are
+		// not tracked by the incremental refresh.
+		p, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt.AsSource, false, false)
+		if err != nil {
+			return err
+		}
+		scans := 0
+		for _, node := range p.GetQuery().GetNodes() {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				scans++
+			}
+		}
+		if scans != 1 {
+			return moerr.NewNotSupported(ctx.GetContext(), "incremental refresh of materialized view with subqueries")
+		}
+	}
+
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	stmt.AsSource.Format(fmtCtx)
+	createTable.MaterializedView = &plan.MaterializedView{
+		Query:         fmtCtx.String(),
+		Incremental:   opt.Mode == tree.RefreshModeIncremental,
+		RefreshCron:   opt.RefreshCron,
+		QueryDatabase: ctx.DefaultDatabase(),
+	}
+	// the view is populated by a complete refresh, which also records the
+	// snapshot the next incremental refresh starts from.
+	createTable.CreateAsSelectSql = fmt.Sprintf("refresh materialized view `%s`.`%s` complete",
+		createTable.Database, createTable.TableDef.Name)
+	return nil
+}
+
+func buildRefreshMaterializedView(stmt *tree.RefreshMaterializedView, ctx CompilerContext) (*Plan, error) {
+	refresh := &plan.RefreshMaterializedView{
+		Database: string(stmt.Name.SchemaName),
+		Table:    string(stmt.Name.ObjectName),
+	}
+	if refresh.Database == "" {
+		refresh.Database = ctx.DefaultDatabase()
+	}
+	if refresh.Database == "" {
+		return nil, moerr.NewNoDB(ctx.GetContext())
+	}
+	switch stmt.Mode {
+	case tree.RefreshModeComplete:
+		refresh.Mode = plan.RefreshMaterializedView_COMPLETE
+	case tree.RefreshModeIncremental:
+		refresh.Mode = plan.RefreshMaterializedView_INCREMENTAL
+	}
+
+	_, tableDef := ctx.Resolve(refresh.Database, refresh.Table, Snapshot{TS: &timestamp.Timestamp{}})
+	if tableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), refresh.Database, refresh.Table)
+	}
+	if !IsMaterializedView(tableDef) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "'%s' is not a materialized view", refresh.Table)
+	}
+	for _, col := range tableDef.Cols {
+		if !col.Hidden {
+			refresh.Cols = append(refresh.Cols, col.Name)
+		}
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_REFRESH_MATERIALIZED_VIEW,
+				Definition: &plan.DataDefinition_RefreshMaterializedView{
+					RefreshMaterializedView: refresh,
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeIncrementalMaterializedView(t *testing.T) {
+	ctx := context.Background()
+	analyze := func(sql string) (*IncrementalMaterializedView, error) {
+		stmt, err := mysql.ParseOne(ctx, sql, 1)
+		require.NoError(t, err)
+		return AnalyzeIncrementalMaterializedView(ctx, stmt.(*tree.Select), "db")
+	}
+
+	view, err := analyze("select a, count(*), sum(b), count(c), min(c), max(c) from t where b > 1 group by a")
+	require.NoError(t, err)
+	require.Equal(t, "db", view.Database)
+	require.Equal(t, "t", view.Table)
+	require.Equal(t, "t", view.Alias)
+	require.Equal(t, []MaterializedViewColumn{
+		MaterializedViewGroupKey,
+		MaterializedViewCountStar,
+		MaterializedViewSum,
+		MaterializedViewCount,
+		MaterializedViewMin,
+		MaterializedViewMax,
+	}, view.Columns)
+	require.True(t, view.HasGroupKey())
+	require.False(t, view.CanMergeDeletes())
+	require.Equal(t,
+		"select a as `a`, count(\"*\") as `cnt`, sum(b) as `s`, count(c) as `c`, min(c) as `mi`, max(c) as `ma` from (delta) as `t` where b > 1 group by a",
+		view.AggregateSql("delta", []string{"a", "cnt", "s", "c", "mi", "ma"}))
+
+	// the query stored is formatted with quoted strings
+	view, err = analyze(`select a, count("*") from t group by a`)
+	require.NoError(t, err)
+	require.Equal(t, []MaterializedViewColumn{MaterializedViewGroupKey, MaterializedViewCountStar}, view.Columns)
+
+	view, err = analyze("select sum(x.b) as s, x.a as k from d.t as x group by 2")
+	require.NoError(t, err)
+	require.Equal(t, "d", view.Database)
+	require.Equal(t, "x", view.Alias)
+	require.Equal(t, []MaterializedViewColumn{MaterializedViewSum, MaterializedViewGroupKey}, view.Columns)
+	// deletes can not be merged without count(*) when grouping
+	require.False(t, view.CanMergeDeletes())
+
+	view, err = analyze("select a + 1 as k, count(*) from t group by k")
+	require.NoError(t, err)
+	require.True(t, view.CanMergeDeletes())
+
+	view, err = analyze("select sum(b) from t")
+	require.NoError(t, err)
+	require.False(t, view.HasGroupKey())
+	require.True(t, view.CanMergeDeletes())
+
+	for _, sql := range []string{
+		"select a, b from t",
+		"select a, count(*) from t group by a, b",
+		"select count(distinct a) from t",
+		"select avg(a) from t",
+		"select a, count(*) from t group by a having count(*) > 1",
+		"select distinct a from t",
+		"select count(*) from t1 join t2 on t1.a = t2.a",
+		"select count(*) from t1, t2",
+		"select count(*) from (select * from t) as x",
+		"select count(*) from t order by 1",
+		"select count(*) from t union select count(*) from t1",
+		"select count(*) from t {snapshot = 'sp'}",
+	} {
+		_, err = analyze(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestMaterializedViewBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupProcessLevelRuntime(rt)
+	moruntime.ProcessLevelRuntime().SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+
+	runTestShouldPass(mock, t, []string{
+		"create materialized view mv1 as select n_name, n_regionkey from nation",
+		"create materialized view mv2 refresh incremental as select n_regionkey, count(*), sum(n_nationkey) from nation group by n_regionkey",
+		"create materialized view mv3 refresh complete refresh every '0 */5 * * * *' as select * from nation",
+	}, false, false)
+
+	runTestShouldError(mock, t, []string{
+		"create materialized view mv1 refresh every 'every five minutes' as select * from nation",
+		"create materialized view mv1 refresh incremental as select n_name from nation",
+		"create materialized view mv1 refresh incremental as select count(*) from nation, region",
+		"create materialized view mv1 refresh incremental as select count(*) from nation where n_regionkey in (select r_regionkey from region)",
+		"refresh materialized view nation",
+		"drop materialized view nation",
+	})
+
+	logicPlan, err := runOneStmt(mock, t, "create materialized view tpch.mv2 refresh incremental refresh every '@hourly' as select n_regionkey, count(*) from nation group by n_regionkey")
+	require.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	require.NotNil(t, createTable.MaterializedView)
+	require.True(t, createTable.MaterializedView.Incremental)
+	require.Equal(t, "@hourly", createTable.MaterializedView.RefreshCron)
+	require.Equal(t, "tpch", createTable.MaterializedView.QueryDatabase)
+	require.Equal(t, "refresh materialized view `tpch`.`mv2` complete", createTable.CreateAsSelectSql)
+}
@@ -0,0 +1,581 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+)
+
+// mergeWhenInfo is the bound WHEN clause of the MERGE statement.
+type mergeWhenInfo struct {
+	when *tree.MergeWhen
+	// action is the value of the action column for the rows taking this clause
+	action int64
+	// condPos is the position of the clause condition in the select list
+	condPos int
+	// valuePos is the position of the values of the clause in the select list by column name
+	valuePos map[string]int
+}
+
+// buildMerge builds the MERGE statement as one left join of the source and the target:
+//
+//	select target.*, <update values>, <action>, <insert values>
+//	from source left join target on condition
+//
+// the action column is the number of the first WHEN clause the row satisfies, 0 if none.
+// The result is sunk and feeds the existing DML plans by action:
+//
+//	[s]sink_scan -> filter(update actions) -> project -> sink -> update plans
+//	[s]sink_scan -> filter(delete actions) -> sink -> delete plans
+//	[s]sink_scan -> filter(insert actions) -> project -> insert plans
+//	[s]sink_scan -> filter(update or delete actions) -> agg(count by row_id) -> filter(assert)
+//
+// the last branch makes sure every target row is updated or deleted at most once, so the rows
+// of the update plans and the delete plans are disjoint.
+func buildMerge(stmt *tree.Merge, ctx CompilerContext, isPrepareStmt bool) (p *Plan, err error) {
+	start := time.Now()
+	defer func() {
+		v2.TxnStatementBuildMergeHistogram.Observe(time.Since(start).Seconds())
+	}()
+
+	target, ok := stmt.Table.(*tree.AliasedTableExpr)
+	if !ok {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "merge target must be a table")
+	}
+	tblInfo, err := getDmlTableInfo(ctx, tree.TableExprs{target}, nil, nil, "merge")
+	if err != nil {
+		return nil, err
+	}
+	if len(tblInfo.tableDefs) != 1 {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "merge does not support multi-table")
+	}
+	tableDef := tblInfo.tableDefs[0]
+	objRef := tblInfo.objRef[0]
+	var alias string
+	for name := range tblInfo.alias {
+		alias = name
+	}
+
+	// the deletes of the update plans and the delete plans can not cascade to the same child table
+	enabled, err := IsForeignKeyChecksEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if enabled && len(tableDef.RefChildTbls) > 0 {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "merge into table %s referenced by foreign keys", tableDef.Name)
+	}
+
+	colIdx := make(map[string]int, len(tableDef.Cols))
+	rowIdPos := -1
+	for i, col := range tableDef.Cols {
+		colIdx[col.Name] = i
+		if col.Name == catalog.Row_ID {
+			rowIdPos = i
+		}
+	}
+	var insertCols []*ColDef
+	for _, col := range tableDef.Cols {
+		if !col.Hidden || col.Name == catalog.FakePrimaryKeyColName {
+			insertCols = append(insertCols, col)
+		}
+	}
+
+	// select target.*, <clause conditions>, <clause values> from source left join target on condition
+	var selectList []tree.SelectExpr
+	for _, col := range tableDef.Cols {
+		e, _ := tree.NewUnresolvedName(ctx.GetContext(), alias, col.Name)
+		selectList = append(selectList, tree.SelectExpr{Expr: e})
+	}
+	rowIdName, _ := tree.NewUnresolvedName(ctx.GetContext(), alias, catalog.Row_ID)
+	whens := make([]*mergeWhenInfo, len(stmt.Whens))
+	for i, when := range stmt.Whens {
+		var cond tree.Expr
+		if when.Matched {
+			cond = tree.NewIsNotNullExpr(rowIdName)
+		} else {
+			cond = tree.NewIsNullExpr(rowIdName)
+		}
+		if when.Cond != nil {
+			cond = tree.NewAndExpr(cond, tree.NewParentExpr(when.Cond))
+		}
+		whens[i] = &mergeWhenInfo{
+			when:     when,
+			action:   int64(i + 1),
+			condPos:  len(selectList),
+			valuePos: make(map[string]int),
+		}
+		selectList = append(selectList, tree.SelectExpr{Expr: cond})
+	}
+
+	var updateCols []string
+	for _, info := range whens {
+		switch info.when.Action {
+		case tree.MergeUpdate:
+			for _, expr := range info.when.Exprs {
+				name, err := getMergeUpdateColumn(ctx.GetContext(), expr, alias, tableDef, colIdx)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := info.valuePos[name]; !ok && !slices.Contains(updateCols, name) {
+					updateCols = append(updateCols, name)
+				}
+				info.valuePos[name] = len(selectList)
+				selectList = append(selectList, tree.SelectExpr{Expr: expr.Expr})
+			}
+		case tree.MergeInsert:
+			var names []string
+			if info.when.Columns == nil {
+				for _, col := range insertCols {
+					if !col.Hidden {
+						names = append(names, col.Name)
+					}
+				}
+			} else {
+				for _, column := range info.when.Columns {
+					name := string(column)
+					if i, ok := colIdx[name]; !ok || tableDef.Cols[i].Hidden {
+						return nil, moerr.NewBadFieldError(ctx.GetContext(), name, tableDef.Name)
+					}
+					names = append(names, name)
+				}
+			}
+			if len(names) != len(info.when.Values) {
+				return nil, moerr.NewWrongValueCountOnRow(ctx.GetContext(), 1)
+			}
+			for i, name := range names {
+				info.valuePos[name] = len(selectList)
+				selectList = append(selectList, tree.SelectExpr{Expr: info.when.Values[i]})
+			}
+		}
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, isPrepareStmt, false)
+	bindCtx := NewBindContext(builder, nil)
+	selectAst := &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: selectList,
+			From: &tree.From{
+				Tables: tree.TableExprs{&tree.JoinTableExpr{
+					JoinType: tree.JOIN_TYPE_LEFT,
+					Left:     stmt.Source,
+					Right:    target,
+					Cond:     tree.NewOnJoinCond(stmt.On),
+				}},
+			},
+		},
+	}
+	lastNodeId, err := builder.buildSelect(selectAst, bindCtx, false)
+	if err != nil {
+		return nil, err
+	}
+	sourceStep := builder.appendStep(lastNodeId)
+	query, err := builder.createQuery()
+	if err != nil {
+		return nil, err
+	}
+	builder.qry.Steps = append(builder.qry.Steps[:sourceStep], builder.qry.Steps[sourceStep+1:]...)
+
+	// set col = default and insert default
+	lastNode := builder.qry.Nodes[lastNodeId]
+	for _, info := range whens {
+		for name, pos := range info.valuePos {
+			if isDefaultValExpr(lastNode.ProjectList[pos]) {
+				if lastNode.ProjectList[pos], err = getDefaultExpr(builder.GetContext(), tableDef.Cols[colIdx[name]]); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// project the action of the rows
+	projection := getProjectionByLastNode(builder, lastNodeId)
+	caseArgs := make([]*Expr, 0, 2*len(whens)+1)
+	for _, info := range whens {
+		caseArgs = append(caseArgs, projection[info.condPos], makePlan2Int64ConstExprWithType(info.action))
+	}
+	caseArgs = append(caseArgs, makePlan2Int64ConstExprWithType(0))
+	actionExpr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "case", caseArgs)
+	if err != nil {
+		return nil, err
+	}
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: append(projection, actionExpr),
+	}, bindCtx)
+
+	// project target.*, <update values>, <action>, <insert values>
+	projection = getProjectionByLastNode(builder, lastNodeId)
+	action := projection[len(projection)-1]
+	sinkProjection := make([]*Expr, 0, len(tableDef.Cols)+len(updateCols)+1+len(insertCols))
+	sinkProjection = append(sinkProjection, projection[:len(tableDef.Cols)]...)
+	var updateActions, deleteActions, insertActions []int64
+	for _, info := range whens {
+		switch info.when.Action {
+		case tree.MergeUpdate:
+			updateActions = append(updateActions, info.action)
+		case tree.MergeDelete:
+			deleteActions = append(deleteActions, info.action)
+		case tree.MergeInsert:
+			insertActions = append(insertActions, info.action)
+		}
+	}
+	for _, name := range updateCols {
+		col := tableDef.Cols[colIdx[name]]
+		expr, err := makeMergeValueExpr(builder.GetContext(), whens, tree.MergeUpdate, action, projection, col, projection[colIdx[name]])
+		if err != nil {
+			return nil, err
+		}
+		sinkProjection = append(sinkProjection, expr)
+	}
+	actionPos := len(sinkProjection)
+	sinkProjection = append(sinkProjection, action)
+	for _, col := range insertCols {
+		// the default value is only taken by the insert clauses without the column
+		var defExpr *Expr
+		for _, info := range whens {
+			if _, ok := info.valuePos[col.Name]; info.when.Action == tree.MergeInsert && !ok {
+				if defExpr, err = getDefaultExpr(builder.GetContext(), col); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+		expr, err := makeMergeValueExpr(builder.GetContext(), whens, tree.MergeInsert, action, projection, col, defExpr)
+		if err != nil {
+			return nil, err
+		}
+		sinkProjection = append(sinkProjection, expr)
+	}
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: sinkProjection,
+	}, bindCtx)
+	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
+	sourceStep = builder.appendStep(lastNodeId)
+
+	actionCol := &Expr{
+		Typ: sinkProjection[actionPos].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: int32(actionPos),
+			},
+		},
+	}
+
+	// every target row is updated or deleted at most once
+	if len(updateActions)+len(deleteActions) > 0 {
+		if err = appendMergeCardinalityCheck(builder, bindCtx, sourceStep, actionCol, append(updateActions, deleteActions...), tableDef.Cols[rowIdPos].Typ, rowIdPos); err != nil {
+			return nil, err
+		}
+	}
+
+	allDelTableIDs := map[uint64]struct{}{tableDef.TblId: {}}
+	allDelTables := map[FkReferKey]struct{}{{Db: objRef.SchemaName, Tbl: tableDef.Name}: {}}
+
+	if len(updateActions) > 0 {
+		// sink_scan -> filter -> project(target.*, <update values>) -> sink
+		lastNodeId, err = appendMergeActionFilter(builder, bindCtx, sourceStep, actionCol, updateActions)
+		if err != nil {
+			return nil, err
+		}
+		projection = getProjectionByLastNode(builder, lastNodeId)
+		updateProjection := make([]*Expr, 0, len(tableDef.Cols)+len(updateCols))
+		updateColPosMap := make(map[string]int, len(updateCols))
+		updatePkCol := false
+		for i, col := range tableDef.Cols {
+			expr := projection[i]
+			if !slices.Contains(updateCols, col.Name) && col.OnUpdate != nil && col.OnUpdate.Expr != nil {
+				if expr, err = forceCastExpr(builder.GetContext(), DeepCopyExpr(col.OnUpdate.Expr), col.Typ); err != nil {
+					return nil, err
+				}
+			}
+			updateProjection = append(updateProjection, expr)
+		}
+		for i, name := range updateCols {
+			updateColPosMap[name] = len(tableDef.Cols) + i
+			updateProjection = append(updateProjection, projection[len(tableDef.Cols)+i])
+			if tableDef.Pkey != nil && slices.Contains(tableDef.Pkey.Names, name) {
+				updatePkCol = true
+			}
+		}
+		lastNodeId = builder.appendNode(&Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{lastNodeId},
+			ProjectList: updateProjection,
+		}, bindCtx)
+		lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
+
+		upPlanCtx := getDmlPlanCtx()
+		upPlanCtx.objRef = objRef
+		upPlanCtx.tableDef = DeepCopyTableDef(tableDef, true)
+		upPlanCtx.beginIdx = 0
+		upPlanCtx.sourceStep = builder.appendStep(lastNodeId)
+		upPlanCtx.updateColLength = len(updateCols)
+		upPlanCtx.rowIdPos = rowIdPos
+		upPlanCtx.updateColPosMap = updateColPosMap
+		upPlanCtx.allDelTableIDs = allDelTableIDs
+		upPlanCtx.allDelTables = allDelTables
+		upPlanCtx.checkInsertPkDup = true
+		upPlanCtx.updatePkCol = updatePkCol
+		for i, col := range tableDef.Cols {
+			// row_id、compPrimaryKey、clusterByKey will not inserted from old data
+			if col.Hidden && col.Name != catalog.FakePrimaryKeyColName {
+				continue
+			}
+			if pos, ok := updateColPosMap[col.Name]; ok {
+				upPlanCtx.insertColPos = append(upPlanCtx.insertColPos, pos)
+			} else {
+				upPlanCtx.insertColPos = append(upPlanCtx.insertColPos, i)
+			}
+		}
+		err = buildUpdatePlans(ctx, builder, NewBindContext(builder, nil), upPlanCtx, false)
+		putDmlPlanCtx(upPlanCtx)
+		if err != nil {
+			return nil, err
+		}
+		// the rows of the delete plans are not the rows of the update plans
+		delete(builder.deleteNode, tableDef.TblId)
+	}
+
+	if len(deleteActions) > 0 {
+		// sink_scan -> filter -> project(target.*) -> sink
+		lastNodeId, err = appendMergeActionFilter(builder, bindCtx, sourceStep, actionCol, deleteActions)
+		if err != nil {
+			return nil, err
+		}
+		delPlanCtx := getDmlPlanCtx()
+		delPlanCtx.objRef = objRef
+		delPlanCtx.tableDef = DeepCopyTableDef(tableDef, true)
+		delPlanCtx.beginIdx = 0
+		delPlanCtx.updateColLength = 0
+		delPlanCtx.rowIdPos = rowIdPos
+		delPlanCtx.allDelTableIDs = allDelTableIDs
+		delPlanCtx.allDelTables = allDelTables
+		deleteBindCtx := NewBindContext(builder, nil)
+		lastNodeId, err = makePreUpdateDeletePlan(ctx, builder, deleteBindCtx, delPlanCtx, lastNodeId)
+		if err != nil {
+			putDmlPlanCtx(delPlanCtx)
+			return nil, err
+		}
+		lastNodeId = appendSinkNode(builder, deleteBindCtx, lastNodeId)
+		delPlanCtx.sourceStep = builder.appendStep(lastNodeId)
+		err = buildDeletePlans(ctx, builder, deleteBindCtx, delPlanCtx)
+		putDmlPlanCtx(delPlanCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(insertActions) > 0 {
+		// sink_scan -> filter -> project(<insert values>) -> insert plans
+		lastNodeId, err = appendMergeActionFilter(builder, bindCtx, sourceStep, actionCol, insertActions)
+		if err != nil {
+			return nil, err
+		}
+		projection = getProjectionByLastNode(builder, lastNodeId)
+		lastNodeId = builder.appendNode(&Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{lastNodeId},
+			ProjectList: projection[actionPos+1:],
+		}, bindCtx)
+
+		insertTableDef := DeepCopyTableDef(tableDef, true)
+		insertTableDef.Cols = make([]*ColDef, 0, len(insertCols))
+		insertTableDef.Name2ColIndex = make(map[string]int32, len(insertCols))
+		for i, col := range insertCols {
+			insertTableDef.Cols = append(insertTableDef.Cols, DeepCopyColDef(col))
+			insertTableDef.Name2ColIndex[col.Name] = int32(i)
+		}
+		if err = buildInsertPlans(ctx, builder, NewBindContext(builder, nil), nil, objRef, insertTableDef, lastNodeId, false, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	sqls, err := genSqlsForCheckFKSelfRefer(ctx.GetContext(),
+		objRef.SchemaName, tableDef.Name, tableDef.Cols, tableDef.Fkeys)
+	if err != nil {
+		return nil, err
+	}
+	query.DetectSqls = sqls
+	reduceSinkSinkScanNodes(query)
+	ReCalcQueryStats(builder, query)
+	reCheckifNeedLockWholeTable(builder)
+	query.StmtType = plan.Query_UPDATE
+	return &Plan{
+		Plan: &plan.Plan_Query{
+			Query: query,
+		},
+	}, nil
+}
+
+// getMergeUpdateColumn returns the target column assigned by WHEN MATCHED THEN UPDATE.
+func getMergeUpdateColumn(ctx context.Context, expr *tree.UpdateExpr, alias string, tableDef *TableDef, colIdx map[string]int) (string, error) {
+	if len(expr.Names) > 1 {
+		return "", moerr.NewNYI(ctx, "unsupport expr")
+	}
+	parts := expr.Names[0]
+	name := parts.Parts[0]
+	if parts.NumParts > 1 && parts.Parts[1] != alias {
+		return "", moerr.NewInvalidInput(ctx, "merge can only update the columns of the target table %s", alias)
+	}
+	if i, ok := colIdx[name]; !ok || tableDef.Cols[i].Hidden {
+		return "", moerr.NewInternalError(ctx, "column '%v' not found in table %s", name, alias)
+	}
+	return name, nil
+}
+
+// makeMergeValueExpr returns the value of the column by the action of the row:
+//
+//	case action when 1 then <value of clause 1> when 2 then <value of clause 2> ... else <elseExpr> end
+//
+// only the clauses of the type are taken, and elseExpr is the value of the column for the
+// clauses of the type without the column. The null elseExpr is the null of the column type.
+func makeMergeValueExpr(ctx context.Context, whens []*mergeWhenInfo, typ tree.MergeActionType,
+	action *Expr, projection []*Expr, col *ColDef, elseExpr *Expr) (*Expr, error) {
+	castToCol := func(expr *Expr) (*Expr, error) {
+		if col.Typ.Id == int32(types.T_enum) {
+			return funcCastForEnumType(ctx, expr, col.Typ)
+		}
+		return forceCastExpr(ctx, expr, col.Typ)
+	}
+
+	var err error
+	if elseExpr == nil {
+		elseExpr = &Expr{
+			Typ: plan.Type{
+				Id:    col.Typ.Id,
+				Width: col.Typ.Width,
+				Scale: col.Typ.Scale,
+			},
+			Expr: &plan.Expr_Lit{
+				Lit: &Const{
+					Isnull: true,
+				},
+			},
+		}
+	}
+	if elseExpr, err = castToCol(elseExpr); err != nil {
+		return nil, err
+	}
+
+	var args []*Expr
+	for _, info := range whens {
+		if info.when.Action != typ {
+			continue
+		}
+		value := elseExpr
+		if pos, ok := info.valuePos[col.Name]; ok {
+			if value, err = castToCol(projection[pos]); err != nil {
+				return nil, err
+			}
+		}
+		cond, err := BindFuncExprImplByPlanExpr(ctx, "=", []*Expr{DeepCopyExpr(action), makePlan2Int64ConstExprWithType(info.action)})
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, cond, value)
+	}
+	if len(args) == 0 {
+		return elseExpr, nil
+	}
+	args = append(args, elseExpr)
+	return BindFuncExprImplByPlanExpr(ctx, "case", args)
+}
+
+// appendMergeActionFilter appends sink_scan -> filter(action in actions).
+func appendMergeActionFilter(builder *QueryBuilder, bindCtx *BindContext, sourceStep int32, action *Expr, actions []int64) (int32, error) {
+	var filterExpr *Expr
+	for _, a := range actions {
+		expr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{DeepCopyExpr(action), makePlan2Int64ConstExprWithType(a)})
+		if err != nil {
+			return -1, err
+		}
+		if filterExpr == nil {
+			filterExpr = expr
+		} else if filterExpr, err = BindFuncExprImplByPlanExpr(builder.GetContext(), "or", []*Expr{filterExpr, expr}); err != nil {
+			return -1, err
+		}
+	}
+	lastNodeId := appendSinkScanNode(builder, bindCtx, sourceStep)
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:    plan.Node_FILTER,
+		Children:    []int32{lastNodeId},
+		FilterList:  []*Expr{filterExpr},
+		ProjectList: getProjectionByLastNode(builder, lastNodeId),
+	}, bindCtx)
+	return lastNodeId, nil
+}
+
+// appendMergeCardinalityCheck appends the plan checking every target row is updated or deleted at most once:
+//
+//	sink_scan -> filter(action in actions) -> agg(count group by row_id) -> filter(assert(count = 1))
+func appendMergeCardinalityCheck(builder *QueryBuilder, bindCtx *BindContext, sourceStep int32,
+	action *Expr, actions []int64, rowIdTyp Type, rowIdPos int) error {
+	lastNodeId, err := appendMergeActionFilter(builder, bindCtx, sourceStep, action, actions)
+	if err != nil {
+		return err
+	}
+	rowIdExpr := &Expr{
+		Typ: rowIdTyp,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: int32(rowIdPos),
+				Name:   catalog.Row_ID,
+			},
+		},
+	}
+	lastNodeId, err = appendAggCountGroupByColExpr(builder, bindCtx, lastNodeId, rowIdExpr)
+	if err != nil {
+		return err
+	}
+
+	countType := types.T_int64.ToType()
+	countColExpr := &Expr{
+		Typ: makePlan2Type(&countType),
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: 0,
+			},
+		},
+	}
+	eqCheckExpr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{makePlan2Int64ConstExprWithType(1), countColExpr})
+	if err != nil {
+		return err
+	}
+	filterExpr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "assert", []*Expr{eqCheckExpr,
+		makePlan2StringConstExprWithType("merge can not update or delete the same row of the target table more than once")})
+	if err != nil {
+		return err
+	}
+	lastNodeId = builder.appendNode(&Node{
+		NodeType:   plan.Node_FILTER,
+		Children:   []int32{lastNodeId},
+		FilterList: []*Expr{filterExpr},
+		IsEnd:      true,
+	}, bindCtx)
+	builder.appendStep(lastNodeId)
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// getDmlTableAlias returns the alias of the idx-th table of the insert/update/delete statement,
+// it is the table name if the table has no alias.
+func getDmlTableAlias(tblInfo *dmlTableInfo, idx int) string {
+	for alias, i := range tblInfo.alias {
+		if i == idx {
+			return alias
+		}
+	}
+	return tblInfo.tableDefs[idx].Name
+}
+
+// buildReturning appends the RETURNING clause of the insert/update/delete statement.
+//
+// The insert and delete operators pass the rows they write through, so the clause is a
+// project node on the insert node (insert, update) or the delete node (delete) of the
+// target table:
+//
+//	... -> insert/delete -> project(returning)
+//
+// the step of the node is moved to the end of the query, and the rows of the last step
+// are returned to the client like the select statement. It must be called after
+// reduceSinkSinkScanNodes, which renumbers the steps.
+func buildReturning(builder *QueryBuilder, query *Query, returning tree.SelectExprs,
+	table string, tableDef *TableDef, nodeType plan.Node_NodeType) error {
+	stepIdx := -1
+	for i, nodeId := range query.Steps {
+		node := query.Nodes[nodeId]
+		if node.NodeType != nodeType {
+			continue
+		}
+		var found bool
+		switch nodeType {
+		case plan.Node_INSERT:
+			found = node.InsertCtx.TableDef.TblId == tableDef.TblId
+		case plan.Node_DELETE:
+			found = node.DeleteCtx.TableDef.TblId == tableDef.TblId
+		}
+		if !found {
+			continue
+		}
+		if stepIdx != -1 {
+			return moerr.NewNYI(builder.GetContext(), "RETURNING clause of this statement")
+		}
+		stepIdx = i
+	}
+	if stepIdx == -1 {
+		return moerr.NewNYI(builder.GetContext(), "RETURNING clause of this statement")
+	}
+	nodeId := query.Steps[stepIdx]
+	node := query.Nodes[nodeId]
+
+	var cols []*ColDef
+	if nodeType == plan.Node_INSERT {
+		cols = node.InsertCtx.TableDef.Cols
+	} else {
+		cols = node.DeleteCtx.TableDef.Cols
+	}
+	// the types of the columns are the types of the rows passed through the node
+	projection := getProjectionByLastNode(builder, node.Children[0])
+	if len(projection) < len(cols) {
+		return moerr.NewInternalError(builder.GetContext(), "the rows of %s do not have all the columns of table %s", node.NodeType, tableDef.Name)
+	}
+	rowCols := make([]*ColDef, len(cols))
+	for i, col := range cols {
+		rowCols[i] = &ColDef{
+			Name:   col.Name,
+			Hidden: col.Hidden,
+			Typ:    projection[i].Typ,
+		}
+	}
+
+	bindCtx := NewBindContext(builder, nil)
+	binder := NewReturningBinder(builder.GetContext(), builder, bindCtx, table, rowCols)
+	var headings []string
+	var projectList []*Expr
+	appendColumns := func(qualifier string) error {
+		if qualifier != "" && qualifier != table {
+			return moerr.NewInvalidInput(builder.GetContext(), "missing FROM-clause entry for table '%v'", qualifier)
+		}
+		for i, col := range rowCols {
+			if col.Hidden {
+				continue
+			}
+			headings = append(headings, col.Name)
+			projectList = append(projectList, &Expr{
+				Typ: col.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						ColPos: int32(i),
+						Name:   col.Name,
+					},
+				},
+			})
+		}
+		return nil
+	}
+	for _, selectExpr := range returning {
+		switch expr := selectExpr.Expr.(type) {
+		case tree.UnqualifiedStar:
+			if err := appendColumns(""); err != nil {
+				return err
+			}
+			continue
+		case *tree.UnresolvedName:
+			if expr.Star {
+				if err := appendColumns(expr.Parts[0]); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if selectExpr.As != nil && !selectExpr.As.Empty() {
+			headings = append(headings, selectExpr.As.Origin())
+		} else if name, ok := selectExpr.Expr.(*tree.UnresolvedName); ok {
+			if name.CStrParts[0] != nil {
+				headings = append(headings, name.CStrParts[0].Origin())
+			} else {
+				headings = append(headings, name.Parts[0])
+			}
+		} else {
+			expr := selectExpr.Expr
+			for {
+				if parenExpr, ok := expr.(*tree.ParenExpr); ok {
+					expr = parenExpr.Expr
+				} else {
+					break
+				}
+			}
+			headings = append(headings, tree.String(expr, dialect.MYSQL))
+		}
+		planExpr, err := binder.BindExpr(selectExpr.Expr, 0, true)
+		if err != nil {
+			return err
+		}
+		projectList = append(projectList, planExpr)
+	}
+
+	projectNode := &Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{nodeId},
+		ProjectList: projectList,
+	}
+	lastNodeId := builder.appendNode(projectNode, bindCtx)
+
+	moveStepToEnd(query, stepIdx, lastNodeId)
+	query.Headings = headings
+	query.Returning = true
+	return nil
+}
+
+// moveStepToEnd moves the stepIdx-th step of the query to the end with the new root node,
+// the source steps of the nodes reading the steps behind it and the step itself are remapped.
+func moveStepToEnd(query *Query, stepIdx int, rootId int32) {
+	query.Steps = append(query.Steps[:stepIdx], query.Steps[stepIdx+1:]...)
+	query.Steps = append(query.Steps, rootId)
+	lastStep := int32(len(query.Steps) - 1)
+	for _, n := range query.Nodes {
+		for i, step := range n.SourceStep {
+			switch {
+			case step == int32(stepIdx):
+				n.SourceStep[i] = lastStep
+			case step > int32(stepIdx):
+				n.SourceStep[i] = step - 1
+			}
+		}
+	}
+}
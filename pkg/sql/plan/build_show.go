@@ -689,6 +689,10 @@ func buildShowTarget(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error)
 		sql = "select '' as `Charset`, '' as `Description`, '' as `Default collation`, '' as `Maxlen` where 0"
 	case tree.ShowTriggers:
 		return buildShowTriggers(stmt, ctx)
+	case tree.ShowEvents:
+		return buildShowEvents(stmt, ctx)
+	case tree.ShowPlanBaselines:
+		return buildShowPlanBaselines(stmt, ctx)
 	default:
 		sql = "select 1 where 0"
 	}
@@ -747,7 +751,7 @@ func buildShowTriggers(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error
 	stmt.DbName = dbName
 
 	ddlType := plan.DataDefinition_SHOW_TARGET
-	sql := fmt.Sprintf("SELECT trigger_name as `Trigger`, event_manipulation as `Event`, event_object_table as `Table`, action_statement as `Statement`, action_timing as `Timing`, created as `Created`, sql_mode, definer as `Definer`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.TRIGGERS ", INFORMATION_SCHEMA)
+	sql := fmt.Sprintf("SELECT trigger_name as `Trigger`, event_manipulation as `Event`, event_object_table as `Table`, action_statement as `Statement`, action_timing as `Timing`, created as `Created`, sql_mode, definer as `Definer`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.TRIGGERS WHERE trigger_schema = '%s'", INFORMATION_SCHEMA, dbName)
 
 	if stmt.Where != nil {
 		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
@@ -763,6 +767,56 @@ func buildShowTriggers(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error
 	return returnByRewriteSQL(ctx, sql, ddlType)
 }
 
+func buildShowEvents(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error) {
+	if stmt.Like != nil && stmt.Where != nil {
+		return nil, moerr.NewSyntaxError(ctx.GetContext(), "like clause and where clause cannot exist at the same time")
+	}
+
+	dbName, err := databaseIsValid(stmt.DbName, ctx, Snapshot{TS: &timestamp.Timestamp{}})
+	if err != nil {
+		return nil, err
+	}
+	stmt.DbName = dbName
+
+	ddlType := plan.DataDefinition_SHOW_TARGET
+	sql := fmt.Sprintf("SELECT event_schema as `Db`, event_name as `Name`, definer as `Definer`, time_zone as `Time zone`, event_type as `Type`, execute_at as `Execute at`, interval_value as `Interval value`, interval_field as `Interval field`, starts as `Starts`, ends as `Ends`, status as `Status`, originator as `Originator`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.EVENTS WHERE event_schema = '%s'", INFORMATION_SCHEMA, dbName)
+
+	if stmt.Where != nil {
+		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
+	}
+
+	if stmt.Like != nil {
+		// append filter [AND event_name like stmt.Like] to WHERE clause
+		likeExpr := stmt.Like
+		likeExpr.Left = tree.SetUnresolvedName("event_name")
+		return returnByLikeAndSQL(ctx, sql, likeExpr, ddlType)
+	}
+
+	return returnByRewriteSQL(ctx, sql, ddlType)
+}
+
+func buildShowPlanBaselines(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error) {
+	if stmt.Like != nil && stmt.Where != nil {
+		return nil, moerr.NewSyntaxError(ctx.GetContext(), "like clause and where clause cannot exist at the same time")
+	}
+
+	ddlType := plan.DataDefinition_SHOW_TARGET
+	sql := fmt.Sprintf("SELECT digest as `Digest`, plan_digest as `Plan digest`, database_name as `Database`, sql_text as `Sql text`, plan_hints as `Plan hints`, status as `Status`, cost as `Cost`, create_time as `Create time`, update_time as `Update time` FROM %s.%s", MO_CATALOG_DB_NAME, catalog.MO_PLAN_BASELINES)
+
+	if stmt.Where != nil {
+		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
+	}
+
+	if stmt.Like != nil {
+		// append filter [AND sql_text like stmt.Like] to WHERE clause
+		likeExpr := stmt.Like
+		likeExpr.Left = tree.SetUnresolvedName("sql_text")
+		return returnByLikeAndSQL(ctx, sql, likeExpr, ddlType)
+	}
+
+	return returnByRewriteSQL(ctx, sql, ddlType)
+}
+
 func buildShowIndex(stmt *tree.ShowIndex, ctx CompilerContext) (*Plan, error) {
 	dbName, err := databaseIsValid(getSuitableDBName(stmt.TableName.GetDBName(), stmt.DbName), ctx, Snapshot{TS: &timestamp.Timestamp{}})
 	if err != nil {
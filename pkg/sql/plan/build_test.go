@@ -24,12 +24,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
 )
 
 func BenchmarkInsert(b *testing.B) {
@@ -708,6 +712,122 @@ func TestDelete(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestReturning(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	// should pass
+	kases := []struct {
+		sql      string
+		headings []string
+	}{
+		{"insert into nation values (1, 'a', 2, 'b') returning *", []string{"n_nationkey", "n_name", "n_regionkey", "n_comment"}},
+		{"insert into nation (n_nationkey, n_name, n_regionkey) select n_nationkey, n_name, r_regionkey from nation2 returning n_nationkey, nation.n_name as name", []string{"n_nationkey", "name"}},
+		{"update nation set n_name = 'x' where n_nationkey > 10 returning n_nationkey, concat(n_name, n_comment)", []string{"n_nationkey", "concat(n_name, n_comment)"}},
+		{"update nation a set a.n_regionkey = a.n_regionkey + 1 returning a.*", []string{"n_nationkey", "n_name", "n_regionkey", "n_comment"}},
+		{"delete from nation where n_nationkey > 10 returning n_name", []string{"n_name"}},
+		{"delete from nation returning n_nationkey + 1", []string{"n_nationkey + 1"}},
+	}
+	for _, kase := range kases {
+		logicPlan, err := runOneStmt(mock, t, kase.sql)
+		require.NoError(t, err, kase.sql)
+		query := logicPlan.GetQuery()
+		require.True(t, query.Returning, kase.sql)
+		require.Equal(t, plan.Node_PROJECT, query.Nodes[query.Steps[len(query.Steps)-1]].NodeType, kase.sql)
+		cols := GetResultColumnsFromPlan(logicPlan)
+		require.Equal(t, len(kase.headings), len(cols), kase.sql)
+		for i, col := range cols {
+			require.Equal(t, kase.headings[i], col.Name, kase.sql)
+		}
+	}
+
+	// should error
+	sqls := []string{
+		"insert into nation values (1, 'a', 2, 'b') returning n_name2",        // column not exist
+		"delete from nation where n_nationkey > 10 returning nation2.n_name",  // not the target table
+		"update nation set n_name = 'x' returning count(*)",                   // aggregate
+		"delete from nation returning (select max(n_nationkey) from nation2)", // subquery
+		"delete from nation where n_nationkey > 10 returning __mo_rowid",      // hidden column
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestMoveStepToEnd(t *testing.T) {
+	// step 0: sink(0), step 1: insert(2) <- sink scan(1) of step 0,
+	// step 2: filter(4) <- sink scan(3) of step 1, step 3: sink scan(5) of step 2
+	query := &Query{
+		Steps: []int32{0, 2, 4, 5},
+		Nodes: []*Node{
+			{NodeType: plan.Node_SINK},
+			{NodeType: plan.Node_SINK_SCAN, SourceStep: []int32{0}},
+			{NodeType: plan.Node_INSERT, Children: []int32{1}},
+			{NodeType: plan.Node_SINK_SCAN, SourceStep: []int32{1}},
+			{NodeType: plan.Node_FILTER, Children: []int32{3}},
+			{NodeType: plan.Node_SINK_SCAN, SourceStep: []int32{2}},
+			{NodeType: plan.Node_PROJECT, Children: []int32{2}},
+		},
+	}
+	moveStepToEnd(query, 1, 6)
+	require.Equal(t, []int32{0, 4, 5, 6}, query.Steps)
+	require.Equal(t, []int32{0}, query.Nodes[1].SourceStep)
+	require.Equal(t, []int32{3}, query.Nodes[3].SourceStep)
+	require.Equal(t, []int32{1}, query.Nodes[5].SourceStep)
+}
+
+func TestReturningWithConstraint(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	// emp has a unique index and a foreign key referring to dept, dept has a unique index
+	sqls := []string{
+		"insert into emp values (1, 'a', 'b', 1, '2020-01-01', 1, 1, 10) returning *",
+		"update emp set ename = 'x', deptno = 20 where empno = 1 returning empno, ename",
+		"delete from emp where empno = 1 returning ename",
+		"insert into dept values (1, 'a', 'b') returning deptno",
+		"update dept set deptno = 2, dname = 'x' where deptno = 1 returning dname",
+		"delete from dept where deptno = 1 returning dname, loc",
+	}
+	for _, sql := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		query := logicPlan.GetQuery()
+		require.True(t, query.Returning, sql)
+		require.Equal(t, plan.Node_PROJECT, query.Nodes[query.Steps[len(query.Steps)-1]].NodeType, sql)
+		// the sink scan nodes of the steps read the sink nodes of the steps before them
+		var check func(stepIdx int, nodeId int32)
+		check = func(stepIdx int, nodeId int32) {
+			node := query.Nodes[nodeId]
+			for _, step := range node.SourceStep {
+				require.Less(t, int(step), stepIdx, sql)
+				require.Equal(t, plan.Node_SINK, query.Nodes[query.Steps[step]].NodeType, sql)
+			}
+			for _, child := range node.Children {
+				check(stepIdx, child)
+			}
+		}
+		for i, nodeId := range query.Steps {
+			check(i, nodeId)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	// should pass
+	sqls := []string{
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched then update set n_name = s.n_name",
+		"merge into nation using nation2 s on nation.n_nationkey = s.n_nationkey when not matched then insert values (s.n_nationkey, s.n_name, s.r_regionkey, s.n_comment)",
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched and s.r_regionkey = 0 then delete when matched then update set t.n_name = s.n_name, n_comment = default when not matched then insert (n_nationkey, n_name, n_regionkey) values (s.n_nationkey, s.n_name, s.r_regionkey)",
+		"merge into nation t using (select * from nation2 where r_regionkey > 1) s on t.n_nationkey = s.n_nationkey when matched then delete",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"merge into nation2222 t using nation2 s on t.n_nationkey = s.n_nationkey when matched then delete",                             // table not exist
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched then update set n_name2 = s.n_name",          // column not exist
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when matched then update set s.n_name = t.n_name",         // not the target
+		"merge into nation t using nation2 s on t.n_nationkey = s.n_nationkey when not matched then insert (n_nationkey) values (1, 2)", // value count
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestSubQuery(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
@@ -1032,6 +1152,35 @@ func TestBuildUnnest(t *testing.T) {
 	runTestShouldError(mock, t, errSqls)
 }
 
+func TestBindTriggerPseudoRow(t *testing.T) {
+	rt := moruntime.DefaultRuntime()
+	moruntime.SetupProcessLevelRuntime(rt)
+	moruntime.ProcessLevelRuntime().SetGlobalVariables(moruntime.InternalSQLExecutor, executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+		return executor.Result{}, nil
+	}))
+	mock := NewMockOptimizer(false)
+	scope := []map[string]interface{}{{"new.n_name": "x", "n_regionkey": "1"}}
+	ctx := context.WithValue(context.Background(), defines.VarScopeKey{}, &scope)
+	mock.ctxt.ctx = context.WithValue(ctx, defines.InSp{}, true)
+
+	logicPlan, err := runOneStmt(mock, t, "select new.n_name, nation.n_name, n_regionkey from nation")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry := logicPlan.GetQuery()
+	var varNames []string
+	for _, node := range qry.Nodes {
+		for _, expr := range node.ProjectList {
+			if v := expr.GetV(); v != nil {
+				varNames = append(varNames, v.Name)
+			}
+		}
+	}
+	assert.Equal(t, []string{"new.n_name", "n_regionkey"}, varNames)
+
+	runTestShouldError(mock, t, []string{"select old.n_name from nation"})
+}
+
 func TestVisitRule(t *testing.T) {
 	sql := "select * from nation where n_nationkey > 10 or n_nationkey=@int_var or abs(-1) > 1"
 	mock := NewMockOptimizer(false)
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -34,8 +35,12 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext, isPrepareStmt bool
 	if err != nil {
 		return nil, err
 	}
+	if len(stmt.Returning) > 0 && len(tblInfo.tableDefs) > 1 {
+		return nil, moerr.NewNYI(ctx.GetContext(), "RETURNING clause of multi-table update")
+	}
 	// new logic
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx, isPrepareStmt, false)
+	builder.stmtHints = stmt.Hints
 	queryBindCtx := NewBindContext(builder, nil)
 	lastNodeId, updatePlanCtxs, err := selectUpdateTables(builder, queryBindCtx, stmt, tblInfo)
 	if err != nil {
@@ -89,6 +94,11 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext, isPrepareStmt bool
 	}
 	query.DetectSqls = detectSqls
 	reduceSinkSinkScanNodes(query)
+	if len(stmt.Returning) > 0 {
+		if err = buildReturning(builder, query, stmt.Returning, getDmlTableAlias(tblInfo, 0), tblInfo.tableDefs[0], plan.Node_INSERT); err != nil {
+			return nil, err
+		}
+	}
 	ReCalcQueryStats(builder, query)
 	reCheckifNeedLockWholeTable(builder)
 	query.StmtType = plan.Query_UPDATE
@@ -156,7 +156,7 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				// create table t1(a char) -> DisplayWith = -1；but get width=1 in MySQL and PgSQL
 				if fstr == "char" || fstr == "binary" {
 					width = 1
-				} else if fstr == "vecf32" || fstr == "vecf64" {
+				} else if fstr == "vecf32" || fstr == "vecf64" || fstr == "veci8" || fstr == "vecbit" {
 					width = types.MaxArrayDimension
 				} else {
 					width = types.MaxVarcharLen
@@ -167,13 +167,16 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen is over the MaxCharLen: %v", types.MaxCharLen)
 			} else if (fstr == "varchar" || fstr == "varbinary") && width > types.MaxVarcharLen {
 				return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen is over the MaxVarcharLen: %v", types.MaxVarcharLen)
-			} else if fstr == "vecf32" || fstr == "vecf64" {
+			} else if fstr == "vecf32" || fstr == "vecf64" || fstr == "veci8" || fstr == "vecbit" {
 				if width > types.MaxArrayDimension {
 					return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen is over the MaxVectorLen : %v", types.MaxArrayDimension)
 				}
 				if width < 1 {
 					return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen cannot be less than 1")
 				}
+				if fstr == "vecbit" && width%types.BitArrayDimensionUnit != 0 {
+					return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen must be a multiple of %v", types.BitArrayDimensionUnit)
+				}
 			}
 			switch fstr {
 			case "char":
@@ -186,6 +189,10 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				return plan.Type{Id: int32(types.T_array_float32), Width: width}, nil
 			case "vecf64":
 				return plan.Type{Id: int32(types.T_array_float64), Width: width}, nil
+			case "veci8":
+				return plan.Type{Id: int32(types.T_array_int8), Width: width}, nil
+			case "vecbit":
+				return plan.Type{Id: int32(types.T_array_bit), Width: width}, nil
 			}
 			// varbinary
 			return plan.Type{Id: int32(types.T_varbinary), Width: width}, nil
@@ -727,7 +734,7 @@ func rewriteForCreateTableLike(stmt *tree.CreateTable, ctx CompilerContext) (new
 		}
 		if typ.Oid == types.T_varchar || typ.Oid == types.T_char ||
 			typ.Oid == types.T_binary || typ.Oid == types.T_varbinary ||
-			typ.Oid.IsArrayRelate() || typ.Oid == types.T_bit {
+			typ.Oid.IsArrayRelate() || typ.Oid.IsQuantizedArray() || typ.Oid == types.T_bit {
 			typeStr += fmt.Sprintf("(%d)", col.Typ.Width)
 		}
 		if typ.Oid.IsFloat() && col.Typ.Scale != -1 {
@@ -102,6 +102,17 @@ func makeCrossJoinCentroidsMetaForCurrVersion(builder *QueryBuilder, bindCtx *Bi
 	return joinMetaAndCentroidsId, nil
 }
 
+// ivfCentroidTypeId returns the type of the centroids of an IVFFLAT index built on a column of type id.
+// veci8 columns are clustered in float32, so their centroids are stored as vecf32. vecbit columns are
+// clustered by hamming distance and their centroids are the majority bits of the clusters, so they stay
+// vecbit.
+func ivfCentroidTypeId(id int32) int32 {
+	if id == int32(types.T_array_int8) {
+		return int32(types.T_array_float32)
+	}
+	return id
+}
+
 func makeTblCrossJoinL2Centroids(builder *QueryBuilder, bindCtx *BindContext, tableDef *TableDef, lastNodeId int32, currVersionCentroids int32, typeOriginPk Type, posOriginPk int, typeOriginVecColumn Type, posOriginVecColumn int) int32 {
 	joinTblAndCentroidsUsingCrossL2Join := builder.appendNode(&plan.Node{
 		NodeType: plan.Node_JOIN,
@@ -151,7 +162,10 @@ func makeTblCrossJoinL2Centroids(builder *QueryBuilder, bindCtx *BindContext, ta
 		},
 		OnList: []*Expr{
 			{ // centroids.centroid
-				Typ: *DeepCopyType(&typeOriginVecColumn),
+				Typ: Type{
+					Id:    ivfCentroidTypeId(typeOriginVecColumn.Id),
+					Width: typeOriginVecColumn.Width,
+				},
 				Expr: &plan.Expr_Col{
 					Col: &plan.ColRef{
 						RelPos: 1,
@@ -1153,5 +1153,42 @@ func DeepCopyPartitionByDef(partiiondef *PartitionByDef) *PartitionByDef {
 			PartitionTableName: e.PartitionTableName,
 		}
 	}
+
+	if partiiondef.SubPartition != nil {
+		partitionDef.SubPartition = DeepCopySubPartitionByDef(partiiondef.SubPartition)
+	}
 	return partitionDef
 }
+
+func DeepCopySubPartitionByDef(subPartitionDef *plan.SubPartitionByDef) *plan.SubPartitionByDef {
+	newSubPartitionDef := &plan.SubPartitionByDef{
+		Type:                      subPartitionDef.GetType(),
+		PartitionExpression:       DeepCopyExpr(subPartitionDef.GetPartitionExpression()),
+		PartitionNum:              subPartitionDef.GetPartitionNum(),
+		Algorithm:                 subPartitionDef.GetAlgorithm(),
+		Partitions:                make([]*plan.PartitionItem, len(subPartitionDef.Partitions)),
+		ParentPartitionExpression: DeepCopyExpr(subPartitionDef.GetParentPartitionExpression()),
+	}
+	if subPartitionDef.PartitionExpr != nil {
+		newSubPartitionDef.PartitionExpr = &plan.PartitionExpr{
+			Expr:    DeepCopyExpr(subPartitionDef.PartitionExpr.Expr),
+			ExprStr: subPartitionDef.PartitionExpr.GetExprStr(),
+		}
+	}
+	if subPartitionDef.PartitionColumns != nil {
+		newSubPartitionDef.PartitionColumns = &plan.PartitionColumns{
+			Columns:          DeepCopyExprList(subPartitionDef.PartitionColumns.Columns),
+			PartitionColumns: DeepCopyStringList(subPartitionDef.PartitionColumns.PartitionColumns),
+		}
+	}
+	for i, e := range subPartitionDef.Partitions {
+		newSubPartitionDef.Partitions[i] = &plan.PartitionItem{
+			PartitionName:      e.PartitionName,
+			OrdinalPosition:    e.OrdinalPosition,
+			Description:        e.Description,
+			Comment:            e.Comment,
+			PartitionTableName: e.PartitionTableName,
+		}
+	}
+	return newSubPartitionDef
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BuildExplainDot returns the Graphviz graph of the document of EXPLAIN
+// FORMAT=JSON. Every step is a cluster, a node is named n<id> and has an edge
+// to its parent, and a sink scan has a dashed edge from the root of every
+// step it reads.
+func BuildExplainDot(doc *ExplainJson) string {
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	buf.WriteString("digraph plan {\n")
+	buf.WriteString("  rankdir=BT;\n")
+	buf.WriteString("  node [shape=box, fontname=\"Courier\"];\n")
+
+	roots := make(map[int]int32, len(doc.Steps))
+	for _, step := range doc.Steps {
+		if step.Plan != nil {
+			roots[step.Step] = step.Plan.Id
+		}
+	}
+	for _, step := range doc.Steps {
+		fmt.Fprintf(buf, "  subgraph cluster_%d {\n", step.Step)
+		fmt.Fprintf(buf, "    label=%s;\n", dotQuote(fmt.Sprintf("Plan %d", step.Step)))
+		writeDotNode(buf, step.Plan)
+		buf.WriteString("  }\n")
+	}
+	for _, step := range doc.Steps {
+		writeDotSourceEdges(buf, step.Plan, roots)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+func writeDotNode(buf *bytes.Buffer, node *ExplainJsonNode) {
+	if node == nil {
+		return
+	}
+	label := node.Type
+	if node.Object != "" {
+		label += " on " + node.Object
+	}
+	label += fmt.Sprintf("\nrows=%.0f cost=%.2f", node.Estimate.Rows, node.Estimate.Cost)
+	for _, line := range node.Details {
+		label += "\n" + line
+	}
+	if a := node.Analyze; a != nil {
+		label += fmt.Sprintf("\ntime=%dms outputRows=%d memory=%dbytes",
+			a.TimeConsumed/MILLION, a.OutputRows, a.MemorySize)
+	}
+	fmt.Fprintf(buf, "    n%d [label=%s];\n", node.Id, dotQuote(label))
+	for _, child := range node.Children {
+		writeDotNode(buf, child)
+		fmt.Fprintf(buf, "    n%d -> n%d;\n", child.Id, node.Id)
+	}
+}
+
+func writeDotSourceEdges(buf *bytes.Buffer, node *ExplainJsonNode, roots map[int]int32) {
+	if node == nil {
+		return
+	}
+	for _, step := range node.SourceSteps {
+		if root, ok := roots[int(step)]; ok {
+			fmt.Fprintf(buf, "  n%d -> n%d [style=dashed];\n", root, node.Id)
+		}
+	}
+	for _, child := range node.Children {
+		writeDotSourceEdges(buf, child, roots)
+	}
+}
+
+// dotQuote quotes the string as a DOT string, the lines are left aligned.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\l`)
+	return `"` + s + `\l"`
+}
+
+// explainPlanDot pushes the graph of the query into the buffer as one line,
+// which is one row of the result.
+func (e *ExplainQueryImpl) explainPlanDot(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	doc, err := BuildExplainJson(ctx, e.QueryPlan, options)
+	if err != nil {
+		return err
+	}
+	buffer.PushDocument(BuildExplainDot(doc))
+	return nil
+}
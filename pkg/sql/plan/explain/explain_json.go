@@ -0,0 +1,270 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// ExplainJsonVersion is the version of the schema of EXPLAIN FORMAT=JSON. The
+// fields are only added in the same version, the version is increased if any
+// field is removed or changes its meaning.
+const ExplainJsonVersion = 1
+
+// ExplainJson is the document of EXPLAIN FORMAT=JSON:
+//
+//	{
+//	  "version": 1,
+//	  "steps": [{"step": 0, "plan": {node}}, ...]
+//	}
+//
+// The steps are the plans of the query in the order they run, the sink scans
+// of a plan read the results of the steps in its source_steps.
+type ExplainJson struct {
+	Version int               `json:"version"`
+	Steps   []ExplainJsonStep `json:"steps"`
+}
+
+type ExplainJsonStep struct {
+	Step int              `json:"step"`
+	Plan *ExplainJsonNode `json:"plan"`
+}
+
+// ExplainJsonNode is an operator of the plan:
+//
+//	{
+//	  "id": 3,
+//	  "type": "Join",
+//	  "object": "tpch.nation",
+//	  "estimate": {...},
+//	  "output": ["..."],
+//	  "details": ["Join Type: INNER", "Join Cond: (...)"],
+//	  "source_steps": [1],
+//	  "analyze": {...},
+//	  "children": [{node}, ...]
+//	}
+//
+// type is the name of the operator in the text format. object is the table
+// or the table function of the operator if any. output is the project list,
+// only given by EXPLAIN VERBOSE. details are the lines of the text format
+// describing the operator, such as the filters and the join conditions.
+// analyze is only given by EXPLAIN ANALYZE.
+type ExplainJsonNode struct {
+	Id          int32               `json:"id"`
+	Type        string              `json:"type"`
+	Object      string              `json:"object,omitempty"`
+	Estimate    ExplainJsonEstimate `json:"estimate"`
+	Output      []string            `json:"output,omitempty"`
+	Details     []string            `json:"details,omitempty"`
+	SourceSteps []int32             `json:"source_steps,omitempty"`
+	Analyze     *ExplainJsonAnalyze `json:"analyze,omitempty"`
+	Children    []*ExplainJsonNode  `json:"children,omitempty"`
+}
+
+// ExplainJsonEstimate is the statistics estimated by the optimizer.
+type ExplainJsonEstimate struct {
+	Rows        float64 `json:"rows"`
+	Cost        float64 `json:"cost"`
+	Selectivity float64 `json:"selectivity"`
+	TableRows   float64 `json:"table_rows"`
+	Blocks      int32   `json:"blocks"`
+	RowSize     float64 `json:"row_size"`
+	HashmapSize float64 `json:"hashmap_size"`
+	ForceOneCN  bool    `json:"force_one_cn"`
+}
+
+// ExplainJsonAnalyze is the runtime statistics of the operator. The times
+// are in nanoseconds and the sizes are in bytes. major_times and minor_times
+// are the times of the parallel instances of the operator, such as the probe
+// and the build of a join, or the scan and the filter of a table scan.
+type ExplainJsonAnalyze struct {
+	TimeConsumed    int64   `json:"time_consumed"`
+	WaitTime        int64   `json:"wait_time"`
+	MajorTimes      []int64 `json:"major_times,omitempty"`
+	MinorTimes      []int64 `json:"minor_times,omitempty"`
+	InputBlocks     int64   `json:"input_blocks"`
+	InputRows       int64   `json:"input_rows"`
+	OutputRows      int64   `json:"output_rows"`
+	InputSize       int64   `json:"input_size"`
+	OutputSize      int64   `json:"output_size"`
+	MemorySize      int64   `json:"memory_size"`
+	DiskIO          int64   `json:"disk_io"`
+	S3IOBytes       int64   `json:"s3_io_bytes"`
+	S3IOInputCount  int64   `json:"s3_io_input_count"`
+	S3IOOutputCount int64   `json:"s3_io_output_count"`
+	NetworkIO       int64   `json:"network_io"`
+	ScanTime        int64   `json:"scan_time"`
+	InsertTime      int64   `json:"insert_time"`
+}
+
+// BuildExplainJson returns the document of EXPLAIN FORMAT=JSON of the query.
+func BuildExplainJson(ctx context.Context, query *plan.Query, options *ExplainOptions) (*ExplainJson, error) {
+	// the details are described like the text format
+	textOptions := *options
+	textOptions.Format = EXPLAIN_FORMAT_TEXT
+
+	doc := &ExplainJson{
+		Version: ExplainJsonVersion,
+		Steps:   make([]ExplainJsonStep, 0, len(query.Steps)),
+	}
+	for i, rootID := range query.Steps {
+		root, err := buildExplainJsonNode(ctx, rootID, query.Nodes, &textOptions)
+		if err != nil {
+			return nil, err
+		}
+		doc.Steps = append(doc.Steps, ExplainJsonStep{Step: i, Plan: root})
+	}
+	return doc, nil
+}
+
+func buildExplainJsonNode(ctx context.Context, nodeID int32, nodes []*plan.Node, options *ExplainOptions) (*ExplainJsonNode, error) {
+	index, err := serachNodeIndex(ctx, nodeID, nodes)
+	if err != nil {
+		return nil, err
+	}
+	node := nodes[index]
+	ndesc := NewNodeDescriptionImpl(node)
+
+	jnode := &ExplainJsonNode{
+		Id:     node.NodeId,
+		Type:   getNodeTypeName(node.NodeType),
+		Object: getNodeObjectName(node),
+	}
+	if stats := node.Stats; stats != nil {
+		jnode.Estimate = ExplainJsonEstimate{
+			Rows:        stats.Outcnt,
+			Cost:        stats.Cost,
+			Selectivity: stats.Selectivity,
+			TableRows:   stats.TableCnt,
+			Blocks:      stats.BlockNum,
+			RowSize:     stats.Rowsize,
+			ForceOneCN:  stats.ForceOneCN,
+		}
+		if stats.HashmapStats != nil {
+			jnode.Estimate.HashmapSize = stats.HashmapStats.HashmapSize
+		}
+	}
+	if options.Verbose {
+		for _, expr := range node.ProjectList {
+			buf := bytes.NewBuffer(make([]byte, 0, 64))
+			if err = describeExpr(ctx, expr, options, buf); err != nil {
+				return nil, err
+			}
+			jnode.Output = append(jnode.Output, buf.String())
+		}
+	}
+	if jnode.Details, err = ndesc.GetExtraInfo(ctx, options); err != nil {
+		return nil, err
+	}
+	switch node.NodeType {
+	case plan.Node_SINK_SCAN, plan.Node_RECURSIVE_SCAN, plan.Node_RECURSIVE_CTE:
+		jnode.SourceSteps = slices.Clone(node.SourceStep)
+	}
+	if options.Analyze {
+		jnode.Analyze = buildExplainJsonAnalyze(node.AnalyzeInfo)
+	}
+
+	for _, childID := range node.Children {
+		child, err := buildExplainJsonNode(ctx, childID, nodes, options)
+		if err != nil {
+			return nil, err
+		}
+		jnode.Children = append(jnode.Children, child)
+	}
+	return jnode, nil
+}
+
+func buildExplainJsonAnalyze(info *plan.AnalyzeInfo) *ExplainJsonAnalyze {
+	if info == nil {
+		return &ExplainJsonAnalyze{}
+	}
+	return &ExplainJsonAnalyze{
+		TimeConsumed:    info.TimeConsumed,
+		WaitTime:        info.WaitTimeConsumed,
+		MajorTimes:      slices.Clone(info.TimeConsumedArrayMajor),
+		MinorTimes:      slices.Clone(info.TimeConsumedArrayMinor),
+		InputBlocks:     info.InputBlocks,
+		InputRows:       info.InputRows,
+		OutputRows:      info.OutputRows,
+		InputSize:       info.InputSize,
+		OutputSize:      info.OutputSize,
+		MemorySize:      info.MemorySize,
+		DiskIO:          info.DiskIO,
+		S3IOBytes:       info.S3IOByte,
+		S3IOInputCount:  info.S3IOInputCount,
+		S3IOOutputCount: info.S3IOOutputCount,
+		NetworkIO:       info.NetworkIO,
+		ScanTime:        info.ScanTime,
+		InsertTime:      info.InsertTime,
+	}
+}
+
+// getNodeObjectName returns the table or the table function the node works
+// on, or "" if none.
+func getNodeObjectName(node *plan.Node) string {
+	var ref *plan.ObjectRef
+	switch node.NodeType {
+	case plan.Node_FUNCTION_SCAN:
+		if node.TableDef != nil && node.TableDef.TblFunc != nil {
+			return node.TableDef.TblFunc.Name
+		}
+		return ""
+	case plan.Node_DELETE:
+		if node.DeleteCtx != nil {
+			ref = node.DeleteCtx.Ref
+		}
+	case plan.Node_PRE_INSERT:
+		if node.PreInsertCtx != nil {
+			ref = node.PreInsertCtx.Ref
+		}
+	case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_SOURCE_SCAN,
+		plan.Node_INSERT, plan.Node_PRE_DELETE:
+		ref = node.ObjRef
+	default:
+		return ""
+	}
+	if ref != nil {
+		return ref.GetSchemaName() + "." + ref.GetObjName()
+	}
+	if node.TableDef != nil {
+		return node.TableDef.GetName()
+	}
+	return ""
+}
+
+// explainPlanJson pushes the document of the query into the buffer as one
+// line, which is one row of the result.
+func (e *ExplainQueryImpl) explainPlanJson(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	doc, err := BuildExplainJson(ctx, e.QueryPlan, options)
+	if err != nil {
+		return err
+	}
+	// the conditions are written as they are, e.g. "a > 0" but not "a \u003e 0"
+	data := bytes.NewBuffer(make([]byte, 0, 1024))
+	encoder := json.NewEncoder(data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(doc); err != nil {
+		return err
+	}
+	buffer.PushDocument(strings.TrimSuffix(data.String(), "\n"))
+	return nil
+}
@@ -48,101 +48,7 @@ const ExternalScan = "External Scan"
 
 func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 400))
-	var pname string /* node type name for text output */
-
-	// Get the Node Name
-	switch ndesc.Node.NodeType {
-	case plan.Node_UNKNOWN:
-		pname = "UnKnow Node"
-	case plan.Node_VALUE_SCAN:
-		pname = "Values Scan"
-	case plan.Node_TABLE_SCAN:
-		pname = TableScan
-	case plan.Node_EXTERNAL_SCAN:
-		pname = ExternalScan
-	case plan.Node_SOURCE_SCAN:
-		pname = "Source Scan"
-	case plan.Node_MATERIAL_SCAN:
-		pname = "Material Scan"
-	case plan.Node_PROJECT:
-		pname = "Project"
-	case plan.Node_EXTERNAL_FUNCTION:
-		pname = "External Function"
-	case plan.Node_MATERIAL:
-		pname = "Material"
-	case plan.Node_SINK:
-		pname = "Sink"
-	case plan.Node_SINK_SCAN:
-		pname = "Sink Scan"
-	case plan.Node_RECURSIVE_SCAN:
-		pname = "Recursive Scan"
-	case plan.Node_RECURSIVE_CTE:
-		pname = "CTE Scan"
-	case plan.Node_AGG:
-		pname = "Aggregate"
-	case plan.Node_DISTINCT:
-		pname = "Distinct"
-	case plan.Node_FILTER:
-		pname = "Filter"
-	case plan.Node_JOIN:
-		pname = "Join"
-	case plan.Node_SAMPLE:
-		pname = "Sample"
-	case plan.Node_SORT:
-		pname = "Sort"
-	case plan.Node_PARTITION:
-		pname = "Partition"
-	case plan.Node_UNION:
-		pname = "Union"
-	case plan.Node_UNION_ALL:
-		pname = "Union All"
-	case plan.Node_UNIQUE:
-		pname = "Unique"
-	case plan.Node_WINDOW:
-		pname = "Window"
-	case plan.Node_TIME_WINDOW:
-		pname = "Time window"
-	case plan.Node_FILL:
-		pname = "Fill"
-	case plan.Node_BROADCAST:
-		pname = "Broadcast"
-	case plan.Node_SPLIT:
-		pname = "Split"
-	case plan.Node_GATHER:
-		pname = "Gather"
-	case plan.Node_ASSERT:
-		pname = "Assert"
-	case plan.Node_INSERT:
-		pname = "Insert"
-	case plan.Node_DELETE:
-		pname = "Delete"
-	case plan.Node_INTERSECT:
-		pname = "Intersect"
-	case plan.Node_INTERSECT_ALL:
-		pname = "Intersect All"
-	case plan.Node_MINUS:
-		pname = "Minus"
-	case plan.Node_MINUS_ALL:
-		pname = "Minus All"
-	case plan.Node_FUNCTION_SCAN:
-		pname = "Table Function"
-	case plan.Node_PRE_INSERT:
-		pname = "PreInsert"
-	case plan.Node_PRE_INSERT_UK:
-		pname = "PreInsert UniqueKey"
-	case plan.Node_PRE_INSERT_SK:
-		pname = "PreInsert SecondaryKey"
-	case plan.Node_PRE_DELETE:
-		pname = "PreDelete"
-	case plan.Node_ON_DUPLICATE_KEY:
-		pname = "On Duplicate Key"
-	case plan.Node_FUZZY_FILTER:
-		pname = "Fuzzy Filter for duplicate key"
-	case plan.Node_LOCK_OP:
-		pname = "Lock"
-	default:
-		panic("error node type")
-	}
+	pname := getNodeTypeName(ndesc.Node.NodeType) /* node type name for text output */
 
 	// Get Node's operator object info ,such as table, view
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -210,6 +116,103 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 	return buf.String(), nil
 }
 
+// getNodeTypeName returns the name of the node type, which is the same in all
+// the formats.
+func getNodeTypeName(nodeType plan.Node_NodeType) string {
+	switch nodeType {
+	case plan.Node_UNKNOWN:
+		return "UnKnow Node"
+	case plan.Node_VALUE_SCAN:
+		return "Values Scan"
+	case plan.Node_TABLE_SCAN:
+		return TableScan
+	case plan.Node_EXTERNAL_SCAN:
+		return ExternalScan
+	case plan.Node_SOURCE_SCAN:
+		return "Source Scan"
+	case plan.Node_MATERIAL_SCAN:
+		return "Material Scan"
+	case plan.Node_PROJECT:
+		return "Project"
+	case plan.Node_EXTERNAL_FUNCTION:
+		return "External Function"
+	case plan.Node_MATERIAL:
+		return "Material"
+	case plan.Node_SINK:
+		return "Sink"
+	case plan.Node_SINK_SCAN:
+		return "Sink Scan"
+	case plan.Node_RECURSIVE_SCAN:
+		return "Recursive Scan"
+	case plan.Node_RECURSIVE_CTE:
+		return "CTE Scan"
+	case plan.Node_AGG:
+		return "Aggregate"
+	case plan.Node_DISTINCT:
+		return "Distinct"
+	case plan.Node_FILTER:
+		return "Filter"
+	case plan.Node_JOIN:
+		return "Join"
+	case plan.Node_SAMPLE:
+		return "Sample"
+	case plan.Node_SORT:
+		return "Sort"
+	case plan.Node_PARTITION:
+		return "Partition"
+	case plan.Node_UNION:
+		return "Union"
+	case plan.Node_UNION_ALL:
+		return "Union All"
+	case plan.Node_UNIQUE:
+		return "Unique"
+	case plan.Node_WINDOW:
+		return "Window"
+	case plan.Node_TIME_WINDOW:
+		return "Time window"
+	case plan.Node_FILL:
+		return "Fill"
+	case plan.Node_BROADCAST:
+		return "Broadcast"
+	case plan.Node_SPLIT:
+		return "Split"
+	case plan.Node_GATHER:
+		return "Gather"
+	case plan.Node_ASSERT:
+		return "Assert"
+	case plan.Node_INSERT:
+		return "Insert"
+	case plan.Node_DELETE:
+		return "Delete"
+	case plan.Node_INTERSECT:
+		return "Intersect"
+	case plan.Node_INTERSECT_ALL:
+		return "Intersect All"
+	case plan.Node_MINUS:
+		return "Minus"
+	case plan.Node_MINUS_ALL:
+		return "Minus All"
+	case plan.Node_FUNCTION_SCAN:
+		return "Table Function"
+	case plan.Node_PRE_INSERT:
+		return "PreInsert"
+	case plan.Node_PRE_INSERT_UK:
+		return "PreInsert UniqueKey"
+	case plan.Node_PRE_INSERT_SK:
+		return "PreInsert SecondaryKey"
+	case plan.Node_PRE_DELETE:
+		return "PreDelete"
+	case plan.Node_ON_DUPLICATE_KEY:
+		return "On Duplicate Key"
+	case plan.Node_FUZZY_FILTER:
+		return "Fuzzy Filter for duplicate key"
+	case plan.Node_LOCK_OP:
+		return "Lock"
+	default:
+		panic("error node type")
+	}
+}
+
 func (ndesc *NodeDescribeImpl) GetActualAnalyzeInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 400))
 	buf.WriteString("Analyze: ")
@@ -16,9 +16,13 @@ package explain
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -311,6 +315,56 @@ func TestMultiTableDeleteSQL(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestExplainFormatJsonAndDot(t *testing.T) {
+	sqls := []string{
+		"explain format = json SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_REGIONKEY > 0 ORDER BY N_NAME DESC LIMIT 10",
+		"explain (verbose true, format json) SELECT NATION.N_NAME, REGION.R_NAME FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY",
+		"explain format = dot SELECT NATION.N_NAME, REGION.R_NAME FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY",
+		"explain format = json with t as (select n_regionkey from nation) select * from t t1, t t2 where t1.n_regionkey = t2.n_regionkey",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+
+	ctx := mockOptimizer.CurrentContext()
+	stmt, err := mysql.ParseOne(ctx.GetContext(),
+		"SELECT NATION.N_NAME, REGION.R_NAME FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY", 1)
+	require.NoError(t, err)
+	logicPlan, err := plan.BuildPlan(ctx, stmt, false)
+	require.NoError(t, err)
+
+	// the join is not analyzed, its runtime statistics are all zero
+	options := &ExplainOptions{Verbose: true, Analyze: true, Format: EXPLAIN_FORMAT_JSON}
+	buffer := NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(logicPlan.GetQuery()).ExplainPlan(ctx.GetContext(), buffer, options))
+	require.Len(t, buffer.Lines, 1)
+	doc := &ExplainJson{}
+	require.NoError(t, json.Unmarshal([]byte(buffer.Lines[0]), doc))
+	require.Equal(t, ExplainJsonVersion, doc.Version)
+	require.Len(t, doc.Steps, 1)
+	var join *ExplainJsonNode
+	var visit func(node *ExplainJsonNode)
+	visit = func(node *ExplainJsonNode) {
+		require.NotNil(t, node.Analyze)
+		if node.Type == "Join" {
+			join = node
+		}
+		for _, child := range node.Children {
+			visit(child)
+		}
+	}
+	visit(doc.Steps[0].Plan)
+	require.NotNil(t, join)
+	require.Len(t, join.Children, 2)
+	require.Equal(t, "Table Scan", join.Children[0].Type)
+	require.Contains(t, []string{join.Children[0].Object, join.Children[1].Object}, "tpch.nation")
+	require.NotEmpty(t, join.Output)
+	require.NotEmpty(t, join.Details)
+
+	dot := BuildExplainDot(doc)
+	require.True(t, strings.HasPrefix(dot, "digraph plan {\n"))
+	require.Contains(t, dot, fmt.Sprintf("n%d -> n%d;", join.Children[0].Id, join.Id))
+}
+
 func runTestShouldPass(opt plan.Optimizer, t *testing.T, sqls []string) {
 	for _, sql := range sqls {
 		err := runOneStmt(opt, t, sql)
@@ -354,6 +408,8 @@ func runOneStmt(opt plan.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return moerr.NewInvalidInput(ctx, "explain format %v", v.Value)
 				}
@@ -120,6 +120,16 @@ func (buf *ExplainDataBuffer) PushPlanTitle(title string) {
 	buf.End++
 }
 
+// PushDocument pushes the document of the plan, such as the json, as one line.
+func (buf *ExplainDataBuffer) PushDocument(doc string) {
+	if buf.Start == -1 {
+		buf.Start++
+	}
+	buf.CurrentLine++
+	buf.Lines = append(buf.Lines, doc)
+	buf.End++
+}
+
 func (buf *ExplainDataBuffer) ToString() string {
 	strBuffer := bytes.NewBufferString("")
 	rs := buf.Lines
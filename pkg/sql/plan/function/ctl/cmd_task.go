@@ -36,6 +36,7 @@ var (
 
 	taskMap = map[string]int32{
 		"storageusage": int32(taskpb.TaskCode_MetricStorageUsage),
+		"autoanalyze":  int32(taskpb.TaskCode_StatsAutoAnalyze),
 	}
 )
 
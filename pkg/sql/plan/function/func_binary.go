@@ -2947,3 +2947,15 @@ func CosineDistanceArray[T types.RealNumbers](ivecs []*vector.Vector, result vec
 		return moarray.CosineDistance[T](_v1, _v2)
 	}, selectList)
 }
+
+func HammingDistanceArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](ivecs, result, proc, length, func(v1, v2 []byte) (out float64, err error) {
+		return moarray.HammingDistance(v1, v2)
+	}, selectList)
+}
+
+func JaccardDistanceArray(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](ivecs, result, proc, length, func(v1, v2 []byte) (out float64, err error) {
+		return moarray.JaccardDistance(v1, v2)
+	}, selectList)
+}
@@ -2685,6 +2685,37 @@ func TestCosineDistanceArray(t *testing.T) {
 	}
 }
 
+func TestBitArrayDistance(t *testing.T) {
+	bitArray := func(str string) []byte {
+		b, err := types.StringToBitArray(str)
+		require.NoError(t, err)
+		return b
+	}
+	v1 := [][]byte{bitArray("[1,1,0,0,0,0,0,0]"), bitArray("[0,0,0,0,0,0,0,0]")}
+	v2 := [][]byte{bitArray("[1,0,1,0,0,0,0,0]"), bitArray("[0,0,0,0,0,0,0,0]")}
+
+	proc := testutil.NewProcess()
+	fcTC := NewFunctionTestCase(proc,
+		[]FunctionTestInput{
+			NewFunctionTestInput(types.T_array_bit.ToType(), v1, []bool{false, false}),
+			NewFunctionTestInput(types.T_array_bit.ToType(), v2, []bool{false, false}),
+		},
+		NewFunctionTestResult(types.T_float64.ToType(), false, []float64{2, 0}, []bool{false, false}),
+		HammingDistanceArray)
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+
+	fcTC = NewFunctionTestCase(proc,
+		[]FunctionTestInput{
+			NewFunctionTestInput(types.T_array_bit.ToType(), v1, []bool{false, false}),
+			NewFunctionTestInput(types.T_array_bit.ToType(), v2, []bool{false, false}),
+		},
+		NewFunctionTestResult(types.T_float64.ToType(), false, []float64{1 - 1.0/3, 0}, []bool{false, false}),
+		JaccardDistanceArray)
+	s, info = fcTC.Run()
+	require.True(t, s, info)
+}
+
 // Extract
 func initExtractTestCase() []tcTemp {
 	MakeDates := func(values ...string) []types.Date {
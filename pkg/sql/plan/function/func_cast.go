@@ -50,6 +50,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_date, types.T_datetime,
 		types.T_time, types.T_timestamp,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_int8, types.T_array_bit,
 	},
 
 	types.T_bool: {
@@ -278,6 +279,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_int8, types.T_array_bit,
 	},
 
 	types.T_binary: {
@@ -322,6 +324,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_int8, types.T_array_bit,
 	},
 
 	types.T_text: {
@@ -338,6 +341,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_int8, types.T_array_bit,
 	},
 
 	types.T_json: {
@@ -365,9 +369,19 @@ var supportedTypeCast = map[types.T][]types.T{
 
 	types.T_array_float32: {
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_int8, types.T_array_bit,
 	},
 	types.T_array_float64: {
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_int8, types.T_array_bit,
+	},
+	types.T_array_int8: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_int8,
+	},
+	types.T_array_bit: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_bit,
 	},
 }
 
@@ -449,7 +463,7 @@ func NewCast(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
 		s := vector.GenerateFunctionStrParameter(from)
 		err = strTypeToOthers(proc, s, *toType, result, length, selectList)
-	case types.T_array_float32, types.T_array_float64:
+	case types.T_array_float32, types.T_array_float64, types.T_array_int8, types.T_array_bit:
 		//NOTE: Don't mix T_array and T_varchar.
 		// T_varchar will have "[1,2,3]" string
 		// T_array will have "@@@#@!#@!@#!" binary.
@@ -505,7 +519,7 @@ func scalarNullToOthers(ctx context.Context,
 		return appendNulls[uint64](result, length, selectList)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_varbinary, types.T_text, types.T_json,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_int8, types.T_array_bit:
 		return appendNulls[types.Varlena](result, length, selectList)
 	case types.T_float32:
 		return appendNulls[float32](result, length, selectList)
@@ -1656,6 +1670,9 @@ func strTypeToOthers(proc *process.Process,
 	case types.T_array_float64:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return strToArray[float64](ctx, source, rs, length, toType)
+	case types.T_array_int8, types.T_array_bit:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToQuantizedArray(ctx, source, rs, length, toType)
 	}
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from %s to %s", source.GetType(), toType))
 }
@@ -1674,6 +1691,8 @@ func arrayTypeToOthers(proc *process.Process,
 			return arrayToArray[float32, float32](proc.Ctx, source, rs, length, toType)
 		case types.T_array_float64:
 			return arrayToArray[float32, float64](proc.Ctx, source, rs, length, toType)
+		case types.T_array_int8, types.T_array_bit:
+			return arrayToQuantizedArray[float32](proc.Ctx, source, rs, length, toType)
 		}
 	case types.T_array_float64:
 		switch toType.Oid {
@@ -1681,6 +1700,26 @@ func arrayTypeToOthers(proc *process.Process,
 			return arrayToArray[float64, float32](proc.Ctx, source, rs, length, toType)
 		case types.T_array_float64:
 			return arrayToArray[float64, float64](proc.Ctx, source, rs, length, toType)
+		case types.T_array_int8, types.T_array_bit:
+			return arrayToQuantizedArray[float64](proc.Ctx, source, rs, length, toType)
+		}
+	case types.T_array_int8:
+		switch toType.Oid {
+		case types.T_array_float32:
+			return int8ArrayToArray[float32](proc.Ctx, source, rs, length, toType)
+		case types.T_array_float64:
+			return int8ArrayToArray[float64](proc.Ctx, source, rs, length, toType)
+		case types.T_array_int8:
+			return rs.DupFromParameter(source, length)
+		}
+	case types.T_array_bit:
+		switch toType.Oid {
+		case types.T_array_float32:
+			return bitArrayToArray[float32](proc.Ctx, source, rs, length, toType)
+		case types.T_array_float64:
+			return bitArrayToArray[float64](proc.Ctx, source, rs, length, toType)
+		case types.T_array_bit:
+			return rs.DupFromParameter(source, length)
 		}
 	}
 
@@ -4398,6 +4437,136 @@ func blobToArray[T types.RealNumbers](
 	return nil
 }
 
+// strToQuantizedArray parses "[1,0,1,...]" into veci8 or vecbit.
+func strToQuantizedArray(
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null || len(v) == 0 {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		b, err := types.StringToQuantizedArrayToBytes(toType.Oid, convertByteSliceToString(v))
+		if err != nil {
+			return err
+		}
+		if err = checkQuantizedArrayDimension(toType, b); err != nil {
+			return err
+		}
+		if err = to.AppendBytes(b, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// arrayToQuantizedArray quantizes VECF32/VECF64 to veci8 or vecbit.
+func arrayToQuantizedArray[I types.RealNumbers](
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		_v := types.BytesToArray[I](v)
+		var bytes []byte
+		if toType.Oid == types.T_array_int8 {
+			bytes = types.Int8ArrayToBytes(types.QuantizeToInt8[I](_v))
+		} else {
+			var err error
+			if bytes, err = types.QuantizeToBit[I](_v); err != nil {
+				return err
+			}
+		}
+		if err := checkQuantizedArrayDimension(toType, bytes); err != nil {
+			return err
+		}
+		if err := to.AppendBytes(bytes, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkQuantizedArrayDimension checks the dimension of veci8 or vecbit matches the
+// dimension of the type, the type without dimension takes any.
+func checkQuantizedArrayDimension(toType types.Type, input []byte) error {
+	if toType.Width == types.MaxArrayDimension {
+		return nil
+	}
+	if dim := types.QuantizedArrayDimension(toType.Oid, input); dim != int(toType.Width) {
+		return moerr.NewArrayDefMismatchNoCtx(int(toType.Width), dim)
+	}
+	return nil
+}
+
+// int8ArrayToArray widens veci8 to VECF32/VECF64.
+func int8ArrayToArray[O types.RealNumbers](
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, _ types.Type) error {
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		cast := types.Int8ArrayToFloatArray[O](types.BytesToInt8Array(v))
+		if err := to.AppendBytes(types.ArrayToBytes[O](cast), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bitArrayToArray maps every dimension of vecbit to 0 or 1.
+func bitArrayToArray[O types.RealNumbers](
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, _ types.Type) error {
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		cast := types.BitArrayToFloatArray[O](v)
+		if err := to.AppendBytes(types.ArrayToBytes[O](cast), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func arrayToArray[I types.RealNumbers, O types.RealNumbers](
 	_ context.Context,
 	from vector.FunctionParameterWrapper[types.Varlena],
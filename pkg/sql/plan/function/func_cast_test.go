@@ -191,6 +191,54 @@ func initCastTestCase() []tcTemp {
 			},
 			expect: NewFunctionTestResult(types.T_array_float64.ToType(), false, [][]float64{{1, 2, 3}}, []bool{false}),
 		},
+		{
+			info: "vecf32 to veci8",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_array_float32.ToType(), [][]float32{{1.4, -200, 130}}, []bool{false}),
+				NewFunctionTestInput(types.T_array_int8.ToType(), [][]byte{}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.T_array_int8.ToType(), false, [][]byte{types.Int8ArrayToBytes([]int8{1, -128, 127})}, []bool{false}),
+		},
+		{
+			info: "vecf32 to vecbit",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_array_float32.ToType(), [][]float32{{1, -1, 0, 2, 0, 0, 0, 3}}, []bool{false}),
+				NewFunctionTestInput(types.T_array_bit.ToType(), [][]byte{}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.T_array_bit.ToType(), false, [][]byte{{0x91}}, []bool{false}),
+		},
+		{
+			info: "vecf32 to veci8 of the same dimension",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.New(types.T_array_float32, 3, 0), [][]float32{{1, 2, 3}}, []bool{false}),
+				NewFunctionTestInput(types.New(types.T_array_int8, 3, 0), [][]byte{}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.New(types.T_array_int8, 3, 0), false, [][]byte{types.Int8ArrayToBytes([]int8{1, 2, 3})}, []bool{false}),
+		},
+		{
+			info: "vecf32 to vecbit of another dimension",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.New(types.T_array_float32, 8, 0), [][]float32{{1, -1, 0, 2, 0, 0, 0, 3}}, []bool{false}),
+				NewFunctionTestInput(types.New(types.T_array_bit, 16, 0), [][]byte{}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.New(types.T_array_bit, 16, 0), true, [][]byte{}, []bool{}),
+		},
+		{
+			info: "veci8 to vecf32",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_array_int8.ToType(), [][]byte{types.Int8ArrayToBytes([]int8{1, -2, 3})}, []bool{false}),
+				NewFunctionTestInput(types.T_array_float32.ToType(), [][]float32{{}}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.T_array_float32.ToType(), false, [][]float32{{1, -2, 3}}, []bool{false}),
+		},
+		{
+			info: "vecbit to vecf32",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_array_bit.ToType(), [][]byte{{0x91}}, []bool{false}),
+				NewFunctionTestInput(types.T_array_float32.ToType(), [][]float32{{}}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.T_array_float32.ToType(), false, [][]float32{{1, 0, 0, 1, 0, 0, 0, 1}}, []bool{false}),
+		},
 		{
 			info: "bit to bit",
 			inputs: []FunctionTestInput{
@@ -1491,6 +1539,31 @@ func initCastTestCase() []tcTemp {
 			},
 			expect: NewFunctionTestResult(types.T_array_float64.ToType(), false, [][]float64{{1, 2, 3}, {4, 5, 6}}, []bool{false, false}),
 		},
+		{
+			info: "str type to veci8",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"[1,2,3]", "[4,5,6]"}, nil),
+				NewFunctionTestInput(types.New(types.T_array_int8, 3, 0), [][]byte{}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.New(types.T_array_int8, 3, 0), false,
+				[][]byte{types.Int8ArrayToBytes([]int8{1, 2, 3}), types.Int8ArrayToBytes([]int8{4, 5, 6})}, []bool{false, false}),
+		},
+		{
+			info: "str type to veci8 of another dimension",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"[1,2,3]", "[4,5]"}, nil),
+				NewFunctionTestInput(types.New(types.T_array_int8, 3, 0), [][]byte{}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.New(types.T_array_int8, 3, 0), true, [][]byte{}, []bool{}),
+		},
+		{
+			info: "str type to vecbit of another dimension",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varchar.ToType(), []string{"[1,0,1,0,1,0,1,0]"}, nil),
+				NewFunctionTestInput(types.New(types.T_array_bit, 16, 0), [][]byte{}, []bool{}),
+			},
+			expect: NewFunctionTestResult(types.New(types.T_array_bit, 16, 0), true, [][]byte{}, []bool{}),
+		},
 		{
 			info: "str type to bit",
 			inputs: []FunctionTestInput{
@@ -574,6 +574,16 @@ var (
 		"mo_pubs":                     0,
 		"mo_stages":                   0,
 		"mo_snapshots":                0,
+		"mo_mviews":                   0,
+		"mo_triggers":                 0,
+		"mo_events":                   0,
+		"mo_event_history":            0,
+		"mo_online_ddl":               0,
+		"mo_column_histograms":        0,
+		"mo_table_analyze":            0,
+		"mo_plan_baselines":           0,
+		"mo_resource_groups":          0,
+		"mo_resource_group_bindings":  0,
 	}
 )
 
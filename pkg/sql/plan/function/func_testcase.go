@@ -578,7 +578,8 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 			}
 		}
 	case types.T_char, types.T_varchar,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text,
+		types.T_array_int8, types.T_array_bit:
 		r := vector.GenerateFunctionStrParameter(v)
 		s := vector.GenerateFunctionStrParameter(vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -841,6 +842,9 @@ func newVectorByType(mp *mpool.MPool, typ types.Type, val any, nsp *nulls.Nulls)
 	case types.T_array_float64:
 		values := val.([][]float64)
 		vector.AppendArrayList[float64](vec, values, nil, mp)
+	case types.T_array_int8, types.T_array_bit:
+		values := val.([][]byte)
+		vector.AppendBytesList(vec, values, nil, mp)
 	case types.T_uuid:
 		values := val.([]types.Uuid)
 		vector.AppendFixedList(vec, values, nil, mp)
@@ -373,6 +373,10 @@ const (
 	BITMAP_CONSTRUCT_AGG
 	BITMAP_OR_AGG
 
+	// bit vector function
+	HAMMING_DISTANCE
+	JACCARD_DISTANCE
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -677,4 +681,6 @@ var functionIdRegister = map[string]int32{
 	"bitmap_count":         BITMAP_COUNT,
 	"bitmap_construct_agg": BITMAP_CONSTRUCT_AGG,
 	"bitmap_or_agg":        BITMAP_OR_AGG,
+	"hamming_distance":     HAMMING_DISTANCE,
+	"jaccard_distance":     JACCARD_DISTANCE,
 }
@@ -2034,6 +2034,46 @@ var supportedArrayOperations = []FuncNew{
 			},
 		},
 	},
+	// function `hamming_distance`
+	{
+		functionId: HAMMING_DISTANCE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return HammingDistanceArray
+				},
+			},
+		},
+	},
+	// function `jaccard_distance`
+	{
+		functionId: JACCARD_DISTANCE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JaccardDistanceArray
+				},
+			},
+		},
+	},
 	// function `normalize_l2`
 	{
 		functionId: NORMALIZE_L2,
@@ -1949,6 +1949,8 @@ func initFixed3() {
 				//C
 				{toType: types.T_array_float32, preferLevel: 2},
 				{toType: types.T_array_float64, preferLevel: 2},
+				{toType: types.T_array_int8, preferLevel: 2},
+				{toType: types.T_array_bit, preferLevel: 2},
 			},
 		},
 
@@ -2095,6 +2097,13 @@ func initFixed3() {
 				{toType: types.T_array_float32, preferLevel: 1},
 			},
 		},
+		{
+			from: types.T_array_int8,
+			toList: []toRule{
+				{toType: types.T_array_float32, preferLevel: 2},
+				{toType: types.T_array_float64, preferLevel: 2},
+			},
+		},
 		{
 			from: types.T_bit,
 			toList: []toRule{
@@ -0,0 +1,225 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+)
+
+const (
+	// HistogramSampleRows is the number of the rows ANALYZE TABLE samples.
+	HistogramSampleRows = 30000
+	// HistogramBuckets is the number of the buckets of a histogram.
+	HistogramBuckets = 64
+	// HistogramMCVs is the max number of the most common values of a histogram.
+	HistogramMCVs = 16
+)
+
+// IsHistogramSupported returns whether ANALYZE TABLE builds histograms for the
+// type, they are the types whose literals can be compared by getFloat64Value.
+func IsHistogramSupported(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime:
+		return true
+	}
+	return false
+}
+
+// BuildHistogram builds the equi-depth histogram and the most common values
+// from the sampled non-null values, vals is sorted in place.
+func BuildHistogram(vals []float64, buckets int, mcvs int) *pb.Histogram {
+	if len(vals) == 0 || buckets <= 0 {
+		return nil
+	}
+	sort.Float64s(vals)
+	n := len(vals)
+
+	// upperBound returns the number of the values not greater than vals[i]
+	upperBound := func(i int) int {
+		return sort.Search(n, func(j int) bool { return vals[j] > vals[i] })
+	}
+
+	type run struct {
+		val float64
+		cnt int
+	}
+	var runs []run
+	for i := 0; i < n; {
+		j := upperBound(i)
+		runs = append(runs, run{val: vals[i], cnt: j - i})
+		i = j
+	}
+
+	h := &pb.Histogram{
+		Distinct:   float64(len(runs)),
+		SampleRows: int64(n),
+	}
+
+	// like postgres, only the values more common than the average are MCVs
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].cnt > runs[j].cnt })
+	avg := float64(n) / float64(len(runs))
+	for _, r := range runs {
+		if len(h.MCVs) >= mcvs || r.cnt < 2 || float64(r.cnt) <= avg {
+			break
+		}
+		h.MCVs = append(h.MCVs, r.val)
+		h.MCVFreqs = append(h.MCVFreqs, float64(r.cnt)/float64(n))
+	}
+
+	h.Bounds = append(h.Bounds, vals[0])
+	h.Fractions = append(h.Fractions, float64(upperBound(0))/float64(n))
+	for k := 1; k <= buckets; k++ {
+		i := k*n/buckets - 1
+		if i < 0 || vals[i] <= h.Bounds[len(h.Bounds)-1] {
+			continue
+		}
+		h.Bounds = append(h.Bounds, vals[i])
+		h.Fractions = append(h.Fractions, float64(upperBound(i))/float64(n))
+	}
+	return h
+}
+
+// histogramCDF returns the fraction of the non-null rows not greater than val.
+func histogramCDF(h *pb.Histogram, val float64) float64 {
+	n := len(h.Bounds)
+	if n == 0 || val < h.Bounds[0] {
+		return 0
+	}
+	i := sort.SearchFloat64s(h.Bounds, val)
+	if i < n && h.Bounds[i] == val {
+		return h.Fractions[i]
+	}
+	if i == n {
+		return 1
+	}
+	lo, hi := h.Bounds[i-1], h.Bounds[i]
+	loFrac, hiFrac := h.Fractions[i-1], h.Fractions[i]
+	return loFrac + (hiFrac-loFrac)*(val-lo)/(hi-lo)
+}
+
+// histogramEqualFraction returns the fraction of the non-null rows equal to
+// val, the values not in the MCVs share the rest rows evenly.
+func histogramEqualFraction(h *pb.Histogram, val float64, ndv float64) float64 {
+	rest := 1.0
+	for i, mcv := range h.MCVs {
+		if mcv == val {
+			return h.MCVFreqs[i]
+		}
+		rest -= h.MCVFreqs[i]
+	}
+	if ndv <= 0 {
+		ndv = h.Distinct
+	}
+	others := ndv - float64(len(h.MCVs))
+	if others < 1 {
+		others = 1
+	}
+	if rest < 0 {
+		rest = 0
+	}
+	return rest / others
+}
+
+// estimateSelectivityByHistogram estimates the selectivity of the filters like
+// col = 1, col < 1, col between 1 and 2 or col in (1, 2) by the histogram of
+// the column, it returns false if there is no histogram.
+func estimateSelectivityByHistogram(expr *plan.Expr, funcName string, builder *QueryBuilder) (float64, bool) {
+	var col *ColRef
+	var literals []*Const
+	if funcName == "in" {
+		fn := expr.GetF()
+		col = fn.Args[0].GetCol()
+		list := fn.Args[1].GetList()
+		if col == nil || list == nil {
+			return 0, false
+		}
+		for _, e := range list.List {
+			lit := e.GetLit()
+			if lit == nil {
+				return 0, false
+			}
+			literals = append(literals, lit)
+		}
+	} else {
+		var colFnName string
+		col, _, literals, colFnName = extractColRefAndLiteralsInFilter(expr)
+		if col == nil || colFnName != "" {
+			return 0, false
+		}
+	}
+	if len(literals) == 0 {
+		return 0, false
+	}
+	s := builder.getStatsInfoByCol(col)
+	if s == nil || s.HistogramMap == nil {
+		return 0, false
+	}
+	h := s.HistogramMap[col.Name]
+	if h == nil || len(h.Bounds) == 0 {
+		return 0, false
+	}
+	typ := types.T(s.DataTypeMap[col.Name])
+	vals := make([]float64, len(literals))
+	for i, lit := range literals {
+		if lit == nil {
+			return 0, false
+		}
+		val, ok := getFloat64Value(typ, lit)
+		if !ok {
+			return 0, false
+		}
+		vals[i] = val
+	}
+
+	ndv := s.NdvMap[col.Name]
+	var ret float64
+	switch funcName {
+	case "=":
+		ret = histogramEqualFraction(h, vals[0], ndv)
+	case "in":
+		for _, val := range vals {
+			ret += histogramEqualFraction(h, val, ndv)
+		}
+	case "<=":
+		ret = histogramCDF(h, vals[0])
+	case "<":
+		ret = histogramCDF(h, vals[0]) - histogramEqualFraction(h, vals[0], ndv)
+	case ">":
+		ret = 1 - histogramCDF(h, vals[0])
+	case ">=":
+		ret = 1 - histogramCDF(h, vals[0]) + histogramEqualFraction(h, vals[0], ndv)
+	case "between":
+		ret = histogramCDF(h, vals[1]) - histogramCDF(h, vals[0]) + histogramEqualFraction(h, vals[0], ndv)
+	default:
+		return 0, false
+	}
+
+	if s.TableCnt > 0 {
+		ret *= 1 - float64(s.NullCntMap[col.Name])/s.TableCnt
+	}
+	if ret < 0 {
+		ret = 0
+	}
+	if ret > 1 {
+		ret = 1
+	}
+	return ret, true
+}
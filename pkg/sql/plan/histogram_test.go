@@ -0,0 +1,52 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildHistogram(t *testing.T) {
+	require.Nil(t, BuildHistogram(nil, HistogramBuckets, HistogramMCVs))
+
+	// 1..100 once each, and 7 another 900 times
+	vals := make([]float64, 0, 1000)
+	for i := 1; i <= 100; i++ {
+		vals = append(vals, float64(i))
+	}
+	for i := 0; i < 900; i++ {
+		vals = append(vals, 7)
+	}
+	h := BuildHistogram(vals, 10, 4)
+	require.Equal(t, float64(100), h.Distinct)
+	require.Equal(t, int64(1000), h.SampleRows)
+	require.Equal(t, []float64{7}, h.MCVs)
+	require.InDelta(t, 0.901, h.MCVFreqs[0], 1e-9)
+	require.Equal(t, float64(1), h.Bounds[0])
+	require.Equal(t, float64(100), h.Bounds[len(h.Bounds)-1])
+	require.Equal(t, float64(1), h.Fractions[len(h.Fractions)-1])
+	for i := 1; i < len(h.Bounds); i++ {
+		require.Less(t, h.Bounds[i-1], h.Bounds[i])
+		require.LessOrEqual(t, h.Fractions[i-1], h.Fractions[i])
+	}
+
+	require.Equal(t, float64(0), histogramCDF(h, 0))
+	require.Equal(t, float64(1), histogramCDF(h, 200))
+	require.InDelta(t, 0.907, histogramCDF(h, 7), 1e-9)
+	require.InDelta(t, 0.901, histogramEqualFraction(h, 7, 0), 1e-9)
+	require.InDelta(t, 0.099/99, histogramEqualFraction(h, 50, 0), 1e-9)
+}
@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"slices"
 	"sort"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -202,7 +203,7 @@ func HasColExpr(expr *plan.Expr, pos int32) int32 {
 }
 
 func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
-	if builder.optimizerHints != nil && builder.optimizerHints.joinOrdering != 0 {
+	if builder.optimizerHints != nil && builder.optimizerHints.joinOrdering != 0 && !builder.hasJoinOrderHint() {
 		return nodeID
 	}
 	node := builder.qry.Nodes[nodeID]
@@ -223,6 +224,19 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 	leaves, conds := builder.gatherJoinLeavesAndConds(node, nil, nil)
 	newConds := deduceNewOnList(conds)
 	conds = append(conds, newConds...)
+
+	if builder.orderLeavesByHint(leaves) {
+		nodeID = leaves[0].NodeId
+		for i := 1; i < len(leaves); i++ {
+			nodeID = builder.appendNode(&plan.Node{
+				NodeType: plan.Node_JOIN,
+				Children: []int32{nodeID, leaves[i].NodeId},
+				JoinType: plan.Node_INNER,
+			}, nil)
+		}
+		return builder.pushdownJoinConds(nodeID, conds)
+	}
+
 	vertices := builder.getJoinGraph(leaves, conds)
 
 	subTrees := make([]*plan.Node, 0, len(leaves))
@@ -327,6 +341,10 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 		}
 	}
 
+	return builder.pushdownJoinConds(nodeID, conds)
+}
+
+func (builder *QueryBuilder) pushdownJoinConds(nodeID int32, conds []*plan.Expr) int32 {
 	nodeID, conds = builder.pushdownFilters(nodeID, conds, true)
 	if len(conds) > 0 {
 		nodeID = builder.appendNode(&plan.Node{
@@ -338,6 +356,63 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 	return nodeID
 }
 
+// hasJoinOrderHint returns whether the statement or any query block has the
+// JOIN_ORDER hint.
+func (builder *QueryBuilder) hasJoinOrderHint() bool {
+	if builder.optimizerHints != nil && len(builder.optimizerHints.joinOrder) > 0 {
+		return true
+	}
+	for _, hints := range builder.blockHints {
+		if len(hints.joinOrder) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// orderLeavesByHint sorts the join leaves by the JOIN_ORDER hint of their
+// query block or the statement, the leaves in the hint come first in the hint
+// order, and the others follow by stats. It returns false if the hint names
+// none of the leaves.
+func (builder *QueryBuilder) orderLeavesByHint(leaves []*plan.Node) bool {
+	var joinOrder []string
+	for _, leaf := range leaves {
+		if hints := builder.blockHintsOf(leaf); hints != nil && len(hints.joinOrder) > 0 {
+			joinOrder = hints.joinOrder
+			break
+		}
+	}
+	if len(joinOrder) == 0 && builder.optimizerHints != nil {
+		joinOrder = builder.optimizerHints.joinOrder
+	}
+	if len(joinOrder) == 0 {
+		return false
+	}
+	pos := make(map[int32]int, len(leaves))
+	found := false
+	for _, leaf := range leaves {
+		pos[leaf.NodeId] = len(joinOrder)
+		if i := slices.Index(joinOrder, builder.hintTableName(leaf)); i >= 0 {
+			pos[leaf.NodeId] = i
+			found = true
+		}
+	}
+	if !found {
+		return false
+	}
+	sort.SliceStable(leaves, func(i, j int) bool {
+		pi, pj := pos[leaves[i].NodeId], pos[leaves[j].NodeId]
+		if pi != pj {
+			return pi < pj
+		}
+		if pi < len(joinOrder) {
+			return false
+		}
+		return compareStats(leaves[i].Stats, leaves[j].Stats)
+	})
+	return true
+}
+
 func (builder *QueryBuilder) gatherJoinLeavesAndConds(joinNode *plan.Node, leaves []*plan.Node, conds []*plan.Expr) ([]*plan.Node, []*plan.Expr) {
 	if joinNode.NodeType != plan.Node_JOIN || joinNode.JoinType != plan.Node_INNER || joinNode.Limit != nil {
 		nodeID := builder.determineJoinOrder(joinNode.NodeId)
@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"slices"
 	"strconv"
 	"strings"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
 
@@ -775,7 +777,7 @@ func (builder *QueryBuilder) optimizeLikeExpr(nodeID int32) {
 }
 
 func (builder *QueryBuilder) forceJoinOnOneCN(nodeID int32, force bool) {
-	if builder.optimizerHints != nil && builder.optimizerHints.forceOneCN != 0 {
+	if builder.optimizerHints != nil && builder.optimizerHints.forceOneCN != 0 && !builder.optimizerHints.forceOneCNAll {
 		return
 	}
 
@@ -874,3 +876,142 @@ func (builder *QueryBuilder) parseOptimizeHints() {
 		handleOptimizerHints(kvs[i], builder)
 	}
 }
+
+// parseStatementHints applies the hints in the /*+ ... */ comment of the
+// statement, they override the process level optimizer_hints. The hints of
+// the whole query in the query blocks are applied too.
+func (builder *QueryBuilder) parseStatementHints() {
+	if builder.stmtHints != nil && len(builder.stmtHints.Hints) > 0 {
+		if builder.optimizerHints == nil {
+			builder.optimizerHints = &OptimizerHints{}
+		}
+		applyStatementHints(builder.optimizerHints, builder.stmtHints)
+	}
+	for _, block := range builder.blockHints {
+		if block.determineShuffle == 0 && !block.forceOneCNAll {
+			continue
+		}
+		if builder.optimizerHints == nil {
+			builder.optimizerHints = &OptimizerHints{}
+		}
+		if block.determineShuffle != 0 {
+			builder.optimizerHints.determineShuffle = block.determineShuffle
+		}
+		if block.forceOneCNAll {
+			builder.optimizerHints.forceOneCNAll = true
+		}
+	}
+}
+
+// parseBlockHints parses the hints of a query block which is not the
+// outermost, its table hints only match the tables of the query block.
+func (builder *QueryBuilder) parseBlockHints(stmtHints *tree.OptimizerHints) *OptimizerHints {
+	if stmtHints == nil || len(stmtHints.Hints) == 0 {
+		return nil
+	}
+	hints := &OptimizerHints{}
+	applyStatementHints(hints, stmtHints)
+	builder.blockHints = append(builder.blockHints, hints)
+	return hints
+}
+
+func applyStatementHints(hints *OptimizerHints, stmtHints *tree.OptimizerHints) {
+	for _, hint := range stmtHints.Hints {
+		switch hint.Name {
+		case tree.HintJoinOrder:
+			hints.joinOrder = hints.joinOrder[:0]
+			for _, arg := range hint.Args {
+				hints.joinOrder = append(hints.joinOrder, strings.ToLower(arg))
+			}
+		case tree.HintHashJoin:
+			if len(hint.Args) == 0 {
+				hints.hashJoinAll = true
+			}
+			for _, arg := range hint.Args {
+				hints.hashJoin = append(hints.hashJoin, strings.ToLower(arg))
+			}
+		case tree.HintNoShuffle:
+			hints.determineShuffle = 1
+		case tree.HintUseIndex:
+			if hints.useIndex == nil {
+				hints.useIndex = make(map[string][]string)
+			}
+			table := strings.ToLower(hint.Args[0])
+			for _, arg := range hint.Args[1:] {
+				hints.useIndex[table] = append(hints.useIndex[table], strings.ToLower(arg))
+			}
+			if len(hint.Args) == 1 {
+				// USE_INDEX(t) uses no index for t
+				hints.useIndex[table] = []string{}
+			}
+		case tree.HintForceOneCN:
+			hints.forceOneCNAll = true
+		}
+	}
+}
+
+// hintTableName returns the lower case table name or alias of the scan node
+// to match the tables in the hints.
+func (builder *QueryBuilder) hintTableName(node *plan.Node) string {
+	if node.NodeType != plan.Node_TABLE_SCAN || len(node.BindingTags) == 0 {
+		return ""
+	}
+	if alias, ok := builder.aliasByTag[node.BindingTags[0]]; ok {
+		return strings.ToLower(alias)
+	}
+	if node.TableDef != nil {
+		return strings.ToLower(node.TableDef.Name)
+	}
+	return ""
+}
+
+// blockHintsOf returns the hints of the query block of the scan node.
+func (builder *QueryBuilder) blockHintsOf(node *plan.Node) *OptimizerHints {
+	if node.NodeType != plan.Node_TABLE_SCAN || len(node.BindingTags) == 0 {
+		return nil
+	}
+	return builder.hintsByTag[node.BindingTags[0]]
+}
+
+// isHashJoinHinted returns whether the HASH_JOIN hint of the query block or
+// the statement forbids the index join on the scan node.
+func (builder *QueryBuilder) isHashJoinHinted(node *plan.Node) bool {
+	for _, hints := range []*OptimizerHints{builder.blockHintsOf(node), builder.optimizerHints} {
+		if hints == nil {
+			continue
+		}
+		if hints.hashJoinAll {
+			return true
+		}
+		if len(hints.hashJoin) > 0 && slices.Contains(hints.hashJoin, builder.hintTableName(node)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hintedIndexes returns the indexes of the scan node allowed by the USE_INDEX
+// hint of the query block or the statement, the table def itself is never
+// changed.
+func (builder *QueryBuilder) hintedIndexes(node *plan.Node) []*plan.IndexDef {
+	var names []string
+	found := false
+	for _, hints := range []*OptimizerHints{builder.blockHintsOf(node), builder.optimizerHints} {
+		if hints == nil || len(hints.useIndex) == 0 {
+			continue
+		}
+		if names, found = hints.useIndex[builder.hintTableName(node)]; found {
+			break
+		}
+	}
+	if !found {
+		return node.TableDef.Indexes
+	}
+	indexes := make([]*plan.IndexDef, 0, len(names))
+	for _, idxDef := range node.TableDef.Indexes {
+		if slices.Contains(names, strings.ToLower(idxDef.IndexName)) {
+			indexes = append(indexes, idxDef)
+		}
+	}
+	return indexes
+}
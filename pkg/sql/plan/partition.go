@@ -161,9 +161,12 @@ func buildPartitionExpr(ctx context.Context, tblInfo *plan.TableDef, partitionBi
 // getValidPartitionCount checks the subpartition and adjust the number of the partition
 func getValidPartitionCount(ctx context.Context, needPartitionDefs bool, partitionSyntaxDef *tree.PartitionOption) (uint64, error) {
 	var err error
-	//step 1 : reject subpartition
+	//step 1 : only RANGE/LIST partitioning can be subpartitioned
 	if partitionSyntaxDef.SubPartBy != nil {
-		return 0, moerr.NewInvalidInput(ctx, "subpartition is unsupported")
+		switch partitionSyntaxDef.PartBy.PType.(type) {
+		case *tree.HashType, *tree.KeyType:
+			return 0, moerr.NewErrSubpartition(ctx)
+		}
 	}
 
 	if needPartitionDefs && len(partitionSyntaxDef.Partitions) == 0 {
@@ -39,6 +39,14 @@ func (builder *QueryBuilder) partitionPrune(nodeID int32) {
 	case plan.Node_TABLE_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_EXTERNAL_SCAN:
 		if node.TableDef.GetPartition() != nil && len(node.FilterList) != 0 {
 			partitionByDef := node.TableDef.Partition
+			if partitionByDef.SubPartition != nil {
+				pruner := &SubPartitionPruner{
+					node:    node,
+					process: builder.compCtx.GetProcess(),
+				}
+				pruner.prune()
+				return
+			}
 			switch partitionByDef.Type {
 			case plan.PartitionType_KEY, plan.PartitionType_LINEAR_KEY, plan.PartitionType_HASH, plan.PartitionType_LINEAR_HASH:
 				pruner := &KeyHashPartitionPruner{
@@ -64,8 +72,10 @@ func (builder *QueryBuilder) partitionPrune(nodeID int32) {
 type KeyHashPartitionPruner struct {
 	partitionKeysMap map[string]int
 	partitionByDef   *plan.PartitionByDef
-	node             *Node
-	process          *process.Process
+	// partitionExpression computes the partition number from the partition keys
+	partitionExpression *plan.Expr
+	node                *Node
+	process             *process.Process
 }
 
 type PartitionPruneResult struct {
@@ -77,18 +87,25 @@ type PartitionPruneResult struct {
 func (p *KeyHashPartitionPruner) init() {
 	partitionByDef := p.node.TableDef.Partition
 	p.partitionByDef = partitionByDef
-	p.partitionKeysMap = make(map[string]int)
+	p.partitionExpression = partitionByDef.PartitionExpression
+	p.partitionKeysMap = getPartitionKeysMap(partitionByDef.Type, partitionByDef.PartitionExpr, partitionByDef.PartitionColumns)
+}
 
-	switch partitionByDef.Type {
-	case plan.PartitionType_KEY, plan.PartitionType_LINEAR_KEY:
-		for _, partitionCol := range partitionByDef.PartitionColumns.PartitionColumns {
-			if _, ok := p.partitionKeysMap[partitionCol]; !ok {
-				p.partitionKeysMap[partitionCol] = 1
+// getPartitionKeysMap returns the columns used by the partitioning
+func getPartitionKeysMap(typ plan.PartitionType, partitionExpr *plan.PartitionExpr, partitionColumns *plan.PartitionColumns) map[string]int {
+	partitionKeysMap := make(map[string]int)
+	switch typ {
+	case plan.PartitionType_KEY, plan.PartitionType_LINEAR_KEY,
+		plan.PartitionType_RANGE_COLUMNS, plan.PartitionType_LIST_COLUMNS:
+		for _, partitionCol := range partitionColumns.PartitionColumns {
+			if _, ok := partitionKeysMap[partitionCol]; !ok {
+				partitionKeysMap[partitionCol] = 1
 			}
 		}
-	case plan.PartitionType_HASH, plan.PartitionType_LINEAR_HASH:
-		extractColumnsFromExpression(partitionByDef.PartitionExpr.Expr, p.partitionKeysMap)
+	default:
+		extractColumnsFromExpression(partitionExpr.Expr, partitionKeysMap)
 	}
+	return partitionKeysMap
 }
 
 // detachAndPrune Detach of filter conditions and partition prune
@@ -292,7 +309,7 @@ func (p *KeyHashPartitionPruner) getUsedPartition(cnfColEqVal map[string]*plan.E
 	}
 
 	// 2. calculate partition expression
-	resVec, err := colexec.EvalExpressionOnce(p.process, p.partitionByDef.PartitionExpression, []*batch.Batch{inputBat})
+	resVec, err := colexec.EvalExpressionOnce(p.process, p.partitionExpression, []*batch.Batch{inputBat})
 	if err != nil {
 		return false, -1
 	}
@@ -306,6 +323,78 @@ func (p *KeyHashPartitionPruner) getUsedPartition(cnfColEqVal map[string]*plan.E
 	}
 }
 
+// SubPartitionPruner prunes the partitions of a subpartitioned table in two levels, the
+// partitions are pruned by the first level keys and the subpartitions of every selected
+// partition are pruned by the subpartition keys. A level which can not be pruned keeps
+// all of its partitions.
+type SubPartitionPruner struct {
+	node    *Node
+	process *process.Process
+}
+
+func (p *SubPartitionPruner) prune() bool {
+	partitionByDef := p.node.TableDef.Partition
+	subPartition := partitionByDef.SubPartition
+
+	parentPruner := &KeyHashPartitionPruner{
+		partitionKeysMap:    getPartitionKeysMap(partitionByDef.Type, partitionByDef.PartitionExpr, partitionByDef.PartitionColumns),
+		partitionByDef:      partitionByDef,
+		partitionExpression: subPartition.ParentPartitionExpression,
+		node:                p.node,
+		process:             p.process,
+	}
+	parentResult := parentPruner.detachAndPrune()
+
+	subPruner := &KeyHashPartitionPruner{
+		partitionKeysMap:    getPartitionKeysMap(subPartition.Type, subPartition.PartitionExpr, subPartition.PartitionColumns),
+		partitionByDef:      partitionByDef,
+		partitionExpression: subPartition.PartitionExpression,
+		node:                p.node,
+		process:             p.process,
+	}
+	subResult := subPruner.detachAndPrune()
+
+	if parentResult.isUnablePrune && subResult.isUnablePrune {
+		return false
+	}
+
+	parents := getPrunedPartitionIds(parentResult, int32(partitionByDef.PartitionNum))
+	subs := getPrunedPartitionIds(subResult, int32(subPartition.PartitionNum))
+
+	p.node.PartitionPrune = &plan.PartitionPrune{
+		IsPruned:           true,
+		SelectedPartitions: make([]*plan.PartitionItem, 0, len(parents)*len(subs)),
+	}
+	for _, parent := range parents {
+		for _, sub := range subs {
+			partitionItem := subPartition.Partitions[parent*int32(subPartition.PartitionNum)+sub]
+			partition := &plan.PartitionItem{
+				PartitionName:      partitionItem.PartitionName,
+				OrdinalPosition:    partitionItem.OrdinalPosition,
+				Description:        partitionItem.Description,
+				Comment:            partitionItem.Comment,
+				LessThan:           DeepCopyExprList(partitionItem.LessThan),
+				InValues:           DeepCopyExprList(partitionItem.InValues),
+				PartitionTableName: partitionItem.PartitionTableName,
+			}
+			p.node.PartitionPrune.SelectedPartitions = append(p.node.PartitionPrune.SelectedPartitions, partition)
+		}
+	}
+	return true
+}
+
+// getPrunedPartitionIds returns the sorted ids of the partitions hit by the prune result,
+// all partitions are returned if the prune result is unable to prune.
+func getPrunedPartitionIds(result *PartitionPruneResult, partitionNum int32) []int32 {
+	ids := make([]int32, 0, partitionNum)
+	for i := int32(0); i < partitionNum; i++ {
+		if result.isUnablePrune || result.usedPartitions[i] {
+			ids = append(ids, i)
+		}
+	}
+	return ids
+}
+
 // intersection Finding the Intersection of Two Map[int32]bool Sets
 func intersection(left, right map[int32]bool) map[int32]bool {
 	result := make(map[int32]bool)
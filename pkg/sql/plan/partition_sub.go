@@ -0,0 +1,226 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildSubPartition builds the HASH/KEY subpartitioning of a RANGE/LIST partitioned table.
+// Every subpartition is stored in its own partition table, the i-th partition owns the
+// subpartitions [i*subpartitionNum, (i+1)*subpartitionNum), so the partition expression
+// of the table is rewritten to compute the position of the subpartition of a row.
+func buildSubPartition(ctx context.Context, partitionBinder *PartitionBinder, stmt *tree.CreateTable, tableDef *TableDef) error {
+	partitionOp := stmt.PartitionOption
+	partitionDef := tableDef.Partition
+	switch partitionDef.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS,
+		plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+	default:
+		return moerr.NewErrSubpartition(ctx)
+	}
+
+	subPartitionNum, err := getValidSubPartitionCount(ctx, partitionOp)
+	if err != nil {
+		return err
+	}
+
+	// the subpartitioning is built as a HASH/KEY partitioning of the table,
+	// which checks the subpartition keys in the same way.
+	var builder partitionBuilder
+	switch partitionOp.SubPartBy.PType.(type) {
+	case *tree.HashType:
+		builder = &hashPartitionBuilder{}
+	case *tree.KeyType:
+		builder = &keyPartitionBuilder{}
+	default:
+		return moerr.NewErrSubpartition(ctx)
+	}
+	subStmt := &tree.CreateTable{
+		Defs: stmt.Defs,
+		PartitionOption: &tree.PartitionOption{
+			PartBy: &tree.PartitionBy{
+				IsSubPartition: true,
+				PType:          partitionOp.SubPartBy.PType,
+				Num:            subPartitionNum,
+			},
+		},
+	}
+	if err = builder.build(ctx, partitionBinder, subStmt, tableDef); err != nil {
+		return err
+	}
+	subPartitionDef := tableDef.Partition
+	tableDef.Partition = partitionDef
+
+	subPartitions, err := buildSubPartitionDefs(ctx, partitionDef, partitionOp.Partitions, subPartitionNum)
+	if err != nil {
+		return err
+	}
+	partitionDef.SubPartition = &plan.SubPartitionByDef{
+		Type:             subPartitionDef.Type,
+		PartitionExpr:    subPartitionDef.PartitionExpr,
+		PartitionColumns: subPartitionDef.PartitionColumns,
+		PartitionNum:     subPartitionNum,
+		Algorithm:        subPartitionDef.Algorithm,
+		Partitions:       subPartitions,
+	}
+
+	if err = buildSubPartitionExpression(ctx, partitionBinder, partitionOp, partitionDef); err != nil {
+		return err
+	}
+	// the column list of KEY() is complemented while building
+	partitionDef.PartitionMsg = tree.StringWithOpts(partitionOp, dialect.MYSQL, tree.WithSingleQuoteString())
+	return nil
+}
+
+// getValidSubPartitionCount checks the subpartition definitions and returns the number of
+// the subpartitions of every partition
+func getValidSubPartitionCount(ctx context.Context, partitionOp *tree.PartitionOption) (uint64, error) {
+	subPartitionCount := partitionOp.SubPartBy.Num
+	definedCount := len(partitionOp.Partitions[0].Subs)
+	for _, partition := range partitionOp.Partitions {
+		if len(partition.Subs) != definedCount {
+			return 0, moerr.NewErrPartitionWrongNoSubpart(ctx)
+		}
+	}
+	if definedCount != 0 {
+		if subPartitionCount != 0 && subPartitionCount != uint64(definedCount) {
+			return 0, moerr.NewErrPartitionWrongNoSubpart(ctx)
+		}
+		subPartitionCount = uint64(definedCount)
+	}
+	if subPartitionCount == 0 {
+		subPartitionCount = 1
+	}
+	if err := checkPartitionCount(ctx, int(subPartitionCount)*len(partitionOp.Partitions)); err != nil {
+		return 0, err
+	}
+	return subPartitionCount, nil
+}
+
+// buildSubPartitionDefs constructs the subpartitions of all partitions, the names of the
+// subpartitions are unique in the table and default to the partition name followed by 'sp' and
+// the position of the subpartition in the partition.
+func buildSubPartitionDefs(ctx context.Context, partitionDef *plan.PartitionByDef,
+	syntaxDefs []*tree.Partition, subPartitionNum uint64) ([]*plan.PartitionItem, error) {
+	dedup := make(map[string]bool)
+	for _, partition := range partitionDef.Partitions {
+		dedup[partition.PartitionName] = true
+	}
+
+	subPartitions := make([]*plan.PartitionItem, 0, len(partitionDef.Partitions)*int(subPartitionNum))
+	for i, partition := range partitionDef.Partitions {
+		for j := 0; j < int(subPartitionNum); j++ {
+			name := fmt.Sprintf("%ssp%d", partition.PartitionName, j)
+			comment := ""
+			if len(syntaxDefs[i].Subs) != 0 {
+				name = string(syntaxDefs[i].Subs[j].Name)
+				for _, option := range syntaxDefs[i].Subs[j].Options {
+					if commentOpt, ok := option.(*tree.TableOptionComment); ok {
+						comment = commentOpt.Comment
+					}
+				}
+			}
+			if _, ok := dedup[name]; ok {
+				return nil, moerr.NewSameNamePartition(ctx, name)
+			}
+			dedup[name] = true
+
+			subPartitions = append(subPartitions, &plan.PartitionItem{
+				PartitionName:   name,
+				OrdinalPosition: uint32(len(subPartitions) + 1),
+				Description:     partition.Description,
+				Comment:         comment,
+				LessThan:        partition.LessThan,
+				InValues:        partition.InValues,
+			})
+		}
+	}
+	return subPartitions, nil
+}
+
+// buildSubPartitionExpression builds the expressions of a subpartitioned table. The first level
+// expression is kept in the subpartition def as the parent partition expression, and the
+// partition expression of the table is replaced by the expression computing the position of
+// the subpartition, such as:
+// case when parentExpr = -1 then -1 else parentExpr * subpartitionNum + subExpr end
+func buildSubPartitionExpression(ctx context.Context, partitionBinder *PartitionBinder,
+	stmt *tree.PartitionOption, partitionDef *plan.PartitionByDef) error {
+	subPartition := partitionDef.SubPartition
+	subPartition.ParentPartitionExpression = partitionDef.PartitionExpression
+
+	subPartitionAst, err := buildSubPartitionExprAst(ctx, stmt, subPartition)
+	if err != nil {
+		return err
+	}
+	subPartition.PartitionExpression, err = bindPartitionExpression(ctx, partitionBinder, subPartitionAst)
+	if err != nil {
+		return err
+	}
+
+	partitionAst, err := buildPartitionExprAst(ctx, stmt, partitionDef)
+	if err != nil {
+		return err
+	}
+	partitionDef.PartitionExpression, err = bindPartitionExpression(ctx, partitionBinder, partitionAst)
+	return err
+}
+
+// buildSubPartitionExprAst returns the syntax tree of the expression computing the
+// subpartition number of a row within its partition
+func buildSubPartitionExprAst(ctx context.Context, stmt *tree.PartitionOption, subPartition *plan.SubPartitionByDef) (tree.Expr, error) {
+	return buildPartitionExprAst(ctx, &tree.PartitionOption{PartBy: stmt.SubPartBy}, &plan.PartitionByDef{
+		Type:             subPartition.Type,
+		PartitionColumns: subPartition.PartitionColumns,
+		PartitionNum:     subPartition.PartitionNum,
+	})
+}
+
+// genSubPartitionAst combines the partition and subpartition expressions into the
+// expression computing the position of the subpartition of a row
+func genSubPartitionAst(partitionAst, subPartitionAst tree.Expr, subPartitionNum uint64) tree.Expr {
+	minusOne := tree.NewNumValWithType(constant.MakeInt64(-1), "-1", false, tree.P_int64)
+	num := tree.NewNumValWithType(constant.MakeInt64(int64(subPartitionNum)), fmt.Sprintf("%v", subPartitionNum), false, tree.P_int64)
+	return &tree.CaseExpr{
+		Whens: []*tree.When{
+			{
+				Cond: tree.NewComparisonExpr(tree.EQUAL, partitionAst, minusOne),
+				Val:  minusOne,
+			},
+		},
+		Else: tree.NewBinaryExpr(tree.PLUS, tree.NewBinaryExpr(tree.MULTI, partitionAst, num), subPartitionAst),
+	}
+}
+
+// bindPartitionExpression binds the syntax tree of a partition expression,
+// the partition number is an int32.
+func bindPartitionExpression(ctx context.Context, partitionBinder *PartitionBinder, ast tree.Expr) (*plan.Expr, error) {
+	tempExpr, err := partitionBinder.baseBindExpr(ast, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	return appendCastBeforeExpr(ctx, tempExpr, plan.Type{
+		Id:          int32(types.T_int32),
+		NotNullable: true,
+	})
+}
@@ -0,0 +1,245 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubPartition(t *testing.T) {
+	sqls := []string{
+		`create table t1 (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 2 (
+			partition p0 values less than (10),
+			partition p1 values less than (20))`,
+		`create table t1 (a int, b int, primary key (a, b)) partition by list (a) subpartition by key () subpartitions 3 (
+			partition p0 values in (1, 3),
+			partition p1 values in (2, 4))`,
+		`create table t1 (a date, b varchar(20)) partition by range columns (a) subpartition by key (b) (
+			partition p0 values less than ('2024-01-01') (subpartition s0, subpartition s1),
+			partition p1 values less than (maxvalue) (subpartition s2, subpartition s3))`,
+		`create table t1 (a int, b int) partition by list columns (a) subpartition by linear hash (b + 1) subpartitions 2 (
+			partition p0 values in (1, 3),
+			partition p1 values in (2, 4))`,
+	}
+
+	setupPartitionTestRuntime()
+	mock := NewMockOptimizer(false)
+	for _, sql := range sqls {
+		t.Log(sql)
+		_, err := buildSingleStmt(mock, t, sql)
+		require.NoError(t, err)
+	}
+}
+
+func TestSubPartitionDefs(t *testing.T) {
+	setupPartitionTestRuntime()
+	mock := NewMockOptimizer(false)
+	sql := `create table t1 (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 2 (
+		partition p0 values less than (10),
+		partition p1 values less than (20) (subpartition s0 comment 'c0', subpartition s1))`
+	_, err := buildSingleStmt(mock, t, sql)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrPartitionWrongNoSubpart))
+
+	sql = `create table t1 (a int, b int) partition by range (a) subpartition by hash (b) (
+		partition p0 values less than (10) (subpartition s0 comment 'c0', subpartition s1),
+		partition p1 values less than (20) (subpartition s2, subpartition s3))`
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	partInfo := createTable.TableDef.Partition
+	require.Equal(t, uint64(2), partInfo.PartitionNum)
+	require.Equal(t, uint64(2), partInfo.SubPartition.PartitionNum)
+	require.Equal(t, plan.PartitionType_HASH, partInfo.SubPartition.Type)
+	require.Len(t, createTable.PartitionTables, 4)
+	require.Len(t, partInfo.PartitionTableNames, 4)
+
+	names := make([]string, 0, 4)
+	for i, subPartition := range partInfo.SubPartition.Partitions {
+		names = append(names, subPartition.PartitionName)
+		require.Equal(t, uint32(i+1), subPartition.OrdinalPosition)
+		require.Equal(t, partInfo.PartitionTableNames[i], subPartition.PartitionTableName)
+		require.Equal(t, createTable.PartitionTables[i].Name, subPartition.PartitionTableName)
+	}
+	require.Equal(t, []string{"s0", "s1", "s2", "s3"}, names)
+	require.Equal(t, "c0", partInfo.SubPartition.Partitions[0].Comment)
+	require.Equal(t, partInfo.Partitions[1].Description, partInfo.SubPartition.Partitions[3].Description)
+	require.NotNil(t, partInfo.SubPartition.ParentPartitionExpression)
+	require.NotNil(t, partInfo.SubPartition.PartitionExpression)
+
+	sql = `create table t1 (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 2 (
+		partition p0 values less than (10),
+		partition p1 values less than (20))`
+	logicPlan, err = buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	partInfo = logicPlan.GetDdl().GetCreateTable().TableDef.Partition
+	names = names[:0]
+	for _, subPartition := range partInfo.SubPartition.Partitions {
+		names = append(names, subPartition.PartitionName)
+	}
+	require.Equal(t, []string{"p0sp0", "p0sp1", "p1sp0", "p1sp1"}, names)
+}
+
+func TestSubPartitionError(t *testing.T) {
+	sqls := []string{
+		// only RANGE/LIST partitioning can be subpartitioned
+		`create table t1 (a int, b int) partition by hash (a) partitions 2 subpartition by hash (b) subpartitions 2`,
+		`create table t1 (a int, b int) partition by key (a) partitions 2 subpartition by key (b) subpartitions 2`,
+		// the number of the subpartitions does not match
+		`create table t1 (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 3 (
+			partition p0 values less than (10) (subpartition s0, subpartition s1),
+			partition p1 values less than (20) (subpartition s2, subpartition s3))`,
+		`create table t1 (a int, b int) partition by range (a) subpartition by hash (b) (
+			partition p0 values less than (10) (subpartition s0, subpartition s1),
+			partition p1 values less than (20) (subpartition s2))`,
+		// duplicate subpartition names
+		`create table t1 (a int, b int) partition by range (a) subpartition by hash (b) (
+			partition p0 values less than (10) (subpartition s0, subpartition s1),
+			partition p1 values less than (20) (subpartition s0, subpartition s3))`,
+		`create table t1 (a int, b int) partition by range (a) subpartition by hash (b) (
+			partition p0 values less than (10) (subpartition p1, subpartition s1),
+			partition p1 values less than (20) (subpartition s2, subpartition s3))`,
+		// the unique key must include the subpartition keys
+		`create table t1 (a int, b int, c int, primary key (a, b)) partition by range (a) subpartition by hash (c) subpartitions 2 (
+			partition p0 values less than (10),
+			partition p1 values less than (20))`,
+		`create table t1 (a int, b text) partition by range (a) subpartition by key (b) subpartitions 2 (
+			partition p0 values less than (10),
+			partition p1 values less than (20))`,
+	}
+
+	setupPartitionTestRuntime()
+	mock := NewMockOptimizer(false)
+	for _, sql := range sqls {
+		t.Log(sql)
+		_, err := buildSingleStmt(mock, t, sql)
+		require.Error(t, err)
+	}
+}
+
+func TestDropAndTruncateSubPartitions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := `create table t1 (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 2 (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than maxvalue)`
+	alterTable := buildPartitionedAlterTable(t, mock, sql)
+	partInfo := alterTable.TableDef.Partition
+
+	dropPartition, err := DropTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionDropPartitionClause(tree.AlterPartitionDropPartition, tree.IdentifierList{"p1"}))
+	require.NoError(t, err)
+	require.Equal(t, partInfo.PartitionTableNames[2:4], dropPartition.PartitionTableNames)
+
+	def := dropPartition.PartitionDef
+	require.Equal(t, uint64(2), def.PartitionNum)
+	require.Len(t, def.SubPartition.Partitions, 4)
+	require.Equal(t, []string{
+		partInfo.PartitionTableNames[0], partInfo.PartitionTableNames[1],
+		partInfo.PartitionTableNames[4], partInfo.PartitionTableNames[5],
+	}, def.PartitionTableNames)
+	require.Equal(t, "p2sp0", def.SubPartition.Partitions[2].PartitionName)
+	require.Equal(t, uint32(3), def.SubPartition.Partitions[2].OrdinalPosition)
+	require.Contains(t, def.PartitionMsg, "subpartition by hash")
+
+	truncatePartition, err := TruncateTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionTruncatePartitionClause(tree.AlterPartitionTruncatePartition, tree.IdentifierList{"p0", "P2SP1"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		partInfo.PartitionTableNames[0], partInfo.PartitionTableNames[1], partInfo.PartitionTableNames[5],
+	}, truncatePartition.PartitionTableNames)
+
+	_, err = TruncateTablePartitions(mock.CurrentContext(), alterTable,
+		tree.NewAlterPartitionTruncatePartitionClause(tree.AlterPartitionTruncatePartition, tree.IdentifierList{"p3sp0"}))
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDropPartitionNonExistent))
+}
+
+func TestSubPartitionPrune(t *testing.T) {
+	setupPartitionTestRuntime()
+	mock := NewMockOptimizer(false)
+	sql := `create table t1 (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 4 (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than maxvalue)`
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().TableDef
+	subPartition := tableDef.Partition.SubPartition
+
+	binder, err := mockPartitionBinder(tableDef)
+	require.NoError(t, err)
+	bindFilters := func(filters ...string) []*plan.Expr {
+		exprs := make([]*plan.Expr, len(filters))
+		for i, filter := range filters {
+			ast, err := mockExpr(t, filter)
+			require.NoError(t, err)
+			expr, err := binder.BindExpr(ast, 0, true)
+			require.NoError(t, err)
+			// the filters are folded before the partitions are pruned
+			exprs[i], err = ConstantFold(batch.EmptyForConstFoldBatch, expr, testutil.NewProc(), false)
+			require.NoError(t, err)
+		}
+		return exprs
+	}
+	prune := func(filters ...string) []string {
+		node := &plan.Node{
+			TableDef:   tableDef,
+			FilterList: bindFilters(filters...),
+		}
+		pruner := &SubPartitionPruner{
+			node:    node,
+			process: testutil.NewProc(),
+		}
+		if !pruner.prune() {
+			return nil
+		}
+		names := make([]string, 0, len(node.PartitionPrune.SelectedPartitions))
+		for _, partition := range node.PartitionPrune.SelectedPartitions {
+			names = append(names, partition.PartitionName)
+		}
+		return names
+	}
+
+	// the first level is pruned
+	require.Equal(t, []string{"p1sp0", "p1sp1", "p1sp2", "p1sp3"}, prune("a = 15"))
+	// the subpartitions are pruned
+	names := prune("b = 7")
+	require.Len(t, names, 3)
+	// both levels are pruned
+	names = prune("a = 15", "b = 7")
+	require.Len(t, names, 1)
+	require.Contains(t, prune("b = 7"), names[0])
+	require.Equal(t, "p1", names[0][:2])
+	// the partition computed from the filters is the partition of the row
+	pruner := &KeyHashPartitionPruner{
+		partitionKeysMap:    map[string]int{"a": 1, "b": 1},
+		partitionByDef:      tableDef.Partition,
+		partitionExpression: tableDef.Partition.PartitionExpression,
+		node:                &plan.Node{TableDef: tableDef},
+		process:             testutil.NewProc(),
+	}
+	_, colEqValMap := extractColEqValFromExprs(bindFilters("a = 15", "b = 7"), pruner.partitionKeysMap)
+	ok, pid := pruner.getUsedPartition(colEqValMap)
+	require.True(t, ok)
+	require.Equal(t, names[0], subPartition.Partitions[pid].PartitionName)
+	// unable to prune
+	require.Nil(t, prune("a > 15"))
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// PlanShapeRecorder is implemented by the CompilerContext keeping the shape
+// of the last select plan built, which is used by the plan baselines.
+type PlanShapeRecorder interface {
+	SetPlanShape(shape *tree.OptimizerHints)
+}
+
+// recordPlanShape gives the shape of the plan built to the CompilerContext if
+// it records the shapes.
+func (builder *QueryBuilder) recordPlanShape() {
+	recorder, ok := builder.compCtx.(PlanShapeRecorder)
+	if !ok || len(builder.qry.Steps) == 0 {
+		return
+	}
+	recorder.SetPlanShape(builder.planShape(builder.qry.Steps[0]))
+}
+
+// planShape returns the hints building the plan in the same shape: the
+// JOIN_ORDER of the tables scanned, and the USE_INDEX of the indexes used by
+// every table with indexes. The tables are named like the hints name them.
+func (builder *QueryBuilder) planShape(rootID int32) *tree.OptimizerHints {
+	var scans []*plan.Node
+	var visit func(nodeID int32)
+	visit = func(nodeID int32) {
+		node := builder.qry.Nodes[nodeID]
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef != nil {
+			scans = append(scans, node)
+		}
+		for _, child := range node.Children {
+			visit(child)
+		}
+	}
+	visit(rootID)
+
+	indexTables := make(map[string]struct{})
+	for _, node := range scans {
+		if strings.HasPrefix(node.TableDef.Name, catalog.PrefixIndexTableName) {
+			indexTables[node.TableDef.Name] = struct{}{}
+		}
+	}
+
+	shape := &tree.OptimizerHints{}
+	var tables []string
+	var useIndexes []*tree.OptimizerHint
+	for _, node := range scans {
+		name := builder.hintTableName(node)
+		if _, ok := indexTables[node.TableDef.Name]; ok || name == "" || slices.Contains(tables, name) {
+			continue
+		}
+		tables = append(tables, name)
+		if len(node.TableDef.Indexes) == 0 {
+			continue
+		}
+		useIndex := &tree.OptimizerHint{Name: tree.HintUseIndex, Args: []string{name}}
+		for _, idxDef := range node.TableDef.Indexes {
+			if _, ok := indexTables[idxDef.IndexTableName]; !ok {
+				continue
+			}
+			// the multi table indexes have several index tables
+			if idxName := strings.ToLower(idxDef.IndexName); !slices.Contains(useIndex.Args[1:], idxName) {
+				useIndex.Args = append(useIndex.Args, idxName)
+			}
+		}
+		useIndexes = append(useIndexes, useIndex)
+	}
+	if len(tables) > 1 {
+		shape.Hints = append(shape.Hints, &tree.OptimizerHint{Name: tree.HintJoinOrder, Args: tables})
+	}
+	shape.Hints = append(shape.Hints, useIndexes...)
+	return shape
+}
+
+// PlanCost returns the estimated cost of the query.
+func PlanCost(qry *plan.Query) float64 {
+	if len(qry.Steps) == 0 {
+		return 0
+	}
+	root := qry.Nodes[qry.Steps[0]]
+	if root.Stats == nil {
+		return 0
+	}
+	return root.Stats.Cost
+}
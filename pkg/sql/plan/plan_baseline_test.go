@@ -0,0 +1,63 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+type mockShapeRecorder struct {
+	*MockCompilerContext
+	shape *tree.OptimizerHints
+}
+
+func (m *mockShapeRecorder) SetPlanShape(shape *tree.OptimizerHints) {
+	m.shape = shape
+}
+
+func TestPlanShape(t *testing.T) {
+	ctx := &mockShapeRecorder{MockCompilerContext: NewMockCompilerContext(true)}
+	build := func(sql string) (*Plan, string) {
+		stmt, err := mysql.ParseOne(ctx.GetContext(), sql, 1)
+		require.NoError(t, err)
+		ctx.shape = nil
+		p, err := BuildPlan(ctx, stmt, false)
+		require.NoError(t, err)
+		require.NotNil(t, ctx.shape)
+		return p, tree.String(ctx.shape, dialect.MYSQL)
+	}
+
+	sql := "select n.n_name from nation n, region r, supplier s " +
+		"where n.n_regionkey = r.r_regionkey and s.s_nationkey = n.n_nationkey"
+	_, shape := build("select /*+ JOIN_ORDER(s, r) */" + sql[len("select"):])
+	require.Contains(t, shape, "JOIN_ORDER(")
+
+	// the plan built by the shape has the same shape
+	hints := mysql.ParseOptimizerHints(shape)
+	require.Empty(t, hints.Warnings)
+	p, again := build("select " + shape + sql[len("select"):])
+	require.Equal(t, shape, again)
+	require.Greater(t, PlanCost(p.GetQuery()), float64(0))
+
+	// one table without indexes has no shape
+	build("select * from nation where n_nationkey = 1")
+	require.Empty(t, ctx.shape.Hints)
+}
@@ -63,6 +63,8 @@ func NewQueryBuilder(queryType plan.Query_StatementType, ctx CompilerContext, is
 		nextTag:            0,
 		mysqlCompatible:    mysqlCompatible,
 		tag2Table:          make(map[int32]*TableDef),
+		aliasByTag:         make(map[int32]string),
+		hintsByTag:         make(map[int32]*OptimizerHints),
 		isPrepareStatement: isPrepareStatement,
 		deleteNode:         make(map[uint64]int32),
 		skipStats:          skipStats,
@@ -1430,6 +1432,7 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 	sinkColRef := make(map[[2]int32]int)
 
 	builder.parseOptimizeHints()
+	builder.parseStatementHints()
 	for i, rootID := range builder.qry.Steps {
 		builder.skipStats = builder.canSkipStats()
 		builder.rewriteDistinctToAGG(rootID)
@@ -1497,7 +1500,7 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 
 		builder.generateRuntimeFilters(rootID)
 		ReCalcNodeStats(rootID, builder, true, false, false)
-		builder.forceJoinOnOneCN(rootID, false)
+		builder.forceJoinOnOneCN(rootID, builder.optimizerHints != nil && builder.optimizerHints.forceOneCNAll)
 		// after this ,never call ReCalcNodeStats again !!!
 
 		if builder.isForUpdate {
@@ -1518,6 +1521,11 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		}
 	}
 
+	// the binding tags of the scans are gone after the remapping
+	if builder.recordShape {
+		builder.recordPlanShape()
+	}
+
 	for i := range builder.qry.Steps {
 		rootID := builder.qry.Steps[i]
 		builder.markSinkProject(rootID, int32(i), colRefBool)
@@ -2086,6 +2094,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	switch selectClause := stmt.Select.(type) {
 	case *tree.SelectClause:
 		clause = selectClause
+		ctx.hints = builder.parseBlockHints(clause.Hints)
 	case *tree.UnionClause:
 		if builder.isForUpdate {
 			return 0, moerr.NewInternalError(builder.GetContext(), "not support select union for update")
@@ -3772,6 +3781,10 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 		defaultVals = make([]string, colLength)
 
 		tag := node.BindingTags[0]
+		builder.aliasByTag[tag] = table
+		if ctx.hints != nil {
+			builder.hintsByTag[tag] = ctx.hints
+		}
 
 		for i, col := range node.TableDef.Cols {
 			if i < len(alias.Cols) {
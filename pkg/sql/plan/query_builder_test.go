@@ -89,3 +89,91 @@ func TestBuildTable_AlterView(t *testing.T) {
 	_, err = qb.buildTable(tb, bc, -1, nil)
 	assert.Error(t, err)
 }
+
+func TestStatementHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "select /*+ JOIN_ORDER(s, r) FORCE_ONE_CN */ n.n_name from nation n, region r, supplier s " +
+		"where n.n_regionkey = r.r_regionkey and s.s_nationkey = n.n_nationkey"
+	logicPlan, err := runOneStmt(mock, t, sql)
+	assert.NoError(t, err)
+
+	// the tables in JOIN_ORDER are joined first
+	var leafJoin []string
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			assert.True(t, node.Stats.ForceOneCN)
+		}
+		if node.NodeType != plan.Node_JOIN {
+			continue
+		}
+		left := logicPlan.GetQuery().Nodes[node.Children[0]]
+		right := logicPlan.GetQuery().Nodes[node.Children[1]]
+		if left.NodeType == plan.Node_TABLE_SCAN && right.NodeType == plan.Node_TABLE_SCAN {
+			leafJoin = []string{left.TableDef.Name, right.TableDef.Name}
+		}
+	}
+	assert.ElementsMatch(t, []string{"supplier", "region"}, leafJoin)
+
+	builder := NewQueryBuilder(plan.Query_SELECT, &mock.ctxt, false, false)
+	builder.stmtHints = &tree.OptimizerHints{Hints: []*tree.OptimizerHint{
+		{Name: tree.HintNoShuffle},
+		{Name: tree.HintHashJoin, Args: []string{"T1"}},
+		{Name: tree.HintUseIndex, Args: []string{"t1", "IDX2"}},
+	}}
+	builder.parseStatementHints()
+	assert.Equal(t, 1, builder.optimizerHints.determineShuffle)
+
+	node := &plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		BindingTags: []int32{1},
+		TableDef: &plan.TableDef{
+			Name:    "t",
+			Indexes: []*plan.IndexDef{{IndexName: "idx1"}, {IndexName: "idx2"}},
+		},
+	}
+	builder.aliasByTag[1] = "t1"
+	assert.True(t, builder.isHashJoinHinted(node))
+	indexes := builder.hintedIndexes(node)
+	assert.Len(t, indexes, 1)
+	assert.Equal(t, "idx2", indexes[0].IndexName)
+	assert.Len(t, node.TableDef.Indexes, 2)
+
+	// the hints of a query block only match the tables of the query block
+	builder.hintsByTag[2] = builder.parseBlockHints(&tree.OptimizerHints{Hints: []*tree.OptimizerHint{
+		{Name: tree.HintUseIndex, Args: []string{"t1"}},
+		{Name: tree.HintForceOneCN},
+	}})
+	builder.parseStatementHints()
+	assert.True(t, builder.optimizerHints.forceOneCNAll)
+	assert.Len(t, builder.hintedIndexes(node), 1)
+	blockNode := &plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		BindingTags: []int32{2},
+		TableDef:    node.TableDef,
+	}
+	builder.aliasByTag[2] = "t1"
+	assert.Empty(t, builder.hintedIndexes(blockNode))
+	// the hints of the statement still match the tables not in the hints of the query block
+	assert.True(t, builder.isHashJoinHinted(blockNode))
+}
+
+func TestQueryBlockHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "select n.n_name from nation n where n.n_regionkey in (select /*+ JOIN_ORDER(s, r) */ r.r_regionkey " +
+		"from nation n, region r, supplier s where n.n_regionkey = r.r_regionkey and s.s_nationkey = n.n_nationkey)"
+	logicPlan, err := runOneStmt(mock, t, sql)
+	assert.NoError(t, err)
+
+	var leafJoin []string
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType != plan.Node_JOIN || node.JoinType != plan.Node_INNER {
+			continue
+		}
+		left := logicPlan.GetQuery().Nodes[node.Children[0]]
+		right := logicPlan.GetQuery().Nodes[node.Children[1]]
+		if left.NodeType == plan.Node_TABLE_SCAN && right.NodeType == plan.Node_TABLE_SCAN {
+			leafJoin = []string{left.TableDef.Name, right.TableDef.Name}
+		}
+	}
+	assert.ElementsMatch(t, []string{"supplier", "region"}, leafJoin)
+}
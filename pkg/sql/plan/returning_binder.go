@@ -0,0 +1,86 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// use for returning clause: eg: insert into t1(b) values(1),(2) returning a, b + 1
+// the columns are bound to their position in the rows written by the insert or delete node.
+func NewReturningBinder(sysCtx context.Context, builder *QueryBuilder, ctx *BindContext, table string, cols []*ColDef) *ReturningBinder {
+	b := &ReturningBinder{table: table, cols: cols}
+	b.sysCtx = sysCtx
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+
+	return b
+}
+
+func (b *ReturningBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return b.baseBindExpr(astExpr, depth, isRoot)
+}
+
+func (b *ReturningBinder) BindColRef(astExpr *tree.UnresolvedName, _ int32, _ bool) (expr *plan.Expr, err error) {
+	col := strings.ToLower(astExpr.Parts[0])
+	if astExpr.NumParts > 1 && astExpr.Parts[1] != b.table {
+		err = moerr.NewInvalidInput(b.GetContext(), "missing FROM-clause entry for table '%v'", astExpr.Parts[1])
+		return
+	}
+	idx := -1
+	for i, c := range b.cols {
+		if c.Name == col && !c.Hidden {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		err = moerr.NewInvalidInput(b.GetContext(), "column '%s' does not exist", col)
+		return
+	}
+	expr = &plan.Expr{
+		Typ: b.cols[idx].Typ,
+	}
+	expr.Expr = &plan.Expr_Col{
+		Col: &plan.ColRef{
+			RelPos: 0,
+			ColPos: int32(idx),
+			Name:   col,
+		},
+	}
+	return
+}
+
+func (b *ReturningBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInput(b.GetContext(), "aggregate function %s not allowed in RETURNING clause", funcName)
+}
+
+func (b *ReturningBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInput(b.GetContext(), "window function %s not allowed in RETURNING clause", funcName)
+}
+
+func (b *ReturningBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewNYI(b.GetContext(), "subquery in RETURNING clause")
+}
+
+func (b *ReturningBinder) BindTimeWindowFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewInvalidInput(b.GetContext(), "time window function %s not allowed in RETURNING clause", funcName)
+}
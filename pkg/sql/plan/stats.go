@@ -356,6 +356,9 @@ func estimateEqualitySelectivity(expr *plan.Expr, builder *QueryBuilder) float64
 	if col == nil {
 		return 0.01
 	}
+	if sel, ok := estimateSelectivityByHistogram(expr, "=", builder); ok {
+		return sel
+	}
 	ndv := getExprNdv(expr, builder)
 	if ndv > 0 {
 		return 1 / ndv
@@ -457,6 +460,9 @@ func estimateNonEqualitySelectivity(expr *plan.Expr, funcName string, builder *Q
 	if s == nil {
 		return 0.1
 	}
+	if sel, ok := estimateSelectivityByHistogram(expr, funcName, builder); ok {
+		return sel
+	}
 	//check strict filter, otherwise can not estimate outcnt by min/max val
 	col, litType, literals, colFnName := extractColRefAndLiteralsInFilter(expr)
 	if col != nil && len(literals) > 0 {
@@ -531,6 +537,10 @@ func estimateExprSelectivity(expr *plan.Expr, builder *QueryBuilder) float64 {
 			if funcName == "prefix_in" {
 				card *= 10
 			}
+			if sel, ok := estimateSelectivityByHistogram(expr, funcName, builder); ok {
+				ret = sel
+				break
+			}
 			ndv := getExprNdv(expr, builder)
 			if ndv > card {
 				ret = card / ndv
@@ -173,6 +173,19 @@ type QueryBuilder struct {
 	deleteNode     map[uint64]int32 //delete node in this query. key is tableId, value is the nodeId of sinkScan node in the delete plan
 	skipStats      bool
 	optimizerHints *OptimizerHints
+	// stmtHints is the hints in the /*+ ... */ comment of the statement
+	stmtHints *tree.OptimizerHints
+	// aliasByTag is the table name or alias of the scan nodes, used to match
+	// the tables in the hints
+	aliasByTag map[int32]string
+	// hintsByTag is the hints of the query blocks of the scan nodes, they are
+	// matched before the hints of the statement, see addBinding
+	hintsByTag map[int32]*OptimizerHints
+	// blockHints is the hints of the query blocks which are not the outermost
+	blockHints []*OptimizerHints
+	// recordShape records the shape of the plan for the plan baselines, see
+	// recordPlanShape
+	recordShape bool
 }
 
 type OptimizerHints struct {
@@ -196,6 +209,13 @@ type OptimizerHints struct {
 	execType                   int
 	disableRightJoin           int
 	printShuffle               int
+
+	// the hints of the statement or the query block, see parseStatementHints
+	joinOrder     []string
+	hashJoinAll   bool
+	hashJoin      []string
+	useIndex      map[string][]string
+	forceOneCNAll bool
 }
 
 type CTERef struct {
@@ -282,6 +302,9 @@ type BindContext struct {
 	snapshot *Snapshot
 	// all view keys(dbName#viewName)
 	views []string
+
+	// hints is the hints of the query block
+	hints *OptimizerHints
 }
 
 type NameTuple struct {
@@ -327,6 +350,12 @@ type UpdateBinder struct {
 	cols []*ColDef
 }
 
+type ReturningBinder struct {
+	baseBinder
+	table string
+	cols  []*ColDef
+}
+
 type TableBinder struct {
 	baseBinder
 }
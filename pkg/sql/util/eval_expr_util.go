@@ -220,6 +220,12 @@ func SetBytesToAnyVector(ctx context.Context, val string, row int,
 			return err
 		}
 		return vector.SetBytesAt(vec, row, v, proc.Mp())
+	case types.T_array_int8, types.T_array_bit:
+		v, err := types.StringToQuantizedArrayToBytes(vec.GetType().Oid, val)
+		if err != nil {
+			return err
+		}
+		return vector.SetBytesAt(vec, row, v, proc.Mp())
 	case types.T_json:
 		return vector.SetBytesAt(vec, row, []byte(val), proc.Mp())
 	case types.T_time:
@@ -288,7 +294,7 @@ func SetInsertValue(proc *process.Process, numVal *tree.NumVal, vec *vector.Vect
 	case types.T_decimal128:
 		return setInsertValueDecimal128(proc, numVal, vec)
 	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary, types.T_text,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_int8, types.T_array_bit:
 		return setInsertValueString(proc, numVal, vec)
 	case types.T_json:
 		return setInsertValueJSON(proc, numVal, vec)
@@ -660,7 +666,7 @@ func setInsertValueString(proc *process.Process, numVal *tree.NumVal, vec *vecto
 	checkStrLen := func(s string) ([]byte, error) {
 		typ := vec.GetType()
 		destLen := int(typ.Width)
-		if typ.Oid != types.T_text && typ.Oid != types.T_binary && destLen != 0 && !typ.Oid.IsArrayRelate() {
+		if typ.Oid != types.T_text && typ.Oid != types.T_binary && destLen != 0 && !typ.Oid.IsArrayRelate() && !typ.Oid.IsQuantizedArray() {
 			if utf8.RuneCountInString(s) > destLen {
 				return nil, function.FormatCastErrorForInsertValue(proc.Ctx, s, *typ, fmt.Sprintf("Src length %v is larger than Dest length %v", len(s), destLen))
 			}
@@ -697,7 +703,17 @@ func setInsertValueString(proc *process.Process, numVal *tree.NumVal, vec *vecto
 				return nil, moerr.NewInternalErrorNoCtx("%s is not supported array type", typ.String())
 
 			}
+		} else if typ.Oid.IsQuantizedArray() {
+			_v, err := types.StringToQuantizedArrayToBytes(typ.Oid, s)
+			if err != nil {
+				return nil, err
+			}
+
+			if dim := types.QuantizedArrayDimension(typ.Oid, _v); dim != destLen {
+				return nil, moerr.NewArrayDefMismatchNoCtx(int(typ.Width), dim)
+			}
 
+			v = _v
 		} else {
 			v = []byte(s)
 		}
@@ -160,13 +160,16 @@ func (s *memTaskStorage) AddCronTask(ctx context.Context, tasks ...task.CronTask
 	return n, nil
 }
 
-func (s *memTaskStorage) QueryCronTask(context.Context, ...Condition) ([]task.CronTask, error) {
+func (s *memTaskStorage) QueryCronTask(ctx context.Context, conds ...Condition) ([]task.CronTask, error) {
 	s.Lock()
 	defer s.Unlock()
 
+	c := newConditions(conds...)
 	tasks := make([]task.CronTask, 0, len(s.cronTasks))
 	for _, v := range s.cronTasks {
-		tasks = append(tasks, v)
+		if s.filterCronTask(c, v) {
+			tasks = append(tasks, v)
+		}
 	}
 	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
 	return tasks, nil
@@ -194,6 +197,26 @@ func (s *memTaskStorage) UpdateCronTask(ctx context.Context, cron task.CronTask,
 	return 2, nil
 }
 
+func (s *memTaskStorage) DeleteCronTask(ctx context.Context, conds ...Condition) (int, error) {
+	c := newConditions(conds...)
+
+	s.Lock()
+	defer s.Unlock()
+
+	var removeTasks []task.CronTask
+	for _, v := range s.cronTasks {
+		if s.filterCronTask(c, v) {
+			removeTasks = append(removeTasks, v)
+		}
+	}
+
+	for _, v := range removeTasks {
+		delete(s.cronTasks, v.ID)
+		delete(s.cronTaskIndexes, v.Metadata.ID)
+	}
+	return len(removeTasks), nil
+}
+
 func (s *memTaskStorage) AddDaemonTask(ctx context.Context, tasks ...task.DaemonTask) (int, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -335,6 +358,22 @@ func (s *memTaskStorage) filterAsyncTask(c *conditions, task task.AsyncTask) boo
 	return ok
 }
 
+func (s *memTaskStorage) filterCronTask(c *conditions, task task.CronTask) bool {
+	ok := true
+
+	if cond, e := (*c)[CondCronTaskId]; e {
+		ok = cond.eval(task.ID)
+	}
+	if !ok {
+		return false
+	}
+
+	if cond, e := (*c)[CondTaskMetadataId]; e {
+		ok = cond.eval(task.Metadata.ID)
+	}
+	return ok
+}
+
 func (s *memTaskStorage) filterDaemonTask(c *conditions, task task.DaemonTask) bool {
 	ok := true
 
@@ -211,6 +211,10 @@ func (s *taskService) QueryCronTask(ctx context.Context, c ...Condition) ([]task
 	return s.store.QueryCronTask(ctx, c...)
 }
 
+func (s *taskService) DeleteCronTask(ctx context.Context, c ...Condition) (int, error) {
+	return s.store.DeleteCronTask(ctx, c...)
+}
+
 func (s *taskService) UpdateDaemonTask(ctx context.Context, tasks []task.DaemonTask, conds ...Condition) (int, error) {
 	return s.store.UpdateDaemonTask(ctx, tasks, conds...)
 }
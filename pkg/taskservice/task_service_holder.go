@@ -296,6 +296,23 @@ func (s *refreshableTaskStorage) UpdateCronTask(ctx context.Context, cronTask ta
 	return v, err
 }
 
+func (s *refreshableTaskStorage) DeleteCronTask(ctx context.Context, c ...Condition) (int, error) {
+	var v int
+	var err error
+	s.mu.RLock()
+	lastAddress := s.mu.lastAddress
+	if s.mu.store == nil {
+		err = ErrNotReady
+	} else if err = s.mu.store.PingContext(ctx); err == nil {
+		v, err = s.mu.store.DeleteCronTask(ctx, c...)
+	}
+	s.mu.RUnlock()
+	if err != nil {
+		s.maybeRefresh(lastAddress)
+	}
+	return v, err
+}
+
 func (s *refreshableTaskStorage) AddDaemonTask(ctx context.Context, tasks ...task.DaemonTask) (int, error) {
 	var v int
 	var err error
@@ -177,6 +177,38 @@ func TestAddAndQueryCronTask(t *testing.T) {
 	}
 }
 
+func TestDeleteCronTaskWithConditions(t *testing.T) {
+	for name, factory := range storages {
+		t.Run(name, func(t *testing.T) {
+			s := factory(t)
+			defer func() {
+				assert.NoError(t, s.Close())
+			}()
+
+			v1 := newTestCronTask("t1", "cron1")
+			v2 := newTestCronTask("t2", "cron2")
+			mustAddTestCronTask(t, s, 2, v1, v2)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+			tasks, err := s.QueryCronTask(ctx, WithTaskMetadataId(EQ, "t2"))
+			require.NoError(t, err)
+			require.Equal(t, 1, len(tasks))
+			require.Equal(t, "t2", tasks[0].Metadata.ID)
+
+			n, err := s.DeleteCronTask(ctx, WithTaskMetadataId(EQ, "t1"))
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+			tasks = mustQueryTestCronTask(t, s, 1)
+			require.Equal(t, "t2", tasks[0].Metadata.ID)
+
+			// a deleted cron task can be created again
+			mustAddTestCronTask(t, s, 1, v1)
+			mustQueryTestCronTask(t, s, 2)
+		})
+	}
+}
+
 func TestUpdateCronTask(t *testing.T) {
 	for name, factory := range storages {
 		t.Run(name, func(t *testing.T) {
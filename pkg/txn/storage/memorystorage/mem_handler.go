@@ -766,6 +766,33 @@ func (m *MemHandler) HandleTruncateRelation(ctx context.Context, meta txn.TxnMet
 	return m.deleteRelationData(tx, req.OldTableID)
 }
 
+func (m *MemHandler) HandleRenameRelation(ctx context.Context, meta txn.TxnMeta, req *memoryengine.RenameRelationReq, resp *memoryengine.RenameRelationResp) error {
+	tx := m.getTx(meta)
+	rel, err := m.relations.Get(tx, req.TableID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return moerr.NewNoSuchTableNoCtx(req.DatabaseName, req.OldName)
+	}
+	if err != nil {
+		return err
+	}
+
+	// check existence
+	entries, err := m.relations.Index(tx, Tuple{
+		index_DatabaseID_Name,
+		rel.DatabaseID,
+		Text(req.NewName),
+	})
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return moerr.NewTableAlreadyExistsNoCtx(req.NewName)
+	}
+
+	rel.Name = []byte(req.NewName)
+	return m.relations.Update(tx, rel)
+}
+
 func (m *MemHandler) HandleGetDatabases(ctx context.Context, meta txn.TxnMeta, req *memoryengine.GetDatabasesReq, resp *memoryengine.GetDatabasesResp) error {
 	tx := m.getTx(meta)
 
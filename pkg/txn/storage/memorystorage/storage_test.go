@@ -485,6 +485,63 @@ func testDatabase(
 		assert.Nil(t, err)
 	}
 
+	// rename relation
+	{
+		resp := &memoryengine.RenameRelationResp{}
+		err := testWrite(
+			ctx, t, s, txnMeta,
+			memoryengine.OpRenameRelation,
+			&memoryengine.RenameRelationReq{
+				TableID: relID,
+				OldName: "table",
+				NewName: "table2",
+			},
+			resp,
+		)
+		assert.Nil(t, err)
+	}
+	{
+		resp := &memoryengine.OpenRelationResp{}
+		err := testRead(
+			ctx, t, s, txnMeta,
+			memoryengine.OpOpenRelation,
+			&memoryengine.OpenRelationReq{
+				DatabaseID: dbID,
+				Name:       "table2",
+			},
+			resp,
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, relID, resp.ID)
+	}
+	{
+		resp := &memoryengine.GetRelationsResp{}
+		err := testRead(
+			ctx, t, s, txnMeta,
+			memoryengine.OpGetRelations,
+			&memoryengine.GetRelationsReq{
+				DatabaseID: dbID,
+			},
+			resp,
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"table2"}, resp.Names)
+	}
+	{
+		resp := &memoryengine.RenameRelationResp{}
+		err := testWrite(
+			ctx, t, s, txnMeta,
+			memoryengine.OpRenameRelation,
+			&memoryengine.RenameRelationReq{
+				TableID: relID,
+				OldName: "table2",
+				NewName: "table",
+			},
+			resp,
+		)
+		assert.Nil(t, err)
+	}
+
 	// delete relation
 	{
 		resp := &memoryengine.DeleteRelationResp{}
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 // WithDisableIncrStatement disable incr statement
@@ -66,6 +67,12 @@ func (opts Options) WithWaitCommittedLogApplied() Options {
 	return opts
 }
 
+// WithTempEngine makes the temporary tables of a session visible to the sql
+func (opts Options) WithTempEngine(tempEngine engine.Engine) Options {
+	opts.tempEngine = tempEngine
+	return opts
+}
+
 // Database returns default database
 func (opts Options) Database() string {
 	return opts.database
@@ -91,6 +98,11 @@ func (opts Options) WaitCommittedLogApplied() bool {
 	return opts.waitCommittedLogApplied
 }
 
+// TempEngine returns the engine of the temporary tables, nil if not set
+func (opts Options) TempEngine() engine.Engine {
+	return opts.tempEngine
+}
+
 // HasExistsTxn return true if a exists txn is set
 func (opts Options) HasExistsTxn() bool {
 	return opts.txnOp != nil
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 // SQLExecutor is used to execute internal sql. All internal requirements for writing
@@ -63,6 +64,7 @@ type Options struct {
 	statementOptions        StatementOption
 	txnOpts                 []client.TxnOption
 	enableTrace             bool
+	tempEngine              engine.Engine
 }
 
 // StatementOption statement execute option.
@@ -187,6 +187,8 @@ func initFrontendMetrics() {
 
 func initPipelineMetrics() {
 	registry.MustRegister(PipelineServerDurationHistogram)
+	registry.MustRegister(PipelineAdmissionWaitDurationHistogram)
+	registry.MustRegister(pipelineAdmissionQueueSizeGauge)
 }
 
 func getDurationBuckets() []float64 {
@@ -25,4 +25,23 @@ var (
 			Help:      "Bucketed histogram of server processing duration seconds of pipeline.",
 			Buckets:   getDurationBuckets(),
 		})
+
+	PipelineAdmissionWaitDurationHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mo",
+			Subsystem: "pipeline",
+			Name:      "admission_wait_duration_seconds",
+			Help:      "Bucketed histogram of the duration seconds the statements wait in the admission queue.",
+			Buckets:   getDurationBuckets(),
+		})
+
+	pipelineAdmissionQueueSizeGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mo",
+			Subsystem: "pipeline",
+			Name:      "admission_queue_size",
+			Help:      "Size of the admission queues.",
+		}, []string{"type"})
+	PipelineAdmissionRunningQueueSizeGauge = pipelineAdmissionQueueSizeGauge.WithLabelValues("running")
+	PipelineAdmissionWaitingQueueSizeGauge = pipelineAdmissionQueueSizeGauge.WithLabelValues("waiting")
 )
@@ -185,6 +185,7 @@ var (
 	TxnStatementBuildReplaceHistogram           = txnStatementDurationHistogram.WithLabelValues("BuildReplace")
 	TxnStatementBuildUpdateHistogram            = txnStatementDurationHistogram.WithLabelValues("BuildUpdate")
 	TxnStatementBuildDeleteHistogram            = txnStatementDurationHistogram.WithLabelValues("BuildDelete")
+	TxnStatementBuildMergeHistogram             = txnStatementDurationHistogram.WithLabelValues("BuildMerge")
 	TxnStatementBuildLoadHistogram              = txnStatementDurationHistogram.WithLabelValues("BuildLoad")
 
 	txnLockDurationHistogram = prometheus.NewHistogramVec(
@@ -0,0 +1,30 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import "strings"
+
+// EscapeSQLString escapes the string to be put between the single quotes of a
+// string literal in the SQL, so it can neither end the literal nor change it.
+func EscapeSQLString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+// EscapeSQLIdent escapes the name to be put between the backticks of a quoted
+// identifier in the SQL, so it can neither end the identifier nor change it.
+func EscapeSQLIdent(s string) string {
+	return strings.ReplaceAll(s, "`", "``")
+}
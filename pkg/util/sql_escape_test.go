@@ -0,0 +1,33 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeSQLString(t *testing.T) {
+	require.Equal(t, "abc", EscapeSQLString("abc"))
+	require.Equal(t, "it''s", EscapeSQLString("it's"))
+	require.Equal(t, `a\\'' or 1=1 -- `, EscapeSQLString(`a\' or 1=1 -- `))
+}
+
+func TestEscapeSQLIdent(t *testing.T) {
+	require.Equal(t, "abc", EscapeSQLIdent("abc"))
+	require.Equal(t, "a``b", EscapeSQLIdent("a`b"))
+	require.Equal(t, "t`` where 1=1 -- ", EscapeSQLIdent("t` where 1=1 -- "))
+}
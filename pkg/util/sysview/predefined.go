@@ -222,7 +222,7 @@ var (
 	InformationSchemaProcesslistDDL = fmt.Sprintf("CREATE VIEW %s.PROCESSLIST AS "+
 		"select node_id, conn_id, session_id, account, user, host, db, "+
 		"session_start, command, info, txn_id, statement_id, statement_type, "+
-		"query_type, sql_source_type, query_start, client_host, role, proxy_host "+
+		"query_type, sql_source_type, query_start, client_host, role, proxy_host, queue_wait_time "+
 		"from PROCESSLIST() A", InformationDBConst)
 
 	InformationSchemaUserPrivilegesDDL = "CREATE TABLE information_schema.USER_PRIVILEGES (" +
@@ -248,30 +248,30 @@ var (
 		"MAXLEN int unsigned" +
 		")"
 
-	InformationSchemaTriggersDDL = "CREATE TABLE information_schema.TRIGGERS (" +
-		"TRIGGER_CATALOG varchar(64)," +
-		"TRIGGER_SCHEMA varchar(64)," +
-		"TRIGGER_NAME varchar(64)," +
-		"EVENT_MANIPULATION varchar(10)," +
-		"EVENT_OBJECT_CATALOG varchar(64)," +
-		"EVENT_OBJECT_SCHEMA varchar(64)," +
-		"EVENT_OBJECT_TABLE varchar(64)," +
-		"ACTION_ORDER int unsigned," +
-		"ACTION_CONDITION binary(0)," +
-		"ACTION_STATEMENT longtext," +
-		"ACTION_ORIENTATION varchar(3)," +
-		"ACTION_TIMING varchar(10)," +
-		"ACTION_REFERENCE_OLD_TABLE binary(0)," +
-		"ACTION_REFERENCE_NEW_TABLE binary(0)," +
-		"ACTION_REFERENCE_OLD_ROW varchar(3)," +
-		"ACTION_REFERENCE_NEW_ROW varchar(3)," +
-		"CREATED timestamp(2)," +
-		"SQL_MODE varchar(10)," +
-		"DEFINER varchar(288)," +
-		"CHARACTER_SET_CLIENT varchar(64)," +
-		"COLLATION_CONNECTION varchar(64)," +
-		"DATABASE_COLLATION varchar(64)" +
-		")"
+	InformationSchemaTriggersDDL = "CREATE VIEW information_schema.TRIGGERS AS " +
+		"SELECT 'def' AS TRIGGER_CATALOG," +
+		"database_name AS TRIGGER_SCHEMA," +
+		"trigger_name AS TRIGGER_NAME," +
+		"event_manipulation AS EVENT_MANIPULATION," +
+		"'def' AS EVENT_OBJECT_CATALOG," +
+		"database_name AS EVENT_OBJECT_SCHEMA," +
+		"table_name AS EVENT_OBJECT_TABLE," +
+		"action_order AS ACTION_ORDER," +
+		"NULL AS ACTION_CONDITION," +
+		"action_statement AS ACTION_STATEMENT," +
+		"'ROW' AS ACTION_ORIENTATION," +
+		"action_timing AS ACTION_TIMING," +
+		"NULL AS ACTION_REFERENCE_OLD_TABLE," +
+		"NULL AS ACTION_REFERENCE_NEW_TABLE," +
+		"'OLD' AS ACTION_REFERENCE_OLD_ROW," +
+		"'NEW' AS ACTION_REFERENCE_NEW_ROW," +
+		"created_time AS CREATED," +
+		"'' AS SQL_MODE," +
+		"NULL AS DEFINER," +
+		"'utf8mb4' AS CHARACTER_SET_CLIENT," +
+		"'utf8mb4_0900_ai_ci' AS COLLATION_CONNECTION," +
+		"'utf8mb4_0900_ai_ci' AS DATABASE_COLLATION " +
+		"FROM mo_catalog.mo_triggers"
 
 	InformationSchemaTablesDDL = fmt.Sprintf("CREATE VIEW information_schema.TABLES AS "+
 		"SELECT 'def' AS TABLE_CATALOG,"+
@@ -510,7 +510,62 @@ var (
 		"ENFORCED varchar(3) NOT NULL DEFAULT ''" +
 		")"
 
-	InformationSchemaEventsDDL = "CREATE TABLE information_schema.EVENTS (" +
+	InformationSchemaEventsDDL = "CREATE VIEW information_schema.EVENTS AS " +
+		"SELECT 'def' AS EVENT_CATALOG," +
+		"database_name AS EVENT_SCHEMA," +
+		"event_name AS EVENT_NAME," +
+		"definer AS `DEFINER`," +
+		"time_zone AS TIME_ZONE," +
+		"'SQL' AS EVENT_BODY," +
+		"event_body AS EVENT_DEFINITION," +
+		"event_type AS EVENT_TYPE," +
+		"execute_at AS EXECUTE_AT," +
+		"interval_value AS INTERVAL_VALUE," +
+		"interval_field AS INTERVAL_FIELD," +
+		"'' AS SQL_MODE," +
+		"starts AS STARTS," +
+		"ends AS ENDS," +
+		"status AS STATUS," +
+		"on_completion AS ON_COMPLETION," +
+		"created AS CREATED," +
+		"last_altered AS LAST_ALTERED," +
+		"last_executed AS LAST_EXECUTED," +
+		"event_comment AS EVENT_COMMENT," +
+		"0 AS ORIGINATOR," +
+		"'utf8mb4' AS CHARACTER_SET_CLIENT," +
+		"'utf8mb4_0900_ai_ci' AS COLLATION_CONNECTION," +
+		"'utf8mb4_0900_ai_ci' AS DATABASE_COLLATION " +
+		"FROM mo_catalog.mo_events"
+
+	InformationSchemaOnlineDDLProgressDDL = "CREATE VIEW information_schema.ONLINE_DDL_PROGRESS AS " +
+		"SELECT job_id AS JOB_ID," +
+		"database_name AS TABLE_SCHEMA," +
+		"table_name AS TABLE_NAME," +
+		"statement AS STATEMENT," +
+		"phase AS PHASE," +
+		"rows_total AS ROWS_TOTAL," +
+		"rows_copied AS ROWS_COPIED," +
+		"changes_replayed AS CHANGES_REPLAYED," +
+		"rounds AS ROUNDS," +
+		"error_message AS ERROR_MESSAGE," +
+		"start_time AS START_TIME," +
+		"update_time AS UPDATE_TIME " +
+		"FROM mo_catalog.mo_online_ddl"
+
+	InformationSchemaAnalyzeStatusDDL = fmt.Sprintf("CREATE VIEW information_schema.ANALYZE_STATUS AS "+
+		"SELECT tbl.reldatabase AS TABLE_SCHEMA,"+
+		"tbl.relname AS TABLE_NAME,"+
+		"if(ana.auto_analyze is null or ana.auto_analyze, 'YES', 'NO') AS AUTO_ANALYZE,"+
+		"ana.analyzed_rows AS ANALYZED_ROWS,"+
+		"ana.analyze_type AS LAST_ANALYZE_TYPE,"+
+		"ana.analyze_time AS LAST_ANALYZE_TIME "+
+		"FROM mo_catalog.mo_tables tbl LEFT JOIN mo_catalog.mo_table_analyze ana ON tbl.rel_id = ana.table_id "+
+		"WHERE tbl.account_id = current_account_id() and tbl.relkind = 'r' and tbl.relname not like '%s' "+
+		"and tbl.reldatabase not in ('mo_catalog', 'information_schema', 'system', 'system_metrics', 'mysql', 'mo_task')",
+		catalog.IndexTableNamePrefix+"%")
+
+	// InformationSchemaEventsTableDDL is the empty EVENTS table before the events are supported
+	InformationSchemaEventsTableDDL = "CREATE TABLE information_schema.EVENTS (" +
 		"EVENT_CATALOG varchar(64)," +
 		"EVENT_SCHEMA varchar(64)," +
 		"EVENT_NAME varchar(64) NOT NULL," +
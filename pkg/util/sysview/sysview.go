@@ -64,6 +64,8 @@ var (
 		InformationSchemaCollationsDDL,
 		InformationSchemaTableConstraintsDDL,
 		InformationSchemaEventsDDL,
+		InformationSchemaOnlineDDLProgressDDL,
+		InformationSchemaAnalyzeStatusDDL,
 		InformationSchemaFilesDDL,
 		informationSchemaKeywordsData,
 	}
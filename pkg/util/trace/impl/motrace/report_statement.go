@@ -530,6 +530,13 @@ func (s *StatementInfo) ExecPlan2Stats(ctx context.Context) error {
 	return nil
 }
 
+// SetPeakMemory records the max memory in bytes used by the statement.
+func (s *StatementInfo) SetPeakMemory(peak int64) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.statsArray.InitIfEmpty().WithPeakMemory(float64(peak))
+}
+
 func (s *StatementInfo) GetStatsArrayBytes() []byte {
 	return s.statsArray.ToJsonString()
 }
@@ -322,7 +322,7 @@ func TestMergeStats(t *testing.T) {
 		t.Fatalf("mergeStats failed: %v", err)
 	}
 
-	wantBytes := []byte("[4,228295,3600.000,1,0,0,1,3,0]")
+	wantBytes := []byte("[5,228295,3600.000,1,0,0,1,3,0,0]")
 	require.Equal(t, wantBytes, e.statsArray.ToJsonString())
 
 	n = &StatementInfo{}
@@ -333,7 +333,7 @@ func TestMergeStats(t *testing.T) {
 		t.Fatalf("mergeStats failed: %v", err)
 	}
 
-	wantBytes = []byte("[4,228296,3601.000,1,0,0,1,13,1.1234]")
+	wantBytes = []byte("[5,228296,3601.000,1,0,0,1,13,1.1234,0]")
 	require.Equal(t, wantBytes, e.statsArray.ToJsonString())
 
 }
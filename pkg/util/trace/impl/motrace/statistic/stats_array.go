@@ -38,6 +38,7 @@ const (
 	StatsArrayVersion2 = 2 // float64 array + plus one elem OutTrafficBytes
 	StatsArrayVersion3 = 3 // ... + 1 elem: ConnType
 	StatsArrayVersion4 = 4 // ... + 2 elem: OutPacketCount, CU
+	StatsArrayVersion5 = 5 // ... + 1 elem: PeakMemory
 
 	StatsArrayVersionLatest // same value as last variable StatsArrayVersion#
 )
@@ -52,6 +53,7 @@ const (
 	StatsArrayIndexConnType        // index: 6
 	StatsArrayIndexOutPacketCnt    // index: 7, version: 4
 	StatsArrayIndexCU              // index: 8, version: 4
+	StatsArrayIndexPeakMemory      // index: 9, version: 5
 
 	StatsArrayLength
 )
@@ -61,6 +63,7 @@ const (
 	StatsArrayLengthV2 = 6
 	StatsArrayLengthV3 = 7
 	StatsArrayLengthV4 = 9
+	StatsArrayLengthV5 = 10
 )
 
 type ConnType float64
@@ -92,6 +95,10 @@ func NewStatsArrayV4() *StatsArray {
 	return NewStatsArray().WithVersion(StatsArrayVersion4)
 }
 
+func NewStatsArrayV5() *StatsArray {
+	return NewStatsArray().WithVersion(StatsArrayVersion5)
+}
+
 func (s *StatsArray) Init() *StatsArray {
 	return s.WithVersion(StatsArrayVersion)
 }
@@ -140,6 +147,15 @@ func (s *StatsArray) GetCU() float64 {
 	return s[StatsArrayIndexCU]
 }
 
+// GetPeakMemory returns the max memory used by the statement on the CN
+// received it, unit: byte
+func (s *StatsArray) GetPeakMemory() float64 {
+	if s.GetVersion() < StatsArrayVersion5 {
+		return 0
+	}
+	return s[StatsArrayIndexPeakMemory]
+}
+
 // WithVersion set the version array in StatsArray, please carefully to use.
 func (s *StatsArray) WithVersion(v float64) *StatsArray { (*s)[StatsArrayIndexVersion] = v; return s }
 func (s *StatsArray) WithTimeConsumed(v float64) *StatsArray {
@@ -182,6 +198,13 @@ func (s *StatsArray) WithCU(v float64) *StatsArray {
 	return s
 }
 
+func (s *StatsArray) WithPeakMemory(v float64) *StatsArray {
+	if s.GetVersion() >= StatsArrayVersion5 {
+		s[StatsArrayIndexPeakMemory] = v
+	}
+	return s
+}
+
 func (s *StatsArray) ToJsonString() []byte {
 	switch s.GetVersion() {
 	case StatsArrayVersion1:
@@ -192,20 +215,27 @@ func (s *StatsArray) ToJsonString() []byte {
 		return StatsArrayToJsonString((*s)[:StatsArrayLengthV3])
 	case StatsArrayVersion4:
 		return StatsArrayToJsonString((*s)[:StatsArrayLengthV4])
+	case StatsArrayVersion5:
+		return StatsArrayToJsonString((*s)[:StatsArrayLengthV5])
 	default:
 		return StatsArrayToJsonString((*s)[:])
 	}
 }
 
 // Add do add two stats array together
-// except for Element ConnType, which idx = StatsArrayIndexConnType, just keep s[StatsArrayIndexConnType] value.
+// except for Element ConnType, which idx = StatsArrayIndexConnType, just keep s[StatsArrayIndexConnType] value,
+// and Element PeakMemory, which keeps the max value.
 func (s *StatsArray) Add(delta *StatsArray) *StatsArray {
 	dstLen := len(*delta)
 	if len(*s) < len(*delta) {
 		dstLen = len(*s)
 	}
 	for idx := 1; idx < dstLen; idx++ {
-		if idx == StatsArrayIndexConnType {
+		switch idx {
+		case StatsArrayIndexConnType:
+			continue
+		case StatsArrayIndexPeakMemory:
+			(*s)[idx] = max((*s)[idx], (*delta)[idx])
 			continue
 		}
 		(*s)[idx] += (*delta)[idx]
@@ -298,6 +298,18 @@ func TestStatsArray_AddV3(t *testing.T) {
 	}
 }
 
+func TestStatsArray_AddV5(t *testing.T) {
+	dst := NewStatsArrayV5().WithTimeConsumed(1).WithPeakMemory(1024)
+	s := NewStatsArrayV5().WithTimeConsumed(2).WithPeakMemory(512)
+	require.Equal(t, []byte(`[5,3,0,0,0,0,0,0,0,1024]`), dst.Add(s).ToJsonString())
+	require.Equal(t, float64(1024), dst.GetPeakMemory())
+
+	s = NewStatsArrayV5().WithPeakMemory(4096)
+	require.Equal(t, float64(4096), dst.Add(s).GetPeakMemory())
+	// the versions before 5 have no peak memory
+	require.Equal(t, float64(0), NewStatsArrayV4().WithPeakMemory(4096).GetPeakMemory())
+}
+
 func TestStatsArray_InitIfEmpty(t *testing.T) {
 	tests := []struct {
 		name string
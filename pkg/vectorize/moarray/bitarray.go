@@ -0,0 +1,52 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moarray
+
+import (
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// These functions work on the packed bit vectors of vecbit, see types.T_array_bit.
+
+// HammingDistance returns the number of dimensions in which v1 and v2 differ.
+func HammingDistance(v1, v2 []byte) (float64, error) {
+	if len(v1) != len(v2) {
+		return 0, moerr.NewArrayInvalidOpNoCtx(len(v1)*types.BitArrayDimensionUnit, len(v2)*types.BitArrayDimensionUnit)
+	}
+	diff := 0
+	for i := range v1 {
+		diff += bits.OnesCount8(v1[i] ^ v2[i])
+	}
+	return float64(diff), nil
+}
+
+// JaccardDistance returns 1 - |v1 AND v2| / |v1 OR v2|. Two empty sets have a distance of 0.
+func JaccardDistance(v1, v2 []byte) (float64, error) {
+	if len(v1) != len(v2) {
+		return 0, moerr.NewArrayInvalidOpNoCtx(len(v1)*types.BitArrayDimensionUnit, len(v2)*types.BitArrayDimensionUnit)
+	}
+	intersection, union := 0, 0
+	for i := range v1 {
+		intersection += bits.OnesCount8(v1[i] & v2[i])
+		union += bits.OnesCount8(v1[i] | v2[i])
+	}
+	if union == 0 {
+		return 0, nil
+	}
+	return 1 - float64(intersection)/float64(union), nil
+}
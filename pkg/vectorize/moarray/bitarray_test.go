@@ -0,0 +1,48 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moarray
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHammingDistance(t *testing.T) {
+	d, err := HammingDistance([]byte{0b10110000}, []byte{0b10010001})
+	require.NoError(t, err)
+	require.Equal(t, float64(2), d)
+
+	d, err = HammingDistance([]byte{0xff, 0x00}, []byte{0xff, 0x00})
+	require.NoError(t, err)
+	require.Equal(t, float64(0), d)
+
+	_, err = HammingDistance([]byte{0xff}, []byte{0xff, 0x00})
+	require.Error(t, err)
+}
+
+func TestJaccardDistance(t *testing.T) {
+	// intersection 2, union 4
+	d, err := JaccardDistance([]byte{0b11100000}, []byte{0b01110000})
+	require.NoError(t, err)
+	require.Equal(t, 0.5, d)
+
+	d, err = JaccardDistance([]byte{0}, []byte{0})
+	require.NoError(t, err)
+	require.Equal(t, float64(0), d)
+
+	_, err = JaccardDistance([]byte{0xff}, []byte{0xff, 0x00})
+	require.Error(t, err)
+}
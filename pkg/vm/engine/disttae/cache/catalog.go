@@ -19,6 +19,7 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
@@ -54,6 +55,24 @@ func NewCatalog() *CatalogCache {
 	}
 }
 
+// catalogVersion is the last version of the catalogs, the versions are not
+// reused by the catalog rebuilt.
+var catalogVersion atomic.Uint64
+
+// bumpAccountVersion changes the catalog version of the account.
+func (cc *CatalogCache) bumpAccountVersion(accountID uint32) {
+	cc.accountVersions.Store(accountID, catalogVersion.Add(1))
+}
+
+// AccountVersion returns the catalog version of the account, which is changed
+// by the DDL of the account executed in any CN.
+func (cc *CatalogCache) AccountVersion(accountID uint32) uint64 {
+	if v, ok := cc.accountVersions.Load(accountID); ok {
+		return v.(uint64)
+	}
+	return 0
+}
+
 func (cc *CatalogCache) UpdateDuration(start types.TS, end types.TS) {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
@@ -399,6 +418,7 @@ func (cc *CatalogCache) DeleteTable(bat *batch.Batch) {
 				Ts:         timestamps[i].ToTimestamp(),
 			}
 			cc.tables.addTableItem(newItem)
+			cc.bumpAccountVersion(item.AccountId)
 
 			key := TableKey{
 				AccountId:  item.AccountId,
@@ -439,6 +459,7 @@ func (cc *CatalogCache) DeleteDatabase(bat *batch.Batch) {
 				Ts:        timestamps[i].ToTimestamp(),
 			}
 			cc.databases.data.Set(newItem)
+			cc.bumpAccountVersion(item.AccountId)
 		}
 	}
 }
@@ -520,6 +541,7 @@ func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
 		})
 		cc.tables.addTableItem(item)
 		cc.tables.rowidIndex.Set(item)
+		cc.bumpAccountVersion(account)
 	}
 }
 
@@ -634,6 +656,7 @@ func (cc *CatalogCache) InsertDatabase(bat *batch.Batch) {
 		copy(item.Rowid[:], rowids[i][:])
 		cc.databases.data.Set(item)
 		cc.databases.rowidIndex.Set(item)
+		cc.bumpAccountVersion(account)
 	}
 }
 
@@ -96,12 +96,19 @@ func TestTables(t *testing.T) {
 			databaseIds[i] = 12
 		}
 	}
+	require.Equal(t, uint64(0), cc.AccountVersion(1))
 	cc.InsertTable(bat)
 	tblList, tblIdList := cc.Tables(1, 12, timestamp.Timestamp{
 		PhysicalTime: 100,
 	})
 	require.Equal(t, 10, len(tblList))
 	require.Equal(t, 10, len(tblIdList))
+	// the tables changed change the catalog version of their account
+	version := cc.AccountVersion(1)
+	require.NotEqual(t, uint64(0), version)
+	require.Equal(t, uint64(0), cc.AccountVersion(2))
+	cc.InsertTable(bat)
+	require.Greater(t, cc.AccountVersion(1), version)
 	bat.Clean(mp)
 	require.Equal(t, int64(0), mp.CurrNB())
 }
@@ -57,6 +57,9 @@ type CatalogCache struct {
 	//tables and database is safe to be read concurrently.
 	tables    *tableCache
 	databases *databaseCache
+	// accountVersions is the version of the catalog of the accounts, it is
+	// changed when the tables or databases of the account are changed.
+	accountVersions sync.Map // uint32 -> uint64
 }
 
 // database cache:
@@ -0,0 +1,460 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+// ChangeCapture collects the primary keys of the rows changed in a table from
+// the logtail pushed to this CN. Online DDL copies a snapshot of the table and
+// uses the captured keys to find the rows changed after the snapshot.
+//
+// The keys are encoded like the primary index of the partition state. A key may
+// be captured more than once, or captured although the row is not changed (e.g.
+// the objects written by CN are read fully), so the consumers must replay the
+// keys idempotently.
+type ChangeCapture struct {
+	tableID  uint64
+	pkSeqnum uint16
+	pkType   types.Type
+	fs       fileservice.FileService
+	mp       *mpool.MPool
+	tailC    chan<- captureTask
+
+	mu struct {
+		sync.Mutex
+		// keys is the captured primary keys and their latest commit ts.
+		keys map[string]types.TS
+		// objects are the objects written to S3 by CN directly, the primary keys
+		// of them are read when collected.
+		objects []capturedObject
+		// tombstones are the deletes written to S3 by CN directly.
+		tombstones []capturedTombstone
+		// err is set if some changes of the table can not be captured.
+		err error
+	}
+}
+
+// captureTask is a logtail to capture, or a flush of the tasks queued before it
+// if done is not nil.
+type captureTask struct {
+	ccs           []*ChangeCapture
+	primarySeqnum int
+	tail          *logtail.TableLogtail
+	done          chan struct{}
+}
+
+type capturedObject struct {
+	stats    objectio.ObjectStats
+	commitTS types.TS
+}
+
+type capturedTombstone struct {
+	location objectio.Location
+	commitTS types.TS
+}
+
+// StartChangeCapture subscribes the table and starts to capture the changes of
+// it from the logtail. The caller must call StopChangeCapture when the capture
+// is no longer needed.
+func (e *Engine) StartChangeCapture(
+	ctx context.Context,
+	databaseID, tableID uint64,
+	pkSeqnum uint16,
+	pkType types.Type,
+) (*ChangeCapture, error) {
+	if err := e.pClient.TryToSubscribeTable(ctx, databaseID, tableID); err != nil {
+		return nil, err
+	}
+	cc := &ChangeCapture{
+		tableID:  tableID,
+		pkSeqnum: pkSeqnum,
+		pkType:   pkType,
+		fs:       e.fs,
+		mp:       e.mp,
+		tailC:    e.captures.tailC,
+	}
+	cc.mu.keys = make(map[string]types.TS)
+
+	e.captures.Lock()
+	defer e.captures.Unlock()
+	if e.captures.m == nil {
+		e.captures.m = make(map[uint64][]*ChangeCapture)
+	}
+	e.captures.m[tableID] = append(e.captures.m[tableID], cc)
+	return cc, nil
+}
+
+// StopChangeCapture stops the capture started by StartChangeCapture.
+func (e *Engine) StopChangeCapture(cc *ChangeCapture) {
+	e.captures.Lock()
+	defer e.captures.Unlock()
+	ccs := e.captures.m[cc.tableID]
+	for i, c := range ccs {
+		if c == cc {
+			ccs = append(ccs[:i], ccs[i+1:]...)
+			break
+		}
+	}
+	if len(ccs) == 0 {
+		delete(e.captures.m, cc.tableID)
+	} else {
+		e.captures.m[cc.tableID] = ccs
+	}
+}
+
+func (e *Engine) getChangeCaptures(tableID uint64) []*ChangeCapture {
+	e.captures.Lock()
+	defer e.captures.Unlock()
+	if len(e.captures.m) == 0 {
+		return nil
+	}
+	return append([]*ChangeCapture(nil), e.captures.m[tableID]...)
+}
+
+// captureChanges is called after the logtail of a table is consumed, it only
+// queues the logtail, which is captured by captureWorker.
+func (e *Engine) captureChanges(primarySeqnum int, tl *logtail.TableLogtail) {
+	ccs := e.getChangeCaptures(tl.Table.GetTbId())
+	if len(ccs) == 0 {
+		return
+	}
+	select {
+	case e.captures.tailC <- captureTask{ccs: ccs, primarySeqnum: primarySeqnum, tail: tl}:
+	default:
+		for _, cc := range ccs {
+			cc.abort("the channel of logtails is full")
+		}
+	}
+}
+
+func (e *Engine) captureWorker(ctx context.Context) {
+	packer := types.NewPacker(e.mp)
+	defer packer.FreeMem()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case task := <-e.captures.tailC:
+			if task.done != nil {
+				close(task.done)
+				continue
+			}
+			for i := range task.tail.Commands {
+				for _, cc := range task.ccs {
+					cc.capture(&task.tail.Commands[i], task.primarySeqnum, packer)
+				}
+			}
+		}
+	}
+}
+
+// flush waits until the logtails queued before are captured.
+func (cc *ChangeCapture) flush(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case cc.tailC <- captureTask{done: done}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// abortChangeCaptures marks the captures of the table failed, all of them are
+// failed if tableID is 0. It is called when the logtail of the table will not
+// be received any more, e.g. the table is unsubscribed.
+func (e *Engine) abortChangeCaptures(tableID uint64, reason string) {
+	e.captures.Lock()
+	defer e.captures.Unlock()
+	for id, ccs := range e.captures.m {
+		if tableID != 0 && id != tableID {
+			continue
+		}
+		for _, cc := range ccs {
+			cc.abort(reason)
+		}
+	}
+}
+
+func (cc *ChangeCapture) capture(entry *api.Entry, primarySeqnum int, packer *types.Packer) {
+	if entry.EntryType == api.Entry_Delete && !logtailreplay.IsMetaTable(entry.TableName) &&
+		len(entry.Bat.Vecs) <= 2 {
+		cc.abort("deletes without primary key")
+		return
+	}
+	bat, err := batch.ProtoBatchToBatch(entry.Bat)
+	if err != nil {
+		cc.fail(err)
+		return
+	}
+	switch {
+	case logtailreplay.IsBlkTable(entry.TableName):
+		if entry.EntryType == api.Entry_Insert {
+			cc.captureTombstones(bat)
+		}
+	case logtailreplay.IsObjTable(entry.TableName):
+		if entry.EntryType == api.Entry_Insert {
+			cc.captureObjects(bat)
+		}
+	case entry.EntryType == api.Entry_Insert:
+		cc.captureKeys(bat.Vecs[1], bat.Vecs[2+primarySeqnum], packer)
+	case entry.EntryType == api.Entry_Delete:
+		cc.captureKeys(bat.Vecs[1], bat.Vecs[2], packer)
+	}
+}
+
+func (cc *ChangeCapture) captureKeys(tsVec, pkVec *vector.Vector, packer *types.Packer) {
+	timestamps := vector.MustFixedCol[types.TS](tsVec)
+	keys := logtailreplay.EncodePrimaryKeyVector(pkVec, packer)
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for i, key := range keys {
+		cc.addKeyLocked(key, timestamps[i])
+	}
+}
+
+func (cc *ChangeCapture) addKeyLocked(key []byte, ts types.TS) {
+	if old, ok := cc.mu.keys[string(key)]; !ok || old.Less(&ts) {
+		cc.mu.keys[string(key)] = ts
+	}
+}
+
+// captureObjects captures the objects written by CN. The appendable objects
+// are flushed by TN and the rows of them have been captured from the logtail,
+// and the objects created together with deleted objects are the results of
+// merging, so both are skipped.
+func (cc *ChangeCapture) captureObjects(bat *batch.Batch) {
+	statsVec := bat.Vecs[2]
+	stateCol := vector.MustFixedCol[bool](bat.Vecs[3])
+	deleteTSCol := vector.MustFixedCol[types.TS](bat.Vecs[8])
+	commitTSCol := vector.MustFixedCol[types.TS](bat.Vecs[11])
+
+	for i := range deleteTSCol {
+		if !deleteTSCol[i].IsEmpty() {
+			return
+		}
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for i, appendable := range stateCol {
+		if appendable {
+			continue
+		}
+		stats := objectio.ObjectStats(statsVec.GetBytesAt(i))
+		if stats.BlkCnt() == 0 || stats.Rows() == 0 {
+			continue
+		}
+		cc.mu.objects = append(cc.mu.objects, capturedObject{
+			stats:    stats,
+			commitTS: commitTSCol[i],
+		})
+	}
+}
+
+// captureTombstones captures the delta locations of the blocks, only the ones
+// persisted by CN are read when collected, the others are flushed by TN and the
+// deletes of them have been captured from the logtail.
+func (cc *ChangeCapture) captureTombstones(bat *batch.Batch) {
+	deltaLocationVector := bat.Vecs[6]
+	commitTimeVector := vector.MustFixedCol[types.TS](bat.Vecs[7])
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for i := range commitTimeVector {
+		location := objectio.Location(deltaLocationVector.GetBytesAt(i))
+		if location.IsEmpty() {
+			continue
+		}
+		cc.mu.tombstones = append(cc.mu.tombstones, capturedTombstone{
+			location: append(objectio.Location(nil), location...),
+			commitTS: commitTimeVector[i],
+		})
+	}
+}
+
+func (cc *ChangeCapture) abort(reason string) {
+	cc.fail(moerr.NewInternalErrorNoCtx("the changes of table %d can not be captured: %s", cc.tableID, reason))
+}
+
+func (cc *ChangeCapture) fail(err error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.mu.err == nil {
+		logutil.Warnf("change capture of table %d failed: %v", cc.tableID, err)
+		cc.mu.err = err
+	}
+}
+
+// Err returns the error if some changes of the table were not captured.
+func (cc *ChangeCapture) Err() error {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.mu.err
+}
+
+// Pending returns the number of the captured keys not collected yet.
+func (cc *ChangeCapture) Pending() int {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return len(cc.mu.keys)
+}
+
+// Collect returns and removes the keys captured with commit ts not greater
+// than ts. The keys committed later are kept for the next collection. All the
+// logtails applied before it is called are captured.
+func (cc *ChangeCapture) Collect(ctx context.Context, ts types.TS) ([][]byte, error) {
+	if err := cc.flush(ctx); err != nil {
+		return nil, err
+	}
+	objects, tombstones, err := cc.takeLocations(ts)
+	if err != nil {
+		return nil, err
+	}
+
+	packer := types.NewPacker(cc.mp)
+	defer packer.FreeMem()
+	for _, obj := range objects {
+		if err = cc.readObjectKeys(ctx, obj, packer); err != nil {
+			return nil, err
+		}
+	}
+	for _, tombstone := range tombstones {
+		if err = cc.readTombstoneKeys(ctx, tombstone, packer); err != nil {
+			return nil, err
+		}
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.mu.err != nil {
+		return nil, cc.mu.err
+	}
+	keys := make([][]byte, 0, len(cc.mu.keys))
+	for key, keyTS := range cc.mu.keys {
+		if keyTS.LessEq(&ts) {
+			keys = append(keys, []byte(key))
+			delete(cc.mu.keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// Requeue puts the keys back, they will be returned by the next collection.
+// It is used when the keys collected are failed to be replayed.
+func (cc *ChangeCapture) Requeue(keys [][]byte) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for _, key := range keys {
+		cc.addKeyLocked(key, types.TS{})
+	}
+}
+
+func (cc *ChangeCapture) takeLocations(ts types.TS) ([]capturedObject, []capturedTombstone, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.mu.err != nil {
+		return nil, nil, cc.mu.err
+	}
+
+	var objects []capturedObject
+	remainObjects := cc.mu.objects[:0]
+	for _, obj := range cc.mu.objects {
+		if obj.commitTS.LessEq(&ts) {
+			objects = append(objects, obj)
+		} else {
+			remainObjects = append(remainObjects, obj)
+		}
+	}
+	cc.mu.objects = remainObjects
+
+	var tombstones []capturedTombstone
+	remainTombstones := cc.mu.tombstones[:0]
+	for _, tombstone := range cc.mu.tombstones {
+		if tombstone.commitTS.LessEq(&ts) {
+			tombstones = append(tombstones, tombstone)
+		} else {
+			remainTombstones = append(remainTombstones, tombstone)
+		}
+	}
+	cc.mu.tombstones = remainTombstones
+	return objects, tombstones, nil
+}
+
+func (cc *ChangeCapture) readObjectKeys(ctx context.Context, obj capturedObject, packer *types.Packer) error {
+	name := obj.stats.ObjectName()
+	extent := obj.stats.Extent()
+	blkCnt := obj.stats.BlkCnt()
+	for i := uint32(0); i < blkCnt; i++ {
+		rows := options.DefaultBlockMaxRows
+		if i == blkCnt-1 {
+			rows = obj.stats.Rows() - options.DefaultBlockMaxRows*i
+		}
+		location := objectio.BuildLocation(name, extent, rows, uint16(i))
+		bat, release, err := blockio.LoadColumns(ctx, []uint16{cc.pkSeqnum}, []types.Type{cc.pkType},
+			cc.fs, location, cc.mp, fileservice.Policy(0))
+		if err != nil {
+			return err
+		}
+		cc.addKeys(bat.Vecs[0], obj.commitTS, packer)
+		release()
+	}
+	return nil
+}
+
+func (cc *ChangeCapture) readTombstoneKeys(ctx context.Context, tombstone capturedTombstone, packer *types.Packer) error {
+	bat, isPersistedByCN, release, err := blockio.ReadBlockDelete(ctx, tombstone.location, cc.fs)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if isPersistedByCN {
+		cc.addKeys(bat.Vecs[1], tombstone.commitTS, packer)
+	}
+	return nil
+}
+
+func (cc *ChangeCapture) addKeys(pkVec *vector.Vector, ts types.TS, packer *types.Packer) {
+	keys := logtailreplay.EncodePrimaryKeyVector(pkVec, packer)
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for _, key := range keys {
+		cc.addKeyLocked(key, ts)
+	}
+}
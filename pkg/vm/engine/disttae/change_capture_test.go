@@ -0,0 +1,136 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+)
+
+func newRowEntryForTest(t *testing.T, mp *mpool.MPool, typ api.Entry_EntryType, pks []int64, ts int64) *api.Entry {
+	bat := batch.New(true, []string{"rowid", "commit_time", "a"})
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_int64.ToType())
+	for _, pk := range pks {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], types.RandomRowid(), false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.BuildTS(ts, 0), false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[2], pk, false, mp))
+	}
+	bat.SetRowCount(len(pks))
+	pbat, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	return &api.Entry{
+		EntryType: typ,
+		TableName: "t",
+		Bat:       pbat,
+	}
+}
+
+func newChangeCaptureForTest(t *testing.T, mp *mpool.MPool) (*Engine, *ChangeCapture) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	e := &Engine{mp: mp}
+	e.captures.tailC = make(chan captureTask, 16)
+	go e.captureWorker(ctx)
+
+	cc := &ChangeCapture{tableID: 1, pkType: types.T_int64.ToType(), mp: mp, tailC: e.captures.tailC}
+	cc.mu.keys = make(map[string]types.TS)
+	e.captures.m = map[uint64][]*ChangeCapture{1: {cc}}
+	return e, cc
+}
+
+func newTableLogtailForTest(entries ...*api.Entry) *logtail.TableLogtail {
+	tl := &logtail.TableLogtail{Table: &api.TableID{TbId: 1}}
+	for _, entry := range entries {
+		tl.Commands = append(tl.Commands, *entry)
+	}
+	return tl
+}
+
+func decodeKeysForTest(t *testing.T, keys [][]byte) []int64 {
+	var pks []int64
+	for _, key := range keys {
+		tuple, err := types.Unpack(key)
+		require.NoError(t, err)
+		pks = append(pks, tuple[0].(int64))
+	}
+	return pks
+}
+
+func TestChangeCapture(t *testing.T) {
+	mp := mpool.MustNewZero()
+	e, cc := newChangeCaptureForTest(t, mp)
+
+	e.captureChanges(0, newTableLogtailForTest(
+		newRowEntryForTest(t, mp, api.Entry_Insert, []int64{1, 2}, 10),
+		newRowEntryForTest(t, mp, api.Entry_Delete, []int64{2}, 20),
+	))
+	e.captureChanges(0, newTableLogtailForTest(
+		newRowEntryForTest(t, mp, api.Entry_Insert, []int64{3}, 30),
+	))
+
+	// the logtails queued are captured before collected, key 2 is changed
+	// again after ts 15, so it is kept is changed again after ts 15, so it is kept
+	keys, err := cc.Collect(context.Background(), types.BuildTS(15, 0))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, decodeKeysForTest(t, keys))
+
+	require.Equal(t, 2, cc.Pending())
+
+	keys, err = cc.Collect(context.Background(), types.BuildTS(30, 0))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int64{2, 3}, decodeKeysForTest(t, keys))
+	require.Equal(t, 0, cc.Pending())
+
+	// the keys failed to replay are collected again
+	cc.Requeue(keys)
+	keys, err = cc.Collect(context.Background(), types.BuildTS(1, 0))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int64{2, 3}, decodeKeysForTest(t, keys))
+}
+
+func TestChangeCaptureAbort(t *testing.T) {
+	mp := mpool.MustNewZero()
+	e, cc := newChangeCaptureForTest(t, mp)
+
+	entry := newRowEntryForTest(t, mp, api.Entry_Delete, []int64{1}, 10)
+	entry.Bat.Vecs = entry.Bat.Vecs[:2]
+	e.captureChanges(0, newTableLogtailForTest(entry))
+	_, err := cc.Collect(context.Background(), types.MaxTs())
+	require.Error(t, err)
+	require.Error(t, cc.Err())
+}
+
+func TestChangeCaptureQueueFull(t *testing.T) {
+	mp := mpool.MustNewZero()
+	e, cc := newChangeCaptureForTest(t, mp)
+	// the logtails are not captured in the path of applying them, the capture
+	// fails if the queue is full.
+	e.captures.tailC = make(chan captureTask)
+	e.captureChanges(0, newTableLogtailForTest(
+		newRowEntryForTest(t, mp, api.Entry_Insert, []int64{1}, 10),
+	))
+	require.Error(t, cc.Err())
+}
@@ -264,6 +264,12 @@ func (e *Engine) getLatestCatalogCache() *cache.CatalogCache {
 	return e.catalog
 }
 
+// CatalogVersion returns the catalog version of the account, which is changed
+// after the DDL of the account is applied from the logtail.
+func (e *Engine) CatalogVersion(accountID uint32) uint64 {
+	return e.getLatestCatalogCache().AccountVersion(accountID)
+}
+
 func (e *Engine) loadSnapCkpForTable(
 	ctx context.Context,
 	snapCatalog *cache.CatalogCache,
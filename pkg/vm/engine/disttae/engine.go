@@ -123,6 +123,9 @@ func New(
 
 	e.globalStats = NewGlobalStats(ctx, e, keyRouter)
 
+	e.captures.tailC = make(chan captureTask, 10000)
+	go e.captureWorker(ctx)
+
 	e.messageCenter = &process.MessageCenter{
 		StmtIDToBoard: make(map[uuid.UUID]*process.MessageBoard, 64),
 		RwMutex:       &sync.Mutex{},
@@ -534,6 +534,7 @@ func (c *PushClient) connect(ctx context.Context, e *Engine) {
 	e.setPushClientStatus(false)
 
 	c.stopConsumers()
+	e.abortChangeCaptures(0, "logtail client reconnected")
 
 	logutil.Infof("%s %s: clean finished, start to reconnect to tn log tail service", logTag, c.serviceID)
 	for {
@@ -1299,6 +1300,7 @@ func (cmd cmdToConsumeUnSub) action(ctx context.Context, e *Engine, _ *routineCo
 	table := cmd.log.Table
 	e.cleanMemoryTableWithTable(table.DbId, table.TbId)
 	e.pClient.subscribed.setTableUnsubscribe(table.DbId, table.TbId)
+	e.abortChangeCaptures(table.TbId, "table unsubscribed")
 	return nil
 }
 
@@ -1412,6 +1414,7 @@ func updatePartitionOfPush(
 		logutil.Errorf("%s consume %d-%s log tail error: %v\n", logTag, tblId, partition.TableInfo.Name, err)
 		return err
 	}
+	e.captureChanges(partition.TableInfo.PrimarySeqnum, tl)
 
 	//After consume checkpoints finished ,then update the start and end of
 	//the mo system table's partition and catalog.
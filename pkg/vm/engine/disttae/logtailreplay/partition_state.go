@@ -1098,6 +1098,54 @@ func (p *PartitionState) truncate(ids [2]uint64, ts types.TS) {
 	}
 }
 
+// ChangedRows calls fn with the versions of the rows inserted or deleted in
+// (from, to] until fn returns false. It returns false if some changes after
+// from are not kept in the rows of the state, i.e. the state is truncated after
+// from, or objects are flushed, merged or written by CN after from, or deletes
+// are persisted after from, the callers must read the table at the snapshots
+// then.
+func (p *PartitionState) ChangedRows(from, to types.TS, fn func(entry RowEntry) bool) bool {
+	if p.noData || from.Less(&p.minTS) || from.Less(&p.end) {
+		return false
+	}
+	if flushed := p.LastFlushTimestamp(); flushed.Greater(&from) {
+		return false
+	}
+
+	objIter := p.objectIndexByTS.Copy().Iter()
+	defer objIter.Release()
+	for ok := objIter.Seek(ObjectIndexByTSEntry{Time: from.Next()}); ok; ok = objIter.Next() {
+		// the appendable objects are created by TN for the rows appended
+		if entry := objIter.Item(); entry.IsDelete || !entry.IsAppendable {
+			return false
+		}
+	}
+
+	deltaIter := p.blockDeltas.Copy().Iter()
+	defer deltaIter.Release()
+	for ok := deltaIter.First(); ok; ok = deltaIter.Next() {
+		if entry := deltaIter.Item(); entry.CommitTs.Greater(&from) {
+			return false
+		}
+	}
+
+	rowIter := p.rows.Copy().Iter()
+	defer rowIter.Release()
+	for ok := rowIter.First(); ok; ok = rowIter.Next() {
+		entry := rowIter.Item()
+		if entry.Time.LessEq(&from) || entry.Time.Greater(&to) {
+			continue
+		}
+		if entry.Batch == nil || len(entry.PrimaryIndexBytes) == 0 {
+			return false
+		}
+		if !fn(entry) {
+			break
+		}
+	}
+	return true
+}
+
 func (p *PartitionState) LastFlushTimestamp() types.TS {
 	p.shared.Lock()
 	defer p.shared.Unlock()
@@ -492,3 +492,66 @@ func TestPrimaryKeyModifiedWithDeleteOnly(t *testing.T) {
 	}
 
 }
+
+func TestChangedRows(t *testing.T) {
+	state := NewPartitionState(false)
+	ctx := context.Background()
+	pool := mpool.MustNewZero()
+	packer := types.NewPacker(pool)
+	defer packer.FreeMem()
+
+	sid := objectio.NewSegmentid()
+	buildRowID := func(i int) types.Rowid {
+		blk := objectio.NewBlockid(sid, uint16(i), 0)
+		return *objectio.NewRowid(blk, uint32(0))
+	}
+	buildBatch := func(rows []int, ts int64) *api.Batch {
+		rowIDVec := vector.NewVec(types.T_Rowid.ToType())
+		tsVec := vector.NewVec(types.T_TS.ToType())
+		vec1 := vector.NewVec(types.T_int64.ToType())
+		for _, i := range rows {
+			vector.AppendFixed(rowIDVec, buildRowID(i), false, pool)
+			vector.AppendFixed(tsVec, types.BuildTS(ts, 0), false, pool)
+			vector.AppendFixed(vec1, int64(i), false, pool)
+		}
+		return &api.Batch{
+			Attrs: []string{"rowid", "time", "a"},
+			Vecs: []api.Vector{
+				mustVectorToProto(rowIDVec),
+				mustVectorToProto(tsVec),
+				mustVectorToProto(vec1),
+			},
+		}
+	}
+	state.HandleRowsInsert(ctx, buildBatch([]int{1, 2}, 1), 0, packer)
+	state.HandleRowsInsert(ctx, buildBatch([]int{3}, 2), 0, packer)
+	state.HandleRowsDelete(ctx, buildBatch([]int{1}, 3), packer)
+
+	changed := func(from, to int64) ([]int64, bool) {
+		var keys []int64
+		ok := state.ChangedRows(types.BuildTS(from, 0), types.BuildTS(to, 0), func(entry RowEntry) bool {
+			vec := entry.Batch.Vecs[2]
+			keys = append(keys, vector.GetFixedAt[int64](vec, int(entry.Offset)))
+			return true
+		})
+		return keys, ok
+	}
+	keys, ok := changed(1, 3)
+	require.True(t, ok)
+	require.ElementsMatch(t, []int64{1, 3}, keys)
+	keys, ok = changed(0, 1)
+	require.True(t, ok)
+	require.ElementsMatch(t, []int64{1, 2}, keys)
+
+	// the objects written after from are not in the rows
+	addObject(state, types.BuildTS(2, 0), types.TS{})
+	_, ok = changed(1, 3)
+	require.False(t, ok)
+	_, ok = changed(2, 3)
+	require.True(t, ok)
+
+	// the state is truncated after from
+	state.truncate([2]uint64{0, 0}, types.BuildTS(2, 0))
+	_, ok = changed(1, 3)
+	require.False(t, ok)
+}
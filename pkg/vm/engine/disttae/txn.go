@@ -243,7 +243,7 @@ func checkPKDup(
 		vs := vector.MustFixedCol[types.Blockid](pk)
 		return checkPKDupGeneric[types.Blockid](mp, colType, vs, start, count)
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_array_int8, types.T_array_bit:
 		for i := start; i < start+count; i++ {
 			v := pk.UnsafeGetStringAt(i)
 			if _, ok := mp[v]; ok {
@@ -1326,6 +1326,10 @@ func (tbl *txnTable) TableRenameInTxn(ctx context.Context, constraint [][]byte)
 		rename_table := req.Operation.(*api.AlterTableReq_RenameTable)
 		newTblName := rename_table.RenameTable.NewName
 		tbl.tableName = newTblName
+		if rename_table.RenameTable.RelKind != "" {
+			tbl.relKind = rename_table.RenameTable.RelKind
+			tbl.createSql = rename_table.RenameTable.CreateSql
+		}
 	}
 	tbl.tableDef = nil
 	tbl.GetTableDef(ctx)
@@ -2248,6 +2252,50 @@ func (tbl *txnTable) PrimaryKeysMayBeModified(
 		keysVector)
 }
 
+// ChangedPrimaryKeys returns the primary keys of the rows inserted or deleted
+// in (from, to], keys is nil if no row is changed. ok is false if some of the
+// changes are not kept in the memory of this CN, see PartitionState.ChangedRows.
+func (tbl *txnTable) ChangedPrimaryKeys(
+	ctx context.Context,
+	from types.TS,
+	to types.TS,
+	mp *mpool.MPool) (keys *vector.Vector, ok bool, err error) {
+	if tbl.primarySeqnum < 0 {
+		return nil, false, nil
+	}
+	if err = tbl.updateLogtail(ctx); err != nil {
+		return nil, false, err
+	}
+	part, err := tbl.getTxn().engine.lazyLoadLatestCkp(ctx, tbl)
+	if err != nil {
+		return nil, false, err
+	}
+
+	seen := make(map[string]struct{})
+	ok = part.Snapshot().ChangedRows(from, to, func(entry logtailreplay.RowEntry) bool {
+		if _, dup := seen[string(entry.PrimaryIndexBytes)]; dup {
+			return true
+		}
+		seen[string(entry.PrimaryIndexBytes)] = struct{}{}
+		src := entry.Batch.Vecs[2+tbl.primarySeqnum]
+		if entry.Deleted {
+			src = entry.Batch.Vecs[2]
+		}
+		if keys == nil {
+			keys = vector.NewVec(*src.GetType())
+		}
+		err = keys.UnionOne(src, entry.Offset, mp)
+		return err == nil
+	})
+	if err != nil || !ok {
+		if keys != nil {
+			keys.Free(mp)
+		}
+		return nil, false, err
+	}
+	return keys, true, nil
+}
+
 // TODO::refactor in next PR
 func (tbl *txnTable) transferDeletes(
 	ctx context.Context,
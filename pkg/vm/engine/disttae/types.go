@@ -163,6 +163,15 @@ type Engine struct {
 
 	//for message on multiCN, use uuid to get the messageBoard
 	messageCenter *process.MessageCenter
+
+	// captures are the change captures of the tables, see ChangeCapture.
+	captures struct {
+		sync.Mutex
+		m map[uint64][]*ChangeCapture
+		// tailC is the chan of the logtails to capture, it is consumed by
+		// captureWorker out of the path of applying logtails.
+		tailC chan captureTask
+	}
 }
 
 // Transaction represents a transaction
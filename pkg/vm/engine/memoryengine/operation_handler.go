@@ -136,6 +136,13 @@ type OperationHandler interface {
 		resp *TruncateRelationResp,
 	) error
 
+	HandleRenameRelation(
+		ctx context.Context,
+		meta txn.TxnMeta,
+		req *RenameRelationReq,
+		resp *RenameRelationResp,
+	) error
+
 	HandleAddTableDef(
 		ctx context.Context,
 		meta txn.TxnMeta,
@@ -232,6 +239,11 @@ func handle(
 		request := req.(*TruncateRelationReq)
 		handler.HandleTruncateRelation(ctx, meta, request, response)
 
+	case OpRenameRelation:
+		response := resp.(*RenameRelationResp)
+		request := req.(*RenameRelationReq)
+		err = handler.HandleRenameRelation(ctx, meta, request, response)
+
 	case OpOpenRelation:
 		response := resp.(*OpenRelationResp)
 		request := req.(*OpenRelationReq)
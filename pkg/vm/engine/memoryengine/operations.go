@@ -53,6 +53,7 @@ const (
 	OpRead
 	OpCloseTableIter
 	OpTableStats
+	OpRenameRelation
 	OpPreCommit  = uint32(apipb.OpCode_OpPreCommit)
 	OpGetLogTail = uint32(apipb.OpCode_OpGetLogTail)
 )
@@ -79,6 +80,7 @@ type WriteReqeust interface {
 		CreateRelationReq |
 		DeleteRelationReq |
 		TruncateRelationReq |
+		RenameRelationReq |
 		AddTableDefReq |
 		DelTableDefReq |
 		DeleteReq |
@@ -98,6 +100,7 @@ type Response interface {
 		CreateRelationResp |
 		DeleteRelationResp |
 		TruncateRelationResp |
+		RenameRelationResp |
 		OpenRelationResp |
 		GetRelationsResp |
 		AddTableDefResp |
@@ -304,6 +307,32 @@ func (m *TruncateRelationResp) UnmarshalBinary(data []byte) error {
 	return m.Unmarshal(data)
 }
 
+type RenameRelationReq struct {
+	TableID      ID
+	DatabaseName string
+	OldName      string
+	NewName      string
+}
+
+func (m *RenameRelationReq) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *RenameRelationReq) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
+type RenameRelationResp struct {
+}
+
+func (m *RenameRelationResp) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *RenameRelationResp) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
 type OpenRelationReq struct {
 	DatabaseID   ID
 	DatabaseName string
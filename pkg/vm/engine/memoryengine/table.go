@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"strings"
 )
 
 type Table struct {
@@ -417,7 +418,34 @@ func (t *Table) AlterTable(ctx context.Context, c *engine.ConstraintDef, constra
 }
 
 func (t *Table) TableRenameInTxn(ctx context.Context, constraint [][]byte) error {
-	// implement me
+	for _, data := range constraint {
+		req := &api.AlterTableReq{}
+		if err := req.Unmarshal(data); err != nil {
+			return err
+		}
+		rename := req.GetRenameTable()
+		if req.Kind != api.AlterKind_RenameTable || rename == nil {
+			continue
+		}
+
+		_, err := DoTxnRequest[RenameRelationResp](
+			ctx,
+			t.txnOperator,
+			false,
+			t.engine.allShards,
+			OpRenameRelation,
+			&RenameRelationReq{
+				TableID:      t.id,
+				DatabaseName: t.databaseName,
+				OldName:      strings.ToLower(rename.OldName),
+				NewName:      strings.ToLower(rename.NewName),
+			},
+		)
+		if err != nil {
+			return err
+		}
+		t.tableName = rename.NewName
+	}
 	return nil
 }
 
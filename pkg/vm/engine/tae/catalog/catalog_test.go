@@ -415,3 +415,33 @@ func TestAlterSchema(t *testing.T) {
 	require.Equal(t, 5, schema.GetSingleSortKeyIdx())
 
 }
+
+func TestAlterSchemaModifyColumn(t *testing.T) {
+	schema := MockSchemaAll(14, 3)
+	idx := schema.GetColIdx("mock_12")
+
+	typ := types.NewProtoType(types.T_varchar)
+	typ.Width = 200
+	req := api.NewModifyColumnReq(0, 0, "mock_12", typ, 12)
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Equal(t, idx, schema.GetColIdx("mock_12"))
+	require.Equal(t, types.T_varchar, schema.ColDefs[idx].Type.Oid)
+	require.Equal(t, int32(200), schema.ColDefs[idx].Type.Width)
+
+	// narrowing the column needs a rewrite of the data
+	typ = types.NewProtoType(types.T_varchar)
+	typ.Width = 10
+	req = api.NewModifyColumnReq(0, 0, "mock_12", typ, 12)
+	require.Error(t, schema.ApplyAlterTable(req))
+
+	typ = types.NewProtoType(types.T_text)
+	req = api.NewModifyColumnReq(0, 0, "mock_12", typ, 12)
+	require.Error(t, schema.ApplyAlterTable(req))
+
+	typ = types.NewProtoType(types.T_varchar)
+	typ.Width = 300
+	req = api.NewModifyColumnReq(0, 0, "mock_12", typ, 11)
+	require.Error(t, schema.ApplyAlterTable(req))
+	require.Error(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, "xyz", typ, 12)))
+	require.Equal(t, int32(200), schema.ColDefs[idx].Type.Width)
+}
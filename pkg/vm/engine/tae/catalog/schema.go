@@ -204,6 +204,24 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Extra.DroppedAttrs = append(s.Extra.DroppedAttrs, rename.OldName)
 		s.removeDroppedName(rename.NewName)
 		logutil.Infof("[Alter] rename column %s %s %d", rename.OldName, rename.NewName, targetCol.SeqNum)
+	case apipb.AlterKind_ModifyColumn:
+		modify := req.GetModifyCol()
+		idx, ok := s.NameMap[modify.Name]
+		if !ok {
+			return moerr.NewInternalErrorNoCtx("column %q not found", modify.Name)
+		}
+		targetCol := s.ColDefs[idx]
+		if targetCol.SeqNum != uint16(modify.SequenceNum) {
+			return moerr.NewInternalErrorNoCtx("unmatched seqnumn: %d != %d", targetCol.SeqNum, modify.SequenceNum)
+		}
+		newType := vector.ProtoTypeToType(modify.Type)
+		// only the width of a column can be changed without rewriting its data
+		if newType.Oid != targetCol.Type.Oid || newType.Width < targetCol.Type.Width {
+			return moerr.NewInternalErrorNoCtx("can't change column %q from %s to %s in place",
+				modify.Name, targetCol.Type.String(), newType.String())
+		}
+		targetCol.Type = newType
+		logutil.Infof("[Alter] modify column %s %s %d", modify.Name, newType.String(), targetCol.SeqNum)
 	case apipb.AlterKind_AddColumn:
 		add := req.GetAddColumn()
 		logutil.Infof("[Alter] add column %s(%s)@%d", add.Column.Name, types.T(add.Column.Typ.Id), add.InsertPosition)
@@ -264,6 +282,10 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		}
 		logutil.Infof("[Alter] rename table %s -> %s", s.Name, rename.NewName)
 		s.Name = rename.NewName
+		if rename.RelKind != "" {
+			s.Relkind = rename.RelKind
+			s.Createsql = rename.CreateSql
+		}
 	case apipb.AlterKind_AddPartition:
 		newPartitionDef := req.GetAddPartition().GetPartitionDef()
 		bytes, err := newPartitionDef.MarshalPartitionInfo()
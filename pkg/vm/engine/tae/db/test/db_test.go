@@ -6175,6 +6175,59 @@ func TestAlterRenameTbl2(t *testing.T) {
 
 }
 
+func TestAlterRenameTblSwapKind(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema1 := catalog.MockSchemaAll(2, -1)
+	schema1.Name = "p0"
+	schema1.Relkind = pkgcatalog.SystemPartitionRel
+	schema2 := catalog.MockSchemaAll(2, -1)
+	schema2.Name = "t1"
+	schema2.Relkind = pkgcatalog.SystemOrdinaryRel
+	schema2.Createsql = "create table t1"
+
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db", "", "")
+	rel1, _ := db.CreateRelation(schema1)
+	rel2, _ := db.CreateRelation(schema2)
+	tid1, tid2 := rel1.ID(), rel2.ID()
+	require.NoError(t, txn.Commit(context.Background()))
+
+	// swap the tables like EXCHANGE PARTITION: p0 -> tmp, t1 -> p0, tmp -> t1
+	txn, _ = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	tbl, _ := db.GetRelationByName("p0")
+	require.NoError(t, tbl.AlterTable(ctx, api.NewRenameTableReq(0, 0, "p0", "tmp")))
+	tbl, _ = db.GetRelationByName("t1")
+	require.NoError(t, tbl.AlterTable(ctx, api.NewRenameTableWithKindReq(0, 0, "t1", "p0", pkgcatalog.SystemPartitionRel, "")))
+	tbl, _ = db.GetRelationByName("tmp")
+	require.NoError(t, tbl.AlterTable(ctx, api.NewRenameTableWithKindReq(0, 0, "tmp", "t1", pkgcatalog.SystemOrdinaryRel, "create table t1")))
+	require.NoError(t, txn.Commit(context.Background()))
+
+	txn, _ = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	_, err := db.GetRelationByName("tmp")
+	require.True(t, moerr.IsMoErrCode(err, moerr.OkExpectedEOB))
+	tbl, err = db.GetRelationByName("p0")
+	require.NoError(t, err)
+	require.Equal(t, tid2, tbl.ID())
+	schema := tbl.Schema().(*catalog.Schema)
+	require.Equal(t, pkgcatalog.SystemPartitionRel, schema.Relkind)
+	require.Empty(t, schema.Createsql)
+	tbl, err = db.GetRelationByName("t1")
+	require.NoError(t, err)
+	require.Equal(t, tid1, tbl.ID())
+	schema = tbl.Schema().(*catalog.Schema)
+	require.Equal(t, pkgcatalog.SystemOrdinaryRel, schema.Relkind)
+	require.Equal(t, "create table t1", schema.Createsql)
+	require.NoError(t, txn.Commit(context.Background()))
+}
+
 func TestAlterTableBasic(t *testing.T) {
 	defer testutils.AfterTest(t)()
 	ctx := context.Background()
@@ -62,6 +62,8 @@ var dedupNABlkFunctions = map[types.T]any{
 
 	types.T_array_float32: dedupNABlkBytesFunc,
 	types.T_array_float64: dedupNABlkBytesFunc,
+	types.T_array_int8:    dedupNABlkBytesFunc,
+	types.T_array_bit:     dedupNABlkBytesFunc,
 }
 
 var dedupAlkFunctions = map[types.T]any{
@@ -100,6 +102,8 @@ var dedupAlkFunctions = map[types.T]any{
 
 	types.T_array_float32: dedupABlkBytesFunc,
 	types.T_array_float64: dedupABlkBytesFunc,
+	types.T_array_int8:    dedupABlkBytesFunc,
+	types.T_array_bit:     dedupABlkBytesFunc,
 }
 
 func parseNADedeupArgs(args ...any) (vec *vector.Vector, mask *nulls.Bitmap, def *catalog.ColDef) {
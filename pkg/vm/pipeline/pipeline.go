@@ -85,6 +85,7 @@ func (p *Pipeline) Run(r engine.Reader, topValueMsgTag int32, proc *process.Proc
 			// end is true means pipeline successfully completed
 			return end, nil
 		}
+		proc.ThrottleCPU()
 	}
 }
 
@@ -117,6 +118,7 @@ func (p *Pipeline) ConstRun(bat *batch.Batch, proc *process.Process) (end bool,
 		if end {
 			return end, nil
 		}
+		proc.ThrottleCPU()
 	}
 }
 
@@ -140,5 +142,6 @@ func (p *Pipeline) MergeRun(proc *process.Process) (end bool, err error) {
 		if end {
 			return end, nil
 		}
+		proc.ThrottleCPU()
 	}
 }
@@ -54,6 +54,7 @@ func resetAnalyzeInfo(a *AnalyzeInfo) {
 	a.InputRows = 0
 	a.OutputRows = 0
 	a.TimeConsumed = 0
+	a.cpuCharged = 0
 	a.WaitTimeConsumed = 0
 	a.InputSize = 0
 	a.OutputSize = 0
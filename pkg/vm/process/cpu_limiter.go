@@ -0,0 +1,131 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// cpuLimiterBurst is how long the pipelines of a resource group may run on
+// all the CPUs before they are throttled.
+const cpuLimiterBurst = 100 * time.Millisecond
+
+// CPULimiter limits the CPU time used by the pipelines of the statements of a
+// resource group on this CN to its share of the CPUs. The time used by the
+// operators is charged after each batch, and the pipeline sleeps while the
+// group used more than its share, so the pipelines waiting for it are never
+// blocked by the limiter.
+type CPULimiter struct {
+	sync.Mutex
+	share int64
+	// rate is the CPU time the group may use in a unit of time
+	rate float64
+	// avail is the CPU time in ns the group may use now, it is negative if
+	// the group used more than its share
+	avail float64
+	last  time.Time
+}
+
+func newCPULimiter(share int64, ncpu int) *CPULimiter {
+	rate := float64(ncpu) * float64(share) / 100
+	return &CPULimiter{
+		share: share,
+		rate:  rate,
+		avail: rate * float64(cpuLimiterBurst),
+		last:  time.Now(),
+	}
+}
+
+// charge charges the CPU time used at now, and returns how long the pipeline
+// should sleep before it runs on.
+func (l *CPULimiter) charge(used time.Duration, now time.Time) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+	l.avail = min(l.avail+l.rate*float64(now.Sub(l.last)), l.rate*float64(cpuLimiterBurst))
+	l.last = now
+	l.avail -= float64(used)
+	if l.avail >= 0 {
+		return 0
+	}
+	return time.Duration(-l.avail / l.rate)
+}
+
+// cpuLimiters are the CPU limiters of the resource groups on this CN.
+var cpuLimiters = struct {
+	sync.Mutex
+	m map[string]*CPULimiter
+}{m: make(map[string]*CPULimiter)}
+
+// GroupCPULimiter returns the CPU limiter of the resource group on this CN,
+// or nil if the group may use all the CPUs. The limiter is replaced if the
+// share is changed.
+func GroupCPULimiter(name string, share int64) *CPULimiter {
+	if name == "" || share <= 0 || share >= 100 {
+		return nil
+	}
+	cpuLimiters.Lock()
+	defer cpuLimiters.Unlock()
+	if l, ok := cpuLimiters.m[name]; ok && l.share == share {
+		return l
+	}
+	l := newCPULimiter(share, runtime.GOMAXPROCS(0))
+	cpuLimiters.m[name] = l
+	return l
+}
+
+// ThrottleCPU charges the CPU time used by the operators of the statement
+// since the last call to the resource group of the statement, and sleeps while
+// the group used more than its share of the CPUs on this CN. It is called by
+// the pipelines between the batches.
+func (proc *Process) ThrottleCPU() {
+	l := GroupCPULimiter(proc.Lim.ResourceGroup, proc.Lim.CPUShare)
+	if l == nil {
+		return
+	}
+	var used int64
+	for _, info := range proc.AnalInfos {
+		if info != nil {
+			used += info.chargeCPU()
+		}
+	}
+	wait := l.charge(time.Duration(used), time.Now())
+	if wait <= 0 {
+		return
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-proc.Ctx.Done():
+	}
+}
+
+// chargeCPU returns the time consumed by the node not charged to the CPU
+// limiter yet. The time is charged once by the parallel pipelines of the node.
+func (a *AnalyzeInfo) chargeCPU() int64 {
+	consumed := atomic.LoadInt64(&a.TimeConsumed)
+	for {
+		charged := atomic.LoadInt64(&a.cpuCharged)
+		if consumed <= charged {
+			return 0
+		}
+		if atomic.CompareAndSwapInt64(&a.cpuCharged, charged, consumed) {
+			return consumed - charged
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPULimiter(t *testing.T) {
+	// the group may use 2 of the 4 CPUs
+	l := newCPULimiter(50, 4)
+	now := l.last
+
+	// the burst is used at once
+	require.Zero(t, l.charge(2*cpuLimiterBurst, now))
+	require.Equal(t, time.Second/2, l.charge(time.Second, now))
+
+	// the CPU time is given back at the rate of the share
+	require.Zero(t, l.charge(0, now.Add(time.Second/2)))
+	require.Equal(t, 50*time.Millisecond, l.charge(300*time.Millisecond, now.Add(time.Second)))
+	require.Zero(t, l.charge(0, now.Add(time.Hour)))
+	require.Equal(t, 2*cpuLimiterBurst, time.Duration(l.avail))
+}
+
+func TestGroupCPULimiter(t *testing.T) {
+	require.Nil(t, GroupCPULimiter("", 50))
+	require.Nil(t, GroupCPULimiter("g1", 0))
+	require.Nil(t, GroupCPULimiter("g1", 100))
+
+	l := GroupCPULimiter("g1", 50)
+	require.Same(t, l, GroupCPULimiter("g1", 50))
+	require.NotSame(t, l, GroupCPULimiter("g1", 20))
+}
+
+func TestThrottleCPU(t *testing.T) {
+	infos := []*AnalyzeInfo{newAnalyzeInfo(), nil, newAnalyzeInfo()}
+	proc := &Process{Ctx: context.Background(), AnalInfos: infos}
+	proc.Lim.ResourceGroup = "test-throttle-cpu"
+	proc.Lim.CPUShare = 1
+
+	// the time consumed is charged once
+	infos[0].TimeConsumed = int64(time.Millisecond)
+	infos[2].TimeConsumed = int64(2 * time.Millisecond)
+	proc.ThrottleCPU()
+	require.Zero(t, infos[0].chargeCPU())
+	require.Zero(t, infos[2].chargeCPU())
+
+	// the pipeline sleeps until the statement is cancelled
+	l := GroupCPULimiter(proc.Lim.ResourceGroup, proc.Lim.CPUShare)
+	l.charge(time.Hour, time.Now())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	proc.Ctx = ctx
+	start := time.Now()
+	proc.ThrottleCPU()
+	require.Less(t, time.Since(start), time.Minute)
+	require.Error(t, ctx.Err())
+}
@@ -45,6 +45,11 @@ func (proc *Process) BuildProcessInfo(
 		procInfo.Sql = sql
 		procInfo.Lim = convertToPipelineLimitation(proc.Lim)
 		procInfo.UnixTime = proc.UnixTime
+		// the remote pipelines are interrupted at the same deadline, such as
+		// the max_execution_time of the statement
+		if deadline, ok := proc.Ctx.Deadline(); ok {
+			procInfo.Deadline = deadline.UnixNano()
+		}
 		accountId, err := defines.GetAccountId(proc.Ctx)
 		if err != nil {
 			return procInfo, err
@@ -199,6 +204,10 @@ func convertToPipelineLimitation(lim Limitation) pipeline.ProcessLimitation {
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		MemoryQuota:   lim.MemoryQuota,
+		CpuShare:      lim.CPUShare,
+		ResourceGroup: lim.ResourceGroup,
+		MemoryBudget:  lim.MemoryBudget,
 	}
 }
 
@@ -245,6 +254,10 @@ func ConvertToProcessLimitation(
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		MemoryQuota:   lim.MemoryQuota,
+		CPUShare:      lim.CpuShare,
+		ResourceGroup: lim.ResourceGroup,
+		MemoryBudget:  lim.MemoryBudget,
 	}
 }
 
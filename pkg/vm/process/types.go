@@ -118,6 +118,18 @@ type Limitation struct {
 	PartitionRows int64
 	// ReaderSize, memory threshold for storage's reader
 	ReaderSize int64
+	// MemoryQuota, max memory of the statement on a CN, 0 means no limit.
+	MemoryQuota int64
+	// CPUShare, percent of the CPUs the pipelines of the statements of the
+	// resource group may use on a CN, 0 means all of them. It scales the
+	// degree of parallelism of the pipelines, and the pipelines are throttled
+	// while the group uses more, see ThrottleCPU.
+	CPUShare int64
+	// ResourceGroup, the resource group the statement runs in.
+	ResourceGroup string
+	// MemoryBudget, max memory of the statements of the resource group on a
+	// CN, 0 means no limit.
+	MemoryBudget int64
 	// MaxMessageSize max size for read messages from dn
 	MaxMsgSize uint64
 }
@@ -184,6 +196,10 @@ type AnalyzeInfo struct {
 	// InsertTime, insert cost time in load flow
 	InsertTime int64
 
+	// cpuCharged, time consumed charged to the CPU limiter of the resource
+	// group, see ThrottleCPU
+	cpuCharged int64
+
 	// time consumed by every single parallel
 	mu                     *sync.Mutex
 	TimeConsumedArrayMajor []int64
@@ -214,6 +230,10 @@ type StmtProfile struct {
 	//the sql from user may have multiple statements
 	//sqlOfStmt is the text part of one statement in the sql
 	sqlOfStmt string
+	// queueWait is the time the query waited in the admission queue, and
+	// queueWaitStart is the time it starts to wait if it is waiting now.
+	queueWait      time.Duration
+	queueWaitStart time.Time
 }
 
 func NewStmtProfile(txnId, stmtId uuid.UUID) *StmtProfile {
@@ -232,6 +252,8 @@ func (sp *StmtProfile) Clear() {
 	sp.stmtType = ""
 	sp.queryType = ""
 	sp.sqlOfStmt = ""
+	sp.queueWait = 0
+	sp.queueWaitStart = time.Time{}
 }
 
 func (sp *StmtProfile) SetSqlOfStmt(sot string) {
@@ -246,10 +268,14 @@ func (sp *StmtProfile) GetSqlOfStmt() string {
 	return sp.sqlOfStmt
 }
 
+// SetQueryStart sets the start time of a new query, which has not waited in
+// the admission queue yet.
 func (sp *StmtProfile) SetQueryStart(t time.Time) {
 	sp.mu.Lock()
 	defer sp.mu.Unlock()
 	sp.queryStart = t
+	sp.queueWait = 0
+	sp.queueWaitStart = time.Time{}
 }
 
 func (sp *StmtProfile) GetQueryStart() time.Time {
@@ -258,6 +284,34 @@ func (sp *StmtProfile) GetQueryStart() time.Time {
 	return sp.queryStart
 }
 
+// BeginQueueWait marks the query starts to wait in the admission queue.
+func (sp *StmtProfile) BeginQueueWait() {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	sp.queueWaitStart = time.Now()
+}
+
+// EndQueueWait marks the query leaves the admission queue.
+func (sp *StmtProfile) EndQueueWait() {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if !sp.queueWaitStart.IsZero() {
+		sp.queueWait += time.Since(sp.queueWaitStart)
+		sp.queueWaitStart = time.Time{}
+	}
+}
+
+// GetQueueWait returns the time the query has waited in the admission queue,
+// including the time it is waiting now.
+func (sp *StmtProfile) GetQueueWait() time.Duration {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if sp.queueWaitStart.IsZero() {
+		return sp.queueWait
+	}
+	return sp.queueWait + time.Since(sp.queueWaitStart)
+}
+
 func (sp *StmtProfile) SetSqlSourceType(st string) {
 	sp.mu.Lock()
 	defer sp.mu.Unlock()
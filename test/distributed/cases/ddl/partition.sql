@@ -145,9 +145,62 @@ create table pt_table_52(col1 tinyint,col2 smallint,col3 int,col4 bigint,col11 v
 create table pt_table_53(col1 tinyint,col2 smallint,col3 int,col4 bigint,col5 tinyint unsigned,col6 smallint unsigned,col7 int unsigned,col8 bigint unsigned,col9 float,col10 double,col11 varchar(255),col12 Date,col13 DateTime,col14 timestamp,col15 bool,col16 decimal(5,2),col17 text,col18 varchar(255),col19 varchar(255),col20 text,primary key(col4,col3,col11))partition by list(col3) (PARTITION r0 VALUES IN (1, 5*2, 9, 13, 17-20, 21),PARTITION r1 VALUES IN (2, 6, 10, 14*2, 18, 22),PARTITION r2 VALUES IN (3, 7, 11+6, 15, 19, 23),PARTITION r3 VALUES IN (4, 8, 12, 16, 20, 24));
 create table pt_table_54(col1 tinyint,col2 smallint,col3 int,col4 bigint,col5 tinyint unsigned,col6 smallint unsigned,col7 int unsigned,col8 bigint unsigned,col9 float,col10 double,col11 varchar(255),col12 Date,col13 DateTime,col14 timestamp,col15 bool,col16 decimal(5,2),col17 text,col18 varchar(255),col19 varchar(255),col20 text,primary key(col4,col3,col11))partition by list(col3) (PARTITION r0 VALUES IN (1, 5*2, 9, 13, 17-20, 21),PARTITION r1 VALUES IN (2, 6, 11, 14*2, 18, 22),PARTITION r2 VALUES IN (3, 7, 11+6, 15, 19, 23),PARTITION r3 VALUES IN (4, 8, 12, 16, 20, 24));
 
---异常：兼容mysql,外键不支持与分区结合使用
+--外键可以与分区表结合使用，外键在逻辑表上检查
 create table dept(deptno int unsigned auto_increment, dname varchar(15), loc varchar(50), primary key(deptno));
 create table emp(empno int unsigned auto_increment, ename varchar(15), job varchar(10), mgr int unsigned, hiredate date, sal decimal(7,2), comm decimal(7,2), deptno int unsigned, primary key(empno), foreign key (deptno) references dept(deptno)) partition by key(empno) partitions 2;
+insert into dept values (10, 'ACCOUNTING', 'NEW YORK');
+insert into emp values (7369, 'SMITH', 'CLERK', 7902, '1980-12-17', 800, NULL, 10);
+insert into emp values (7499, 'ALLEN', 'SALESMAN', 7698, '1981-02-20', 1600, 300, 30);
+delete from dept where deptno = 10;
+select empno, deptno from emp;
+drop table emp;
+drop table dept;
+--外键的级联删除和置空在分区子表上生效
+create table dept(deptno int unsigned auto_increment, dname varchar(15), loc varchar(50), primary key(deptno));
+create table emp(empno int unsigned auto_increment, ename varchar(15), job varchar(10), mgr int unsigned, hiredate date, sal decimal(7,2), comm decimal(7,2), deptno int unsigned, primary key(empno), foreign key (deptno) references dept(deptno) on delete cascade on update cascade) partition by key(empno) partitions 2;
+insert into dept values (10, 'ACCOUNTING', 'NEW YORK'), (20, 'RESEARCH', 'DALLAS');
+insert into emp values (7369, 'SMITH', 'CLERK', 7902, '1980-12-17', 800, NULL, 10), (7499, 'ALLEN', 'SALESMAN', 7698, '1981-02-20', 1600, 300, 20), (7521, 'WARD', 'SALESMAN', 7698, '1981-02-22', 1250, 500, 20);
+update dept set deptno = 30 where deptno = 20;
+select empno, deptno from emp order by empno;
+delete from dept where deptno = 30;
+select empno, deptno from emp order by empno;
+drop table emp;
+create table emp(empno int unsigned auto_increment, ename varchar(15), deptno int unsigned, primary key(empno), foreign key (deptno) references dept(deptno) on delete set null on update set null) partition by hash(empno) partitions 3;
+insert into dept values (20, 'RESEARCH', 'DALLAS');
+insert into emp values (7369, 'SMITH', 10), (7499, 'ALLEN', 20), (7521, 'WARD', 20);
+update dept set deptno = 40 where deptno = 10;
+select empno, deptno from emp order by empno;
+delete from dept where deptno = 20;
+select empno, deptno from emp order by empno;
+drop table emp;
+drop table dept;
+--被引用的父表是分区表
+create table dept(deptno int unsigned, dname varchar(15), primary key(deptno)) partition by range(deptno) (partition p0 values less than (20), partition p1 values less than maxvalue);
+create table emp(empno int unsigned, ename varchar(15), deptno int unsigned, primary key(empno), foreign key (deptno) references dept(deptno) on delete cascade);
+insert into dept values (10, 'ACCOUNTING'), (30, 'SALES');
+insert into emp values (7369, 'SMITH', 10), (7499, 'ALLEN', 30);
+insert into emp values (7521, 'WARD', 20);
+delete from dept where deptno = 30;
+select empno, deptno from emp order by empno;
+drop table dept;
+drop table emp;
+drop table dept;
+--在已有的分区表上添加外键
+create table dept(deptno int unsigned, dname varchar(15), primary key(deptno));
+create table emp(empno int unsigned, ename varchar(15), deptno int unsigned, primary key(empno)) partition by key(empno) partitions 2;
+insert into dept values (10, 'ACCOUNTING');
+insert into emp values (7369, 'SMITH', 10), (7499, 'ALLEN', 20);
+alter table emp add constraint fk_emp_dept foreign key (deptno) references dept(deptno);
+delete from emp where empno = 7499;
+alter table emp add constraint fk_emp_dept foreign key (deptno) references dept(deptno) on delete cascade;
+insert into emp values (7499, 'ALLEN', 20);
+delete from dept where deptno = 10;
+select empno, deptno from emp order by empno;
+alter table emp drop foreign key fk_emp_dept;
+insert into emp values (7499, 'ALLEN', 20);
+select empno, deptno from emp order by empno;
+drop table emp;
+drop table dept;
 
 --该测试用例在mysql中是合法，在mo中不合法，原因是ceiling函数造成的，如下：
 --在MySQL中，HASH分区要求分区键必须是INT类型，或者通过表达式返回INT类型。
@@ -0,0 +1,36 @@
+drop database if exists merge_db;
+create database merge_db;
+use merge_db;
+
+create table t (a int primary key, b varchar(10), c int default 7);
+create table s (a int, b varchar(10));
+insert into t values (1, 'a', 1), (2, 'b', 2), (3, 'c', 3);
+insert into s values (1, 'x'), (3, null), (4, 'y');
+
+-- matched
+merge into t using s on t.a = s.a when matched then update set b = s.b;
+select * from t order by a;
+
+-- not matched
+merge into t using s on t.a = s.a when not matched then insert (a, b) values (s.a, s.b);
+select * from t order by a;
+
+-- delete, the first WHEN clause satisfied takes the row
+merge into t using s on t.a = s.a when matched and s.b is null then delete when matched then update set c = c + 10;
+select * from t order by a;
+
+-- all the clauses
+delete from s;
+insert into s values (1, null), (2, 'z'), (5, 'w');
+merge into t using s on t.a = s.a
+when matched and s.b is null then delete
+when matched then update set b = s.b, c = default
+when not matched then insert values (s.a, s.b, 5);
+select * from t order by a;
+
+-- a target row can not be updated or deleted more than once
+insert into s values (2, 'v');
+merge into t using s on t.a = s.a when matched then update set b = s.b;
+select * from t order by a;
+
+drop database merge_db;
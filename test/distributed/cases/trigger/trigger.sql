@@ -0,0 +1,87 @@
+drop database if exists trigger_test;
+create database trigger_test;
+use trigger_test;
+
+create table t1(a int primary key, b int);
+create table audit(id int auto_increment primary key, op varchar(10), a int, b int);
+
+-- @case
+-- @desc:the row triggers of INSERT, UPDATE and DELETE
+-- @label:bvt
+create trigger t1_bi before insert on t1 for each row set new.b = new.b * 10;
+create trigger t1_ai after insert on t1 for each row insert into audit(op, a, b) values ('insert', new.a, new.b);
+create trigger t1_au after update on t1 for each row insert into audit(op, a, b) values ('update', old.b, new.b);
+create trigger t1_ad after delete on t1 for each row insert into audit(op, a, b) values ('delete', old.a, old.b);
+insert into t1 values (1, 1), (2, 2);
+update t1 set b = b + 1 where a = 1;
+delete from t1 where a = 2;
+select * from t1 order by a;
+select op, a, b from audit order by id;
+delete from audit;
+
+-- @case
+-- @desc:the prepared statements fire the triggers with their parameters
+-- @label:bvt
+prepare s1 from 'insert into t1 values (?, ?)';
+set @a = 3;
+set @b = 3;
+execute s1 using @a, @b;
+set @a = 4;
+execute s1 using @a, @b;
+prepare s2 from 'update t1 set b = ? where a = ?';
+set @b = 0;
+execute s2 using @b, @a;
+prepare s3 from 'delete from t1 where a = ?';
+execute s3 using @a;
+deallocate prepare s1;
+deallocate prepare s2;
+deallocate prepare s3;
+select * from t1 order by a;
+select op, a, b from audit order by id;
+delete from audit;
+
+-- @case
+-- @desc:the DML of the stored procedures fires the triggers
+-- @label:bvt
+drop procedure if exists p1;
+-- @delimiter .
+create procedure p1 (in x int) 'begin insert into t1 values (x, x); update t1 set b = b + 1 where a = x; delete from t1 where a = x; end'
+.
+-- @delimiter ;
+call p1(5);
+select * from t1 order by a;
+select op, a, b from audit order by id;
+drop procedure p1;
+delete from audit;
+
+-- @case
+-- @desc:the statements with triggers and the statements of the triggers run with the privileges of the invoker
+-- @label:bvt
+drop user if exists trigger_user;
+drop role if exists trigger_role;
+create role trigger_role;
+grant connect on account * to trigger_role;
+grant select on table trigger_test.* to trigger_role;
+create user trigger_user identified by '111' default role trigger_role;
+-- @session:id=1&user=sys:trigger_user:trigger_role&password=111
+use trigger_test;
+insert into t1 values (6, 6);
+update t1 set b = 0;
+delete from t1;
+-- @session
+grant insert on table trigger_test.t1 to trigger_role;
+-- @session:id=1&user=sys:trigger_user:trigger_role&password=111
+insert into t1 values (6, 6);
+-- @session
+select * from t1 order by a;
+select op, a, b from audit order by id;
+drop user trigger_user;
+drop role trigger_role;
+
+-- @case
+-- @desc:the statements returning the rows can not fire the triggers
+-- @label:bvt
+insert into t1 values (7, 7) returning a;
+select * from t1 order by a;
+
+drop database trigger_test;
@@ -169,4 +169,17 @@ commit;
 show create table vector_index_10;
 drop table vector_index_10;
 
+-- pre-filtered vector search returns the limit under a selective filter
+create table vector_index_11(a int primary key, b vecf32(3), c int);
+insert into vector_index_11 select result, concat('[', result, ',', result, ',', result, ']'), result % 100 from generate_series(1, 1000) g;
+create index idx01 using ivfflat on vector_index_11(b) lists=10 op_type "vector_l2_ops";
+set ivf_filter_mode = 'pre';
+select a from vector_index_11 where a > 990 order by l2_distance(b, '[1,1,1]') limit 3;
+select a from vector_index_11 where a > 990 order by l2_distance(b, '[1,1,1]') limit 3 offset 2;
+select a from vector_index_11 where c = 0 order by l2_distance(b, '[1,1,1]') limit 5;
+set ivf_filter_mode = 'auto';
+select a from vector_index_11 where a > 990 order by l2_distance(b, '[1,1,1]') limit 3;
+set ivf_filter_mode = 'post';
+drop table vector_index_11;
+
 SET experimental_ivf_index = 0;